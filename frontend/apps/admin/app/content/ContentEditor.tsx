@@ -1,7 +1,8 @@
 import { useEffect, useState } from 'react'
 import { FormProvider, useForm, useController } from 'react-hook-form'
 import { Input, Loading, Tabbar } from 'ui'
-import { ContentConfig } from './types'
+import { ContentConfig, ContentFormat } from './types'
+import { contentFormats } from './formats'
 import { useContentCreate, useContentFindById, useContentUpdate } from './api'
 import { useNamespace } from './NamespaceSelector'
 import { useRouter } from 'next/router'
@@ -18,7 +19,9 @@ interface Props {
 interface ContentFormValues {
   title: string
   slug: string
+  format: ContentFormat
   body: string
+  language: string
   publishedAt: string
 }
 
@@ -36,7 +39,9 @@ export function ContentEditor({ config, id }: Props) {
     defaultValues: {
       title: '',
       slug: '',
+      format: config.defaultFormat,
       body: '',
+      language: '',
       publishedAt: '',
     },
   })
@@ -61,14 +66,16 @@ export function ContentEditor({ config, id }: Props) {
     if (existing.data && !initialized) {
       let formattedBody: string
       try {
-        formattedBody = config.formatBody(existing.data.body)
+        formattedBody = contentFormats[existing.data.format].formatBody(existing.data.body)
       } catch {
         formattedBody = existing.data.body
       }
       methods.reset({
         title: existing.data.title,
         slug: existing.data.slug,
+        format: existing.data.format,
         body: formattedBody,
+        language: existing.data.language ?? '',
         publishedAt: existing.data.published_at
           ? existing.data.published_at.slice(0, 16) // format for datetime-local input
           : '',
@@ -95,6 +102,8 @@ export function ContentEditor({ config, id }: Props) {
   // Watch values for live preview
   const watchedTitle = methods.watch('title')
   const watchedBody = methods.watch('body')
+  const watchedFormat = methods.watch('format')
+  const format = contentFormats[watchedFormat]
 
   const createMutation = useContentCreate(
     config,
@@ -128,7 +137,9 @@ export function ContentEditor({ config, id }: Props) {
       id: itemId,
       slug: data.slug.trim().toLowerCase(),
       title: data.title.trim(),
+      format: data.format,
       body: data.body,
+      language: data.language.trim().toLowerCase() || null,
       published_at: data.publishedAt ? toUtcISOStringFromLocal(data.publishedAt) : null,
     }
 
@@ -201,14 +212,25 @@ export function ContentEditor({ config, id }: Props) {
                 <span className="error">{methods.formState.errors.slug?.message}</span>
               </div>
               <div className={`label flex-1 ${methods.formState.errors.body ? 'error' : ''}`}>
-                <div className="flex items-center justify-between">
-                  <span className="label-text">Content</span>
+                <div className="flex items-center justify-between gap-3">
+                  <span className="label-text flex-1">Content</span>
+                  <select
+                    className="input w-auto"
+                    aria-label="Content format"
+                    {...methods.register('format')}
+                  >
+                    {(Object.keys(contentFormats) as ContentFormat[]).map(key => (
+                      <option key={key} value={key}>
+                        {contentFormats[key].label}
+                      </option>
+                    ))}
+                  </select>
                   <button
                     type="button"
                     className="btn ghost"
                     onClick={() => {
                       try {
-                        bodyController.field.onChange(config.formatBody(bodyController.field.value))
+                        bodyController.field.onChange(format.formatBody(bodyController.field.value))
                       } catch (e) {
                         toast.error(
                           `Format failed: ${e instanceof Error ? e.message : 'unknown error'}`,
@@ -220,13 +242,23 @@ export function ContentEditor({ config, id }: Props) {
                     Format
                   </button>
                 </div>
-                {config.renderEditor({
+                {format.renderEditor({
                   value: bodyController.field.value,
                   onChange: bodyController.field.onChange,
                   placeholder: `Write your ${config.label.toLowerCase()} content here...`,
                 })}
                 <span className="error">{methods.formState.errors.body?.message}</span>
               </div>
+              <Input
+                name="language"
+                type="text"
+                label="Language"
+                placeholder="en"
+                hint="ISO 639-1 code of the content, e.g. en or ja. Used to index it for search"
+                options={{
+                  pattern: { value: /^\s*([a-zA-Z]{2,3})?\s*$/, message: 'Use a two or three letter code' },
+                }}
+              />
               <Input
                 name="publishedAt"
                 type="datetime-local"
@@ -259,7 +291,7 @@ export function ContentEditor({ config, id }: Props) {
               {watchedTitle.trim() || watchedBody.trim() ? (
                 <>
                   {watchedTitle.trim() ? <h2 className="text-xl font-bold mb-4">{watchedTitle}</h2> : null}
-                  {config.renderBody(watchedBody)}
+                  {format.renderBody(watchedBody)}
                 </>
               ) : (
                 <p className="text-sm text-slate-400 italic">
@@ -2,6 +2,7 @@ import { Loading, Pagination } from 'ui'
 import { ContentConfig } from './types'
 import { useContentList } from './api'
 import { StatusBadge } from './StatusBadge'
+import { UpcomingSchedules } from './ContentSchedules'
 import { DateTime } from 'luxon'
 import Link from 'next/link'
 import { useState, useEffect } from 'react'
@@ -24,6 +25,7 @@ export function ContentList({ config, namespace }: Props) {
 
   return (
     <>
+      <UpcomingSchedules config={config} namespace={namespace} />
 
       {list.isError ? (
         <div className="mt-4">
@@ -1,8 +1,19 @@
 import { Loading } from 'ui'
 import { ContentConfig } from './types'
-import { ContentVersion, useContentFindById, useContentUpdate, useContentVersionList, useContentVersionGet } from './api'
+import { contentFormats } from './formats'
+import {
+  ContentDiffSegment,
+  ContentVersion,
+  useContentFindById,
+  useContentUpdate,
+  useContentVersionDiff,
+  useContentVersionList,
+  useContentVersionGet,
+  useContentVersionRestore,
+} from './api'
 import { useNamespace } from './NamespaceSelector'
 import { StatusBadge } from './StatusBadge'
+import { ContentSchedulePanel } from './ContentSchedules'
 import { DateTime } from 'luxon'
 import Link from 'next/link'
 import { ArrowUturnLeftIcon, PencilIcon } from '@heroicons/react/20/solid'
@@ -34,6 +45,7 @@ function VersionHistory({
     (versionPage + 1) * versionsPerPage,
   )
   const latestId = versions[versions.length - 1].id
+  const versionNumbers = new Map(versions.map(v => [v.id, v.version]))
 
   return (
     <div className="mt-4 pt-4 border-t border-slate-100">
@@ -63,6 +75,11 @@ function VersionHistory({
                 <span className="text-xs text-slate-400 ml-2">
                   {DateTime.fromISO(v.created_at).toLocaleString(DateTime.DATE_MED)}
                 </span>
+                {v.restored_from_id && versionNumbers.has(v.restored_from_id) ? (
+                  <span className="text-xs text-slate-400 ml-2">
+                    restored from v{versionNumbers.get(v.restored_from_id)}
+                  </span>
+                ) : null}
               </button>
             </li>
           )
@@ -95,6 +112,30 @@ function VersionHistory({
   )
 }
 
+function DiffText({ segments }: { segments: ContentDiffSegment[] }) {
+  return (
+    <>
+      {segments.map((segment, i) => {
+        if (segment.op === 'insert') {
+          return (
+            <ins key={i} className="bg-emerald-100 text-emerald-900 no-underline">
+              {segment.text}
+            </ins>
+          )
+        }
+        if (segment.op === 'delete') {
+          return (
+            <del key={i} className="bg-red-100 text-red-900">
+              {segment.text}
+            </del>
+          )
+        }
+        return <span key={i}>{segment.text}</span>
+      })}
+    </>
+  )
+}
+
 function MetadataRow({ label, children }: { label: string; children: React.ReactNode }) {
   return (
     <div className="flex flex-col gap-1">
@@ -116,6 +157,7 @@ export function ContentPreview({ config, id }: Props) {
   const queryClient = useQueryClient()
   const [selectedVersionId, setSelectedVersionId] = useState<string | null>(null)
   const [versionPage, setVersionPage] = useState(0)
+  const [showChanges, setShowChanges] = useState(false)
   const versionsPerPage = 10
 
   const item = useContentFindById(config, namespace, id, {
@@ -134,6 +176,16 @@ export function ContentPreview({ config, id }: Props) {
     { enabled: !!selectedVersionId },
   )
 
+  const latestId = versions.data?.[versions.data.length - 1]?.id ?? null
+  const versionDiff = useContentVersionDiff(
+    config,
+    namespace,
+    id,
+    selectedVersionId,
+    latestId,
+    { enabled: showChanges && selectedVersionId !== latestId },
+  )
+
   const togglePublishMutation = useContentUpdate(
     config,
     namespace,
@@ -150,12 +202,13 @@ export function ContentPreview({ config, id }: Props) {
     },
   )
 
-  const restoreVersionMutation = useContentUpdate(
+  const restoreVersionMutation = useContentVersionRestore(
     config,
     namespace,
     () => {
       toast.success('Version restored successfully', { position: 'bottom-right' })
       setSelectedVersionId(null)
+      setShowChanges(false)
       setVersionPage(0)
       queryClient.removeQueries([config.type, 'findById', namespace, id])
       queryClient.invalidateQueries([config.type])
@@ -171,19 +224,18 @@ export function ContentPreview({ config, id }: Props) {
       id: item.data.id,
       slug: item.data.slug,
       title: item.data.title,
+      format: item.data.format,
       body: item.data.body,
+      language: item.data.language,
       published_at: item.data.published_at ? null : new Date().toISOString(),
     })
   }
 
   const handleRestoreVersion = () => {
-    if (!item.data || !selectedVersion.data) return
+    if (!item.data || !selectedVersionId) return
     restoreVersionMutation.mutate({
       id: item.data.id,
-      slug: item.data.slug,
-      title: selectedVersion.data.title,
-      body: selectedVersion.data.body,
-      published_at: item.data.published_at,
+      contentId: selectedVersionId,
     })
   }
 
@@ -202,9 +254,10 @@ export function ContentPreview({ config, id }: Props) {
   }
 
   const data = item.data
-  const latestVersionId = versions.data?.[versions.data.length - 1]?.id ?? null
-  const isViewingVersion = selectedVersionId !== null && selectedVersionId !== latestVersionId
+  const isViewingVersion = selectedVersionId !== null && selectedVersionId !== latestId
+  const showingChanges = isViewingVersion && showChanges
   const previewBody = isViewingVersion ? (selectedVersion.data?.body ?? data.body) : data.body
+  const previewFormat = isViewingVersion ? (selectedVersion.data?.format ?? data.format) : data.format
   const previewTitle = isViewingVersion ? (selectedVersion.data?.title ?? data.title) : data.title
 
   return (
@@ -228,6 +281,13 @@ export function ContentPreview({ config, id }: Props) {
                 <ArrowUturnLeftIcon className="w-4 h-4 mr-1 inline" />
                 Back to current
               </button>
+              <button
+                type="button"
+                className="btn ghost"
+                onClick={() => setShowChanges(v => !v)}
+              >
+                {showChanges ? 'Show version' : 'Show changes'}
+              </button>
               <button
                 type="button"
                 className="btn secondary"
@@ -260,12 +320,25 @@ export function ContentPreview({ config, id }: Props) {
         {/* Content preview */}
         <div className="flex-1 min-w-0">
           <div className={`card ${isViewingVersion ? 'bg-amber-50' : ''}`}>
-            {selectedVersion.isLoading ? (
+            {showingChanges && versionDiff.isError ? (
+              <span className="flash error">Could not load changes.</span>
+            ) : showingChanges && !versionDiff.data ? (
+              <Loading />
+            ) : showingChanges && versionDiff.data ? (
+              <>
+                <h2 className="text-xl font-bold mb-4">
+                  <DiffText segments={versionDiff.data.title} />
+                </h2>
+                <pre className="whitespace-pre-wrap break-words text-sm font-mono">
+                  <DiffText segments={versionDiff.data.body} />
+                </pre>
+              </>
+            ) : selectedVersion.isLoading ? (
               <Loading />
             ) : (
               <>
                 <h2 className="text-xl font-bold mb-4">{previewTitle}</h2>
-                {config.renderBody(previewBody)}
+                {contentFormats[previewFormat].renderBody(previewBody)}
               </>
             )}
           </div>
@@ -292,6 +365,8 @@ export function ContentPreview({ config, id }: Props) {
               </MetadataRow>
             </div>
 
+            <ContentSchedulePanel config={config} namespace={namespace} contentId={data.id} />
+
             {/* Version history */}
             {versions.data && versions.data.length > 1 ? (
               <VersionHistory
@@ -0,0 +1,173 @@
+import { DateTime } from 'luxon'
+import Link from 'next/link'
+import { useState } from 'react'
+import { useQueryClient } from 'react-query'
+import { toast } from 'react-toastify'
+import { ContentConfig } from './types'
+import {
+  ContentSchedule,
+  scheduleContentType,
+  useContentScheduleCancel,
+  useContentScheduleCreate,
+  useContentScheduleList,
+} from './api'
+
+function scheduleLabel(schedule: ContentSchedule) {
+  return schedule.action === 'publish' ? 'Publish' : 'Unpublish'
+}
+
+function formatScheduledAt(schedule: ContentSchedule) {
+  return DateTime.fromISO(schedule.scheduled_at).toLocaleString(DateTime.DATETIME_MED)
+}
+
+// Lists the upcoming schedules of a content type, shown above the content list.
+export function UpcomingSchedules({
+  config,
+  namespace,
+}: {
+  config: ContentConfig
+  namespace: string
+}) {
+  const schedules = useContentScheduleList(namespace, { enabled: !!namespace })
+  const upcoming = (schedules.data ?? []).filter(
+    s => s.content_type === scheduleContentType(config),
+  )
+
+  if (upcoming.length === 0) {
+    return null
+  }
+
+  return (
+    <div className="card mt-4">
+      <span className="text-sm font-semibold text-slate-500 uppercase tracking-wide mb-2 block">
+        Upcoming
+      </span>
+      <ul className="flex flex-col gap-1">
+        {upcoming.map(s => (
+          <li key={s.id} className="text-sm">
+            <span className="text-slate-400 mr-2">{formatScheduledAt(s)}</span>
+            <span className="font-medium mr-2">{scheduleLabel(s)}</span>
+            <Link
+              href={config.routes.preview(namespace, s.content_id)}
+              className="text-indigo-700 hover:underline"
+            >
+              {s.title || s.slug || s.content_id}
+            </Link>
+          </li>
+        ))}
+      </ul>
+    </div>
+  )
+}
+
+// Manages the schedules of a single page or post, shown in the preview.
+export function ContentSchedulePanel({
+  config,
+  namespace,
+  contentId,
+}: {
+  config: ContentConfig
+  namespace: string
+  contentId: string
+}) {
+  const queryClient = useQueryClient()
+  const [action, setAction] = useState<'publish' | 'unpublish'>('publish')
+  const [scheduledAt, setScheduledAt] = useState('')
+
+  const schedules = useContentScheduleList(namespace, { enabled: !!namespace })
+  const pending = (schedules.data ?? []).filter(s => s.content_id === contentId)
+
+  const invalidate = () => queryClient.invalidateQueries(['schedules'])
+
+  const createMutation = useContentScheduleCreate(
+    config,
+    namespace,
+    () => {
+      toast.success('Scheduled successfully', { position: 'bottom-right' })
+      setScheduledAt('')
+      invalidate()
+    },
+    () => {
+      toast.error('Failed to schedule', { position: 'bottom-right' })
+    },
+  )
+
+  const cancelMutation = useContentScheduleCancel(
+    namespace,
+    () => {
+      toast.success('Schedule cancelled', { position: 'bottom-right' })
+      invalidate()
+    },
+    error => {
+      toast.error(
+        error.message === '409'
+          ? 'This schedule already ran'
+          : 'Failed to cancel schedule',
+        { position: 'bottom-right' },
+      )
+      invalidate()
+    },
+  )
+
+  const handleSchedule = () => {
+    const at = DateTime.fromISO(scheduledAt)
+    if (!at.isValid) return
+    createMutation.mutate({
+      contentId,
+      action,
+      scheduledAt: at.toUTC().toISO()!,
+    })
+  }
+
+  return (
+    <div className="mt-4 pt-4 border-t border-slate-100">
+      <span className="text-sm font-semibold text-slate-500 uppercase tracking-wide mb-2 block">
+        Schedule
+      </span>
+      {pending.length > 0 ? (
+        <ul className="flex flex-col gap-1 mb-3">
+          {pending.map(s => (
+            <li key={s.id} className="flex items-center justify-between text-sm">
+              <span>
+                {scheduleLabel(s)}
+                <span className="text-xs text-slate-400 ml-2">{formatScheduledAt(s)}</span>
+              </span>
+              <button
+                type="button"
+                className="text-xs text-slate-500 hover:text-red-700"
+                onClick={() => cancelMutation.mutate(s.id)}
+                disabled={cancelMutation.isLoading}
+              >
+                Cancel
+              </button>
+            </li>
+          ))}
+        </ul>
+      ) : null}
+      <div className="flex flex-col gap-2">
+        <select
+          className="input"
+          value={action}
+          onChange={e => setAction(e.target.value as 'publish' | 'unpublish')}
+        >
+          <option value="publish">Publish</option>
+          <option value="unpublish">Unpublish</option>
+        </select>
+        <input
+          type="datetime-local"
+          className="input"
+          value={scheduledAt}
+          onChange={e => setScheduledAt(e.target.value)}
+        />
+        <button
+          type="button"
+          className="btn secondary"
+          onClick={handleSchedule}
+          disabled={!scheduledAt || createMutation.isLoading}
+        >
+          {createMutation.isLoading ? 'Scheduling...' : 'Schedule'}
+        </button>
+      </div>
+    </div>
+  )
+}
@@ -1,7 +1,7 @@
 import { z } from 'zod'
 import getConfig from 'next/config'
 import { useMutation, useQuery } from 'react-query'
-import { ContentConfig, ContentItem, ContentListResponse } from './types'
+import { ContentConfig, ContentFormat, ContentItem, ContentListResponse } from './types'
 
 const { publicRuntimeConfig } = getConfig()
 const root = `${publicRuntimeConfig.apiEndpoint}/content`
@@ -12,8 +12,11 @@ const ContentItemSchema = z.object({
   namespace: z.string().optional().default(''),
   slug: z.string(),
   title: z.string(),
+  format: z.enum(['html', 'markdown']).optional(),
   content: z.string().optional(),
+  source: z.string().optional(),
   html: z.string().optional(),
+  language: z.string().nullable().optional().default(null),
   published_at: z.string().nullable().optional().default(null),
   created_at: z.string().optional().default(''),
   updated_at: z.string().optional().default(''),
@@ -32,13 +35,15 @@ async function handleResponse(response: Response) {
   return response.json()
 }
 
-function parseItem(data: z.infer<typeof ContentItemSchema>, bodyField: 'content' | 'html'): ContentItem {
+function parseItem(data: z.infer<typeof ContentItemSchema>, config: ContentConfig): ContentItem {
   return {
     id: data.id,
     namespace: data.namespace ?? '',
     slug: data.slug,
     title: data.title,
-    body: (bodyField === 'content' ? data.content : data.html) ?? '',
+    format: data.format ?? config.defaultFormat,
+    body: data[config.bodyField] ?? '',
+    language: data.language ?? null,
     published_at: data.published_at ?? null,
     created_at: data.created_at ?? '',
     updated_at: data.updated_at ?? '',
@@ -68,7 +73,7 @@ export function useContentList(
       const items = z.array(ContentItemSchema).parse(data[config.type])
 
       return {
-        items: items.map(item => parseItem(item, config.bodyField)),
+        items: items.map(item => parseItem(item, config)),
         total_size: list.total_size,
         next_page_token: list.next_page_token,
       }
@@ -92,7 +97,7 @@ export function useContentFind(
       )
       const data = await handleResponse(response)
       const item = ContentItemSchema.parse(data)
-      return parseItem(item, config.bodyField)
+      return parseItem(item, config)
     },
     { ...options, retry: false },
   )
@@ -109,15 +114,21 @@ export function useContentCreate(
       id: string
       slug: string
       title: string
+      format: ContentFormat
       body: string
+      language?: string | null
       published_at?: string | null
     }) => {
       const payload: Record<string, unknown> = {
         id: input.id,
         slug: input.slug,
         title: input.title,
+        format: input.format,
         [config.bodyField]: input.body,
       }
+      if (input.language !== undefined) {
+        payload.language = input.language
+      }
       if (input.published_at !== undefined) {
         payload.published_at = input.published_at
       }
@@ -133,7 +144,7 @@ export function useContentCreate(
       )
       const data = await handleResponse(response)
       const item = ContentItemSchema.parse(data)
-      return parseItem(item, config.bodyField)
+      return parseItem(item, config)
     },
     onSuccess,
     onError,
@@ -151,14 +162,20 @@ export function useContentUpdate(
       id: string
       slug: string
       title: string
+      format: ContentFormat
       body: string
+      language?: string | null
       published_at?: string | null
     }) => {
       const payload: Record<string, unknown> = {
         slug: input.slug,
         title: input.title,
+        format: input.format,
         [config.bodyField]: input.body,
       }
+      if (input.language !== undefined) {
+        payload.language = input.language
+      }
       if (input.published_at !== undefined) {
         payload.published_at = input.published_at
       }
@@ -174,7 +191,7 @@ export function useContentUpdate(
       )
       const data = await handleResponse(response)
       const item = ContentItemSchema.parse(data)
-      return parseItem(item, config.bodyField)
+      return parseItem(item, config)
     },
     onSuccess,
     onError,
@@ -212,9 +229,11 @@ export interface ContentVersion {
   version: number
   title: string
   created_at: string
+  restored_from_id?: string
 }
 
 export interface ContentVersionDetail extends ContentVersion {
+  format: ContentFormat
   body: string
 }
 
@@ -239,6 +258,7 @@ export function useContentVersionList(
             version: z.number(),
             title: z.string(),
             created_at: z.string(),
+            restored_from_id: z.string().optional(),
           }),
         )
         .parse(data.versions)
@@ -267,8 +287,9 @@ export function useContentVersionGet(
           id: z.string(),
           version: z.number(),
           title: z.string(),
+          format: z.enum(['html', 'markdown']).optional(),
           content: z.string().optional(),
-          html: z.string().optional(),
+          source: z.string().optional(),
           created_at: z.string(),
         })
         .parse(data)
@@ -276,7 +297,8 @@ export function useContentVersionGet(
         id: parsed.id,
         version: parsed.version,
         title: parsed.title,
-        body: (config.bodyField === 'content' ? parsed.content : parsed.html) ?? '',
+        format: parsed.format ?? config.defaultFormat,
+        body: parsed[config.bodyField] ?? '',
         created_at: parsed.created_at,
       }
     },
@@ -284,6 +306,73 @@ export function useContentVersionGet(
   )
 }
 
+export function useContentVersionRestore(
+  config: ContentConfig,
+  namespace: string,
+  onSuccess: () => void,
+  onError: (error: Error) => void,
+) {
+  return useMutation({
+    mutationFn: async (input: { id: string; contentId: string }) => {
+      const response = await fetch(
+        `${root}/${config.type}/${namespace}/${input.id}/versions/${input.contentId}/restore`,
+        {
+          method: 'POST',
+          credentials: 'include',
+        },
+      )
+      const data = await handleResponse(response)
+      const item = ContentItemSchema.parse(data)
+      return parseItem(item, config)
+    },
+    onSuccess,
+    onError,
+  })
+}
+
+const DiffSegmentSchema = z.object({
+  op: z.enum(['equal', 'insert', 'delete']),
+  text: z.string(),
+})
+
+export type ContentDiffSegment = z.infer<typeof DiffSegmentSchema>
+
+export interface ContentDiff {
+  title: ContentDiffSegment[]
+  body: ContentDiffSegment[]
+}
+
+export function useContentVersionDiff(
+  config: ContentConfig,
+  namespace: string,
+  id: string,
+  fromId: string | null,
+  toId: string | null,
+  options?: { enabled?: boolean },
+) {
+  return useQuery(
+    [config.type, 'versionDiff', namespace, id, fromId, toId],
+    async (): Promise<ContentDiff> => {
+      const response = await fetch(
+        `${root}/${config.type}/${namespace}/${id}/versions/${fromId}/diff?to=${toId}`,
+        { credentials: 'include' },
+      )
+      const data = await handleResponse(response)
+      return z
+        .object({
+          title: z.array(DiffSegmentSchema),
+          body: z.array(DiffSegmentSchema),
+        })
+        .parse(data)
+    },
+    {
+      ...options,
+      enabled: !!fromId && !!toId && (options?.enabled ?? true),
+      retry: false,
+    },
+  )
+}
+
 // Find by ID: the backend slug endpoint falls back to ID lookup for admins.
 export function useContentFindById(
   config: ContentConfig,
@@ -300,8 +389,95 @@ export function useContentFindById(
       )
       const data = await handleResponse(response)
       const item = ContentItemSchema.parse(data)
-      return parseItem(item, config.bodyField)
+      return parseItem(item, config)
+    },
+    { ...options, retry: false },
+  )
+}
+
+// Scheduled publishing
+const ContentScheduleSchema = z.object({
+  id: z.string(),
+  content_type: z.enum(['page', 'post']),
+  content_id: z.string(),
+  action: z.enum(['publish', 'unpublish']),
+  scheduled_at: z.string(),
+  slug: z.string().optional(),
+  title: z.string().optional(),
+})
+
+export type ContentSchedule = z.infer<typeof ContentScheduleSchema>
+
+export function scheduleContentType(config: ContentConfig) {
+  return config.type === 'posts' ? 'post' : 'page'
+}
+
+export function useContentScheduleList(
+  namespace: string,
+  options?: { enabled?: boolean },
+) {
+  return useQuery(
+    ['schedules', 'upcoming', namespace],
+    async (): Promise<ContentSchedule[]> => {
+      const response = await fetch(`${root}/schedules/${namespace}/upcoming`, {
+        credentials: 'include',
+      })
+      const data = await handleResponse(response)
+      return z.array(ContentScheduleSchema).parse(data.schedules)
     },
     { ...options, retry: false },
   )
 }
+
+export function useContentScheduleCreate(
+  config: ContentConfig,
+  namespace: string,
+  onSuccess: () => void,
+  onError: (error: Error) => void,
+) {
+  return useMutation({
+    mutationFn: async (input: {
+      contentId: string
+      action: 'publish' | 'unpublish'
+      scheduledAt: string
+    }) => {
+      const response = await fetch(`${root}/schedules/${namespace}`, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        credentials: 'include',
+        body: JSON.stringify({
+          content_type: scheduleContentType(config),
+          content_id: input.contentId,
+          action: input.action,
+          scheduled_at: input.scheduledAt,
+        }),
+      })
+      const data = await handleResponse(response)
+      return ContentScheduleSchema.parse(data)
+    },
+    onSuccess,
+    onError,
+  })
+}
+
+export function useContentScheduleCancel(
+  namespace: string,
+  onSuccess: () => void,
+  onError: (error: Error) => void,
+) {
+  return useMutation({
+    mutationFn: async (id: string) => {
+      const response = await fetch(`${root}/schedules/${namespace}/${id}`, {
+        method: 'DELETE',
+        credentials: 'include',
+      })
+      if (response.status === 204) return
+      if (response.status === 403) throw new Error('403')
+      if (response.status === 404) throw new Error('404')
+      if (response.status === 409) throw new Error('409')
+      if (!response.ok) throw new Error(response.status.toString())
+    },
+    onSuccess,
+    onError,
+  })
+}
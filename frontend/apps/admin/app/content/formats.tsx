@@ -0,0 +1,49 @@
+import { ContentFormat, ContentFormatConfig } from './types'
+import { MarkdownPreview } from './MarkdownPreview'
+import { CodeEditor } from './CodeEditor'
+import { html } from '@codemirror/lang-html'
+import { markdown } from '@codemirror/lang-markdown'
+import { languages } from '@codemirror/language-data'
+import prettier from 'prettier/standalone'
+import parserHtml from 'prettier/parser-html'
+import parserMarkdown from 'prettier/parser-markdown'
+import DOMPurify from 'dompurify'
+
+const htmlExtensions = [html()]
+const mdExtensions = [markdown({ codeLanguages: languages })]
+
+// Previews are rendered client-side, content-api renders and sanitizes the
+// stored HTML on save.
+export const contentFormats: Record<ContentFormat, ContentFormatConfig> = {
+  html: {
+    label: 'HTML',
+    formatBody: (body: string) =>
+      prettier.format(body.replace(/\\"/g, '"'), {
+        parser: 'html',
+        plugins: [parserHtml],
+        tabWidth: 2,
+        printWidth: 80,
+      }),
+    renderBody: (body: string) => (
+      <div className="auto-format" dangerouslySetInnerHTML={{ __html: DOMPurify.sanitize(body) }} />
+    ),
+    renderEditor: (props) => (
+      <CodeEditor {...props} extensions={htmlExtensions} />
+    ),
+  },
+  markdown: {
+    label: 'Markdown',
+    formatBody: (body: string) =>
+      prettier.format(body, {
+        parser: 'markdown',
+        plugins: [parserMarkdown],
+        tabWidth: 2,
+        proseWrap: 'always',
+        printWidth: 80,
+      }),
+    renderBody: (body: string) => <MarkdownPreview content={body} />,
+    renderEditor: (props) => (
+      <CodeEditor {...props} extensions={mdExtensions} />
+    ),
+  },
+}
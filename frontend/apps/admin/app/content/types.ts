@@ -1,11 +1,15 @@
 import { ReactNode } from 'react'
 
+export type ContentFormat = 'html' | 'markdown'
+
 export interface ContentItem {
   id: string
   namespace: string
   slug: string
   title: string
+  format: ContentFormat
   body: string
+  language: string | null
   published_at: string | null
   created_at: string
   updated_at: string
@@ -23,17 +27,11 @@ export interface ContentConfig {
   label: string
   labelPlural: string
 
-  // API field that holds the body content ('content' for posts, 'html' for pages)
-  bodyField: 'content' | 'html'
-
-  // How to render the body content in previews
-  renderBody: (body: string) => ReactNode
+  // API field that holds the body source ('content' for posts, 'source' for pages)
+  bodyField: 'content' | 'source'
 
-  // Format the body content (e.g. via Prettier)
-  formatBody: (body: string) => string
-
-  // How to render the body editor
-  renderEditor: (props: { value: string; onChange: (v: string) => void; placeholder?: string }) => ReactNode
+  // Format used for new items and items saved before formats existed
+  defaultFormat: ContentFormat
 
   // Route helpers (all take namespace, preview/edit also take item ID)
   routes: {
@@ -47,3 +45,16 @@ export interface ContentConfig {
   icon: React.ComponentType<{ className?: string }>
   sidebarKey: 'posts' | 'pages'
 }
+
+export interface ContentFormatConfig {
+  label: string
+
+  // How to render the body content in previews
+  renderBody: (body: string) => ReactNode
+
+  // Format the body content (e.g. via Prettier)
+  formatBody: (body: string) => string
+
+  // How to render the body editor
+  renderEditor: (props: { value: string; onChange: (v: string) => void; placeholder?: string }) => ReactNode
+}
@@ -1,6 +1,4 @@
-import ReactMarkdown from 'react-markdown'
-import rehypeSanitize from 'rehype-sanitize'
-import remarkGfm from 'remark-gfm'
+import DOMPurify from 'dompurify'
 import { DateTime } from 'luxon'
 import { Post } from '@app/content/api'
 
@@ -19,5 +17,5 @@ export const PostDetail = ({ post }: Props) => (
 )
 
 export const PostBody = ({ post }: Props) => (
-  <ReactMarkdown remarkPlugins={[remarkGfm]} rehypePlugins={[rehypeSanitize]}>{post.content}</ReactMarkdown>
+  <div dangerouslySetInnerHTML={{ __html: DOMPurify.sanitize(post.html) }} />
 )
@@ -31,7 +31,7 @@ const Post = z.object({
   id: z.string(),
   slug: z.string(),
   title: z.string(),
-  content: z.string(),
+  html: z.string(),
   published_at: z.string().datetime({ offset: true }),
 })
 
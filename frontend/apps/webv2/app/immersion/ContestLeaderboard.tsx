@@ -144,6 +144,19 @@ export const ContestLeaderboard = ({ id, contest, routeForPage }: Props) => {
               <strong>{summary.data.language_count}</strong> language
               {summary.data.language_count != 1 ? 's' : ''} for a total score of{' '}
               <strong>{formatScore(summary.data.total_score)}</strong>.
+              {summary.data.top_tags?.length ? (
+                <>
+                  <h3 className="subtitle text-sm mt-4 mb-2">Top tags</h3>
+                  <ul className="flex flex-wrap gap-2">
+                    {summary.data.top_tags.map(it => (
+                      <li key={it.tag} className="tag text-slate-900 bg-slate-200">
+                        {it.tag}{' '}
+                        <span className="text-slate-500">({it.log_count})</span>
+                      </li>
+                    ))}
+                  </ul>
+                </>
+              ) : null}
             </div>
           ) : null}
 
@@ -246,10 +246,20 @@ export const useContestRegistration = (
     { ...options, retry: false },
   )
 
+export const TagStatistic = z.object({
+  tag: z.string(),
+  log_count: z.number(),
+  user_count: z.number(),
+  total_score: z.number(),
+})
+
+export type TagStatistic = z.infer<typeof TagStatistic>
+
 export const ContestSummary = z.object({
   participant_count: z.number(),
   language_count: z.number(),
   total_score: z.number(),
+  top_tags: z.array(TagStatistic).optional(),
 })
 
 export type ContestSummary = z.infer<typeof ContestSummary>
@@ -9,17 +9,17 @@ import { routes } from '@app/common/routes'
 import Head from 'next/head'
 import { DateTime } from 'luxon'
 
-const getExcerpt = (markdown: string, maxLength: number): string => {
-  const text = markdown
-    .replace(/#+\s/g, '')
-    .replace(/\*\*(.+?)\*\*/g, '$1')
-    .replace(/\*(.+?)\*/g, '$1')
-    .replace(/\[(.+?)\]\(.+?\)/g, '$1')
-    .replace(/!\[.*?\]\(.+?\)/g, '')
-    .replace(/`{1,3}[^`]*`{1,3}/g, '')
-    .replace(/>\s/g, '')
-    .replace(/[-*]\s/g, '')
-    .replace(/\n+/g, ' ')
+const getExcerpt = (html: string, maxLength: number): string => {
+  const text = html
+    .replace(/<(script|style)[^>]*>[\s\S]*?<\/\1>/gi, '')
+    .replace(/<[^>]+>/g, ' ')
+    .replace(/&nbsp;/g, ' ')
+    .replace(/&lt;/g, '<')
+    .replace(/&gt;/g, '>')
+    .replace(/&quot;/g, '"')
+    .replace(/&#39;/g, "'")
+    .replace(/&amp;/g, '&')
+    .replace(/\s+/g, ' ')
     .trim()
 
   if (text.length <= maxLength) return text
@@ -90,7 +90,7 @@ const BlogIndex = () => {
               )}
             </h2>
             <p className="text-slate-700 text-lg leading-relaxed max-w-3xl">
-              {getExcerpt(heroPost.content, 500)}
+              {getExcerpt(heroPost.html, 500)}
             </p>
             <div className="mt-5">
               <Link
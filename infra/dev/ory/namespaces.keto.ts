@@ -24,3 +24,23 @@ class app implements Namespace {
     is_banned: (ctx: Context) => this.related.banned.includes(ctx.subject),
   }
 }
+
+// contest namespace - access to private contests and delegated moderation,
+// objects are contest IDs. Owners and admins are checked by immersion-api itself.
+class contest implements Namespace {
+  related: {
+    // Users invited by the owner or through an invite code
+    invitees: User[]
+    // Co-organizers added by the owner
+    organizers: User[]
+  }
+
+  permits = {
+    // Check if user may register for the contest
+    register: (ctx: Context) =>
+      this.related.invitees.includes(ctx.subject) ||
+      this.related.organizers.includes(ctx.subject),
+    // Check if user may moderate logs, participants and scoring rule drafts
+    moderate: (ctx: Context) => this.related.organizers.includes(ctx.subject),
+  }
+}
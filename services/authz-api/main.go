@@ -45,8 +45,11 @@ type Config struct {
 
 const (
 	// Keep these hardcoded until the permission system stabilizes.
-	publicPermissionAllowlistCSV     = "" // start with nothing allowlisted
-	relationshipMutationAllowlistCSV = "" // start with nothing allowlisted
+	publicPermissionAllowlistCSV = "" // start with nothing allowlisted
+
+	// immersion-api removes role tuples when erasing a user and manages who
+	// is invited to or co-organizes a contest
+	relationshipMutationAllowlistCSV = "immersion-api:app:admins,immersion-api:app:banned,immersion-api:contest:invitees,immersion-api:contest:organizers"
 )
 
 func main() {
@@ -28,16 +28,32 @@ type PermissionCheckResponse struct {
 	Allowed bool `json:"allowed"`
 }
 
+type RelationshipWriteRequest struct {
+	Namespace string `json:"namespace"`
+	Object    string `json:"object"`
+	Relation  string `json:"relation"`
+
+	SubjectID  *string     `json:"subject_id,omitempty"`
+	SubjectSet *SubjectSet `json:"subject_set,omitempty"`
+}
+
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
 }
 
 func NewClient(baseURL string) *Client {
+	return NewClientWithTransport(baseURL, nil)
+}
+
+// NewClientWithTransport creates a client which sends requests through the
+// given transport, e.g. an s2s.AuthTransport for authenticated calls.
+func NewClientWithTransport(baseURL string, transport http.RoundTripper) *Client {
 	return &Client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: transport,
 		},
 	}
 }
@@ -71,3 +87,40 @@ func (c *Client) CheckPermission(ctx context.Context, req PermissionCheckRequest
 
 	return out.Allowed, nil
 }
+
+// CreateRelationship adds a relation tuple. The calling service must be
+// allowlisted for the namespace and relation in authz-api.
+func (c *Client) CreateRelationship(ctx context.Context, req RelationshipWriteRequest) error {
+	return c.writeRelationship(ctx, http.MethodPost, req)
+}
+
+// DeleteRelationship removes a relation tuple. The calling service must be
+// allowlisted for the namespace and relation in authz-api.
+func (c *Client) DeleteRelationship(ctx context.Context, req RelationshipWriteRequest) error {
+	return c.writeRelationship(ctx, http.MethodDelete, req)
+}
+
+func (c *Client) writeRelationship(ctx context.Context, method string, req RelationshipWriteRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/internal/v1/relationships", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
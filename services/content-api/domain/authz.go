@@ -3,7 +3,9 @@ package domain
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/tadoku/tadoku/services/common/authz/roles"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
 )
 
 func requireAdmin(ctx context.Context) error {
@@ -13,3 +15,16 @@ func requireAdmin(ctx context.Context) error {
 func isAdmin(ctx context.Context) bool {
 	return roles.IsAdmin(ctx)
 }
+
+// sessionUserID returns the ID of the signed in user.
+func sessionUserID(ctx context.Context) (uuid.UUID, error) {
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return uuid.Nil, ErrUnauthorized
+	}
+	userID, err := uuid.Parse(session.Subject)
+	if err != nil {
+		return uuid.Nil, ErrUnauthorized
+	}
+	return userID, nil
+}
@@ -0,0 +1,318 @@
+package domain
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+// DiffSegment is a run of text that is unchanged, inserted or deleted between
+// two versions.
+type DiffSegment struct {
+	Op   DiffOp
+	Text string
+}
+
+// ContentDiff describes the changes between two versions of a page or post.
+type ContentDiff struct {
+	FromID uuid.UUID
+	ToID   uuid.UUID
+	Title  []DiffSegment
+	Body   []DiffSegment
+	// BodyHTML renders the body diff as escaped source with <ins> and <del>
+	// markers, so it can be shown side by side with the versions.
+	BodyHTML string
+}
+
+// maxDiffEdits bounds the work spent on a diff. Versions that differ more are
+// shown as fully replaced.
+const maxDiffEdits = 2000
+
+func newContentDiff(fromID, toID uuid.UUID, fromTitle, toTitle, fromBody, toBody string) *ContentDiff {
+	body := diffText(fromBody, toBody)
+	return &ContentDiff{
+		FromID:   fromID,
+		ToID:     toID,
+		Title:    diffText(fromTitle, toTitle),
+		Body:     body,
+		BodyHTML: renderDiffHTML(body),
+	}
+}
+
+// diffText compares two texts word by word, HTML tags and whitespace count as
+// separate tokens so markup changes don't swallow the surrounding text.
+func diffText(from, to string) []DiffSegment {
+	a := tokenizeDiffText(from)
+	b := tokenizeDiffText(to)
+
+	ops, ok := myersDiff(a, b)
+	if !ok {
+		segments := appendDiffSegment([]DiffSegment{}, DiffOpDelete, from)
+		return appendDiffSegment(segments, DiffOpInsert, to)
+	}
+
+	// Tokens are joined per run, appending them to the segment one by one
+	// copies the text for every token.
+	segments := []DiffSegment{}
+	var run strings.Builder
+	var runOp DiffOp
+	i, j := 0, 0
+	for _, op := range ops {
+		if op != runOp {
+			segments = appendDiffSegment(segments, runOp, run.String())
+			run.Reset()
+			runOp = op
+		}
+		switch op {
+		case DiffOpEqual:
+			run.WriteString(a[i])
+			i++
+			j++
+		case DiffOpDelete:
+			run.WriteString(a[i])
+			i++
+		case DiffOpInsert:
+			run.WriteString(b[j])
+			j++
+		}
+	}
+	return appendDiffSegment(segments, runOp, run.String())
+}
+
+func appendDiffSegment(segments []DiffSegment, op DiffOp, text string) []DiffSegment {
+	if text == "" {
+		return segments
+	}
+	if n := len(segments); n > 0 && segments[n-1].Op == op {
+		segments[n-1].Text += text
+		return segments
+	}
+	return append(segments, DiffSegment{Op: op, Text: text})
+}
+
+func tokenizeDiffText(s string) []string {
+	var tokens []string
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		end := size
+		switch {
+		case r == '<':
+			if i := strings.IndexByte(s, '>'); i > 0 {
+				end = i + 1
+			}
+		case unicode.IsSpace(r):
+			end = indexDiffTokenEnd(s, func(r rune) bool { return !unicode.IsSpace(r) })
+		case isUnspacedRune(r):
+			// Scripts like Japanese don't separate words, compare per character.
+		default:
+			end = indexDiffTokenEnd(s, func(r rune) bool { return r == '<' || unicode.IsSpace(r) || isUnspacedRune(r) })
+		}
+		tokens = append(tokens, s[:end])
+		s = s[end:]
+	}
+	return tokens
+}
+
+// indexDiffTokenEnd finds the end of the token starting at s, the first rune
+// always belongs to the token.
+func indexDiffTokenEnd(s string, isEnd func(rune) bool) int {
+	_, size := utf8.DecodeRuneInString(s)
+	if i := strings.IndexFunc(s[size:], isEnd); i >= 0 {
+		return size + i
+	}
+	return len(s)
+}
+
+func isUnspacedRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		unicode.IsPunct(r) && r > unicode.MaxLatin1
+}
+
+// myersDiff returns the shortest edit script turning a into b, or false when
+// it needs more than maxDiffEdits edits. It uses the linear space variant of
+// the algorithm, which splits the inputs at the middle snake of the edit path
+// instead of keeping every step of the search.
+func myersDiff(a, b []string) ([]DiffOp, bool) {
+	limit := len(a) + len(b)
+	if limit > maxDiffEdits {
+		limit = maxDiffEdits
+	}
+	maxD := (limit + 1) / 2
+	d := &myersDiffer{
+		a:      a,
+		b:      b,
+		ops:    make([]DiffOp, 0, len(a)+len(b)),
+		offset: maxD + 1,
+		maxD:   maxD,
+		vf:     make([]int, 2*maxD+3),
+		vb:     make([]int, 2*maxD+3),
+	}
+	if !d.compare(0, len(a), 0, len(b)) {
+		return nil, false
+	}
+	deletionsFirst(d.ops)
+	return d.ops, true
+}
+
+// deletionsFirst orders every run of changes between unchanged tokens as
+// deletions followed by insertions, which reads as a replacement.
+func deletionsFirst(ops []DiffOp) {
+	for start := 0; start < len(ops); {
+		if ops[start] == DiffOpEqual {
+			start++
+			continue
+		}
+		end, deletions := start, 0
+		for ; end < len(ops) && ops[end] != DiffOpEqual; end++ {
+			if ops[end] == DiffOpDelete {
+				deletions++
+			}
+		}
+		for i := start; i < end; i++ {
+			if i < start+deletions {
+				ops[i] = DiffOpDelete
+			} else {
+				ops[i] = DiffOpInsert
+			}
+		}
+		start = end
+	}
+}
+
+type myersDiffer struct {
+	a, b []string
+	ops  []DiffOp
+	// vf and vb hold the furthest reaching forward and reverse paths per
+	// diagonal, they are reused by every step of the recursion.
+	vf, vb []int
+	offset int
+	maxD   int
+}
+
+// compare appends the edit script turning a[aLo:aHi] into b[bLo:bHi].
+func (d *myersDiffer) compare(aLo, aHi, bLo, bHi int) bool {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		d.ops = append(d.ops, DiffOpEqual)
+		aLo++
+		bLo++
+	}
+	suffix := 0
+	for aLo < aHi && bLo < bHi && d.a[aHi-1] == d.b[bHi-1] {
+		aHi--
+		bHi--
+		suffix++
+	}
+
+	switch {
+	case aLo == aHi:
+		for ; bLo < bHi; bLo++ {
+			d.ops = append(d.ops, DiffOpInsert)
+		}
+	case bLo == bHi:
+		for ; aLo < aHi; aLo++ {
+			d.ops = append(d.ops, DiffOpDelete)
+		}
+	default:
+		x, y, u, v, ok := d.middleSnake(aLo, aHi, bLo, bHi)
+		if !ok {
+			return false
+		}
+		if !d.compare(aLo, x, bLo, y) {
+			return false
+		}
+		for ; x < u; x++ {
+			d.ops = append(d.ops, DiffOpEqual)
+		}
+		if !d.compare(u, aHi, v, bHi) {
+			return false
+		}
+	}
+
+	for ; suffix > 0; suffix-- {
+		d.ops = append(d.ops, DiffOpEqual)
+	}
+	return true
+}
+
+// middleSnake searches the shortest edit path from both ends at once and
+// returns the snake where they meet, from (x, y) to (u, v).
+func (d *myersDiffer) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	o := d.offset
+	d.vf[o+1] = 0
+	d.vb[o+1] = 0
+
+	maxD := (n + m + 1) / 2
+	if maxD > d.maxD {
+		maxD = d.maxD
+	}
+	for step := 0; step <= maxD; step++ {
+		for k := -step; k <= step; k += 2 {
+			var x int
+			if k == -step || (k != step && d.vf[o+k-1] < d.vf[o+k+1]) {
+				x = d.vf[o+k+1]
+			} else {
+				x = d.vf[o+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.a[aLo+x] == d.b[bLo+y] {
+				x++
+				y++
+			}
+			d.vf[o+k] = x
+			if c := delta - k; odd && c >= -(step-1) && c <= step-1 && x+d.vb[o+c] >= n {
+				return aLo + startX, bLo + startY, aLo + x, bLo + y, true
+			}
+		}
+
+		for c := -step; c <= step; c += 2 {
+			var x int
+			if c == -step || (c != step && d.vb[o+c-1] < d.vb[o+c+1]) {
+				x = d.vb[o+c+1]
+			} else {
+				x = d.vb[o+c-1] + 1
+			}
+			y := x - c
+			startX, startY := x, y
+			for x < n && y < m && d.a[aHi-x-1] == d.b[bHi-y-1] {
+				x++
+				y++
+			}
+			d.vb[o+c] = x
+			if k := delta - c; !odd && k >= -step && k <= step && x+d.vf[o+k] >= n {
+				return aHi - x, bHi - y, aHi - startX, bHi - startY, true
+			}
+		}
+	}
+	return 0, 0, 0, 0, false
+}
+
+func renderDiffHTML(segments []DiffSegment) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		text := html.EscapeString(segment.Text)
+		switch segment.Op {
+		case DiffOpInsert:
+			sb.WriteString("<ins>" + text + "</ins>")
+		case DiffOpDelete:
+			sb.WriteString("<del>" + text + "</del>")
+		default:
+			sb.WriteString(text)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ContentEventListRepository interface {
+	ListContentEvents(ctx context.Context, namespace string, afterID int64, limit int) ([]ContentEvent, error)
+}
+
+type ContentEventListRequest struct {
+	Namespace string `validate:"required"`
+	// AfterID is the ID of the last processed event, 0 starts at the beginning.
+	AfterID  int64 `validate:"gte=0"`
+	PageSize int
+}
+
+type ContentEventListResponse struct {
+	Events []ContentEvent
+}
+
+type ContentEventList struct {
+	repo     ContentEventListRepository
+	validate *validator.Validate
+}
+
+func NewContentEventList(repo ContentEventListRepository) *ContentEventList {
+	return &ContentEventList{
+		repo:     repo,
+		validate: validator.New(),
+	}
+}
+
+func (s *ContentEventList) Execute(ctx context.Context, req *ContentEventListRequest) (*ContentEventListResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestInvalid, err)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	events, err := s.repo.ListContentEvents(ctx, req.Namespace, req.AfterID, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContentEventListResponse{Events: events}, nil
+}
@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentEventListRepo struct {
+	listContentEventsFn func(ctx context.Context, namespace string, afterID int64, limit int) ([]contentdomain.ContentEvent, error)
+}
+
+func (m *mockContentEventListRepo) ListContentEvents(ctx context.Context, namespace string, afterID int64, limit int) ([]contentdomain.ContentEvent, error) {
+	if m.listContentEventsFn != nil {
+		return m.listContentEventsFn(ctx, namespace, afterID, limit)
+	}
+	return nil, nil
+}
+
+func TestContentEventList_Execute(t *testing.T) {
+	t.Run("lists events after cursor", func(t *testing.T) {
+		var gotAfterID int64
+		repo := &mockContentEventListRepo{
+			listContentEventsFn: func(ctx context.Context, namespace string, afterID int64, limit int) ([]contentdomain.ContentEvent, error) {
+				gotAfterID = afterID
+				return []contentdomain.ContentEvent{{ID: 43, Type: contentdomain.ContentEventPublished}}, nil
+			},
+		}
+		svc := contentdomain.NewContentEventList(repo)
+
+		resp, err := svc.Execute(adminContext(), &contentdomain.ContentEventListRequest{Namespace: "blog", AfterID: 42})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), gotAfterID)
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, int64(43), resp.Events[0].ID)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewContentEventList(&mockContentEventListRepo{})
+
+		_, err := svc.Execute(userContext(), &contentdomain.ContentEventListRequest{Namespace: "blog"})
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns error on negative cursor", func(t *testing.T) {
+		svc := contentdomain.NewContentEventList(&mockContentEventListRepo{})
+
+		_, err := svc.Execute(adminContext(), &contentdomain.ContentEventListRequest{Namespace: "blog", AfterID: -1})
+
+		assert.ErrorIs(t, err, contentdomain.ErrRequestInvalid)
+	})
+}
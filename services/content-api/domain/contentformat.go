@@ -0,0 +1,23 @@
+package domain
+
+// ContentFormat is the markup a page or post is authored in.
+type ContentFormat string
+
+const (
+	ContentFormatHTML     ContentFormat = "html"
+	ContentFormatMarkdown ContentFormat = "markdown"
+)
+
+// ContentRenderVersion identifies the rendering rules in use, e.g. the
+// sanitizer allowlist or how the search text is extracted. Bump it when they
+// change so stored content is rendered again on startup.
+const ContentRenderVersion = 2
+
+// ContentRenderer turns the source of a page or post into sanitized HTML.
+type ContentRenderer interface {
+	Render(format ContentFormat, source string) (string, error)
+}
+
+func validContentFormat(format ContentFormat) bool {
+	return format == ContentFormatHTML || format == ContentFormatMarkdown
+}
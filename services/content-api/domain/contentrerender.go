@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ContentRevision is a version of a page or post whose HTML was rendered with
+// rules older than ContentRenderVersion.
+type ContentRevision struct {
+	ContentType ContentType
+	ID          uuid.UUID
+	Format      ContentFormat
+	Source      string
+}
+
+type ContentRerenderRepository interface {
+	ListStaleContentRevisions(ctx context.Context, renderVersion int, limit int) ([]ContentRevision, error)
+	UpdateContentRendering(ctx context.Context, revision *ContentRevision, html string, renderVersion int) error
+}
+
+// ContentRerender renders all stored versions of pages and posts again when
+// the rendering rules changed. Content written before sanitization was
+// introduced is sanitized this way as well.
+type ContentRerender struct {
+	repo      ContentRerenderRepository
+	renderer  ContentRenderer
+	batchSize int
+}
+
+func NewContentRerender(repo ContentRerenderRepository, renderer ContentRenderer) *ContentRerender {
+	return &ContentRerender{
+		repo:      repo,
+		renderer:  renderer,
+		batchSize: 100,
+	}
+}
+
+// Execute returns the number of versions that were rendered again. It is run
+// on startup, before any content is served.
+func (s *ContentRerender) Execute(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		revisions, err := s.repo.ListStaleContentRevisions(ctx, ContentRenderVersion, s.batchSize)
+		if err != nil {
+			return count, err
+		}
+		if len(revisions) == 0 {
+			return count, nil
+		}
+
+		for i := range revisions {
+			revision := &revisions[i]
+			html, err := s.renderer.Render(revision.Format, revision.Source)
+			if err != nil {
+				return count, fmt.Errorf("could not render %s version %s: %w", revision.ContentType, revision.ID, err)
+			}
+			if err := s.repo.UpdateContentRendering(ctx, revision, html, ContentRenderVersion); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentRerenderRepo struct {
+	listStaleContentRevisionsFn func(ctx context.Context, renderVersion int, limit int) ([]contentdomain.ContentRevision, error)
+	updateContentRenderingFn    func(ctx context.Context, revision *contentdomain.ContentRevision, html string, renderVersion int) error
+}
+
+func (m *mockContentRerenderRepo) ListStaleContentRevisions(ctx context.Context, renderVersion int, limit int) ([]contentdomain.ContentRevision, error) {
+	if m.listStaleContentRevisionsFn != nil {
+		return m.listStaleContentRevisionsFn(ctx, renderVersion, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockContentRerenderRepo) UpdateContentRendering(ctx context.Context, revision *contentdomain.ContentRevision, html string, renderVersion int) error {
+	if m.updateContentRenderingFn != nil {
+		return m.updateContentRenderingFn(ctx, revision, html, renderVersion)
+	}
+	return nil
+}
+
+func TestContentRerender_Execute(t *testing.T) {
+	t.Run("renders stale revisions until none are left", func(t *testing.T) {
+		stale := []contentdomain.ContentRevision{
+			{ContentType: contentdomain.ContentTypePage, ID: uuid.New(), Format: contentdomain.ContentFormatHTML, Source: "<p>Legacy</p>"},
+			{ContentType: contentdomain.ContentTypePost, ID: uuid.New(), Format: contentdomain.ContentFormatMarkdown, Source: "# Post"},
+		}
+		rendered := map[uuid.UUID]string{}
+		repo := &mockContentRerenderRepo{
+			listStaleContentRevisionsFn: func(ctx context.Context, renderVersion int, limit int) ([]contentdomain.ContentRevision, error) {
+				assert.Equal(t, contentdomain.ContentRenderVersion, renderVersion)
+				var res []contentdomain.ContentRevision
+				for _, r := range stale {
+					if _, ok := rendered[r.ID]; !ok {
+						res = append(res, r)
+					}
+				}
+				return res, nil
+			},
+			updateContentRenderingFn: func(ctx context.Context, revision *contentdomain.ContentRevision, html string, renderVersion int) error {
+				assert.Equal(t, contentdomain.ContentRenderVersion, renderVersion)
+				rendered[revision.ID] = html
+				return nil
+			},
+		}
+		renderer := &mockRenderer{
+			renderFn: func(format contentdomain.ContentFormat, source string) (string, error) {
+				return string(format) + ":" + source, nil
+			},
+		}
+		svc := contentdomain.NewContentRerender(repo, renderer)
+
+		count, err := svc.Execute(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, "html:<p>Legacy</p>", rendered[stale[0].ID])
+		assert.Equal(t, "markdown:# Post", rendered[stale[1].ID])
+	})
+
+	t.Run("stops on render failure", func(t *testing.T) {
+		repo := &mockContentRerenderRepo{
+			listStaleContentRevisionsFn: func(ctx context.Context, renderVersion int, limit int) ([]contentdomain.ContentRevision, error) {
+				return []contentdomain.ContentRevision{{ContentType: contentdomain.ContentTypePage, ID: uuid.New()}}, nil
+			},
+			updateContentRenderingFn: func(ctx context.Context, revision *contentdomain.ContentRevision, html string, renderVersion int) error {
+				t.Fatal("should not store a failed rendering")
+				return nil
+			},
+		}
+		renderFailed := errors.New("render failed")
+		renderer := &mockRenderer{
+			renderFn: func(format contentdomain.ContentFormat, source string) (string, error) {
+				return "", renderFailed
+			},
+		}
+		svc := contentdomain.NewContentRerender(repo, renderer)
+
+		count, err := svc.Execute(context.Background())
+
+		assert.ErrorIs(t, err, renderFailed)
+		assert.Equal(t, 0, count)
+	})
+}
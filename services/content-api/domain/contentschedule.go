@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ContentType string
+
+const (
+	ContentTypePage ContentType = "page"
+	ContentTypePost ContentType = "post"
+)
+
+type ScheduleAction string
+
+const (
+	ScheduleActionPublish   ScheduleAction = "publish"
+	ScheduleActionUnpublish ScheduleAction = "unpublish"
+)
+
+// ContentSchedule publishes or unpublishes a page or post at a given time.
+type ContentSchedule struct {
+	ID              uuid.UUID
+	Namespace       string
+	ContentType     ContentType
+	ContentID       uuid.UUID
+	Action          ScheduleAction
+	ScheduledAt     time.Time
+	CreatedByUserID uuid.UUID
+	CreatedAt       time.Time
+	// Slug and Title describe the scheduled content, they are only set when
+	// listing upcoming schedules.
+	Slug  string
+	Title string
+}
+
+type ContentEventType string
+
+const (
+	ContentEventPublished   ContentEventType = "published"
+	ContentEventUnpublished ContentEventType = "unpublished"
+)
+
+// ContentEvent records a state change of a page or post. Events are kept in
+// order of ID so consumers, e.g. cache purges or webhooks, can resume after the
+// last event they processed.
+type ContentEvent struct {
+	ID          int64
+	Namespace   string
+	Type        ContentEventType
+	ContentType ContentType
+	ContentID   uuid.UUID
+	ScheduleID  *uuid.UUID
+	OccurredAt  time.Time
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ContentScheduleCancelRepository interface {
+	// CancelContentSchedule returns ErrScheduleNotFound when the schedule does
+	// not exist and ErrConflict when it already ran or was cancelled.
+	CancelContentSchedule(ctx context.Context, id uuid.UUID, namespace string) error
+}
+
+type ContentScheduleCancel struct {
+	repo ContentScheduleCancelRepository
+}
+
+func NewContentScheduleCancel(repo ContentScheduleCancelRepository) *ContentScheduleCancel {
+	return &ContentScheduleCancel{
+		repo: repo,
+	}
+}
+
+func (s *ContentScheduleCancel) Execute(ctx context.Context, id uuid.UUID, namespace string) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	return s.repo.CancelContentSchedule(ctx, id, namespace)
+}
@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentScheduleCancelRepo struct {
+	cancelContentScheduleFn func(ctx context.Context, id uuid.UUID, namespace string) error
+}
+
+func (m *mockContentScheduleCancelRepo) CancelContentSchedule(ctx context.Context, id uuid.UUID, namespace string) error {
+	if m.cancelContentScheduleFn != nil {
+		return m.cancelContentScheduleFn(ctx, id, namespace)
+	}
+	return nil
+}
+
+func TestContentScheduleCancel_Execute(t *testing.T) {
+	t.Run("cancels schedule successfully", func(t *testing.T) {
+		id := uuid.New()
+		var cancelledID uuid.UUID
+		repo := &mockContentScheduleCancelRepo{
+			cancelContentScheduleFn: func(ctx context.Context, gotID uuid.UUID, namespace string) error {
+				cancelledID = gotID
+				assert.Equal(t, "blog", namespace)
+				return nil
+			},
+		}
+		svc := contentdomain.NewContentScheduleCancel(repo)
+
+		err := svc.Execute(adminContext(), id, "blog")
+
+		require.NoError(t, err)
+		assert.Equal(t, id, cancelledID)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleCancel(&mockContentScheduleCancelRepo{})
+
+		err := svc.Execute(userContext(), uuid.New(), "blog")
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns conflict when schedule is no longer pending", func(t *testing.T) {
+		repo := &mockContentScheduleCancelRepo{
+			cancelContentScheduleFn: func(ctx context.Context, id uuid.UUID, namespace string) error {
+				return contentdomain.ErrConflict
+			},
+		}
+		svc := contentdomain.NewContentScheduleCancel(repo)
+
+		err := svc.Execute(adminContext(), uuid.New(), "blog")
+
+		assert.ErrorIs(t, err, contentdomain.ErrConflict)
+	})
+}
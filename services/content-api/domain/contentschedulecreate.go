@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContentScheduleCreateRepository interface {
+	// CreateContentSchedule returns ErrPageNotFound or ErrPostNotFound when the
+	// scheduled content does not exist.
+	CreateContentSchedule(ctx context.Context, schedule *ContentSchedule) error
+}
+
+type ContentScheduleCreateRequest struct {
+	ID          uuid.UUID `validate:"required"`
+	Namespace   string    `validate:"required"`
+	ContentType string    `validate:"required,oneof=page post"`
+	ContentID   uuid.UUID `validate:"required"`
+	Action      string    `validate:"required,oneof=publish unpublish"`
+	ScheduledAt time.Time `validate:"required"`
+}
+
+type ContentScheduleCreateResponse struct {
+	Schedule *ContentSchedule
+}
+
+type ContentScheduleCreate struct {
+	repo     ContentScheduleCreateRepository
+	validate *validator.Validate
+	clock    commondomain.Clock
+}
+
+func NewContentScheduleCreate(repo ContentScheduleCreateRepository, clock commondomain.Clock) *ContentScheduleCreate {
+	return &ContentScheduleCreate{
+		repo:     repo,
+		validate: validator.New(),
+		clock:    clock,
+	}
+}
+
+func (s *ContentScheduleCreate) Execute(ctx context.Context, req *ContentScheduleCreateRequest) (*ContentScheduleCreateResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := sessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+
+	now := s.clock.Now()
+	if !req.ScheduledAt.After(now) {
+		return nil, fmt.Errorf("%w: scheduled_at must be in the future", ErrInvalidSchedule)
+	}
+
+	schedule := &ContentSchedule{
+		ID:              req.ID,
+		Namespace:       req.Namespace,
+		ContentType:     ContentType(req.ContentType),
+		ContentID:       req.ContentID,
+		Action:          ScheduleAction(req.Action),
+		ScheduledAt:     req.ScheduledAt,
+		CreatedByUserID: userID,
+		CreatedAt:       now,
+	}
+
+	if err := s.repo.CreateContentSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return &ContentScheduleCreateResponse{Schedule: schedule}, nil
+}
@@ -0,0 +1,131 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/common/testutil/authzctx"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentScheduleCreateRepo struct {
+	createContentScheduleFn func(ctx context.Context, schedule *contentdomain.ContentSchedule) error
+}
+
+func (m *mockContentScheduleCreateRepo) CreateContentSchedule(ctx context.Context, schedule *contentdomain.ContentSchedule) error {
+	if m.createContentScheduleFn != nil {
+		return m.createContentScheduleFn(ctx, schedule)
+	}
+	return nil
+}
+
+func TestContentScheduleCreate_Execute(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	adminID := uuid.New()
+	adminCtx := authzctx.AdminSubject(adminID.String())
+
+	validRequest := func() *contentdomain.ContentScheduleCreateRequest {
+		return &contentdomain.ContentScheduleCreateRequest{
+			ID:          uuid.New(),
+			Namespace:   "blog",
+			ContentType: "post",
+			ContentID:   uuid.New(),
+			Action:      "publish",
+			ScheduledAt: now.Add(24 * time.Hour),
+		}
+	}
+
+	t.Run("creates schedule successfully", func(t *testing.T) {
+		var saved *contentdomain.ContentSchedule
+		repo := &mockContentScheduleCreateRepo{
+			createContentScheduleFn: func(ctx context.Context, schedule *contentdomain.ContentSchedule) error {
+				saved = schedule
+				return nil
+			},
+		}
+		svc := contentdomain.NewContentScheduleCreate(repo, clock)
+		req := validRequest()
+
+		resp, err := svc.Execute(adminCtx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, req.ID, resp.Schedule.ID)
+		assert.Equal(t, contentdomain.ContentTypePost, saved.ContentType)
+		assert.Equal(t, contentdomain.ScheduleActionPublish, saved.Action)
+		assert.Equal(t, req.ScheduledAt, saved.ScheduledAt)
+		assert.Equal(t, adminID, saved.CreatedByUserID)
+		assert.Equal(t, now, saved.CreatedAt)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleCreate(&mockContentScheduleCreateRepo{}, clock)
+
+		_, err := svc.Execute(userContext(), validRequest())
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns error on unknown content type", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleCreate(&mockContentScheduleCreateRepo{}, clock)
+		req := validRequest()
+		req.ContentType = "announcement"
+
+		_, err := svc.Execute(adminCtx, req)
+
+		assert.ErrorIs(t, err, contentdomain.ErrInvalidSchedule)
+	})
+
+	t.Run("returns error on unknown action", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleCreate(&mockContentScheduleCreateRepo{}, clock)
+		req := validRequest()
+		req.Action = "archive"
+
+		_, err := svc.Execute(adminCtx, req)
+
+		assert.ErrorIs(t, err, contentdomain.ErrInvalidSchedule)
+	})
+
+	t.Run("returns error when scheduled in the past", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleCreate(&mockContentScheduleCreateRepo{}, clock)
+		req := validRequest()
+		req.ScheduledAt = now.Add(-time.Minute)
+
+		_, err := svc.Execute(adminCtx, req)
+
+		assert.ErrorIs(t, err, contentdomain.ErrInvalidSchedule)
+	})
+
+	t.Run("returns not found when content does not exist", func(t *testing.T) {
+		repo := &mockContentScheduleCreateRepo{
+			createContentScheduleFn: func(ctx context.Context, schedule *contentdomain.ContentSchedule) error {
+				return contentdomain.ErrPostNotFound
+			},
+		}
+		svc := contentdomain.NewContentScheduleCreate(repo, clock)
+
+		_, err := svc.Execute(adminCtx, validRequest())
+
+		assert.ErrorIs(t, err, contentdomain.ErrPostNotFound)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		repo := &mockContentScheduleCreateRepo{
+			createContentScheduleFn: func(ctx context.Context, schedule *contentdomain.ContentSchedule) error {
+				return repoErr
+			},
+		}
+		svc := contentdomain.NewContentScheduleCreate(repo, clock)
+
+		_, err := svc.Execute(adminCtx, validRequest())
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ContentScheduleListUpcomingRepository interface {
+	ListUpcomingContentSchedules(ctx context.Context, namespace string, limit int) ([]ContentSchedule, error)
+}
+
+type ContentScheduleListUpcomingRequest struct {
+	Namespace string `validate:"required"`
+	PageSize  int
+}
+
+type ContentScheduleListUpcomingResponse struct {
+	Schedules []ContentSchedule
+}
+
+type ContentScheduleListUpcoming struct {
+	repo     ContentScheduleListUpcomingRepository
+	validate *validator.Validate
+}
+
+func NewContentScheduleListUpcoming(repo ContentScheduleListUpcomingRepository) *ContentScheduleListUpcoming {
+	return &ContentScheduleListUpcoming{
+		repo:     repo,
+		validate: validator.New(),
+	}
+}
+
+// Execute lists the pending schedules of a namespace, the next one first.
+func (s *ContentScheduleListUpcoming) Execute(ctx context.Context, req *ContentScheduleListUpcomingRequest) (*ContentScheduleListUpcomingResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestInvalid, err)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	schedules, err := s.repo.ListUpcomingContentSchedules(ctx, req.Namespace, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContentScheduleListUpcomingResponse{Schedules: schedules}, nil
+}
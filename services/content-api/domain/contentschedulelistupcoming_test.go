@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentScheduleListUpcomingRepo struct {
+	listUpcomingContentSchedulesFn func(ctx context.Context, namespace string, limit int) ([]contentdomain.ContentSchedule, error)
+}
+
+func (m *mockContentScheduleListUpcomingRepo) ListUpcomingContentSchedules(ctx context.Context, namespace string, limit int) ([]contentdomain.ContentSchedule, error) {
+	if m.listUpcomingContentSchedulesFn != nil {
+		return m.listUpcomingContentSchedulesFn(ctx, namespace, limit)
+	}
+	return nil, nil
+}
+
+func TestContentScheduleListUpcoming_Execute(t *testing.T) {
+	t.Run("lists upcoming schedules with default page size", func(t *testing.T) {
+		var gotLimit int
+		repo := &mockContentScheduleListUpcomingRepo{
+			listUpcomingContentSchedulesFn: func(ctx context.Context, namespace string, limit int) ([]contentdomain.ContentSchedule, error) {
+				gotLimit = limit
+				return []contentdomain.ContentSchedule{{Title: "Hello"}}, nil
+			},
+		}
+		svc := contentdomain.NewContentScheduleListUpcoming(repo)
+
+		resp, err := svc.Execute(adminContext(), &contentdomain.ContentScheduleListUpcomingRequest{Namespace: "blog"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 50, gotLimit)
+		require.Len(t, resp.Schedules, 1)
+		assert.Equal(t, "Hello", resp.Schedules[0].Title)
+	})
+
+	t.Run("caps page size", func(t *testing.T) {
+		var gotLimit int
+		repo := &mockContentScheduleListUpcomingRepo{
+			listUpcomingContentSchedulesFn: func(ctx context.Context, namespace string, limit int) ([]contentdomain.ContentSchedule, error) {
+				gotLimit = limit
+				return nil, nil
+			},
+		}
+		svc := contentdomain.NewContentScheduleListUpcoming(repo)
+
+		_, err := svc.Execute(adminContext(), &contentdomain.ContentScheduleListUpcomingRequest{Namespace: "blog", PageSize: 500})
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, gotLimit)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleListUpcoming(&mockContentScheduleListUpcomingRepo{})
+
+		_, err := svc.Execute(userContext(), &contentdomain.ContentScheduleListUpcomingRequest{Namespace: "blog"})
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns error on missing namespace", func(t *testing.T) {
+		svc := contentdomain.NewContentScheduleListUpcoming(&mockContentScheduleListUpcomingRepo{})
+
+		_, err := svc.Execute(adminContext(), &contentdomain.ContentScheduleListUpcomingRequest{})
+
+		assert.ErrorIs(t, err, contentdomain.ErrRequestInvalid)
+	})
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// contentScheduleBatchSize bounds how many due schedules are executed in a
+// single pass, the rest are picked up by the next one.
+const contentScheduleBatchSize = 100
+
+// ContentScheduleWorkerRepository executes due schedules.
+type ContentScheduleWorkerRepository interface {
+	ListDueContentSchedules(ctx context.Context, now time.Time, limit int) ([]ContentSchedule, error)
+
+	// ExecuteContentSchedule marks the schedule as executed, sets the
+	// published_at of the content and records the event in a single
+	// transaction, filling in the event ID and time. It returns ErrConflict when
+	// the schedule was executed or cancelled in the meantime, so multiple API
+	// instances can run the worker at the same time. When the content was
+	// deleted the schedule is cancelled and ErrPageNotFound or ErrPostNotFound is
+	// returned.
+	ExecuteContentSchedule(ctx context.Context, schedule *ContentSchedule, publishedAt *time.Time, event *ContentEvent) error
+}
+
+// ContentScheduleWorker publishes and unpublishes content once its schedule
+// is due.
+type ContentScheduleWorker struct {
+	repo     ContentScheduleWorkerRepository
+	clock    commondomain.Clock
+	interval time.Duration
+}
+
+func NewContentScheduleWorker(repo ContentScheduleWorkerRepository, clock commondomain.Clock, interval time.Duration) *ContentScheduleWorker {
+	return &ContentScheduleWorker{repo: repo, clock: clock, interval: interval}
+}
+
+// Run executes due schedules at the configured interval until the context is
+// cancelled.
+func (w *ContentScheduleWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.executeDue(ctx)
+		}
+	}
+}
+
+// ExecuteDueForTest exposes executeDue for unit testing.
+func (w *ContentScheduleWorker) ExecuteDueForTest(ctx context.Context) {
+	w.executeDue(ctx)
+}
+
+func (w *ContentScheduleWorker) executeDue(ctx context.Context) {
+	schedules, err := w.repo.ListDueContentSchedules(ctx, w.clock.Now(), contentScheduleBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "content schedule worker: could not list due schedules", "error", err)
+		return
+	}
+
+	for i := range schedules {
+		schedule := &schedules[i]
+		err := w.execute(ctx, schedule)
+		switch {
+		case err == nil, errors.Is(err, ErrConflict):
+		case errors.Is(err, ErrPageNotFound), errors.Is(err, ErrPostNotFound):
+			slog.WarnContext(ctx, "content schedule worker: scheduled content no longer exists", "schedule_id", schedule.ID, "content_id", schedule.ContentID)
+		default:
+			slog.ErrorContext(ctx, "content schedule worker: could not execute schedule", "schedule_id", schedule.ID, "error", err)
+		}
+	}
+}
+
+func (w *ContentScheduleWorker) execute(ctx context.Context, schedule *ContentSchedule) error {
+	scheduleID := schedule.ID
+	event := &ContentEvent{
+		Namespace:   schedule.Namespace,
+		ContentType: schedule.ContentType,
+		ContentID:   schedule.ContentID,
+		ScheduleID:  &scheduleID,
+	}
+
+	// Publishing uses the scheduled time rather than the time the worker got
+	// to it, so the publication date doesn't depend on the worker interval.
+	var publishedAt *time.Time
+	switch schedule.Action {
+	case ScheduleActionPublish:
+		scheduledAt := schedule.ScheduledAt
+		publishedAt = &scheduledAt
+		event.Type = ContentEventPublished
+	case ScheduleActionUnpublish:
+		event.Type = ContentEventUnpublished
+	default:
+		return ErrInvalidSchedule
+	}
+
+	if err := w.repo.ExecuteContentSchedule(ctx, schedule, publishedAt, event); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "content schedule worker: executed schedule", "schedule_id", schedule.ID, "event", event.Type, "content_type", schedule.ContentType, "content_id", schedule.ContentID)
+	return nil
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type executedContentSchedule struct {
+	schedule    *contentdomain.ContentSchedule
+	publishedAt *time.Time
+	event       *contentdomain.ContentEvent
+}
+
+type mockContentScheduleWorkerRepo struct {
+	due        []contentdomain.ContentSchedule
+	listErr    error
+	executeErr map[uuid.UUID]error
+	executed   []executedContentSchedule
+	listedAt   time.Time
+}
+
+func (m *mockContentScheduleWorkerRepo) ListDueContentSchedules(ctx context.Context, now time.Time, limit int) ([]contentdomain.ContentSchedule, error) {
+	m.listedAt = now
+	return m.due, m.listErr
+}
+
+func (m *mockContentScheduleWorkerRepo) ExecuteContentSchedule(ctx context.Context, schedule *contentdomain.ContentSchedule, publishedAt *time.Time, event *contentdomain.ContentEvent) error {
+	if err := m.executeErr[schedule.ID]; err != nil {
+		return err
+	}
+	m.executed = append(m.executed, executedContentSchedule{schedule: schedule, publishedAt: publishedAt, event: event})
+	return nil
+}
+
+func TestContentScheduleWorker_ExecuteDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	scheduledAt := now.Add(-30 * time.Second)
+
+	t.Run("publishes due content at the scheduled time", func(t *testing.T) {
+		schedule := contentdomain.ContentSchedule{
+			ID:          uuid.New(),
+			Namespace:   "blog",
+			ContentType: contentdomain.ContentTypePost,
+			ContentID:   uuid.New(),
+			Action:      contentdomain.ScheduleActionPublish,
+			ScheduledAt: scheduledAt,
+		}
+		repo := &mockContentScheduleWorkerRepo{due: []contentdomain.ContentSchedule{schedule}}
+		worker := contentdomain.NewContentScheduleWorker(repo, clock, time.Minute)
+
+		worker.ExecuteDueForTest(ctx)
+
+		assert.Equal(t, now, repo.listedAt)
+		require.Len(t, repo.executed, 1)
+		executed := repo.executed[0]
+		require.NotNil(t, executed.publishedAt)
+		assert.Equal(t, scheduledAt, *executed.publishedAt)
+		assert.Equal(t, contentdomain.ContentEventPublished, executed.event.Type)
+		assert.Equal(t, contentdomain.ContentTypePost, executed.event.ContentType)
+		assert.Equal(t, schedule.ContentID, executed.event.ContentID)
+		assert.Equal(t, &schedule.ID, executed.event.ScheduleID)
+	})
+
+	t.Run("unpublishes due content", func(t *testing.T) {
+		schedule := contentdomain.ContentSchedule{
+			ID:          uuid.New(),
+			Namespace:   "blog",
+			ContentType: contentdomain.ContentTypePage,
+			ContentID:   uuid.New(),
+			Action:      contentdomain.ScheduleActionUnpublish,
+			ScheduledAt: scheduledAt,
+		}
+		repo := &mockContentScheduleWorkerRepo{due: []contentdomain.ContentSchedule{schedule}}
+		worker := contentdomain.NewContentScheduleWorker(repo, clock, time.Minute)
+
+		worker.ExecuteDueForTest(ctx)
+
+		require.Len(t, repo.executed, 1)
+		assert.Nil(t, repo.executed[0].publishedAt)
+		assert.Equal(t, contentdomain.ContentEventUnpublished, repo.executed[0].event.Type)
+	})
+
+	t.Run("continues after a failing schedule", func(t *testing.T) {
+		failing := contentdomain.ContentSchedule{ID: uuid.New(), Action: contentdomain.ScheduleActionPublish}
+		handled := contentdomain.ContentSchedule{ID: uuid.New(), Action: contentdomain.ScheduleActionPublish}
+		gone := contentdomain.ContentSchedule{ID: uuid.New(), Action: contentdomain.ScheduleActionPublish}
+		ok := contentdomain.ContentSchedule{ID: uuid.New(), Action: contentdomain.ScheduleActionPublish}
+		repo := &mockContentScheduleWorkerRepo{
+			due: []contentdomain.ContentSchedule{failing, handled, gone, ok},
+			executeErr: map[uuid.UUID]error{
+				failing.ID: errors.New("database error"),
+				handled.ID: contentdomain.ErrConflict,
+				gone.ID:    contentdomain.ErrPageNotFound,
+			},
+		}
+		worker := contentdomain.NewContentScheduleWorker(repo, clock, time.Minute)
+
+		worker.ExecuteDueForTest(ctx)
+
+		require.Len(t, repo.executed, 1)
+		assert.Equal(t, ok.ID, repo.executed[0].schedule.ID)
+	})
+
+	t.Run("does nothing when listing fails", func(t *testing.T) {
+		repo := &mockContentScheduleWorkerRepo{listErr: errors.New("database error")}
+		worker := contentdomain.NewContentScheduleWorker(repo, clock, time.Minute)
+
+		worker.ExecuteDueForTest(ctx)
+
+		assert.Empty(t, repo.executed)
+	})
+}
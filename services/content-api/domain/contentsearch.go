@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Search snippets mark matches with these private use characters, they are
+// turned into <mark> tags once the snippet is escaped.
+const (
+	SearchHighlightStart = "\ue000"
+	SearchHighlightStop  = "\ue001"
+)
+
+type ContentSearchRepository interface {
+	SearchContent(ctx context.Context, query *ContentSearchQuery) (*ContentSearchResult, error)
+}
+
+// ContentSearchQuery is a search within a namespace, ordered by rank.
+type ContentSearchQuery struct {
+	Namespace     string
+	Query         string
+	SearchConfig  string
+	IncludePages  bool
+	IncludePosts  bool
+	IncludeDrafts bool
+	PageSize      int
+	Page          int
+}
+
+type ContentSearchHit struct {
+	ContentType ContentType
+	ID          uuid.UUID
+	Slug        string
+	Title       string
+	PublishedAt *time.Time
+	Rank        float32
+	// Snippet is an excerpt of the body as plain text, with matches enclosed
+	// in SearchHighlightStart and SearchHighlightStop.
+	Snippet string
+}
+
+type ContentSearchResult struct {
+	Hits      []ContentSearchHit
+	TotalSize int
+}
+
+type ContentSearchRequest struct {
+	Namespace string `validate:"required"`
+	Query     string `validate:"required,max=200"`
+	// Language is the ISO 639 code the query is written in, words are stemmed
+	// accordingly in addition to matching their exact form.
+	Language      *string `validate:"omitempty,min=2,max=3,lowercase,alpha"`
+	ContentType   string  `validate:"omitempty,oneof=page post"`
+	IncludeDrafts bool
+	PageSize      int
+	Page          int
+}
+
+type ContentSearchResponseHit struct {
+	ContentSearchHit
+	// SnippetHTML is the escaped snippet with matches enclosed in <mark> tags.
+	SnippetHTML string
+}
+
+type ContentSearchResponse struct {
+	Hits          []ContentSearchResponseHit
+	TotalSize     int
+	NextPageToken string
+}
+
+type ContentSearch struct {
+	repo     ContentSearchRepository
+	validate *validator.Validate
+}
+
+func NewContentSearch(repo ContentSearchRepository) *ContentSearch {
+	return &ContentSearch{
+		repo:     repo,
+		validate: validator.New(),
+	}
+}
+
+func (s *ContentSearch) Execute(ctx context.Context, req *ContentSearchRequest) (*ContentSearchResponse, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestInvalid, err)
+	}
+
+	if req.IncludeDrafts && !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	result, err := s.repo.SearchContent(ctx, &ContentSearchQuery{
+		Namespace:     req.Namespace,
+		Query:         req.Query,
+		SearchConfig:  SearchConfig(req.Language),
+		IncludePages:  req.ContentType == "" || req.ContentType == string(ContentTypePage),
+		IncludePosts:  req.ContentType == "" || req.ContentType == string(ContentTypePost),
+		IncludeDrafts: req.IncludeDrafts,
+		PageSize:      pageSize,
+		Page:          req.Page,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ContentSearchResponse{
+		Hits:      make([]ContentSearchResponseHit, len(result.Hits)),
+		TotalSize: result.TotalSize,
+	}
+	for i, hit := range result.Hits {
+		res.Hits[i] = ContentSearchResponseHit{
+			ContentSearchHit: hit,
+			SnippetHTML:      renderSearchSnippet(hit.Snippet),
+		}
+	}
+	if (req.Page*pageSize)+pageSize < result.TotalSize {
+		res.NextPageToken = fmt.Sprint(req.Page + 1)
+	}
+
+	return res, nil
+}
+
+// renderSearchSnippet escapes a snippet and marks the matches. Snippets are cut
+// from the plain text of the body, see SearchText.
+func renderSearchSnippet(snippet string) string {
+	text := strings.Join(strings.Fields(snippet), " ")
+	text = html.EscapeString(text)
+	text = strings.ReplaceAll(text, SearchHighlightStart, "<mark>")
+	return strings.ReplaceAll(text, SearchHighlightStop, "</mark>")
+}
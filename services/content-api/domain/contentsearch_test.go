@@ -0,0 +1,162 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockContentSearchRepo struct {
+	searchContentFn func(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error)
+}
+
+func (m *mockContentSearchRepo) SearchContent(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error) {
+	if m.searchContentFn != nil {
+		return m.searchContentFn(ctx, query)
+	}
+	return &contentdomain.ContentSearchResult{}, nil
+}
+
+func TestContentSearch_Execute(t *testing.T) {
+	t.Run("searches published pages and posts", func(t *testing.T) {
+		var got *contentdomain.ContentSearchQuery
+		id := uuid.New()
+		repo := &mockContentSearchRepo{
+			searchContentFn: func(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error) {
+				got = query
+				return &contentdomain.ContentSearchResult{
+					Hits: []contentdomain.ContentSearchHit{{
+						ContentType: contentdomain.ContentTypePost,
+						ID:          id,
+						Snippet:     "Reading " + contentdomain.SearchHighlightStart + "manga" + contentdomain.SearchHighlightStop + " & <b>books</b>",
+					}},
+					TotalSize: 25,
+				}, nil
+			},
+		}
+		svc := contentdomain.NewContentSearch(repo)
+
+		language := "en"
+		resp, err := svc.Execute(context.Background(), &contentdomain.ContentSearchRequest{
+			Namespace: "tadoku",
+			Query:     "  manga  ",
+			Language:  &language,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "manga", got.Query)
+		assert.Equal(t, "english", got.SearchConfig)
+		assert.True(t, got.IncludePages)
+		assert.True(t, got.IncludePosts)
+		assert.False(t, got.IncludeDrafts)
+		assert.Equal(t, 10, got.PageSize)
+
+		require.Len(t, resp.Hits, 1)
+		assert.Equal(t, id, resp.Hits[0].ID)
+		assert.Equal(t, "Reading <mark>manga</mark> &amp; &lt;b&gt;books&lt;/b&gt;", resp.Hits[0].SnippetHTML)
+		assert.Equal(t, 25, resp.TotalSize)
+		assert.Equal(t, "1", resp.NextPageToken)
+	})
+
+	t.Run("restricts to a single content type", func(t *testing.T) {
+		var got *contentdomain.ContentSearchQuery
+		repo := &mockContentSearchRepo{
+			searchContentFn: func(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error) {
+				got = query
+				return &contentdomain.ContentSearchResult{}, nil
+			},
+		}
+		svc := contentdomain.NewContentSearch(repo)
+
+		resp, err := svc.Execute(context.Background(), &contentdomain.ContentSearchRequest{
+			Namespace:   "tadoku",
+			Query:       "manga",
+			ContentType: "page",
+			PageSize:    500,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, got.IncludePages)
+		assert.False(t, got.IncludePosts)
+		assert.Equal(t, "simple", got.SearchConfig)
+		assert.Equal(t, 100, got.PageSize)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("falls back to simple search for languages without stemming", func(t *testing.T) {
+		var got *contentdomain.ContentSearchQuery
+		repo := &mockContentSearchRepo{
+			searchContentFn: func(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error) {
+				got = query
+				return &contentdomain.ContentSearchResult{}, nil
+			},
+		}
+		svc := contentdomain.NewContentSearch(repo)
+
+		language := "ja"
+		_, err := svc.Execute(context.Background(), &contentdomain.ContentSearchRequest{
+			Namespace: "tadoku",
+			Query:     "漫画",
+			Language:  &language,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "simple", got.SearchConfig)
+	})
+
+	t.Run("includes drafts for admins", func(t *testing.T) {
+		var got *contentdomain.ContentSearchQuery
+		repo := &mockContentSearchRepo{
+			searchContentFn: func(ctx context.Context, query *contentdomain.ContentSearchQuery) (*contentdomain.ContentSearchResult, error) {
+				got = query
+				return &contentdomain.ContentSearchResult{}, nil
+			},
+		}
+		svc := contentdomain.NewContentSearch(repo)
+
+		_, err := svc.Execute(adminContext(), &contentdomain.ContentSearchRequest{
+			Namespace:     "tadoku",
+			Query:         "manga",
+			IncludeDrafts: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, got.IncludeDrafts)
+	})
+
+	t.Run("returns forbidden when including drafts as non-admin", func(t *testing.T) {
+		svc := contentdomain.NewContentSearch(&mockContentSearchRepo{})
+
+		_, err := svc.Execute(userContext(), &contentdomain.ContentSearchRequest{
+			Namespace:     "tadoku",
+			Query:         "manga",
+			IncludeDrafts: true,
+		})
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns error on invalid request", func(t *testing.T) {
+		svc := contentdomain.NewContentSearch(&mockContentSearchRepo{})
+
+		tests := []struct {
+			name string
+			req  *contentdomain.ContentSearchRequest
+		}{
+			{"empty query", &contentdomain.ContentSearchRequest{Namespace: "tadoku", Query: "   "}},
+			{"missing namespace", &contentdomain.ContentSearchRequest{Query: "manga"}},
+			{"unknown content type", &contentdomain.ContentSearchRequest{Namespace: "tadoku", Query: "manga", ContentType: "announcement"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := svc.Execute(context.Background(), tt.req)
+
+				assert.ErrorIs(t, err, contentdomain.ErrRequestInvalid)
+			})
+		}
+	})
+}
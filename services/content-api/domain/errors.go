@@ -26,10 +26,17 @@ var (
 	ErrInvalidAnnouncement  = errors.New("unable to validate announcement")
 )
 
+// Schedule errors
+var (
+	ErrScheduleNotFound = errors.New("schedule not found")
+	ErrInvalidSchedule  = errors.New("unable to validate schedule")
+)
+
 // Common errors
 var (
 	ErrForbidden        = commondomain.ErrForbidden
 	ErrAuthzUnavailable = commondomain.ErrAuthzUnavailable
+	ErrConflict         = commondomain.ErrConflict
 	ErrRequestInvalid   = commondomain.ErrRequestInvalid
 	ErrUnauthorized     = commondomain.ErrUnauthorized
 )
@@ -6,13 +6,17 @@ import (
 	"github.com/google/uuid"
 )
 
-// Page is a web page whose content is managed by this service.
+// Page is a web page whose content is managed by this service. Source is the
+// content as authored in Format, HTML is the sanitized rendering of it.
 type Page struct {
 	ID          uuid.UUID
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      ContentFormat
+	Source      string
 	HTML        string
+	Language    *string
 	PublishedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -19,7 +19,9 @@ type PageCreateRequest struct {
 	Namespace   string    `validate:"required"`
 	Slug        string    `validate:"required,gt=1,lowercase"`
 	Title       string    `validate:"required"`
-	HTML        string    `validate:"required"`
+	Format      ContentFormat
+	Source      string  `validate:"required"`
+	Language    *string `validate:"omitempty,min=2,max=3,lowercase,alpha"`
 	PublishedAt *time.Time
 }
 
@@ -31,13 +33,15 @@ type PageCreate struct {
 	repo     PageCreateRepository
 	validate *validator.Validate
 	clock    commondomain.Clock
+	renderer ContentRenderer
 }
 
-func NewPageCreate(repo PageCreateRepository, clock commondomain.Clock) *PageCreate {
+func NewPageCreate(repo PageCreateRepository, clock commondomain.Clock, renderer ContentRenderer) *PageCreate {
 	return &PageCreate{
 		repo:     repo,
 		validate: validator.New(),
 		clock:    clock,
+		renderer: renderer,
 	}
 }
 
@@ -50,13 +54,29 @@ func (s *PageCreate) Execute(ctx context.Context, req *PageCreateRequest) (*Page
 		return nil, fmt.Errorf("%w: %v", ErrInvalidPage, err)
 	}
 
+	format := req.Format
+	if format == "" {
+		format = ContentFormatHTML
+	}
+	if !validContentFormat(format) {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPage, format)
+	}
+
+	html, err := s.renderer.Render(format, req.Source)
+	if err != nil {
+		return nil, fmt.Errorf("could not render page: %w", err)
+	}
+
 	now := s.clock.Now()
 	page := &Page{
 		ID:          req.ID,
 		Namespace:   req.Namespace,
 		Slug:        req.Slug,
 		Title:       req.Title,
-		HTML:        req.HTML,
+		Format:      format,
+		Source:      req.Source,
+		HTML:        html,
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 		CreatedAt:   now,
 		UpdatedAt:   now,
@@ -24,6 +24,18 @@ func (m *mockPageCreateRepo) CreatePage(ctx context.Context, page *contentdomain
 	return nil
 }
 
+// mockRenderer returns the source as is unless renderFn is set.
+type mockRenderer struct {
+	renderFn func(format contentdomain.ContentFormat, source string) (string, error)
+}
+
+func (m *mockRenderer) Render(format contentdomain.ContentFormat, source string) (string, error) {
+	if m.renderFn != nil {
+		return m.renderFn(format, source)
+	}
+	return source, nil
+}
+
 func adminContext() context.Context {
 	return authzctx.AdminSubject("kratos-admin-id")
 }
@@ -45,7 +57,7 @@ func TestPageCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 		id := uuid.New()
 		publishedAt := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
 
@@ -54,7 +66,7 @@ func TestPageCreate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "hello-world",
 			Title:       "Hello World",
-			HTML:        "<p>Content</p>",
+			Source:      "<p>Content</p>",
 			PublishedAt: &publishedAt,
 		})
 
@@ -64,6 +76,8 @@ func TestPageCreate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "hello-world",
 			Title:       "Hello World",
+			Format:      contentdomain.ContentFormatHTML,
+			Source:      "<p>Content</p>",
 			HTML:        "<p>Content</p>",
 			PublishedAt: &publishedAt,
 			CreatedAt:   now,
@@ -74,14 +88,14 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns forbidden when not admin", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(userContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "hello-world",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
@@ -89,14 +103,14 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns unauthorized when no session", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(context.Background(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "hello-world",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrUnauthorized)
@@ -104,13 +118,13 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing ID", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			Namespace: "blog",
 			Slug:      "hello-world",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -118,13 +132,13 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing namespace", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
-			ID:    uuid.New(),
-			Slug:  "hello-world",
-			Title: "Hello World",
-			HTML:  "<p>Content</p>",
+			ID:     uuid.New(),
+			Slug:   "hello-world",
+			Title:  "Hello World",
+			Source: "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -132,13 +146,13 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing slug", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -146,14 +160,14 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - uppercase slug", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "Hello-World",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -161,13 +175,13 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing title", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "hello-world",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -175,7 +189,7 @@ func TestPageCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing HTML", func(t *testing.T) {
 		repo := &mockPageCreateRepo{}
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
@@ -195,14 +209,14 @@ func TestPageCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "hello-world",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, repoErr)
@@ -215,14 +229,14 @@ func TestPageCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
 			ID:        uuid.New(),
 			Namespace: "blog",
 			Slug:      "hello-world",
 			Title:     "Hello World",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrPageAlreadyExists)
@@ -237,7 +251,7 @@ func TestPageCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageCreate(repo, clock)
+		svc := contentdomain.NewPageCreate(repo, clock, &mockRenderer{})
 		id := uuid.New()
 
 		resp, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
@@ -245,7 +259,7 @@ func TestPageCreate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "draft-page",
 			Title:     "Draft Page",
-			HTML:      "<p>Draft content</p>",
+			Source:    "<p>Draft content</p>",
 		})
 
 		require.NoError(t, err)
@@ -254,6 +268,8 @@ func TestPageCreate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "draft-page",
 			Title:       "Draft Page",
+			Format:      contentdomain.ContentFormatHTML,
+			Source:      "<p>Draft content</p>",
 			HTML:        "<p>Draft content</p>",
 			PublishedAt: nil,
 			CreatedAt:   now,
@@ -261,4 +277,44 @@ func TestPageCreate_Execute(t *testing.T) {
 		}, resp.Page)
 		assert.Equal(t, resp.Page, savedPage)
 	})
+
+	t.Run("renders markdown source", func(t *testing.T) {
+		var renderedFormat contentdomain.ContentFormat
+		renderer := &mockRenderer{
+			renderFn: func(format contentdomain.ContentFormat, source string) (string, error) {
+				renderedFormat = format
+				return "<h1>Hello</h1>", nil
+			},
+		}
+		svc := contentdomain.NewPageCreate(&mockPageCreateRepo{}, clock, renderer)
+
+		resp, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
+			ID:        uuid.New(),
+			Namespace: "blog",
+			Slug:      "markdown-page",
+			Title:     "Markdown Page",
+			Format:    contentdomain.ContentFormatMarkdown,
+			Source:    "# Hello",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, contentdomain.ContentFormatMarkdown, renderedFormat)
+		assert.Equal(t, "# Hello", resp.Page.Source)
+		assert.Equal(t, "<h1>Hello</h1>", resp.Page.HTML)
+	})
+
+	t.Run("returns error on unknown format", func(t *testing.T) {
+		svc := contentdomain.NewPageCreate(&mockPageCreateRepo{}, clock, &mockRenderer{})
+
+		_, err := svc.Execute(adminContext(), &contentdomain.PageCreateRequest{
+			ID:        uuid.New(),
+			Namespace: "blog",
+			Slug:      "rst-page",
+			Title:     "RST Page",
+			Format:    contentdomain.ContentFormat("rst"),
+			Source:    "Hello",
+		})
+
+		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
+	})
 }
@@ -20,7 +20,9 @@ type PageUpdateRequest struct {
 	Namespace   string `validate:"required"`
 	Slug        string `validate:"required,gt=1,lowercase"`
 	Title       string `validate:"required"`
-	HTML        string `validate:"required"`
+	Format      ContentFormat
+	Source      string  `validate:"required"`
+	Language    *string `validate:"omitempty,min=2,max=3,lowercase,alpha"`
 	PublishedAt *time.Time
 }
 
@@ -32,13 +34,15 @@ type PageUpdate struct {
 	repo     PageUpdateRepository
 	validate *validator.Validate
 	clock    commondomain.Clock
+	renderer ContentRenderer
 }
 
-func NewPageUpdate(repo PageUpdateRepository, clock commondomain.Clock) *PageUpdate {
+func NewPageUpdate(repo PageUpdateRepository, clock commondomain.Clock, renderer ContentRenderer) *PageUpdate {
 	return &PageUpdate{
 		repo:     repo,
 		validate: validator.New(),
 		clock:    clock,
+		renderer: renderer,
 	}
 }
 
@@ -51,17 +55,37 @@ func (s *PageUpdate) Execute(ctx context.Context, id uuid.UUID, req *PageUpdateR
 		return nil, fmt.Errorf("%w: %v", ErrInvalidPage, err)
 	}
 
+	format := req.Format
+	if format == "" {
+		format = ContentFormatHTML
+	}
+	if !validContentFormat(format) {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPage, format)
+	}
+
 	page, err := s.repo.GetPageByID(ctx, id, req.Namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	contentChanged := page.Title != req.Title || page.HTML != req.HTML
+	contentChanged := page.Title != req.Title ||
+		page.Format != format ||
+		page.Source != req.Source ||
+		!sameLanguage(page.Language, req.Language)
+
+	if contentChanged {
+		page.HTML, err = s.renderer.Render(format, req.Source)
+		if err != nil {
+			return nil, fmt.Errorf("could not render page: %w", err)
+		}
+	}
 
 	page.Namespace = req.Namespace
 	page.Slug = req.Slug
 	page.Title = req.Title
-	page.HTML = req.HTML
+	page.Format = format
+	page.Source = req.Source
+	page.Language = req.Language
 	page.PublishedAt = req.PublishedAt
 	page.UpdatedAt = s.clock.Now()
 
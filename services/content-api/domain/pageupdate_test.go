@@ -51,6 +51,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Old content</p>",
 			HTML:      "<p>Old content</p>",
 			CreatedAt: createdAt,
 			UpdatedAt: createdAt,
@@ -67,14 +69,14 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 		publishedAt := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
 
 		resp, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
 			Namespace:   "blog",
 			Slug:        "new-slug",
 			Title:       "New Title",
-			HTML:        "<p>New content</p>",
+			Source:      "<p>New content</p>",
 			PublishedAt: &publishedAt,
 		})
 
@@ -84,6 +86,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "new-slug",
 			Title:       "New Title",
+			Format:      contentdomain.ContentFormatHTML,
+			Source:      "<p>New content</p>",
 			HTML:        "<p>New content</p>",
 			PublishedAt: &publishedAt,
 			CreatedAt:   createdAt,
@@ -94,13 +98,13 @@ func TestPageUpdate_Execute(t *testing.T) {
 
 	t.Run("returns forbidden when not admin", func(t *testing.T) {
 		repo := &mockPageUpdateRepo{}
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(userContext(), uuid.New(), &contentdomain.PageUpdateRequest{
 			Namespace: "blog",
 			Slug:      "test-slug",
 			Title:     "Test Title",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
@@ -108,12 +112,12 @@ func TestPageUpdate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing slug", func(t *testing.T) {
 		repo := &mockPageUpdateRepo{}
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), uuid.New(), &contentdomain.PageUpdateRequest{
 			Namespace: "blog",
 			Title:     "Test Title",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrInvalidPage)
@@ -126,13 +130,13 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), uuid.New(), &contentdomain.PageUpdateRequest{
 			Namespace: "blog",
 			Slug:      "test-slug",
 			Title:     "Test Title",
-			HTML:      "<p>Content</p>",
+			Source:    "<p>Content</p>",
 		})
 
 		assert.ErrorIs(t, err, contentdomain.ErrPageNotFound)
@@ -145,6 +149,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Old content</p>",
 			HTML:      "<p>Old content</p>",
 		}
 
@@ -158,13 +164,13 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
 			Namespace: "blog",
 			Slug:      "new-slug",
 			Title:     "New Title",
-			HTML:      "<p>New content</p>",
+			Source:    "<p>New content</p>",
 		})
 
 		assert.ErrorIs(t, err, repoErr)
@@ -177,6 +183,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "test-page",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Old content</p>",
 			HTML:      "<p>Old content</p>",
 		}
 
@@ -195,13 +203,13 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
 			Namespace: "blog",
 			Slug:      "test-page",
 			Title:     "New Title",
-			HTML:      "<p>Old content</p>",
+			Source:    "<p>Old content</p>",
 		})
 
 		require.NoError(t, err)
@@ -209,6 +217,45 @@ func TestPageUpdate_Execute(t *testing.T) {
 		assert.False(t, calledUpdateMetadata, "should not call UpdatePageMetadata when content changes")
 	})
 
+	t.Run("calls UpdatePage when language changes", func(t *testing.T) {
+		id := uuid.New()
+		existingPage := &contentdomain.Page{
+			ID:        id,
+			Namespace: "blog",
+			Slug:      "test-page",
+			Title:     "Same Title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Same content</p>",
+			HTML:      "<p>Same content</p>",
+		}
+
+		var savedPage *contentdomain.Page
+		repo := &mockPageUpdateRepo{
+			getPageByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error) {
+				return existingPage, nil
+			},
+			updatePageFn: func(ctx context.Context, page *contentdomain.Page) error {
+				savedPage = page
+				return nil
+			},
+		}
+
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
+		language := "de"
+
+		_, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
+			Namespace: "blog",
+			Slug:      "test-page",
+			Title:     "Same Title",
+			Source:    "<p>Same content</p>",
+			Language:  &language,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, savedPage, "should call UpdatePage when the language changes")
+		assert.Equal(t, &language, savedPage.Language)
+	})
+
 	t.Run("calls UpdatePageMetadata when only metadata changes", func(t *testing.T) {
 		id := uuid.New()
 		existingPage := &contentdomain.Page{
@@ -216,6 +263,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Same Title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Same content</p>",
 			HTML:      "<p>Same content</p>",
 		}
 
@@ -234,14 +283,14 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 		publishedAt := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
 			Namespace:   "blog",
 			Slug:        "new-slug",
 			Title:       "Same Title",
-			HTML:        "<p>Same content</p>",
+			Source:      "<p>Same content</p>",
 			PublishedAt: &publishedAt,
 		})
 
@@ -259,6 +308,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "published-page",
 			Title:       "Published Page",
+			Format:      contentdomain.ContentFormatHTML,
+			Source:      "<p>Content</p>",
 			HTML:        "<p>Content</p>",
 			PublishedAt: &publishedAt,
 			CreatedAt:   createdAt,
@@ -276,13 +327,13 @@ func TestPageUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPageUpdate(repo, clock)
+		svc := contentdomain.NewPageUpdate(repo, clock, &mockRenderer{})
 
 		resp, err := svc.Execute(adminContext(), id, &contentdomain.PageUpdateRequest{
 			Namespace:   "blog",
 			Slug:        "published-page",
 			Title:       "Published Page",
-			HTML:        "<p>Content</p>",
+			Source:      "<p>Content</p>",
 			PublishedAt: nil,
 		})
 
@@ -292,6 +343,8 @@ func TestPageUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "published-page",
 			Title:       "Published Page",
+			Format:      contentdomain.ContentFormatHTML,
+			Source:      "<p>Content</p>",
 			HTML:        "<p>Content</p>",
 			PublishedAt: nil,
 			CreatedAt:   createdAt,
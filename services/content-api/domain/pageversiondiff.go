@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PageVersionDiffRepository interface {
+	GetPageVersion(ctx context.Context, pageID uuid.UUID, contentID uuid.UUID) (*PageVersion, error)
+}
+
+// PageVersionDiff compares two versions of a page.
+type PageVersionDiff struct {
+	repo PageVersionDiffRepository
+}
+
+func NewPageVersionDiff(repo PageVersionDiffRepository) *PageVersionDiff {
+	return &PageVersionDiff{
+		repo: repo,
+	}
+}
+
+func (s *PageVersionDiff) Execute(ctx context.Context, pageID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) (*ContentDiff, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	from, err := s.repo.GetPageVersion(ctx, pageID, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.repo.GetPageVersion(ctx, pageID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newContentDiff(from.ID, to.ID, from.Title, to.Title, from.Source, to.Source), nil
+}
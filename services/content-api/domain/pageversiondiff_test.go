@@ -0,0 +1,147 @@
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockPageVersionDiffRepo struct {
+	versions map[uuid.UUID]*contentdomain.PageVersion
+}
+
+func (m *mockPageVersionDiffRepo) GetPageVersion(ctx context.Context, pageID uuid.UUID, contentID uuid.UUID) (*contentdomain.PageVersion, error) {
+	v, ok := m.versions[contentID]
+	if !ok {
+		return nil, contentdomain.ErrPageNotFound
+	}
+	return v, nil
+}
+
+func TestPageVersionDiff_Execute(t *testing.T) {
+	pageID := uuid.New()
+	fromID := uuid.New()
+	toID := uuid.New()
+
+	diffPage := func(t *testing.T, from, to *contentdomain.PageVersion) *contentdomain.ContentDiff {
+		t.Helper()
+		from.ID = fromID
+		to.ID = toID
+		repo := &mockPageVersionDiffRepo{versions: map[uuid.UUID]*contentdomain.PageVersion{
+			fromID: from,
+			toID:   to,
+		}}
+
+		diff, err := contentdomain.NewPageVersionDiff(repo).Execute(adminContext(), pageID, fromID, toID)
+		require.NoError(t, err)
+		return diff
+	}
+
+	t.Run("diffs title and html by word", func(t *testing.T) {
+		diff := diffPage(t,
+			&contentdomain.PageVersion{Title: "Hello world", Source: "<p>Read every day</p>"},
+			&contentdomain.PageVersion{Title: "Hello Tadoku", Source: "<p>Read a book every day</p>"},
+		)
+
+		assert.Equal(t, fromID, diff.FromID)
+		assert.Equal(t, toID, diff.ToID)
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "Hello "},
+			{Op: contentdomain.DiffOpDelete, Text: "world"},
+			{Op: contentdomain.DiffOpInsert, Text: "Tadoku"},
+		}, diff.Title)
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "<p>Read "},
+			{Op: contentdomain.DiffOpInsert, Text: "a book "},
+			{Op: contentdomain.DiffOpEqual, Text: "every day</p>"},
+		}, diff.Body)
+		assert.Equal(t, "&lt;p&gt;Read <ins>a book </ins>every day&lt;/p&gt;", diff.BodyHTML)
+	})
+
+	t.Run("diffs japanese text per character", func(t *testing.T) {
+		diff := diffPage(t,
+			&contentdomain.PageVersion{Title: "多読", Source: "<p>毎日本を読む</p>"},
+			&contentdomain.PageVersion{Title: "多読", Source: "<p>毎日漫画を読む</p>"},
+		)
+
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "多読"},
+		}, diff.Title)
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "<p>毎日"},
+			{Op: contentdomain.DiffOpDelete, Text: "本"},
+			{Op: contentdomain.DiffOpInsert, Text: "漫画"},
+			{Op: contentdomain.DiffOpEqual, Text: "を読む</p>"},
+		}, diff.Body)
+	})
+
+	t.Run("shows unrelated versions as replaced", func(t *testing.T) {
+		from := strings.Repeat("a ", 3000)
+		to := strings.Repeat("b ", 3000)
+		diff := diffPage(t,
+			&contentdomain.PageVersion{Title: "Title", Source: from},
+			&contentdomain.PageVersion{Title: "Title", Source: to},
+		)
+
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpDelete, Text: from},
+			{Op: contentdomain.DiffOpInsert, Text: to},
+		}, diff.Body)
+	})
+
+	t.Run("diffs long versions with scattered edits", func(t *testing.T) {
+		words := make([]string, 20000)
+		for i := range words {
+			words[i] = fmt.Sprintf("w%d", i)
+		}
+		from := strings.Join(words, " ")
+		words[10] = "start"
+		words[10000] = "middle"
+		words = append(words[:19990], words[19995:]...)
+		to := strings.Join(words, " ")
+
+		diff := diffPage(t,
+			&contentdomain.PageVersion{Title: "Title", Source: from},
+			&contentdomain.PageVersion{Title: "Title", Source: to},
+		)
+
+		var before, after strings.Builder
+		changes := 0
+		for _, segment := range diff.Body {
+			if segment.Op != contentdomain.DiffOpInsert {
+				before.WriteString(segment.Text)
+			}
+			if segment.Op != contentdomain.DiffOpDelete {
+				after.WriteString(segment.Text)
+			}
+			if segment.Op == contentdomain.DiffOpDelete {
+				changes++
+			}
+		}
+		assert.Equal(t, from, before.String())
+		assert.Equal(t, to, after.String())
+		assert.Equal(t, 3, changes)
+	})
+
+	t.Run("returns not found for unknown version", func(t *testing.T) {
+		repo := &mockPageVersionDiffRepo{versions: map[uuid.UUID]*contentdomain.PageVersion{}}
+
+		_, err := contentdomain.NewPageVersionDiff(repo).Execute(adminContext(), pageID, fromID, toID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrPageNotFound)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		repo := &mockPageVersionDiffRepo{}
+
+		_, err := contentdomain.NewPageVersionDiff(repo).Execute(userContext(), pageID, fromID, toID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+}
@@ -11,8 +11,15 @@ type PageVersion struct {
 	ID        uuid.UUID
 	Version   int
 	Title     string
+	Format    ContentFormat
+	Source    string
 	HTML      string
+	Language  *string
 	CreatedAt time.Time
+	// RestoredFromID is set when this version was created by restoring an
+	// older version, along with the user who restored it.
+	RestoredFromID   *uuid.UUID
+	RestoredByUserID *uuid.UUID
 }
 
 type PageVersionGetRepository interface {
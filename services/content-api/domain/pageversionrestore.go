@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type PageVersionRestoreRepository interface {
+	GetPageByID(ctx context.Context, id uuid.UUID, namespace string) (*Page, error)
+	GetPageVersion(ctx context.Context, pageID uuid.UUID, contentID uuid.UUID) (*PageVersion, error)
+	RestorePageVersion(ctx context.Context, page *Page, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error
+}
+
+// PageVersionRestore makes an older version the current content of a page.
+// The version is copied into a new version, so the history stays intact.
+type PageVersionRestore struct {
+	repo     PageVersionRestoreRepository
+	clock    commondomain.Clock
+	renderer ContentRenderer
+}
+
+func NewPageVersionRestore(repo PageVersionRestoreRepository, clock commondomain.Clock, renderer ContentRenderer) *PageVersionRestore {
+	return &PageVersionRestore{
+		repo:     repo,
+		clock:    clock,
+		renderer: renderer,
+	}
+}
+
+func (s *PageVersionRestore) Execute(ctx context.Context, namespace string, pageID uuid.UUID, contentID uuid.UUID) (*Page, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := sessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.repo.GetPageByID(ctx, pageID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.repo.GetPageVersion(ctx, pageID, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rendered again as the rules may have changed since the version was saved
+	html, err := s.renderer.Render(version.Format, version.Source)
+	if err != nil {
+		return nil, fmt.Errorf("could not render page: %w", err)
+	}
+
+	page.Title = version.Title
+	page.Format = version.Format
+	page.Source = version.Source
+	page.HTML = html
+	page.Language = version.Language
+	page.UpdatedAt = s.clock.Now()
+
+	if err := s.repo.RestorePageVersion(ctx, page, version.ID, userID); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,160 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/common/testutil/authzctx"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockPageVersionRestoreRepo struct {
+	getPageByIDFn        func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error)
+	getPageVersionFn     func(ctx context.Context, pageID uuid.UUID, contentID uuid.UUID) (*contentdomain.PageVersion, error)
+	restorePageVersionFn func(ctx context.Context, page *contentdomain.Page, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error
+}
+
+func (m *mockPageVersionRestoreRepo) GetPageByID(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error) {
+	return m.getPageByIDFn(ctx, id, namespace)
+}
+
+func (m *mockPageVersionRestoreRepo) GetPageVersion(ctx context.Context, pageID uuid.UUID, contentID uuid.UUID) (*contentdomain.PageVersion, error) {
+	return m.getPageVersionFn(ctx, pageID, contentID)
+}
+
+func (m *mockPageVersionRestoreRepo) RestorePageVersion(ctx context.Context, page *contentdomain.Page, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error {
+	if m.restorePageVersionFn != nil {
+		return m.restorePageVersionFn(ctx, page, restoredFromID, restoredByUserID)
+	}
+	return nil
+}
+
+func TestPageVersionRestore_Execute(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	pageID := uuid.New()
+	contentID := uuid.New()
+	adminID := uuid.New()
+
+	existingPage := func() *contentdomain.Page {
+		return &contentdomain.Page{
+			ID:        pageID,
+			Namespace: "blog",
+			Slug:      "about",
+			Title:     "Current title",
+			Format:    contentdomain.ContentFormatHTML,
+			Source:    "<p>Current</p>",
+			HTML:      "<p>Current</p>",
+			CreatedAt: now.Add(-48 * time.Hour),
+			UpdatedAt: now.Add(-24 * time.Hour),
+		}
+	}
+	oldVersion := &contentdomain.PageVersion{
+		ID:     contentID,
+		Title:  "Old title",
+		Format: contentdomain.ContentFormatHTML,
+		Source: "<p>Old</p>",
+		HTML:   "<p>Old</p>",
+	}
+
+	t.Run("restores version as new content", func(t *testing.T) {
+		var restored *contentdomain.Page
+		var restoredFrom, restoredBy uuid.UUID
+		repo := &mockPageVersionRestoreRepo{
+			getPageByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error) {
+				assert.Equal(t, "blog", namespace)
+				return existingPage(), nil
+			},
+			getPageVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PageVersion, error) {
+				assert.Equal(t, pageID, pID)
+				assert.Equal(t, contentID, cID)
+				return oldVersion, nil
+			},
+			restorePageVersionFn: func(ctx context.Context, page *contentdomain.Page, fromID uuid.UUID, byID uuid.UUID) error {
+				restored = page
+				restoredFrom = fromID
+				restoredBy = byID
+				return nil
+			},
+		}
+
+		renderer := &mockRenderer{
+			renderFn: func(format contentdomain.ContentFormat, source string) (string, error) {
+				return "sanitized " + source, nil
+			},
+		}
+		svc := contentdomain.NewPageVersionRestore(repo, clock, renderer)
+		page, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", pageID, contentID)
+
+		require.NoError(t, err)
+		require.NotNil(t, restored)
+		assert.Equal(t, "Old title", page.Title)
+		assert.Equal(t, "<p>Old</p>", page.Source)
+		assert.Equal(t, "sanitized <p>Old</p>", page.HTML, "should render the version with the current rules")
+		assert.Equal(t, "about", page.Slug)
+		assert.Equal(t, now, page.UpdatedAt)
+		assert.Equal(t, contentID, restoredFrom)
+		assert.Equal(t, adminID, restoredBy)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewPageVersionRestore(&mockPageVersionRestoreRepo{}, clock, &mockRenderer{})
+
+		_, err := svc.Execute(userContext(), "blog", pageID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns unauthorized when no session", func(t *testing.T) {
+		svc := contentdomain.NewPageVersionRestore(&mockPageVersionRestoreRepo{}, clock, &mockRenderer{})
+
+		_, err := svc.Execute(context.Background(), "blog", pageID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrUnauthorized)
+	})
+
+	t.Run("returns not found for unknown version", func(t *testing.T) {
+		repo := &mockPageVersionRestoreRepo{
+			getPageByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error) {
+				return existingPage(), nil
+			},
+			getPageVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PageVersion, error) {
+				return nil, contentdomain.ErrPageNotFound
+			},
+			restorePageVersionFn: func(ctx context.Context, page *contentdomain.Page, fromID uuid.UUID, byID uuid.UUID) error {
+				t.Fatal("should not restore")
+				return nil
+			},
+		}
+
+		svc := contentdomain.NewPageVersionRestore(repo, clock, &mockRenderer{})
+		_, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", pageID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrPageNotFound)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		repo := &mockPageVersionRestoreRepo{
+			getPageByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Page, error) {
+				return existingPage(), nil
+			},
+			getPageVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PageVersion, error) {
+				return oldVersion, nil
+			},
+			restorePageVersionFn: func(ctx context.Context, page *contentdomain.Page, fromID uuid.UUID, byID uuid.UUID) error {
+				return repoErr
+			},
+		}
+
+		svc := contentdomain.NewPageVersionRestore(repo, clock, &mockRenderer{})
+		_, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", pageID, contentID)
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
@@ -6,13 +6,17 @@ import (
 	"github.com/google/uuid"
 )
 
-// Post is a blog post managed by this service.
+// Post is a blog post managed by this service. Content is the post as authored
+// in Format, HTML is the sanitized rendering of it.
 type Post struct {
 	ID          uuid.UUID
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      ContentFormat
 	Content     string
+	HTML        string
+	Language    *string
 	PublishedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -19,7 +19,9 @@ type PostCreateRequest struct {
 	Namespace   string    `validate:"required"`
 	Slug        string    `validate:"required,gt=1,lowercase"`
 	Title       string    `validate:"required"`
-	Content     string    `validate:"required"`
+	Format      ContentFormat
+	Content     string  `validate:"required"`
+	Language    *string `validate:"omitempty,min=2,max=3,lowercase,alpha"`
 	PublishedAt *time.Time
 }
 
@@ -31,13 +33,15 @@ type PostCreate struct {
 	repo     PostCreateRepository
 	validate *validator.Validate
 	clock    commondomain.Clock
+	renderer ContentRenderer
 }
 
-func NewPostCreate(repo PostCreateRepository, clock commondomain.Clock) *PostCreate {
+func NewPostCreate(repo PostCreateRepository, clock commondomain.Clock, renderer ContentRenderer) *PostCreate {
 	return &PostCreate{
 		repo:     repo,
 		validate: validator.New(),
 		clock:    clock,
+		renderer: renderer,
 	}
 }
 
@@ -50,13 +54,29 @@ func (s *PostCreate) Execute(ctx context.Context, req *PostCreateRequest) (*Post
 		return nil, fmt.Errorf("%w: %v", ErrInvalidPost, err)
 	}
 
+	format := req.Format
+	if format == "" {
+		format = ContentFormatMarkdown
+	}
+	if !validContentFormat(format) {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPost, format)
+	}
+
+	html, err := s.renderer.Render(format, req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("could not render post: %w", err)
+	}
+
 	now := s.clock.Now()
 	post := &Post{
 		ID:          req.ID,
 		Namespace:   req.Namespace,
 		Slug:        req.Slug,
 		Title:       req.Title,
+		Format:      format,
 		Content:     req.Content,
+		HTML:        html,
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 		CreatedAt:   now,
 		UpdatedAt:   now,
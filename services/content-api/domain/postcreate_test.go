@@ -36,7 +36,7 @@ func TestPostCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 		id := uuid.New()
 		publishedAt := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
 
@@ -55,7 +55,9 @@ func TestPostCreate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "hello-world",
 			Title:       "Hello World",
+			Format:      contentdomain.ContentFormatMarkdown,
 			Content:     "Post content here",
+			HTML:        "Post content here",
 			PublishedAt: &publishedAt,
 			CreatedAt:   now,
 			UpdatedAt:   now,
@@ -65,7 +67,7 @@ func TestPostCreate_Execute(t *testing.T) {
 
 	t.Run("returns forbidden when not admin", func(t *testing.T) {
 		repo := &mockPostCreateRepo{}
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(userContext(), &contentdomain.PostCreateRequest{
 			ID:        uuid.New(),
@@ -80,7 +82,7 @@ func TestPostCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing slug", func(t *testing.T) {
 		repo := &mockPostCreateRepo{}
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PostCreateRequest{
 			ID:        uuid.New(),
@@ -94,7 +96,7 @@ func TestPostCreate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - uppercase slug", func(t *testing.T) {
 		repo := &mockPostCreateRepo{}
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PostCreateRequest{
 			ID:        uuid.New(),
@@ -115,7 +117,7 @@ func TestPostCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PostCreateRequest{
 			ID:        uuid.New(),
@@ -135,7 +137,7 @@ func TestPostCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), &contentdomain.PostCreateRequest{
 			ID:        uuid.New(),
@@ -157,7 +159,7 @@ func TestPostCreate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostCreate(repo, clock)
+		svc := contentdomain.NewPostCreate(repo, clock, &mockRenderer{})
 		id := uuid.New()
 
 		resp, err := svc.Execute(adminContext(), &contentdomain.PostCreateRequest{
@@ -174,7 +176,9 @@ func TestPostCreate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "draft-post",
 			Title:       "Draft Post",
+			Format:      contentdomain.ContentFormatMarkdown,
 			Content:     "Draft content",
+			HTML:        "Draft content",
 			PublishedAt: nil,
 			CreatedAt:   now,
 			UpdatedAt:   now,
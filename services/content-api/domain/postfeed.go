@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// maxPostFeedItems bounds the number of posts a feed can be requested with.
+const maxPostFeedItems = 100
+
+type PostFeedRepository interface {
+	// ListPostFeedEntries returns the posts published at or before now, the
+	// latest first.
+	ListPostFeedEntries(ctx context.Context, namespace string, now time.Time, limit int) ([]PostFeedEntry, error)
+}
+
+// PostFeedEntry is a published post as syndicated in a feed.
+type PostFeedEntry struct {
+	ID          uuid.UUID
+	Slug        string
+	Title       string
+	HTML        string
+	URL         string
+	PublishedAt time.Time
+	// UpdatedAt is the time the current version of the post was written, or
+	// the publication time if it was written before that.
+	UpdatedAt time.Time
+}
+
+// PostFeedConfig describes the site the feeds link to.
+type PostFeedConfig struct {
+	Title string
+	// SiteURL is the URL of the blog, posts are linked as <SiteURL>/posts/<slug>.
+	SiteURL          string
+	DefaultItemCount int
+}
+
+type PostFeedRequest struct {
+	Namespace string `validate:"required"`
+	// ItemCount falls back to the configured default when zero.
+	ItemCount int `validate:"gte=0"`
+}
+
+type PostFeedResponse struct {
+	Title   string
+	SiteURL string
+	// UpdatedAt is the latest update of any entry, it is zero for an empty feed.
+	UpdatedAt time.Time
+	Entries   []PostFeedEntry
+}
+
+type PostFeed struct {
+	repo     PostFeedRepository
+	validate *validator.Validate
+	clock    commondomain.Clock
+	config   PostFeedConfig
+}
+
+func NewPostFeed(repo PostFeedRepository, clock commondomain.Clock, config PostFeedConfig) *PostFeed {
+	config.SiteURL = strings.TrimSuffix(config.SiteURL, "/")
+	if config.DefaultItemCount <= 0 {
+		config.DefaultItemCount = 20
+	}
+	return &PostFeed{
+		repo:     repo,
+		validate: validator.New(),
+		clock:    clock,
+		config:   config,
+	}
+}
+
+func (s *PostFeed) Execute(ctx context.Context, req *PostFeedRequest) (*PostFeedResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestInvalid, err)
+	}
+
+	itemCount := req.ItemCount
+	if itemCount == 0 {
+		itemCount = s.config.DefaultItemCount
+	}
+	if itemCount > maxPostFeedItems {
+		itemCount = maxPostFeedItems
+	}
+
+	entries, err := s.repo.ListPostFeedEntries(ctx, req.Namespace, s.clock.Now(), itemCount)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PostFeedResponse{
+		Title:   s.config.Title,
+		SiteURL: s.config.SiteURL,
+		Entries: entries,
+	}
+	for i := range res.Entries {
+		entry := &res.Entries[i]
+		entry.URL = s.config.SiteURL + "/posts/" + entry.Slug
+		if entry.UpdatedAt.Before(entry.PublishedAt) {
+			entry.UpdatedAt = entry.PublishedAt
+		}
+		if entry.UpdatedAt.After(res.UpdatedAt) {
+			res.UpdatedAt = entry.UpdatedAt
+		}
+	}
+
+	return res, nil
+}
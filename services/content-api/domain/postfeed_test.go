@@ -0,0 +1,120 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockPostFeedRepo struct {
+	listPostFeedEntriesFn func(ctx context.Context, namespace string, now time.Time, limit int) ([]contentdomain.PostFeedEntry, error)
+}
+
+func (m *mockPostFeedRepo) ListPostFeedEntries(ctx context.Context, namespace string, now time.Time, limit int) ([]contentdomain.PostFeedEntry, error) {
+	if m.listPostFeedEntriesFn != nil {
+		return m.listPostFeedEntriesFn(ctx, namespace, now, limit)
+	}
+	return nil, nil
+}
+
+func TestPostFeed_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	config := contentdomain.PostFeedConfig{
+		Title:            "Tadoku Blog",
+		SiteURL:          "https://tadoku.app/blog/",
+		DefaultItemCount: 10,
+	}
+
+	t.Run("builds feed from published posts", func(t *testing.T) {
+		publishedAt := now.Add(-48 * time.Hour)
+		editedAt := now.Add(-time.Hour)
+		var gotNow time.Time
+		repo := &mockPostFeedRepo{
+			listPostFeedEntriesFn: func(ctx context.Context, namespace string, at time.Time, limit int) ([]contentdomain.PostFeedEntry, error) {
+				gotNow = at
+				assert.Equal(t, "blog", namespace)
+				return []contentdomain.PostFeedEntry{
+					{ID: uuid.New(), Slug: "edited", PublishedAt: publishedAt, UpdatedAt: editedAt},
+					// Written before it was scheduled to be published.
+					{ID: uuid.New(), Slug: "scheduled", PublishedAt: publishedAt, UpdatedAt: publishedAt.Add(-time.Hour)},
+				}, nil
+			},
+		}
+		svc := contentdomain.NewPostFeed(repo, clock, config)
+
+		feed, err := svc.Execute(ctx, &contentdomain.PostFeedRequest{Namespace: "blog"})
+
+		require.NoError(t, err)
+		assert.Equal(t, now, gotNow)
+		assert.Equal(t, "Tadoku Blog", feed.Title)
+		assert.Equal(t, "https://tadoku.app/blog", feed.SiteURL)
+		assert.Equal(t, editedAt, feed.UpdatedAt)
+		require.Len(t, feed.Entries, 2)
+		assert.Equal(t, "https://tadoku.app/blog/posts/edited", feed.Entries[0].URL)
+		assert.Equal(t, editedAt, feed.Entries[0].UpdatedAt)
+		assert.Equal(t, publishedAt, feed.Entries[1].UpdatedAt)
+	})
+
+	t.Run("uses configured item count by default", func(t *testing.T) {
+		var gotLimit int
+		repo := &mockPostFeedRepo{
+			listPostFeedEntriesFn: func(ctx context.Context, namespace string, at time.Time, limit int) ([]contentdomain.PostFeedEntry, error) {
+				gotLimit = limit
+				return nil, nil
+			},
+		}
+		svc := contentdomain.NewPostFeed(repo, clock, config)
+
+		feed, err := svc.Execute(ctx, &contentdomain.PostFeedRequest{Namespace: "blog"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, gotLimit)
+		assert.True(t, feed.UpdatedAt.IsZero())
+	})
+
+	t.Run("caps item count", func(t *testing.T) {
+		var gotLimit int
+		repo := &mockPostFeedRepo{
+			listPostFeedEntriesFn: func(ctx context.Context, namespace string, at time.Time, limit int) ([]contentdomain.PostFeedEntry, error) {
+				gotLimit = limit
+				return nil, nil
+			},
+		}
+		svc := contentdomain.NewPostFeed(repo, clock, config)
+
+		_, err := svc.Execute(ctx, &contentdomain.PostFeedRequest{Namespace: "blog", ItemCount: 1000})
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, gotLimit)
+	})
+
+	t.Run("returns error on invalid request", func(t *testing.T) {
+		svc := contentdomain.NewPostFeed(&mockPostFeedRepo{}, clock, config)
+
+		_, err := svc.Execute(ctx, &contentdomain.PostFeedRequest{Namespace: "blog", ItemCount: -1})
+
+		assert.ErrorIs(t, err, contentdomain.ErrRequestInvalid)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		repo := &mockPostFeedRepo{
+			listPostFeedEntriesFn: func(ctx context.Context, namespace string, at time.Time, limit int) ([]contentdomain.PostFeedEntry, error) {
+				return nil, repoErr
+			},
+		}
+		svc := contentdomain.NewPostFeed(repo, clock, config)
+
+		_, err := svc.Execute(ctx, &contentdomain.PostFeedRequest{Namespace: "blog"})
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
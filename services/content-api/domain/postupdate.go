@@ -20,7 +20,9 @@ type PostUpdateRequest struct {
 	Namespace   string `validate:"required"`
 	Slug        string `validate:"required,gt=1,lowercase"`
 	Title       string `validate:"required"`
-	Content     string `validate:"required"`
+	Format      ContentFormat
+	Content     string  `validate:"required"`
+	Language    *string `validate:"omitempty,min=2,max=3,lowercase,alpha"`
 	PublishedAt *time.Time
 }
 
@@ -32,13 +34,15 @@ type PostUpdate struct {
 	repo     PostUpdateRepository
 	validate *validator.Validate
 	clock    commondomain.Clock
+	renderer ContentRenderer
 }
 
-func NewPostUpdate(repo PostUpdateRepository, clock commondomain.Clock) *PostUpdate {
+func NewPostUpdate(repo PostUpdateRepository, clock commondomain.Clock, renderer ContentRenderer) *PostUpdate {
 	return &PostUpdate{
 		repo:     repo,
 		validate: validator.New(),
 		clock:    clock,
+		renderer: renderer,
 	}
 }
 
@@ -51,17 +55,37 @@ func (s *PostUpdate) Execute(ctx context.Context, id uuid.UUID, req *PostUpdateR
 		return nil, fmt.Errorf("%w: %v", ErrInvalidPost, err)
 	}
 
+	format := req.Format
+	if format == "" {
+		format = ContentFormatMarkdown
+	}
+	if !validContentFormat(format) {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPost, format)
+	}
+
 	post, err := s.repo.GetPostByID(ctx, id, req.Namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	contentChanged := post.Title != req.Title || post.Content != req.Content
+	contentChanged := post.Title != req.Title ||
+		post.Format != format ||
+		post.Content != req.Content ||
+		!sameLanguage(post.Language, req.Language)
+
+	if contentChanged {
+		post.HTML, err = s.renderer.Render(format, req.Content)
+		if err != nil {
+			return nil, fmt.Errorf("could not render post: %w", err)
+		}
+	}
 
 	post.Namespace = req.Namespace
 	post.Slug = req.Slug
 	post.Title = req.Title
+	post.Format = format
 	post.Content = req.Content
+	post.Language = req.Language
 	post.PublishedAt = req.PublishedAt
 	post.UpdatedAt = s.clock.Now()
 
@@ -51,7 +51,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatMarkdown,
 			Content:   "Old content",
+			HTML:      "Old content",
 			CreatedAt: createdAt,
 			UpdatedAt: createdAt,
 		}
@@ -67,7 +69,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 		publishedAt := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
 
 		resp, err := svc.Execute(adminContext(), id, &contentdomain.PostUpdateRequest{
@@ -84,7 +86,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "new-slug",
 			Title:       "New Title",
+			Format:      contentdomain.ContentFormatMarkdown,
 			Content:     "New content",
+			HTML:        "New content",
 			PublishedAt: &publishedAt,
 			CreatedAt:   createdAt,
 			UpdatedAt:   now,
@@ -94,7 +98,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 
 	t.Run("returns forbidden when not admin", func(t *testing.T) {
 		repo := &mockPostUpdateRepo{}
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(userContext(), uuid.New(), &contentdomain.PostUpdateRequest{
 			Namespace: "blog",
@@ -108,7 +112,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 
 	t.Run("returns error on invalid request - missing slug", func(t *testing.T) {
 		repo := &mockPostUpdateRepo{}
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), uuid.New(), &contentdomain.PostUpdateRequest{
 			Namespace: "blog",
@@ -126,7 +130,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), uuid.New(), &contentdomain.PostUpdateRequest{
 			Namespace: "blog",
@@ -145,7 +149,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatMarkdown,
 			Content:   "Old content",
+			HTML:      "Old content",
 		}
 
 		repoErr := errors.New("database connection failed")
@@ -158,7 +164,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PostUpdateRequest{
 			Namespace: "blog",
@@ -177,7 +183,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "test-post",
 			Title:     "Old Title",
+			Format:    contentdomain.ContentFormatMarkdown,
 			Content:   "Old content",
+			HTML:      "Old content",
 		}
 
 		var calledUpdatePost, calledUpdateMetadata bool
@@ -195,7 +203,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PostUpdateRequest{
 			Namespace: "blog",
@@ -216,7 +224,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace: "blog",
 			Slug:      "old-slug",
 			Title:     "Same Title",
+			Format:    contentdomain.ContentFormatMarkdown,
 			Content:   "Same content",
+			HTML:      "Same content",
 		}
 
 		var calledUpdatePost, calledUpdateMetadata bool
@@ -234,7 +244,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 		publishedAt := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
 
 		_, err := svc.Execute(adminContext(), id, &contentdomain.PostUpdateRequest{
@@ -259,7 +269,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "published-post",
 			Title:       "Published Post",
+			Format:      contentdomain.ContentFormatMarkdown,
 			Content:     "Content",
+			HTML:        "Content",
 			PublishedAt: &publishedAt,
 			CreatedAt:   createdAt,
 			UpdatedAt:   createdAt,
@@ -276,7 +288,7 @@ func TestPostUpdate_Execute(t *testing.T) {
 			},
 		}
 
-		svc := contentdomain.NewPostUpdate(repo, clock)
+		svc := contentdomain.NewPostUpdate(repo, clock, &mockRenderer{})
 
 		resp, err := svc.Execute(adminContext(), id, &contentdomain.PostUpdateRequest{
 			Namespace:   "blog",
@@ -292,7 +304,9 @@ func TestPostUpdate_Execute(t *testing.T) {
 			Namespace:   "blog",
 			Slug:        "published-post",
 			Title:       "Published Post",
+			Format:      contentdomain.ContentFormatMarkdown,
 			Content:     "Content",
+			HTML:        "Content",
 			PublishedAt: nil,
 			CreatedAt:   createdAt,
 			UpdatedAt:   now,
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PostVersionDiffRepository interface {
+	GetPostVersion(ctx context.Context, postID uuid.UUID, contentID uuid.UUID) (*PostVersion, error)
+}
+
+// PostVersionDiff compares two versions of a post.
+type PostVersionDiff struct {
+	repo PostVersionDiffRepository
+}
+
+func NewPostVersionDiff(repo PostVersionDiffRepository) *PostVersionDiff {
+	return &PostVersionDiff{
+		repo: repo,
+	}
+}
+
+func (s *PostVersionDiff) Execute(ctx context.Context, postID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) (*ContentDiff, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	from, err := s.repo.GetPostVersion(ctx, postID, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.repo.GetPostVersion(ctx, postID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newContentDiff(from.ID, to.ID, from.Title, to.Title, from.Content, to.Content), nil
+}
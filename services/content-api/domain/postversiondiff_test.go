@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockPostVersionDiffRepo struct {
+	versions map[uuid.UUID]*contentdomain.PostVersion
+}
+
+func (m *mockPostVersionDiffRepo) GetPostVersion(ctx context.Context, postID uuid.UUID, contentID uuid.UUID) (*contentdomain.PostVersion, error) {
+	v, ok := m.versions[contentID]
+	if !ok {
+		return nil, contentdomain.ErrPostNotFound
+	}
+	return v, nil
+}
+
+func TestPostVersionDiff_Execute(t *testing.T) {
+	postID := uuid.New()
+	fromID := uuid.New()
+	toID := uuid.New()
+
+	diffPost := func(t *testing.T, from, to *contentdomain.PostVersion) *contentdomain.ContentDiff {
+		t.Helper()
+		from.ID = fromID
+		to.ID = toID
+		repo := &mockPostVersionDiffRepo{versions: map[uuid.UUID]*contentdomain.PostVersion{
+			fromID: from,
+			toID:   to,
+		}}
+
+		diff, err := contentdomain.NewPostVersionDiff(repo).Execute(adminContext(), postID, fromID, toID)
+		require.NoError(t, err)
+		return diff
+	}
+
+	t.Run("diffs title and content by word", func(t *testing.T) {
+		diff := diffPost(t,
+			&contentdomain.PostVersion{Title: "Hello world", Content: "<p>Read every day</p>"},
+			&contentdomain.PostVersion{Title: "Hello Tadoku", Content: "<p>Read a book every day</p>"},
+		)
+
+		assert.Equal(t, fromID, diff.FromID)
+		assert.Equal(t, toID, diff.ToID)
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "Hello "},
+			{Op: contentdomain.DiffOpDelete, Text: "world"},
+			{Op: contentdomain.DiffOpInsert, Text: "Tadoku"},
+		}, diff.Title)
+		assert.Equal(t, []contentdomain.DiffSegment{
+			{Op: contentdomain.DiffOpEqual, Text: "<p>Read "},
+			{Op: contentdomain.DiffOpInsert, Text: "a book "},
+			{Op: contentdomain.DiffOpEqual, Text: "every day</p>"},
+		}, diff.Body)
+		assert.Equal(t, "&lt;p&gt;Read <ins>a book </ins>every day&lt;/p&gt;", diff.BodyHTML)
+	})
+
+	t.Run("returns not found for unknown version", func(t *testing.T) {
+		repo := &mockPostVersionDiffRepo{versions: map[uuid.UUID]*contentdomain.PostVersion{}}
+
+		_, err := contentdomain.NewPostVersionDiff(repo).Execute(adminContext(), postID, fromID, toID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrPostNotFound)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		repo := &mockPostVersionDiffRepo{}
+
+		_, err := contentdomain.NewPostVersionDiff(repo).Execute(userContext(), postID, fromID, toID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+}
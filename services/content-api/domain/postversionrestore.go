@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type PostVersionRestoreRepository interface {
+	GetPostByID(ctx context.Context, id uuid.UUID, namespace string) (*Post, error)
+	GetPostVersion(ctx context.Context, postID uuid.UUID, contentID uuid.UUID) (*PostVersion, error)
+	RestorePostVersion(ctx context.Context, post *Post, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error
+}
+
+// PostVersionRestore makes an older version the current content of a post.
+// The version is copied into a new version, so the history stays intact.
+type PostVersionRestore struct {
+	repo     PostVersionRestoreRepository
+	clock    commondomain.Clock
+	renderer ContentRenderer
+}
+
+func NewPostVersionRestore(repo PostVersionRestoreRepository, clock commondomain.Clock, renderer ContentRenderer) *PostVersionRestore {
+	return &PostVersionRestore{
+		repo:     repo,
+		clock:    clock,
+		renderer: renderer,
+	}
+}
+
+func (s *PostVersionRestore) Execute(ctx context.Context, namespace string, postID uuid.UUID, contentID uuid.UUID) (*Post, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := sessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.repo.GetPostByID(ctx, postID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.repo.GetPostVersion(ctx, postID, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rendered again as the rules may have changed since the version was saved
+	html, err := s.renderer.Render(version.Format, version.Content)
+	if err != nil {
+		return nil, fmt.Errorf("could not render post: %w", err)
+	}
+
+	post.Title = version.Title
+	post.Format = version.Format
+	post.Content = version.Content
+	post.HTML = html
+	post.Language = version.Language
+	post.UpdatedAt = s.clock.Now()
+
+	if err := s.repo.RestorePostVersion(ctx, post, version.ID, userID); err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
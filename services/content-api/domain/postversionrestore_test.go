@@ -0,0 +1,154 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/common/testutil/authzctx"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type mockPostVersionRestoreRepo struct {
+	getPostByIDFn        func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Post, error)
+	getPostVersionFn     func(ctx context.Context, postID uuid.UUID, contentID uuid.UUID) (*contentdomain.PostVersion, error)
+	restorePostVersionFn func(ctx context.Context, post *contentdomain.Post, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error
+}
+
+func (m *mockPostVersionRestoreRepo) GetPostByID(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Post, error) {
+	return m.getPostByIDFn(ctx, id, namespace)
+}
+
+func (m *mockPostVersionRestoreRepo) GetPostVersion(ctx context.Context, postID uuid.UUID, contentID uuid.UUID) (*contentdomain.PostVersion, error) {
+	return m.getPostVersionFn(ctx, postID, contentID)
+}
+
+func (m *mockPostVersionRestoreRepo) RestorePostVersion(ctx context.Context, post *contentdomain.Post, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error {
+	if m.restorePostVersionFn != nil {
+		return m.restorePostVersionFn(ctx, post, restoredFromID, restoredByUserID)
+	}
+	return nil
+}
+
+func TestPostVersionRestore_Execute(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	postID := uuid.New()
+	contentID := uuid.New()
+	adminID := uuid.New()
+
+	existingPost := func() *contentdomain.Post {
+		return &contentdomain.Post{
+			ID:        postID,
+			Namespace: "blog",
+			Slug:      "about",
+			Title:     "Current title",
+			Format:    contentdomain.ContentFormatMarkdown,
+			Content:   "<p>Current</p>",
+			HTML:      "<p>Current</p>",
+			CreatedAt: now.Add(-48 * time.Hour),
+			UpdatedAt: now.Add(-24 * time.Hour),
+		}
+	}
+	oldVersion := &contentdomain.PostVersion{
+		ID:      contentID,
+		Title:   "Old title",
+		Format:  contentdomain.ContentFormatMarkdown,
+		Content: "<p>Old</p>",
+		HTML:    "<p>Old</p>",
+	}
+
+	t.Run("restores version as new content", func(t *testing.T) {
+		var restored *contentdomain.Post
+		var restoredFrom, restoredBy uuid.UUID
+		repo := &mockPostVersionRestoreRepo{
+			getPostByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Post, error) {
+				assert.Equal(t, "blog", namespace)
+				return existingPost(), nil
+			},
+			getPostVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PostVersion, error) {
+				assert.Equal(t, postID, pID)
+				assert.Equal(t, contentID, cID)
+				return oldVersion, nil
+			},
+			restorePostVersionFn: func(ctx context.Context, post *contentdomain.Post, fromID uuid.UUID, byID uuid.UUID) error {
+				restored = post
+				restoredFrom = fromID
+				restoredBy = byID
+				return nil
+			},
+		}
+
+		svc := contentdomain.NewPostVersionRestore(repo, clock, &mockRenderer{})
+		post, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", postID, contentID)
+
+		require.NoError(t, err)
+		require.NotNil(t, restored)
+		assert.Equal(t, "Old title", post.Title)
+		assert.Equal(t, "<p>Old</p>", post.Content)
+		assert.Equal(t, "about", post.Slug)
+		assert.Equal(t, now, post.UpdatedAt)
+		assert.Equal(t, contentID, restoredFrom)
+		assert.Equal(t, adminID, restoredBy)
+	})
+
+	t.Run("returns forbidden when not admin", func(t *testing.T) {
+		svc := contentdomain.NewPostVersionRestore(&mockPostVersionRestoreRepo{}, clock, &mockRenderer{})
+
+		_, err := svc.Execute(userContext(), "blog", postID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrForbidden)
+	})
+
+	t.Run("returns unauthorized when no session", func(t *testing.T) {
+		svc := contentdomain.NewPostVersionRestore(&mockPostVersionRestoreRepo{}, clock, &mockRenderer{})
+
+		_, err := svc.Execute(context.Background(), "blog", postID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrUnauthorized)
+	})
+
+	t.Run("returns not found for unknown version", func(t *testing.T) {
+		repo := &mockPostVersionRestoreRepo{
+			getPostByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Post, error) {
+				return existingPost(), nil
+			},
+			getPostVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PostVersion, error) {
+				return nil, contentdomain.ErrPostNotFound
+			},
+			restorePostVersionFn: func(ctx context.Context, post *contentdomain.Post, fromID uuid.UUID, byID uuid.UUID) error {
+				t.Fatal("should not restore")
+				return nil
+			},
+		}
+
+		svc := contentdomain.NewPostVersionRestore(repo, clock, &mockRenderer{})
+		_, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", postID, contentID)
+
+		assert.ErrorIs(t, err, contentdomain.ErrPostNotFound)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		repo := &mockPostVersionRestoreRepo{
+			getPostByIDFn: func(ctx context.Context, id uuid.UUID, namespace string) (*contentdomain.Post, error) {
+				return existingPost(), nil
+			},
+			getPostVersionFn: func(ctx context.Context, pID uuid.UUID, cID uuid.UUID) (*contentdomain.PostVersion, error) {
+				return oldVersion, nil
+			},
+			restorePostVersionFn: func(ctx context.Context, post *contentdomain.Post, fromID uuid.UUID, byID uuid.UUID) error {
+				return repoErr
+			},
+		}
+
+		svc := contentdomain.NewPostVersionRestore(repo, clock, &mockRenderer{})
+		_, err := svc.Execute(authzctx.AdminSubject(adminID.String()), "blog", postID, contentID)
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
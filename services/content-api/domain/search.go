@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// searchConfigs maps ISO 639-1 codes to the Postgres text search
+// configurations that stem them. Other languages, e.g. Japanese or Chinese,
+// use the simple configuration.
+var searchConfigs = map[string]string{
+	"ar": "arabic",
+	"da": "danish",
+	"de": "german",
+	"el": "greek",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"ga": "irish",
+	"hu": "hungarian",
+	"id": "indonesian",
+	"it": "italian",
+	"lt": "lithuanian",
+	"ne": "nepali",
+	"nl": "dutch",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"ta": "tamil",
+	"tr": "turkish",
+}
+
+// SearchConfig returns the text search configuration for content written in
+// the given language.
+func SearchConfig(language *string) string {
+	if language != nil {
+		if config, ok := searchConfigs[*language]; ok {
+			return config
+		}
+	}
+	return "simple"
+}
+
+func sameLanguage(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SearchText returns the text readers see in the given html, which is what
+// search indexes and cuts snippets from. Entities are decoded, the contents of
+// scripts and styles are dropped and tags separate words.
+func SearchText(source string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(source))
+	var text strings.Builder
+	hidden := 0
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return strings.Join(strings.Fields(text.String()), " ")
+		case html.TextToken:
+			if hidden == 0 {
+				text.Write(tokenizer.Text())
+			}
+		case html.StartTagToken:
+			if name, _ := tokenizer.TagName(); hiddenElement(string(name)) {
+				hidden++
+			}
+			text.WriteByte(' ')
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); hiddenElement(string(name)) && hidden > 0 {
+				hidden--
+			}
+			text.WriteByte(' ')
+		case html.SelfClosingTagToken:
+			text.WriteByte(' ')
+		}
+	}
+}
+
+func hiddenElement(name string) bool {
+	switch name {
+	case "script", "style", "template", "noscript":
+		return true
+	}
+	return false
+}
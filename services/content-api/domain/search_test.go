@@ -0,0 +1,22 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	contentdomain "github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+func TestSearchText(t *testing.T) {
+	t.Run("decodes entities and separates words at tags", func(t *testing.T) {
+		text := contentdomain.SearchText("<p>Tom &amp; Jerry</p><p>read<br>books</p>")
+
+		assert.Equal(t, "Tom & Jerry read books", text)
+	})
+
+	t.Run("drops scripts and styles", func(t *testing.T) {
+		text := contentdomain.SearchText(`<style>p { color: red; }</style><p>Hello</p><script>alert("hi")</script>`)
+
+		assert.Equal(t, "Hello", text)
+	})
+}
@@ -0,0 +1,27 @@
+package rest
+
+import (
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+func contentDiffToAPI(diff *domain.ContentDiff) openapi.ContentDiff {
+	return openapi.ContentDiff{
+		FromId:   diff.FromID,
+		ToId:     diff.ToID,
+		Title:    diffSegmentsToAPI(diff.Title),
+		Body:     diffSegmentsToAPI(diff.Body),
+		BodyHtml: diff.BodyHTML,
+	}
+}
+
+func diffSegmentsToAPI(segments []domain.DiffSegment) []openapi.DiffSegment {
+	res := make([]openapi.DiffSegment, len(segments))
+	for i, segment := range segments {
+		res[i] = openapi.DiffSegment{
+			Op:   string(segment.Op),
+			Text: segment.Text,
+		}
+	}
+	return res
+}
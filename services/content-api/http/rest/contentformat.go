@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+// contentFormatFromAPI leaves the format empty when omitted, the domain picks
+// the default for the content type.
+func contentFormatFromAPI(format *string) domain.ContentFormat {
+	if format == nil {
+		return ""
+	}
+	return domain.ContentFormat(*format)
+}
+
+func contentFormatToAPI(format domain.ContentFormat) *string {
+	res := string(format)
+	return &res
+}
+
+// pageSource returns the source of a page, clients that don't send one yet
+// send the html instead.
+func pageSource(req *openapi.Page) string {
+	if req.Source != nil {
+		return *req.Source
+	}
+	if req.Html != nil {
+		return *req.Html
+	}
+	return ""
+}
+
+// postContent returns the content of a post, which is optional in the schema
+// as readers are not sent it.
+func postContent(req *openapi.Post) string {
+	if req.Content != nil {
+		return *req.Content
+	}
+	return ""
+}
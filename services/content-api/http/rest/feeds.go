@@ -0,0 +1,263 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+// feedCacheControl lets readers and proxies reuse a feed for a while, they
+// revalidate with the ETag afterwards.
+const feedCacheControl = "public, max-age=300"
+
+// QUERIES
+
+// Gets the published posts as an RSS 2.0 feed
+// (GET /feeds/{namespace}/rss.xml)
+func (s *Server) PostFeedRSS(ctx echo.Context, namespace string, params openapi.PostFeedRSSParams) error {
+	return s.servePostFeed(ctx, namespace, params.Items, params.IfNoneMatch, params.IfModifiedSince, "application/rss+xml; charset=utf-8", renderRSSFeed)
+}
+
+// Gets the published posts as an Atom feed
+// (GET /feeds/{namespace}/atom.xml)
+func (s *Server) PostFeedAtom(ctx echo.Context, namespace string, params openapi.PostFeedAtomParams) error {
+	return s.servePostFeed(ctx, namespace, params.Items, params.IfNoneMatch, params.IfModifiedSince, "application/atom+xml; charset=utf-8", renderAtomFeed)
+}
+
+// Gets the published posts as a JSON Feed
+// (GET /feeds/{namespace}/feed.json)
+func (s *Server) PostFeedJSON(ctx echo.Context, namespace string, params openapi.PostFeedJSONParams) error {
+	return s.servePostFeed(ctx, namespace, params.Items, params.IfNoneMatch, params.IfModifiedSince, "application/feed+json; charset=utf-8", renderJSONFeed)
+}
+
+func (s *Server) servePostFeed(
+	ctx echo.Context,
+	namespace string,
+	items *int,
+	ifNoneMatch *string,
+	ifModifiedSince *string,
+	contentType string,
+	render func(*domain.PostFeedResponse) ([]byte, error),
+) error {
+	itemCount := 0
+	if items != nil {
+		itemCount = *items
+	}
+
+	feed, err := s.postFeed.Execute(ctx.Request().Context(), &domain.PostFeedRequest{
+		Namespace: namespace,
+		ItemCount: itemCount,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	body, err := render(feed)
+	if err != nil {
+		ctx.Echo().Logger.Error("could not render feed: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := ctx.Response().Header()
+	header.Set("ETag", etag)
+	header.Set(echo.HeaderCacheControl, feedCacheControl)
+	if !feed.UpdatedAt.IsZero() {
+		header.Set(echo.HeaderLastModified, feed.UpdatedAt.UTC().Format(http.TimeFormat))
+	}
+
+	if feedNotModified(etag, feed.UpdatedAt, ifNoneMatch, ifModifiedSince) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, body)
+}
+
+// feedNotModified evaluates the conditional request headers, If-None-Match
+// takes precedence over If-Modified-Since as required by RFC 9110.
+func feedNotModified(etag string, updatedAt time.Time, ifNoneMatch *string, ifModifiedSince *string) bool {
+	if ifNoneMatch != nil {
+		for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince != nil && !updatedAt.IsZero() {
+		since, err := http.ParseTime(*ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !updatedAt.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// feedEntryID identifies an entry independently of its slug, which may change.
+func feedEntryID(entry *domain.PostFeedEntry) string {
+	return "urn:uuid:" + entry.ID.String()
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func renderRSSFeed(feed *domain.PostFeedResponse) ([]byte, error) {
+	res := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.SiteURL,
+			Description: feed.Title,
+			Items:       make([]rssItem, len(feed.Entries)),
+		},
+	}
+	if !feed.UpdatedAt.IsZero() {
+		res.Channel.LastBuildDate = feed.UpdatedAt.UTC().Format(time.RFC1123Z)
+	}
+	for i := range feed.Entries {
+		entry := &feed.Entries[i]
+		res.Channel.Items[i] = rssItem{
+			Title:       entry.Title,
+			Link:        entry.URL,
+			GUID:        rssGUID{Value: feedEntryID(entry)},
+			PubDate:     entry.PublishedAt.UTC().Format(time.RFC1123Z),
+			Description: entry.HTML,
+		}
+	}
+
+	return marshalFeedXML(res)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func renderAtomFeed(feed *domain.PostFeedResponse) ([]byte, error) {
+	res := atomFeed{
+		ID:      feed.SiteURL,
+		Title:   feed.Title,
+		Updated: feed.UpdatedAt.UTC().Format(time.RFC3339),
+		Link:    atomLink{Href: feed.SiteURL, Rel: "alternate"},
+		Author:  atomAuthor{Name: feed.Title},
+		Entries: make([]atomEntry, len(feed.Entries)),
+	}
+	for i := range feed.Entries {
+		entry := &feed.Entries[i]
+		res.Entries[i] = atomEntry{
+			ID:        feedEntryID(entry),
+			Title:     entry.Title,
+			Link:      atomLink{Href: entry.URL, Rel: "alternate"},
+			Published: entry.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:   entry.UpdatedAt.UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: entry.HTML},
+		}
+	}
+
+	return marshalFeedXML(res)
+}
+
+func marshalFeedXML(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func renderJSONFeed(feed *domain.PostFeedResponse) ([]byte, error) {
+	res := openapi.JSONFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageUrl: &feed.SiteURL,
+		Items:       make([]openapi.JSONFeedItem, len(feed.Entries)),
+	}
+	for i := range feed.Entries {
+		entry := &feed.Entries[i]
+		res.Items[i] = openapi.JSONFeedItem{
+			Id:            feedEntryID(entry),
+			Url:           &entry.URL,
+			Title:         &entry.Title,
+			ContentHtml:   &entry.HTML,
+			DatePublished: &entry.PublishedAt,
+			DateModified:  &entry.UpdatedAt,
+		}
+	}
+
+	return json.Marshal(res)
+}
@@ -0,0 +1,215 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+type postFeedRepositoryStub struct {
+	entries []domain.PostFeedEntry
+}
+
+func (s *postFeedRepositoryStub) ListPostFeedEntries(context.Context, string, time.Time, int) ([]domain.PostFeedEntry, error) {
+	// The service fills in the URLs, hand out a copy every time.
+	return append([]domain.PostFeedEntry{}, s.entries...), nil
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func newFeedTestServer() (openapi.ServerInterface, domain.PostFeedEntry) {
+	entry := domain.PostFeedEntry{
+		ID:          uuid.New(),
+		Slug:        "hello-world",
+		Title:       "Hello <World>",
+		HTML:        "<p>This is <strong>markdown</strong> &amp; more</p>",
+		PublishedAt: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 1, 12, 18, 30, 15, 0, time.UTC),
+	}
+	service := domain.NewPostFeed(
+		&postFeedRepositoryStub{entries: []domain.PostFeedEntry{entry}},
+		fixedClock{now: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		domain.PostFeedConfig{Title: "Tadoku Blog", SiteURL: "https://tadoku.app/blog"},
+	)
+	return newTestServer(service), entry
+}
+
+// newTestServer goes through NewServer, so a service it doesn't assign fails
+// the tests instead of panicking in production.
+func newTestServer(postFeed *domain.PostFeed) openapi.ServerInterface {
+	return NewServer(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		postFeed,
+		nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+	)
+}
+
+func executeFeedRequest(t *testing.T, handler func(echo.Context) error, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := echo.New()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	for k, v := range headers {
+		request.Header.Set(k, v)
+	}
+	recorder := httptest.NewRecorder()
+
+	require.NoError(t, handler(e.NewContext(request, recorder)))
+	return recorder
+}
+
+func TestPostFeedRSS(t *testing.T) {
+	server, entry := newFeedTestServer()
+
+	recorder := executeFeedRequest(t, func(ctx echo.Context) error {
+		return server.PostFeedRSS(ctx, "tadoku", openapi.PostFeedRSSParams{})
+	}, nil)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "Fri, 12 Jan 2024 18:30:15 GMT", recorder.Header().Get(echo.HeaderLastModified))
+	assert.NotEmpty(t, recorder.Header().Get("ETag"))
+
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &feed))
+	assert.Equal(t, "Tadoku Blog", feed.Channel.Title)
+	require.Len(t, feed.Channel.Items, 1)
+	item := feed.Channel.Items[0]
+	assert.Equal(t, entry.Title, item.Title)
+	assert.Equal(t, "https://tadoku.app/blog/posts/hello-world", item.Link)
+	assert.Equal(t, "urn:uuid:"+entry.ID.String(), item.GUID.Value)
+	assert.Equal(t, "Wed, 10 Jan 2024 09:00:00 +0000", item.PubDate)
+	assert.Equal(t, entry.HTML, item.Description)
+}
+
+func TestPostFeedAtom(t *testing.T) {
+	server, entry := newFeedTestServer()
+
+	recorder := executeFeedRequest(t, func(ctx echo.Context) error {
+		return server.PostFeedAtom(ctx, "tadoku", openapi.PostFeedAtomParams{})
+	}, nil)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &feed))
+	assert.Equal(t, "2024-01-12T18:30:15Z", feed.Updated)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, "2024-01-10T09:00:00Z", feed.Entries[0].Published)
+	assert.Equal(t, "2024-01-12T18:30:15Z", feed.Entries[0].Updated)
+	assert.Equal(t, "html", feed.Entries[0].Content.Type)
+	assert.Equal(t, entry.HTML, feed.Entries[0].Content.Value)
+}
+
+func TestPostFeedJSON(t *testing.T) {
+	server, entry := newFeedTestServer()
+
+	recorder := executeFeedRequest(t, func(ctx echo.Context) error {
+		return server.PostFeedJSON(ctx, "tadoku", openapi.PostFeedJSONParams{})
+	}, nil)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var feed openapi.JSONFeed
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &feed))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", feed.Version)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "urn:uuid:"+entry.ID.String(), feed.Items[0].Id)
+	require.NotNil(t, feed.Items[0].DateModified)
+	assert.True(t, entry.UpdatedAt.Equal(*feed.Items[0].DateModified))
+	require.NotNil(t, feed.Items[0].ContentHtml)
+	assert.Equal(t, entry.HTML, *feed.Items[0].ContentHtml)
+}
+
+func TestPostFeedConditionalGet(t *testing.T) {
+	server, _ := newFeedTestServer()
+	rss := func(ctx echo.Context) error {
+		return server.PostFeedRSS(ctx, "tadoku", openapi.PostFeedRSSParams{
+			IfNoneMatch:     nilIfEmpty(ctx.Request().Header.Get("If-None-Match")),
+			IfModifiedSince: nilIfEmpty(ctx.Request().Header.Get("If-Modified-Since")),
+		})
+	}
+	etag := executeFeedRequest(t, rss, nil).Header().Get("ETag")
+
+	t.Run("not modified when etag matches", func(t *testing.T) {
+		recorder := executeFeedRequest(t, rss, map[string]string{"If-None-Match": `"other", ` + etag})
+
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+		assert.Empty(t, recorder.Body.String())
+		assert.Equal(t, etag, recorder.Header().Get("ETag"))
+	})
+
+	t.Run("modified when etag differs", func(t *testing.T) {
+		recorder := executeFeedRequest(t, rss, map[string]string{
+			"If-None-Match":     `"other"`,
+			"If-Modified-Since": "Sat, 13 Jan 2024 00:00:00 GMT",
+		})
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("not modified since last update", func(t *testing.T) {
+		recorder := executeFeedRequest(t, rss, map[string]string{"If-Modified-Since": "Fri, 12 Jan 2024 18:30:15 GMT"})
+
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+	})
+
+	t.Run("modified after the given date", func(t *testing.T) {
+		recorder := executeFeedRequest(t, rss, map[string]string{"If-Modified-Since": "Fri, 12 Jan 2024 18:00:00 GMT"})
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("etags differ per format", func(t *testing.T) {
+		recorder := executeFeedRequest(t, func(ctx echo.Context) error {
+			return server.PostFeedAtom(ctx, "tadoku", openapi.PostFeedAtomParams{})
+		}, nil)
+
+		assert.NotEqual(t, etag, recorder.Header().Get("ETag"))
+	})
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func TestPostFeedRoutes(t *testing.T) {
+	server, entry := newFeedTestServer()
+
+	e := echo.New()
+	openapi.RegisterHandlersWithBaseURL(e, server, "")
+
+	for _, path := range []string{"/feeds/tadoku/rss.xml", "/feeds/tadoku/atom.xml", "/feeds/tadoku/feed.json"} {
+		t.Run(path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), entry.Slug)
+		})
+	}
+}
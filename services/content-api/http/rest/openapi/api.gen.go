@@ -56,25 +56,149 @@ type Announcements struct {
 	Announcements []Announcement `json:"announcements"`
 }
 
+// ContentDiff defines model for ContentDiff.
+type ContentDiff struct {
+	Body []DiffSegment `json:"body"`
+
+	// BodyHtml Escaped source of the body with changes marked by ins and del tags
+	BodyHtml string             `json:"body_html"`
+	FromId   openapi_types.UUID `json:"from_id"`
+	Title    []DiffSegment      `json:"title"`
+	ToId     openapi_types.UUID `json:"to_id"`
+}
+
+// ContentEvent defines model for ContentEvent.
+type ContentEvent struct {
+	ContentId openapi_types.UUID `json:"content_id"`
+
+	// ContentType One of page or post
+	ContentType string    `json:"content_type"`
+	Id          int64     `json:"id"`
+	Namespace   string    `json:"namespace"`
+	OccurredAt  time.Time `json:"occurred_at"`
+
+	// ScheduleId Schedule that caused the event
+	ScheduleId *openapi_types.UUID `json:"schedule_id,omitempty"`
+
+	// Type One of published or unpublished
+	Type string `json:"type"`
+}
+
+// ContentEvents defines model for ContentEvents.
+type ContentEvents struct {
+	Events []ContentEvent `json:"events"`
+}
+
+// ContentSchedule defines model for ContentSchedule.
+type ContentSchedule struct {
+	// Action One of publish or unpublish
+	Action    string             `json:"action"`
+	ContentId openapi_types.UUID `json:"content_id"`
+
+	// ContentType One of page or post
+	ContentType     string              `json:"content_type"`
+	CreatedAt       *time.Time          `json:"created_at,omitempty"`
+	CreatedByUserId *openapi_types.UUID `json:"created_by_user_id,omitempty"`
+	Id              *openapi_types.UUID `json:"id,omitempty"`
+	Namespace       *string             `json:"namespace,omitempty"`
+	ScheduledAt     time.Time           `json:"scheduled_at"`
+
+	// Slug Slug of the scheduled content, only set when listing
+	Slug *string `json:"slug,omitempty"`
+
+	// Title Title of the scheduled content, only set when listing
+	Title *string `json:"title,omitempty"`
+}
+
+// ContentSchedules defines model for ContentSchedules.
+type ContentSchedules struct {
+	Schedules []ContentSchedule `json:"schedules"`
+}
+
+// ContentSearchResult defines model for ContentSearchResult.
+type ContentSearchResult struct {
+	// ContentType page or post
+	ContentType string             `json:"content_type"`
+	Id          openapi_types.UUID `json:"id"`
+	PublishedAt *time.Time         `json:"published_at,omitempty"`
+	Rank        float32            `json:"rank"`
+	Slug        string             `json:"slug"`
+
+	// Snippet Escaped HTML excerpt of the body with matches enclosed in mark tags
+	Snippet string `json:"snippet"`
+	Title   string `json:"title"`
+}
+
+// ContentSearchResults defines model for ContentSearchResults.
+type ContentSearchResults struct {
+	// NextPageToken is empty if there's no next page
+	NextPageToken string                `json:"next_page_token"`
+	Results       []ContentSearchResult `json:"results"`
+	TotalSize     int                   `json:"total_size"`
+}
+
+// DiffSegment defines model for DiffSegment.
+type DiffSegment struct {
+	// Op One of equal, insert or delete
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// JSONFeed A feed following https://www.jsonfeed.org/version/1.1/
+type JSONFeed struct {
+	HomePageUrl *string        `json:"home_page_url,omitempty"`
+	Items       []JSONFeedItem `json:"items"`
+	Title       string         `json:"title"`
+	Version     string         `json:"version"`
+}
+
+// JSONFeedItem defines model for JSONFeedItem.
+type JSONFeedItem struct {
+	ContentHtml   *string    `json:"content_html,omitempty"`
+	DateModified  *time.Time `json:"date_modified,omitempty"`
+	DatePublished *time.Time `json:"date_published,omitempty"`
+	Id            string     `json:"id"`
+	Title         *string    `json:"title,omitempty"`
+	Url           *string    `json:"url,omitempty"`
+}
+
 // Page defines model for Page.
 type Page struct {
-	CreatedAt   *time.Time          `json:"created_at,omitempty"`
-	Html        *string             `json:"html,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	PublishedAt *time.Time          `json:"published_at,omitempty"`
-	Slug        string              `json:"slug"`
-	Title       string              `json:"title"`
-	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Format Format of the source, html (default) or markdown
+	Format *string `json:"format,omitempty"`
+
+	// Html Sanitized html rendered from the source
+	Html *string             `json:"html,omitempty"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// Language ISO 639-1 code of the language the content is written in, used to index it for search
+	Language    *string    `json:"language,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Slug        string     `json:"slug"`
+
+	// Source Content as authored in the given format, html is accepted as the source when omitted. Only returned when editing a page and by its versions
+	Source    *string    `json:"source,omitempty"`
+	Title     string     `json:"title"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 // PageVersion defines model for PageVersion.
 type PageVersion struct {
 	CreatedAt time.Time `json:"created_at"`
+	Format    *string   `json:"format,omitempty"`
 	Html      *string   `json:"html,omitempty"`
 
 	// Id Content ID of this version
-	Id    openapi_types.UUID `json:"id"`
-	Title string             `json:"title"`
+	Id               openapi_types.UUID  `json:"id"`
+	Language         *string             `json:"language,omitempty"`
+	RestoredByUserId *openapi_types.UUID `json:"restored_by_user_id,omitempty"`
+
+	// RestoredFromId Content ID of the version this version was restored from
+	RestoredFromId *openapi_types.UUID `json:"restored_from_id,omitempty"`
+	Source         *string             `json:"source,omitempty"`
+	Title          string              `json:"title"`
 
 	// Version Version number (starting at 1)
 	Version int `json:"version"`
@@ -102,24 +226,41 @@ type PaginatedList struct {
 
 // Post defines model for Post.
 type Post struct {
-	Content     string              `json:"content"`
-	CreatedAt   *time.Time          `json:"created_at,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	Namespace   *string             `json:"namespace,omitempty"`
-	PublishedAt *time.Time          `json:"published_at,omitempty"`
-	Slug        string              `json:"slug"`
-	Title       string              `json:"title"`
-	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+	// Content Post as authored in the given format, required when saving a post. Only returned when editing a post and by its versions
+	Content   *string    `json:"content,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Format Format of the content, markdown (default) or html
+	Format *string `json:"format,omitempty"`
+
+	// Html Sanitized html rendered from the content
+	Html *string             `json:"html,omitempty"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// Language ISO 639-1 code of the language the content is written in, used to index it for search
+	Language    *string    `json:"language,omitempty"`
+	Namespace   *string    `json:"namespace,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
 // PostVersion defines model for PostVersion.
 type PostVersion struct {
 	Content   *string   `json:"content,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
+	Format    *string   `json:"format,omitempty"`
+	Html      *string   `json:"html,omitempty"`
 
 	// Id Content ID of this version
-	Id    openapi_types.UUID `json:"id"`
-	Title string             `json:"title"`
+	Id               openapi_types.UUID  `json:"id"`
+	Language         *string             `json:"language,omitempty"`
+	RestoredByUserId *openapi_types.UUID `json:"restored_by_user_id,omitempty"`
+
+	// RestoredFromId Content ID of the version this version was restored from
+	RestoredFromId *openapi_types.UUID `json:"restored_from_id,omitempty"`
+	Title          string              `json:"title"`
 
 	// Version Version number (starting at 1)
 	Version int `json:"version"`
@@ -138,12 +279,51 @@ type Posts struct {
 	TotalSize     int    `json:"total_size"`
 }
 
+// FeedItemCount defines model for FeedItemCount.
+type FeedItemCount = int
+
+// IfModifiedSince defines model for IfModifiedSince.
+type IfModifiedSince = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
 // AnnouncementListParams defines parameters for AnnouncementList.
 type AnnouncementListParams struct {
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 	Page     *int `form:"page,omitempty" json:"page,omitempty"`
 }
 
+// ContentEventListParams defines parameters for ContentEventList.
+type ContentEventListParams struct {
+	After    *int64 `form:"after,omitempty" json:"after,omitempty"`
+	PageSize *int   `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// PostFeedAtomParams defines parameters for PostFeedAtom.
+type PostFeedAtomParams struct {
+	// Items Number of posts in the feed, defaults to the configured count
+	Items           *FeedItemCount   `form:"items,omitempty" json:"items,omitempty"`
+	IfNoneMatch     *IfNoneMatch     `json:"If-None-Match,omitempty"`
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// PostFeedJSONParams defines parameters for PostFeedJSON.
+type PostFeedJSONParams struct {
+	// Items Number of posts in the feed, defaults to the configured count
+	Items           *FeedItemCount   `form:"items,omitempty" json:"items,omitempty"`
+	IfNoneMatch     *IfNoneMatch     `json:"If-None-Match,omitempty"`
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// PostFeedRSSParams defines parameters for PostFeedRSS.
+type PostFeedRSSParams struct {
+	// Items Number of posts in the feed, defaults to the configured count
+	Items           *FeedItemCount   `form:"items,omitempty" json:"items,omitempty"`
+	IfNoneMatch     *IfNoneMatch     `json:"If-None-Match,omitempty"`
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
 // PageListParams defines parameters for PageList.
 type PageListParams struct {
 	PageSize      *int  `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -151,6 +331,12 @@ type PageListParams struct {
 	IncludeDrafts *bool `form:"include_drafts,omitempty" json:"include_drafts,omitempty"`
 }
 
+// PageVersionDiffParams defines parameters for PageVersionDiff.
+type PageVersionDiffParams struct {
+	// To Content ID of the version to compare with
+	To openapi_types.UUID `form:"to" json:"to"`
+}
+
 // PostListParams defines parameters for PostList.
 type PostListParams struct {
 	PageSize      *int  `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -158,6 +344,32 @@ type PostListParams struct {
 	IncludeDrafts *bool `form:"include_drafts,omitempty" json:"include_drafts,omitempty"`
 }
 
+// PostVersionDiffParams defines parameters for PostVersionDiff.
+type PostVersionDiffParams struct {
+	// To Content ID of the version to compare with
+	To openapi_types.UUID `form:"to" json:"to"`
+}
+
+// ContentScheduleListUpcomingParams defines parameters for ContentScheduleListUpcoming.
+type ContentScheduleListUpcomingParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ContentSearchParams defines parameters for ContentSearch.
+type ContentSearchParams struct {
+	// Q Search terms, supports quoted phrases, or and negation with -
+	Q string `form:"q" json:"q"`
+
+	// Language ISO 639-1 code of the language of the query, enables stemming for supported languages
+	Language *string `form:"language,omitempty" json:"language,omitempty"`
+
+	// Type Restricts the results to page or post
+	Type          *string `form:"type,omitempty" json:"type,omitempty"`
+	IncludeDrafts *bool   `form:"include_drafts,omitempty" json:"include_drafts,omitempty"`
+	PageSize      *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Page          *int    `form:"page,omitempty" json:"page,omitempty"`
+}
+
 // AnnouncementCreateJSONRequestBody defines body for AnnouncementCreate for application/json ContentType.
 type AnnouncementCreateJSONRequestBody = Announcement
 
@@ -176,6 +388,9 @@ type PostCreateJSONRequestBody = Post
 // PostUpdateJSONRequestBody defines body for PostUpdate for application/json ContentType.
 type PostUpdateJSONRequestBody = Post
 
+// ContentScheduleCreateJSONRequestBody defines body for ContentScheduleCreate for application/json ContentType.
+type ContentScheduleCreateJSONRequestBody = ContentSchedule
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Lists all announcements
@@ -196,6 +411,18 @@ type ServerInterface interface {
 	// Updates an existing announcement
 	// (PUT /announcements/{namespace}/{id})
 	AnnouncementUpdate(ctx echo.Context, namespace string, id string) error
+	// Lists content events in the order they occurred
+	// (GET /events/{namespace})
+	ContentEventList(ctx echo.Context, namespace string, params ContentEventListParams) error
+	// Gets the published posts as an Atom feed
+	// (GET /feeds/{namespace}/atom.xml)
+	PostFeedAtom(ctx echo.Context, namespace string, params PostFeedAtomParams) error
+	// Gets the published posts as a JSON Feed
+	// (GET /feeds/{namespace}/feed.json)
+	PostFeedJSON(ctx echo.Context, namespace string, params PostFeedJSONParams) error
+	// Gets the published posts as an RSS 2.0 feed
+	// (GET /feeds/{namespace}/rss.xml)
+	PostFeedRSS(ctx echo.Context, namespace string, params PostFeedRSSParams) error
 	// lists all pages
 	// (GET /pages/{namespace})
 	PageList(ctx echo.Context, namespace string, params PageListParams) error
@@ -214,6 +441,12 @@ type ServerInterface interface {
 	// Gets a specific version of a page
 	// (GET /pages/{namespace}/{id}/versions/{contentId})
 	PageVersionGet(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID) error
+	// Shows the changes from a version of a page to another version
+	// (GET /pages/{namespace}/{id}/versions/{contentId}/diff)
+	PageVersionDiff(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID, params PageVersionDiffParams) error
+	// Restores a version of a page as a new current version
+	// (POST /pages/{namespace}/{id}/versions/{contentId}/restore)
+	PageVersionRestore(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID) error
 	// Returns page content for a given slug
 	// (GET /pages/{namespace}/{slug})
 	PageFindBySlug(ctx echo.Context, namespace string, slug string) error
@@ -238,9 +471,27 @@ type ServerInterface interface {
 	// Gets a specific version of a post
 	// (GET /posts/{namespace}/{id}/versions/{contentId})
 	PostVersionGet(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID) error
+	// Shows the changes from a version of a post to another version
+	// (GET /posts/{namespace}/{id}/versions/{contentId}/diff)
+	PostVersionDiff(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID, params PostVersionDiffParams) error
+	// Restores a version of a post as a new current version
+	// (POST /posts/{namespace}/{id}/versions/{contentId}/restore)
+	PostVersionRestore(ctx echo.Context, namespace string, id string, contentId openapi_types.UUID) error
 	// Returns page content for a given slug
 	// (GET /posts/{namespace}/{slug})
 	PostFindBySlug(ctx echo.Context, namespace string, slug string) error
+	// Schedules a page or post to be published or unpublished
+	// (POST /schedules/{namespace})
+	ContentScheduleCreate(ctx echo.Context, namespace string) error
+	// Lists pending schedules, the next one first
+	// (GET /schedules/{namespace}/upcoming)
+	ContentScheduleListUpcoming(ctx echo.Context, namespace string, params ContentScheduleListUpcomingParams) error
+	// Cancels a pending schedule
+	// (DELETE /schedules/{namespace}/{id})
+	ContentScheduleCancel(ctx echo.Context, namespace string, id openapi_types.UUID) error
+	// Searches pages and posts
+	// (GET /search/{namespace})
+	ContentSearch(ctx echo.Context, namespace string, params ContentSearchParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -394,6 +645,211 @@ func (w *ServerInterfaceWrapper) AnnouncementUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ContentEventList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContentEventList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContentEventListParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContentEventList(ctx, namespace, params)
+	return err
+}
+
+// PostFeedAtom converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFeedAtom(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFeedAtomParams
+	// ------------- Optional query parameter "items" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "items", ctx.QueryParams(), &params.Items)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter items: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Modified-Since, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Modified-Since: %s", err))
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFeedAtom(ctx, namespace, params)
+	return err
+}
+
+// PostFeedJSON converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFeedJSON(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFeedJSONParams
+	// ------------- Optional query parameter "items" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "items", ctx.QueryParams(), &params.Items)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter items: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Modified-Since, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Modified-Since: %s", err))
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFeedJSON(ctx, namespace, params)
+	return err
+}
+
+// PostFeedRSS converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFeedRSS(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFeedRSSParams
+	// ------------- Optional query parameter "items" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "items", ctx.QueryParams(), &params.Items)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter items: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Modified-Since, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Modified-Since: %s", err))
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFeedRSS(ctx, namespace, params)
+	return err
+}
+
 // PageList converts echo context to params.
 func (w *ServerInterfaceWrapper) PageList(ctx echo.Context) error {
 	var err error
@@ -565,6 +1021,83 @@ func (w *ServerInterfaceWrapper) PageVersionGet(ctx echo.Context) error {
 	return err
 }
 
+// PageVersionDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) PageVersionDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "contentId" -------------
+	var contentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contentId", runtime.ParamLocationPath, ctx.Param("contentId"), &contentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PageVersionDiffParams
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PageVersionDiff(ctx, namespace, id, contentId, params)
+	return err
+}
+
+// PageVersionRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PageVersionRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "contentId" -------------
+	var contentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contentId", runtime.ParamLocationPath, ctx.Param("contentId"), &contentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PageVersionRestore(ctx, namespace, id, contentId)
+	return err
+}
+
 // PageFindBySlug converts echo context to params.
 func (w *ServerInterfaceWrapper) PageFindBySlug(ctx echo.Context) error {
 	var err error
@@ -767,6 +1300,83 @@ func (w *ServerInterfaceWrapper) PostVersionGet(ctx echo.Context) error {
 	return err
 }
 
+// PostVersionDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVersionDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "contentId" -------------
+	var contentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contentId", runtime.ParamLocationPath, ctx.Param("contentId"), &contentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVersionDiffParams
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostVersionDiff(ctx, namespace, id, contentId, params)
+	return err
+}
+
+// PostVersionRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVersionRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "contentId" -------------
+	var contentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contentId", runtime.ParamLocationPath, ctx.Param("contentId"), &contentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contentId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostVersionRestore(ctx, namespace, id, contentId)
+	return err
+}
+
 // PostFindBySlug converts echo context to params.
 func (w *ServerInterfaceWrapper) PostFindBySlug(ctx echo.Context) error {
 	var err error
@@ -791,6 +1401,137 @@ func (w *ServerInterfaceWrapper) PostFindBySlug(ctx echo.Context) error {
 	return err
 }
 
+// ContentScheduleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContentScheduleCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContentScheduleCreate(ctx, namespace)
+	return err
+}
+
+// ContentScheduleListUpcoming converts echo context to params.
+func (w *ServerInterfaceWrapper) ContentScheduleListUpcoming(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContentScheduleListUpcomingParams
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContentScheduleListUpcoming(ctx, namespace, params)
+	return err
+}
+
+// ContentScheduleCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) ContentScheduleCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContentScheduleCancel(ctx, namespace, id)
+	return err
+}
+
+// ContentSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) ContentSearch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContentSearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", ctx.QueryParams(), &params.Language)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_drafts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_drafts", ctx.QueryParams(), &params.IncludeDrafts)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_drafts: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContentSearch(ctx, namespace, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -825,12 +1566,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/announcements/:namespace/:id", wrapper.AnnouncementDelete)
 	router.GET(baseURL+"/announcements/:namespace/:id", wrapper.AnnouncementFindByID)
 	router.PUT(baseURL+"/announcements/:namespace/:id", wrapper.AnnouncementUpdate)
+	router.GET(baseURL+"/events/:namespace", wrapper.ContentEventList)
+	router.GET(baseURL+"/feeds/:namespace/atom.xml", wrapper.PostFeedAtom)
+	router.GET(baseURL+"/feeds/:namespace/feed.json", wrapper.PostFeedJSON)
+	router.GET(baseURL+"/feeds/:namespace/rss.xml", wrapper.PostFeedRSS)
 	router.GET(baseURL+"/pages/:namespace", wrapper.PageList)
 	router.POST(baseURL+"/pages/:namespace", wrapper.PageCreate)
 	router.DELETE(baseURL+"/pages/:namespace/:id", wrapper.PageDelete)
 	router.PUT(baseURL+"/pages/:namespace/:id", wrapper.PageUpdate)
 	router.GET(baseURL+"/pages/:namespace/:id/versions", wrapper.PageVersionList)
 	router.GET(baseURL+"/pages/:namespace/:id/versions/:contentId", wrapper.PageVersionGet)
+	router.GET(baseURL+"/pages/:namespace/:id/versions/:contentId/diff", wrapper.PageVersionDiff)
+	router.POST(baseURL+"/pages/:namespace/:id/versions/:contentId/restore", wrapper.PageVersionRestore)
 	router.GET(baseURL+"/pages/:namespace/:slug", wrapper.PageFindBySlug)
 	router.GET(baseURL+"/ping", wrapper.Ping)
 	router.GET(baseURL+"/posts/:namespace", wrapper.PostList)
@@ -839,6 +1586,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/posts/:namespace/:id", wrapper.PostUpdate)
 	router.GET(baseURL+"/posts/:namespace/:id/versions", wrapper.PostVersionList)
 	router.GET(baseURL+"/posts/:namespace/:id/versions/:contentId", wrapper.PostVersionGet)
+	router.GET(baseURL+"/posts/:namespace/:id/versions/:contentId/diff", wrapper.PostVersionDiff)
+	router.POST(baseURL+"/posts/:namespace/:id/versions/:contentId/restore", wrapper.PostVersionRestore)
 	router.GET(baseURL+"/posts/:namespace/:slug", wrapper.PostFindBySlug)
+	router.POST(baseURL+"/schedules/:namespace", wrapper.ContentScheduleCreate)
+	router.GET(baseURL+"/schedules/:namespace/upcoming", wrapper.ContentScheduleListUpcoming)
+	router.DELETE(baseURL+"/schedules/:namespace/:id", wrapper.ContentScheduleCancel)
+	router.GET(baseURL+"/search/:namespace", wrapper.ContentSearch)
 
 }
@@ -26,7 +26,9 @@ func (s *Server) PageCreate(ctx echo.Context, namespace string) error {
 		Namespace:   namespace,
 		Slug:        req.Slug,
 		Title:       req.Title,
-		HTML:        *req.Html,
+		Format:      contentFormatFromAPI(req.Format),
+		Source:      pageSource(&req),
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 	})
 	if err != nil {
@@ -46,7 +48,10 @@ func (s *Server) PageCreate(ctx echo.Context, namespace string) error {
 		Id:          &resp.Page.ID,
 		Slug:        resp.Page.Slug,
 		Title:       resp.Page.Title,
+		Format:      contentFormatToAPI(resp.Page.Format),
+		Source:      &resp.Page.Source,
 		Html:        &resp.Page.HTML,
+		Language:    resp.Page.Language,
 		PublishedAt: resp.Page.PublishedAt,
 	})
 }
@@ -69,7 +74,9 @@ func (s *Server) PageUpdate(ctx echo.Context, namespace string, id string) error
 		Slug:        req.Slug,
 		Namespace:   namespace,
 		Title:       req.Title,
-		HTML:        *req.Html,
+		Format:      contentFormatFromAPI(req.Format),
+		Source:      pageSource(&req),
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 	})
 	if err != nil {
@@ -92,7 +99,10 @@ func (s *Server) PageUpdate(ctx echo.Context, namespace string, id string) error
 		Id:          &resp.Page.ID,
 		Slug:        resp.Page.Slug,
 		Title:       resp.Page.Title,
+		Format:      contentFormatToAPI(resp.Page.Format),
+		Source:      &resp.Page.Source,
 		Html:        &resp.Page.HTML,
+		Language:    resp.Page.Language,
 		PublishedAt: resp.Page.PublishedAt,
 	})
 }
@@ -121,6 +131,41 @@ func (s *Server) PageDelete(ctx echo.Context, namespace string, id string) error
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// Restores a version of a page as a new current version
+// (POST /pages/{namespace}/{id}/versions/{contentId}/restore)
+func (s *Server) PageVersionRestore(ctx echo.Context, namespace string, id string, contentId uuid.UUID) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	page, err := s.pageVersionRestore.Execute(ctx.Request().Context(), namespace, parsedID, contentId)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrPageNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.Page{
+		Id:          &page.ID,
+		Slug:        page.Slug,
+		Title:       page.Title,
+		Format:      contentFormatToAPI(page.Format),
+		Source:      &page.Source,
+		Html:        &page.HTML,
+		Language:    page.Language,
+		PublishedAt: page.PublishedAt,
+		CreatedAt:   &page.CreatedAt,
+		UpdatedAt:   &page.UpdatedAt,
+	})
+}
+
 // Lists all versions of a page
 // (GET /pages/{namespace}/{id}/versions)
 func (s *Server) PageVersionList(ctx echo.Context, namespace string, id string) error {
@@ -147,10 +192,12 @@ func (s *Server) PageVersionList(ctx echo.Context, namespace string, id string)
 	}
 	for i, v := range versions {
 		res.Versions[i] = openapi.PageVersion{
-			Id:        v.ID,
-			Version:   v.Version,
-			Title:     v.Title,
-			CreatedAt: v.CreatedAt,
+			Id:               v.ID,
+			Version:          v.Version,
+			Title:            v.Title,
+			CreatedAt:        v.CreatedAt,
+			RestoredFromId:   v.RestoredFromID,
+			RestoredByUserId: v.RestoredByUserID,
 		}
 	}
 
@@ -179,14 +226,43 @@ func (s *Server) PageVersionGet(ctx echo.Context, namespace string, id string, c
 	}
 
 	return ctx.JSON(http.StatusOK, openapi.PageVersion{
-		Id:        v.ID,
-		Version:   v.Version,
-		Title:     v.Title,
-		Html:      &v.HTML,
-		CreatedAt: v.CreatedAt,
+		Id:               v.ID,
+		Version:          v.Version,
+		Title:            v.Title,
+		Format:           contentFormatToAPI(v.Format),
+		Source:           &v.Source,
+		Html:             &v.HTML,
+		Language:         v.Language,
+		CreatedAt:        v.CreatedAt,
+		RestoredFromId:   v.RestoredFromID,
+		RestoredByUserId: v.RestoredByUserID,
 	})
 }
 
+// Shows the changes from a version of a page to another version
+// (GET /pages/{namespace}/{id}/versions/{contentId}/diff)
+func (s *Server) PageVersionDiff(ctx echo.Context, namespace string, id string, contentId uuid.UUID, params openapi.PageVersionDiffParams) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	diff, err := s.pageVersionDiff.Execute(ctx.Request().Context(), parsedID, contentId, params.To)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrPageNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, contentDiffToAPI(diff))
+}
+
 // QUERIES
 
 // Returns page content for a given slug, falling back to ID lookup
@@ -207,7 +283,10 @@ func (s *Server) PageFindBySlug(ctx echo.Context, namespace string, slug string)
 						Id:          &page.ID,
 						Slug:        page.Slug,
 						Title:       page.Title,
+						Format:      contentFormatToAPI(page.Format),
+						Source:      &page.Source,
 						Html:        &page.HTML,
+						Language:    page.Language,
 						PublishedAt: page.PublishedAt,
 						CreatedAt:   &page.CreatedAt,
 						UpdatedAt:   &page.UpdatedAt,
@@ -226,11 +305,14 @@ func (s *Server) PageFindBySlug(ctx echo.Context, namespace string, slug string)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	// Readers only get the sanitized html, the source is for editing.
 	return ctx.JSON(http.StatusOK, openapi.Page{
-		Id:    &resp.Page.ID,
-		Slug:  resp.Page.Slug,
-		Title: resp.Page.Title,
-		Html:  &resp.Page.HTML,
+		Id:       &resp.Page.ID,
+		Slug:     resp.Page.Slug,
+		Title:    resp.Page.Title,
+		Format:   contentFormatToAPI(resp.Page.Format),
+		Html:     &resp.Page.HTML,
+		Language: resp.Page.Language,
 	})
 }
 
@@ -279,7 +361,9 @@ func (s *Server) PageList(ctx echo.Context, namespace string, params openapi.Pag
 			Id:          &p.ID,
 			Slug:        p.Slug,
 			Title:       p.Title,
+			Format:      contentFormatToAPI(p.Format),
 			Html:        &p.HTML,
+			Language:    p.Language,
 			PublishedAt: p.PublishedAt,
 			CreatedAt:   &p.CreatedAt,
 			UpdatedAt:   &p.UpdatedAt,
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type pageFindRepositoryStub struct {
+	page *domain.Page
+}
+
+func (s *pageFindRepositoryStub) FindPageBySlug(context.Context, string, string) (*domain.Page, error) {
+	return s.page, nil
+}
+
+func TestPageFindBySlugOmitsSource(t *testing.T) {
+	publishedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	page := &domain.Page{
+		ID:          uuid.New(),
+		Namespace:   "tadoku",
+		Slug:        "about",
+		Title:       "About",
+		Format:      domain.ContentFormatMarkdown,
+		Source:      "About **Tadoku**",
+		HTML:        "<p>About <strong>Tadoku</strong></p>",
+		PublishedAt: &publishedAt,
+	}
+	server := &Server{
+		pageFind: domain.NewPageFind(&pageFindRepositoryStub{page: page}, fixedClock{now: publishedAt.Add(time.Hour)}),
+	}
+
+	e := echo.New()
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), recorder)
+	require.NoError(t, server.PageFindBySlug(ctx, "tadoku", "about"))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, page.HTML, body["html"])
+	assert.NotContains(t, body, "source")
+}
@@ -26,7 +26,9 @@ func (s *Server) PostCreate(ctx echo.Context, namespace string) error {
 		Namespace:   namespace,
 		Slug:        req.Slug,
 		Title:       req.Title,
-		Content:     req.Content,
+		Format:      contentFormatFromAPI(req.Format),
+		Content:     postContent(&req),
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 	})
 	if err != nil {
@@ -46,7 +48,10 @@ func (s *Server) PostCreate(ctx echo.Context, namespace string) error {
 		Id:          &resp.Post.ID,
 		Slug:        resp.Post.Slug,
 		Title:       resp.Post.Title,
-		Content:     resp.Post.Content,
+		Format:      contentFormatToAPI(resp.Post.Format),
+		Content:     &resp.Post.Content,
+		Html:        &resp.Post.HTML,
+		Language:    resp.Post.Language,
 		PublishedAt: resp.Post.PublishedAt,
 	})
 }
@@ -69,7 +74,9 @@ func (s *Server) PostUpdate(ctx echo.Context, namespace string, id string) error
 		Slug:        req.Slug,
 		Namespace:   namespace,
 		Title:       req.Title,
-		Content:     req.Content,
+		Format:      contentFormatFromAPI(req.Format),
+		Content:     postContent(&req),
+		Language:    req.Language,
 		PublishedAt: req.PublishedAt,
 	})
 	if err != nil {
@@ -92,7 +99,10 @@ func (s *Server) PostUpdate(ctx echo.Context, namespace string, id string) error
 		Id:          &resp.Post.ID,
 		Slug:        resp.Post.Slug,
 		Title:       resp.Post.Title,
-		Content:     resp.Post.Content,
+		Format:      contentFormatToAPI(resp.Post.Format),
+		Content:     &resp.Post.Content,
+		Html:        &resp.Post.HTML,
+		Language:    resp.Post.Language,
 		PublishedAt: resp.Post.PublishedAt,
 	})
 }
@@ -121,6 +131,41 @@ func (s *Server) PostDelete(ctx echo.Context, namespace string, id string) error
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// Restores a version of a post as a new current version
+// (POST /posts/{namespace}/{id}/versions/{contentId}/restore)
+func (s *Server) PostVersionRestore(ctx echo.Context, namespace string, id string, contentId uuid.UUID) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	post, err := s.postVersionRestore.Execute(ctx.Request().Context(), namespace, parsedID, contentId)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrPostNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.Post{
+		Id:          &post.ID,
+		Slug:        post.Slug,
+		Title:       post.Title,
+		Format:      contentFormatToAPI(post.Format),
+		Content:     &post.Content,
+		Html:        &post.HTML,
+		Language:    post.Language,
+		PublishedAt: post.PublishedAt,
+		CreatedAt:   &post.CreatedAt,
+		UpdatedAt:   &post.UpdatedAt,
+	})
+}
+
 // Lists all versions of a post
 // (GET /posts/{namespace}/{id}/versions)
 func (s *Server) PostVersionList(ctx echo.Context, namespace string, id string) error {
@@ -147,10 +192,12 @@ func (s *Server) PostVersionList(ctx echo.Context, namespace string, id string)
 	}
 	for i, v := range versions {
 		res.Versions[i] = openapi.PostVersion{
-			Id:        v.ID,
-			Version:   v.Version,
-			Title:     v.Title,
-			CreatedAt: v.CreatedAt,
+			Id:               v.ID,
+			Version:          v.Version,
+			Title:            v.Title,
+			CreatedAt:        v.CreatedAt,
+			RestoredFromId:   v.RestoredFromID,
+			RestoredByUserId: v.RestoredByUserID,
 		}
 	}
 
@@ -179,14 +226,43 @@ func (s *Server) PostVersionGet(ctx echo.Context, namespace string, id string, c
 	}
 
 	return ctx.JSON(http.StatusOK, openapi.PostVersion{
-		Id:        v.ID,
-		Version:   v.Version,
-		Title:     v.Title,
-		Content:   &v.Content,
-		CreatedAt: v.CreatedAt,
+		Id:               v.ID,
+		Version:          v.Version,
+		Title:            v.Title,
+		Format:           contentFormatToAPI(v.Format),
+		Content:          &v.Content,
+		Html:             &v.HTML,
+		Language:         v.Language,
+		CreatedAt:        v.CreatedAt,
+		RestoredFromId:   v.RestoredFromID,
+		RestoredByUserId: v.RestoredByUserID,
 	})
 }
 
+// Shows the changes from a version of a post to another version
+// (GET /posts/{namespace}/{id}/versions/{contentId}/diff)
+func (s *Server) PostVersionDiff(ctx echo.Context, namespace string, id string, contentId uuid.UUID, params openapi.PostVersionDiffParams) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	diff, err := s.postVersionDiff.Execute(ctx.Request().Context(), parsedID, contentId, params.To)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrPostNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, contentDiffToAPI(diff))
+}
+
 // QUERIES
 
 // Returns post content for a given slug, falling back to ID lookup
@@ -207,7 +283,10 @@ func (s *Server) PostFindBySlug(ctx echo.Context, namespace string, slug string)
 						Id:          &post.ID,
 						Slug:        post.Slug,
 						Title:       post.Title,
-						Content:     post.Content,
+						Format:      contentFormatToAPI(post.Format),
+						Content:     &post.Content,
+						Html:        &post.HTML,
+						Language:    post.Language,
 						PublishedAt: post.PublishedAt,
 						CreatedAt:   &post.CreatedAt,
 						UpdatedAt:   &post.UpdatedAt,
@@ -224,11 +303,14 @@ func (s *Server) PostFindBySlug(ctx echo.Context, namespace string, slug string)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	// Readers only get the sanitized html, the content is for editing.
 	return ctx.JSON(http.StatusOK, openapi.Post{
 		Id:          &resp.Post.ID,
 		Slug:        resp.Post.Slug,
 		Title:       resp.Post.Title,
-		Content:     resp.Post.Content,
+		Format:      contentFormatToAPI(resp.Post.Format),
+		Html:        &resp.Post.HTML,
+		Language:    resp.Post.Language,
 		PublishedAt: resp.Post.PublishedAt,
 	})
 }
@@ -278,7 +360,9 @@ func (s *Server) PostList(ctx echo.Context, namespace string, params openapi.Pos
 			Id:          &p.ID,
 			Slug:        p.Slug,
 			Title:       p.Title,
-			Content:     p.Content,
+			Format:      contentFormatToAPI(p.Format),
+			Html:        &p.HTML,
+			Language:    p.Language,
 			PublishedAt: p.PublishedAt,
 			CreatedAt:   &p.CreatedAt,
 			UpdatedAt:   &p.UpdatedAt,
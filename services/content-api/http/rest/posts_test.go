@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+type postFindRepositoryStub struct {
+	post *domain.Post
+}
+
+func (s *postFindRepositoryStub) FindPostBySlug(context.Context, string, string) (*domain.Post, error) {
+	return s.post, nil
+}
+
+func TestPostFindBySlugOmitsContent(t *testing.T) {
+	publishedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	post := &domain.Post{
+		ID:          uuid.New(),
+		Namespace:   "tadoku",
+		Slug:        "welcome",
+		Title:       "Welcome",
+		Format:      domain.ContentFormatHTML,
+		Content:     `<p onclick="alert(1)">Welcome</p>`,
+		HTML:        "<p>Welcome</p>",
+		PublishedAt: &publishedAt,
+	}
+	server := &Server{
+		postFind: domain.NewPostFind(&postFindRepositoryStub{post: post}, fixedClock{now: publishedAt.Add(time.Hour)}),
+	}
+
+	e := echo.New()
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), recorder)
+	require.NoError(t, server.PostFindBySlug(ctx, "tadoku", "welcome"))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, post.HTML, body["html"])
+	assert.NotContains(t, body, "content")
+}
@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+// COMMANDS
+
+// Schedules a page or post to be published or unpublished
+// (POST /schedules/{namespace})
+func (s *Server) ContentScheduleCreate(ctx echo.Context, namespace string) error {
+	var req openapi.ContentScheduleCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	id := uuid.New()
+	if req.Id != nil {
+		id = *req.Id
+	}
+
+	resp, err := s.contentScheduleCreate.Execute(ctx.Request().Context(), &domain.ContentScheduleCreateRequest{
+		ID:          id,
+		Namespace:   namespace,
+		ContentType: req.ContentType,
+		ContentID:   req.ContentId,
+		Action:      req.Action,
+		ScheduledAt: req.ScheduledAt,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrInvalidSchedule) {
+			ctx.Echo().Logger.Error("could not process request: ", err)
+			return ctx.NoContent(http.StatusBadRequest)
+		}
+		if errors.Is(err, domain.ErrPageNotFound) || errors.Is(err, domain.ErrPostNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusCreated, contentScheduleToOpenAPI(resp.Schedule))
+}
+
+// Cancels a pending schedule
+// (DELETE /schedules/{namespace}/{id})
+func (s *Server) ContentScheduleCancel(ctx echo.Context, namespace string, id uuid.UUID) error {
+	err := s.contentScheduleCancel.Execute(ctx.Request().Context(), id, namespace)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrScheduleNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// QUERIES
+
+// Lists pending schedules, the next one first
+// (GET /schedules/{namespace}/upcoming)
+func (s *Server) ContentScheduleListUpcoming(ctx echo.Context, namespace string, params openapi.ContentScheduleListUpcomingParams) error {
+	pageSize := 0
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	resp, err := s.contentScheduleListUpcoming.Execute(ctx.Request().Context(), &domain.ContentScheduleListUpcomingRequest{
+		Namespace: namespace,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := openapi.ContentSchedules{
+		Schedules: make([]openapi.ContentSchedule, len(resp.Schedules)),
+	}
+	for i := range resp.Schedules {
+		res.Schedules[i] = contentScheduleToOpenAPI(&resp.Schedules[i])
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Lists content events in the order they occurred
+// (GET /events/{namespace})
+func (s *Server) ContentEventList(ctx echo.Context, namespace string, params openapi.ContentEventListParams) error {
+	var after int64
+	if params.After != nil {
+		after = *params.After
+	}
+	pageSize := 0
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	resp, err := s.contentEventList.Execute(ctx.Request().Context(), &domain.ContentEventListRequest{
+		Namespace: namespace,
+		AfterID:   after,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := openapi.ContentEvents{
+		Events: make([]openapi.ContentEvent, len(resp.Events)),
+	}
+	for i, e := range resp.Events {
+		res.Events[i] = openapi.ContentEvent{
+			Id:          e.ID,
+			Namespace:   e.Namespace,
+			Type:        string(e.Type),
+			ContentType: string(e.ContentType),
+			ContentId:   e.ContentID,
+			ScheduleId:  e.ScheduleID,
+			OccurredAt:  e.OccurredAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func contentScheduleToOpenAPI(schedule *domain.ContentSchedule) openapi.ContentSchedule {
+	res := openapi.ContentSchedule{
+		Id:              &schedule.ID,
+		Namespace:       &schedule.Namespace,
+		ContentType:     string(schedule.ContentType),
+		ContentId:       schedule.ContentID,
+		Action:          string(schedule.Action),
+		ScheduledAt:     schedule.ScheduledAt,
+		CreatedByUserId: &schedule.CreatedByUserID,
+		CreatedAt:       &schedule.CreatedAt,
+	}
+	if schedule.Title != "" {
+		res.Slug = &schedule.Slug
+		res.Title = &schedule.Title
+	}
+	return res
+}
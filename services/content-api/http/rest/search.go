@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+)
+
+// QUERIES
+
+// Searches pages and posts
+// (GET /search/{namespace})
+func (s *Server) ContentSearch(ctx echo.Context, namespace string, params openapi.ContentSearchParams) error {
+	req := &domain.ContentSearchRequest{
+		Namespace: namespace,
+		Query:     params.Q,
+		Language:  params.Language,
+	}
+	if params.Type != nil {
+		req.ContentType = *params.Type
+	}
+	if params.IncludeDrafts != nil {
+		req.IncludeDrafts = *params.IncludeDrafts
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+
+	resp, err := s.contentSearch.Execute(ctx.Request().Context(), req)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := openapi.ContentSearchResults{
+		Results:       make([]openapi.ContentSearchResult, len(resp.Hits)),
+		TotalSize:     resp.TotalSize,
+		NextPageToken: resp.NextPageToken,
+	}
+	for i, hit := range resp.Hits {
+		res.Results[i] = openapi.ContentSearchResult{
+			ContentType: string(hit.ContentType),
+			Id:          hit.ID,
+			Slug:        hit.Slug,
+			Title:       hit.Title,
+			PublishedAt: hit.PublishedAt,
+			Rank:        hit.Rank,
+			Snippet:     hit.SnippetHTML,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
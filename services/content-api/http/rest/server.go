@@ -15,6 +15,8 @@ func NewServer(
 	pageList *domain.PageList,
 	pageVersionList *domain.PageVersionList,
 	pageVersionGet *domain.PageVersionGet,
+	pageVersionRestore *domain.PageVersionRestore,
+	pageVersionDiff *domain.PageVersionDiff,
 	postCreate *domain.PostCreate,
 	postUpdate *domain.PostUpdate,
 	postDelete *domain.PostDelete,
@@ -23,57 +25,80 @@ func NewServer(
 	postList *domain.PostList,
 	postVersionList *domain.PostVersionList,
 	postVersionGet *domain.PostVersionGet,
+	postVersionRestore *domain.PostVersionRestore,
+	postVersionDiff *domain.PostVersionDiff,
+	postFeed *domain.PostFeed,
 	announcementCreate *domain.AnnouncementCreate,
 	announcementUpdate *domain.AnnouncementUpdate,
 	announcementDelete *domain.AnnouncementDelete,
 	announcementFindByID *domain.AnnouncementFindByID,
 	announcementList *domain.AnnouncementList,
 	announcementListActive *domain.AnnouncementListActive,
+	contentScheduleCreate *domain.ContentScheduleCreate,
+	contentScheduleCancel *domain.ContentScheduleCancel,
+	contentScheduleListUpcoming *domain.ContentScheduleListUpcoming,
+	contentEventList *domain.ContentEventList,
+	contentSearch *domain.ContentSearch,
 ) openapi.ServerInterface {
 	return &Server{
-		pageCreate:             pageCreate,
-		pageUpdate:             pageUpdate,
-		pageDelete:             pageDelete,
-		pageFind:               pageFind,
-		pageFindByID:           pageFindByID,
-		pageList:               pageList,
-		pageVersionList:        pageVersionList,
-		pageVersionGet:         pageVersionGet,
-		postCreate:             postCreate,
-		postUpdate:             postUpdate,
-		postDelete:             postDelete,
-		postFind:               postFind,
-		postFindByID:           postFindByID,
-		postList:               postList,
-		postVersionList:        postVersionList,
-		postVersionGet:         postVersionGet,
-		announcementCreate:     announcementCreate,
-		announcementUpdate:     announcementUpdate,
-		announcementDelete:     announcementDelete,
-		announcementFindByID:   announcementFindByID,
-		announcementList:       announcementList,
-		announcementListActive: announcementListActive,
+		pageCreate:                  pageCreate,
+		pageUpdate:                  pageUpdate,
+		pageDelete:                  pageDelete,
+		pageFind:                    pageFind,
+		pageFindByID:                pageFindByID,
+		pageList:                    pageList,
+		pageVersionList:             pageVersionList,
+		pageVersionGet:              pageVersionGet,
+		pageVersionRestore:          pageVersionRestore,
+		pageVersionDiff:             pageVersionDiff,
+		postCreate:                  postCreate,
+		postUpdate:                  postUpdate,
+		postDelete:                  postDelete,
+		postFind:                    postFind,
+		postFindByID:                postFindByID,
+		postList:                    postList,
+		postVersionList:             postVersionList,
+		postVersionGet:              postVersionGet,
+		postVersionRestore:          postVersionRestore,
+		postVersionDiff:             postVersionDiff,
+		postFeed:                    postFeed,
+		announcementCreate:          announcementCreate,
+		announcementUpdate:          announcementUpdate,
+		announcementDelete:          announcementDelete,
+		announcementFindByID:        announcementFindByID,
+		announcementList:            announcementList,
+		announcementListActive:      announcementListActive,
+		contentScheduleCreate:       contentScheduleCreate,
+		contentScheduleCancel:       contentScheduleCancel,
+		contentScheduleListUpcoming: contentScheduleListUpcoming,
+		contentEventList:            contentEventList,
+		contentSearch:               contentSearch,
 	}
 }
 
 type Server struct {
-	pageCreate      *domain.PageCreate
-	pageUpdate      *domain.PageUpdate
-	pageDelete      *domain.PageDelete
-	pageFind        *domain.PageFind
-	pageFindByID    *domain.PageFindByID
-	pageList        *domain.PageList
-	pageVersionList *domain.PageVersionList
-	pageVersionGet  *domain.PageVersionGet
+	pageCreate         *domain.PageCreate
+	pageUpdate         *domain.PageUpdate
+	pageDelete         *domain.PageDelete
+	pageFind           *domain.PageFind
+	pageFindByID       *domain.PageFindByID
+	pageList           *domain.PageList
+	pageVersionList    *domain.PageVersionList
+	pageVersionGet     *domain.PageVersionGet
+	pageVersionRestore *domain.PageVersionRestore
+	pageVersionDiff    *domain.PageVersionDiff
 
-	postCreate      *domain.PostCreate
-	postUpdate      *domain.PostUpdate
-	postDelete      *domain.PostDelete
-	postFind        *domain.PostFind
-	postFindByID    *domain.PostFindByID
-	postList        *domain.PostList
-	postVersionList *domain.PostVersionList
-	postVersionGet  *domain.PostVersionGet
+	postCreate         *domain.PostCreate
+	postUpdate         *domain.PostUpdate
+	postDelete         *domain.PostDelete
+	postFind           *domain.PostFind
+	postFindByID       *domain.PostFindByID
+	postList           *domain.PostList
+	postVersionList    *domain.PostVersionList
+	postVersionGet     *domain.PostVersionGet
+	postVersionRestore *domain.PostVersionRestore
+	postVersionDiff    *domain.PostVersionDiff
+	postFeed           *domain.PostFeed
 
 	announcementCreate     *domain.AnnouncementCreate
 	announcementUpdate     *domain.AnnouncementUpdate
@@ -81,4 +106,11 @@ type Server struct {
 	announcementFindByID   *domain.AnnouncementFindByID
 	announcementList       *domain.AnnouncementList
 	announcementListActive *domain.AnnouncementListActive
+
+	contentScheduleCreate       *domain.ContentScheduleCreate
+	contentScheduleCancel       *domain.ContentScheduleCancel
+	contentScheduleListUpcoming *domain.ContentScheduleListUpcoming
+	contentEventList            *domain.ContentEventList
+
+	contentSearch *domain.ContentSearch
 }
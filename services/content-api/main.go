@@ -21,6 +21,7 @@ import (
 	"github.com/tadoku/tadoku/services/content-api/domain"
 	"github.com/tadoku/tadoku/services/content-api/http/rest"
 	"github.com/tadoku/tadoku/services/content-api/http/rest/openapi"
+	"github.com/tadoku/tadoku/services/content-api/render"
 	"github.com/tadoku/tadoku/services/content-api/storage/postgres"
 
 	"github.com/getsentry/sentry-go"
@@ -38,6 +39,9 @@ type Config struct {
 	MetricsPort            int64   `envconfig:"metrics_port" default:"9090"`
 	SentryDSN              string  `envconfig:"sentry_dns"`
 	SentryTracesSampleRate float64 `validate:"required_with=SentryDSN" envconfig:"sentry_traces_sample_rate"`
+	FeedTitle              string  `envconfig:"feed_title" default:"Tadoku Blog"`
+	FeedSiteURL            string  `envconfig:"feed_site_url" default:"https://tadoku.app/blog"`
+	FeedItemCount          int     `validate:"gte=0,lte=100" envconfig:"feed_item_count" default:"20"`
 }
 
 func main() {
@@ -63,6 +67,9 @@ func main() {
 	pageRepository := postgres.NewPageRepository(psql)
 	postRepository := postgres.NewPostRepository(psql)
 	announcementRepository := postgres.NewAnnouncementRepository(psql)
+	scheduleRepository := postgres.NewScheduleRepository(psql)
+	searchRepository := postgres.NewSearchRepository(psql)
+	renderRepository := postgres.NewRenderRepository(psql)
 	rolesSvc := commonroles.NewKetoService(ketoclient.NewReadClient(cfg.KetoReadURL), "app", "tadoku")
 	serviceMetrics := commonobservability.NewMetrics(psql, cfg.ServiceName)
 	metricsServer := commonobservability.NewServer(
@@ -107,25 +114,45 @@ func main() {
 		panic(err)
 	}
 
+	// Render content stored with older rules again before serving it, this
+	// also sanitizes content written before sanitization was introduced
+	renderer := render.NewRenderer()
+	rerendered, err := domain.NewContentRerender(renderRepository, renderer).Execute(context.Background())
+	if err != nil {
+		panic(fmt.Errorf("could not render content: %w", err))
+	}
+	if rerendered > 0 {
+		slog.Info("rendered stored content again", "versions", rerendered, "render_version", domain.ContentRenderVersion)
+	}
+
 	// Page services
-	pageCreate := domain.NewPageCreate(pageRepository, clock)
-	pageUpdate := domain.NewPageUpdate(pageRepository, clock)
+	pageCreate := domain.NewPageCreate(pageRepository, clock, renderer)
+	pageUpdate := domain.NewPageUpdate(pageRepository, clock, renderer)
 	pageDelete := domain.NewPageDelete(pageRepository)
 	pageFind := domain.NewPageFind(pageRepository, clock)
 	pageFindByID := domain.NewPageFindByID(pageRepository)
 	pageList := domain.NewPageList(pageRepository)
 	pageVersionList := domain.NewPageVersionList(pageRepository)
 	pageVersionGet := domain.NewPageVersionGet(pageRepository)
+	pageVersionRestore := domain.NewPageVersionRestore(pageRepository, clock, renderer)
+	pageVersionDiff := domain.NewPageVersionDiff(pageRepository)
 
 	// Post services
-	postCreate := domain.NewPostCreate(postRepository, clock)
-	postUpdate := domain.NewPostUpdate(postRepository, clock)
+	postCreate := domain.NewPostCreate(postRepository, clock, renderer)
+	postUpdate := domain.NewPostUpdate(postRepository, clock, renderer)
 	postDelete := domain.NewPostDelete(postRepository)
 	postFind := domain.NewPostFind(postRepository, clock)
 	postFindByID := domain.NewPostFindByID(postRepository)
 	postList := domain.NewPostList(postRepository)
 	postVersionList := domain.NewPostVersionList(postRepository)
 	postVersionGet := domain.NewPostVersionGet(postRepository)
+	postVersionRestore := domain.NewPostVersionRestore(postRepository, clock, renderer)
+	postVersionDiff := domain.NewPostVersionDiff(postRepository)
+	postFeed := domain.NewPostFeed(postRepository, clock, domain.PostFeedConfig{
+		Title:            cfg.FeedTitle,
+		SiteURL:          cfg.FeedSiteURL,
+		DefaultItemCount: cfg.FeedItemCount,
+	})
 
 	// Announcement services
 	announcementCreate := domain.NewAnnouncementCreate(announcementRepository, clock)
@@ -135,6 +162,21 @@ func main() {
 	announcementList := domain.NewAnnouncementList(announcementRepository)
 	announcementListActive := domain.NewAnnouncementListActive(announcementRepository)
 
+	// Schedule services
+	contentScheduleCreate := domain.NewContentScheduleCreate(scheduleRepository, clock)
+	contentScheduleCancel := domain.NewContentScheduleCancel(scheduleRepository)
+	contentScheduleListUpcoming := domain.NewContentScheduleListUpcoming(scheduleRepository)
+	contentEventList := domain.NewContentEventList(scheduleRepository)
+
+	// Search services
+	contentSearch := domain.NewContentSearch(searchRepository)
+
+	// Start content schedule worker, publishes and unpublishes scheduled content
+	contentScheduleWorker := domain.NewContentScheduleWorker(scheduleRepository, clock, time.Minute)
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+	go contentScheduleWorker.Run(workerCtx)
+
 	server := rest.NewServer(
 		pageCreate,
 		pageUpdate,
@@ -144,6 +186,8 @@ func main() {
 		pageList,
 		pageVersionList,
 		pageVersionGet,
+		pageVersionRestore,
+		pageVersionDiff,
 		postCreate,
 		postUpdate,
 		postDelete,
@@ -152,12 +196,20 @@ func main() {
 		postList,
 		postVersionList,
 		postVersionGet,
+		postVersionRestore,
+		postVersionDiff,
+		postFeed,
 		announcementCreate,
 		announcementUpdate,
 		announcementDelete,
 		announcementFindByID,
 		announcementList,
 		announcementListActive,
+		contentScheduleCreate,
+		contentScheduleCancel,
+		contentScheduleListUpcoming,
+		contentEventList,
+		contentSearch,
 	)
 
 	openapi.RegisterHandlersWithBaseURL(api, server, "")
@@ -177,6 +229,8 @@ func main() {
 		slog.Error("internal metrics server stopped", "error", metricsErr)
 	}
 
+	workerCancel()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err := e.Shutdown(ctx); err != nil {
@@ -0,0 +1,64 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// classNames matches utility classes such as `md:w-1/2` or `top-[3px]`, they
+// are kept so pages can be styled with the site stylesheet.
+var classNames = regexp.MustCompile(`^[\p{L}\p{N}\s_:/.\[\]%#-]*$`)
+
+// Renderer implements domain.ContentRenderer
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+func NewRenderer() *Renderer {
+	return &Renderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+			// Inline HTML is passed through, the output is sanitized afterwards.
+			goldmark.WithRendererOptions(html.WithUnsafe()),
+		),
+		policy: newPolicy(),
+	}
+}
+
+// newPolicy allows the elements of user generated content, without scripts,
+// styles, event handlers, embeds or forms.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(classNames).Globally()
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^(|checked|disabled)$`)).OnElements("input")
+	// Links are added by admins, they don't need rel="nofollow"
+	p.RequireNoFollowOnLinks(false)
+	return p
+}
+
+// Render implements domain.ContentRenderer
+func (r *Renderer) Render(format domain.ContentFormat, source string) (string, error) {
+	switch format {
+	case domain.ContentFormatHTML:
+		return r.policy.Sanitize(source), nil
+	case domain.ContentFormatMarkdown:
+		var buf bytes.Buffer
+		if err := r.markdown.Convert([]byte(source), &buf); err != nil {
+			return "", fmt.Errorf("could not render markdown: %w", err)
+		}
+		return r.policy.Sanitize(buf.String()), nil
+	default:
+		return "", fmt.Errorf("could not render content: unknown format %q", format)
+	}
+}
@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+	"github.com/tadoku/tadoku/services/content-api/render"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	renderer := render.NewRenderer()
+
+	t.Run("renders markdown", func(t *testing.T) {
+		html, err := renderer.Render(domain.ContentFormatMarkdown, "# Hello\n\nSome **bold** text and a [link](https://tadoku.app).")
+
+		require.NoError(t, err)
+		assert.Equal(t, "<h1 id=\"hello\">Hello</h1>\n<p>Some <strong>bold</strong> text and a <a href=\"https://tadoku.app\">link</a>.</p>\n", html)
+	})
+
+	t.Run("renders github flavored markdown", func(t *testing.T) {
+		html, err := renderer.Render(domain.ContentFormatMarkdown, "| a | b |\n|---|---|\n| 1 | 2 |\n\n- [x] done\n\n~~old~~")
+
+		require.NoError(t, err)
+		assert.Contains(t, html, "<table>")
+		assert.Contains(t, html, `<input checked="" disabled="" type="checkbox"`)
+		assert.Contains(t, html, "<del>old</del>")
+	})
+
+	t.Run("sanitizes inline html in markdown", func(t *testing.T) {
+		html, err := renderer.Render(domain.ContentFormatMarkdown, "Hi <span class=\"text-red-500\">there</span><script>alert(1)</script>")
+
+		require.NoError(t, err)
+		assert.Equal(t, "<p>Hi <span class=\"text-red-500\">there</span></p>\n", html)
+	})
+
+	t.Run("sanitizes html", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			source string
+			want   string
+		}{
+			{"keeps markup", `<div class="md:w-1/2"><p>Hello <em>world</em></p></div>`, `<div class="md:w-1/2"><p>Hello <em>world</em></p></div>`},
+			{"removes scripts", `<p>Hi</p><script>alert(1)</script>`, `<p>Hi</p>`},
+			{"removes event handlers", `<img src="/a.png" onerror="alert(1)">`, `<img src="/a.png">`},
+			{"removes javascript urls", `<a href="javascript:alert(1)">x</a>`, `x`},
+			{"removes styles", `<p style="background:url(x)">x</p><style>p{}</style>`, `<p>x</p>`},
+			{"removes iframes", `<iframe src="https://example.com"></iframe>`, ``},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				html, err := renderer.Render(domain.ContentFormatHTML, tt.source)
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, html)
+			})
+		}
+	})
+
+	t.Run("returns error on unknown format", func(t *testing.T) {
+		_, err := renderer.Render(domain.ContentFormat("rst"), "text")
+
+		assert.Error(t, err)
+	})
+}
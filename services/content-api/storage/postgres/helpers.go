@@ -3,6 +3,8 @@ package postgres
 import (
 	"database/sql"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 func NewNullTime(t *time.Time) sql.NullTime {
@@ -46,3 +48,18 @@ func NewStringFromNullString(s sql.NullString) *string {
 
 	return &s.String
 }
+
+func NewNullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{
+		Valid: true,
+		UUID:  id,
+	}
+}
+
+func NewUUIDFromNullUUID(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+
+	return &id.UUID
+}
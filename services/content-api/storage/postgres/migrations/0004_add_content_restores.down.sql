@@ -0,0 +1,11 @@
+begin;
+
+alter table pages_content
+  drop column restored_from_id,
+  drop column restored_by_user_id;
+
+alter table posts_content
+  drop column restored_from_id,
+  drop column restored_by_user_id;
+
+commit;
@@ -0,0 +1,11 @@
+begin;
+
+alter table pages_content
+  add column restored_from_id uuid,
+  add column restored_by_user_id uuid;
+
+alter table posts_content
+  add column restored_from_id uuid,
+  add column restored_by_user_id uuid;
+
+commit;
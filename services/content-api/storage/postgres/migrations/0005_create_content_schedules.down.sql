@@ -0,0 +1,6 @@
+begin;
+
+drop table if exists content_events;
+drop table if exists content_schedules;
+
+commit;
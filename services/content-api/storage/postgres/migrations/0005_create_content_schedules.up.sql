@@ -0,0 +1,32 @@
+begin;
+
+create table content_schedules (
+  id uuid primary key default uuid_generate_v4(),
+  "namespace" varchar(50) not null,
+  content_type varchar(20) not null,
+  content_id uuid not null,
+  "action" varchar(20) not null,
+  scheduled_at timestamp not null,
+  created_by_user_id uuid not null,
+  created_at timestamp not null default now(),
+  executed_at timestamp,
+  cancelled_at timestamp
+);
+
+create index content_schedules_pending on content_schedules(scheduled_at) where executed_at is null and cancelled_at is null;
+create index content_schedules_content on content_schedules(content_type, content_id);
+
+-- Transitions applied by the schedule worker, consumers read them in order of id.
+create table content_events (
+  id bigserial primary key,
+  "namespace" varchar(50) not null,
+  event_type varchar(50) not null,
+  content_type varchar(20) not null,
+  content_id uuid not null,
+  schedule_id uuid,
+  occurred_at timestamp not null default now()
+);
+
+create index content_events_namespace on content_events("namespace", id);
+
+commit;
@@ -0,0 +1,15 @@
+begin;
+
+drop index if exists posts_content_search;
+alter table posts_content
+  drop column if exists search_vector,
+  drop column if exists search_config,
+  drop column if exists "language";
+
+drop index if exists pages_content_search;
+alter table pages_content
+  drop column if exists search_vector,
+  drop column if exists search_config,
+  drop column if exists "language";
+
+commit;
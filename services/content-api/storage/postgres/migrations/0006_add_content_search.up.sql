@@ -0,0 +1,34 @@
+begin;
+
+-- search_config is the text search configuration matching the language of the
+-- content. The simple configuration is always indexed as well, so words are
+-- found in their exact form regardless of the language used to search.
+alter table pages_content
+  add column "language" varchar(10),
+  add column search_config regconfig not null default 'simple';
+
+alter table pages_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, regexp_replace(html, '<[^>]*>', ' ', 'g')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(html, '<[^>]*>', ' ', 'g')), 'B')
+  ) stored;
+
+create index pages_content_search on pages_content using gin(search_vector);
+
+alter table posts_content
+  add column "language" varchar(10),
+  add column search_config regconfig not null default 'simple';
+
+alter table posts_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, regexp_replace(content, '<[^>]*>', ' ', 'g')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(content, '<[^>]*>', ' ', 'g')), 'B')
+  ) stored;
+
+create index posts_content_search on posts_content using gin(search_vector);
+
+commit;
@@ -0,0 +1,17 @@
+begin;
+
+drop index if exists posts_content_render_version;
+drop index if exists pages_content_render_version;
+
+alter table posts_content
+  drop column if exists render_version,
+  drop column if exists html,
+  drop column if exists format;
+
+-- The rendered html of markdown pages is kept, the source is lost.
+alter table pages_content
+  drop column if exists render_version,
+  drop column if exists source,
+  drop column if exists format;
+
+commit;
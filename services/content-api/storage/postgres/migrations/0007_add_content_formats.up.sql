@@ -0,0 +1,24 @@
+begin;
+
+-- Content is authored in a format and rendered to sanitized html on save.
+-- render_version records the rendering rules the html was produced with, rows
+-- rendered with older rules are rendered again when the service starts.
+alter table pages_content
+  add column format varchar(20) not null default 'html' check (format in ('html', 'markdown')),
+  add column source text,
+  add column render_version integer not null default 0;
+
+update pages_content set source = html;
+
+alter table pages_content alter column source set not null;
+
+-- Posts have always been written in markdown, content holds the source.
+alter table posts_content
+  add column format varchar(20) not null default 'markdown' check (format in ('html', 'markdown')),
+  add column html text not null default '',
+  add column render_version integer not null default 0;
+
+create index pages_content_render_version on pages_content(render_version);
+create index posts_content_render_version on posts_content(render_version);
+
+commit;
@@ -0,0 +1,15 @@
+begin;
+
+alter table posts_content drop column search_vector;
+
+alter table posts_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, regexp_replace(content, '<[^>]*>', ' ', 'g')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(content, '<[^>]*>', ' ', 'g')), 'B')
+  ) stored;
+
+create index posts_content_search on posts_content using gin(search_vector);
+
+commit;
@@ -0,0 +1,17 @@
+begin;
+
+-- Posts are searched by their rendered html like pages, the markdown source
+-- indexes syntax and raw html the readers never see.
+alter table posts_content drop column search_vector;
+
+alter table posts_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, regexp_replace(html, '<[^>]*>', ' ', 'g')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(html, '<[^>]*>', ' ', 'g')), 'B')
+  ) stored;
+
+create index posts_content_search on posts_content using gin(search_vector);
+
+commit;
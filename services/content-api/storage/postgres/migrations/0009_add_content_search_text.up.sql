@@ -0,0 +1,34 @@
+begin;
+
+-- search_text is the plain text of the rendered html, written with it. Unlike
+-- stripping tags in the database it drops scripts and styles and decodes
+-- entities. Existing versions are filled in when they are rendered again on
+-- startup.
+alter table pages_content add column search_text text not null default '';
+alter table posts_content add column search_text text not null default '';
+
+alter table pages_content drop column search_vector;
+
+alter table pages_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, search_text), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, search_text), 'B')
+  ) stored;
+
+create index pages_content_search on pages_content using gin(search_vector);
+
+alter table posts_content drop column search_vector;
+
+alter table posts_content
+  add column search_vector tsvector generated always as (
+    setweight(to_tsvector(search_config, title), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+    setweight(to_tsvector(search_config, search_text), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, search_text), 'B')
+  ) stored;
+
+create index posts_content_search on posts_content using gin(search_vector);
+
+commit;
@@ -25,6 +25,29 @@ type Announcement struct {
 	DeletedAt sql.NullTime
 }
 
+type ContentEvent struct {
+	ID          int64
+	Namespace   string
+	EventType   string
+	ContentType string
+	ContentID   uuid.UUID
+	ScheduleID  uuid.NullUUID
+	OccurredAt  time.Time
+}
+
+type ContentSchedule struct {
+	ID              uuid.UUID
+	Namespace       string
+	ContentType     string
+	ContentID       uuid.UUID
+	Action          string
+	ScheduledAt     time.Time
+	CreatedByUserID uuid.UUID
+	CreatedAt       time.Time
+	ExecutedAt      sql.NullTime
+	CancelledAt     sql.NullTime
+}
+
 type Page struct {
 	ID               uuid.UUID
 	Namespace        string
@@ -37,11 +60,20 @@ type Page struct {
 }
 
 type PagesContent struct {
-	ID        uuid.UUID
-	PageID    uuid.UUID
-	Title     string
-	Html      string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	PageID           uuid.UUID
+	Title            string
+	Html             string
+	CreatedAt        time.Time
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	Language         sql.NullString
+	SearchConfig     interface{}
+	Format           string
+	Source           string
+	RenderVersion    int32
+	SearchText       string
+	SearchVector     interface{}
 }
 
 type Post struct {
@@ -56,9 +88,18 @@ type Post struct {
 }
 
 type PostsContent struct {
-	ID        uuid.UUID
-	PostID    uuid.UUID
-	Title     string
-	Content   string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	PostID           uuid.UUID
+	Title            string
+	Content          string
+	CreatedAt        time.Time
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	Language         sql.NullString
+	SearchConfig     interface{}
+	Format           string
+	Html             string
+	RenderVersion    int32
+	SearchText       string
+	SearchVector     interface{}
 }
@@ -59,10 +59,16 @@ func (r *PageRepository) CreatePage(ctx context.Context, page *domain.Page) erro
 	}
 
 	_, err = qtx.CreatePageContent(ctx, CreatePageContentParams{
-		ID:     pageContentID,
-		PageID: page.ID,
-		Title:  page.Title,
-		Html:   page.HTML,
+		ID:            pageContentID,
+		PageID:        page.ID,
+		Title:         page.Title,
+		Format:        string(page.Format),
+		Source:        page.Source,
+		Html:          page.HTML,
+		SearchText:    domain.SearchText(page.HTML),
+		RenderVersion: domain.ContentRenderVersion,
+		Language:      NewNullString(page.Language),
+		SearchConfig:  domain.SearchConfig(page.Language),
 	})
 	if err != nil {
 		_ = tx.Rollback()
@@ -91,7 +97,10 @@ func (r *PageRepository) GetPageByID(ctx context.Context, id uuid.UUID, namespac
 		Namespace:   page.Namespace,
 		Slug:        page.Slug,
 		Title:       page.Title,
+		Format:      domain.ContentFormat(page.Format),
+		Source:      page.Source,
 		HTML:        page.Html,
+		Language:    NewStringFromNullString(page.Language),
 		PublishedAt: NewTimeFromNullTime(page.PublishedAt),
 		CreatedAt:   page.CreatedAt,
 		UpdatedAt:   page.UpdatedAt,
@@ -131,10 +140,16 @@ func (r *PageRepository) UpdatePage(ctx context.Context, page *domain.Page) erro
 	}
 
 	_, err = qtx.CreatePageContent(ctx, CreatePageContentParams{
-		ID:     pageContentID,
-		PageID: page.ID,
-		Title:  page.Title,
-		Html:   page.HTML,
+		ID:            pageContentID,
+		PageID:        page.ID,
+		Title:         page.Title,
+		Format:        string(page.Format),
+		Source:        page.Source,
+		Html:          page.HTML,
+		SearchText:    domain.SearchText(page.HTML),
+		RenderVersion: domain.ContentRenderVersion,
+		Language:      NewNullString(page.Language),
+		SearchConfig:  domain.SearchConfig(page.Language),
 	})
 	if err != nil {
 		_ = tx.Rollback()
@@ -189,10 +204,12 @@ func (r *PageRepository) ListPageVersions(ctx context.Context, pageID uuid.UUID)
 	versions := make([]domain.PageVersion, len(rows))
 	for i, row := range rows {
 		versions[i] = domain.PageVersion{
-			ID:        row.ID,
-			Version:   i + 1,
-			Title:     row.Title,
-			CreatedAt: row.CreatedAt,
+			ID:               row.ID,
+			Version:          i + 1,
+			Title:            row.Title,
+			CreatedAt:        row.CreatedAt,
+			RestoredFromID:   NewUUIDFromNullUUID(row.RestoredFromID),
+			RestoredByUserID: NewUUIDFromNullUUID(row.RestoredByUserID),
 		}
 	}
 
@@ -213,13 +230,69 @@ func (r *PageRepository) GetPageVersion(ctx context.Context, pageID uuid.UUID, c
 	}
 
 	return &domain.PageVersion{
-		ID:        row.ID,
-		Title:     row.Title,
-		HTML:      row.Html,
-		CreatedAt: row.CreatedAt,
+		ID:               row.ID,
+		Title:            row.Title,
+		Format:           domain.ContentFormat(row.Format),
+		Source:           row.Source,
+		HTML:             row.Html,
+		Language:         NewStringFromNullString(row.Language),
+		CreatedAt:        row.CreatedAt,
+		RestoredFromID:   NewUUIDFromNullUUID(row.RestoredFromID),
+		RestoredByUserID: NewUUIDFromNullUUID(row.RestoredByUserID),
 	}, nil
 }
 
+// RestorePageVersion implements domain.PageVersionRestoreRepository
+func (r *PageRepository) RestorePageVersion(ctx context.Context, page *domain.Page, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not restore page version: %w", err)
+	}
+
+	pageContentID := uuid.New()
+
+	qtx := r.q.WithTx(tx)
+
+	_, err = qtx.RestorePageContent(ctx, RestorePageContentParams{
+		ID:               pageContentID,
+		PageID:           page.ID,
+		Title:            page.Title,
+		Format:           string(page.Format),
+		Source:           page.Source,
+		Html:             page.HTML,
+		SearchText:       domain.SearchText(page.HTML),
+		RenderVersion:    domain.ContentRenderVersion,
+		Language:         NewNullString(page.Language),
+		SearchConfig:     domain.SearchConfig(page.Language),
+		RestoredFromID:   NewNullUUID(restoredFromID),
+		RestoredByUserID: NewNullUUID(restoredByUserID),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not restore page version: %w", err)
+	}
+
+	_, err = qtx.UpdatePageCurrentContent(ctx, UpdatePageCurrentContentParams{
+		ID:               page.ID,
+		CurrentContentID: pageContentID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrPageNotFound
+		}
+
+		return fmt.Errorf("could not restore page version: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not restore page version: %w", err)
+	}
+
+	return nil
+}
+
 // FindPageBySlug implements domain.PageFindRepository
 func (r *PageRepository) FindPageBySlug(ctx context.Context, namespace, slug string) (*domain.Page, error) {
 	page, err := r.q.FindPageBySlug(ctx, FindPageBySlugParams{
@@ -239,7 +312,10 @@ func (r *PageRepository) FindPageBySlug(ctx context.Context, namespace, slug str
 		Namespace:   page.Namespace,
 		Slug:        page.Slug,
 		Title:       page.Title,
+		Format:      domain.ContentFormat(page.Format),
+		Source:      page.Source,
 		HTML:        page.Html,
+		Language:    NewStringFromNullString(page.Language),
 		PublishedAt: NewTimeFromNullTime(page.PublishedAt),
 		CreatedAt:   page.CreatedAt,
 		UpdatedAt:   page.UpdatedAt,
@@ -286,7 +362,10 @@ func (r *PageRepository) ListPages(ctx context.Context, namespace string, includ
 			Namespace:   p.Namespace,
 			Slug:        p.Slug,
 			Title:       p.Title,
+			Format:      domain.ContentFormat(p.Format),
+			Source:      p.Source,
 			HTML:        p.Html,
+			Language:    NewStringFromNullString(p.Language),
 			PublishedAt: NewTimeFromNullTime(p.PublishedAt),
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
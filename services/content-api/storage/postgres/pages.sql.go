@@ -55,20 +55,38 @@ insert into pages_content (
   id,
   page_id,
   title,
-  html
+  format,
+  source,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config
 ) values (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  coalesce(to_regconfig($10::text), 'simple')
 ) returning id
 `
 
 type CreatePageContentParams struct {
-	ID     uuid.UUID
-	PageID uuid.UUID
-	Title  string
-	Html   string
+	ID            uuid.UUID
+	PageID        uuid.UUID
+	Title         string
+	Format        string
+	Source        string
+	Html          string
+	SearchText    string
+	RenderVersion int32
+	Language      sql.NullString
+	SearchConfig  string
 }
 
 func (q *Queries) CreatePageContent(ctx context.Context, arg CreatePageContentParams) (uuid.UUID, error) {
@@ -76,7 +94,13 @@ func (q *Queries) CreatePageContent(ctx context.Context, arg CreatePageContentPa
 		arg.ID,
 		arg.PageID,
 		arg.Title,
+		arg.Format,
+		arg.Source,
 		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.Language,
+		arg.SearchConfig,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -107,7 +131,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -130,7 +157,10 @@ type FindPageByIDRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
+	Source      string
 	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -144,7 +174,10 @@ func (q *Queries) FindPageByID(ctx context.Context, arg FindPageByIDParams) (Fin
 		&i.Namespace,
 		&i.Slug,
 		&i.Title,
+		&i.Format,
+		&i.Source,
 		&i.Html,
+		&i.Language,
 		&i.PublishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -158,7 +191,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -181,7 +217,10 @@ type FindPageBySlugRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
+	Source      string
 	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -195,7 +234,10 @@ func (q *Queries) FindPageBySlug(ctx context.Context, arg FindPageBySlugParams)
 		&i.Namespace,
 		&i.Slug,
 		&i.Title,
+		&i.Format,
+		&i.Source,
 		&i.Html,
+		&i.Language,
 		&i.PublishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -207,7 +249,12 @@ const getPageVersion = `-- name: GetPageVersion :one
 select
   id,
   title,
+  format,
+  source,
   html,
+  "language",
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from pages_content
 where id = $1
@@ -220,10 +267,15 @@ type GetPageVersionParams struct {
 }
 
 type GetPageVersionRow struct {
-	ID        uuid.UUID
-	Title     string
-	Html      string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	Title            string
+	Format           string
+	Source           string
+	Html             string
+	Language         sql.NullString
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	CreatedAt        time.Time
 }
 
 func (q *Queries) GetPageVersion(ctx context.Context, arg GetPageVersionParams) (GetPageVersionRow, error) {
@@ -232,7 +284,12 @@ func (q *Queries) GetPageVersion(ctx context.Context, arg GetPageVersionParams)
 	err := row.Scan(
 		&i.ID,
 		&i.Title,
+		&i.Format,
+		&i.Source,
 		&i.Html,
+		&i.Language,
+		&i.RestoredFromID,
+		&i.RestoredByUserID,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -242,6 +299,8 @@ const listPageVersions = `-- name: ListPageVersions :many
 select
   id,
   title,
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from pages_content
 where page_id = $1
@@ -249,9 +308,11 @@ order by created_at asc
 `
 
 type ListPageVersionsRow struct {
-	ID        uuid.UUID
-	Title     string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	Title            string
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	CreatedAt        time.Time
 }
 
 func (q *Queries) ListPageVersions(ctx context.Context, pageID uuid.UUID) ([]ListPageVersionsRow, error) {
@@ -263,7 +324,13 @@ func (q *Queries) ListPageVersions(ctx context.Context, pageID uuid.UUID) ([]Lis
 	var items []ListPageVersionsRow
 	for rows.Next() {
 		var i ListPageVersionsRow
-		if err := rows.Scan(&i.ID, &i.Title, &i.CreatedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.RestoredFromID,
+			&i.RestoredByUserID,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -283,7 +350,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -293,7 +363,7 @@ inner join pages_content
 where
   deleted_at is null
   and "namespace" = $1
-  and ($2::boolean or published_at is not null)
+  and ($2::boolean or published_at <= now())
 order by pages.created_at desc
 limit $4
 offset $3
@@ -311,7 +381,10 @@ type ListPagesRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
+	Source      string
 	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -336,7 +409,10 @@ func (q *Queries) ListPages(ctx context.Context, arg ListPagesParams) ([]ListPag
 			&i.Namespace,
 			&i.Slug,
 			&i.Title,
+			&i.Format,
+			&i.Source,
 			&i.Html,
+			&i.Language,
 			&i.PublishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -354,6 +430,51 @@ func (q *Queries) ListPages(ctx context.Context, arg ListPagesParams) ([]ListPag
 	return items, nil
 }
 
+const listStalePageContent = `-- name: ListStalePageContent :many
+select
+  id,
+  format,
+  source
+from pages_content
+where render_version < $1
+order by id
+limit $2
+`
+
+type ListStalePageContentParams struct {
+	RenderVersion int32
+	BatchSize     int32
+}
+
+type ListStalePageContentRow struct {
+	ID     uuid.UUID
+	Format string
+	Source string
+}
+
+func (q *Queries) ListStalePageContent(ctx context.Context, arg ListStalePageContentParams) ([]ListStalePageContentRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStalePageContent, arg.RenderVersion, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStalePageContentRow
+	for rows.Next() {
+		var i ListStalePageContentRow
+		if err := rows.Scan(&i.ID, &i.Format, &i.Source); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const pagesMetadata = `-- name: PagesMetadata :one
 select
   count(pages.id) as total_size,
@@ -361,7 +482,7 @@ select
 from pages
 where
   deleted_at is null
-  and ($1::boolean or published_at is not null)
+  and ($1::boolean or published_at <= now())
   and "namespace" = $2
 `
 
@@ -382,6 +503,71 @@ func (q *Queries) PagesMetadata(ctx context.Context, arg PagesMetadataParams) (P
 	return i, err
 }
 
+const restorePageContent = `-- name: RestorePageContent :one
+insert into pages_content (
+  id,
+  page_id,
+  title,
+  format,
+  source,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config,
+  restored_from_id,
+  restored_by_user_id
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  coalesce(to_regconfig($10::text), 'simple'),
+  $11,
+  $12
+) returning id
+`
+
+type RestorePageContentParams struct {
+	ID               uuid.UUID
+	PageID           uuid.UUID
+	Title            string
+	Format           string
+	Source           string
+	Html             string
+	SearchText       string
+	RenderVersion    int32
+	Language         sql.NullString
+	SearchConfig     string
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+}
+
+func (q *Queries) RestorePageContent(ctx context.Context, arg RestorePageContentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, restorePageContent,
+		arg.ID,
+		arg.PageID,
+		arg.Title,
+		arg.Format,
+		arg.Source,
+		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.Language,
+		arg.SearchConfig,
+		arg.RestoredFromID,
+		arg.RestoredByUserID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updatePage = `-- name: UpdatePage :one
 update pages
 set
@@ -414,6 +600,58 @@ func (q *Queries) UpdatePage(ctx context.Context, arg UpdatePageParams) (uuid.UU
 	return id, err
 }
 
+const updatePageContentRendering = `-- name: UpdatePageContentRendering :execrows
+update pages_content
+set
+  html = $1,
+  search_text = $2,
+  render_version = $3
+where id = $4
+`
+
+type UpdatePageContentRenderingParams struct {
+	Html          string
+	SearchText    string
+	RenderVersion int32
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdatePageContentRendering(ctx context.Context, arg UpdatePageContentRenderingParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePageContentRendering,
+		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updatePageCurrentContent = `-- name: UpdatePageCurrentContent :one
+update pages
+set
+  current_content_id = $1,
+  updated_at = now()
+where
+  id = $2 and
+  deleted_at is null
+returning id
+`
+
+type UpdatePageCurrentContentParams struct {
+	CurrentContentID uuid.UUID
+	ID               uuid.UUID
+}
+
+func (q *Queries) UpdatePageCurrentContent(ctx context.Context, arg UpdatePageCurrentContentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, updatePageCurrentContent, arg.CurrentContentID, arg.ID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updatePageMetadata = `-- name: UpdatePageMetadata :one
 update pages
 set
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgconn"
@@ -17,6 +18,7 @@ import (
 // - domain.PostUpdateRepository
 // - domain.PostFindRepository
 // - domain.PostListRepository
+// - domain.PostFeedRepository
 type PostRepository struct {
 	psql *sql.DB
 	q    *Queries
@@ -59,10 +61,16 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *domain.Post) erro
 	}
 
 	_, err = qtx.CreatePostContent(ctx, CreatePostContentParams{
-		ID:      postContentID,
-		PostID:  post.ID,
-		Title:   post.Title,
-		Content: post.Content,
+		ID:            postContentID,
+		PostID:        post.ID,
+		Title:         post.Title,
+		Format:        string(post.Format),
+		Content:       post.Content,
+		Html:          post.HTML,
+		SearchText:    domain.SearchText(post.HTML),
+		RenderVersion: domain.ContentRenderVersion,
+		Language:      NewNullString(post.Language),
+		SearchConfig:  domain.SearchConfig(post.Language),
 	})
 	if err != nil {
 		_ = tx.Rollback()
@@ -91,7 +99,10 @@ func (r *PostRepository) GetPostByID(ctx context.Context, id uuid.UUID, namespac
 		Namespace:   post.Namespace,
 		Slug:        post.Slug,
 		Title:       post.Title,
+		Format:      domain.ContentFormat(post.Format),
 		Content:     post.Content,
+		HTML:        post.Html,
+		Language:    NewStringFromNullString(post.Language),
 		PublishedAt: NewTimeFromNullTime(post.PublishedAt),
 		CreatedAt:   post.CreatedAt,
 		UpdatedAt:   post.UpdatedAt,
@@ -131,10 +142,16 @@ func (r *PostRepository) UpdatePost(ctx context.Context, post *domain.Post) erro
 	}
 
 	_, err = qtx.CreatePostContent(ctx, CreatePostContentParams{
-		ID:      postContentID,
-		PostID:  post.ID,
-		Title:   post.Title,
-		Content: post.Content,
+		ID:            postContentID,
+		PostID:        post.ID,
+		Title:         post.Title,
+		Format:        string(post.Format),
+		Content:       post.Content,
+		Html:          post.HTML,
+		SearchText:    domain.SearchText(post.HTML),
+		RenderVersion: domain.ContentRenderVersion,
+		Language:      NewNullString(post.Language),
+		SearchConfig:  domain.SearchConfig(post.Language),
 	})
 	if err != nil {
 		_ = tx.Rollback()
@@ -189,10 +206,12 @@ func (r *PostRepository) ListPostVersions(ctx context.Context, postID uuid.UUID)
 	versions := make([]domain.PostVersion, len(rows))
 	for i, row := range rows {
 		versions[i] = domain.PostVersion{
-			ID:        row.ID,
-			Version:   i + 1,
-			Title:     row.Title,
-			CreatedAt: row.CreatedAt,
+			ID:               row.ID,
+			Version:          i + 1,
+			Title:            row.Title,
+			CreatedAt:        row.CreatedAt,
+			RestoredFromID:   NewUUIDFromNullUUID(row.RestoredFromID),
+			RestoredByUserID: NewUUIDFromNullUUID(row.RestoredByUserID),
 		}
 	}
 
@@ -213,13 +232,69 @@ func (r *PostRepository) GetPostVersion(ctx context.Context, postID uuid.UUID, c
 	}
 
 	return &domain.PostVersion{
-		ID:        row.ID,
-		Title:     row.Title,
-		Content:   row.Content,
-		CreatedAt: row.CreatedAt,
+		ID:               row.ID,
+		Title:            row.Title,
+		Format:           domain.ContentFormat(row.Format),
+		Content:          row.Content,
+		HTML:             row.Html,
+		Language:         NewStringFromNullString(row.Language),
+		CreatedAt:        row.CreatedAt,
+		RestoredFromID:   NewUUIDFromNullUUID(row.RestoredFromID),
+		RestoredByUserID: NewUUIDFromNullUUID(row.RestoredByUserID),
 	}, nil
 }
 
+// RestorePostVersion implements domain.PostVersionRestoreRepository
+func (r *PostRepository) RestorePostVersion(ctx context.Context, post *domain.Post, restoredFromID uuid.UUID, restoredByUserID uuid.UUID) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not restore post version: %w", err)
+	}
+
+	postContentID := uuid.New()
+
+	qtx := r.q.WithTx(tx)
+
+	_, err = qtx.RestorePostContent(ctx, RestorePostContentParams{
+		ID:               postContentID,
+		PostID:           post.ID,
+		Title:            post.Title,
+		Format:           string(post.Format),
+		Content:          post.Content,
+		Html:             post.HTML,
+		SearchText:       domain.SearchText(post.HTML),
+		RenderVersion:    domain.ContentRenderVersion,
+		Language:         NewNullString(post.Language),
+		SearchConfig:     domain.SearchConfig(post.Language),
+		RestoredFromID:   NewNullUUID(restoredFromID),
+		RestoredByUserID: NewNullUUID(restoredByUserID),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not restore post version: %w", err)
+	}
+
+	_, err = qtx.UpdatePostCurrentContent(ctx, UpdatePostCurrentContentParams{
+		ID:               post.ID,
+		CurrentContentID: postContentID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrPostNotFound
+		}
+
+		return fmt.Errorf("could not restore post version: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not restore post version: %w", err)
+	}
+
+	return nil
+}
+
 // FindPostBySlug implements domain.PostFindRepository
 func (r *PostRepository) FindPostBySlug(ctx context.Context, namespace, slug string) (*domain.Post, error) {
 	post, err := r.q.FindPostBySlug(ctx, FindPostBySlugParams{
@@ -239,7 +314,10 @@ func (r *PostRepository) FindPostBySlug(ctx context.Context, namespace, slug str
 		Namespace:   post.Namespace,
 		Slug:        post.Slug,
 		Title:       post.Title,
+		Format:      domain.ContentFormat(post.Format),
 		Content:     post.Content,
+		HTML:        post.Html,
+		Language:    NewStringFromNullString(post.Language),
 		PublishedAt: NewTimeFromNullTime(post.PublishedAt),
 		CreatedAt:   post.CreatedAt,
 		UpdatedAt:   post.UpdatedAt,
@@ -286,7 +364,10 @@ func (r *PostRepository) ListPosts(ctx context.Context, namespace string, includ
 			Namespace:   p.Namespace,
 			Slug:        p.Slug,
 			Title:       p.Title,
+			Format:      domain.ContentFormat(p.Format),
 			Content:     p.Content,
+			HTML:        p.Html,
+			Language:    NewStringFromNullString(p.Language),
 			PublishedAt: NewTimeFromNullTime(p.PublishedAt),
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
@@ -304,3 +385,29 @@ func (r *PostRepository) ListPosts(ctx context.Context, namespace string, includ
 		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// ListPostFeedEntries implements domain.PostFeedRepository
+func (r *PostRepository) ListPostFeedEntries(ctx context.Context, namespace string, now time.Time, limit int) ([]domain.PostFeedEntry, error) {
+	rows, err := r.q.ListPostFeedEntries(ctx, ListPostFeedEntriesParams{
+		Namespace: namespace,
+		Now:       now,
+		ItemCount: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list post feed entries: %w", err)
+	}
+
+	entries := make([]domain.PostFeedEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = domain.PostFeedEntry{
+			ID:          row.ID,
+			Slug:        row.Slug,
+			Title:       row.Title,
+			HTML:        row.Html,
+			PublishedAt: row.PublishedAt,
+			UpdatedAt:   row.ContentUpdatedAt,
+		}
+	}
+
+	return entries, nil
+}
@@ -55,20 +55,38 @@ insert into posts_content (
   id,
   post_id,
   title,
-  content
+  format,
+  content,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config
 ) values (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  coalesce(to_regconfig($10::text), 'simple')
 ) returning id
 `
 
 type CreatePostContentParams struct {
-	ID      uuid.UUID
-	PostID  uuid.UUID
-	Title   string
-	Content string
+	ID            uuid.UUID
+	PostID        uuid.UUID
+	Title         string
+	Format        string
+	Content       string
+	Html          string
+	SearchText    string
+	RenderVersion int32
+	Language      sql.NullString
+	SearchConfig  string
 }
 
 func (q *Queries) CreatePostContent(ctx context.Context, arg CreatePostContentParams) (uuid.UUID, error) {
@@ -76,7 +94,13 @@ func (q *Queries) CreatePostContent(ctx context.Context, arg CreatePostContentPa
 		arg.ID,
 		arg.PostID,
 		arg.Title,
+		arg.Format,
 		arg.Content,
+		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.Language,
+		arg.SearchConfig,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -107,7 +131,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -130,7 +157,10 @@ type FindPostByIDRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
 	Content     string
+	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -144,7 +174,10 @@ func (q *Queries) FindPostByID(ctx context.Context, arg FindPostByIDParams) (Fin
 		&i.Namespace,
 		&i.Slug,
 		&i.Title,
+		&i.Format,
 		&i.Content,
+		&i.Html,
+		&i.Language,
 		&i.PublishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -158,7 +191,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -181,7 +217,10 @@ type FindPostBySlugRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
 	Content     string
+	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -195,7 +234,10 @@ func (q *Queries) FindPostBySlug(ctx context.Context, arg FindPostBySlugParams)
 		&i.Namespace,
 		&i.Slug,
 		&i.Title,
+		&i.Format,
 		&i.Content,
+		&i.Html,
+		&i.Language,
 		&i.PublishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -207,7 +249,12 @@ const getPostVersion = `-- name: GetPostVersion :one
 select
   id,
   title,
+  format,
   content,
+  html,
+  "language",
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from posts_content
 where id = $1
@@ -220,10 +267,15 @@ type GetPostVersionParams struct {
 }
 
 type GetPostVersionRow struct {
-	ID        uuid.UUID
-	Title     string
-	Content   string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	Title            string
+	Format           string
+	Content          string
+	Html             string
+	Language         sql.NullString
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	CreatedAt        time.Time
 }
 
 func (q *Queries) GetPostVersion(ctx context.Context, arg GetPostVersionParams) (GetPostVersionRow, error) {
@@ -232,16 +284,87 @@ func (q *Queries) GetPostVersion(ctx context.Context, arg GetPostVersionParams)
 	err := row.Scan(
 		&i.ID,
 		&i.Title,
+		&i.Format,
 		&i.Content,
+		&i.Html,
+		&i.Language,
+		&i.RestoredFromID,
+		&i.RestoredByUserID,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const listPostFeedEntries = `-- name: ListPostFeedEntries :many
+select
+  posts.id,
+  slug,
+  posts_content.title,
+  posts_content.html,
+  published_at::timestamp as published_at,
+  posts_content.created_at as content_updated_at
+from posts
+inner join posts_content
+  on posts_content.id = posts.current_content_id
+where
+  deleted_at is null
+  and published_at <= $1::timestamp
+  and "namespace" = $2
+order by published_at desc
+limit $3
+`
+
+type ListPostFeedEntriesParams struct {
+	Now       time.Time
+	Namespace string
+	ItemCount int32
+}
+
+type ListPostFeedEntriesRow struct {
+	ID               uuid.UUID
+	Slug             string
+	Title            string
+	Html             string
+	PublishedAt      time.Time
+	ContentUpdatedAt time.Time
+}
+
+func (q *Queries) ListPostFeedEntries(ctx context.Context, arg ListPostFeedEntriesParams) ([]ListPostFeedEntriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPostFeedEntries, arg.Now, arg.Namespace, arg.ItemCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostFeedEntriesRow
+	for rows.Next() {
+		var i ListPostFeedEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Html,
+			&i.PublishedAt,
+			&i.ContentUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPostVersions = `-- name: ListPostVersions :many
 select
   id,
   title,
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from posts_content
 where post_id = $1
@@ -249,9 +372,11 @@ order by created_at asc
 `
 
 type ListPostVersionsRow struct {
-	ID        uuid.UUID
-	Title     string
-	CreatedAt time.Time
+	ID               uuid.UUID
+	Title            string
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+	CreatedAt        time.Time
 }
 
 func (q *Queries) ListPostVersions(ctx context.Context, postID uuid.UUID) ([]ListPostVersionsRow, error) {
@@ -263,7 +388,13 @@ func (q *Queries) ListPostVersions(ctx context.Context, postID uuid.UUID) ([]Lis
 	var items []ListPostVersionsRow
 	for rows.Next() {
 		var i ListPostVersionsRow
-		if err := rows.Scan(&i.ID, &i.Title, &i.CreatedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.RestoredFromID,
+			&i.RestoredByUserID,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -283,7 +414,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -292,7 +426,7 @@ inner join posts_content
   on posts_content.id = posts.current_content_id
 where
   deleted_at is null
-  and ($1::boolean or published_at is not null)
+  and ($1::boolean or published_at <= now())
   and "namespace" = $2
 order by posts.created_at desc
 limit $4
@@ -311,7 +445,10 @@ type ListPostsRow struct {
 	Namespace   string
 	Slug        string
 	Title       string
+	Format      string
 	Content     string
+	Html        string
+	Language    sql.NullString
 	PublishedAt sql.NullTime
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -336,7 +473,10 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 			&i.Namespace,
 			&i.Slug,
 			&i.Title,
+			&i.Format,
 			&i.Content,
+			&i.Html,
+			&i.Language,
 			&i.PublishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -354,6 +494,51 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 	return items, nil
 }
 
+const listStalePostContent = `-- name: ListStalePostContent :many
+select
+  id,
+  format,
+  content
+from posts_content
+where render_version < $1
+order by id
+limit $2
+`
+
+type ListStalePostContentParams struct {
+	RenderVersion int32
+	BatchSize     int32
+}
+
+type ListStalePostContentRow struct {
+	ID      uuid.UUID
+	Format  string
+	Content string
+}
+
+func (q *Queries) ListStalePostContent(ctx context.Context, arg ListStalePostContentParams) ([]ListStalePostContentRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStalePostContent, arg.RenderVersion, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStalePostContentRow
+	for rows.Next() {
+		var i ListStalePostContentRow
+		if err := rows.Scan(&i.ID, &i.Format, &i.Content); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const postsMetadata = `-- name: PostsMetadata :one
 select
   count(posts.id) as total_size,
@@ -361,7 +546,7 @@ select
 from posts
 where
   deleted_at is null
-  and ($1::boolean or published_at is not null)
+  and ($1::boolean or published_at <= now())
   and "namespace" = $2
 `
 
@@ -382,6 +567,71 @@ func (q *Queries) PostsMetadata(ctx context.Context, arg PostsMetadataParams) (P
 	return i, err
 }
 
+const restorePostContent = `-- name: RestorePostContent :one
+insert into posts_content (
+  id,
+  post_id,
+  title,
+  format,
+  content,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config,
+  restored_from_id,
+  restored_by_user_id
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  coalesce(to_regconfig($10::text), 'simple'),
+  $11,
+  $12
+) returning id
+`
+
+type RestorePostContentParams struct {
+	ID               uuid.UUID
+	PostID           uuid.UUID
+	Title            string
+	Format           string
+	Content          string
+	Html             string
+	SearchText       string
+	RenderVersion    int32
+	Language         sql.NullString
+	SearchConfig     string
+	RestoredFromID   uuid.NullUUID
+	RestoredByUserID uuid.NullUUID
+}
+
+func (q *Queries) RestorePostContent(ctx context.Context, arg RestorePostContentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, restorePostContent,
+		arg.ID,
+		arg.PostID,
+		arg.Title,
+		arg.Format,
+		arg.Content,
+		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.Language,
+		arg.SearchConfig,
+		arg.RestoredFromID,
+		arg.RestoredByUserID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updatePost = `-- name: UpdatePost :one
 update posts
 set
@@ -414,6 +664,58 @@ func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) (uuid.UU
 	return id, err
 }
 
+const updatePostContentRendering = `-- name: UpdatePostContentRendering :execrows
+update posts_content
+set
+  html = $1,
+  search_text = $2,
+  render_version = $3
+where id = $4
+`
+
+type UpdatePostContentRenderingParams struct {
+	Html          string
+	SearchText    string
+	RenderVersion int32
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdatePostContentRendering(ctx context.Context, arg UpdatePostContentRenderingParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePostContentRendering,
+		arg.Html,
+		arg.SearchText,
+		arg.RenderVersion,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updatePostCurrentContent = `-- name: UpdatePostCurrentContent :one
+update posts
+set
+  current_content_id = $1,
+  updated_at = now()
+where
+  id = $2 and
+  deleted_at is null
+returning id
+`
+
+type UpdatePostCurrentContentParams struct {
+	CurrentContentID uuid.UUID
+	ID               uuid.UUID
+}
+
+func (q *Queries) UpdatePostCurrentContent(ctx context.Context, arg UpdatePostCurrentContentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, updatePostCurrentContent, arg.CurrentContentID, arg.ID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updatePostMetadata = `-- name: UpdatePostMetadata :one
 update posts
 set
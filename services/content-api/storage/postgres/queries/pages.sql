@@ -4,7 +4,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -22,7 +25,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -40,7 +46,10 @@ select
   "namespace",
   slug,
   pages_content.title,
+  pages_content.format,
+  pages_content.source,
   pages_content.html,
+  pages_content."language",
   published_at,
   pages.created_at,
   pages.updated_at
@@ -50,7 +59,7 @@ inner join pages_content
 where
   deleted_at is null
   and "namespace" = sqlc.arg('namespace')
-  and (sqlc.arg('include_drafts')::boolean or published_at is not null)
+  and (sqlc.arg('include_drafts')::boolean or published_at <= now())
 order by pages.created_at desc
 limit sqlc.arg('page_size')
 offset sqlc.arg('start_from');
@@ -75,12 +84,24 @@ insert into pages_content (
   id,
   page_id,
   title,
-  html
+  format,
+  source,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config
 ) values (
   sqlc.arg('id'),
   sqlc.arg('page_id'),
   sqlc.arg('title'),
-  sqlc.arg('html')
+  sqlc.arg('format'),
+  sqlc.arg('source'),
+  sqlc.arg('html'),
+  sqlc.arg('search_text'),
+  sqlc.arg('render_version'),
+  sqlc.narg('language'),
+  coalesce(to_regconfig(sqlc.arg('search_config')::text), 'simple')
 ) returning id;
 
 -- name: UpdatePage :one
@@ -113,7 +134,7 @@ select
 from pages
 where
   deleted_at is null
-  and (sqlc.arg('include_drafts')::boolean or published_at is not null)
+  and (sqlc.arg('include_drafts')::boolean or published_at <= now())
   and "namespace" = sqlc.arg('namespace');
 
 -- name: DeletePage :exec
@@ -127,6 +148,8 @@ where id = sqlc.arg('id')
 select
   id,
   title,
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from pages_content
 where page_id = sqlc.arg('page_id')
@@ -136,8 +159,70 @@ order by created_at asc;
 select
   id,
   title,
+  format,
+  source,
   html,
+  "language",
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from pages_content
 where id = sqlc.arg('id')
   and page_id = sqlc.arg('page_id');
+
+-- name: RestorePageContent :one
+insert into pages_content (
+  id,
+  page_id,
+  title,
+  format,
+  source,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config,
+  restored_from_id,
+  restored_by_user_id
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('page_id'),
+  sqlc.arg('title'),
+  sqlc.arg('format'),
+  sqlc.arg('source'),
+  sqlc.arg('html'),
+  sqlc.arg('search_text'),
+  sqlc.arg('render_version'),
+  sqlc.narg('language'),
+  coalesce(to_regconfig(sqlc.arg('search_config')::text), 'simple'),
+  sqlc.arg('restored_from_id'),
+  sqlc.arg('restored_by_user_id')
+) returning id;
+
+-- name: UpdatePageCurrentContent :one
+update pages
+set
+  current_content_id = sqlc.arg('current_content_id'),
+  updated_at = now()
+where
+  id = sqlc.arg('id') and
+  deleted_at is null
+returning id;
+
+-- name: ListStalePageContent :many
+select
+  id,
+  format,
+  source
+from pages_content
+where render_version < sqlc.arg('render_version')
+order by id
+limit sqlc.arg('batch_size');
+
+-- name: UpdatePageContentRendering :execrows
+update pages_content
+set
+  html = sqlc.arg('html'),
+  search_text = sqlc.arg('search_text'),
+  render_version = sqlc.arg('render_version')
+where id = sqlc.arg('id');
@@ -4,7 +4,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -22,7 +25,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -40,7 +46,10 @@ select
   "namespace",
   slug,
   posts_content.title,
+  posts_content.format,
   posts_content.content,
+  posts_content.html,
+  posts_content."language",
   published_at,
   posts.created_at,
   posts.updated_at
@@ -49,7 +58,7 @@ inner join posts_content
   on posts_content.id = posts.current_content_id
 where
   deleted_at is null
-  and (sqlc.arg('include_drafts')::boolean or published_at is not null)
+  and (sqlc.arg('include_drafts')::boolean or published_at <= now())
   and "namespace" = sqlc.arg('namespace')
 order by posts.created_at desc
 limit sqlc.arg('page_size')
@@ -75,12 +84,24 @@ insert into posts_content (
   id,
   post_id,
   title,
-  content
+  format,
+  content,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config
 ) values (
   sqlc.arg('id'),
   sqlc.arg('post_id'),
   sqlc.arg('title'),
-  sqlc.arg('content')
+  sqlc.arg('format'),
+  sqlc.arg('content'),
+  sqlc.arg('html'),
+  sqlc.arg('search_text'),
+  sqlc.arg('render_version'),
+  sqlc.narg('language'),
+  coalesce(to_regconfig(sqlc.arg('search_config')::text), 'simple')
 ) returning id;
 
 -- name: UpdatePost :one
@@ -113,7 +134,7 @@ select
 from posts
 where
   deleted_at is null
-  and (sqlc.arg('include_drafts')::boolean or published_at is not null)
+  and (sqlc.arg('include_drafts')::boolean or published_at <= now())
   and "namespace" = sqlc.arg('namespace');
 
 -- name: DeletePost :exec
@@ -127,6 +148,8 @@ where id = sqlc.arg('id')
 select
   id,
   title,
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from posts_content
 where post_id = sqlc.arg('post_id')
@@ -136,8 +159,88 @@ order by created_at asc;
 select
   id,
   title,
+  format,
   content,
+  html,
+  "language",
+  restored_from_id,
+  restored_by_user_id,
   created_at
 from posts_content
 where id = sqlc.arg('id')
   and post_id = sqlc.arg('post_id');
+
+-- name: RestorePostContent :one
+insert into posts_content (
+  id,
+  post_id,
+  title,
+  format,
+  content,
+  html,
+  search_text,
+  render_version,
+  "language",
+  search_config,
+  restored_from_id,
+  restored_by_user_id
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('post_id'),
+  sqlc.arg('title'),
+  sqlc.arg('format'),
+  sqlc.arg('content'),
+  sqlc.arg('html'),
+  sqlc.arg('search_text'),
+  sqlc.arg('render_version'),
+  sqlc.narg('language'),
+  coalesce(to_regconfig(sqlc.arg('search_config')::text), 'simple'),
+  sqlc.arg('restored_from_id'),
+  sqlc.arg('restored_by_user_id')
+) returning id;
+
+-- name: UpdatePostCurrentContent :one
+update posts
+set
+  current_content_id = sqlc.arg('current_content_id'),
+  updated_at = now()
+where
+  id = sqlc.arg('id') and
+  deleted_at is null
+returning id;
+
+-- name: ListPostFeedEntries :many
+select
+  posts.id,
+  slug,
+  posts_content.title,
+  posts_content.html,
+  published_at::timestamp as published_at,
+  posts_content.created_at as content_updated_at
+from posts
+inner join posts_content
+  on posts_content.id = posts.current_content_id
+where
+  deleted_at is null
+  and published_at <= sqlc.arg('now')::timestamp
+  and "namespace" = sqlc.arg('namespace')
+order by published_at desc
+limit sqlc.arg('item_count');
+
+-- name: ListStalePostContent :many
+select
+  id,
+  format,
+  content
+from posts_content
+where render_version < sqlc.arg('render_version')
+order by id
+limit sqlc.arg('batch_size');
+
+-- name: UpdatePostContentRendering :execrows
+update posts_content
+set
+  html = sqlc.arg('html'),
+  search_text = sqlc.arg('search_text'),
+  render_version = sqlc.arg('render_version')
+where id = sqlc.arg('id');
@@ -0,0 +1,143 @@
+-- name: CreateContentSchedule :exec
+insert into content_schedules (
+  id,
+  "namespace",
+  content_type,
+  content_id,
+  "action",
+  scheduled_at,
+  created_by_user_id
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('namespace'),
+  sqlc.arg('content_type'),
+  sqlc.arg('content_id'),
+  sqlc.arg('action'),
+  sqlc.arg('scheduled_at'),
+  sqlc.arg('created_by_user_id')
+);
+
+-- name: CancelContentSchedule :execrows
+update content_schedules
+set cancelled_at = now()
+where
+  id = sqlc.arg('id')
+  and "namespace" = sqlc.arg('namespace')
+  and executed_at is null
+  and cancelled_at is null;
+
+-- name: ContentScheduleExists :one
+select exists(
+  select 1
+  from content_schedules
+  where
+    id = sqlc.arg('id')
+    and "namespace" = sqlc.arg('namespace')
+) as "exists";
+
+-- name: ListUpcomingContentSchedules :many
+select
+  content_schedules.id,
+  content_schedules."namespace",
+  content_schedules.content_type,
+  content_schedules.content_id,
+  content_schedules."action",
+  content_schedules.scheduled_at,
+  content_schedules.created_by_user_id,
+  content_schedules.created_at,
+  coalesce(pages.slug, posts.slug, '')::varchar as slug,
+  coalesce(pages_content.title, posts_content.title, '')::varchar as title
+from content_schedules
+left join pages
+  on content_schedules.content_type = 'page'
+  and pages.id = content_schedules.content_id
+left join pages_content
+  on pages_content.id = pages.current_content_id
+left join posts
+  on content_schedules.content_type = 'post'
+  and posts.id = content_schedules.content_id
+left join posts_content
+  on posts_content.id = posts.current_content_id
+where
+  content_schedules."namespace" = sqlc.arg('namespace')
+  and content_schedules.executed_at is null
+  and content_schedules.cancelled_at is null
+order by content_schedules.scheduled_at asc
+limit sqlc.arg('page_size');
+
+-- name: ListDueContentSchedules :many
+select
+  id,
+  "namespace",
+  content_type,
+  content_id,
+  "action",
+  scheduled_at,
+  created_by_user_id,
+  created_at
+from content_schedules
+where
+  executed_at is null
+  and cancelled_at is null
+  and scheduled_at <= sqlc.arg('now')
+order by scheduled_at asc
+limit sqlc.arg('batch_size');
+
+-- name: MarkContentScheduleExecuted :execrows
+update content_schedules
+set executed_at = now()
+where
+  id = sqlc.arg('id')
+  and executed_at is null
+  and cancelled_at is null;
+
+-- name: UpdatePagePublishedAt :execrows
+update pages
+set
+  published_at = sqlc.arg('published_at'),
+  updated_at = now()
+where
+  id = sqlc.arg('id')
+  and "namespace" = sqlc.arg('namespace')
+  and deleted_at is null;
+
+-- name: UpdatePostPublishedAt :execrows
+update posts
+set
+  published_at = sqlc.arg('published_at'),
+  updated_at = now()
+where
+  id = sqlc.arg('id')
+  and "namespace" = sqlc.arg('namespace')
+  and deleted_at is null;
+
+-- name: CreateContentEvent :one
+insert into content_events (
+  "namespace",
+  event_type,
+  content_type,
+  content_id,
+  schedule_id
+) values (
+  sqlc.arg('namespace'),
+  sqlc.arg('event_type'),
+  sqlc.arg('content_type'),
+  sqlc.arg('content_id'),
+  sqlc.arg('schedule_id')
+) returning id, occurred_at;
+
+-- name: ListContentEvents :many
+select
+  id,
+  "namespace",
+  event_type,
+  content_type,
+  content_id,
+  schedule_id,
+  occurred_at
+from content_events
+where
+  "namespace" = sqlc.arg('namespace')
+  and id > sqlc.arg('after_id')
+order by id asc
+limit sqlc.arg('page_size');
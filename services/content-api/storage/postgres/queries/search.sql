@@ -0,0 +1,56 @@
+-- name: SearchContent :many
+with search_query as (
+  select
+    websearch_to_tsquery(coalesce(to_regconfig(sqlc.arg('search_config')::text), 'simple'), sqlc.arg('query')::text)
+      || websearch_to_tsquery('simple', sqlc.arg('query')::text) as q
+), documents as (
+  select
+    'page'::text as content_type,
+    pages.id,
+    pages.slug,
+    pages_content.title,
+    pages_content.search_text as body,
+    pages_content.search_config,
+    pages_content.search_vector,
+    pages.published_at
+  from pages
+  inner join pages_content
+    on pages_content.id = pages.current_content_id
+  where
+    sqlc.arg('include_pages')::boolean
+    and pages.deleted_at is null
+    and pages."namespace" = sqlc.arg('namespace')
+    and (sqlc.arg('include_drafts')::boolean or pages.published_at <= now())
+  union all
+  select
+    'post'::text as content_type,
+    posts.id,
+    posts.slug,
+    posts_content.title,
+    posts_content.search_text as body,
+    posts_content.search_config,
+    posts_content.search_vector,
+    posts.published_at
+  from posts
+  inner join posts_content
+    on posts_content.id = posts.current_content_id
+  where
+    sqlc.arg('include_posts')::boolean
+    and posts.deleted_at is null
+    and posts."namespace" = sqlc.arg('namespace')
+    and (sqlc.arg('include_drafts')::boolean or posts.published_at <= now())
+)
+select
+  documents.content_type,
+  documents.id,
+  documents.slug,
+  documents.title,
+  documents.published_at,
+  ts_headline(documents.search_config, documents.body, search_query.q, sqlc.arg('headline_options')::text)::text as snippet,
+  ts_rank_cd(documents.search_vector, search_query.q)::real as rank,
+  count(*) over() as total_size
+from documents, search_query
+where documents.search_vector @@ search_query.q
+order by rank desc, documents.published_at desc nulls last
+limit sqlc.arg('page_size')
+offset sqlc.arg('start_from');
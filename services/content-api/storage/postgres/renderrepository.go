@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+// RenderRepository implements domain.ContentRerenderRepository
+type RenderRepository struct {
+	psql *sql.DB
+	q    *Queries
+}
+
+func NewRenderRepository(psql *sql.DB) *RenderRepository {
+	return &RenderRepository{
+		psql: psql,
+		q:    &Queries{psql},
+	}
+}
+
+// ListStaleContentRevisions implements domain.ContentRerenderRepository
+func (r *RenderRepository) ListStaleContentRevisions(ctx context.Context, renderVersion int, limit int) ([]domain.ContentRevision, error) {
+	pages, err := r.q.ListStalePageContent(ctx, ListStalePageContentParams{
+		RenderVersion: int32(renderVersion),
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list stale page content: %w", err)
+	}
+
+	revisions := make([]domain.ContentRevision, 0, len(pages))
+	for _, row := range pages {
+		revisions = append(revisions, domain.ContentRevision{
+			ContentType: domain.ContentTypePage,
+			ID:          row.ID,
+			Format:      domain.ContentFormat(row.Format),
+			Source:      row.Source,
+		})
+	}
+	if len(revisions) >= limit {
+		return revisions, nil
+	}
+
+	posts, err := r.q.ListStalePostContent(ctx, ListStalePostContentParams{
+		RenderVersion: int32(renderVersion),
+		BatchSize:     int32(limit - len(revisions)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list stale post content: %w", err)
+	}
+
+	for _, row := range posts {
+		revisions = append(revisions, domain.ContentRevision{
+			ContentType: domain.ContentTypePost,
+			ID:          row.ID,
+			Format:      domain.ContentFormat(row.Format),
+			Source:      row.Content,
+		})
+	}
+
+	return revisions, nil
+}
+
+// UpdateContentRendering implements domain.ContentRerenderRepository
+func (r *RenderRepository) UpdateContentRendering(ctx context.Context, revision *domain.ContentRevision, html string, renderVersion int) error {
+	var err error
+	switch revision.ContentType {
+	case domain.ContentTypePage:
+		_, err = r.q.UpdatePageContentRendering(ctx, UpdatePageContentRenderingParams{
+			ID:            revision.ID,
+			Html:          html,
+			SearchText:    domain.SearchText(html),
+			RenderVersion: int32(renderVersion),
+		})
+	case domain.ContentTypePost:
+		_, err = r.q.UpdatePostContentRendering(ctx, UpdatePostContentRenderingParams{
+			ID:            revision.ID,
+			Html:          html,
+			SearchText:    domain.SearchText(html),
+			RenderVersion: int32(renderVersion),
+		})
+	default:
+		return fmt.Errorf("could not update rendering: unknown content type %q", revision.ContentType)
+	}
+	if err != nil {
+		return fmt.Errorf("could not update rendering: %w", err)
+	}
+
+	return nil
+}
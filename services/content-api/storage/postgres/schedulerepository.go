@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+// ScheduleRepository implements all scheduling-related domain interfaces:
+// - domain.ContentScheduleCreateRepository
+// - domain.ContentScheduleCancelRepository
+// - domain.ContentScheduleListUpcomingRepository
+// - domain.ContentScheduleWorkerRepository
+// - domain.ContentEventListRepository
+type ScheduleRepository struct {
+	psql *sql.DB
+	q    *Queries
+}
+
+func NewScheduleRepository(psql *sql.DB) *ScheduleRepository {
+	return &ScheduleRepository{
+		psql: psql,
+		q:    &Queries{psql},
+	}
+}
+
+// CreateContentSchedule implements domain.ContentScheduleCreateRepository
+func (r *ScheduleRepository) CreateContentSchedule(ctx context.Context, schedule *domain.ContentSchedule) error {
+	if err := r.findContent(ctx, schedule.ContentType, schedule.ContentID, schedule.Namespace); err != nil {
+		return err
+	}
+
+	err := r.q.CreateContentSchedule(ctx, CreateContentScheduleParams{
+		ID:              schedule.ID,
+		Namespace:       schedule.Namespace,
+		ContentType:     string(schedule.ContentType),
+		ContentID:       schedule.ContentID,
+		Action:          string(schedule.Action),
+		ScheduledAt:     schedule.ScheduledAt,
+		CreatedByUserID: schedule.CreatedByUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepository) findContent(ctx context.Context, contentType domain.ContentType, id uuid.UUID, namespace string) error {
+	var err error
+	notFound := domain.ErrPageNotFound
+	switch contentType {
+	case domain.ContentTypePage:
+		_, err = r.q.FindPageByID(ctx, FindPageByIDParams{ID: id, Namespace: namespace})
+	case domain.ContentTypePost:
+		notFound = domain.ErrPostNotFound
+		_, err = r.q.FindPostByID(ctx, FindPostByIDParams{ID: id, Namespace: namespace})
+	default:
+		return domain.ErrInvalidSchedule
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return fmt.Errorf("could not find scheduled content: %w", err)
+	}
+	return nil
+}
+
+// CancelContentSchedule implements domain.ContentScheduleCancelRepository
+func (r *ScheduleRepository) CancelContentSchedule(ctx context.Context, id uuid.UUID, namespace string) error {
+	rows, err := r.q.CancelContentSchedule(ctx, CancelContentScheduleParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("could not cancel schedule: %w", err)
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	exists, err := r.q.ContentScheduleExists(ctx, ContentScheduleExistsParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("could not cancel schedule: %w", err)
+	}
+	if !exists {
+		return domain.ErrScheduleNotFound
+	}
+
+	return fmt.Errorf("%w: schedule was already executed or cancelled", domain.ErrConflict)
+}
+
+// ListUpcomingContentSchedules implements domain.ContentScheduleListUpcomingRepository
+func (r *ScheduleRepository) ListUpcomingContentSchedules(ctx context.Context, namespace string, limit int) ([]domain.ContentSchedule, error) {
+	rows, err := r.q.ListUpcomingContentSchedules(ctx, ListUpcomingContentSchedulesParams{
+		Namespace: namespace,
+		PageSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list upcoming schedules: %w", err)
+	}
+
+	schedules := make([]domain.ContentSchedule, len(rows))
+	for i, row := range rows {
+		schedules[i] = domain.ContentSchedule{
+			ID:              row.ID,
+			Namespace:       row.Namespace,
+			ContentType:     domain.ContentType(row.ContentType),
+			ContentID:       row.ContentID,
+			Action:          domain.ScheduleAction(row.Action),
+			ScheduledAt:     row.ScheduledAt,
+			CreatedByUserID: row.CreatedByUserID,
+			CreatedAt:       row.CreatedAt,
+			Slug:            row.Slug,
+			Title:           row.Title,
+		}
+	}
+
+	return schedules, nil
+}
+
+// ListDueContentSchedules implements domain.ContentScheduleWorkerRepository
+func (r *ScheduleRepository) ListDueContentSchedules(ctx context.Context, now time.Time, limit int) ([]domain.ContentSchedule, error) {
+	rows, err := r.q.ListDueContentSchedules(ctx, ListDueContentSchedulesParams{
+		Now:       now,
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list due schedules: %w", err)
+	}
+
+	schedules := make([]domain.ContentSchedule, len(rows))
+	for i, row := range rows {
+		schedules[i] = domain.ContentSchedule{
+			ID:              row.ID,
+			Namespace:       row.Namespace,
+			ContentType:     domain.ContentType(row.ContentType),
+			ContentID:       row.ContentID,
+			Action:          domain.ScheduleAction(row.Action),
+			ScheduledAt:     row.ScheduledAt,
+			CreatedByUserID: row.CreatedByUserID,
+			CreatedAt:       row.CreatedAt,
+		}
+	}
+
+	return schedules, nil
+}
+
+// ExecuteContentSchedule implements domain.ContentScheduleWorkerRepository
+func (r *ScheduleRepository) ExecuteContentSchedule(ctx context.Context, schedule *domain.ContentSchedule, publishedAt *time.Time, event *domain.ContentEvent) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not execute schedule: %w", err)
+	}
+
+	qtx := r.q.WithTx(tx)
+
+	rows, err := qtx.MarkContentScheduleExecuted(ctx, schedule.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not execute schedule: %w", err)
+	}
+	if rows == 0 {
+		_ = tx.Rollback()
+		return domain.ErrConflict
+	}
+
+	notFound := domain.ErrPageNotFound
+	switch schedule.ContentType {
+	case domain.ContentTypePage:
+		rows, err = qtx.UpdatePagePublishedAt(ctx, UpdatePagePublishedAtParams{
+			ID:          schedule.ContentID,
+			Namespace:   schedule.Namespace,
+			PublishedAt: NewNullTime(publishedAt),
+		})
+	case domain.ContentTypePost:
+		notFound = domain.ErrPostNotFound
+		rows, err = qtx.UpdatePostPublishedAt(ctx, UpdatePostPublishedAtParams{
+			ID:          schedule.ContentID,
+			Namespace:   schedule.Namespace,
+			PublishedAt: NewNullTime(publishedAt),
+		})
+	default:
+		_ = tx.Rollback()
+		return domain.ErrInvalidSchedule
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not execute schedule: %w", err)
+	}
+
+	// The content was deleted after it was scheduled, cancel the schedule
+	// instead so it isn't retried.
+	if rows == 0 {
+		_ = tx.Rollback()
+
+		_, err = r.q.CancelContentSchedule(ctx, CancelContentScheduleParams{
+			ID:        schedule.ID,
+			Namespace: schedule.Namespace,
+		})
+		if err != nil {
+			return fmt.Errorf("could not cancel schedule of deleted content: %w", err)
+		}
+
+		return notFound
+	}
+
+	var scheduleID uuid.NullUUID
+	if event.ScheduleID != nil {
+		scheduleID = NewNullUUID(*event.ScheduleID)
+	}
+	created, err := qtx.CreateContentEvent(ctx, CreateContentEventParams{
+		Namespace:   event.Namespace,
+		EventType:   string(event.Type),
+		ContentType: string(event.ContentType),
+		ContentID:   event.ContentID,
+		ScheduleID:  scheduleID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not execute schedule: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not execute schedule: %w", err)
+	}
+
+	event.ID = created.ID
+	event.OccurredAt = created.OccurredAt
+
+	return nil
+}
+
+// ListContentEvents implements domain.ContentEventListRepository
+func (r *ScheduleRepository) ListContentEvents(ctx context.Context, namespace string, afterID int64, limit int) ([]domain.ContentEvent, error) {
+	rows, err := r.q.ListContentEvents(ctx, ListContentEventsParams{
+		Namespace: namespace,
+		AfterID:   afterID,
+		PageSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list content events: %w", err)
+	}
+
+	events := make([]domain.ContentEvent, len(rows))
+	for i, row := range rows {
+		events[i] = domain.ContentEvent{
+			ID:          row.ID,
+			Namespace:   row.Namespace,
+			Type:        domain.ContentEventType(row.EventType),
+			ContentType: domain.ContentType(row.ContentType),
+			ContentID:   row.ContentID,
+			ScheduleID:  NewUUIDFromNullUUID(row.ScheduleID),
+			OccurredAt:  row.OccurredAt,
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/content-api/domain"
+)
+
+// searchHeadlineOptions configures the snippets returned by ts_headline, the
+// matches are marked so the domain can escape the snippet before highlighting.
+var searchHeadlineOptions = fmt.Sprintf(
+	`StartSel=%s, StopSel=%s, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`,
+	domain.SearchHighlightStart,
+	domain.SearchHighlightStop,
+)
+
+// SearchRepository implements domain.ContentSearchRepository
+type SearchRepository struct {
+	psql *sql.DB
+	q    *Queries
+}
+
+func NewSearchRepository(psql *sql.DB) *SearchRepository {
+	return &SearchRepository{
+		psql: psql,
+		q:    &Queries{psql},
+	}
+}
+
+// SearchContent implements domain.ContentSearchRepository
+func (r *SearchRepository) SearchContent(ctx context.Context, query *domain.ContentSearchQuery) (*domain.ContentSearchResult, error) {
+	rows, err := r.q.SearchContent(ctx, SearchContentParams{
+		HeadlineOptions: searchHeadlineOptions,
+		StartFrom:       int32(query.Page * query.PageSize),
+		PageSize:        int32(query.PageSize),
+		SearchConfig:    query.SearchConfig,
+		Query:           query.Query,
+		IncludePages:    query.IncludePages,
+		IncludePosts:    query.IncludePosts,
+		IncludeDrafts:   query.IncludeDrafts,
+		Namespace:       query.Namespace,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("could not search content: %w", err)
+	}
+
+	result := &domain.ContentSearchResult{
+		Hits: make([]domain.ContentSearchHit, len(rows)),
+	}
+	for i, row := range rows {
+		result.Hits[i] = domain.ContentSearchHit{
+			ContentType: domain.ContentType(row.ContentType),
+			ID:          row.ID,
+			Slug:        row.Slug,
+			Title:       row.Title,
+			PublishedAt: NewTimeFromNullTime(row.PublishedAt),
+			Rank:        row.Rank,
+			Snippet:     row.Snippet,
+		}
+		result.TotalSize = int(row.TotalSize)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package ory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commonauthz "github.com/tadoku/tadoku/services/common/client/authz"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// userRoleRelations are the relations a user can hold on the app object.
+var userRoleRelations = []string{"admins", "banned"}
+
+const (
+	contestNamespace          = "contest"
+	contestInviteesRelation   = "invitees"
+	contestOrganizersRelation = "organizers"
+	contestRegisterPermit     = "register"
+	contestModeratePermit     = "moderate"
+)
+
+// RelationshipClient manages Keto relation tuples through authz-api's
+// internal relationships endpoint.
+type RelationshipClient struct {
+	client    *commonauthz.Client
+	namespace string
+	object    string
+}
+
+func NewRelationshipClient(client *commonauthz.Client, namespace, object string) *RelationshipClient {
+	return &RelationshipClient{client: client, namespace: namespace, object: object}
+}
+
+// RemoveUserRelationships deletes every role tuple held by the user.
+// Deleting a tuple that does not exist succeeds, so this is safe to retry.
+func (c *RelationshipClient) RemoveUserRelationships(ctx context.Context, userID uuid.UUID) error {
+	subjectID := userID.String()
+	for _, relation := range userRoleRelations {
+		err := c.client.DeleteRelationship(ctx, commonauthz.RelationshipWriteRequest{
+			Namespace: c.namespace,
+			Object:    c.object,
+			Relation:  relation,
+			SubjectID: &subjectID,
+		})
+		if err != nil {
+			return fmt.Errorf("could not delete %s relationship: %w", relation, err)
+		}
+	}
+	return nil
+}
+
+// CanRegisterForContest checks the contest#register permit of the user.
+func (c *RelationshipClient) CanRegisterForContest(ctx context.Context, contestID, userID uuid.UUID) (bool, error) {
+	return c.checkContestPermit(ctx, contestID, contestRegisterPermit, userID)
+}
+
+// AddContestInvitee allows the user to register for a private contest.
+// Adding a tuple that already exists succeeds, so this is safe to retry.
+func (c *RelationshipClient) AddContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error {
+	return c.writeContestRelationship(ctx, c.client.CreateRelationship, contestID, contestInviteesRelation, userID)
+}
+
+// RemoveContestInvitee revokes the user's access to a private contest.
+func (c *RelationshipClient) RemoveContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error {
+	return c.writeContestRelationship(ctx, c.client.DeleteRelationship, contestID, contestInviteesRelation, userID)
+}
+
+// IsContestOrganizer checks the contest#moderate permit of the user.
+func (c *RelationshipClient) IsContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) (bool, error) {
+	return c.checkContestPermit(ctx, contestID, contestModeratePermit, userID)
+}
+
+// AddContestOrganizer makes the user co-organizer of a contest.
+// Adding a tuple that already exists succeeds, so this is safe to retry.
+func (c *RelationshipClient) AddContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error {
+	return c.writeContestRelationship(ctx, c.client.CreateRelationship, contestID, contestOrganizersRelation, userID)
+}
+
+// RemoveContestOrganizer revokes the user's co-organizer role on a contest.
+func (c *RelationshipClient) RemoveContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error {
+	return c.writeContestRelationship(ctx, c.client.DeleteRelationship, contestID, contestOrganizersRelation, userID)
+}
+
+func (c *RelationshipClient) checkContestPermit(ctx context.Context, contestID uuid.UUID, permit string, userID uuid.UUID) (bool, error) {
+	subjectID := userID.String()
+	allowed, err := c.client.CheckPermission(ctx, commonauthz.PermissionCheckRequest{
+		Namespace: contestNamespace,
+		Object:    contestID.String(),
+		Relation:  permit,
+		SubjectID: &subjectID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w: could not check contest %s permission: %w", commondomain.ErrAuthzUnavailable, permit, err)
+	}
+	return allowed, nil
+}
+
+func (c *RelationshipClient) writeContestRelationship(
+	ctx context.Context,
+	write func(context.Context, commonauthz.RelationshipWriteRequest) error,
+	contestID uuid.UUID,
+	relation string,
+	userID uuid.UUID,
+) error {
+	subjectID := userID.String()
+	err := write(ctx, commonauthz.RelationshipWriteRequest{
+		Namespace: contestNamespace,
+		Object:    contestID.String(),
+		Relation:  relation,
+		SubjectID: &subjectID,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: could not write contest %s relationship: %w", commondomain.ErrAuthzUnavailable, relation, err)
+	}
+	return nil
+}
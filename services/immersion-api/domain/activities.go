@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"sort"
+	"sync"
 )
 
 type ActivityInputType string
@@ -12,30 +13,59 @@ const (
 	ActivityInputTypeTimePrimary   ActivityInputType = "time_primary"
 )
 
-var activities = []Activity{
-	{ID: 1, Name: "Reading", Default: true, InputType: ActivityInputTypeAmountPrimary},
-	{ID: 2, Name: "Listening", Default: true, InputType: ActivityInputTypeTimePrimary},
-	{ID: 3, Name: "Writing", Default: false, InputType: ActivityInputTypeAmountPrimary},
-	{ID: 4, Name: "Speaking", Default: false, InputType: ActivityInputTypeTimePrimary},
-	{ID: 5, Name: "Study", Default: false, InputType: ActivityInputTypeTimePrimary},
-}
+// defaultActivities are the activities seeded by the migrations. They serve
+// lookups until the catalog is loaded from the database.
+var defaultActivities = []Activity{
+	{ID: 1, Key: "reading", Name: "Reading", Default: true, InputType: ActivityInputTypeAmountPrimary, DurationScorePerMinute: 0.2},
+	{ID: 2, Key: "listening", Name: "Listening", Default: true, InputType: ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.4},
+	{ID: 3, Key: "writing", Name: "Writing", Default: false, InputType: ActivityInputTypeAmountPrimary, DurationScorePerMinute: 0.2},
+	{ID: 4, Key: "speaking", Name: "Speaking", Default: false, InputType: ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.5},
+	{ID: 5, Key: "study", Name: "Study", Default: false, InputType: ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.5},
+}
+
+// activityCatalog caches the activities in process, activities are looked up
+// for nearly every request and rarely change.
+type activityCatalog struct {
+	mu         sync.RWMutex
+	activities []Activity
+	byID       map[int32]Activity
+}
+
+func (c *activityCatalog) replace(activities []Activity) {
+	sorted := make([]Activity, len(activities))
+	copy(sorted, activities)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	byID := make(map[int32]Activity, len(sorted))
+	for _, activity := range sorted {
+		byID[activity.ID] = activity
+	}
 
-var activitiesByID = map[int32]Activity{
-	1: activities[0],
-	2: activities[1],
-	3: activities[2],
-	4: activities[3],
-	5: activities[4],
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.activities = sorted
+	c.byID = byID
 }
 
+var activities = func() *activityCatalog {
+	catalog := &activityCatalog{}
+	catalog.replace(defaultActivities)
+	return catalog
+}()
+
 func Activities() []Activity {
-	res := make([]Activity, len(activities))
-	copy(res, activities)
+	activities.mu.RLock()
+	defer activities.mu.RUnlock()
+	res := make([]Activity, len(activities.activities))
+	copy(res, activities.activities)
 	return res
 }
 
 func ActivityByID(id int32) (Activity, bool) {
-	activity, ok := activitiesByID[id]
+	activities.mu.RLock()
+	defer activities.mu.RUnlock()
+	activity, ok := activities.byID[id]
 	return activity, ok
 }
 
@@ -12,11 +12,11 @@ func TestActivities(t *testing.T) {
 		activities := domain.Activities()
 
 		assert.Equal(t, []domain.Activity{
-			{ID: 1, Name: "Reading", Default: true, InputType: domain.ActivityInputTypeAmountPrimary},
-			{ID: 2, Name: "Listening", Default: true, InputType: domain.ActivityInputTypeTimePrimary},
-			{ID: 3, Name: "Writing", Default: false, InputType: domain.ActivityInputTypeAmountPrimary},
-			{ID: 4, Name: "Speaking", Default: false, InputType: domain.ActivityInputTypeTimePrimary},
-			{ID: 5, Name: "Study", Default: false, InputType: domain.ActivityInputTypeTimePrimary},
+			{ID: 1, Key: "reading", Name: "Reading", Default: true, InputType: domain.ActivityInputTypeAmountPrimary, DurationScorePerMinute: 0.2},
+			{ID: 2, Key: "listening", Name: "Listening", Default: true, InputType: domain.ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.4},
+			{ID: 3, Key: "writing", Name: "Writing", Default: false, InputType: domain.ActivityInputTypeAmountPrimary, DurationScorePerMinute: 0.2},
+			{ID: 4, Key: "speaking", Name: "Speaking", Default: false, InputType: domain.ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.5},
+			{ID: 5, Key: "study", Name: "Study", Default: false, InputType: domain.ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.5},
 		}, activities)
 	})
 
@@ -25,10 +25,12 @@ func TestActivities(t *testing.T) {
 
 		assert.True(t, ok)
 		assert.Equal(t, domain.Activity{
-			ID:        1,
-			Name:      "Reading",
-			Default:   true,
-			InputType: domain.ActivityInputTypeAmountPrimary,
+			ID:                     1,
+			Key:                    "reading",
+			Name:                   "Reading",
+			Default:                true,
+			InputType:              domain.ActivityInputTypeAmountPrimary,
+			DurationScorePerMinute: 0.2,
 		}, activity)
 	})
 
@@ -44,9 +46,9 @@ func TestActivities(t *testing.T) {
 
 		assert.True(t, ok)
 		assert.Equal(t, []domain.Activity{
-			{ID: 2, Name: "Listening", Default: true, InputType: domain.ActivityInputTypeTimePrimary},
-			{ID: 1, Name: "Reading", Default: true, InputType: domain.ActivityInputTypeAmountPrimary},
-			{ID: 5, Name: "Study", Default: false, InputType: domain.ActivityInputTypeTimePrimary},
+			{ID: 2, Key: "listening", Name: "Listening", Default: true, InputType: domain.ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.4},
+			{ID: 1, Key: "reading", Name: "Reading", Default: true, InputType: domain.ActivityInputTypeAmountPrimary, DurationScorePerMinute: 0.2},
+			{ID: 5, Key: "study", Name: "Study", Default: false, InputType: domain.ActivityInputTypeTimePrimary, DurationScorePerMinute: 0.5},
 		}, activities)
 	})
 }
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ActivityCatalogWorker reloads the cached activities at an interval, so
+// changes made through another API instance are picked up.
+type ActivityCatalogWorker struct {
+	repo     ActivityCatalogRepository
+	interval time.Duration
+}
+
+func NewActivityCatalogWorker(repo ActivityCatalogRepository, interval time.Duration) *ActivityCatalogWorker {
+	return &ActivityCatalogWorker{repo: repo, interval: interval}
+}
+
+// Run reloads the catalog at the configured interval until the context is
+// cancelled.
+func (w *ActivityCatalogWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := RefreshActivityCatalog(ctx, w.repo); err != nil {
+				slog.ErrorContext(ctx, "activity catalog worker: could not refresh activities", "error", err)
+			}
+		}
+	}
+}
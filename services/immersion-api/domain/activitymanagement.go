@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+)
+
+type ActivityCatalogRepository interface {
+	ListActivities(ctx context.Context) ([]Activity, error)
+}
+
+type ActivityManagementRepository interface {
+	ActivityCatalogRepository
+	CreateActivity(ctx context.Context, activity *Activity) (*Activity, error)
+	UpdateActivity(ctx context.Context, activity *Activity) (*Activity, error)
+	ActivityNameExists(ctx context.Context, name string, excludeID int32) (bool, error)
+	ActivityKeyExists(ctx context.Context, key string) (bool, error)
+}
+
+type ActivityCreateRequest struct {
+	Name                   string
+	Default                bool
+	InputType              ActivityInputType
+	DurationScorePerMinute float32
+}
+
+type ActivityUpdateRequest struct {
+	ID                     int32
+	Name                   string
+	Default                bool
+	InputType              ActivityInputType
+	DurationScorePerMinute float32
+}
+
+// RefreshActivityCatalog replaces the cached activities with the ones stored
+// in the database.
+func RefreshActivityCatalog(ctx context.Context, repo ActivityCatalogRepository) error {
+	loaded, err := repo.ListActivities(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load activities: %w", err)
+	}
+	activities.replace(loaded)
+	return nil
+}
+
+type ActivityManagement struct {
+	repo ActivityManagementRepository
+}
+
+func NewActivityManagement(repo ActivityManagementRepository) *ActivityManagement {
+	return &ActivityManagement{repo: repo}
+}
+
+func (s *ActivityManagement) List(ctx context.Context) ([]Activity, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	activities, err := s.repo.ListActivities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list activities: %w", err)
+	}
+
+	return activities, nil
+}
+
+// Create adds an activity. Activities cannot be deleted since logs and
+// contests keep referring to them.
+func (s *ActivityManagement) Create(ctx context.Context, req *ActivityCreateRequest) (*Activity, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	activity := &Activity{
+		Name:                   strings.TrimSpace(req.Name),
+		Default:                req.Default,
+		InputType:              req.InputType,
+		DurationScorePerMinute: req.DurationScorePerMinute,
+	}
+	if err := s.validate(ctx, activity); err != nil {
+		return nil, err
+	}
+
+	activity.Key = activityKey(activity.Name)
+	if !unitKeyPattern.MatchString(activity.Key) {
+		return nil, fmt.Errorf("%w: name must start with a latin letter to derive the activity key, got %q", ErrRequestInvalid, activity.Key)
+	}
+	exists, err := s.repo.ActivityKeyExists(ctx, activity.Key)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if activity key exists: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: activity key '%s' already exists", ErrConflict, activity.Key)
+	}
+
+	created, err := s.repo.CreateActivity(ctx, activity)
+	if err != nil {
+		return nil, fmt.Errorf("could not create activity: %w", err)
+	}
+	s.refresh(ctx)
+
+	return created, nil
+}
+
+func (s *ActivityManagement) Update(ctx context.Context, req *ActivityUpdateRequest) (*Activity, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	activity := &Activity{
+		ID:                     req.ID,
+		Name:                   strings.TrimSpace(req.Name),
+		Default:                req.Default,
+		InputType:              req.InputType,
+		DurationScorePerMinute: req.DurationScorePerMinute,
+	}
+	if err := s.validate(ctx, activity); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateActivity(ctx, activity)
+	if err != nil {
+		return nil, err
+	}
+	s.refresh(ctx)
+
+	return updated, nil
+}
+
+func (s *ActivityManagement) validate(ctx context.Context, activity *Activity) error {
+	if activity.Name == "" || len(activity.Name) > 100 {
+		return fmt.Errorf("%w: name must be between 1 and 100 characters", ErrRequestInvalid)
+	}
+	switch activity.InputType {
+	case ActivityInputTypeAmountPrimary, ActivityInputTypeTimePrimary:
+	default:
+		return fmt.Errorf("%w: unknown input type %q", ErrRequestInvalid, activity.InputType)
+	}
+	rate := float64(activity.DurationScorePerMinute)
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return fmt.Errorf("%w: duration score per minute must be a positive number", ErrRequestInvalid)
+	}
+
+	exists, err := s.repo.ActivityNameExists(ctx, activity.Name, activity.ID)
+	if err != nil {
+		return fmt.Errorf("could not check if activity exists: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("%w: activity '%s' already exists", ErrConflict, activity.Name)
+	}
+	return nil
+}
+
+// refresh invalidates the catalog of this instance right away, other
+// instances pick up the change with ActivityCatalogWorker.
+func (s *ActivityManagement) refresh(ctx context.Context) {
+	if err := RefreshActivityCatalog(ctx, s.repo); err != nil {
+		slog.ErrorContext(ctx, "could not refresh activity catalog", "error", err)
+	}
+}
+
+// activityKey derives the key of a new activity from its name, e.g.
+// "reading" or "visual_novel".
+func activityKey(name string) string {
+	var key strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			key.WriteRune(r)
+		} else {
+			key.WriteRune('_')
+		}
+	}
+	return key.String()
+}
+
+// activityKeyPrefix is the prefix of the stable unit keys of an activity,
+// e.g. "reading_" or "visual_novel_".
+func activityKeyPrefix(activity Activity) string {
+	return activity.Key + "_"
+}
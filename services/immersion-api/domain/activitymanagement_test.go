@@ -0,0 +1,223 @@
+package domain_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockActivityManagementRepository struct {
+	activities []domain.Activity
+}
+
+func (m *mockActivityManagementRepository) ListActivities(context.Context) ([]domain.Activity, error) {
+	return m.activities, nil
+}
+
+func (m *mockActivityManagementRepository) CreateActivity(_ context.Context, activity *domain.Activity) (*domain.Activity, error) {
+	created := *activity
+	created.ID = int32(len(m.activities) + 1)
+	m.activities = append(m.activities, created)
+	return &created, nil
+}
+
+func (m *mockActivityManagementRepository) UpdateActivity(_ context.Context, activity *domain.Activity) (*domain.Activity, error) {
+	for i := range m.activities {
+		if m.activities[i].ID == activity.ID {
+			updated := *activity
+			updated.Key = m.activities[i].Key
+			m.activities[i] = updated
+			return &updated, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockActivityManagementRepository) ActivityNameExists(_ context.Context, name string, excludeID int32) (bool, error) {
+	for _, activity := range m.activities {
+		if activity.ID != excludeID && strings.EqualFold(activity.Name, name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockActivityManagementRepository) ActivityKeyExists(_ context.Context, key string) (bool, error) {
+	for _, activity := range m.activities {
+		if activity.Key == key {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// withDefaultActivityCatalog restores the cached catalog after a test changed
+// it.
+func withDefaultActivityCatalog(t *testing.T) []domain.Activity {
+	defaults := domain.Activities()
+	t.Cleanup(func() {
+		repo := &mockActivityManagementRepository{activities: defaults}
+		require.NoError(t, domain.RefreshActivityCatalog(context.Background(), repo))
+	})
+	return append([]domain.Activity{}, defaults...)
+}
+
+func TestActivityManagement_Create(t *testing.T) {
+	t.Run("creates an activity and refreshes the catalog", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		activity, err := svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+			Name:                   " Watching ",
+			InputType:              domain.ActivityInputTypeTimePrimary,
+			DurationScorePerMinute: 0.3,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(6), activity.ID)
+		assert.Equal(t, "Watching", activity.Name)
+		assert.Equal(t, "watching", activity.Key)
+
+		cached, ok := domain.ActivityByID(6)
+		require.True(t, ok)
+		assert.Equal(t, "Watching", cached.Name)
+
+		seconds := int32(600)
+		score, err := domain.ComputeInterimLogScore(domain.LogTrackingInput{ActivityID: 6, DurationSeconds: &seconds})
+		require.NoError(t, err)
+		assert.InDelta(t, 3, score, 0.0001)
+	})
+
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+			Name:                   "reading",
+			InputType:              domain.ActivityInputTypeAmountPrimary,
+			DurationScorePerMinute: 0.2,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("rejects names whose key belongs to a renamed activity", func(t *testing.T) {
+		activities := withDefaultActivityCatalog(t)
+		activities[0].Name = "Books"
+		repo := &mockActivityManagementRepository{activities: activities}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+			Name:                   "Reading",
+			InputType:              domain.ActivityInputTypeAmountPrimary,
+			DurationScorePerMinute: 0.2,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("rejects names that do not derive a valid key", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		for _, name := range []string{"読書", "3D games"} {
+			_, err := svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+				Name:                   name,
+				InputType:              domain.ActivityInputTypeAmountPrimary,
+				DurationScorePerMinute: 0.2,
+			})
+
+			assert.ErrorIs(t, err, domain.ErrRequestInvalid, name)
+		}
+	})
+
+	t.Run("rejects invalid input types and rates", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+			Name:                   "Shadowing",
+			InputType:              "sometimes",
+			DurationScorePerMinute: 0.5,
+		})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+
+		_, err = svc.Create(ctxWithAdmin(), &domain.ActivityCreateRequest{
+			Name:      "Shadowing",
+			InputType: domain.ActivityInputTypeTimePrimary,
+		})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("requires an admin", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Create(ctxWithUserSubject("00000000-0000-0000-0000-000000000002"), &domain.ActivityCreateRequest{
+			Name:                   "Shadowing",
+			InputType:              domain.ActivityInputTypeTimePrimary,
+			DurationScorePerMinute: 0.5,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestActivityManagement_Update(t *testing.T) {
+	t.Run("updates the duration rate used for interim scores", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Update(ctxWithAdmin(), &domain.ActivityUpdateRequest{
+			ID:                     2,
+			Name:                   "Listening",
+			Default:                true,
+			InputType:              domain.ActivityInputTypeTimePrimary,
+			DurationScorePerMinute: 0.5,
+		})
+
+		require.NoError(t, err)
+		seconds := int32(600)
+		score, err := domain.ComputeInterimLogScore(domain.LogTrackingInput{ActivityID: 2, DurationSeconds: &seconds})
+		require.NoError(t, err)
+		assert.InDelta(t, 5, score, 0.0001)
+	})
+
+	t.Run("keeps the key when the activity is renamed", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		activity, err := svc.Update(ctxWithAdmin(), &domain.ActivityUpdateRequest{
+			ID:                     1,
+			Name:                   "Books",
+			Default:                true,
+			InputType:              domain.ActivityInputTypeAmountPrimary,
+			DurationScorePerMinute: 0.2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "reading", activity.Key)
+		cached, ok := domain.ActivityByID(1)
+		require.True(t, ok)
+		assert.Equal(t, "Books", cached.Name)
+		assert.Equal(t, "reading", cached.Key)
+	})
+
+	t.Run("returns not found for unknown activities", func(t *testing.T) {
+		repo := &mockActivityManagementRepository{activities: withDefaultActivityCatalog(t)}
+		svc := domain.NewActivityManagement(repo)
+
+		_, err := svc.Update(ctxWithAdmin(), &domain.ActivityUpdateRequest{
+			ID:                     99,
+			Name:                   "Watching",
+			InputType:              domain.ActivityInputTypeTimePrimary,
+			DurationScorePerMinute: 0.3,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestCancelRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	CancelContest(context.Context, *ContestCancelRequest) error
+}
+
+type ContestCancelRequest struct {
+	ID uuid.UUID
+
+	// Reason is stored in the moderation audit log when someone other than
+	// the owner cancels the contest.
+	Reason *string
+
+	moderatorUserID *uuid.UUID
+}
+
+// ModeratorUserID is set when the contest is cancelled by someone other than
+// its owner.
+func (r *ContestCancelRequest) ModeratorUserID() *uuid.UUID { return r.moderatorUserID }
+
+type ContestCancel struct {
+	repo  ContestCancelRepository
+	clock commondomain.Clock
+}
+
+func NewContestCancel(repo ContestCancelRepository, clock commondomain.Clock) *ContestCancel {
+	return &ContestCancel{repo: repo, clock: clock}
+}
+
+func (s *ContestCancel) Execute(ctx context.Context, req *ContestCancelRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ID})
+	if err != nil {
+		return fmt.Errorf("could not find contest: %w", err)
+	}
+
+	req.moderatorUserID, err = authorizeContestChange(ctx, contest)
+	if err != nil {
+		return err
+	}
+
+	// Finished contests are kept for their history, only admins can remove them
+	if !isAdmin(ctx) {
+		now := s.clock.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if contest.ContestEnd.Before(today) {
+			return fmt.Errorf("contest has already ended: %w", ErrInvalidContest)
+		}
+	}
+
+	if err := s.repo.CancelContest(ctx, req); err != nil {
+		return fmt.Errorf("could not cancel contest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockContestCancelRepository struct {
+	contest   *domain.ContestView
+	findErr   error
+	cancelled *domain.ContestCancelRequest
+}
+
+func (m *mockContestCancelRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	return m.contest, nil
+}
+
+func (m *mockContestCancelRepository) CancelContest(ctx context.Context, req *domain.ContestCancelRequest) error {
+	m.cancelled = req
+	return nil
+}
+
+func TestContestCancel_Execute(t *testing.T) {
+	now := time.Date(2026, time.August, 22, 15, 30, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	ownerID := uuid.New()
+
+	newContest := func(end time.Time) *domain.ContestView {
+		return &domain.ContestView{
+			ID:           uuid.New(),
+			ContestStart: end.AddDate(0, 0, -14),
+			ContestEnd:   end,
+			OwnerUserID:  ownerID,
+		}
+	}
+
+	t.Run("owner cancels without audit log", func(t *testing.T) {
+		contest := newContest(now.AddDate(0, 0, 3))
+		repo := &mockContestCancelRepository{contest: contest}
+		svc := domain.NewContestCancel(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestCancelRequest{ID: contest.ID})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.cancelled)
+		assert.Nil(t, repo.cancelled.ModeratorUserID())
+	})
+
+	t.Run("admin cancels with moderator", func(t *testing.T) {
+		contest := newContest(now.AddDate(0, 0, -30))
+		repo := &mockContestCancelRepository{contest: contest}
+		svc := domain.NewContestCancel(repo, clock)
+		adminID := uuid.New()
+
+		err := svc.Execute(ctxWithAdminSubject(adminID.String()), &domain.ContestCancelRequest{
+			ID:     contest.ID,
+			Reason: ptr("spam"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.cancelled.ModeratorUserID())
+		assert.Equal(t, adminID, *repo.cancelled.ModeratorUserID())
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestCancelRepository{contest: newContest(now)}
+		svc := domain.NewContestCancel(repo, clock)
+
+		err := svc.Execute(ctxWithGuest(), &domain.ContestCancelRequest{ID: repo.contest.ID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Nil(t, repo.cancelled)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		repo := &mockContestCancelRepository{contest: newContest(now)}
+		svc := domain.NewContestCancel(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestCancelRequest{ID: repo.contest.ID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.cancelled)
+	})
+
+	t.Run("owner cannot cancel an ended contest", func(t *testing.T) {
+		repo := &mockContestCancelRepository{contest: newContest(now.AddDate(0, 0, -2))}
+		svc := domain.NewContestCancel(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestCancelRequest{ID: repo.contest.ID})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+		assert.Nil(t, repo.cancelled)
+	})
+
+	t.Run("returns not found for unknown contests", func(t *testing.T) {
+		repo := &mockContestCancelRepository{findErr: domain.ErrNotFound}
+		svc := domain.NewContestCancel(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestCancelRequest{ID: uuid.New()})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
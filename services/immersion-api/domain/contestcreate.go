@@ -30,6 +30,9 @@ type ContestCreateRequest struct {
 	Official              bool
 	Private               bool
 	LanguageCodeAllowList []string
+	TeamMode              bool
+	TeamSizeLimit         *int32
+	TeamScoreAggregation  ContestTeamScoreAggregation
 }
 
 type ContestCreateResponse struct {
@@ -45,6 +48,9 @@ type ContestCreateResponse struct {
 	Private                 bool
 	LanguageCodeAllowList   []string
 	ActivityTypeIDAllowList []int32
+	TeamMode                bool
+	TeamSizeLimit           *int32
+	TeamScoreAggregation    ContestTeamScoreAggregation
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
 }
@@ -112,6 +118,10 @@ func (s *ContestCreate) Execute(ctx context.Context, req *ContestCreateRequest)
 		return nil, fmt.Errorf("official rounds cannot limit language choice: %w", ErrInvalidContest)
 	}
 
+	if err := validateContestTeamSettings(req); err != nil {
+		return nil, err
+	}
+
 	if req.ContestStart.After(req.ContestEnd) {
 		return nil, fmt.Errorf("contest cannot start after it has ended: %w", ErrInvalidContest)
 	}
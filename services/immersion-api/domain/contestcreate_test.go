@@ -372,4 +372,92 @@ func TestContestCreate_Execute(t *testing.T) {
 		assert.Equal(t, [][]string{{"jpn"}}, repo.languageBatches)
 		assert.False(t, repo.createCalled)
 	})
+
+	t.Run("creates a team contest with the default aggregation", func(t *testing.T) {
+		repo := &mockContestCreateRepository{createResult: &domain.ContestCreateResponse{}}
+		userUpsert := domain.NewUserUpsert(repo)
+		svc := domain.NewContestCreate(repo, clock, userUpsert)
+		limit := int32(5)
+		request := &domain.ContestCreateRequest{
+			ContestStart:            now.Add(30 * 24 * time.Hour),
+			ContestEnd:              now.Add(45 * 24 * time.Hour),
+			RegistrationEnd:         now.Add(40 * 24 * time.Hour),
+			Title:                   "team round",
+			ActivityTypeIDAllowList: []int32{1},
+			TeamMode:                true,
+			TeamSizeLimit:           &limit,
+		}
+
+		_, err := svc.Execute(ctxWithUserIdentity(uuid.NewString(), "TestUser"), request)
+
+		assert.NoError(t, err)
+		assert.True(t, repo.createCalled)
+		assert.Equal(t, domain.ContestTeamScoreSum, request.TeamScoreAggregation)
+	})
+
+	t.Run("rejects team contests without a valid size limit", func(t *testing.T) {
+		for _, limit := range []*int32{nil, ptr(int32(1)), ptr(int32(domain.MaxContestTeamSize + 1))} {
+			repo := &mockContestCreateRepository{}
+			userUpsert := domain.NewUserUpsert(repo)
+			svc := domain.NewContestCreate(repo, clock, userUpsert)
+			request := &domain.ContestCreateRequest{
+				ContestStart:            now.Add(30 * 24 * time.Hour),
+				ContestEnd:              now.Add(45 * 24 * time.Hour),
+				RegistrationEnd:         now.Add(40 * 24 * time.Hour),
+				Title:                   "team round",
+				ActivityTypeIDAllowList: []int32{1},
+				TeamMode:                true,
+				TeamSizeLimit:           limit,
+			}
+
+			_, err := svc.Execute(ctxWithUserIdentity(uuid.NewString(), "TestUser"), request)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidContest)
+			assert.False(t, repo.createCalled)
+		}
+	})
+
+	t.Run("rejects an unknown team score aggregation", func(t *testing.T) {
+		repo := &mockContestCreateRepository{}
+		userUpsert := domain.NewUserUpsert(repo)
+		svc := domain.NewContestCreate(repo, clock, userUpsert)
+		limit := int32(5)
+		request := &domain.ContestCreateRequest{
+			ContestStart:            now.Add(30 * 24 * time.Hour),
+			ContestEnd:              now.Add(45 * 24 * time.Hour),
+			RegistrationEnd:         now.Add(40 * 24 * time.Hour),
+			Title:                   "team round",
+			ActivityTypeIDAllowList: []int32{1},
+			TeamMode:                true,
+			TeamSizeLimit:           &limit,
+			TeamScoreAggregation:    "median",
+		}
+
+		_, err := svc.Execute(ctxWithUserIdentity(uuid.NewString(), "TestUser"), request)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+		assert.False(t, repo.createCalled)
+	})
+
+	t.Run("clears team settings when team mode is off", func(t *testing.T) {
+		repo := &mockContestCreateRepository{createResult: &domain.ContestCreateResponse{}}
+		userUpsert := domain.NewUserUpsert(repo)
+		svc := domain.NewContestCreate(repo, clock, userUpsert)
+		limit := int32(5)
+		request := &domain.ContestCreateRequest{
+			ContestStart:            now.Add(30 * 24 * time.Hour),
+			ContestEnd:              now.Add(45 * 24 * time.Hour),
+			RegistrationEnd:         now.Add(40 * 24 * time.Hour),
+			Title:                   "solo round",
+			ActivityTypeIDAllowList: []int32{1},
+			TeamSizeLimit:           &limit,
+			TeamScoreAggregation:    domain.ContestTeamScoreAverage,
+		}
+
+		_, err := svc.Execute(ctxWithUserIdentity(uuid.NewString(), "TestUser"), request)
+
+		assert.NoError(t, err)
+		assert.Nil(t, request.TeamSizeLimit)
+		assert.Equal(t, domain.ContestTeamScoreSum, request.TeamScoreAggregation)
+	})
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestInvitationCreateRepository interface {
+	ContestInviteFindRepository
+	CreateContestInvitation(context.Context, *ContestInvitationCreateRequest) error
+}
+
+// ContestInvitationCreateRequest explicitly invites a user to a private
+// contest, which allows them to register without a code.
+type ContestInvitationCreateRequest struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+
+	// Set by domain layer
+	invitedByUserID uuid.UUID
+}
+
+func (r *ContestInvitationCreateRequest) InvitedByUserID() uuid.UUID { return r.invitedByUserID }
+
+type ContestInvitationCreate struct {
+	repo   ContestInvitationCreateRepository
+	access ContestAccessClient
+}
+
+func NewContestInvitationCreate(repo ContestInvitationCreateRepository, access ContestAccessClient) *ContestInvitationCreate {
+	return &ContestInvitationCreate{repo: repo, access: access}
+}
+
+func (s *ContestInvitationCreate) Execute(ctx context.Context, req *ContestInvitationCreateRequest) error {
+	contest, _, err := requirePrivateContestManager(ctx, s.repo, req.ContestID)
+	if err != nil {
+		return err
+	}
+
+	if req.UserID == uuid.Nil || req.UserID == contest.OwnerUserID {
+		return fmt.Errorf("user cannot be invited: %w", ErrInvalidContestInvite)
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	req.invitedByUserID = uuid.MustParse(session.Subject)
+
+	// Keto decides access, the invitation is only recorded once the relation
+	// exists. Both writes are idempotent so a failed request can be retried.
+	if err := s.access.AddContestInvitee(ctx, req.ContestID, req.UserID); err != nil {
+		return fmt.Errorf("could not add contest invitee: %w", err)
+	}
+
+	if err := s.repo.CreateContestInvitation(ctx, req); err != nil {
+		return fmt.Errorf("could not create invitation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestInvitationCreate_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("owner invites a user", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		access := &mockContestAccessClient{}
+		svc := domain.NewContestInvitationCreate(repo, access)
+		userID := uuid.New()
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInvitationCreateRequest{
+			ContestID: repo.contest.ID,
+			UserID:    userID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{userID}, access.added)
+		require.NotNil(t, repo.createdInvitation)
+		assert.Equal(t, ownerID, repo.createdInvitation.InvitedByUserID())
+	})
+
+	t.Run("does not record the invitation when keto fails", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		ketoErr := errors.New("keto unavailable")
+		svc := domain.NewContestInvitationCreate(repo, &mockContestAccessClient{err: ketoErr})
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInvitationCreateRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, ketoErr)
+		assert.Nil(t, repo.createdInvitation)
+	})
+
+	t.Run("rejects inviting the owner", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInvitationCreate(repo, &mockContestAccessClient{})
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInvitationCreateRequest{
+			ContestID: repo.contest.ID,
+			UserID:    ownerID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		access := &mockContestAccessClient{}
+		svc := domain.NewContestInvitationCreate(repo, access)
+
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestInvitationCreateRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Empty(t, access.added)
+	})
+}
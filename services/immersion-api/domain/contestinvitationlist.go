@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestInvitationListRepository interface {
+	ContestInviteFindRepository
+	ListContestInvitations(ctx context.Context, contestID uuid.UUID) ([]ContestInvitation, error)
+}
+
+type ContestInvitationListRequest struct {
+	ContestID uuid.UUID
+}
+
+type ContestInvitationListResponse struct {
+	Invitations []ContestInvitation
+}
+
+type ContestInvitationList struct {
+	repo ContestInvitationListRepository
+}
+
+func NewContestInvitationList(repo ContestInvitationListRepository) *ContestInvitationList {
+	return &ContestInvitationList{repo: repo}
+}
+
+func (s *ContestInvitationList) Execute(ctx context.Context, req *ContestInvitationListRequest) (*ContestInvitationListResponse, error) {
+	if _, _, err := requirePrivateContestManager(ctx, s.repo, req.ContestID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.repo.ListContestInvitations(ctx, req.ContestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invitations: %w", err)
+	}
+
+	return &ContestInvitationListResponse{Invitations: invitations}, nil
+}
@@ -0,0 +1,40 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestInvitationList_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("admin lists invitations", func(t *testing.T) {
+		repo := &mockContestInviteRepository{
+			contest:     newPrivateContest(ownerID),
+			invitations: []domain.ContestInvitation{{UserID: uuid.New(), UserDisplayName: "reader"}},
+		}
+		svc := domain.NewContestInvitationList(repo)
+
+		res, err := svc.Execute(ctxWithAdmin(), &domain.ContestInvitationListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, repo.invitations, res.Invitations)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInvitationList(repo)
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestInvitationListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ContestInviteCodeLength is the number of characters in a generated code.
+	ContestInviteCodeLength = 10
+	// MaxContestInviteCodeUses is the highest usage limit an owner can set.
+	MaxContestInviteCodeUses = 10000
+)
+
+// contestInviteCodeAlphabet leaves out characters that are easily confused
+// when a code is shared by hand, such as 0/O and 1/I.
+const contestInviteCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+type ContestInviteCode struct {
+	ID        uuid.UUID
+	ContestID uuid.UUID
+	Code      string
+	ExpiresAt *time.Time
+	MaxUses   *int32
+	UseCount  int32
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Usable reports whether the code can still be redeemed.
+func (c *ContestInviteCode) Usable(now time.Time) bool {
+	if c.RevokedAt != nil {
+		return false
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return false
+	}
+	if c.MaxUses != nil && c.UseCount >= *c.MaxUses {
+		return false
+	}
+	return true
+}
+
+// ContestInvitation records that a user may register for a private contest,
+// either invited by the owner or by redeeming an invite code.
+type ContestInvitation struct {
+	ContestID       uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	InviteCodeID    *uuid.UUID
+	InvitedByUserID *uuid.UUID
+	CreatedAt       time.Time
+}
+
+// ContestInviteRedeemRequest redeems an invite code for a user. Redeeming a
+// code for a user who is already invited does not count as another use.
+type ContestInviteRedeemRequest struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+	Code      string
+	Now       time.Time
+}
+
+// ContestAccessClient manages the contest#invitees relation in Keto, which
+// decides who may register for a private contest.
+type ContestAccessClient interface {
+	CanRegisterForContest(ctx context.Context, contestID, userID uuid.UUID) (bool, error)
+	AddContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error
+	RemoveContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error
+}
+
+// ContestInviteFindRepository finds the contest an invite belongs to.
+type ContestInviteFindRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+}
+
+// requirePrivateContestManager finds the contest and checks that it is
+// private and that the user may manage it. The returned moderator is set when
+// the user is an admin acting on someone else's contest.
+func requirePrivateContestManager(ctx context.Context, repo ContestInviteFindRepository, contestID uuid.UUID) (*ContestView, *uuid.UUID, error) {
+	return requirePrivateContest(ctx, repo, contestID, authorizeContestChange)
+}
+
+// requirePrivateContestModerator is like requirePrivateContestManager but
+// also lets co-organizers through.
+func requirePrivateContestModerator(ctx context.Context, repo ContestInviteFindRepository, organizers ContestOrganizerChecker, contestID uuid.UUID) (*ContestView, *uuid.UUID, error) {
+	return requirePrivateContest(ctx, repo, contestID, func(ctx context.Context, contest *ContestView) (*uuid.UUID, error) {
+		return authorizeContestModeration(ctx, contest, organizers)
+	})
+}
+
+func requirePrivateContest(
+	ctx context.Context,
+	repo ContestInviteFindRepository,
+	contestID uuid.UUID,
+	authorize func(context.Context, *ContestView) (*uuid.UUID, error),
+) (*ContestView, *uuid.UUID, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	contest, err := repo.FindContestByID(ctx, &ContestFindRequest{ID: contestID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not find contest: %w", err)
+	}
+
+	moderatorUserID, err := authorize(ctx, contest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !contest.Private {
+		return nil, nil, fmt.Errorf("only private contests use invites: %w", ErrInvalidContestInvite)
+	}
+
+	return contest, moderatorUserID, nil
+}
+
+func generateContestInviteCode() (string, error) {
+	code := make([]byte, ContestInviteCodeLength)
+	max := big.NewInt(int64(len(contestInviteCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("could not generate invite code: %w", err)
+		}
+		code[i] = contestInviteCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+// mockContestInviteRepository implements the repositories of all contest
+// invite services.
+type mockContestInviteRepository struct {
+	contest     *domain.ContestView
+	contestErr  error
+	codes       []domain.ContestInviteCode
+	invitations []domain.ContestInvitation
+	revokeErr   error
+
+	createdCode       *domain.ContestInviteCodeCreateRequest
+	revoked           *domain.ContestInviteCodeRevokeRequest
+	createdInvitation *domain.ContestInvitationCreateRequest
+	removed           *domain.ContestParticipantRemoveRequest
+}
+
+func (m *mockContestInviteRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contestErr != nil {
+		return nil, m.contestErr
+	}
+	return m.contest, nil
+}
+
+func (m *mockContestInviteRepository) CreateContestInviteCode(ctx context.Context, req *domain.ContestInviteCodeCreateRequest) (*domain.ContestInviteCode, error) {
+	m.createdCode = req
+	return &domain.ContestInviteCode{
+		ID:        uuid.New(),
+		ContestID: req.ContestID,
+		Code:      req.Code(),
+		ExpiresAt: req.ExpiresAt,
+		MaxUses:   req.MaxUses,
+	}, nil
+}
+
+func (m *mockContestInviteRepository) ListContestInviteCodes(ctx context.Context, contestID uuid.UUID) ([]domain.ContestInviteCode, error) {
+	return m.codes, nil
+}
+
+func (m *mockContestInviteRepository) RevokeContestInviteCode(ctx context.Context, req *domain.ContestInviteCodeRevokeRequest) error {
+	m.revoked = req
+	return m.revokeErr
+}
+
+func (m *mockContestInviteRepository) CreateContestInvitation(ctx context.Context, req *domain.ContestInvitationCreateRequest) error {
+	m.createdInvitation = req
+	return nil
+}
+
+func (m *mockContestInviteRepository) ListContestInvitations(ctx context.Context, contestID uuid.UUID) ([]domain.ContestInvitation, error) {
+	return m.invitations, nil
+}
+
+func (m *mockContestInviteRepository) RemoveContestParticipant(ctx context.Context, req *domain.ContestParticipantRemoveRequest) error {
+	m.removed = req
+	return nil
+}
+
+type mockContestAccessClient struct {
+	allowed bool
+	err     error
+
+	added   []uuid.UUID
+	removed []uuid.UUID
+}
+
+func (m *mockContestAccessClient) CanRegisterForContest(ctx context.Context, contestID, userID uuid.UUID) (bool, error) {
+	return m.allowed, m.err
+}
+
+func (m *mockContestAccessClient) AddContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.added = append(m.added, userID)
+	return nil
+}
+
+func (m *mockContestAccessClient) RemoveContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removed = append(m.removed, userID)
+	return nil
+}
+
+func newPrivateContest(ownerID uuid.UUID) *domain.ContestView {
+	return &domain.ContestView{
+		ID:          uuid.New(),
+		OwnerUserID: ownerID,
+		Private:     true,
+	}
+}
+
+func TestContestInviteCode_Usable(t *testing.T) {
+	now := time.Date(2026, time.September, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	limit := int32(2)
+
+	assert.True(t, (&domain.ContestInviteCode{}).Usable(now))
+	assert.True(t, (&domain.ContestInviteCode{ExpiresAt: &future, MaxUses: &limit, UseCount: 1}).Usable(now))
+	assert.False(t, (&domain.ContestInviteCode{RevokedAt: &past}).Usable(now))
+	assert.False(t, (&domain.ContestInviteCode{ExpiresAt: &now}).Usable(now))
+	assert.False(t, (&domain.ContestInviteCode{MaxUses: &limit, UseCount: 2}).Usable(now))
+}
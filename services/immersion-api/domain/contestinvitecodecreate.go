@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestInviteCodeCreateRepository interface {
+	ContestInviteFindRepository
+	CreateContestInviteCode(context.Context, *ContestInviteCodeCreateRequest) (*ContestInviteCode, error)
+}
+
+type ContestInviteCodeCreateRequest struct {
+	ContestID uuid.UUID
+
+	// Optional
+	ExpiresAt *time.Time
+	MaxUses   *int32
+
+	// Set by domain layer
+	code            string
+	createdByUserID uuid.UUID
+}
+
+func (r *ContestInviteCodeCreateRequest) Code() string               { return r.code }
+func (r *ContestInviteCodeCreateRequest) CreatedByUserID() uuid.UUID { return r.createdByUserID }
+
+type ContestInviteCodeCreate struct {
+	repo  ContestInviteCodeCreateRepository
+	clock commondomain.Clock
+}
+
+func NewContestInviteCodeCreate(repo ContestInviteCodeCreateRepository, clock commondomain.Clock) *ContestInviteCodeCreate {
+	return &ContestInviteCodeCreate{repo: repo, clock: clock}
+}
+
+func (s *ContestInviteCodeCreate) Execute(ctx context.Context, req *ContestInviteCodeCreateRequest) (*ContestInviteCode, error) {
+	if _, _, err := requirePrivateContestManager(ctx, s.repo, req.ContestID); err != nil {
+		return nil, err
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(s.clock.Now()) {
+		return nil, fmt.Errorf("invite code cannot expire in the past: %w", ErrInvalidContestInvite)
+	}
+	if req.MaxUses != nil && (*req.MaxUses < 1 || *req.MaxUses > MaxContestInviteCodeUses) {
+		return nil, fmt.Errorf("usage limit must be between 1 and %d: %w", MaxContestInviteCodeUses, ErrInvalidContestInvite)
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	req.createdByUserID = uuid.MustParse(session.Subject)
+
+	code, err := generateContestInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	req.code = code
+
+	return s.repo.CreateContestInviteCode(ctx, req)
+}
@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestInviteCodeCreate_Execute(t *testing.T) {
+	now := time.Date(2026, time.September, 1, 12, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	ownerID := uuid.New()
+
+	t.Run("owner creates an invite code", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+		expiresAt := now.AddDate(0, 0, 7)
+
+		code, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeCreateRequest{
+			ContestID: repo.contest.ID,
+			ExpiresAt: &expiresAt,
+			MaxUses:   ptr(int32(25)),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.createdCode)
+		assert.Equal(t, ownerID, repo.createdCode.CreatedByUserID())
+		assert.Len(t, code.Code, domain.ContestInviteCodeLength)
+		assert.Equal(t, repo.createdCode.Code(), code.Code)
+	})
+
+	t.Run("admin creates an invite code for someone else's contest", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+		adminID := uuid.New()
+
+		_, err := svc.Execute(ctxWithAdminSubject(adminID.String()), &domain.ContestInviteCodeCreateRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, adminID, repo.createdCode.CreatedByUserID())
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestInviteCodeCreateRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.createdCode)
+	})
+
+	t.Run("requires a private contest", func(t *testing.T) {
+		contest := newPrivateContest(ownerID)
+		contest.Private = false
+		repo := &mockContestInviteRepository{contest: contest}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeCreateRequest{
+			ContestID: contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+	})
+
+	t.Run("rejects an expiry in the past", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeCreateRequest{
+			ContestID: repo.contest.ID,
+			ExpiresAt: &now,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+	})
+
+	t.Run("rejects usage limits out of range", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+
+		for _, limit := range []int32{0, domain.MaxContestInviteCodeUses + 1} {
+			_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeCreateRequest{
+				ContestID: repo.contest.ID,
+				MaxUses:   ptr(limit),
+			})
+
+			assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+		}
+		assert.Nil(t, repo.createdCode)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.ContestInviteCodeCreateRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
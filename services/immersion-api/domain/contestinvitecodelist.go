@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestInviteCodeListRepository interface {
+	ContestInviteFindRepository
+	ListContestInviteCodes(ctx context.Context, contestID uuid.UUID) ([]ContestInviteCode, error)
+}
+
+type ContestInviteCodeListRequest struct {
+	ContestID uuid.UUID
+}
+
+type ContestInviteCodeListResponse struct {
+	InviteCodes []ContestInviteCode
+}
+
+type ContestInviteCodeList struct {
+	repo ContestInviteCodeListRepository
+}
+
+func NewContestInviteCodeList(repo ContestInviteCodeListRepository) *ContestInviteCodeList {
+	return &ContestInviteCodeList{repo: repo}
+}
+
+func (s *ContestInviteCodeList) Execute(ctx context.Context, req *ContestInviteCodeListRequest) (*ContestInviteCodeListResponse, error) {
+	if _, _, err := requirePrivateContestManager(ctx, s.repo, req.ContestID); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.repo.ListContestInviteCodes(ctx, req.ContestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invite codes: %w", err)
+	}
+
+	return &ContestInviteCodeListResponse{InviteCodes: codes}, nil
+}
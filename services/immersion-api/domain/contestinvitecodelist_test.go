@@ -0,0 +1,51 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestInviteCodeList_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("owner lists invite codes", func(t *testing.T) {
+		repo := &mockContestInviteRepository{
+			contest: newPrivateContest(ownerID),
+			codes:   []domain.ContestInviteCode{{ID: uuid.New(), Code: "K7MX2QH9PA"}},
+		}
+		svc := domain.NewContestInviteCodeList(repo)
+
+		res, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, repo.codes, res.InviteCodes)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeList(repo)
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestInviteCodeListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("returns not found for unknown contests", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contestErr: domain.ErrNotFound}
+		svc := domain.NewContestInviteCodeList(repo)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeListRequest{
+			ContestID: uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ContestInviteCodeRevokeRepository interface {
+	ContestInviteFindRepository
+	RevokeContestInviteCode(context.Context, *ContestInviteCodeRevokeRequest) error
+}
+
+// ContestInviteCodeRevokeRequest revokes a code so it can no longer be
+// redeemed. Users who already redeemed it keep their access.
+type ContestInviteCodeRevokeRequest struct {
+	ContestID    uuid.UUID
+	InviteCodeID uuid.UUID
+}
+
+type ContestInviteCodeRevoke struct {
+	repo ContestInviteCodeRevokeRepository
+}
+
+func NewContestInviteCodeRevoke(repo ContestInviteCodeRevokeRepository) *ContestInviteCodeRevoke {
+	return &ContestInviteCodeRevoke{repo: repo}
+}
+
+func (s *ContestInviteCodeRevoke) Execute(ctx context.Context, req *ContestInviteCodeRevokeRequest) error {
+	if _, _, err := requirePrivateContestManager(ctx, s.repo, req.ContestID); err != nil {
+		return err
+	}
+
+	return s.repo.RevokeContestInviteCode(ctx, req)
+}
@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestInviteCodeRevoke_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("owner revokes an invite code", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeRevoke(repo)
+		codeID := uuid.New()
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeRevokeRequest{
+			ContestID:    repo.contest.ID,
+			InviteCodeID: codeID,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.revoked)
+		assert.Equal(t, codeID, repo.revoked.InviteCodeID)
+	})
+
+	t.Run("returns not found for unknown or revoked codes", func(t *testing.T) {
+		repo := &mockContestInviteRepository{
+			contest:   newPrivateContest(ownerID),
+			revokeErr: domain.ErrNotFound,
+		}
+		svc := domain.NewContestInviteCodeRevoke(repo)
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestInviteCodeRevokeRequest{
+			ContestID:    repo.contest.ID,
+			InviteCodeID: uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestInviteCodeRevoke(repo)
+
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestInviteCodeRevokeRequest{
+			ContestID:    repo.contest.ID,
+			InviteCodeID: uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.revoked)
+	})
+}
@@ -21,14 +21,17 @@ type ContestModerationDetachLogRequest struct {
 }
 
 type ContestModerationDetachLog struct {
-	repo ContestModerationDetachLogRepository
+	repo       ContestModerationDetachLogRepository
+	organizers ContestOrganizerChecker
 }
 
 func NewContestModerationDetachLog(
 	repo ContestModerationDetachLogRepository,
+	organizers ContestOrganizerChecker,
 ) *ContestModerationDetachLog {
 	return &ContestModerationDetachLog{
-		repo: repo,
+		repo:       repo,
+		organizers: organizers,
 	}
 }
 
@@ -53,12 +56,9 @@ func (s *ContestModerationDetachLog) Execute(ctx context.Context, req *ContestMo
 		return fmt.Errorf("could not find contest: %w", err)
 	}
 
-	// Check authorization: user must be contest owner OR have Admin role
-	isContestOwner := contest.OwnerUserID == userID
-	isAdmin := isAdmin(ctx)
-
-	if !isContestOwner && !isAdmin {
-		return ErrForbidden
+	// Check authorization: user must be contest owner, co-organizer OR have Admin role
+	if _, err := authorizeContestModeration(ctx, contest, s.organizers); err != nil {
+		return err
 	}
 
 	// Verify log exists
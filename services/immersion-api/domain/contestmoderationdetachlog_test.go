@@ -57,7 +57,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 
 	t.Run("returns unauthorized for guest", func(t *testing.T) {
 		repo := &mockContestModerationDetachLogRepository{}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithGuest()
 
@@ -73,7 +73,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 
 	t.Run("returns unauthorized for nil session", func(t *testing.T) {
 		repo := &mockContestModerationDetachLogRepository{}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		err := svc.Execute(context.Background(), &domain.ContestModerationDetachLogRequest{
 			ContestID: contestID,
@@ -89,7 +89,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 		repo := &mockContestModerationDetachLogRepository{
 			findContestErr: domain.ErrNotFound,
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -107,7 +107,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 		repo := &mockContestModerationDetachLogRepository{
 			contest: contest,
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithUserSubject(otherUserID.String()) // Not the owner
 
@@ -126,7 +126,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 			contest:    contest,
 			findLogErr: domain.ErrNotFound,
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -145,7 +145,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 			contest: contest,
 			log:     log,
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithUserSubject(userID.String()) // Contest owner
 
@@ -160,12 +160,31 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 		assert.Equal(t, userID, repo.detachUserID)
 	})
 
+	t.Run("allows co-organizer to detach log", func(t *testing.T) {
+		organizerID := uuid.New()
+		repo := &mockContestModerationDetachLogRepository{
+			contest: contest,
+			log:     log,
+		}
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}})
+
+		err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestModerationDetachLogRequest{
+			ContestID: contestID,
+			LogID:     logID,
+			Reason:    "test",
+		})
+
+		require.NoError(t, err)
+		assert.True(t, repo.detachCalled)
+		assert.Equal(t, organizerID, repo.detachUserID)
+	})
+
 	t.Run("allows admin to detach log from any contest", func(t *testing.T) {
 		repo := &mockContestModerationDetachLogRepository{
 			contest: contest,
 			log:     log,
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		adminID := uuid.New()
 		ctx := ctxWithAdminSubject(adminID.String())
@@ -187,7 +206,7 @@ func TestContestModerationDetachLog_Execute(t *testing.T) {
 			log:       log,
 			detachErr: errors.New("database error"),
 		}
-		svc := domain.NewContestModerationDetachLog(repo)
+		svc := domain.NewContestModerationDetachLog(repo, &mockContestOrganizerClient{})
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// ContestOrganizer is a user the owner delegated moderation of a contest to.
+type ContestOrganizer struct {
+	ContestID       uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	AddedByUserID   *uuid.UUID
+	CreatedAt       time.Time
+}
+
+// ContestOrganizerChecker checks the contest#moderate permit in Keto, which
+// is granted to co-organizers of a contest.
+type ContestOrganizerChecker interface {
+	IsContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) (bool, error)
+}
+
+// ContestOrganizerClient manages the contest#organizers relation in Keto.
+type ContestOrganizerClient interface {
+	ContestOrganizerChecker
+	AddContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error
+	RemoveContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error
+}
+
+// authorizeContestModeration checks that the user may moderate the contest,
+// which besides the owner and admins includes co-organizers. The returned
+// moderator is set when someone other than the owner is acting.
+func authorizeContestModeration(ctx context.Context, contest *ContestView, organizers ContestOrganizerChecker) (*uuid.UUID, error) {
+	moderatorUserID, err := authorizeContestChange(ctx, contest)
+	if !errors.Is(err, ErrForbidden) || contest.Official {
+		return moderatorUserID, err
+	}
+
+	userID := uuid.MustParse(commondomain.ParseUserIdentity(ctx).Subject)
+	organizer, err := organizers.IsContestOrganizer(ctx, contest.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not check contest organizer: %w", err)
+	}
+	if !organizer {
+		return nil, ErrForbidden
+	}
+	return &userID, nil
+}
+
+// ContestFindByIDRepository finds the contest whose organizers are checked.
+type ContestFindByIDRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+}
+
+// requireContestOrganizer lets admins, the contest owner and co-organizers
+// through. Unlike authorizeContestModeration it also applies to official
+// contests, as their rule sets are managed the same way.
+func requireContestOrganizer(
+	ctx context.Context,
+	repo ContestFindByIDRepository,
+	organizers ContestOrganizerChecker,
+	contestID uuid.UUID,
+) (*ContestView, error) {
+	contest, err := repo.FindContestByID(ctx, &ContestFindRequest{ID: contestID})
+	if err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	if isAdmin(ctx) || contest.OwnerUserID.String() == session.Subject {
+		return contest, nil
+	}
+	organizer, err := organizers.IsContestOrganizer(ctx, contestID, uuid.MustParse(session.Subject))
+	if err != nil {
+		return nil, fmt.Errorf("could not check contest organizer: %w", err)
+	}
+	if !organizer {
+		return nil, ErrForbidden
+	}
+	return contest, nil
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+// mockContestOrganizerRepository implements the repositories of all contest
+// co-organizer services.
+type mockContestOrganizerRepository struct {
+	contest    *domain.ContestView
+	contestErr error
+	organizers []domain.ContestOrganizer
+	deleteErr  error
+
+	created *domain.ContestOrganizerAddRequest
+	deleted *domain.ContestOrganizerRemoveRequest
+}
+
+func (m *mockContestOrganizerRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contestErr != nil {
+		return nil, m.contestErr
+	}
+	return m.contest, nil
+}
+
+func (m *mockContestOrganizerRepository) CreateContestOrganizer(ctx context.Context, req *domain.ContestOrganizerAddRequest) error {
+	m.created = req
+	return nil
+}
+
+func (m *mockContestOrganizerRepository) DeleteContestOrganizer(ctx context.Context, req *domain.ContestOrganizerRemoveRequest) error {
+	m.deleted = req
+	return m.deleteErr
+}
+
+func (m *mockContestOrganizerRepository) ListContestOrganizers(ctx context.Context, contestID uuid.UUID) ([]domain.ContestOrganizer, error) {
+	return m.organizers, nil
+}
+
+type mockContestOrganizerClient struct {
+	organizers []uuid.UUID
+	err        error
+
+	added   []uuid.UUID
+	removed []uuid.UUID
+}
+
+func (m *mockContestOrganizerClient) IsContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	for _, id := range m.organizers {
+		if id == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockContestOrganizerClient) AddContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.added = append(m.added, userID)
+	return nil
+}
+
+func (m *mockContestOrganizerClient) RemoveContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removed = append(m.removed, userID)
+	return nil
+}
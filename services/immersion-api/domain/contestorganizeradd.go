@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestOrganizerAddRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	CreateContestOrganizer(context.Context, *ContestOrganizerAddRequest) error
+}
+
+// ContestOrganizerAddRequest makes a user co-organizer of a contest, which
+// lets them moderate logs, remove participants and draft scoring rule sets.
+type ContestOrganizerAddRequest struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+
+	// Set by domain layer
+	addedByUserID uuid.UUID
+}
+
+func (r *ContestOrganizerAddRequest) AddedByUserID() uuid.UUID { return r.addedByUserID }
+
+type ContestOrganizerAdd struct {
+	repo       ContestOrganizerAddRepository
+	organizers ContestOrganizerClient
+}
+
+func NewContestOrganizerAdd(repo ContestOrganizerAddRepository, organizers ContestOrganizerClient) *ContestOrganizerAdd {
+	return &ContestOrganizerAdd{repo: repo, organizers: organizers}
+}
+
+func (s *ContestOrganizerAdd) Execute(ctx context.Context, req *ContestOrganizerAddRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ContestID})
+	if err != nil {
+		return fmt.Errorf("could not find contest: %w", err)
+	}
+
+	// Only the owner and admins manage co-organizers
+	if _, err := authorizeContestChange(ctx, contest); err != nil {
+		return err
+	}
+
+	if contest.Official {
+		return fmt.Errorf("official rounds cannot have co-organizers: %w", ErrInvalidContest)
+	}
+	if req.UserID == uuid.Nil || req.UserID == contest.OwnerUserID {
+		return fmt.Errorf("user cannot be made co-organizer: %w", ErrInvalidContest)
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	req.addedByUserID = uuid.MustParse(session.Subject)
+
+	// Keto decides access, the co-organizer is only recorded once the
+	// relation exists. Both writes are idempotent so a failed request can be
+	// retried.
+	if err := s.organizers.AddContestOrganizer(ctx, req.ContestID, req.UserID); err != nil {
+		return fmt.Errorf("could not add contest organizer: %w", err)
+	}
+
+	if err := s.repo.CreateContestOrganizer(ctx, req); err != nil {
+		return fmt.Errorf("could not create contest organizer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestOrganizerAdd_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("owner adds a co-organizer", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		organizers := &mockContestOrganizerClient{}
+		svc := domain.NewContestOrganizerAdd(repo, organizers)
+		userID := uuid.New()
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestOrganizerAddRequest{
+			ContestID: repo.contest.ID,
+			UserID:    userID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{userID}, organizers.added)
+		require.NotNil(t, repo.created)
+		assert.Equal(t, ownerID, repo.created.AddedByUserID())
+	})
+
+	t.Run("co-organizers cannot add others", func(t *testing.T) {
+		organizerID := uuid.New()
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		svc := domain.NewContestOrganizerAdd(repo, organizers)
+
+		err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestOrganizerAddRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Empty(t, organizers.added)
+	})
+
+	t.Run("rejects the owner and official rounds", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		svc := domain.NewContestOrganizerAdd(repo, &mockContestOrganizerClient{})
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestOrganizerAddRequest{
+			ContestID: repo.contest.ID,
+			UserID:    ownerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+
+		repo.contest.Official = true
+		err = svc.Execute(ctxWithAdmin(), &domain.ContestOrganizerAddRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("does not record the co-organizer when keto fails", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		ketoErr := errors.New("keto unavailable")
+		svc := domain.NewContestOrganizerAdd(repo, &mockContestOrganizerClient{err: ketoErr})
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestOrganizerAddRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, ketoErr)
+		assert.Nil(t, repo.created)
+	})
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestOrganizerListRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	ListContestOrganizers(ctx context.Context, contestID uuid.UUID) ([]ContestOrganizer, error)
+}
+
+type ContestOrganizerListRequest struct {
+	ContestID uuid.UUID
+}
+
+type ContestOrganizerListResponse struct {
+	Organizers []ContestOrganizer
+}
+
+type ContestOrganizerList struct {
+	repo       ContestOrganizerListRepository
+	organizers ContestOrganizerChecker
+}
+
+func NewContestOrganizerList(repo ContestOrganizerListRepository, organizers ContestOrganizerChecker) *ContestOrganizerList {
+	return &ContestOrganizerList{repo: repo, organizers: organizers}
+}
+
+func (s *ContestOrganizerList) Execute(ctx context.Context, req *ContestOrganizerListRequest) (*ContestOrganizerListResponse, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ContestID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest: %w", err)
+	}
+
+	if _, err := authorizeContestModeration(ctx, contest, s.organizers); err != nil {
+		return nil, err
+	}
+
+	organizers, err := s.repo.ListContestOrganizers(ctx, req.ContestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest organizers: %w", err)
+	}
+
+	return &ContestOrganizerListResponse{Organizers: organizers}, nil
+}
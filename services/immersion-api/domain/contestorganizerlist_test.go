@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestOrganizerList_Execute(t *testing.T) {
+	ownerID := uuid.New()
+	organizerID := uuid.New()
+
+	t.Run("co-organizer lists co-organizers", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{
+			contest:    &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID},
+			organizers: []domain.ContestOrganizer{{UserID: organizerID, UserDisplayName: "mod"}},
+		}
+		svc := domain.NewContestOrganizerList(repo, &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}})
+
+		res, err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestOrganizerListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, repo.organizers, res.Organizers)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		svc := domain.NewContestOrganizerList(repo, &mockContestOrganizerClient{})
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestOrganizerListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("returns keto errors", func(t *testing.T) {
+		ketoErr := errors.New("keto unavailable")
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		svc := domain.NewContestOrganizerList(repo, &mockContestOrganizerClient{err: ketoErr})
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestOrganizerListRequest{
+			ContestID: repo.contest.ID,
+		})
+
+		assert.ErrorIs(t, err, ketoErr)
+	})
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestOrganizerRemoveRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	DeleteContestOrganizer(context.Context, *ContestOrganizerRemoveRequest) error
+}
+
+type ContestOrganizerRemoveRequest struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type ContestOrganizerRemove struct {
+	repo       ContestOrganizerRemoveRepository
+	organizers ContestOrganizerClient
+}
+
+func NewContestOrganizerRemove(repo ContestOrganizerRemoveRepository, organizers ContestOrganizerClient) *ContestOrganizerRemove {
+	return &ContestOrganizerRemove{repo: repo, organizers: organizers}
+}
+
+func (s *ContestOrganizerRemove) Execute(ctx context.Context, req *ContestOrganizerRemoveRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ContestID})
+	if err != nil {
+		return fmt.Errorf("could not find contest: %w", err)
+	}
+
+	// Co-organizers can step down themselves, everyone else is removed by
+	// the owner or an admin
+	session := commondomain.ParseUserIdentity(ctx)
+	if session.Subject != req.UserID.String() {
+		if _, err := authorizeContestChange(ctx, contest); err != nil {
+			return err
+		}
+	}
+
+	// Revoke the Keto relation first, it decides access and removing a tuple
+	// that does not exist succeeds.
+	if err := s.organizers.RemoveContestOrganizer(ctx, req.ContestID, req.UserID); err != nil {
+		return fmt.Errorf("could not remove contest organizer: %w", err)
+	}
+
+	if err := s.repo.DeleteContestOrganizer(ctx, req); err != nil {
+		return fmt.Errorf("could not delete contest organizer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestOrganizerRemove_Execute(t *testing.T) {
+	ownerID := uuid.New()
+	organizerID := uuid.New()
+
+	t.Run("owner removes a co-organizer", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		svc := domain.NewContestOrganizerRemove(repo, organizers)
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestOrganizerRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    organizerID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{organizerID}, organizers.removed)
+		require.NotNil(t, repo.deleted)
+	})
+
+	t.Run("co-organizer steps down", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		svc := domain.NewContestOrganizerRemove(repo, organizers)
+
+		err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestOrganizerRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    organizerID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{organizerID}, organizers.removed)
+	})
+
+	t.Run("co-organizers cannot remove each other", func(t *testing.T) {
+		otherID := uuid.New()
+		repo := &mockContestOrganizerRepository{contest: &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID}}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID, otherID}}
+		svc := domain.NewContestOrganizerRemove(repo, organizers)
+
+		err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestOrganizerRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    otherID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Empty(t, organizers.removed)
+	})
+
+	t.Run("returns not found for users who are not co-organizers", func(t *testing.T) {
+		repo := &mockContestOrganizerRepository{
+			contest:   &domain.ContestView{ID: uuid.New(), OwnerUserID: ownerID},
+			deleteErr: domain.ErrNotFound,
+		}
+		svc := domain.NewContestOrganizerRemove(repo, &mockContestOrganizerClient{})
+
+		err := svc.Execute(ctxWithAdmin(), &domain.ContestOrganizerRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestParticipantRemoveRepository interface {
+	ContestInviteFindRepository
+	RemoveContestParticipant(context.Context, *ContestParticipantRemoveRequest) error
+}
+
+// ContestParticipantRemoveRequest removes a user from a private contest: their
+// access, invitation, registration and team membership are deleted and their
+// logs are detached from the contest.
+type ContestParticipantRemoveRequest struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+
+	// Reason is stored in the moderation audit log when someone other than
+	// the owner removes the participant.
+	Reason *string
+
+	moderatorUserID *uuid.UUID
+}
+
+// ModeratorUserID is set when the participant is removed by someone other
+// than the contest owner.
+func (r *ContestParticipantRemoveRequest) ModeratorUserID() *uuid.UUID { return r.moderatorUserID }
+
+type ContestParticipantRemove struct {
+	repo       ContestParticipantRemoveRepository
+	access     ContestAccessClient
+	organizers ContestOrganizerChecker
+}
+
+func NewContestParticipantRemove(
+	repo ContestParticipantRemoveRepository,
+	access ContestAccessClient,
+	organizers ContestOrganizerChecker,
+) *ContestParticipantRemove {
+	return &ContestParticipantRemove{repo: repo, access: access, organizers: organizers}
+}
+
+func (s *ContestParticipantRemove) Execute(ctx context.Context, req *ContestParticipantRemoveRequest) error {
+	contest, moderatorUserID, err := requirePrivateContestModerator(ctx, s.repo, s.organizers, req.ContestID)
+	if err != nil {
+		return err
+	}
+	req.moderatorUserID = moderatorUserID
+
+	if req.UserID == contest.OwnerUserID {
+		return fmt.Errorf("the owner cannot be removed: %w", ErrInvalidContestInvite)
+	}
+
+	// Revoke access first so the user cannot register again while the rest
+	// of their participation is removed.
+	if err := s.access.RemoveContestInvitee(ctx, req.ContestID, req.UserID); err != nil {
+		return fmt.Errorf("could not remove contest invitee: %w", err)
+	}
+
+	if err := s.repo.RemoveContestParticipant(ctx, req); err != nil {
+		return fmt.Errorf("could not remove participant: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestParticipantRemove_Execute(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("owner removes a participant without audit log", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		access := &mockContestAccessClient{}
+		svc := domain.NewContestParticipantRemove(repo, access, &mockContestOrganizerClient{})
+		userID := uuid.New()
+
+		err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestParticipantRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    userID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{userID}, access.removed)
+		require.NotNil(t, repo.removed)
+		assert.Nil(t, repo.removed.ModeratorUserID())
+	})
+
+	t.Run("admin removes a participant with moderator", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestParticipantRemove(repo, &mockContestAccessClient{}, &mockContestOrganizerClient{})
+		adminID := uuid.New()
+
+		err := svc.Execute(ctxWithAdminSubject(adminID.String()), &domain.ContestParticipantRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+			Reason:    ptr("spam"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.removed.ModeratorUserID())
+		assert.Equal(t, adminID, *repo.removed.ModeratorUserID())
+	})
+
+	t.Run("co-organizer removes a participant with moderator", func(t *testing.T) {
+		organizerID := uuid.New()
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		svc := domain.NewContestParticipantRemove(repo, &mockContestAccessClient{}, organizers)
+
+		err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ContestParticipantRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.removed.ModeratorUserID())
+		assert.Equal(t, organizerID, *repo.removed.ModeratorUserID())
+	})
+
+	t.Run("cannot remove the owner", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		access := &mockContestAccessClient{}
+		svc := domain.NewContestParticipantRemove(repo, access, &mockContestOrganizerClient{})
+
+		err := svc.Execute(ctxWithAdmin(), &domain.ContestParticipantRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    ownerID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+		assert.Empty(t, access.removed)
+		assert.Nil(t, repo.removed)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		repo := &mockContestInviteRepository{contest: newPrivateContest(ownerID)}
+		svc := domain.NewContestParticipantRemove(repo, &mockContestAccessClient{}, &mockContestOrganizerClient{})
+
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.ContestParticipantRemoveRequest{
+			ContestID: repo.contest.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.removed)
+	})
+}
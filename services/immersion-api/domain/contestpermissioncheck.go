@@ -13,20 +13,42 @@ var UserCreateContestYearlyLimit int32 = 12
 
 type ContestPermissionCheckRepository interface {
 	GetContestsByUserCountForYear(ctx context.Context, now time.Time, userID uuid.UUID) (int32, error)
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
 }
 
 type ContestPermissionCheckKratosClient interface {
 	FetchIdentity(ctx context.Context, id uuid.UUID) (*UserTraits, error)
 }
 
+// ContestCapabilities lists what the user may do with a contest, so clients
+// can render the matching controls.
+type ContestCapabilities struct {
+	Owner                  bool
+	Organizer              bool
+	Admin                  bool
+	CanEdit                bool
+	CanManageOrganizers    bool
+	CanManageInvites       bool
+	CanModerateLogs        bool
+	CanRemoveParticipants  bool
+	CanDraftScoringRules   bool
+	CanPublishScoringRules bool
+}
+
 type ContestPermissionCheck struct {
-	repo   ContestPermissionCheckRepository
-	kratos ContestPermissionCheckKratosClient
-	clock  commondomain.Clock
+	repo       ContestPermissionCheckRepository
+	kratos     ContestPermissionCheckKratosClient
+	organizers ContestOrganizerChecker
+	clock      commondomain.Clock
 }
 
-func NewContestPermissionCheck(repo ContestPermissionCheckRepository, kratos ContestPermissionCheckKratosClient, clock commondomain.Clock) *ContestPermissionCheck {
-	return &ContestPermissionCheck{repo: repo, kratos: kratos, clock: clock}
+func NewContestPermissionCheck(
+	repo ContestPermissionCheckRepository,
+	kratos ContestPermissionCheckKratosClient,
+	organizers ContestOrganizerChecker,
+	clock commondomain.Clock,
+) *ContestPermissionCheck {
+	return &ContestPermissionCheck{repo: repo, kratos: kratos, organizers: organizers, clock: clock}
 }
 
 func (s *ContestPermissionCheck) Execute(ctx context.Context) error {
@@ -60,3 +82,49 @@ func (s *ContestPermissionCheck) Execute(ctx context.Context) error {
 
 	return nil
 }
+
+// ExecuteForContest returns the effective capabilities of the user on a
+// contest. It mirrors the checks of the services behind each capability.
+func (s *ContestPermissionCheck) ExecuteForContest(ctx context.Context, contestID uuid.UUID) (*ContestCapabilities, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: contestID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest: %w", err)
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	userID := uuid.MustParse(session.Subject)
+
+	caps := &ContestCapabilities{
+		Owner: contest.OwnerUserID == userID,
+		Admin: isAdmin(ctx),
+	}
+
+	_, err = authorizeContestChange(ctx, contest)
+	manager := err == nil
+	if !manager && !contest.Official {
+		caps.Organizer, err = s.organizers.IsContestOrganizer(ctx, contest.ID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("could not check contest organizer: %w", err)
+		}
+	}
+	moderator := manager || caps.Organizer
+
+	now := s.clock.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	ended := contest.ContestEnd.Before(today)
+	beforeStart := now.Before(contest.ContestStart)
+
+	caps.CanEdit = manager && (caps.Admin || !ended)
+	caps.CanManageOrganizers = manager && !contest.Official
+	caps.CanManageInvites = manager && contest.Private
+	caps.CanModerateLogs = moderator
+	caps.CanRemoveParticipants = moderator && contest.Private
+	caps.CanDraftScoringRules = moderator && beforeStart
+	caps.CanPublishScoringRules = manager && beforeStart
+
+	return caps, nil
+}
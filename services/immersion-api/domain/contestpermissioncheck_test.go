@@ -8,18 +8,27 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tadoku/tadoku/services/immersion-api/domain"
 )
 
 type mockContestPermissionCheckRepository struct {
-	count int32
-	err   error
+	count   int32
+	err     error
+	contest *domain.ContestView
 }
 
 func (m *mockContestPermissionCheckRepository) GetContestsByUserCountForYear(ctx context.Context, now time.Time, userID uuid.UUID) (int32, error) {
 	return m.count, m.err
 }
 
+func (m *mockContestPermissionCheckRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contest == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.contest, nil
+}
+
 type mockContestPermissionCheckKratos struct {
 	traits *domain.UserTraits
 	err    error
@@ -37,7 +46,7 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 	t.Run("allows admin", func(t *testing.T) {
 		repo := &mockContestPermissionCheckRepository{}
 		kratos := &mockContestPermissionCheckKratos{}
-		svc := domain.NewContestPermissionCheck(repo, kratos, clock)
+		svc := domain.NewContestPermissionCheck(repo, kratos, &mockContestOrganizerClient{}, clock)
 
 		ctx := ctxWithAdminSubject(userID.String())
 
@@ -53,7 +62,7 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 				CreatedAt: now.AddDate(0, -2, 0), // 2 months ago
 			},
 		}
-		svc := domain.NewContestPermissionCheck(repo, kratos, clock)
+		svc := domain.NewContestPermissionCheck(repo, kratos, &mockContestOrganizerClient{}, clock)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -69,7 +78,7 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 				CreatedAt: now.AddDate(0, 0, -15), // 15 days ago
 			},
 		}
-		svc := domain.NewContestPermissionCheck(repo, kratos, clock)
+		svc := domain.NewContestPermissionCheck(repo, kratos, &mockContestOrganizerClient{}, clock)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -86,7 +95,7 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 				CreatedAt: now.AddDate(-1, 0, 0), // 1 year ago
 			},
 		}
-		svc := domain.NewContestPermissionCheck(repo, kratos, clock)
+		svc := domain.NewContestPermissionCheck(repo, kratos, &mockContestOrganizerClient{}, clock)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -101,7 +110,7 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 		kratos := &mockContestPermissionCheckKratos{
 			err: errors.New("identity not found"),
 		}
-		svc := domain.NewContestPermissionCheck(repo, kratos, clock)
+		svc := domain.NewContestPermissionCheck(repo, kratos, &mockContestOrganizerClient{}, clock)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -111,3 +120,87 @@ func TestContestPermissionCheck_Execute(t *testing.T) {
 		assert.Contains(t, err.Error(), "could not check permission")
 	})
 }
+
+func TestContestPermissionCheck_ExecuteForContest(t *testing.T) {
+	now := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	ownerID := uuid.New()
+	organizerID := uuid.New()
+
+	newContest := func() *domain.ContestView {
+		return &domain.ContestView{
+			ID:           uuid.New(),
+			OwnerUserID:  ownerID,
+			ContestStart: now.AddDate(0, 0, 3),
+			ContestEnd:   now.AddDate(0, 0, 17),
+			Private:      true,
+		}
+	}
+	organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+
+	t.Run("owner can do everything", func(t *testing.T) {
+		repo := &mockContestPermissionCheckRepository{contest: newContest()}
+		svc := domain.NewContestPermissionCheck(repo, &mockContestPermissionCheckKratos{}, organizers, clock)
+
+		caps, err := svc.ExecuteForContest(ctxWithUserSubject(ownerID.String()), repo.contest.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ContestCapabilities{
+			Owner:                  true,
+			CanEdit:                true,
+			CanManageOrganizers:    true,
+			CanManageInvites:       true,
+			CanModerateLogs:        true,
+			CanRemoveParticipants:  true,
+			CanDraftScoringRules:   true,
+			CanPublishScoringRules: true,
+		}, caps)
+	})
+
+	t.Run("co-organizer can moderate", func(t *testing.T) {
+		repo := &mockContestPermissionCheckRepository{contest: newContest()}
+		svc := domain.NewContestPermissionCheck(repo, &mockContestPermissionCheckKratos{}, organizers, clock)
+
+		caps, err := svc.ExecuteForContest(ctxWithUserSubject(organizerID.String()), repo.contest.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ContestCapabilities{
+			Organizer:             true,
+			CanModerateLogs:       true,
+			CanRemoveParticipants: true,
+			CanDraftScoringRules:  true,
+		}, caps)
+	})
+
+	t.Run("scoring is locked once the contest started", func(t *testing.T) {
+		contest := newContest()
+		contest.ContestStart = now.AddDate(0, 0, -1)
+		repo := &mockContestPermissionCheckRepository{contest: contest}
+		svc := domain.NewContestPermissionCheck(repo, &mockContestPermissionCheckKratos{}, organizers, clock)
+
+		caps, err := svc.ExecuteForContest(ctxWithUserSubject(organizerID.String()), contest.ID)
+
+		require.NoError(t, err)
+		assert.True(t, caps.CanModerateLogs)
+		assert.False(t, caps.CanDraftScoringRules)
+	})
+
+	t.Run("other users get no capabilities", func(t *testing.T) {
+		repo := &mockContestPermissionCheckRepository{contest: newContest()}
+		svc := domain.NewContestPermissionCheck(repo, &mockContestPermissionCheckKratos{}, organizers, clock)
+
+		caps, err := svc.ExecuteForContest(ctxWithUserSubject(uuid.NewString()), repo.contest.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ContestCapabilities{}, caps)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestPermissionCheckRepository{contest: newContest()}
+		svc := domain.NewContestPermissionCheck(repo, &mockContestPermissionCheckKratos{}, organizers, clock)
+
+		_, err := svc.ExecuteForContest(ctxWithGuest(), repo.contest.ID)
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
@@ -3,12 +3,14 @@ package domain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type contestScoringRuleSetFinder interface {
 	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	scoringRuleUsageFinder
 }
 
 type ContestLogTracking struct {
@@ -22,12 +24,14 @@ func scoringInputFromTracking(
 	languageCode string,
 	tags []string,
 	tracking LogTracking,
+	loggedAt time.Time,
 ) ScoringInput {
 	input := ScoringInput{
 		ActivityID:   activityID,
 		UnitKey:      tracking.UnitKey,
 		LanguageCode: languageCode,
 		Tags:         tags,
+		LoggedAt:     loggedAt,
 	}
 	if tracking.Kind == LogTrackingAmountUnit || tracking.Kind == LogTrackingBoth {
 		input.Amount = &tracking.Amount
@@ -41,6 +45,7 @@ func scoringInputFromTracking(
 func resolveContestLogTracking(
 	ctx context.Context,
 	finder contestScoringRuleSetFinder,
+	usage scoringUsageScope,
 	contestID uuid.UUID,
 	registrationID uuid.UUID,
 	platformTracking LogTracking,
@@ -53,6 +58,7 @@ func resolveContestLogTracking(
 
 	tracking := platformTracking
 	if contestRuleSet != nil {
+		input.Usage = usage.lookup(ctx, finder, &contestID)
 		result, evaluateErr := EvaluateContestScore(input, *contestRuleSet, fallbackRuleSet)
 		if evaluateErr != nil {
 			return ContestLogTracking{}, fmt.Errorf("could not evaluate contest scoring rules: %w", evaluateErr)
@@ -18,6 +18,8 @@ type ContestSummaryFetchResponse struct {
 	ParticipantCount int
 	LanguageCount    int
 	TotalScore       float32
+	// TopTags are the most used tags of logs submitted to the contest.
+	TopTags []TagStatistic
 }
 
 type ContestSummaryFetch struct {
@@ -33,6 +33,9 @@ func TestContestSummaryFetch_Execute(t *testing.T) {
 						ParticipantCount: 42,
 						LanguageCount:    5,
 						TotalScore:       12345.67,
+						TopTags: []domain.TagStatistic{
+							{Tag: "book", LogCount: 12, UserCount: 4, TotalScore: 340.5},
+						},
 					}, nil
 				}
 				return nil, domain.ErrNotFound
@@ -48,6 +51,8 @@ func TestContestSummaryFetch_Execute(t *testing.T) {
 		assert.Equal(t, 42, resp.ParticipantCount)
 		assert.Equal(t, 5, resp.LanguageCount)
 		assert.Equal(t, float32(12345.67), resp.TotalScore)
+		require.Len(t, resp.TopTags, 1)
+		assert.Equal(t, "book", resp.TopTags[0].Tag)
 	})
 
 	t.Run("returns not found when contest does not exist", func(t *testing.T) {
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContestTeamScoreAggregation decides how member scores are combined into
+// the score of a team.
+type ContestTeamScoreAggregation string
+
+const (
+	ContestTeamScoreSum     ContestTeamScoreAggregation = "sum"
+	ContestTeamScoreAverage ContestTeamScoreAggregation = "average"
+)
+
+const (
+	// MaxContestTeamSize is the largest team size limit an owner can set.
+	MaxContestTeamSize = 50
+	// MaxContestTeamNameLength mirrors the column size of contest_teams.name.
+	MaxContestTeamNameLength = 50
+)
+
+// ContestTeamSettings holds the team configuration of a contest.
+type ContestTeamSettings struct {
+	TeamMode         bool
+	TeamSizeLimit    *int32
+	ScoreAggregation ContestTeamScoreAggregation
+}
+
+type ContestTeam struct {
+	ID        uuid.UUID
+	ContestID uuid.UUID
+	Name      string
+	Members   []ContestTeamMember
+	CreatedAt time.Time
+}
+
+type ContestTeamMember struct {
+	UserID          uuid.UUID
+	UserDisplayName string
+	JoinedAt        time.Time
+}
+
+// ContestTeamScore is the raw score of a team before aggregation: the sum of
+// all member scores and the number of members.
+type ContestTeamScore struct {
+	TeamID      uuid.UUID
+	MemberCount int
+	TotalScore  float64
+}
+
+// TeamLeaderboardScore represents a team's aggregated score in a contest.
+type TeamLeaderboardScore struct {
+	TeamID uuid.UUID
+	Score  float64
+}
+
+// TeamLeaderboardPage is the team equivalent of LeaderboardPage.
+type TeamLeaderboardPage struct {
+	Scores     []TeamLeaderboardScore
+	TotalCount int
+	StartRank  int
+	HasPrevTie bool
+	HasNextTie bool
+}
+
+// Aggregate returns the score of the team under the given aggregation.
+func (s ContestTeamScore) Aggregate(aggregation ContestTeamScoreAggregation) float64 {
+	if aggregation == ContestTeamScoreAverage {
+		if s.MemberCount == 0 {
+			return 0
+		}
+		return s.TotalScore / float64(s.MemberCount)
+	}
+	return s.TotalScore
+}
+
+// validateContestTeamSettings validates the team configuration of a new
+// contest and clears it when team mode is disabled.
+func validateContestTeamSettings(req *ContestCreateRequest) error {
+	if !req.TeamMode {
+		req.TeamSizeLimit = nil
+		req.TeamScoreAggregation = ContestTeamScoreSum
+		return nil
+	}
+
+	if req.Official {
+		return fmt.Errorf("official rounds cannot be team contests: %w", ErrInvalidContest)
+	}
+	if req.TeamSizeLimit == nil || *req.TeamSizeLimit < 2 || *req.TeamSizeLimit > MaxContestTeamSize {
+		return fmt.Errorf("team size limit must be between 2 and %d: %w", MaxContestTeamSize, ErrInvalidContest)
+	}
+
+	switch req.TeamScoreAggregation {
+	case "":
+		req.TeamScoreAggregation = ContestTeamScoreSum
+	case ContestTeamScoreSum, ContestTeamScoreAverage:
+	default:
+		return fmt.Errorf("unknown team score aggregation %q: %w", req.TeamScoreAggregation, ErrInvalidContest)
+	}
+
+	return nil
+}
+
+// normalizeContestTeamName trims the name and checks its length.
+func normalizeContestTeamName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) < 3 || len(name) > MaxContestTeamNameLength {
+		return "", fmt.Errorf("team name must be between 3 and %d characters: %w", MaxContestTeamNameLength, ErrInvalidContestTeam)
+	}
+	return name, nil
+}
+
+// ContestTeamMembershipRepository provides the lookups needed before a user
+// can change their team.
+type ContestTeamMembershipRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	FindRegistrationForUser(context.Context, *RegistrationFindRequest) (*ContestRegistration, error)
+}
+
+// requireTeamMembershipChange checks that the contest runs in team mode, has
+// not ended yet and that the user is registered for it.
+func requireTeamMembershipChange(ctx context.Context, repo ContestTeamMembershipRepository, contestID, userID uuid.UUID, now time.Time) (*ContestView, error) {
+	contest, err := repo.FindContestByID(ctx, &ContestFindRequest{ID: contestID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest: %w", err)
+	}
+	if !contest.TeamMode {
+		return nil, fmt.Errorf("contest is not a team contest: %w", ErrInvalidContestTeam)
+	}
+	// contest_end is inclusive, teams can change until the last day is over
+	if !now.Before(contest.ContestEnd.AddDate(0, 0, 1)) {
+		return nil, fmt.Errorf("contest has already ended: %w", ErrInvalidContestTeam)
+	}
+
+	if _, err := repo.FindRegistrationForUser(ctx, &RegistrationFindRequest{
+		UserID:    userID,
+		ContestID: contestID,
+	}); err != nil {
+		return nil, fmt.Errorf("user is not registered for contest: %w", err)
+	}
+
+	return contest, nil
+}
+
+// ContestTeamScoreRepository reads the data needed to build a team
+// leaderboard.
+type ContestTeamScoreRepository interface {
+	FindContestTeamSettings(ctx context.Context, contestID uuid.UUID) (*ContestTeamSettings, error)
+	FetchContestTeamScores(ctx context.Context, contestID uuid.UUID) ([]ContestTeamScore, error)
+}
+
+// fetchTeamLeaderboardScores aggregates the member scores of every team in
+// a contest. ok is false when the contest does not run in team mode.
+func fetchTeamLeaderboardScores(ctx context.Context, repo ContestTeamScoreRepository, contestID uuid.UUID) ([]TeamLeaderboardScore, bool, error) {
+	settings, err := repo.FindContestTeamSettings(ctx, contestID)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not fetch contest team settings: %w", err)
+	}
+	if !settings.TeamMode {
+		return nil, false, nil
+	}
+
+	teamScores, err := repo.FetchContestTeamScores(ctx, contestID)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not fetch contest team scores: %w", err)
+	}
+
+	scores := make([]TeamLeaderboardScore, len(teamScores))
+	for i, s := range teamScores {
+		scores[i] = TeamLeaderboardScore{
+			TeamID: s.TeamID,
+			Score:  s.Aggregate(settings.ScoreAggregation),
+		}
+	}
+	return scores, true, nil
+}
@@ -0,0 +1,100 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+// mockContestTeamRepository implements the repositories of all contest team
+// services.
+type mockContestTeamRepository struct {
+	contest         *domain.ContestView
+	contestErr      error
+	registrationErr error
+	nameExists      bool
+	teams           []domain.ContestTeam
+	joinErr         error
+	leaveErr        error
+	teamSettings    *domain.ContestTeamSettings
+	teamScores      []domain.ContestTeamScore
+
+	createdTeam *domain.ContestTeamCreateRequest
+	joined      *domain.ContestTeamJoinRequest
+	joinLimit   int32
+	left        *domain.ContestTeamLeaveRequest
+}
+
+func (m *mockContestTeamRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contestErr != nil {
+		return nil, m.contestErr
+	}
+	return m.contest, nil
+}
+
+func (m *mockContestTeamRepository) FindRegistrationForUser(ctx context.Context, req *domain.RegistrationFindRequest) (*domain.ContestRegistration, error) {
+	if m.registrationErr != nil {
+		return nil, m.registrationErr
+	}
+	return &domain.ContestRegistration{ContestID: req.ContestID, UserID: req.UserID}, nil
+}
+
+func (m *mockContestTeamRepository) ContestTeamNameExists(ctx context.Context, contestID uuid.UUID, name string) (bool, error) {
+	return m.nameExists, nil
+}
+
+func (m *mockContestTeamRepository) CreateContestTeam(ctx context.Context, req *domain.ContestTeamCreateRequest) (*domain.ContestTeam, error) {
+	m.createdTeam = req
+	return &domain.ContestTeam{
+		ID:        uuid.New(),
+		ContestID: req.ContestID,
+		Name:      req.Name,
+		Members:   []domain.ContestTeamMember{{UserID: req.UserID()}},
+	}, nil
+}
+
+func (m *mockContestTeamRepository) JoinContestTeam(ctx context.Context, req *domain.ContestTeamJoinRequest, sizeLimit int32) error {
+	m.joined = req
+	m.joinLimit = sizeLimit
+	return m.joinErr
+}
+
+func (m *mockContestTeamRepository) LeaveContestTeam(ctx context.Context, req *domain.ContestTeamLeaveRequest) error {
+	m.left = req
+	return m.leaveErr
+}
+
+func (m *mockContestTeamRepository) ListContestTeams(ctx context.Context, contestID uuid.UUID) ([]domain.ContestTeam, error) {
+	return m.teams, nil
+}
+
+func (m *mockContestTeamRepository) FindContestTeamSettings(ctx context.Context, contestID uuid.UUID) (*domain.ContestTeamSettings, error) {
+	return m.teamSettings, nil
+}
+
+func (m *mockContestTeamRepository) FetchContestTeamScores(ctx context.Context, contestID uuid.UUID) ([]domain.ContestTeamScore, error) {
+	return m.teamScores, nil
+}
+
+func newTeamContest(now time.Time, sizeLimit int32) *domain.ContestView {
+	return &domain.ContestView{
+		ID:                   uuid.New(),
+		ContestStart:         now.AddDate(0, 0, -7),
+		ContestEnd:           now.AddDate(0, 0, 7),
+		TeamMode:             true,
+		TeamSizeLimit:        &sizeLimit,
+		TeamScoreAggregation: domain.ContestTeamScoreSum,
+	}
+}
+
+func TestContestTeamScore_Aggregate(t *testing.T) {
+	score := domain.ContestTeamScore{MemberCount: 4, TotalScore: 100}
+
+	assert.Equal(t, 100.0, score.Aggregate(domain.ContestTeamScoreSum))
+	assert.Equal(t, 25.0, score.Aggregate(domain.ContestTeamScoreAverage))
+	assert.Equal(t, 0.0, domain.ContestTeamScore{}.Aggregate(domain.ContestTeamScoreAverage))
+}
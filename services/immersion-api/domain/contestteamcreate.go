@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTeamCreateRepository interface {
+	ContestTeamMembershipRepository
+	ContestTeamNameExists(ctx context.Context, contestID uuid.UUID, name string) (bool, error)
+	CreateContestTeam(context.Context, *ContestTeamCreateRequest) (*ContestTeam, error)
+}
+
+type ContestTeamCreateRequest struct {
+	ContestID uuid.UUID
+	Name      string
+
+	// Set by domain layer
+	userID uuid.UUID
+}
+
+func (r *ContestTeamCreateRequest) UserID() uuid.UUID { return r.userID }
+
+// ContestTeamCreate creates a team in a team contest. The creator becomes its
+// first member and leaves the team they were part of before.
+type ContestTeamCreate struct {
+	repo  ContestTeamCreateRepository
+	clock commondomain.Clock
+}
+
+func NewContestTeamCreate(repo ContestTeamCreateRepository, clock commondomain.Clock) *ContestTeamCreate {
+	return &ContestTeamCreate{repo: repo, clock: clock}
+}
+
+func (s *ContestTeamCreate) Execute(ctx context.Context, req *ContestTeamCreateRequest) (*ContestTeam, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	req.userID = uuid.MustParse(session.Subject)
+
+	name, err := normalizeContestTeamName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	req.Name = name
+
+	if _, err := requireTeamMembershipChange(ctx, s.repo, req.ContestID, req.userID, s.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ContestTeamNameExists(ctx, req.ContestID, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not check team name: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("team name %q is already taken: %w", req.Name, ErrInvalidContestTeam)
+	}
+
+	team, err := s.repo.CreateContestTeam(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create team: %w", err)
+	}
+	return team, nil
+}
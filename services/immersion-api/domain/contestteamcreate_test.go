@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTeamCreate_Execute(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	userID := uuid.New()
+
+	t.Run("creates a team with the creator as member", func(t *testing.T) {
+		contest := newTeamContest(now, 5)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamCreate(repo, clock)
+
+		team, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      "  Study group  ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Study group", team.Name)
+		require.NotNil(t, repo.createdTeam)
+		assert.Equal(t, userID, repo.createdTeam.UserID())
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestTeamRepository{contest: newTeamContest(now, 5)}
+		svc := domain.NewContestTeamCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.ContestTeamCreateRequest{Name: "Study group"})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("rejects contests without team mode", func(t *testing.T) {
+		contest := newTeamContest(now, 5)
+		contest.TeamMode = false
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      "Study group",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+		assert.Nil(t, repo.createdTeam)
+	})
+
+	t.Run("rejects ended contests", func(t *testing.T) {
+		contest := newTeamContest(now, 5)
+		contest.ContestEnd = now.AddDate(0, 0, -1)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      "Study group",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+	})
+
+	t.Run("requires a registration", func(t *testing.T) {
+		contest := newTeamContest(now, 5)
+		repo := &mockContestTeamRepository{contest: contest, registrationErr: domain.ErrNotFound}
+		svc := domain.NewContestTeamCreate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      "Study group",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Nil(t, repo.createdTeam)
+	})
+
+	t.Run("rejects invalid and taken names", func(t *testing.T) {
+		contest := newTeamContest(now, 5)
+		svc := domain.NewContestTeamCreate(&mockContestTeamRepository{contest: contest}, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      " a ",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+
+		repo := &mockContestTeamRepository{contest: contest, nameExists: true}
+		svc = domain.NewContestTeamCreate(repo, clock)
+
+		_, err = svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamCreateRequest{
+			ContestID: contest.ID,
+			Name:      "Study group",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+		assert.Nil(t, repo.createdTeam)
+	})
+}
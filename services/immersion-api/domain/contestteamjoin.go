@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTeamJoinRepository interface {
+	ContestTeamMembershipRepository
+	// JoinContestTeam moves the user into the team unless it already has
+	// sizeLimit other members, in which case ErrContestTeamFull is returned.
+	JoinContestTeam(ctx context.Context, req *ContestTeamJoinRequest, sizeLimit int32) error
+}
+
+type ContestTeamJoinRequest struct {
+	ContestID uuid.UUID
+	TeamID    uuid.UUID
+
+	// Set by domain layer
+	userID uuid.UUID
+}
+
+func (r *ContestTeamJoinRequest) UserID() uuid.UUID { return r.userID }
+
+// ContestTeamJoin adds the current user to a team. Users can be part of one
+// team per contest, joining another team switches teams.
+type ContestTeamJoin struct {
+	repo  ContestTeamJoinRepository
+	clock commondomain.Clock
+}
+
+func NewContestTeamJoin(repo ContestTeamJoinRepository, clock commondomain.Clock) *ContestTeamJoin {
+	return &ContestTeamJoin{repo: repo, clock: clock}
+}
+
+func (s *ContestTeamJoin) Execute(ctx context.Context, req *ContestTeamJoinRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return ErrUnauthorized
+	}
+	req.userID = uuid.MustParse(session.Subject)
+
+	contest, err := requireTeamMembershipChange(ctx, s.repo, req.ContestID, req.userID, s.clock.Now())
+	if err != nil {
+		return err
+	}
+	if contest.TeamSizeLimit == nil {
+		return fmt.Errorf("contest has no team size limit: %w", ErrInvalidContestTeam)
+	}
+
+	if err := s.repo.JoinContestTeam(ctx, req, *contest.TeamSizeLimit); err != nil {
+		return fmt.Errorf("could not join team: %w", err)
+	}
+	return nil
+}
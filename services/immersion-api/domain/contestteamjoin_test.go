@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTeamJoin_Execute(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	userID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("joins a team within the size limit", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamJoin(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamJoinRequest{
+			ContestID: contest.ID,
+			TeamID:    teamID,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.joined)
+		assert.Equal(t, userID, repo.joined.UserID())
+		assert.Equal(t, teamID, repo.joined.TeamID)
+		assert.Equal(t, int32(4), repo.joinLimit)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestTeamRepository{contest: newTeamContest(now, 4)}
+		svc := domain.NewContestTeamJoin(repo, clock)
+
+		err := svc.Execute(ctxWithGuest(), &domain.ContestTeamJoinRequest{TeamID: teamID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Nil(t, repo.joined)
+	})
+
+	t.Run("returns full team error", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		repo := &mockContestTeamRepository{contest: contest, joinErr: domain.ErrContestTeamFull}
+		svc := domain.NewContestTeamJoin(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamJoinRequest{
+			ContestID: contest.ID,
+			TeamID:    teamID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrContestTeamFull)
+	})
+
+	t.Run("allows changes on the last day of the contest", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		contest.ContestEnd = time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamJoin(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamJoinRequest{
+			ContestID: contest.ID,
+			TeamID:    teamID,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects contests without team mode", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		contest.TeamMode = false
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamJoin(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamJoinRequest{
+			ContestID: contest.ID,
+			TeamID:    teamID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+		assert.Nil(t, repo.joined)
+	})
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestTeamLeaderboardFetchRepository interface {
+	ContestTeamScoreRepository
+	ListContestTeams(ctx context.Context, contestID uuid.UUID) ([]ContestTeam, error)
+}
+
+type ContestTeamLeaderboardFetchStore interface {
+	FetchContestTeamLeaderboardPage(ctx context.Context, contestID uuid.UUID, page, pageSize int) (*TeamLeaderboardPage, bool, error)
+	RebuildContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID, scores []TeamLeaderboardScore) error
+}
+
+type ContestTeamLeaderboardFetchRequest struct {
+	ContestID uuid.UUID
+	PageSize  int
+	Page      int
+}
+
+// ContestTeamLeaderboardFetch returns the team leaderboard of a team contest.
+// Team scores are aggregated by the leaderboard outbox worker, the board is
+// only rebuilt here when it is missing from the store.
+type ContestTeamLeaderboardFetch struct {
+	repo  ContestTeamLeaderboardFetchRepository
+	store ContestTeamLeaderboardFetchStore
+}
+
+func NewContestTeamLeaderboardFetch(repo ContestTeamLeaderboardFetchRepository, store ContestTeamLeaderboardFetchStore) *ContestTeamLeaderboardFetch {
+	return &ContestTeamLeaderboardFetch{repo: repo, store: store}
+}
+
+func (s *ContestTeamLeaderboardFetch) Execute(ctx context.Context, req *ContestTeamLeaderboardFetchRequest) (*TeamLeaderboard, error) {
+	if req.PageSize == 0 {
+		req.PageSize = 25
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	lbPage, exists, err := s.store.FetchContestTeamLeaderboardPage(ctx, req.ContestID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team leaderboard from store: %w", err)
+	}
+
+	if !exists {
+		scores, teamMode, err := fetchTeamLeaderboardScores(ctx, s.repo, req.ContestID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch team scores for rebuild: %w", err)
+		}
+		if !teamMode {
+			return nil, fmt.Errorf("contest is not a team contest: %w", ErrNotFound)
+		}
+		if err := s.store.RebuildContestTeamLeaderboard(ctx, req.ContestID, scores); err != nil {
+			return nil, fmt.Errorf("failed to rebuild team leaderboard: %w", err)
+		}
+
+		lbPage, exists, err = s.store.FetchContestTeamLeaderboardPage(ctx, req.ContestID, req.Page, req.PageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch team leaderboard from store: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("team leaderboard missing after rebuild")
+		}
+	}
+
+	teams, err := s.repo.ListContestTeams(ctx, req.ContestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch teams: %w", err)
+	}
+	teamsByID := make(map[uuid.UUID]ContestTeam, len(teams))
+	for _, team := range teams {
+		teamsByID[team.ID] = team
+	}
+
+	entries := buildTeamLeaderboardEntries(*lbPage, teamsByID)
+
+	nextPageToken := ""
+	if (req.Page*req.PageSize)+req.PageSize < lbPage.TotalCount {
+		nextPageToken = fmt.Sprint(req.Page + 1)
+	}
+
+	return &TeamLeaderboard{
+		Entries:       entries,
+		TotalSize:     lbPage.TotalCount,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockContestTeamLeaderboardStore struct {
+	page         *domain.TeamLeaderboardPage
+	rebuiltWith  []domain.TeamLeaderboardScore
+	rebuildCalls int
+}
+
+func (m *mockContestTeamLeaderboardStore) FetchContestTeamLeaderboardPage(ctx context.Context, contestID uuid.UUID, page, pageSize int) (*domain.TeamLeaderboardPage, bool, error) {
+	if m.page == nil {
+		return nil, false, nil
+	}
+	return m.page, true, nil
+}
+
+func (m *mockContestTeamLeaderboardStore) RebuildContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID, scores []domain.TeamLeaderboardScore) error {
+	m.rebuildCalls++
+	m.rebuiltWith = scores
+	m.page = &domain.TeamLeaderboardPage{StartRank: 1, TotalCount: len(scores)}
+	for _, s := range scores {
+		m.page.Scores = append(m.page.Scores, s)
+	}
+	return nil
+}
+
+func TestContestTeamLeaderboardFetch_Execute(t *testing.T) {
+	contestID := uuid.New()
+	teamA := domain.ContestTeam{ID: uuid.New(), Name: "A", Members: make([]domain.ContestTeamMember, 2)}
+	teamB := domain.ContestTeam{ID: uuid.New(), Name: "B", Members: make([]domain.ContestTeamMember, 3)}
+	teamC := domain.ContestTeam{ID: uuid.New(), Name: "C", Members: make([]domain.ContestTeamMember, 1)}
+
+	t.Run("ranks teams from the store", func(t *testing.T) {
+		store := &mockContestTeamLeaderboardStore{page: &domain.TeamLeaderboardPage{
+			Scores: []domain.TeamLeaderboardScore{
+				{TeamID: teamB.ID, Score: 300},
+				{TeamID: teamA.ID, Score: 200},
+				{TeamID: teamC.ID, Score: 200},
+			},
+			TotalCount: 3,
+			StartRank:  1,
+		}}
+		repo := &mockContestTeamRepository{teams: []domain.ContestTeam{teamA, teamB, teamC}}
+		svc := domain.NewContestTeamLeaderboardFetch(repo, store)
+
+		lb, err := svc.Execute(context.Background(), &domain.ContestTeamLeaderboardFetchRequest{ContestID: contestID})
+
+		require.NoError(t, err)
+		require.Len(t, lb.Entries, 3)
+		assert.Equal(t, domain.TeamLeaderboardEntry{Rank: 1, TeamID: teamB.ID, TeamName: "B", MemberCount: 3, Score: 300}, lb.Entries[0])
+		assert.Equal(t, 2, lb.Entries[1].Rank)
+		assert.True(t, lb.Entries[1].IsTie)
+		assert.Equal(t, 2, lb.Entries[2].Rank)
+		assert.True(t, lb.Entries[2].IsTie)
+		assert.Equal(t, 3, lb.TotalSize)
+		assert.Empty(t, lb.NextPageToken)
+		assert.Zero(t, store.rebuildCalls)
+	})
+
+	t.Run("rebuilds a missing board with aggregated scores", func(t *testing.T) {
+		store := &mockContestTeamLeaderboardStore{}
+		repo := &mockContestTeamRepository{
+			teams:        []domain.ContestTeam{teamA, teamB},
+			teamSettings: &domain.ContestTeamSettings{TeamMode: true, ScoreAggregation: domain.ContestTeamScoreAverage},
+			teamScores: []domain.ContestTeamScore{
+				{TeamID: teamB.ID, MemberCount: 3, TotalScore: 300},
+				{TeamID: teamA.ID, MemberCount: 2, TotalScore: 100},
+			},
+		}
+		svc := domain.NewContestTeamLeaderboardFetch(repo, store)
+
+		lb, err := svc.Execute(context.Background(), &domain.ContestTeamLeaderboardFetchRequest{ContestID: contestID})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, store.rebuildCalls)
+		assert.Equal(t, []domain.TeamLeaderboardScore{
+			{TeamID: teamB.ID, Score: 100},
+			{TeamID: teamA.ID, Score: 50},
+		}, store.rebuiltWith)
+		require.Len(t, lb.Entries, 2)
+		assert.Equal(t, "B", lb.Entries[0].TeamName)
+	})
+
+	t.Run("returns not found for contests without team mode", func(t *testing.T) {
+		store := &mockContestTeamLeaderboardStore{}
+		repo := &mockContestTeamRepository{teamSettings: &domain.ContestTeamSettings{}}
+		svc := domain.NewContestTeamLeaderboardFetch(repo, store)
+
+		_, err := svc.Execute(context.Background(), &domain.ContestTeamLeaderboardFetchRequest{ContestID: contestID})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Zero(t, store.rebuildCalls)
+	})
+
+	t.Run("skips teams removed since the last rebuild", func(t *testing.T) {
+		store := &mockContestTeamLeaderboardStore{page: &domain.TeamLeaderboardPage{
+			Scores:     []domain.TeamLeaderboardScore{{TeamID: uuid.New(), Score: 50}, {TeamID: teamA.ID, Score: 10}},
+			TotalCount: 2,
+			StartRank:  1,
+		}}
+		svc := domain.NewContestTeamLeaderboardFetch(&mockContestTeamRepository{teams: []domain.ContestTeam{teamA}}, store)
+
+		lb, err := svc.Execute(context.Background(), &domain.ContestTeamLeaderboardFetchRequest{ContestID: contestID})
+
+		require.NoError(t, err)
+		require.Len(t, lb.Entries, 1)
+		assert.Equal(t, teamA.ID, lb.Entries[0].TeamID)
+		assert.Equal(t, 2, lb.Entries[0].Rank)
+	})
+}
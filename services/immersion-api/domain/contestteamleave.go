@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTeamLeaveRepository interface {
+	ContestTeamMembershipRepository
+	// LeaveContestTeam removes the user from their team and deletes the team
+	// once it is empty. Returns ErrNotFound if the user is not in a team.
+	LeaveContestTeam(context.Context, *ContestTeamLeaveRequest) error
+}
+
+type ContestTeamLeaveRequest struct {
+	ContestID uuid.UUID
+
+	// Set by domain layer
+	userID uuid.UUID
+}
+
+func (r *ContestTeamLeaveRequest) UserID() uuid.UUID { return r.userID }
+
+type ContestTeamLeave struct {
+	repo  ContestTeamLeaveRepository
+	clock commondomain.Clock
+}
+
+func NewContestTeamLeave(repo ContestTeamLeaveRepository, clock commondomain.Clock) *ContestTeamLeave {
+	return &ContestTeamLeave{repo: repo, clock: clock}
+}
+
+func (s *ContestTeamLeave) Execute(ctx context.Context, req *ContestTeamLeaveRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return ErrUnauthorized
+	}
+	req.userID = uuid.MustParse(session.Subject)
+
+	if _, err := requireTeamMembershipChange(ctx, s.repo, req.ContestID, req.userID, s.clock.Now()); err != nil {
+		return err
+	}
+
+	if err := s.repo.LeaveContestTeam(ctx, req); err != nil {
+		return fmt.Errorf("could not leave team: %w", err)
+	}
+	return nil
+}
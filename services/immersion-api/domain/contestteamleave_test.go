@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTeamLeave_Execute(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	userID := uuid.New()
+
+	t.Run("leaves the current team", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamLeave(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamLeaveRequest{ContestID: contest.ID})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.left)
+		assert.Equal(t, userID, repo.left.UserID())
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		repo := &mockContestTeamRepository{contest: newTeamContest(now, 4)}
+		svc := domain.NewContestTeamLeave(repo, clock)
+
+		err := svc.Execute(ctxWithGuest(), &domain.ContestTeamLeaveRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("returns not found when not in a team", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		repo := &mockContestTeamRepository{contest: contest, leaveErr: domain.ErrNotFound}
+		svc := domain.NewContestTeamLeave(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamLeaveRequest{ContestID: contest.ID})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("rejects ended contests", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		contest.ContestEnd = now.AddDate(0, 0, -2)
+		repo := &mockContestTeamRepository{contest: contest}
+		svc := domain.NewContestTeamLeave(repo, clock)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTeamLeaveRequest{ContestID: contest.ID})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestTeam)
+		assert.Nil(t, repo.left)
+	})
+}
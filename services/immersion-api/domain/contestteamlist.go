@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ContestTeamListRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	ListContestTeams(ctx context.Context, contestID uuid.UUID) ([]ContestTeam, error)
+}
+
+type ContestTeamListRequest struct {
+	ContestID uuid.UUID
+}
+
+type ContestTeamListResponse struct {
+	Teams []ContestTeam
+}
+
+type ContestTeamList struct {
+	repo ContestTeamListRepository
+}
+
+func NewContestTeamList(repo ContestTeamListRepository) *ContestTeamList {
+	return &ContestTeamList{repo: repo}
+}
+
+func (s *ContestTeamList) Execute(ctx context.Context, req *ContestTeamListRequest) (*ContestTeamListResponse, error) {
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ContestID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest: %w", err)
+	}
+	if !contest.TeamMode {
+		return &ContestTeamListResponse{Teams: []ContestTeam{}}, nil
+	}
+
+	teams, err := s.repo.ListContestTeams(ctx, req.ContestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list teams: %w", err)
+	}
+	return &ContestTeamListResponse{Teams: teams}, nil
+}
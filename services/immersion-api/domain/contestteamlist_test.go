@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTeamList_Execute(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("lists teams of a team contest", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		teams := []domain.ContestTeam{{ID: uuid.New(), ContestID: contest.ID, Name: "Study group"}}
+		svc := domain.NewContestTeamList(&mockContestTeamRepository{contest: contest, teams: teams})
+
+		res, err := svc.Execute(context.Background(), &domain.ContestTeamListRequest{ContestID: contest.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, teams, res.Teams)
+	})
+
+	t.Run("returns no teams for contests without team mode", func(t *testing.T) {
+		contest := newTeamContest(now, 4)
+		contest.TeamMode = false
+		svc := domain.NewContestTeamList(&mockContestTeamRepository{
+			contest: contest,
+			teams:   []domain.ContestTeam{{ID: uuid.New()}},
+		})
+
+		res, err := svc.Execute(context.Background(), &domain.ContestTeamListRequest{ContestID: contest.ID})
+
+		require.NoError(t, err)
+		assert.Empty(t, res.Teams)
+	})
+
+	t.Run("returns not found for unknown contests", func(t *testing.T) {
+		svc := domain.NewContestTeamList(&mockContestTeamRepository{contestErr: domain.ErrNotFound})
+
+		_, err := svc.Execute(context.Background(), &domain.ContestTeamListRequest{ContestID: uuid.New()})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
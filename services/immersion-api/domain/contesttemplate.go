@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestRecurrenceFrequency string
+
+const (
+	ContestRecurrenceWeekly  ContestRecurrenceFrequency = "weekly"
+	ContestRecurrenceMonthly ContestRecurrenceFrequency = "monthly"
+)
+
+const (
+	MaxContestTemplateDurationDays    int32 = 366
+	MaxContestTemplateLeadDays        int32 = 90
+	MaxContestTemplateTitleLength           = 100
+	MaxContestTemplateWeeklyInterval  int32 = 52
+	MaxContestTemplateMonthlyInterval int32 = 24
+
+	// contestTemplateCheckedOccurrences is how many occurrences are checked
+	// for overlaps, enough to cover every month length combination.
+	contestTemplateCheckedOccurrences int32 = 24
+)
+
+// ContestTemplate describes a recurring contest series. Every occurrence is
+// created as a regular contest linking back to the template.
+type ContestTemplate struct {
+	ID                   uuid.UUID
+	OwnerUserID          uuid.UUID
+	OwnerUserDisplayName string
+	// TitlePattern supports the {year}, {month}, {month_name}, {quarter},
+	// {number} and {year_number} placeholders, see Occurrence.
+	TitlePattern string
+	Description  *string
+	Official     bool
+	Private      bool
+	// DurationDays is nil for contests running until the next one starts.
+	DurationDays                    *int32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   *int32
+	TeamScoreAggregation            ContestTeamScoreAggregation
+	// ScoringRuleSetID points to a published contest rule set whose rules are
+	// copied into every created contest.
+	ScoringRuleSetID *uuid.UUID
+
+	Frequency         ContestRecurrenceFrequency
+	IntervalCount     int32
+	FirstContestStart time.Time
+	// LeadDays is how many days before its start an occurrence is created.
+	LeadDays int32
+	// NextOccurrence is the index of the next occurrence to create.
+	NextOccurrence int32
+	Active         bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ContestTemplateOccurrence is a single contest of a series.
+type ContestTemplateOccurrence struct {
+	Index           int32
+	Title           string
+	ContestStart    time.Time
+	ContestEnd      time.Time
+	RegistrationEnd time.Time
+}
+
+// ContestTemplateContest is a contest created from a template.
+type ContestTemplateContest struct {
+	ID              uuid.UUID
+	Occurrence      int32
+	Title           string
+	ContestStart    time.Time
+	ContestEnd      time.Time
+	RegistrationEnd time.Time
+	Private         bool
+	Deleted         bool
+}
+
+// OccurrenceStart returns the start date of the occurrence with the given
+// index. Monthly series keep the day of month of the first contest, clamped
+// to the last day of shorter months.
+func (t *ContestTemplate) OccurrenceStart(index int32) time.Time {
+	first := time.Date(t.FirstContestStart.Year(), t.FirstContestStart.Month(), t.FirstContestStart.Day(), 0, 0, 0, 0, time.UTC)
+	steps := int(index * t.IntervalCount)
+
+	if t.Frequency == ContestRecurrenceWeekly {
+		return first.AddDate(0, 0, 7*steps)
+	}
+
+	monthStart := time.Date(first.Year(), first.Month()+time.Month(steps), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := monthStart.AddDate(0, 1, -1).Day()
+	return monthStart.AddDate(0, 0, min(first.Day(), lastDay)-1)
+}
+
+// Occurrence renders the occurrence with the given index. The {number}
+// placeholder counts occurrences of the series, {year_number} restarts
+// counting every year as was done for the official rounds.
+func (t *ContestTemplate) Occurrence(index int32) ContestTemplateOccurrence {
+	start := t.OccurrenceStart(index)
+	end := t.OccurrenceStart(index+1).AddDate(0, 0, -1)
+	if t.DurationDays != nil {
+		end = start.AddDate(0, 0, int(*t.DurationDays)-1)
+	}
+
+	yearNumber := 1
+	for i := index - 1; i >= 0 && t.OccurrenceStart(i).Year() == start.Year(); i-- {
+		yearNumber++
+	}
+
+	title := strings.NewReplacer(
+		"{year}", strconv.Itoa(start.Year()),
+		"{month}", fmt.Sprintf("%02d", int(start.Month())),
+		"{month_name}", start.Month().String(),
+		"{quarter}", strconv.Itoa((int(start.Month())-1)/3+1),
+		"{number}", strconv.Itoa(int(index)+1),
+		"{year_number}", strconv.Itoa(yearNumber),
+	).Replace(t.TitlePattern)
+
+	return ContestTemplateOccurrence{
+		Index:           index,
+		Title:           title,
+		ContestStart:    start,
+		ContestEnd:      end,
+		RegistrationEnd: end.AddDate(0, 0, -int(t.RegistrationClosesBeforeEndDays)),
+	}
+}
+
+// OccurrencesPerYear is the highest number of contests the template creates
+// within a year.
+func (t *ContestTemplate) OccurrencesPerYear() int32 {
+	if t.Frequency == ContestRecurrenceWeekly {
+		return (52 + t.IntervalCount - 1) / t.IntervalCount
+	}
+	return (12 + t.IntervalCount - 1) / t.IntervalCount
+}
+
+// ContestTemplateValidationRepository looks up what a template refers to.
+type ContestTemplateValidationRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	FindScoringRuleSetByID(context.Context, uuid.UUID) (*ScoringRuleSet, error)
+	LanguagesExist(context.Context, []string) (bool, error)
+}
+
+// validateContestTemplate checks the settings shared by creating and updating
+// a template, normalizing the team settings the same way contests do.
+func validateContestTemplate(ctx context.Context, repo ContestTemplateValidationRepository, t *ContestTemplate) error {
+	if t.DurationDays != nil && (*t.DurationDays < 1 || *t.DurationDays > MaxContestTemplateDurationDays) {
+		return fmt.Errorf("duration must be between 1 and %d days: %w", MaxContestTemplateDurationDays, ErrInvalidContest)
+	}
+	if t.RegistrationClosesBeforeEndDays < 0 {
+		return fmt.Errorf("registration cannot close after the contest ended: %w", ErrInvalidContest)
+	}
+	if t.LeadDays < 0 || t.LeadDays > MaxContestTemplateLeadDays {
+		return fmt.Errorf("lead time must be between 0 and %d days: %w", MaxContestTemplateLeadDays, ErrInvalidContest)
+	}
+
+	switch t.Frequency {
+	case ContestRecurrenceWeekly:
+		if t.IntervalCount < 1 || t.IntervalCount > MaxContestTemplateWeeklyInterval {
+			return fmt.Errorf("weekly interval must be between 1 and %d: %w", MaxContestTemplateWeeklyInterval, ErrInvalidContest)
+		}
+	case ContestRecurrenceMonthly:
+		if t.IntervalCount < 1 || t.IntervalCount > MaxContestTemplateMonthlyInterval {
+			return fmt.Errorf("monthly interval must be between 1 and %d: %w", MaxContestTemplateMonthlyInterval, ErrInvalidContest)
+		}
+	default:
+		return fmt.Errorf("unknown recurrence frequency %q: %w", t.Frequency, ErrInvalidContest)
+	}
+	if t.FirstContestStart.IsZero() {
+		return fmt.Errorf("first contest start is required: %w", ErrInvalidContest)
+	}
+
+	t.TitlePattern = strings.TrimSpace(t.TitlePattern)
+	if len(t.TitlePattern) > MaxContestTemplateTitleLength || len(t.Occurrence(0).Title) <= 3 {
+		return fmt.Errorf("title pattern must be between 4 and %d characters: %w", MaxContestTemplateTitleLength, ErrInvalidContest)
+	}
+
+	// Occurrences should not overlap, otherwise users would be in two
+	// contests of the same series at once.
+	for i := int32(0); i < contestTemplateCheckedOccurrences; i++ {
+		occurrence := t.Occurrence(i)
+		if !occurrence.ContestEnd.Before(t.OccurrenceStart(i + 1)) {
+			return fmt.Errorf("contests of a series cannot overlap: %w", ErrInvalidContest)
+		}
+		if occurrence.RegistrationEnd.Before(occurrence.ContestStart) {
+			return fmt.Errorf("registration must close after the contest started: %w", ErrInvalidContest)
+		}
+	}
+
+	if !isAdmin(ctx) && t.OccurrencesPerYear() > UserCreateContestYearlyLimit {
+		return fmt.Errorf("series cannot create more than %d contests a year: %w", UserCreateContestYearlyLimit, ErrInvalidContest)
+	}
+
+	if t.Official && t.Private {
+		return fmt.Errorf("official rounds cannot be private: %w", ErrInvalidContest)
+	}
+	if t.Official && len(t.LanguageCodeAllowList) != 0 {
+		return fmt.Errorf("official rounds cannot limit language choice: %w", ErrInvalidContest)
+	}
+
+	team := &ContestCreateRequest{
+		Official:             t.Official,
+		TeamMode:             t.TeamMode,
+		TeamSizeLimit:        t.TeamSizeLimit,
+		TeamScoreAggregation: t.TeamScoreAggregation,
+	}
+	if err := validateContestTeamSettings(team); err != nil {
+		return err
+	}
+	t.TeamSizeLimit = team.TeamSizeLimit
+	t.TeamScoreAggregation = team.TeamScoreAggregation
+
+	if len(t.ActivityTypeIDAllowList) == 0 {
+		return fmt.Errorf("at least one activity is required: %w", ErrInvalidContest)
+	}
+	for _, activityID := range t.ActivityTypeIDAllowList {
+		if !IsValidActivityID(activityID) {
+			return fmt.Errorf("activity %d is not valid: %w", activityID, ErrInvalidContest)
+		}
+	}
+
+	if len(t.LanguageCodeAllowList) > 0 {
+		exists, err := repo.LanguagesExist(ctx, t.LanguageCodeAllowList)
+		if err != nil {
+			return fmt.Errorf("could not check whether languages exist: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("one or more languages do not exist: %w", ErrInvalidContest)
+		}
+	}
+
+	if t.ScoringRuleSetID != nil {
+		if err := validateContestTemplateScoringRuleSet(ctx, repo, *t.ScoringRuleSetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateContestTemplateScoringRuleSet checks that the rule set is a
+// published contest rule set of a contest the user may edit, so templates
+// cannot copy rules that were never meant to be shared.
+func validateContestTemplateScoringRuleSet(ctx context.Context, repo ContestTemplateValidationRepository, id uuid.UUID) error {
+	ruleSet, err := repo.FindScoringRuleSetByID(ctx, id)
+	if errors.Is(err, ErrScoringRuleSetNotFound) {
+		return fmt.Errorf("scoring rule set does not exist: %w", ErrInvalidContest)
+	}
+	if err != nil {
+		return fmt.Errorf("could not find scoring rule set: %w", err)
+	}
+
+	if ruleSet.Scope != ScoringRuleSetScopeContest || ruleSet.Status != ScoringRuleSetStatusPublished || ruleSet.ContestID == nil {
+		return fmt.Errorf("only published contest scoring rule sets can be used: %w", ErrInvalidContest)
+	}
+
+	if isAdmin(ctx) {
+		return nil
+	}
+
+	contest, err := repo.FindContestByID(ctx, &ContestFindRequest{ID: *ruleSet.ContestID, IncludeDeleted: true})
+	if err != nil {
+		return fmt.Errorf("could not find contest of scoring rule set: %w", err)
+	}
+	if contest.OwnerUserID.String() != commondomain.ParseUserIdentity(ctx).Subject {
+		return fmt.Errorf("scoring rule set belongs to another user's contest: %w", ErrInvalidContest)
+	}
+	return nil
+}
+
+// authorizeContestTemplateChange checks that the user owns the template or is
+// an admin. Official templates can only be changed by admins.
+func authorizeContestTemplateChange(ctx context.Context, template *ContestTemplate) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+	if isAdmin(ctx) {
+		return nil
+	}
+	if template.Official || template.OwnerUserID.String() != commondomain.ParseUserIdentity(ctx).Subject {
+		return ErrForbidden
+	}
+	return nil
+}
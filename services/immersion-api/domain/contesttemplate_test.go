@@ -0,0 +1,148 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockContestTemplateRepository struct {
+	template  *domain.ContestTemplate
+	contests  []domain.ContestTemplateContest
+	ruleSet   *domain.ScoringRuleSet
+	contest   *domain.ContestView
+	templates []domain.ContestTemplate
+	// contestCount is the number of contests the user created this year.
+	contestCount int32
+
+	created        *domain.ContestTemplate
+	updated        *domain.ContestTemplate
+	listedUserID   *uuid.UUID
+	includePrivate bool
+}
+
+func (m *mockContestTemplateRepository) FindContestByID(_ context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contest == nil || m.contest.ID != req.ID {
+		return nil, domain.ErrNotFound
+	}
+	return m.contest, nil
+}
+
+func (m *mockContestTemplateRepository) FindScoringRuleSetByID(_ context.Context, id uuid.UUID) (*domain.ScoringRuleSet, error) {
+	if m.ruleSet == nil || m.ruleSet.ID != id {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return m.ruleSet, nil
+}
+
+func (m *mockContestTemplateRepository) LanguagesExist(context.Context, []string) (bool, error) {
+	return true, nil
+}
+
+func (m *mockContestTemplateRepository) UpsertUser(context.Context, *domain.UserUpsertRequest) error {
+	return nil
+}
+
+func (m *mockContestTemplateRepository) CreateContestTemplate(_ context.Context, template *domain.ContestTemplate) (*domain.ContestTemplate, error) {
+	m.created = template
+	return template, nil
+}
+
+func (m *mockContestTemplateRepository) UpdateContestTemplate(_ context.Context, template *domain.ContestTemplate) (*domain.ContestTemplate, error) {
+	m.updated = template
+	return template, nil
+}
+
+func (m *mockContestTemplateRepository) FindContestTemplateByID(_ context.Context, id uuid.UUID) (*domain.ContestTemplate, error) {
+	if m.template == nil || m.template.ID != id {
+		return nil, domain.ErrNotFound
+	}
+	return m.template, nil
+}
+
+func (m *mockContestTemplateRepository) ListContestTemplates(_ context.Context, userID *uuid.UUID) ([]domain.ContestTemplate, error) {
+	m.listedUserID = userID
+	return m.templates, nil
+}
+
+func (m *mockContestTemplateRepository) GetContestsByUserCountForYear(context.Context, time.Time, uuid.UUID) (int32, error) {
+	return m.contestCount, nil
+}
+
+func (m *mockContestTemplateRepository) ListContestsForTemplate(_ context.Context, _ uuid.UUID, includePrivate bool) ([]domain.ContestTemplateContest, error) {
+	m.includePrivate = includePrivate
+	return m.contests, nil
+}
+
+func newMonthlyContestTemplate(ownerID uuid.UUID) *domain.ContestTemplate {
+	return &domain.ContestTemplate{
+		ID:                              uuid.New(),
+		OwnerUserID:                     ownerID,
+		OwnerUserDisplayName:            "owner",
+		TitlePattern:                    "{year} Round {year_number}",
+		RegistrationClosesBeforeEndDays: 7,
+		ActivityTypeIDAllowList:         []int32{1, 2},
+		Frequency:                       domain.ContestRecurrenceMonthly,
+		IntervalCount:                   1,
+		FirstContestStart:               time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+		LeadDays:                        14,
+		Active:                          true,
+	}
+}
+
+func TestContestTemplate_Occurrence(t *testing.T) {
+	t.Run("monthly rounds run until the next one starts", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+
+		first := template.Occurrence(0)
+		assert.Equal(t, "2026 Round 1", first.Title)
+		assert.Equal(t, date(2026, time.November, 1), first.ContestStart)
+		assert.Equal(t, date(2026, time.November, 30), first.ContestEnd)
+		assert.Equal(t, date(2026, time.November, 23), first.RegistrationEnd)
+
+		third := template.Occurrence(3)
+		assert.Equal(t, "2027 Round 2", third.Title)
+		assert.Equal(t, date(2027, time.February, 1), third.ContestStart)
+		assert.Equal(t, date(2027, time.February, 28), third.ContestEnd)
+	})
+
+	t.Run("clamps the day to shorter months", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.FirstContestStart = date(2027, time.January, 31)
+		template.DurationDays = ptr(int32(10))
+
+		assert.Equal(t, date(2027, time.February, 28), template.OccurrenceStart(1))
+		assert.Equal(t, date(2027, time.March, 31), template.OccurrenceStart(2))
+		assert.Equal(t, date(2027, time.March, 9), template.Occurrence(1).ContestEnd)
+	})
+
+	t.Run("renders quarterly placeholders", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.TitlePattern = "Q{quarter} {year} ({month_name}, #{number})"
+		template.IntervalCount = 3
+		template.FirstContestStart = date(2027, time.January, 1)
+
+		assert.Equal(t, "Q2 2027 (April, #2)", template.Occurrence(1).Title)
+		assert.Equal(t, date(2027, time.June, 30), template.Occurrence(1).ContestEnd)
+		assert.Equal(t, int32(4), template.OccurrencesPerYear())
+	})
+
+	t.Run("weekly series", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.Frequency = domain.ContestRecurrenceWeekly
+		template.IntervalCount = 2
+		template.DurationDays = ptr(int32(7))
+		template.RegistrationClosesBeforeEndDays = 0
+		template.FirstContestStart = date(2026, time.November, 2)
+
+		occurrence := template.Occurrence(1)
+		assert.Equal(t, date(2026, time.November, 16), occurrence.ContestStart)
+		assert.Equal(t, date(2026, time.November, 22), occurrence.ContestEnd)
+		assert.Equal(t, occurrence.ContestEnd, occurrence.RegistrationEnd)
+		assert.Equal(t, int32(26), template.OccurrencesPerYear())
+	})
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTemplateCreateRepository interface {
+	ContestTemplateValidationRepository
+	CreateContestTemplate(context.Context, *ContestTemplate) (*ContestTemplate, error)
+	ListContestTemplates(context.Context, *uuid.UUID) ([]ContestTemplate, error)
+	GetContestsByUserCountForYear(context.Context, time.Time, uuid.UUID) (int32, error)
+}
+
+type ContestTemplateCreateRequest struct {
+	TitlePattern                    string
+	Description                     *string
+	Official                        bool
+	Private                         bool
+	DurationDays                    *int32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   *int32
+	TeamScoreAggregation            ContestTeamScoreAggregation
+	ScoringRuleSetID                *uuid.UUID
+	Frequency                       ContestRecurrenceFrequency
+	IntervalCount                   int32
+	FirstContestStart               time.Time
+	LeadDays                        int32
+}
+
+type ContestTemplateCreate struct {
+	repo       ContestTemplateCreateRepository
+	clock      commondomain.Clock
+	userUpsert *UserUpsert
+}
+
+func NewContestTemplateCreate(repo ContestTemplateCreateRepository, clock commondomain.Clock, userUpsert *UserUpsert) *ContestTemplateCreate {
+	return &ContestTemplateCreate{repo: repo, clock: clock, userUpsert: userUpsert}
+}
+
+func (s *ContestTemplateCreate) Execute(ctx context.Context, req *ContestTemplateCreateRequest) (*ContestTemplate, error) {
+	if req.Official {
+		if err := requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	} else if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.userUpsert.Execute(ctx); err != nil {
+		return nil, fmt.Errorf("could not update user: %w", err)
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+
+	template := &ContestTemplate{
+		OwnerUserID:                     uuid.MustParse(session.Subject),
+		OwnerUserDisplayName:            session.DisplayName,
+		TitlePattern:                    req.TitlePattern,
+		Description:                     req.Description,
+		Official:                        req.Official,
+		Private:                         req.Private,
+		DurationDays:                    req.DurationDays,
+		RegistrationClosesBeforeEndDays: req.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           req.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         req.ActivityTypeIDAllowList,
+		TeamMode:                        req.TeamMode,
+		TeamSizeLimit:                   req.TeamSizeLimit,
+		TeamScoreAggregation:            req.TeamScoreAggregation,
+		ScoringRuleSetID:                req.ScoringRuleSetID,
+		Frequency:                       req.Frequency,
+		IntervalCount:                   req.IntervalCount,
+		FirstContestStart:               req.FirstContestStart,
+		LeadDays:                        req.LeadDays,
+		Active:                          true,
+	}
+
+	if err := validateContestTemplate(ctx, s.repo, template); err != nil {
+		return nil, err
+	}
+
+	if !isAdmin(ctx) {
+		now := s.clock.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if template.FirstContestStart.Before(today) {
+			return nil, fmt.Errorf("series cannot start in the past: %w", ErrInvalidContest)
+		}
+
+		if err := s.checkYearlyLimit(ctx, template); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.repo.CreateContestTemplate(ctx, template)
+}
+
+// checkYearlyLimit counts the contests the user created this year and every
+// contest their active series can create in a year towards the yearly limit,
+// so the limit cannot be passed by spreading contests over several series.
+func (s *ContestTemplateCreate) checkYearlyLimit(ctx context.Context, template *ContestTemplate) error {
+	contestCount, err := s.repo.GetContestsByUserCountForYear(ctx, s.clock.Now(), template.OwnerUserID)
+	if err != nil {
+		return fmt.Errorf("could not check permission for contest creation: %w", err)
+	}
+
+	templates, err := s.repo.ListContestTemplates(ctx, &template.OwnerUserID)
+	if err != nil {
+		return fmt.Errorf("could not check permission for contest creation: %w", err)
+	}
+
+	contestCount += template.OccurrencesPerYear()
+	for i := range templates {
+		if templates[i].Active {
+			contestCount += templates[i].OccurrencesPerYear()
+		}
+	}
+
+	if contestCount > UserCreateContestYearlyLimit {
+		return fmt.Errorf("hit limit of created contests: %w", ErrForbidden)
+	}
+
+	return nil
+}
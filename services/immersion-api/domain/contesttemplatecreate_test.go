@@ -0,0 +1,148 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTemplateCreate_Execute(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+
+	newRequest := func() *domain.ContestTemplateCreateRequest {
+		return &domain.ContestTemplateCreateRequest{
+			TitlePattern:                    "{year} Round {year_number}",
+			RegistrationClosesBeforeEndDays: 7,
+			ActivityTypeIDAllowList:         []int32{1, 2},
+			Frequency:                       domain.ContestRecurrenceMonthly,
+			IntervalCount:                   1,
+			FirstContestStart:               date(2026, time.November, 1),
+			LeadDays:                        14,
+		}
+	}
+	newService := func(repo *mockContestTemplateRepository) *domain.ContestTemplateCreate {
+		return domain.NewContestTemplateCreate(repo, clock, domain.NewUserUpsert(repo))
+	}
+
+	t.Run("user creates a monthly series", func(t *testing.T) {
+		repo := &mockContestTemplateRepository{}
+		userID := uuid.New()
+
+		template, err := newService(repo).Execute(ctxWithUserSubject(userID.String()), newRequest())
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, template.OwnerUserID)
+		assert.True(t, template.Active)
+		assert.Equal(t, domain.ContestTeamScoreSum, template.TeamScoreAggregation)
+		assert.NotNil(t, repo.created)
+	})
+
+	t.Run("guest is unauthorized", func(t *testing.T) {
+		repo := &mockContestTemplateRepository{}
+
+		_, err := newService(repo).Execute(ctxWithGuest(), newRequest())
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("only admins create official series", func(t *testing.T) {
+		req := newRequest()
+		req.Official = true
+
+		_, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithUser(), req)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		template, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithAdmin(), req)
+		require.NoError(t, err)
+		assert.True(t, template.Official)
+	})
+
+	t.Run("users are limited to the yearly contest limit", func(t *testing.T) {
+		req := newRequest()
+		req.Frequency = domain.ContestRecurrenceWeekly
+		req.DurationDays = ptr(int32(7))
+		req.RegistrationClosesBeforeEndDays = 0
+
+		_, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithUser(), req)
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+
+		_, err = newService(&mockContestTemplateRepository{}).Execute(ctxWithAdmin(), req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("other series and contests count towards the yearly contest limit", func(t *testing.T) {
+		existing := newMonthlyContestTemplate(uuid.New())
+		existing.IntervalCount = 2
+
+		_, err := newService(&mockContestTemplateRepository{templates: []domain.ContestTemplate{*existing}}).Execute(ctxWithUser(), newRequest())
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		_, err = newService(&mockContestTemplateRepository{contestCount: 1}).Execute(ctxWithUser(), newRequest())
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		existing.Active = false
+		_, err = newService(&mockContestTemplateRepository{templates: []domain.ContestTemplate{*existing}}).Execute(ctxWithUser(), newRequest())
+		assert.NoError(t, err)
+
+		_, err = newService(&mockContestTemplateRepository{contestCount: 1}).Execute(ctxWithAdmin(), newRequest())
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects overlapping contests", func(t *testing.T) {
+		req := newRequest()
+		req.DurationDays = ptr(int32(31))
+
+		_, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithUser(), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("rejects registration closing before the start", func(t *testing.T) {
+		req := newRequest()
+		req.RegistrationClosesBeforeEndDays = 30
+
+		_, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithUser(), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("users cannot start a series in the past", func(t *testing.T) {
+		req := newRequest()
+		req.FirstContestStart = date(2026, time.October, 1)
+
+		_, err := newService(&mockContestTemplateRepository{}).Execute(ctxWithUser(), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("scoring rule set must come from an own published contest rule set", func(t *testing.T) {
+		userID := uuid.New()
+		contest := &domain.ContestView{ID: uuid.New(), OwnerUserID: userID}
+		ruleSet := &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contest.ID,
+			Status:    domain.ScoringRuleSetStatusPublished,
+		}
+		req := newRequest()
+		req.ScoringRuleSetID = &ruleSet.ID
+
+		repo := &mockContestTemplateRepository{contest: contest, ruleSet: ruleSet}
+		_, err := newService(repo).Execute(ctxWithUserSubject(userID.String()), req)
+		require.NoError(t, err)
+		assert.Equal(t, &ruleSet.ID, repo.created.ScoringRuleSetID)
+
+		_, err = newService(repo).Execute(ctxWithUserSubject(uuid.NewString()), req)
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+
+		ruleSet.Status = domain.ScoringRuleSetStatusDraft
+		_, err = newService(repo).Execute(ctxWithUserSubject(userID.String()), req)
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTemplateFindRepository interface {
+	FindContestTemplateByID(context.Context, uuid.UUID) (*ContestTemplate, error)
+	ListContestsForTemplate(ctx context.Context, templateID uuid.UUID, includePrivate bool) ([]ContestTemplateContest, error)
+}
+
+type ContestTemplateFindRequest struct {
+	ID uuid.UUID
+}
+
+// ContestTemplateFindResponse holds the template together with the contests
+// created from it, newest first, so the history of a series can be browsed.
+type ContestTemplateFindResponse struct {
+	Template *ContestTemplate
+	Contests []ContestTemplateContest
+}
+
+type ContestTemplateFind struct {
+	repo ContestTemplateFindRepository
+}
+
+func NewContestTemplateFind(repo ContestTemplateFindRepository) *ContestTemplateFind {
+	return &ContestTemplateFind{repo: repo}
+}
+
+func (s *ContestTemplateFind) Execute(ctx context.Context, req *ContestTemplateFindRequest) (*ContestTemplateFindResponse, error) {
+	template, err := s.repo.FindContestTemplateByID(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest template: %w", err)
+	}
+
+	manager := isAdmin(ctx)
+	if session := commondomain.ParseUserIdentity(ctx); session != nil && session.Subject == template.OwnerUserID.String() {
+		manager = true
+	}
+
+	// Private series are hidden the same way private contests are.
+	if template.Private && !manager {
+		return nil, ErrNotFound
+	}
+
+	contests, err := s.repo.ListContestsForTemplate(ctx, template.ID, manager)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contests of template: %w", err)
+	}
+
+	visible := make([]ContestTemplateContest, 0, len(contests))
+	for _, contest := range contests {
+		if contest.Deleted && !manager {
+			continue
+		}
+		visible = append(visible, contest)
+	}
+
+	return &ContestTemplateFindResponse{Template: template, Contests: visible}, nil
+}
@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTemplateFind_Execute(t *testing.T) {
+	ownerID := uuid.New()
+	contests := []domain.ContestTemplateContest{
+		{ID: uuid.New(), Occurrence: 1, Title: "2026 Round 2", Deleted: true},
+		{ID: uuid.New(), Occurrence: 0, Title: "2026 Round 1"},
+	}
+
+	t.Run("guests browse the series without cancelled contests", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		repo := &mockContestTemplateRepository{template: template, contests: contests}
+		svc := domain.NewContestTemplateFind(repo)
+
+		res, err := svc.Execute(ctxWithGuest(), &domain.ContestTemplateFindRequest{ID: template.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, template, res.Template)
+		assert.Equal(t, contests[1:], res.Contests)
+		assert.False(t, repo.includePrivate)
+	})
+
+	t.Run("owner sees every contest of the series", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		repo := &mockContestTemplateRepository{template: template, contests: contests}
+		svc := domain.NewContestTemplateFind(repo)
+
+		res, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestTemplateFindRequest{ID: template.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, contests, res.Contests)
+		assert.True(t, repo.includePrivate)
+	})
+
+	t.Run("private series are hidden from other users", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		template.Private = true
+		svc := domain.NewContestTemplateFind(&mockContestTemplateRepository{template: template})
+
+		_, err := svc.Execute(ctxWithUser(), &domain.ContestTemplateFindRequest{ID: template.ID})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = svc.Execute(ctxWithAdmin(), &domain.ContestTemplateFindRequest{ID: template.ID})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTemplateListRepository interface {
+	// ListContestTemplates lists the templates of the given user, or all
+	// templates when no user is given.
+	ListContestTemplates(ctx context.Context, userID *uuid.UUID) ([]ContestTemplate, error)
+}
+
+type ContestTemplateListRequest struct {
+	// All lists the templates of every user, only admins may set it.
+	All bool
+}
+
+type ContestTemplateListResponse struct {
+	Templates []ContestTemplate
+}
+
+type ContestTemplateList struct {
+	repo ContestTemplateListRepository
+}
+
+func NewContestTemplateList(repo ContestTemplateListRepository) *ContestTemplateList {
+	return &ContestTemplateList{repo: repo}
+}
+
+func (s *ContestTemplateList) Execute(ctx context.Context, req *ContestTemplateListRequest) (*ContestTemplateListResponse, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	var userID *uuid.UUID
+	if req.All {
+		if err := requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		id := uuid.MustParse(commondomain.ParseUserIdentity(ctx).Subject)
+		userID = &id
+	}
+
+	templates, err := s.repo.ListContestTemplates(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest templates: %w", err)
+	}
+
+	return &ContestTemplateListResponse{Templates: templates}, nil
+}
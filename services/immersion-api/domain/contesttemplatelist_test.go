@@ -0,0 +1,51 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTemplateList_Execute(t *testing.T) {
+	t.Run("users list their own templates", func(t *testing.T) {
+		userID := uuid.New()
+		repo := &mockContestTemplateRepository{templates: []domain.ContestTemplate{*newMonthlyContestTemplate(userID)}}
+		svc := domain.NewContestTemplateList(repo)
+
+		res, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.ContestTemplateListRequest{})
+
+		require.NoError(t, err)
+		assert.Len(t, res.Templates, 1)
+		require.NotNil(t, repo.listedUserID)
+		assert.Equal(t, userID, *repo.listedUserID)
+	})
+
+	t.Run("admins list all templates", func(t *testing.T) {
+		repo := &mockContestTemplateRepository{}
+		svc := domain.NewContestTemplateList(repo)
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ContestTemplateListRequest{All: true})
+
+		require.NoError(t, err)
+		assert.Nil(t, repo.listedUserID)
+	})
+
+	t.Run("users cannot list all templates", func(t *testing.T) {
+		svc := domain.NewContestTemplateList(&mockContestTemplateRepository{})
+
+		_, err := svc.Execute(ctxWithUser(), &domain.ContestTemplateListRequest{All: true})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("guests are unauthorized", func(t *testing.T) {
+		svc := domain.NewContestTemplateList(&mockContestTemplateRepository{})
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.ContestTemplateListRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestTemplateUpdateRepository interface {
+	ContestTemplateValidationRepository
+	FindContestTemplateByID(context.Context, uuid.UUID) (*ContestTemplate, error)
+	UpdateContestTemplate(context.Context, *ContestTemplate) (*ContestTemplate, error)
+}
+
+// ContestTemplateUpdateRequest changes the settings of future contests of a
+// series. The recurrence cannot be changed as that would renumber contests
+// that were already created, a new template should be made instead.
+type ContestTemplateUpdateRequest struct {
+	ID                              uuid.UUID
+	TitlePattern                    string
+	Description                     *string
+	Private                         bool
+	DurationDays                    *int32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   *int32
+	TeamScoreAggregation            ContestTeamScoreAggregation
+	ScoringRuleSetID                *uuid.UUID
+	LeadDays                        int32
+	// Active pauses or resumes the series. Occurrences that started while the
+	// series was paused are skipped.
+	Active bool
+}
+
+type ContestTemplateUpdate struct {
+	repo  ContestTemplateUpdateRepository
+	clock commondomain.Clock
+}
+
+func NewContestTemplateUpdate(repo ContestTemplateUpdateRepository, clock commondomain.Clock) *ContestTemplateUpdate {
+	return &ContestTemplateUpdate{repo: repo, clock: clock}
+}
+
+func (s *ContestTemplateUpdate) Execute(ctx context.Context, req *ContestTemplateUpdateRequest) (*ContestTemplate, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	template, err := s.repo.FindContestTemplateByID(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest template: %w", err)
+	}
+
+	if err := authorizeContestTemplateChange(ctx, template); err != nil {
+		return nil, err
+	}
+
+	template.TitlePattern = req.TitlePattern
+	template.Description = req.Description
+	template.Private = req.Private
+	template.DurationDays = req.DurationDays
+	template.RegistrationClosesBeforeEndDays = req.RegistrationClosesBeforeEndDays
+	template.LanguageCodeAllowList = req.LanguageCodeAllowList
+	template.ActivityTypeIDAllowList = req.ActivityTypeIDAllowList
+	template.TeamMode = req.TeamMode
+	template.TeamSizeLimit = req.TeamSizeLimit
+	template.TeamScoreAggregation = req.TeamScoreAggregation
+	template.ScoringRuleSetID = req.ScoringRuleSetID
+	template.LeadDays = req.LeadDays
+	template.Active = req.Active
+	template.UpdatedAt = s.clock.Now()
+
+	if err := validateContestTemplate(ctx, s.repo, template); err != nil {
+		return nil, err
+	}
+
+	return s.repo.UpdateContestTemplate(ctx, template)
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestContestTemplateUpdate_Execute(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	ownerID := uuid.New()
+
+	newRequest := func(template *domain.ContestTemplate) *domain.ContestTemplateUpdateRequest {
+		return &domain.ContestTemplateUpdateRequest{
+			ID:                              template.ID,
+			TitlePattern:                    "Monthly {month_name} {year}",
+			RegistrationClosesBeforeEndDays: 3,
+			ActivityTypeIDAllowList:         []int32{1},
+			LeadDays:                        7,
+			Active:                          false,
+		}
+	}
+
+	t.Run("owner pauses and changes a series", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		repo := &mockContestTemplateRepository{template: template}
+		svc := domain.NewContestTemplateUpdate(repo, commondomain.NewMockClock(now))
+
+		updated, err := svc.Execute(ctxWithUserSubject(ownerID.String()), newRequest(template))
+
+		require.NoError(t, err)
+		assert.False(t, updated.Active)
+		assert.Equal(t, "Monthly {month_name} {year}", updated.TitlePattern)
+		assert.Equal(t, domain.ContestRecurrenceMonthly, updated.Frequency)
+		assert.Equal(t, now, updated.UpdatedAt)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		repo := &mockContestTemplateRepository{template: template}
+		svc := domain.NewContestTemplateUpdate(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), newRequest(template))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("only admins change official series", func(t *testing.T) {
+		template := newMonthlyContestTemplate(ownerID)
+		template.Official = true
+		repo := &mockContestTemplateRepository{template: template}
+		svc := domain.NewContestTemplateUpdate(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), newRequest(template))
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		_, err = svc.Execute(ctxWithAdmin(), newRequest(template))
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns not found for unknown templates", func(t *testing.T) {
+		svc := domain.NewContestTemplateUpdate(&mockContestTemplateRepository{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ContestTemplateUpdateRequest{ID: uuid.New()})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
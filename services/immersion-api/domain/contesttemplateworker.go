@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// maxContestTemplateOccurrencesPerRun bounds how many contests are created for
+// a template in a single pass, in case a long lead time covers many of them.
+const maxContestTemplateOccurrencesPerRun = 5
+
+// ContestTemplateWorkerRepository creates contests for templates. The methods
+// that move the next occurrence only apply when it still equals the given
+// index and return ErrConflict otherwise, so multiple API instances can run
+// the worker at the same time.
+type ContestTemplateWorkerRepository interface {
+	ListActiveContestTemplates(ctx context.Context) ([]ContestTemplate, error)
+
+	// SkipContestTemplateOccurrences moves the next occurrence forward
+	// without creating contests.
+	SkipContestTemplateOccurrences(ctx context.Context, templateID uuid.UUID, from, to int32, now time.Time) error
+
+	// CreateContestFromTemplate creates the contest, copies the template's
+	// scoring rule set into it and advances the next occurrence in a single
+	// transaction.
+	CreateContestFromTemplate(ctx context.Context, template *ContestTemplate, occurrence ContestTemplateOccurrence, now time.Time) error
+
+	GetContestsByUserCountForYear(ctx context.Context, now time.Time, userID uuid.UUID) (int32, error)
+}
+
+// ContestTemplateWorker creates upcoming contests of every active template
+// once they are within the template's lead time.
+type ContestTemplateWorker struct {
+	repo     ContestTemplateWorkerRepository
+	clock    commondomain.Clock
+	interval time.Duration
+}
+
+func NewContestTemplateWorker(repo ContestTemplateWorkerRepository, clock commondomain.Clock, interval time.Duration) *ContestTemplateWorker {
+	return &ContestTemplateWorker{repo: repo, clock: clock, interval: interval}
+}
+
+// Run schedules contests at the configured interval until the context is
+// cancelled.
+func (w *ContestTemplateWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.scheduleAll(ctx)
+		}
+	}
+}
+
+// ScheduleAllForTest exposes scheduleAll for unit testing.
+func (w *ContestTemplateWorker) ScheduleAllForTest(ctx context.Context) {
+	w.scheduleAll(ctx)
+}
+
+func (w *ContestTemplateWorker) scheduleAll(ctx context.Context) {
+	templates, err := w.repo.ListActiveContestTemplates(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "contest template worker: could not list templates", "error", err)
+		return
+	}
+
+	for i := range templates {
+		template := &templates[i]
+		if err := w.schedule(ctx, template); err != nil && !errors.Is(err, ErrConflict) {
+			slog.ErrorContext(ctx, "contest template worker: could not create contest", "template_id", template.ID, "error", err)
+		}
+	}
+}
+
+func (w *ContestTemplateWorker) schedule(ctx context.Context, template *ContestTemplate) error {
+	now := w.clock.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Occurrences that already started, e.g. while the template was paused,
+	// are skipped rather than created late.
+	next := template.NextOccurrence
+	for template.OccurrenceStart(next).Before(today) {
+		next++
+	}
+	if next != template.NextOccurrence {
+		if err := w.repo.SkipContestTemplateOccurrences(ctx, template.ID, template.NextOccurrence, next, now); err != nil {
+			return err
+		}
+	}
+
+	for range maxContestTemplateOccurrencesPerRun {
+		occurrence := template.Occurrence(next)
+		if occurrence.ContestStart.AddDate(0, 0, -int(template.LeadDays)).After(today) {
+			return nil
+		}
+
+		// Owners are held to the yearly limit of created contests like they
+		// are when creating contests themselves. Official series can only be
+		// created by admins, who are not limited.
+		if !template.Official {
+			contestCount, err := w.repo.GetContestsByUserCountForYear(ctx, now, template.OwnerUserID)
+			if err != nil {
+				return err
+			}
+			if contestCount >= UserCreateContestYearlyLimit {
+				slog.InfoContext(ctx, "contest template worker: owner hit limit of created contests", "template_id", template.ID)
+				return nil
+			}
+		}
+
+		if err := w.repo.CreateContestFromTemplate(ctx, template, occurrence, now); err != nil {
+			return err
+		}
+		next++
+	}
+
+	return nil
+}
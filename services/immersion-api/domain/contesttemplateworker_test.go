@@ -0,0 +1,120 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type skippedOccurrences struct {
+	from, to int32
+}
+
+type mockContestTemplateWorkerRepository struct {
+	templates    []domain.ContestTemplate
+	createErr    error
+	contestCount int32
+
+	created []domain.ContestTemplateOccurrence
+	skipped []skippedOccurrences
+}
+
+func (m *mockContestTemplateWorkerRepository) ListActiveContestTemplates(context.Context) ([]domain.ContestTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockContestTemplateWorkerRepository) SkipContestTemplateOccurrences(_ context.Context, _ uuid.UUID, from, to int32, _ time.Time) error {
+	m.skipped = append(m.skipped, skippedOccurrences{from: from, to: to})
+	return nil
+}
+
+func (m *mockContestTemplateWorkerRepository) CreateContestFromTemplate(_ context.Context, _ *domain.ContestTemplate, occurrence domain.ContestTemplateOccurrence, _ time.Time) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.created = append(m.created, occurrence)
+	return nil
+}
+
+func (m *mockContestTemplateWorkerRepository) GetContestsByUserCountForYear(context.Context, time.Time, uuid.UUID) (int32, error) {
+	return m.contestCount, nil
+}
+
+func TestContestTemplateWorker_ScheduleAll(t *testing.T) {
+	t.Run("creates the next contest once within the lead time", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		repo := &mockContestTemplateWorkerRepository{templates: []domain.ContestTemplate{*template}}
+
+		early := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2026, time.October, 17)), time.Hour)
+		early.ScheduleAllForTest(context.Background())
+		assert.Empty(t, repo.created)
+
+		worker := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2026, time.October, 18)), time.Hour)
+		worker.ScheduleAllForTest(context.Background())
+		require.Len(t, repo.created, 1)
+		assert.Equal(t, int32(0), repo.created[0].Index)
+		assert.Equal(t, "2026 Round 1", repo.created[0].Title)
+		assert.Empty(t, repo.skipped)
+	})
+
+	t.Run("skips occurrences that already started", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.LeadDays = 0
+		repo := &mockContestTemplateWorkerRepository{templates: []domain.ContestTemplate{*template}}
+		worker := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2027, time.January, 15)), time.Hour)
+
+		worker.ScheduleAllForTest(context.Background())
+
+		assert.Equal(t, []skippedOccurrences{{from: 0, to: 3}}, repo.skipped)
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("creates every occurrence within a long lead time", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.LeadDays = 60
+		repo := &mockContestTemplateWorkerRepository{templates: []domain.ContestTemplate{*template}}
+		worker := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2026, time.October, 18)), time.Hour)
+
+		worker.ScheduleAllForTest(context.Background())
+
+		require.Len(t, repo.created, 2)
+		assert.Equal(t, int32(1), repo.created[1].Index)
+	})
+
+	t.Run("stops at the owner's yearly contest limit", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		repo := &mockContestTemplateWorkerRepository{
+			templates:    []domain.ContestTemplate{*template},
+			contestCount: domain.UserCreateContestYearlyLimit,
+		}
+		worker := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2026, time.October, 18)), time.Hour)
+
+		worker.ScheduleAllForTest(context.Background())
+		assert.Empty(t, repo.created)
+
+		template.Official = true
+		repo.templates = []domain.ContestTemplate{*template}
+		worker.ScheduleAllForTest(context.Background())
+		assert.Len(t, repo.created, 1)
+	})
+
+	t.Run("stops when another instance created the contest", func(t *testing.T) {
+		template := newMonthlyContestTemplate(uuid.New())
+		template.LeadDays = 60
+		repo := &mockContestTemplateWorkerRepository{
+			templates: []domain.ContestTemplate{*template},
+			createErr: domain.ErrConflict,
+		}
+		worker := domain.NewContestTemplateWorker(repo, commondomain.NewMockClock(date(2026, time.October, 18)), time.Hour)
+
+		worker.ScheduleAllForTest(context.Background())
+
+		assert.Empty(t, repo.created)
+	})
+}
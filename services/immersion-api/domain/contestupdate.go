@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ContestUpdateRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	LanguagesExist(context.Context, []string) (bool, error)
+	UpdateContest(context.Context, *ContestUpdateRequest) error
+}
+
+type ContestUpdateRequest struct {
+	ID                      uuid.UUID `validate:"required"`
+	ContestStart            time.Time `validate:"required"`
+	ContestEnd              time.Time `validate:"required"`
+	RegistrationEnd         time.Time `validate:"required"`
+	Title                   string    `validate:"required,gt=3"`
+	Description             *string
+	Private                 bool
+	LanguageCodeAllowList   []string
+	ActivityTypeIDAllowList []int32 `validate:"required,min=1"`
+
+	// Reason is stored in the moderation audit log when someone other than
+	// the owner changes the contest.
+	Reason *string
+
+	moderatorUserID    *uuid.UUID
+	changedFields      []string
+	restrictsLanguages bool
+}
+
+// ModeratorUserID is set when the contest is changed by someone other than
+// its owner.
+func (r *ContestUpdateRequest) ModeratorUserID() *uuid.UUID { return r.moderatorUserID }
+
+// ChangedFields lists the fields that differ from the stored contest.
+func (r *ContestUpdateRequest) ChangedFields() []string { return r.changedFields }
+
+// RestrictsLanguages reports whether the language allow list got narrowed, in
+// which case registrations and logs outside of it need to be detached.
+func (r *ContestUpdateRequest) RestrictsLanguages() bool { return r.restrictsLanguages }
+
+type ContestUpdate struct {
+	repo     ContestUpdateRepository
+	clock    commondomain.Clock
+	validate *validator.Validate
+}
+
+func NewContestUpdate(repo ContestUpdateRepository, clock commondomain.Clock) *ContestUpdate {
+	return &ContestUpdate{
+		repo:     repo,
+		clock:    clock,
+		validate: validator.New(),
+	}
+}
+
+func (s *ContestUpdate) Execute(ctx context.Context, req *ContestUpdateRequest) (*ContestView, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	contest, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find contest: %w", err)
+	}
+
+	req.moderatorUserID, err = authorizeContestChange(ctx, contest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("unable to validate: %w", ErrInvalidContest)
+	}
+
+	if contest.Official && req.Private {
+		return nil, fmt.Errorf("official rounds cannot be private: %w", ErrInvalidContest)
+	}
+
+	if contest.Official && len(req.LanguageCodeAllowList) != 0 {
+		return nil, fmt.Errorf("official rounds cannot limit language choice: %w", ErrInvalidContest)
+	}
+
+	if req.ContestStart.After(req.ContestEnd) {
+		return nil, fmt.Errorf("contest cannot start after it has ended: %w", ErrInvalidContest)
+	}
+
+	for _, activityID := range req.ActivityTypeIDAllowList {
+		if !IsValidActivityID(activityID) {
+			return nil, fmt.Errorf("activity %d is not valid: %w", activityID, ErrInvalidContest)
+		}
+	}
+
+	if len(req.LanguageCodeAllowList) > 0 {
+		exists, err := s.repo.LanguagesExist(ctx, req.LanguageCodeAllowList)
+		if err != nil {
+			return nil, fmt.Errorf("could not check whether languages exist: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("one or more languages do not exist: %w", ErrInvalidContest)
+		}
+	}
+
+	if !isAdmin(ctx) {
+		if err := validateContestScheduleChange(contest, req, s.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	req.changedFields = contestChangedFields(contest, req)
+	if len(req.changedFields) == 0 {
+		return contest, nil
+	}
+	req.restrictsLanguages = restrictsLanguages(contest.AllowedLanguages, req.LanguageCodeAllowList)
+
+	if err := s.repo.UpdateContest(ctx, req); err != nil {
+		return nil, fmt.Errorf("could not update contest: %w", err)
+	}
+
+	updated, err := s.repo.FindContestByID(ctx, &ContestFindRequest{ID: req.ID})
+	if err != nil {
+		return nil, fmt.Errorf("could not find updated contest: %w", err)
+	}
+	if err := hydrateContestActivities(updated, true); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// authorizeContestChange checks that the user may change the contest and
+// returns the moderator to record in the audit log when the user is not the
+// owner. Official rounds can only be changed by admins.
+func authorizeContestChange(ctx context.Context, contest *ContestView) (*uuid.UUID, error) {
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	userID := uuid.MustParse(session.Subject)
+
+	if contest.Official && !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+	if contest.OwnerUserID == userID {
+		return nil, nil
+	}
+	if !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+	return &userID, nil
+}
+
+// validateContestScheduleChange enforces what owners can change once a
+// contest is underway: the start date and activities are locked after the
+// start, and nothing can change after the contest has ended.
+func validateContestScheduleChange(contest *ContestView, req *ContestUpdateRequest, now time.Time) error {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if contest.ContestEnd.Before(today) {
+		return fmt.Errorf("contest has already ended: %w", ErrInvalidContest)
+	}
+	if req.ContestEnd.Before(today) {
+		return fmt.Errorf("contest end cannot be in the past: %w", ErrInvalidContest)
+	}
+
+	if contest.ContestStart.After(today) {
+		if req.ContestStart.Before(today) {
+			return fmt.Errorf("contest start cannot be in the past: %w", ErrInvalidContest)
+		}
+		return nil
+	}
+
+	if !req.ContestStart.Equal(contest.ContestStart) {
+		return fmt.Errorf("contest start cannot change after the contest has started: %w", ErrInvalidContest)
+	}
+	for _, activity := range contest.AllowedActivities {
+		if !slices.Contains(req.ActivityTypeIDAllowList, activity.ID) {
+			return fmt.Errorf("activities cannot be removed after the contest has started: %w", ErrInvalidContest)
+		}
+	}
+
+	return nil
+}
+
+func contestChangedFields(contest *ContestView, req *ContestUpdateRequest) []string {
+	var changed []string
+	if req.Title != contest.Title {
+		changed = append(changed, "title")
+	}
+	if stringValueOrEmpty(req.Description) != stringValueOrEmpty(contest.Description) {
+		changed = append(changed, "description")
+	}
+	if !req.ContestStart.Equal(contest.ContestStart) {
+		changed = append(changed, "contest_start")
+	}
+	if !req.ContestEnd.Equal(contest.ContestEnd) {
+		changed = append(changed, "contest_end")
+	}
+	if !req.RegistrationEnd.Equal(contest.RegistrationEnd) {
+		changed = append(changed, "registration_end")
+	}
+	if req.Private != contest.Private {
+		changed = append(changed, "private")
+	}
+
+	languages := make([]string, len(contest.AllowedLanguages))
+	for i, language := range contest.AllowedLanguages {
+		languages[i] = language.Code
+	}
+	if !sameElements(languages, req.LanguageCodeAllowList) {
+		changed = append(changed, "language_code_allow_list")
+	}
+
+	activities := make([]int32, len(contest.AllowedActivities))
+	for i, activity := range contest.AllowedActivities {
+		activities[i] = activity.ID
+	}
+	if !sameElements(activities, req.ActivityTypeIDAllowList) {
+		changed = append(changed, "activity_type_id_allow_list")
+	}
+
+	return changed
+}
+
+// restrictsLanguages reports whether a language that was allowed before is no
+// longer allowed. An empty allow list allows every language.
+func restrictsLanguages(current []Language, next []string) bool {
+	if len(next) == 0 {
+		return false
+	}
+	if len(current) == 0 {
+		return true
+	}
+	for _, language := range current {
+		if !slices.Contains(next, language.Code) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameElements[T comparable](a, b []T) bool {
+	for _, it := range a {
+		if !slices.Contains(b, it) {
+			return false
+		}
+	}
+	for _, it := range b {
+		if !slices.Contains(a, it) {
+			return false
+		}
+	}
+	return true
+}
+
+func stringValueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
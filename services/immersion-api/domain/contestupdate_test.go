@@ -0,0 +1,268 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockContestUpdateRepository struct {
+	contest        *domain.ContestView
+	findErr        error
+	languageExists bool
+	updateErr      error
+	updated        *domain.ContestUpdateRequest
+}
+
+func (m *mockContestUpdateRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	contest := *m.contest
+	return &contest, nil
+}
+
+func (m *mockContestUpdateRepository) LanguagesExist(ctx context.Context, codes []string) (bool, error) {
+	return m.languageExists, nil
+}
+
+func (m *mockContestUpdateRepository) UpdateContest(ctx context.Context, req *domain.ContestUpdateRequest) error {
+	m.updated = req
+	return m.updateErr
+}
+
+func TestContestUpdate_Execute(t *testing.T) {
+	now := time.Date(2026, time.August, 22, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2026, time.August, 22, 0, 0, 0, 0, time.UTC)
+	clock := commondomain.NewMockClock(now)
+	ownerID := uuid.New()
+
+	newContest := func(start time.Time) *domain.ContestView {
+		return &domain.ContestView{
+			ID:                uuid.New(),
+			ContestStart:      start,
+			ContestEnd:        start.AddDate(0, 0, 14),
+			RegistrationEnd:   start.AddDate(0, 0, 7),
+			Title:             "test round",
+			OwnerUserID:       ownerID,
+			AllowedLanguages:  []domain.Language{{Code: "jpa"}, {Code: "zho"}},
+			AllowedActivities: []domain.Activity{{ID: 1}, {ID: 2}},
+		}
+	}
+	requestFor := func(contest *domain.ContestView) *domain.ContestUpdateRequest {
+		return &domain.ContestUpdateRequest{
+			ID:                      contest.ID,
+			ContestStart:            contest.ContestStart,
+			ContestEnd:              contest.ContestEnd,
+			RegistrationEnd:         contest.RegistrationEnd,
+			Title:                   contest.Title,
+			LanguageCodeAllowList:   []string{"jpa", "zho"},
+			ActivityTypeIDAllowList: []int32{1, 2},
+		}
+	}
+
+	t.Run("owner updates an upcoming contest", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.Title = "renamed round"
+		req.ContestStart = today.AddDate(0, 0, 5)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.updated)
+		assert.Nil(t, repo.updated.ModeratorUserID())
+		assert.Equal(t, []string{"title", "contest_start"}, repo.updated.ChangedFields())
+		assert.False(t, repo.updated.RestrictsLanguages())
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithGuest(), requestFor(contest))
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), requestFor(contest))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("returns forbidden when owner updates an official contest without admin role", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		contest.Official = true
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), requestFor(contest))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("admin changes are recorded with the moderator", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+		adminID := uuid.New()
+
+		req := requestFor(contest)
+		req.ContestEnd = contest.ContestEnd.AddDate(0, 0, 7)
+		req.Reason = ptr("extended on request")
+
+		_, err := svc.Execute(ctxWithAdminSubject(adminID.String()), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.updated.ModeratorUserID())
+		assert.Equal(t, adminID, *repo.updated.ModeratorUserID())
+		assert.Equal(t, []string{"contest_end"}, repo.updated.ChangedFields())
+	})
+
+	t.Run("owner can extend a running contest", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.ContestEnd = contest.ContestEnd.AddDate(0, 0, 7)
+		req.ActivityTypeIDAllowList = []int32{1, 2, 3}
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"contest_end", "activity_type_id_allow_list"}, repo.updated.ChangedFields())
+	})
+
+	t.Run("owner cannot move the start of a running contest", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.ContestStart = today
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("owner cannot remove activities from a running contest", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.ActivityTypeIDAllowList = []int32{1}
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("owner cannot change an ended contest", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -30))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.Title = "renamed round"
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("narrowing the language allow list restricts languages", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.LanguageCodeAllowList = []string{"jpa"}
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		require.NoError(t, err)
+		assert.True(t, repo.updated.RestrictsLanguages())
+	})
+
+	t.Run("limiting an open contest to languages restricts languages", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		contest.AllowedLanguages = nil
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.LanguageCodeAllowList = []string{"jpa"}
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		require.NoError(t, err)
+		assert.True(t, repo.updated.RestrictsLanguages())
+	})
+
+	t.Run("widening the language allow list does not restrict languages", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, -3))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		req := requestFor(contest)
+		req.LanguageCodeAllowList = nil
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), req)
+
+		require.NoError(t, err)
+		assert.False(t, repo.updated.RestrictsLanguages())
+		assert.Equal(t, []string{"language_code_allow_list"}, repo.updated.ChangedFields())
+	})
+
+	t.Run("returns invalid for unknown languages", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: false}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), requestFor(contest))
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContest)
+	})
+
+	t.Run("skips the update without changes", func(t *testing.T) {
+		contest := newContest(today.AddDate(0, 0, 10))
+		repo := &mockContestUpdateRepository{contest: contest, languageExists: true}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		res, err := svc.Execute(ctxWithUserSubject(ownerID.String()), requestFor(contest))
+
+		require.NoError(t, err)
+		assert.Equal(t, contest.ID, res.ID)
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("returns not found for unknown contests", func(t *testing.T) {
+		repo := &mockContestUpdateRepository{findErr: domain.ErrNotFound}
+		svc := domain.NewContestUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(ownerID.String()), &domain.ContestUpdateRequest{ID: uuid.New()})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
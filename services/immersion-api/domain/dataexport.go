@@ -0,0 +1,481 @@
+package domain
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type DataExportRepository interface {
+	// ReadExportSnapshot calls fn with a repository that reads from a single
+	// snapshot, so the files of an export agree with each other even when
+	// logs change while it is being written.
+	ReadExportSnapshot(ctx context.Context, fn func(DataExportRepository) error) error
+	StreamLogsForExport(ctx context.Context, userID uuid.UUID, fn func(*Log) error) error
+	ListContestRegistrationsForExport(context.Context, uuid.UUID) ([]ExportedContestRegistration, error)
+	YearlyScoresForExport(context.Context, uuid.UUID) ([]YearlyScore, error)
+}
+
+type DataExportRequest struct {
+	UserID uuid.UUID
+}
+
+type ExportedContestRegistration struct {
+	ID            uuid.UUID
+	ContestID     uuid.UUID
+	Title         string
+	ContestStart  time.Time
+	ContestEnd    time.Time
+	Official      bool
+	Private       bool
+	LanguageCodes []string
+	Score         float32
+	CreatedAt     time.Time
+}
+
+type YearlyScore struct {
+	Year         int
+	LanguageCode string
+	LanguageName string
+	Score        float32
+}
+
+// DataExport writes a user's complete history as a ZIP archive. Logs are
+// streamed from the repository so memory use does not grow with history size.
+type DataExport struct {
+	repo  DataExportRepository
+	clock commondomain.Clock
+}
+
+func NewDataExport(repo DataExportRepository, clock commondomain.Clock) *DataExport {
+	return &DataExport{repo: repo, clock: clock}
+}
+
+// Authorize checks whether the caller may export the requested user's data.
+// Callers should invoke it before committing to a response.
+func (s *DataExport) Authorize(ctx context.Context, req *DataExportRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return ErrUnauthorized
+	}
+	if session.Subject != req.UserID.String() && !isAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (s *DataExport) Filename(req *DataExportRequest) string {
+	return fmt.Sprintf("tadoku-export-%s-%s.zip", req.UserID, s.clock.Now().Format(time.DateOnly))
+}
+
+func (s *DataExport) Execute(ctx context.Context, req *DataExportRequest, w io.Writer) error {
+	if err := s.Authorize(ctx, req); err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	err := s.repo.ReadExportSnapshot(ctx, func(repo DataExportRepository) error {
+		return writeExport(ctx, repo, archive, req.UserID)
+	})
+	if err != nil {
+		return err
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("could not finish export archive: %w", err)
+	}
+	return nil
+}
+
+// writeExport writes every file of the export. Logs are streamed once per
+// encoding, which only agree since both reads share the export snapshot.
+func writeExport(ctx context.Context, repo DataExportRepository, archive *zip.Writer, userID uuid.UUID) error {
+	registrations, err := repo.ListContestRegistrationsForExport(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not fetch contest registrations: %w", err)
+	}
+	scores, err := repo.YearlyScoresForExport(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not fetch yearly scores: %w", err)
+	}
+	registrationsByContest := make(map[uuid.UUID]ExportedContestRegistration, len(registrations))
+	for _, registration := range registrations {
+		registrationsByContest[registration.ContestID] = registration
+	}
+
+	if err := writeLogsCSV(ctx, repo, archive, userID, registrationsByContest); err != nil {
+		return err
+	}
+	if err := writeLogsJSON(ctx, repo, archive, userID, registrationsByContest); err != nil {
+		return err
+	}
+	if err := writeRegistrationsExport(archive, registrations); err != nil {
+		return err
+	}
+	return writeYearlyScoresExport(archive, scores)
+}
+
+var exportLogsCSVHeader = []string{
+	"id",
+	"created_at",
+	"updated_at",
+	"language_code",
+	"language_name",
+	"activity_id",
+	"activity_name",
+	"unit_key",
+	"unit_name",
+	"amount",
+	"modifier",
+	"duration_seconds",
+	"score",
+	"score_source",
+	"score_rule_set_id",
+	"score_rule_ids",
+	"score_rates",
+	"tags",
+	"description",
+	"media_id",
+	"contest_ids",
+	"eligible_official_leaderboard",
+}
+
+func writeLogsCSV(
+	ctx context.Context,
+	repo DataExportRepository,
+	archive *zip.Writer,
+	userID uuid.UUID,
+	registrations map[uuid.UUID]ExportedContestRegistration,
+) error {
+	file, err := archive.Create("logs.csv")
+	if err != nil {
+		return fmt.Errorf("could not create logs.csv: %w", err)
+	}
+	writer := csv.NewWriter(file)
+	if err := writer.Write(exportLogsCSVHeader); err != nil {
+		return fmt.Errorf("could not write logs.csv: %w", err)
+	}
+
+	err = repo.StreamLogsForExport(ctx, userID, func(log *Log) error {
+		exported, err := exportLogFromDomain(log, registrations)
+		if err != nil {
+			return err
+		}
+		return writer.Write(exported.csvRecord())
+	})
+	if err != nil {
+		return fmt.Errorf("could not write logs.csv: %w", err)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeLogsJSON(
+	ctx context.Context,
+	repo DataExportRepository,
+	archive *zip.Writer,
+	userID uuid.UUID,
+	registrations map[uuid.UUID]ExportedContestRegistration,
+) error {
+	file, err := archive.Create("logs.json")
+	if err != nil {
+		return fmt.Errorf("could not create logs.json: %w", err)
+	}
+
+	// The array is written element by element so that the full history never
+	// needs to be held in memory.
+	if _, err := io.WriteString(file, "["); err != nil {
+		return fmt.Errorf("could not write logs.json: %w", err)
+	}
+	first := true
+	err = repo.StreamLogsForExport(ctx, userID, func(log *Log) error {
+		exported, err := exportLogFromDomain(log, registrations)
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(exported)
+		if err != nil {
+			return err
+		}
+		if !first {
+			if _, err := io.WriteString(file, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = file.Write(encoded)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("could not write logs.json: %w", err)
+	}
+	if _, err := io.WriteString(file, "]\n"); err != nil {
+		return fmt.Errorf("could not write logs.json: %w", err)
+	}
+	return nil
+}
+
+func writeRegistrationsExport(archive *zip.Writer, registrations []ExportedContestRegistration) error {
+	exported := make([]exportedRegistration, len(registrations))
+	records := make([][]string, 0, len(registrations)+1)
+	records = append(records, []string{
+		"id",
+		"contest_id",
+		"title",
+		"contest_start",
+		"contest_end",
+		"official",
+		"private",
+		"language_codes",
+		"score",
+		"created_at",
+	})
+	for i, registration := range registrations {
+		exported[i] = exportedRegistration{
+			ID:            registration.ID,
+			ContestID:     registration.ContestID,
+			Title:         registration.Title,
+			ContestStart:  registration.ContestStart.Format(time.DateOnly),
+			ContestEnd:    registration.ContestEnd.Format(time.DateOnly),
+			Official:      registration.Official,
+			Private:       registration.Private,
+			LanguageCodes: registration.LanguageCodes,
+			Score:         registration.Score,
+			CreatedAt:     registration.CreatedAt,
+		}
+		records = append(records, []string{
+			registration.ID.String(),
+			registration.ContestID.String(),
+			registration.Title,
+			exported[i].ContestStart,
+			exported[i].ContestEnd,
+			strconv.FormatBool(registration.Official),
+			strconv.FormatBool(registration.Private),
+			strings.Join(registration.LanguageCodes, ";"),
+			formatExportFloat(registration.Score),
+			registration.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	if err := writeExportCSV(archive, "contest_registrations.csv", records); err != nil {
+		return err
+	}
+	return writeExportJSON(archive, "contest_registrations.json", exported)
+}
+
+func writeYearlyScoresExport(archive *zip.Writer, scores []YearlyScore) error {
+	exported := make([]exportedYearlyScore, len(scores))
+	records := make([][]string, 0, len(scores)+1)
+	records = append(records, []string{"year", "language_code", "language_name", "score"})
+	for i, score := range scores {
+		exported[i] = exportedYearlyScore{
+			Year:         score.Year,
+			LanguageCode: score.LanguageCode,
+			LanguageName: score.LanguageName,
+			Score:        score.Score,
+		}
+		records = append(records, []string{
+			strconv.Itoa(score.Year),
+			score.LanguageCode,
+			score.LanguageName,
+			formatExportFloat(score.Score),
+		})
+	}
+	if err := writeExportCSV(archive, "yearly_scores.csv", records); err != nil {
+		return err
+	}
+	return writeExportJSON(archive, "yearly_scores.json", exported)
+}
+
+func writeExportCSV(archive *zip.Writer, name string, records [][]string) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", name, err)
+	}
+	writer := csv.NewWriter(file)
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("could not write %s: %w", name, err)
+	}
+	return nil
+}
+
+func writeExportJSON(archive *zip.Writer, name string, value any) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", name, err)
+	}
+	if err := json.NewEncoder(file).Encode(value); err != nil {
+		return fmt.Errorf("could not write %s: %w", name, err)
+	}
+	return nil
+}
+
+type exportedLogContest struct {
+	ContestID      uuid.UUID  `json:"contest_id"`
+	RegistrationID *uuid.UUID `json:"registration_id,omitempty"`
+	Title          string     `json:"title,omitempty"`
+}
+
+type exportedLog struct {
+	ID                          uuid.UUID            `json:"id"`
+	CreatedAt                   time.Time            `json:"created_at"`
+	UpdatedAt                   time.Time            `json:"updated_at"`
+	LanguageCode                string               `json:"language_code"`
+	LanguageName                string               `json:"language_name"`
+	ActivityID                  int                  `json:"activity_id"`
+	ActivityName                string               `json:"activity_name"`
+	UnitKey                     string               `json:"unit_key,omitempty"`
+	UnitName                    string               `json:"unit_name,omitempty"`
+	Amount                      *float32             `json:"amount,omitempty"`
+	Modifier                    *float32             `json:"modifier,omitempty"`
+	DurationSeconds             *int32               `json:"duration_seconds,omitempty"`
+	Score                       float32              `json:"score"`
+	ScoreSource                 string               `json:"score_source,omitempty"`
+	ScoreRuleSetID              *uuid.UUID           `json:"score_rule_set_id,omitempty"`
+	ScoreRuleIDs                []uuid.UUID          `json:"score_rule_ids"`
+	ScoreRates                  []float32            `json:"score_rates"`
+	Tags                        []string             `json:"tags"`
+	Description                 *string              `json:"description,omitempty"`
+	MediaID                     *uuid.UUID           `json:"media_id,omitempty"`
+	Contests                    []exportedLogContest `json:"contests"`
+	EligibleOfficialLeaderboard bool                 `json:"eligible_official_leaderboard"`
+}
+
+type exportedRegistration struct {
+	ID            uuid.UUID `json:"id"`
+	ContestID     uuid.UUID `json:"contest_id"`
+	Title         string    `json:"title"`
+	ContestStart  string    `json:"contest_start"`
+	ContestEnd    string    `json:"contest_end"`
+	Official      bool      `json:"official"`
+	Private       bool      `json:"private"`
+	LanguageCodes []string  `json:"language_codes"`
+	Score         float32   `json:"score"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type exportedYearlyScore struct {
+	Year         int     `json:"year"`
+	LanguageCode string  `json:"language_code"`
+	LanguageName string  `json:"language_name"`
+	Score        float32 `json:"score"`
+}
+
+func exportLogFromDomain(log *Log, registrations map[uuid.UUID]ExportedContestRegistration) (*exportedLog, error) {
+	if err := hydrateLogActivity(log); err != nil {
+		return nil, err
+	}
+	exported := &exportedLog{
+		ID:                          log.ID,
+		CreatedAt:                   log.CreatedAt,
+		UpdatedAt:                   log.UpdatedAt,
+		LanguageCode:                log.LanguageCode,
+		LanguageName:                log.LanguageName,
+		ActivityID:                  log.ActivityID,
+		ActivityName:                log.ActivityName,
+		UnitKey:                     log.UnitKey,
+		UnitName:                    log.UnitName,
+		DurationSeconds:             log.DurationSeconds,
+		Score:                       log.Score,
+		ScoreRuleIDs:                []uuid.UUID{},
+		ScoreRates:                  []float32{},
+		Tags:                        log.Tags,
+		Description:                 log.Description,
+		MediaID:                     log.MediaID,
+		Contests:                    make([]exportedLogContest, len(log.Registrations)),
+		EligibleOfficialLeaderboard: log.EligibleOfficialLeaderboard,
+	}
+	if exported.Tags == nil {
+		exported.Tags = []string{}
+	}
+	if log.Tracking.Kind == LogTrackingAmountUnit || log.Tracking.Kind == LogTrackingBoth {
+		amount, modifier := log.Amount, log.Modifier
+		exported.Amount = &amount
+		exported.Modifier = &modifier
+	}
+	if provenance := log.Tracking.ScoreProvenance; provenance != nil {
+		exported.ScoreSource = string(provenance.Source)
+		exported.ScoreRuleSetID = provenance.RuleSetID
+		exported.ScoreRuleIDs = append(exported.ScoreRuleIDs, provenance.RuleIDs...)
+		exported.ScoreRates = append(exported.ScoreRates, provenance.Rates...)
+	}
+	for i, reference := range log.Registrations {
+		exported.Contests[i] = exportedLogContest{ContestID: reference.ContestID}
+		if registration, ok := registrations[reference.ContestID]; ok {
+			registrationID := registration.ID
+			exported.Contests[i].RegistrationID = &registrationID
+			exported.Contests[i].Title = registration.Title
+		}
+	}
+	return exported, nil
+}
+
+func (l *exportedLog) csvRecord() []string {
+	ruleIDs := make([]string, len(l.ScoreRuleIDs))
+	for i, id := range l.ScoreRuleIDs {
+		ruleIDs[i] = id.String()
+	}
+	rates := make([]string, len(l.ScoreRates))
+	for i, rate := range l.ScoreRates {
+		rates[i] = formatExportFloat(rate)
+	}
+	contestIDs := make([]string, len(l.Contests))
+	for i, contest := range l.Contests {
+		contestIDs[i] = contest.ContestID.String()
+	}
+	record := []string{
+		l.ID.String(),
+		l.CreatedAt.Format(time.RFC3339),
+		l.UpdatedAt.Format(time.RFC3339),
+		l.LanguageCode,
+		l.LanguageName,
+		strconv.Itoa(l.ActivityID),
+		l.ActivityName,
+		l.UnitKey,
+		l.UnitName,
+		"",
+		"",
+		"",
+		formatExportFloat(l.Score),
+		l.ScoreSource,
+		"",
+		strings.Join(ruleIDs, ";"),
+		strings.Join(rates, ";"),
+		strings.Join(l.Tags, ";"),
+		"",
+		"",
+		strings.Join(contestIDs, ";"),
+		strconv.FormatBool(l.EligibleOfficialLeaderboard),
+	}
+	if l.Amount != nil {
+		record[9] = formatExportFloat(*l.Amount)
+		record[10] = formatExportFloat(*l.Modifier)
+	}
+	if l.DurationSeconds != nil {
+		record[11] = strconv.Itoa(int(*l.DurationSeconds))
+	}
+	if l.ScoreRuleSetID != nil {
+		record[14] = l.ScoreRuleSetID.String()
+	}
+	if l.Description != nil {
+		record[18] = *l.Description
+	}
+	if l.MediaID != nil {
+		record[19] = l.MediaID.String()
+	}
+	return record
+}
+
+func formatExportFloat(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', -1, 32)
+}
@@ -0,0 +1,238 @@
+package domain_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockDataExportRepository struct {
+	logs          []domain.Log
+	registrations []domain.ExportedContestRegistration
+	scores        []domain.YearlyScore
+	streamErr     error
+	streamCalls   int
+	inSnapshot    bool
+}
+
+func (m *mockDataExportRepository) ReadExportSnapshot(_ context.Context, fn func(domain.DataExportRepository) error) error {
+	m.inSnapshot = true
+	defer func() { m.inSnapshot = false }()
+	return fn(m)
+}
+
+func (m *mockDataExportRepository) StreamLogsForExport(_ context.Context, _ uuid.UUID, fn func(*domain.Log) error) error {
+	m.streamCalls++
+	if !m.inSnapshot {
+		return errors.New("logs streamed outside the export snapshot")
+	}
+	if m.streamErr != nil {
+		return m.streamErr
+	}
+	for i := range m.logs {
+		log := m.logs[i]
+		if err := fn(&log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockDataExportRepository) ListContestRegistrationsForExport(context.Context, uuid.UUID) ([]domain.ExportedContestRegistration, error) {
+	return m.registrations, nil
+}
+
+func (m *mockDataExportRepository) YearlyScoresForExport(context.Context, uuid.UUID) ([]domain.YearlyScore, error) {
+	return m.scores, nil
+}
+
+func readExportArchive(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[file.Name] = content
+	}
+	return files
+}
+
+func TestDataExport_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		repo := &mockDataExportRepository{}
+		svc := domain.NewDataExport(repo, commondomain.NewMockClock(now))
+
+		var buf bytes.Buffer
+		err := svc.Execute(ctxWithGuest(), &domain.DataExportRequest{UserID: userID}, &buf)
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Zero(t, buf.Len())
+		assert.Zero(t, repo.streamCalls)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		repo := &mockDataExportRepository{}
+		svc := domain.NewDataExport(repo, commondomain.NewMockClock(now))
+
+		var buf bytes.Buffer
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.DataExportRequest{UserID: userID}, &buf)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("allows admins to export other users", func(t *testing.T) {
+		svc := domain.NewDataExport(&mockDataExportRepository{}, commondomain.NewMockClock(now))
+
+		var buf bytes.Buffer
+		err := svc.Execute(ctxWithAdmin(), &domain.DataExportRequest{UserID: userID}, &buf)
+
+		require.NoError(t, err)
+		files := readExportArchive(t, buf.Bytes())
+		assert.Equal(t, "[]\n", string(files["logs.json"]))
+	})
+
+	t.Run("writes logs, registrations and scores as csv and json", func(t *testing.T) {
+		contestID := uuid.New()
+		registrationID := uuid.New()
+		ruleSetID := uuid.New()
+		duration := int32(600)
+		description := "Chapter 1"
+		mediaID := uuid.New()
+		loggedAt := time.Date(2026, 1, 5, 8, 30, 0, 0, time.UTC)
+
+		repo := &mockDataExportRepository{
+			logs: []domain.Log{
+				{
+					ID:           uuid.New(),
+					UserID:       userID,
+					LanguageCode: "jpn",
+					LanguageName: "Japanese",
+					ActivityID:   1,
+					UnitKey:      "reading_page",
+					UnitName:     "Page",
+					Amount:       12,
+					Modifier:     1,
+					Score:        12,
+					Tags:         []string{"book", "fiction"},
+					Description:  &description,
+					MediaID:      &mediaID,
+					Tracking: domain.LogTracking{
+						Kind:    domain.LogTrackingAmountUnit,
+						UnitKey: "reading_page",
+						ScoreProvenance: &domain.ScoreProvenance{
+							RuleSetID: &ruleSetID,
+							Rates:     []float32{1},
+							Source:    domain.ScoreSourceAmount,
+						},
+					},
+					EligibleOfficialLeaderboard: true,
+					CreatedAt:                   loggedAt,
+					UpdatedAt:                   loggedAt,
+					Registrations:               []domain.ContestRegistrationReference{{ContestID: contestID}},
+				},
+				{
+					ID:              uuid.New(),
+					UserID:          userID,
+					LanguageCode:    "jpn",
+					LanguageName:    "Japanese",
+					ActivityID:      2,
+					DurationSeconds: &duration,
+					Score:           10,
+					Tracking:        domain.LogTracking{Kind: domain.LogTrackingDuration},
+					CreatedAt:       loggedAt.Add(time.Hour),
+					UpdatedAt:       loggedAt.Add(time.Hour),
+				},
+			},
+			registrations: []domain.ExportedContestRegistration{{
+				ID:            registrationID,
+				ContestID:     contestID,
+				Title:         "Round 1",
+				ContestStart:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				ContestEnd:    time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+				Official:      true,
+				LanguageCodes: []string{"jpn"},
+				Score:         12,
+				CreatedAt:     loggedAt,
+			}},
+			scores: []domain.YearlyScore{{Year: 2026, LanguageCode: "jpn", LanguageName: "Japanese", Score: 22}},
+		}
+		svc := domain.NewDataExport(repo, commondomain.NewMockClock(now))
+
+		var buf bytes.Buffer
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.DataExportRequest{UserID: userID}, &buf)
+		require.NoError(t, err)
+
+		files := readExportArchive(t, buf.Bytes())
+		assert.Len(t, files, 6)
+
+		records, err := csv.NewReader(bytes.NewReader(files["logs.csv"])).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		header := map[string]int{}
+		for i, column := range records[0] {
+			header[column] = i
+		}
+		assert.Equal(t, "Reading", records[1][header["activity_name"]])
+		assert.Equal(t, "12", records[1][header["amount"]])
+		assert.Equal(t, "book;fiction", records[1][header["tags"]])
+		assert.Equal(t, contestID.String(), records[1][header["contest_ids"]])
+		assert.Equal(t, ruleSetID.String(), records[1][header["score_rule_set_id"]])
+		assert.Equal(t, "Chapter 1", records[1][header["description"]])
+		assert.Equal(t, mediaID.String(), records[1][header["media_id"]])
+		assert.Equal(t, "", records[2][header["media_id"]])
+		assert.Equal(t, "", records[2][header["amount"]])
+		assert.Equal(t, "600", records[2][header["duration_seconds"]])
+
+		var logs []map[string]any
+		require.NoError(t, json.Unmarshal(files["logs.json"], &logs))
+		require.Len(t, logs, 2)
+		contests := logs[0]["contests"].([]any)
+		require.Len(t, contests, 1)
+		assert.Equal(t, registrationID.String(), contests[0].(map[string]any)["registration_id"])
+		assert.Equal(t, "Round 1", contests[0].(map[string]any)["title"])
+		assert.Equal(t, []any{}, logs[1]["tags"])
+		assert.Equal(t, mediaID.String(), logs[0]["media_id"])
+		assert.NotContains(t, logs[1], "media_id")
+
+		registrations, err := csv.NewReader(bytes.NewReader(files["contest_registrations.csv"])).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, registrations, 2)
+		assert.Equal(t, "2026-01-01", registrations[1][3])
+
+		var scores []map[string]any
+		require.NoError(t, json.Unmarshal(files["yearly_scores.json"], &scores))
+		require.Len(t, scores, 1)
+		assert.EqualValues(t, 22, scores[0]["score"])
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repoErr := errors.New("database unavailable")
+		svc := domain.NewDataExport(&mockDataExportRepository{streamErr: repoErr}, commondomain.NewMockClock(now))
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.DataExportRequest{UserID: userID}, io.Discard)
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
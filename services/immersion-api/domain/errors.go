@@ -22,10 +22,24 @@ var (
 	ErrInvalidScoringRuleSet  = errors.New("invalid scoring rule set")
 	ErrScoringRuleSetNotFound = errors.New("scoring rule set not found")
 	ErrInvalidTags            = errors.New("invalid tags")
+	ErrInvalidLogImport       = errors.New("unable to parse log import")
 )
 
 // Contest errors
 var (
 	ErrInvalidContest             = errors.New("unable to validate contest")
 	ErrInvalidContestRegistration = errors.New("language selection is not valid for contest")
+	ErrInvalidContestTeam         = errors.New("unable to validate contest team")
+	ErrContestTeamFull            = errors.New("contest team is full")
+	ErrInvalidContestInvite       = errors.New("contest invite is not valid")
+)
+
+// Goal errors
+var (
+	ErrInvalidGoal = errors.New("unable to validate goal")
+)
+
+// User errors
+var (
+	ErrUserErasureAuditTampered = errors.New("user erasure audit chain is broken")
 )
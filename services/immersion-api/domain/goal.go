@@ -0,0 +1,338 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalPeriod string
+
+const (
+	GoalPeriodDaily   GoalPeriod = "daily"
+	GoalPeriodWeekly  GoalPeriod = "weekly"
+	GoalPeriodMonthly GoalPeriod = "monthly"
+	GoalPeriodYearly  GoalPeriod = "yearly"
+	GoalPeriodCustom  GoalPeriod = "custom"
+)
+
+// GoalMetric is the quantity a goal counts towards its target.
+type GoalMetric string
+
+const (
+	// GoalMetricAmount sums raw log amounts and requires a unit key, e.g. pages.
+	GoalMetricAmount GoalMetric = "amount"
+	// GoalMetricDuration sums tracked time in seconds.
+	GoalMetricDuration GoalMetric = "duration"
+	// GoalMetricScore sums log scores.
+	GoalMetricScore GoalMetric = "score"
+)
+
+// GoalPaceWindowDays is how far back logs are considered when projecting the
+// completion date of a goal.
+const GoalPaceWindowDays = 14
+
+type Goal struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Title        string
+	LanguageCode *string
+	ActivityID   *int
+	UnitKey      *string
+	Tag          *string
+	Metric       GoalMetric
+	Target       float32
+	Period       GoalPeriod
+	// StartsOn and EndsOn are inclusive dates, only set for custom periods.
+	StartsOn  *time.Time
+	EndsOn    *time.Time
+	Progress  *GoalProgress
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GoalProgress is a snapshot of a goal's progress, refreshed whenever the
+// user's logs change.
+type GoalProgress struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Current     float32
+	// RecentPerDay is the average daily amount logged over the pace window.
+	RecentPerDay float32
+	RefreshedAt  time.Time
+}
+
+// GoalStatus combines a goal with its progress for the current period.
+type GoalStatus struct {
+	Goal                *Goal
+	PeriodStart         time.Time
+	PeriodEnd           time.Time
+	Current             float32
+	Percentage          float32
+	Completed           bool
+	OnTrack             bool
+	ProjectedCompletion *time.Time
+}
+
+// GoalMetricFilter selects the logs that count towards a goal.
+type GoalMetricFilter struct {
+	UserID       uuid.UUID
+	From         time.Time
+	To           time.Time
+	LanguageCode *string
+	ActivityID   *int
+	UnitKey      *string
+	Tag          *string
+}
+
+type GoalMetricTotals struct {
+	Score           float32
+	Amount          float32
+	DurationSeconds float32
+}
+
+func (t GoalMetricTotals) For(metric GoalMetric) float32 {
+	switch metric {
+	case GoalMetricAmount:
+		return t.Amount
+	case GoalMetricDuration:
+		return t.DurationSeconds
+	default:
+		return t.Score
+	}
+}
+
+// CurrentPeriod returns the half-open window [start, end) that contains now.
+// Custom periods always return their configured range.
+func (g *Goal) CurrentPeriod(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch g.Period {
+	case GoalPeriodDaily:
+		return day, day.AddDate(0, 0, 1)
+	case GoalPeriodWeekly:
+		offset := (int(day.Weekday()) + 6) % 7 // weeks start on Monday
+		start := day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, 7)
+	case GoalPeriodMonthly:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	case GoalPeriodYearly:
+		start := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0)
+	case GoalPeriodCustom:
+		if g.StartsOn != nil && g.EndsOn != nil {
+			return *g.StartsOn, g.EndsOn.AddDate(0, 0, 1)
+		}
+	}
+	return day, day.AddDate(0, 0, 1)
+}
+
+func (g *Goal) metricFilter(from, to time.Time) GoalMetricFilter {
+	return GoalMetricFilter{
+		UserID:       g.UserID,
+		From:         from,
+		To:           to,
+		LanguageCode: g.LanguageCode,
+		ActivityID:   g.ActivityID,
+		UnitKey:      g.UnitKey,
+		Tag:          g.Tag,
+	}
+}
+
+// Status projects the goal's cached progress onto now. When the cached
+// snapshot belongs to an earlier period no logs have been recorded in the
+// current one yet, so progress starts from zero.
+func (g *Goal) Status(now time.Time) *GoalStatus {
+	start, end := g.CurrentPeriod(now)
+	status := &GoalStatus{
+		Goal:        g,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+
+	var recentPerDay float32
+	if g.Progress != nil {
+		recentPerDay = g.Progress.RecentPerDay
+		if g.Progress.PeriodStart.Equal(start) {
+			status.Current = g.Progress.Current
+		}
+	}
+
+	if g.Target > 0 {
+		status.Percentage = float32(math.Min(100, float64(status.Current/g.Target*100)))
+	}
+	if status.Current >= g.Target {
+		status.Completed = true
+		status.OnTrack = true
+		return status
+	}
+	if !now.Before(end) || recentPerDay <= 0 {
+		return status
+	}
+
+	remainingDays := float64((g.Target - status.Current) / recentPerDay)
+	projected := now.Add(time.Duration(remainingDays * float64(24*time.Hour)))
+	status.ProjectedCompletion = &projected
+	status.OnTrack = projected.Before(end)
+	return status
+}
+
+func validateGoal(g *Goal) error {
+	if len(g.Title) > 100 {
+		return fmt.Errorf("%w: title can be at most 100 characters", ErrInvalidGoal)
+	}
+	if g.Target <= 0 {
+		return fmt.Errorf("%w: target must be positive", ErrInvalidGoal)
+	}
+
+	switch g.Metric {
+	case GoalMetricAmount:
+		if g.UnitKey == nil || *g.UnitKey == "" {
+			return fmt.Errorf("%w: amount goals require a unit", ErrInvalidGoal)
+		}
+	case GoalMetricDuration, GoalMetricScore:
+	default:
+		return fmt.Errorf("%w: unknown metric %q", ErrInvalidGoal, g.Metric)
+	}
+
+	switch g.Period {
+	case GoalPeriodDaily, GoalPeriodWeekly, GoalPeriodMonthly, GoalPeriodYearly:
+		if g.StartsOn != nil || g.EndsOn != nil {
+			return fmt.Errorf("%w: only custom periods take a date range", ErrInvalidGoal)
+		}
+	case GoalPeriodCustom:
+		if g.StartsOn == nil || g.EndsOn == nil {
+			return fmt.Errorf("%w: custom periods require a start and end date", ErrInvalidGoal)
+		}
+		if g.EndsOn.Before(*g.StartsOn) {
+			return fmt.Errorf("%w: end date must not be before start date", ErrInvalidGoal)
+		}
+	default:
+		return fmt.Errorf("%w: unknown period %q", ErrInvalidGoal, g.Period)
+	}
+
+	if g.ActivityID != nil {
+		if !IsValidActivityID(int32(*g.ActivityID)) {
+			return fmt.Errorf("%w: unknown activity %d", ErrInvalidGoal, *g.ActivityID)
+		}
+	}
+	if g.Tag != nil {
+		tags, err := ValidateAndNormalizeTags([]string{*g.Tag})
+		if err != nil || len(tags) != 1 {
+			return fmt.Errorf("%w: invalid tag", ErrInvalidGoal)
+		}
+		g.Tag = &tags[0]
+	}
+
+	return nil
+}
+
+// GoalProgressRepository reads log totals for goals and stores refreshed
+// progress snapshots.
+type GoalProgressRepository interface {
+	FetchGoalMetricTotals(context.Context, GoalMetricFilter) (*GoalMetricTotals, error)
+	UpdateGoalProgress(ctx context.Context, goalID uuid.UUID, progress *GoalProgress) error
+}
+
+// refreshGoalProgress recomputes and stores the progress snapshot of a goal.
+func refreshGoalProgress(ctx context.Context, repo GoalProgressRepository, goal *Goal, now time.Time) error {
+	start, end := goal.CurrentPeriod(now)
+	current, err := repo.FetchGoalMetricTotals(ctx, goal.metricFilter(start, end))
+	if err != nil {
+		return fmt.Errorf("could not fetch goal totals: %w", err)
+	}
+
+	paceEnd := now.UTC()
+	paceStart := paceEnd.AddDate(0, 0, -GoalPaceWindowDays)
+	recent, err := repo.FetchGoalMetricTotals(ctx, goal.metricFilter(paceStart, paceEnd))
+	if err != nil {
+		return fmt.Errorf("could not fetch goal pace: %w", err)
+	}
+
+	progress := &GoalProgress{
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		Current:      current.For(goal.Metric),
+		RecentPerDay: recent.For(goal.Metric) / GoalPaceWindowDays,
+		RefreshedAt:  now,
+	}
+	if err := repo.UpdateGoalProgress(ctx, goal.ID, progress); err != nil {
+		return fmt.Errorf("could not store goal progress: %w", err)
+	}
+	goal.Progress = progress
+	return nil
+}
+
+// goalProgressStaleAfter bounds how old a progress snapshot may be before it
+// is recomputed on read, so the pace reflects days without any logs.
+const goalProgressStaleAfter = 24 * time.Hour
+
+// ensureFreshGoalProgress refreshes the snapshot when it is missing, belongs
+// to an earlier period or is too old for an accurate pace.
+func ensureFreshGoalProgress(ctx context.Context, repo GoalProgressRepository, goal *Goal, now time.Time) error {
+	start, _ := goal.CurrentPeriod(now)
+	if goal.Progress != nil &&
+		goal.Progress.PeriodStart.Equal(start) &&
+		now.Sub(goal.Progress.RefreshedAt) < goalProgressStaleAfter {
+		return nil
+	}
+	return refreshGoalProgress(ctx, repo, goal, now)
+}
+
+// requireGoalAccess checks that the current user owns the goal. Admins may
+// read any goal but only owners may change them.
+func requireGoalAccess(ctx context.Context, goal *Goal, allowAdmin bool) error {
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return ErrUnauthorized
+	}
+	if goal.UserID.String() == session.Subject {
+		return nil
+	}
+	if allowAdmin && isAdmin(ctx) {
+		return nil
+	}
+	return ErrForbidden
+}
+
+type goalLanguageChecker interface {
+	LanguageExists(ctx context.Context, code string) (bool, error)
+}
+
+func validateGoalLanguage(ctx context.Context, repo goalLanguageChecker, goal *Goal) error {
+	if goal.LanguageCode == nil {
+		return nil
+	}
+	exists, err := repo.LanguageExists(ctx, *goal.LanguageCode)
+	if err != nil {
+		return fmt.Errorf("could not check if language exists: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: unknown language %q", ErrInvalidGoal, *goal.LanguageCode)
+	}
+	return nil
+}
+
+type goalUnitChecker interface {
+	UnitKeyExists(ctx context.Context, key string) (bool, error)
+}
+
+func validateGoalUnit(ctx context.Context, repo goalUnitChecker, goal *Goal) error {
+	if goal.UnitKey == nil || *goal.UnitKey == "" {
+		return nil
+	}
+	exists, err := repo.UnitKeyExists(ctx, *goal.UnitKey)
+	if err != nil {
+		return fmt.Errorf("could not check if unit exists: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: unknown unit %q", ErrInvalidGoal, *goal.UnitKey)
+	}
+	return nil
+}
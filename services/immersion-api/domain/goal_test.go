@@ -0,0 +1,216 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockGoalRepository struct {
+	goals        map[uuid.UUID]*domain.Goal
+	totals       func(filter domain.GoalMetricFilter) domain.GoalMetricTotals
+	filters      []domain.GoalMetricFilter
+	progress     map[uuid.UUID]*domain.GoalProgress
+	created      *domain.Goal
+	updated      *domain.Goal
+	deleted      uuid.UUID
+	languageCode string
+}
+
+func newMockGoalRepository(goals ...domain.Goal) *mockGoalRepository {
+	m := &mockGoalRepository{
+		goals:        map[uuid.UUID]*domain.Goal{},
+		progress:     map[uuid.UUID]*domain.GoalProgress{},
+		languageCode: "jpa",
+	}
+	for i := range goals {
+		m.goals[goals[i].ID] = &goals[i]
+	}
+	return m
+}
+
+func (m *mockGoalRepository) CreateGoal(_ context.Context, goal *domain.Goal, now time.Time) error {
+	goal.CreatedAt = now
+	m.created = goal
+	return nil
+}
+
+func (m *mockGoalRepository) UpdateGoal(_ context.Context, goal *domain.Goal, _ time.Time) error {
+	m.updated = goal
+	return nil
+}
+
+func (m *mockGoalRepository) DeleteGoal(_ context.Context, id uuid.UUID, _ time.Time) error {
+	m.deleted = id
+	return nil
+}
+
+func (m *mockGoalRepository) FindGoalByID(_ context.Context, id uuid.UUID) (*domain.Goal, error) {
+	goal, ok := m.goals[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *goal
+	return &copied, nil
+}
+
+func (m *mockGoalRepository) ListGoalsForUser(_ context.Context, userID uuid.UUID) ([]domain.Goal, error) {
+	var goals []domain.Goal
+	for _, goal := range m.goals {
+		if goal.UserID == userID {
+			goals = append(goals, *goal)
+		}
+	}
+	return goals, nil
+}
+
+func (m *mockGoalRepository) LanguageExists(_ context.Context, code string) (bool, error) {
+	return code == m.languageCode, nil
+}
+
+func (m *mockGoalRepository) UnitKeyExists(_ context.Context, key string) (bool, error) {
+	_, ok := domain.UnitDefinitionByKey(key)
+	return ok, nil
+}
+
+func (m *mockGoalRepository) FetchGoalMetricTotals(_ context.Context, filter domain.GoalMetricFilter) (*domain.GoalMetricTotals, error) {
+	m.filters = append(m.filters, filter)
+	if m.totals == nil {
+		return &domain.GoalMetricTotals{}, nil
+	}
+	totals := m.totals(filter)
+	return &totals, nil
+}
+
+func (m *mockGoalRepository) UpdateGoalProgress(_ context.Context, goalID uuid.UUID, progress *domain.GoalProgress) error {
+	m.progress[goalID] = progress
+	return nil
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGoal_CurrentPeriod(t *testing.T) {
+	// Wednesday
+	now := time.Date(2026, time.March, 11, 15, 30, 0, 0, time.UTC)
+	startsOn := date(2026, time.February, 1)
+	endsOn := date(2026, time.April, 30)
+
+	tests := []struct {
+		name  string
+		goal  domain.Goal
+		start time.Time
+		end   time.Time
+	}{
+		{"daily", domain.Goal{Period: domain.GoalPeriodDaily}, date(2026, time.March, 11), date(2026, time.March, 12)},
+		{"weekly starts on monday", domain.Goal{Period: domain.GoalPeriodWeekly}, date(2026, time.March, 9), date(2026, time.March, 16)},
+		{"monthly", domain.Goal{Period: domain.GoalPeriodMonthly}, date(2026, time.March, 1), date(2026, time.April, 1)},
+		{"yearly", domain.Goal{Period: domain.GoalPeriodYearly}, date(2026, time.January, 1), date(2027, time.January, 1)},
+		{"custom includes the end date", domain.Goal{Period: domain.GoalPeriodCustom, StartsOn: &startsOn, EndsOn: &endsOn}, startsOn, date(2026, time.May, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.goal.CurrentPeriod(now)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+
+	t.Run("sunday belongs to the week started on monday", func(t *testing.T) {
+		goal := domain.Goal{Period: domain.GoalPeriodWeekly}
+		start, _ := goal.CurrentPeriod(date(2026, time.March, 15))
+		assert.Equal(t, date(2026, time.March, 9), start)
+	})
+}
+
+func TestGoal_Status(t *testing.T) {
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	monthStart := date(2026, time.March, 1)
+
+	t.Run("projects completion from the recent pace", func(t *testing.T) {
+		goal := domain.Goal{
+			Period: domain.GoalPeriodMonthly,
+			Target: 300,
+			Progress: &domain.GoalProgress{
+				PeriodStart:  monthStart,
+				Current:      100,
+				RecentPerDay: 20,
+			},
+		}
+
+		status := goal.Status(now)
+
+		assert.InDelta(t, 33.33, status.Percentage, 0.01)
+		assert.False(t, status.Completed)
+		require.NotNil(t, status.ProjectedCompletion)
+		assert.Equal(t, now.AddDate(0, 0, 10), *status.ProjectedCompletion)
+		assert.True(t, status.OnTrack)
+	})
+
+	t.Run("is not on track when the pace is too slow", func(t *testing.T) {
+		goal := domain.Goal{
+			Period: domain.GoalPeriodMonthly,
+			Target: 300,
+			Progress: &domain.GoalProgress{
+				PeriodStart:  monthStart,
+				Current:      100,
+				RecentPerDay: 5,
+			},
+		}
+
+		status := goal.Status(now)
+
+		require.NotNil(t, status.ProjectedCompletion)
+		assert.False(t, status.OnTrack)
+	})
+
+	t.Run("has no projection without recent activity", func(t *testing.T) {
+		goal := domain.Goal{
+			Period:   domain.GoalPeriodMonthly,
+			Target:   300,
+			Progress: &domain.GoalProgress{PeriodStart: monthStart, Current: 100},
+		}
+
+		status := goal.Status(now)
+
+		assert.Nil(t, status.ProjectedCompletion)
+		assert.False(t, status.OnTrack)
+	})
+
+	t.Run("caps percentage for completed goals", func(t *testing.T) {
+		goal := domain.Goal{
+			Period:   domain.GoalPeriodMonthly,
+			Target:   300,
+			Progress: &domain.GoalProgress{PeriodStart: monthStart, Current: 450},
+		}
+
+		status := goal.Status(now)
+
+		assert.Equal(t, float32(100), status.Percentage)
+		assert.True(t, status.Completed)
+		assert.True(t, status.OnTrack)
+	})
+
+	t.Run("ignores progress of an earlier period", func(t *testing.T) {
+		goal := domain.Goal{
+			Period: domain.GoalPeriodMonthly,
+			Target: 300,
+			Progress: &domain.GoalProgress{
+				PeriodStart:  date(2026, time.February, 1),
+				Current:      250,
+				RecentPerDay: 10,
+			},
+		}
+
+		status := goal.Status(now)
+
+		assert.Equal(t, float32(0), status.Current)
+		assert.Equal(t, monthStart, status.PeriodStart)
+	})
+}
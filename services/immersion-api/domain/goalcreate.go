@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalCreateRepository interface {
+	GoalProgressRepository
+	CreateGoal(ctx context.Context, goal *Goal, now time.Time) error
+	LanguageExists(ctx context.Context, code string) (bool, error)
+	UnitKeyExists(ctx context.Context, key string) (bool, error)
+}
+
+type GoalCreateRequest struct {
+	Title        string
+	LanguageCode *string
+	ActivityID   *int
+	UnitKey      *string
+	Tag          *string
+	Metric       GoalMetric
+	Target       float32
+	Period       GoalPeriod
+	StartsOn     *time.Time
+	EndsOn       *time.Time
+}
+
+type GoalCreate struct {
+	repo  GoalCreateRepository
+	clock commondomain.Clock
+}
+
+func NewGoalCreate(repo GoalCreateRepository, clock commondomain.Clock) *GoalCreate {
+	return &GoalCreate{repo: repo, clock: clock}
+}
+
+func (s *GoalCreate) Execute(ctx context.Context, req *GoalCreateRequest) (*GoalStatus, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	userID, err := uuid.Parse(session.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse user id: %w", err)
+	}
+
+	goal := &Goal{
+		ID:           uuid.New(),
+		UserID:       userID,
+		Title:        req.Title,
+		LanguageCode: req.LanguageCode,
+		ActivityID:   req.ActivityID,
+		UnitKey:      req.UnitKey,
+		Tag:          req.Tag,
+		Metric:       req.Metric,
+		Target:       req.Target,
+		Period:       req.Period,
+		StartsOn:     req.StartsOn,
+		EndsOn:       req.EndsOn,
+	}
+	if err := validateGoalLanguage(ctx, s.repo, goal); err != nil {
+		return nil, err
+	}
+	if err := validateGoalUnit(ctx, s.repo, goal); err != nil {
+		return nil, err
+	}
+	if err := validateGoal(goal); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if err := s.repo.CreateGoal(ctx, goal, now); err != nil {
+		return nil, fmt.Errorf("could not create goal: %w", err)
+	}
+	if err := refreshGoalProgress(ctx, s.repo, goal, now); err != nil {
+		return nil, err
+	}
+
+	return goal.Status(now), nil
+}
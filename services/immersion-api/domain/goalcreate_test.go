@@ -0,0 +1,141 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalCreate_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	unitKey := domain.UnitKeyReadingPage
+	language := "jpa"
+
+	validRequest := func() *domain.GoalCreateRequest {
+		return &domain.GoalCreateRequest{
+			Title:        "Read 300 pages",
+			LanguageCode: &language,
+			UnitKey:      &unitKey,
+			Metric:       domain.GoalMetricAmount,
+			Target:       300,
+			Period:       domain.GoalPeriodMonthly,
+		}
+	}
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithGuest(), validRequest())
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("creates goal and computes progress from existing logs", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		repo.totals = func(filter domain.GoalMetricFilter) domain.GoalMetricTotals {
+			if filter.From.Equal(date(2026, time.March, 1)) {
+				return domain.GoalMetricTotals{Amount: 120}
+			}
+			return domain.GoalMetricTotals{Amount: 140}
+		}
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+
+		status, err := svc.Execute(ctxWithUserSubject(userID.String()), validRequest())
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.created)
+		assert.Equal(t, userID, repo.created.UserID)
+		assert.Equal(t, float32(120), status.Current)
+		assert.Equal(t, float32(40), status.Percentage)
+		require.NotNil(t, repo.progress[repo.created.ID])
+		assert.Equal(t, float32(10), repo.progress[repo.created.ID].RecentPerDay)
+
+		require.Len(t, repo.filters, 2)
+		assert.Equal(t, &unitKey, repo.filters[0].UnitKey)
+		assert.Equal(t, now.AddDate(0, 0, -domain.GoalPaceWindowDays), repo.filters[1].From)
+	})
+
+	t.Run("rejects amount goals without unit", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		req := validRequest()
+		req.UnitKey = nil
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("rejects custom periods without range", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		req := validRequest()
+		req.Period = domain.GoalPeriodCustom
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+	})
+
+	t.Run("rejects custom periods ending before they start", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		startsOn := date(2026, time.April, 1)
+		endsOn := date(2026, time.March, 1)
+		req := validRequest()
+		req.Period = domain.GoalPeriodCustom
+		req.StartsOn = &startsOn
+		req.EndsOn = &endsOn
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+	})
+
+	t.Run("rejects unknown languages", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		unknown := "xxx"
+		req := validRequest()
+		req.LanguageCode = &unknown
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+	})
+
+	t.Run("rejects unknown units", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		unknown := "reading_scroll"
+		req := validRequest()
+		req.UnitKey = &unknown
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+	})
+
+	t.Run("normalizes tags", func(t *testing.T) {
+		repo := newMockGoalRepository()
+		svc := domain.NewGoalCreate(repo, commondomain.NewMockClock(now))
+		tag := "  Fiction "
+		req := validRequest()
+		req.Tag = &tag
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.created.Tag)
+		assert.Equal(t, "fiction", *repo.created.Tag)
+	})
+}
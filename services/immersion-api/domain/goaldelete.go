@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalDeleteRepository interface {
+	FindGoalByID(ctx context.Context, id uuid.UUID) (*Goal, error)
+	DeleteGoal(ctx context.Context, id uuid.UUID, now time.Time) error
+}
+
+type GoalDeleteRequest struct {
+	ID uuid.UUID
+}
+
+type GoalDelete struct {
+	repo  GoalDeleteRepository
+	clock commondomain.Clock
+}
+
+func NewGoalDelete(repo GoalDeleteRepository, clock commondomain.Clock) *GoalDelete {
+	return &GoalDelete{repo: repo, clock: clock}
+}
+
+func (s *GoalDelete) Execute(ctx context.Context, req *GoalDeleteRequest) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+
+	goal, err := s.repo.FindGoalByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if err := requireGoalAccess(ctx, goal, false); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteGoal(ctx, goal.ID, s.clock.Now()); err != nil {
+		return fmt.Errorf("could not delete goal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalDelete_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	goal := domain.Goal{ID: uuid.New(), UserID: userID, Period: domain.GoalPeriodDaily}
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		svc := domain.NewGoalDelete(repo, commondomain.NewMockClock(now))
+
+		err := svc.Execute(ctxWithGuest(), &domain.GoalDeleteRequest{ID: goal.ID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		svc := domain.NewGoalDelete(repo, commondomain.NewMockClock(now))
+
+		err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.GoalDeleteRequest{ID: goal.ID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Equal(t, uuid.Nil, repo.deleted)
+	})
+
+	t.Run("deletes own goal", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		svc := domain.NewGoalDelete(repo, commondomain.NewMockClock(now))
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.GoalDeleteRequest{ID: goal.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, goal.ID, repo.deleted)
+	})
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalFindRepository interface {
+	GoalProgressRepository
+	FindGoalByID(ctx context.Context, id uuid.UUID) (*Goal, error)
+}
+
+type GoalFindRequest struct {
+	ID uuid.UUID
+}
+
+type GoalFind struct {
+	repo  GoalFindRepository
+	clock commondomain.Clock
+}
+
+func NewGoalFind(repo GoalFindRepository, clock commondomain.Clock) *GoalFind {
+	return &GoalFind{repo: repo, clock: clock}
+}
+
+func (s *GoalFind) Execute(ctx context.Context, req *GoalFindRequest) (*GoalStatus, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	goal, err := s.repo.FindGoalByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireGoalAccess(ctx, goal, true); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if err := ensureFreshGoalProgress(ctx, s.repo, goal, now); err != nil {
+		return nil, err
+	}
+	return goal.Status(now), nil
+}
@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalFind_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	goal := domain.Goal{
+		ID:     uuid.New(),
+		UserID: userID,
+		Metric: domain.GoalMetricScore,
+		Target: 100,
+		Period: domain.GoalPeriodMonthly,
+		Progress: &domain.GoalProgress{
+			PeriodStart: date(2026, time.March, 1),
+			Current:     50,
+			RefreshedAt: now.Add(-time.Hour),
+		},
+	}
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		svc := domain.NewGoalFind(newMockGoalRepository(goal), commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.GoalFindRequest{ID: goal.ID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("uses fresh cached progress", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		svc := domain.NewGoalFind(repo, commondomain.NewMockClock(now))
+
+		status, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.GoalFindRequest{ID: goal.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, float32(50), status.Current)
+		assert.Empty(t, repo.filters)
+	})
+
+	t.Run("allows admins and refreshes stale progress", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		repo.totals = func(domain.GoalMetricFilter) domain.GoalMetricTotals {
+			return domain.GoalMetricTotals{Score: 60}
+		}
+		later := now.Add(48 * time.Hour)
+		svc := domain.NewGoalFind(repo, commondomain.NewMockClock(later))
+
+		status, err := svc.Execute(ctxWithAdminSubject(uuid.NewString()), &domain.GoalFindRequest{ID: goal.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, float32(60), status.Current)
+		assert.Len(t, repo.filters, 2)
+	})
+}
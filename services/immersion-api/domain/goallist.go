@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalListRepository interface {
+	GoalProgressRepository
+	ListGoalsForUser(ctx context.Context, userID uuid.UUID) ([]Goal, error)
+}
+
+type GoalListRequest struct {
+	UserID uuid.UUID
+}
+
+type GoalListResponse struct {
+	Goals []GoalStatus
+}
+
+type GoalList struct {
+	repo  GoalListRepository
+	clock commondomain.Clock
+}
+
+func NewGoalList(repo GoalListRepository, clock commondomain.Clock) *GoalList {
+	return &GoalList{repo: repo, clock: clock}
+}
+
+func (s *GoalList) Execute(ctx context.Context, req *GoalListRequest) (*GoalListResponse, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	if session.Subject != req.UserID.String() && !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	goals, err := s.repo.ListGoalsForUser(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list goals: %w", err)
+	}
+
+	now := s.clock.Now()
+	res := &GoalListResponse{Goals: make([]GoalStatus, len(goals))}
+	for i := range goals {
+		goal := &goals[i]
+		if err := ensureFreshGoalProgress(ctx, s.repo, goal, now); err != nil {
+			return nil, err
+		}
+		res.Goals[i] = *goal.Status(now)
+	}
+	return res, nil
+}
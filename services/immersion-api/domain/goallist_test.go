@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalList_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	goal := domain.Goal{
+		ID:     uuid.New(),
+		UserID: userID,
+		Metric: domain.GoalMetricScore,
+		Target: 10,
+		Period: domain.GoalPeriodDaily,
+		Progress: &domain.GoalProgress{
+			PeriodStart: date(2026, time.March, 10),
+			Current:     8,
+			RefreshedAt: now.Add(-12 * time.Hour),
+		},
+	}
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		svc := domain.NewGoalList(newMockGoalRepository(goal), commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.GoalListRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("refreshes progress from an earlier period", func(t *testing.T) {
+		repo := newMockGoalRepository(goal)
+		repo.totals = func(domain.GoalMetricFilter) domain.GoalMetricTotals {
+			return domain.GoalMetricTotals{Score: 2}
+		}
+		svc := domain.NewGoalList(repo, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.GoalListRequest{UserID: userID})
+
+		require.NoError(t, err)
+		require.Len(t, res.Goals, 1)
+		assert.Equal(t, float32(2), res.Goals[0].Current)
+		assert.Equal(t, date(2026, time.March, 11), res.Goals[0].PeriodStart)
+		assert.Contains(t, repo.progress, goal.ID)
+	})
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalProgressUpdaterRepository interface {
+	GoalProgressRepository
+	ListGoalsForUser(ctx context.Context, userID uuid.UUID) ([]Goal, error)
+}
+
+// GoalProgressUpdater recomputes the progress of all goals of a user. It is
+// driven by outbox events emitted alongside log writes.
+type GoalProgressUpdater struct {
+	repo  GoalProgressUpdaterRepository
+	clock commondomain.Clock
+}
+
+func NewGoalProgressUpdater(repo GoalProgressUpdaterRepository, clock commondomain.Clock) *GoalProgressUpdater {
+	return &GoalProgressUpdater{repo: repo, clock: clock}
+}
+
+func (u *GoalProgressUpdater) RefreshUserGoals(ctx context.Context, userID uuid.UUID) error {
+	goals, err := u.repo.ListGoalsForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not list goals: %w", err)
+	}
+
+	now := u.clock.Now()
+	for i := range goals {
+		if err := refreshGoalProgress(ctx, u.repo, &goals[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
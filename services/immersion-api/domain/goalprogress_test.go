@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalProgressUpdater_RefreshUserGoals(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	language := "jpa"
+	tag := "fiction"
+	goals := []domain.Goal{
+		{ID: uuid.New(), UserID: userID, Metric: domain.GoalMetricScore, Target: 10, Period: domain.GoalPeriodDaily, LanguageCode: &language},
+		{ID: uuid.New(), UserID: userID, Metric: domain.GoalMetricScore, Target: 100, Period: domain.GoalPeriodYearly, Tag: &tag},
+		{ID: uuid.New(), UserID: uuid.New(), Metric: domain.GoalMetricScore, Target: 10, Period: domain.GoalPeriodDaily},
+	}
+
+	repo := newMockGoalRepository(goals...)
+	repo.totals = func(filter domain.GoalMetricFilter) domain.GoalMetricTotals {
+		if filter.Tag != nil {
+			return domain.GoalMetricTotals{Score: 42}
+		}
+		return domain.GoalMetricTotals{Score: 7}
+	}
+	updater := domain.NewGoalProgressUpdater(repo, commondomain.NewMockClock(now))
+
+	err := updater.RefreshUserGoals(context.Background(), userID)
+
+	require.NoError(t, err)
+	require.Len(t, repo.progress, 2)
+	assert.Equal(t, float32(7), repo.progress[goals[0].ID].Current)
+	assert.Equal(t, float32(42), repo.progress[goals[1].ID].Current)
+	assert.Equal(t, date(2026, time.January, 1), repo.progress[goals[1].ID].PeriodStart)
+	assert.NotContains(t, repo.progress, goals[2].ID)
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type GoalUpdateRepository interface {
+	GoalProgressRepository
+	FindGoalByID(ctx context.Context, id uuid.UUID) (*Goal, error)
+	UpdateGoal(ctx context.Context, goal *Goal, now time.Time) error
+	LanguageExists(ctx context.Context, code string) (bool, error)
+	UnitKeyExists(ctx context.Context, key string) (bool, error)
+}
+
+type GoalUpdateRequest struct {
+	ID           uuid.UUID
+	Title        string
+	LanguageCode *string
+	ActivityID   *int
+	UnitKey      *string
+	Tag          *string
+	Metric       GoalMetric
+	Target       float32
+	Period       GoalPeriod
+	StartsOn     *time.Time
+	EndsOn       *time.Time
+}
+
+type GoalUpdate struct {
+	repo  GoalUpdateRepository
+	clock commondomain.Clock
+}
+
+func NewGoalUpdate(repo GoalUpdateRepository, clock commondomain.Clock) *GoalUpdate {
+	return &GoalUpdate{repo: repo, clock: clock}
+}
+
+func (s *GoalUpdate) Execute(ctx context.Context, req *GoalUpdateRequest) (*GoalStatus, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	goal, err := s.repo.FindGoalByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireGoalAccess(ctx, goal, false); err != nil {
+		return nil, err
+	}
+
+	// Goals keep tracking a unit after it was deprecated.
+	unitChanged := !equalStringPtr(goal.UnitKey, req.UnitKey)
+	goal.Title = req.Title
+	goal.LanguageCode = req.LanguageCode
+	goal.ActivityID = req.ActivityID
+	goal.UnitKey = req.UnitKey
+	goal.Tag = req.Tag
+	goal.Metric = req.Metric
+	goal.Target = req.Target
+	goal.Period = req.Period
+	goal.StartsOn = req.StartsOn
+	goal.EndsOn = req.EndsOn
+	if err := validateGoalLanguage(ctx, s.repo, goal); err != nil {
+		return nil, err
+	}
+	if unitChanged {
+		if err := validateGoalUnit(ctx, s.repo, goal); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateGoal(goal); err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if err := s.repo.UpdateGoal(ctx, goal, now); err != nil {
+		return nil, fmt.Errorf("could not update goal: %w", err)
+	}
+	if err := refreshGoalProgress(ctx, s.repo, goal, now); err != nil {
+		return nil, err
+	}
+
+	return goal.Status(now), nil
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestGoalUpdate_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+	existing := domain.Goal{
+		ID:     uuid.New(),
+		UserID: userID,
+		Title:  "Daily immersion",
+		Metric: domain.GoalMetricScore,
+		Target: 10,
+		Period: domain.GoalPeriodDaily,
+	}
+	request := &domain.GoalUpdateRequest{
+		ID:     existing.ID,
+		Title:  "Weekly listening",
+		Metric: domain.GoalMetricDuration,
+		Target: 5 * 3600,
+		Period: domain.GoalPeriodWeekly,
+	}
+
+	t.Run("returns not found for unknown goals", func(t *testing.T) {
+		svc := domain.NewGoalUpdate(newMockGoalRepository(), commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), request)
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("returns forbidden for other users and admins", func(t *testing.T) {
+		repo := newMockGoalRepository(existing)
+		svc := domain.NewGoalUpdate(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), request)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		_, err = svc.Execute(ctxWithAdminSubject(uuid.NewString()), request)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		assert.Nil(t, repo.updated)
+	})
+
+	t.Run("updates goal and recomputes progress", func(t *testing.T) {
+		repo := newMockGoalRepository(existing)
+		repo.totals = func(domain.GoalMetricFilter) domain.GoalMetricTotals {
+			return domain.GoalMetricTotals{Score: 3, DurationSeconds: 3600}
+		}
+		svc := domain.NewGoalUpdate(repo, commondomain.NewMockClock(now))
+
+		status, err := svc.Execute(ctxWithUserSubject(userID.String()), request)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.updated)
+		assert.Equal(t, "Weekly listening", repo.updated.Title)
+		assert.Equal(t, domain.GoalPeriodWeekly, repo.updated.Period)
+		assert.Equal(t, float32(3600), status.Current)
+		assert.Equal(t, date(2026, time.March, 9), status.PeriodStart)
+	})
+
+	t.Run("rejects invalid changes", func(t *testing.T) {
+		repo := newMockGoalRepository(existing)
+		svc := domain.NewGoalUpdate(repo, commondomain.NewMockClock(now))
+		invalid := *request
+		invalid.Target = 0
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &invalid)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGoal)
+		assert.Nil(t, repo.updated)
+	})
+}
@@ -34,6 +34,13 @@ type LeaderboardOutboxUpdater interface {
 	UpdateUserContestScore(ctx context.Context, contestID uuid.UUID, userID uuid.UUID) error
 	UpdateUserOfficialScores(ctx context.Context, year int, userID uuid.UUID) error
 	RebuildOfficialLeaderboards(ctx context.Context, year int) error
+	RefreshContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID) error
+	RebuildContestLeaderboard(ctx context.Context, contestID uuid.UUID) error
+}
+
+// GoalOutboxUpdater refreshes goal progress after a user's logs changed.
+type GoalOutboxUpdater interface {
+	RefreshUserGoals(ctx context.Context, userID uuid.UUID) error
 }
 
 // LeaderboardOutboxWorker polls the leaderboard_outbox table and processes events
@@ -42,6 +49,7 @@ type LeaderboardOutboxUpdater interface {
 type LeaderboardOutboxWorker struct {
 	repo     LeaderboardOutboxWorkerRepository
 	updater  LeaderboardOutboxUpdater
+	goals    GoalOutboxUpdater
 	clock    commondomain.Clock
 	interval time.Duration
 }
@@ -49,12 +57,14 @@ type LeaderboardOutboxWorker struct {
 func NewLeaderboardOutboxWorker(
 	repo LeaderboardOutboxWorkerRepository,
 	updater LeaderboardOutboxUpdater,
+	goals GoalOutboxUpdater,
 	clock commondomain.Clock,
 	interval time.Duration,
 ) *LeaderboardOutboxWorker {
 	return &LeaderboardOutboxWorker{
 		repo:     repo,
 		updater:  updater,
+		goals:    goals,
 		clock:    clock,
 		interval: interval,
 	}
@@ -162,7 +172,31 @@ func (w *LeaderboardOutboxWorker) processEvent(ctx context.Context, event Leader
 			slog.ErrorContext(ctx, "outbox worker: refresh_contest_score event missing contest_id", "event_id", event.ID)
 			return nil
 		}
-		return w.updater.UpdateUserContestScore(ctx, *event.ContestID, event.UserID)
+		if err := w.updater.UpdateUserContestScore(ctx, *event.ContestID, event.UserID); err != nil {
+			return err
+		}
+		// The user's team score changes along with their own score.
+		return w.updater.RefreshContestTeamLeaderboard(ctx, *event.ContestID)
+
+	case "refresh_contest_team_scores":
+		if event.ContestID == nil {
+			slog.ErrorContext(ctx, "outbox worker: refresh_contest_team_scores event missing contest_id", "event_id", event.ID)
+			return nil
+		}
+		return w.updater.RefreshContestTeamLeaderboard(ctx, *event.ContestID)
+
+	case "rebuild_contest_leaderboard":
+		if event.ContestID == nil {
+			slog.ErrorContext(ctx, "outbox worker: rebuild_contest_leaderboard event missing contest_id", "event_id", event.ID)
+			return nil
+		}
+		// Used when a participant leaves the contest or the contest is
+		// cancelled, which a score update cannot express as it would keep
+		// them on the board.
+		if err := w.updater.RebuildContestLeaderboard(ctx, *event.ContestID); err != nil {
+			return err
+		}
+		return w.updater.RefreshContestTeamLeaderboard(ctx, *event.ContestID)
 
 	case "refresh_official_scores":
 		if event.Year == nil {
@@ -172,6 +206,9 @@ func (w *LeaderboardOutboxWorker) processEvent(ctx context.Context, event Leader
 		}
 		return w.updater.UpdateUserOfficialScores(ctx, *event.Year, event.UserID)
 
+	case "refresh_goal_progress":
+		return w.goals.RefreshUserGoals(ctx, event.UserID)
+
 	default:
 		// Unknown event types are also permanent payload errors rather than
 		// transient update failures, so acknowledge them after logging.
@@ -39,7 +39,10 @@ type mockLeaderboardOutboxUpdater struct {
 	contestCalls         []mockLeaderboardOutboxContestCall
 	officialCalls        []mockLeaderboardOutboxOfficialCall
 	rebuildOfficialCalls []int
+	teamCalls            []uuid.UUID
+	rebuildContestCalls  []uuid.UUID
 	contestErr           error
+	teamErr              error
 	officialErr          error
 	rebuildOfficialErr   error
 	rebuildCalled        chan int
@@ -73,6 +76,26 @@ func (m *mockLeaderboardOutboxUpdater) RebuildOfficialLeaderboards(ctx context.C
 	return m.rebuildOfficialErr
 }
 
+func (m *mockLeaderboardOutboxUpdater) RefreshContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID) error {
+	m.teamCalls = append(m.teamCalls, contestID)
+	return m.teamErr
+}
+
+func (m *mockLeaderboardOutboxUpdater) RebuildContestLeaderboard(ctx context.Context, contestID uuid.UUID) error {
+	m.rebuildContestCalls = append(m.rebuildContestCalls, contestID)
+	return nil
+}
+
+type mockGoalOutboxUpdater struct {
+	userIDs []uuid.UUID
+	err     error
+}
+
+func (m *mockGoalOutboxUpdater) RefreshUserGoals(ctx context.Context, userID uuid.UUID) error {
+	m.userIDs = append(m.userIDs, userID)
+	return m.err
+}
+
 func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 	userID := uuid.New()
 	contestID := uuid.New()
@@ -92,16 +115,66 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		require.Len(t, updater.contestCalls, 1)
 		assert.Equal(t, contestID, updater.contestCalls[0].ContestID)
 		assert.Equal(t, userID, updater.contestCalls[0].UserID)
 		assert.Empty(t, updater.officialCalls)
+		assert.Equal(t, []uuid.UUID{contestID}, updater.teamCalls)
+		assert.Equal(t, []int64{1}, repo.markedIDs)
+	})
+
+	t.Run("processes refresh_contest_team_scores events", func(t *testing.T) {
+		updater := &mockLeaderboardOutboxUpdater{}
+		repo := &mockLeaderboardOutboxRepository{
+			events: []domain.LeaderboardOutboxEvent{
+				{ID: 1, EventType: "refresh_contest_team_scores", UserID: userID, ContestID: &contestID},
+				{ID: 2, EventType: "refresh_contest_team_scores", UserID: userID, ContestID: &contestID},
+			},
+		}
+
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
+		worker.ProcessBatchForTest(context.Background())
+
+		assert.Empty(t, updater.contestCalls)
+		assert.Equal(t, []uuid.UUID{contestID}, updater.teamCalls)
+		assert.Equal(t, []int64{1, 2}, repo.markedIDs)
+	})
+
+	t.Run("processes rebuild_contest_leaderboard events", func(t *testing.T) {
+		updater := &mockLeaderboardOutboxUpdater{}
+		repo := &mockLeaderboardOutboxRepository{
+			events: []domain.LeaderboardOutboxEvent{
+				{ID: 1, EventType: "rebuild_contest_leaderboard", UserID: userID, ContestID: &contestID},
+			},
+		}
+
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
+		worker.ProcessBatchForTest(context.Background())
+
+		assert.Empty(t, updater.contestCalls)
+		assert.Equal(t, []uuid.UUID{contestID}, updater.rebuildContestCalls)
+		assert.Equal(t, []uuid.UUID{contestID}, updater.teamCalls)
 		assert.Equal(t, []int64{1}, repo.markedIDs)
 	})
 
+	t.Run("keeps contest score events pending when the team refresh fails", func(t *testing.T) {
+		updater := &mockLeaderboardOutboxUpdater{teamErr: errors.New("valkey unavailable")}
+		repo := &mockLeaderboardOutboxRepository{
+			events: []domain.LeaderboardOutboxEvent{
+				{ID: 1, EventType: "refresh_contest_score", UserID: userID, ContestID: &contestID},
+			},
+		}
+
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
+		worker.ProcessBatchForTest(context.Background())
+
+		require.Len(t, updater.contestCalls, 1)
+		assert.Empty(t, repo.markedIDs)
+	})
+
 	t.Run("processes refresh_official_scores events", func(t *testing.T) {
 		updater := &mockLeaderboardOutboxUpdater{}
 		repo := &mockLeaderboardOutboxRepository{
@@ -115,7 +188,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		assert.Empty(t, updater.contestCalls)
@@ -138,7 +211,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		require.Len(t, updater.officialCalls, 1)
@@ -155,7 +228,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		require.Len(t, updater.officialCalls, 1)
@@ -177,7 +250,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		require.Len(t, updater.officialCalls, 1)
@@ -210,7 +283,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		// Only one actual update should happen despite 3 events
@@ -247,7 +320,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		require.Len(t, updater.contestCalls, 2)
@@ -255,13 +328,44 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 		assert.Equal(t, []int64{1, 2, 3}, repo.markedIDs)
 	})
 
+	t.Run("processes refresh_goal_progress events once per user", func(t *testing.T) {
+		updater := &mockLeaderboardOutboxUpdater{}
+		goals := &mockGoalOutboxUpdater{}
+		repo := &mockLeaderboardOutboxRepository{
+			events: []domain.LeaderboardOutboxEvent{
+				{ID: 1, EventType: "refresh_goal_progress", UserID: userID},
+				{ID: 2, EventType: "refresh_goal_progress", UserID: userID},
+			},
+		}
+
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, goals, &mockClock{now: now}, time.Second)
+		worker.ProcessBatchForTest(context.Background())
+
+		assert.Equal(t, []uuid.UUID{userID}, goals.userIDs)
+		assert.Equal(t, []int64{1, 2}, repo.markedIDs)
+	})
+
+	t.Run("retries refresh_goal_progress events that fail", func(t *testing.T) {
+		goals := &mockGoalOutboxUpdater{err: errors.New("db unavailable")}
+		repo := &mockLeaderboardOutboxRepository{
+			events: []domain.LeaderboardOutboxEvent{
+				{ID: 1, EventType: "refresh_goal_progress", UserID: userID},
+			},
+		}
+
+		worker := domain.NewLeaderboardOutboxWorker(repo, &mockLeaderboardOutboxUpdater{}, goals, &mockClock{now: now}, time.Second)
+		worker.ProcessBatchForTest(context.Background())
+
+		assert.Empty(t, repo.markedIDs)
+	})
+
 	t.Run("no-op when no events", func(t *testing.T) {
 		updater := &mockLeaderboardOutboxUpdater{}
 		repo := &mockLeaderboardOutboxRepository{
 			events: []domain.LeaderboardOutboxEvent{},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		assert.Empty(t, updater.contestCalls)
@@ -275,7 +379,7 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 			batchErr: errors.New("db connection lost"),
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		// Should not panic
 		worker.ProcessBatchForTest(context.Background())
 
@@ -290,15 +394,17 @@ func TestLeaderboardOutboxWorker_ProcessEvent(t *testing.T) {
 				{ID: 1, EventType: "refresh_contest_score", UserID: userID},
 				{ID: 2, EventType: "refresh_official_scores", UserID: userID},
 				{ID: 3, EventType: "not_a_real_event", UserID: userID},
+				{ID: 4, EventType: "refresh_contest_team_scores", UserID: userID},
 			},
 		}
 
-		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Second)
+		worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Second)
 		worker.ProcessBatchForTest(context.Background())
 
 		assert.Empty(t, updater.contestCalls)
 		assert.Empty(t, updater.officialCalls)
-		assert.Equal(t, []int64{1, 2, 3}, repo.markedIDs)
+		assert.Empty(t, updater.teamCalls)
+		assert.Equal(t, []int64{1, 2, 3, 4}, repo.markedIDs)
 	})
 }
 
@@ -307,7 +413,7 @@ func TestLeaderboardOutboxWorker_RunReconcilesOfficialLeaderboardsAtStartup(t *t
 	rebuildCalled := make(chan int, 1)
 	updater := &mockLeaderboardOutboxUpdater{rebuildCalled: rebuildCalled}
 	repo := &mockLeaderboardOutboxRepository{}
-	worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockClock{now: now}, time.Hour)
+	worker := domain.NewLeaderboardOutboxWorker(repo, updater, &mockGoalOutboxUpdater{}, &mockClock{now: now}, time.Hour)
 	ctx, cancel := context.WithCancel(context.Background())
 	done := make(chan struct{})
 
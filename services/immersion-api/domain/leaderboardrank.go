@@ -13,35 +13,88 @@ func buildLeaderboardEntries(page LeaderboardPage, displayNames map[uuid.UUID]st
 		return []LeaderboardEntry{}
 	}
 
+	scores := make([]float64, len(page.Scores))
+	for i, s := range page.Scores {
+		scores[i] = s.Score
+	}
+	ranks, ties := rankLeaderboardScores(scores, page.StartRank, page.HasPrevTie, page.HasNextTie)
+
 	entries := make([]LeaderboardEntry, len(page.Scores))
-	currentRank := page.StartRank
 	for i, s := range page.Scores {
-		if i > 0 && s.Score < page.Scores[i-1].Score {
-			currentRank = page.StartRank + i
-		}
 		entries[i] = LeaderboardEntry{
-			Rank:            currentRank,
+			Rank:            ranks[i],
 			UserID:          s.UserID,
 			UserDisplayName: displayNames[s.UserID],
 			Score:           float32(s.Score),
+			IsTie:           ties[i],
 		}
 	}
 
+	return entries
+}
+
+// buildTeamLeaderboardEntries is the team equivalent of
+// buildLeaderboardEntries. Teams missing from teams are skipped, they were
+// removed after the leaderboard was last rebuilt.
+func buildTeamLeaderboardEntries(page TeamLeaderboardPage, teams map[uuid.UUID]ContestTeam) []TeamLeaderboardEntry {
+	if len(page.Scores) == 0 {
+		return []TeamLeaderboardEntry{}
+	}
+
+	scores := make([]float64, len(page.Scores))
+	for i, s := range page.Scores {
+		scores[i] = s.Score
+	}
+	ranks, ties := rankLeaderboardScores(scores, page.StartRank, page.HasPrevTie, page.HasNextTie)
+
+	entries := make([]TeamLeaderboardEntry, 0, len(page.Scores))
+	for i, s := range page.Scores {
+		team, ok := teams[s.TeamID]
+		if !ok {
+			continue
+		}
+		entries = append(entries, TeamLeaderboardEntry{
+			Rank:        ranks[i],
+			TeamID:      s.TeamID,
+			TeamName:    team.Name,
+			MemberCount: len(team.Members),
+			Score:       float32(s.Score),
+			IsTie:       ties[i],
+		})
+	}
+
+	return entries
+}
+
+// rankLeaderboardScores computes the rank and tie flag of each score in a
+// page sorted by descending score.
+func rankLeaderboardScores(scores []float64, startRank int, hasPrevTie, hasNextTie bool) ([]int, []bool) {
+	ranks := make([]int, len(scores))
+	ties := make([]bool, len(scores))
+
+	currentRank := startRank
+	for i, s := range scores {
+		if i > 0 && s < scores[i-1] {
+			currentRank = startRank + i
+		}
+		ranks[i] = currentRank
+	}
+
 	// Mark ties: entries that share a rank with at least one other entry
-	for i := range entries {
-		if i > 0 && entries[i].Rank == entries[i-1].Rank {
-			entries[i].IsTie = true
-			entries[i-1].IsTie = true
+	for i := range ranks {
+		if i > 0 && ranks[i] == ranks[i-1] {
+			ties[i] = true
+			ties[i-1] = true
 		}
 	}
 
 	// Handle boundary ties
-	if page.HasPrevTie && len(entries) > 0 {
-		entries[0].IsTie = true
+	if hasPrevTie && len(ties) > 0 {
+		ties[0] = true
 	}
-	if page.HasNextTie && len(entries) > 0 {
-		entries[len(entries)-1].IsTie = true
+	if hasNextTie && len(ties) > 0 {
+		ties[len(ties)-1] = true
 	}
 
-	return entries
+	return ranks, ties
 }
@@ -0,0 +1,307 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LeaderboardBoardKind string
+
+const (
+	LeaderboardBoardContest LeaderboardBoardKind = "contest"
+	LeaderboardBoardYearly  LeaderboardBoardKind = "yearly"
+	LeaderboardBoardGlobal  LeaderboardBoardKind = "global"
+)
+
+// LeaderboardBoard identifies a leaderboard whose updates can be streamed.
+type LeaderboardBoard struct {
+	Kind      LeaderboardBoardKind
+	ContestID uuid.UUID
+	Year      int
+}
+
+func ContestLeaderboardBoard(contestID uuid.UUID) LeaderboardBoard {
+	return LeaderboardBoard{Kind: LeaderboardBoardContest, ContestID: contestID}
+}
+
+func YearlyLeaderboardBoard(year int) LeaderboardBoard {
+	return LeaderboardBoard{Kind: LeaderboardBoardYearly, Year: year}
+}
+
+func GlobalLeaderboardBoard() LeaderboardBoard {
+	return LeaderboardBoard{Kind: LeaderboardBoardGlobal}
+}
+
+// Key uniquely identifies the board, e.g. contest:<id>, yearly:2026 or global.
+func (b LeaderboardBoard) Key() string {
+	switch b.Kind {
+	case LeaderboardBoardContest:
+		return string(b.Kind) + ":" + b.ContestID.String()
+	case LeaderboardBoardYearly:
+		return string(b.Kind) + ":" + strconv.Itoa(b.Year)
+	}
+	return string(b.Kind)
+}
+
+type LeaderboardEventType string
+
+const (
+	// LeaderboardEventScore is sent when the score of a single user changed.
+	LeaderboardEventScore LeaderboardEventType = "score"
+	// LeaderboardEventRebuild is sent when the whole board was recomputed, or
+	// when missed events can't be replayed. Clients should refetch the board.
+	LeaderboardEventRebuild LeaderboardEventType = "rebuild"
+)
+
+type LeaderboardEvent struct {
+	// ID is assigned by the event store and increases per board, it is empty
+	// for events that are not part of the board history.
+	ID     string
+	Board  string
+	Type   LeaderboardEventType
+	UserID *uuid.UUID
+	Score  float64
+	// Rank is the position of the user after the update, ties share a rank.
+	Rank int
+}
+
+// LeaderboardEventStore keeps a short history of events per board and
+// broadcasts new events to every API instance.
+type LeaderboardEventStore interface {
+	// PublishLeaderboardEvent appends the event to the board history and
+	// broadcasts it. The store assigns the ID and, for score events, the rank.
+	PublishLeaderboardEvent(ctx context.Context, board LeaderboardBoard, event *LeaderboardEvent) error
+	// ListLeaderboardEventsSince returns the events after lastEventID. Complete
+	// is false when older events were already trimmed from the history.
+	ListLeaderboardEventsSince(ctx context.Context, board LeaderboardBoard, lastEventID string) (events []LeaderboardEvent, complete bool, err error)
+	// ReceiveLeaderboardEvents calls fn for every broadcast event until the
+	// context is cancelled or the connection is lost.
+	ReceiveLeaderboardEvents(ctx context.Context, fn func(LeaderboardEvent)) error
+}
+
+// publishLeaderboardEvent is best effort, streams are a convenience on top of
+// the stored leaderboards and must not fail updates.
+func publishLeaderboardEvent(ctx context.Context, events LeaderboardEventStore, board LeaderboardBoard, event LeaderboardEvent) {
+	if events == nil {
+		return
+	}
+	if err := events.PublishLeaderboardEvent(ctx, board, &event); err != nil {
+		slog.ErrorContext(ctx, "could not publish leaderboard event", "board", board.Key(), "error", err)
+	}
+}
+
+// CompareLeaderboardEventIDs orders event IDs of the form <millis>-<sequence>.
+func CompareLeaderboardEventIDs(a, b string) int {
+	aMillis, aSeq, _ := parseLeaderboardEventID(a)
+	bMillis, bSeq, _ := parseLeaderboardEventID(b)
+	switch {
+	case aMillis != bMillis:
+		if aMillis < bMillis {
+			return -1
+		}
+		return 1
+	case aSeq != bSeq:
+		if aSeq < bSeq {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+func parseLeaderboardEventID(id string) (uint64, uint64, bool) {
+	millis, seq, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	m, err := strconv.ParseUint(millis, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	s, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return m, s, true
+}
+
+// LeaderboardSubscription receives the events of a single board.
+type LeaderboardSubscription struct {
+	board string
+	// Replay holds the events missed since the last event ID of the client.
+	Replay []LeaderboardEvent
+	events chan LeaderboardEvent
+	// lastID skips broadcast events that were already replayed.
+	lastID string
+	hub    *LeaderboardStreamHub
+}
+
+// Events is closed when the subscriber fell behind or the hub stopped, the
+// client is expected to reconnect with its last event ID.
+func (s *LeaderboardSubscription) Events() <-chan LeaderboardEvent {
+	return s.events
+}
+
+func (s *LeaderboardSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// LeaderboardStreamHub fans the broadcast leaderboard events out to the
+// subscribers of this API instance.
+type LeaderboardStreamHub struct {
+	events     LeaderboardEventStore
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*LeaderboardSubscription]struct{}
+	stopped     bool
+}
+
+func NewLeaderboardStreamHub(events LeaderboardEventStore, bufferSize int) *LeaderboardStreamHub {
+	return &LeaderboardStreamHub{
+		events:      events,
+		bufferSize:  bufferSize,
+		subscribers: map[string]map[*LeaderboardSubscription]struct{}{},
+	}
+}
+
+// Run receives broadcast events until the context is cancelled. Subscribers
+// are disconnected when the connection to the store is lost, as events
+// broadcast in the meantime can only be recovered by replaying them.
+func (h *LeaderboardStreamHub) Run(ctx context.Context) {
+	for {
+		err := h.events.ReceiveLeaderboardEvents(ctx, h.dispatch)
+		if ctx.Err() != nil {
+			h.Close()
+			return
+		}
+		slog.ErrorContext(ctx, "leaderboard stream hub: lost connection to event store", "error", err)
+		h.disconnectAll()
+
+		select {
+		case <-ctx.Done():
+			h.Close()
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// Subscribe registers a subscriber for a board. When lastEventID is set the
+// events since then are replayed first.
+func (h *LeaderboardStreamHub) Subscribe(ctx context.Context, board LeaderboardBoard, lastEventID string) (*LeaderboardSubscription, error) {
+	if lastEventID != "" {
+		if _, _, ok := parseLeaderboardEventID(lastEventID); !ok {
+			return nil, fmt.Errorf("%w: last event id %q is invalid", ErrRequestInvalid, lastEventID)
+		}
+	}
+
+	sub := &LeaderboardSubscription{
+		board:  board.Key(),
+		events: make(chan LeaderboardEvent, h.bufferSize),
+		lastID: lastEventID,
+		hub:    h,
+	}
+
+	// Register before replaying so no event falls between the two. Events
+	// broadcast while replaying may repeat the end of the replay, consumers
+	// skip event IDs they already sent.
+	h.mu.Lock()
+	if h.stopped {
+		h.mu.Unlock()
+		return nil, fmt.Errorf("leaderboard stream hub is stopped")
+	}
+	if h.subscribers[sub.board] == nil {
+		h.subscribers[sub.board] = map[*LeaderboardSubscription]struct{}{}
+	}
+	h.subscribers[sub.board][sub] = struct{}{}
+	h.mu.Unlock()
+
+	if lastEventID == "" {
+		return sub, nil
+	}
+
+	replay, complete, err := h.events.ListLeaderboardEventsSince(ctx, board, lastEventID)
+	if err != nil {
+		sub.Close()
+		return nil, fmt.Errorf("could not replay leaderboard events: %w", err)
+	}
+	if !complete {
+		sub.Replay = append(sub.Replay, LeaderboardEvent{Board: sub.board, Type: LeaderboardEventRebuild})
+	}
+	sub.Replay = append(sub.Replay, replay...)
+
+	h.mu.Lock()
+	for _, event := range replay {
+		if CompareLeaderboardEventIDs(event.ID, sub.lastID) > 0 {
+			sub.lastID = event.ID
+		}
+	}
+	h.mu.Unlock()
+
+	return sub, nil
+}
+
+// Close disconnects every subscriber and rejects new ones.
+func (h *LeaderboardStreamHub) Close() {
+	h.mu.Lock()
+	h.stopped = true
+	h.mu.Unlock()
+	h.disconnectAll()
+}
+
+func (h *LeaderboardStreamHub) dispatch(event LeaderboardEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[event.Board] {
+		if sub.lastID != "" && CompareLeaderboardEventIDs(event.ID, sub.lastID) <= 0 {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// The subscriber can't keep up, drop it instead of buffering
+			// without bound. It resumes from its last event ID.
+			h.removeLocked(sub)
+		}
+	}
+}
+
+func (h *LeaderboardStreamHub) unsubscribe(sub *LeaderboardSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.removeLocked(sub)
+}
+
+func (h *LeaderboardStreamHub) disconnectAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			h.removeLocked(sub)
+		}
+	}
+}
+
+func (h *LeaderboardStreamHub) removeLocked(sub *LeaderboardSubscription) {
+	subs, ok := h.subscribers[sub.board]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.board)
+	}
+	close(sub.events)
+}
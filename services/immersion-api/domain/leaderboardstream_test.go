@@ -0,0 +1,274 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+// mockLeaderboardEventStore implements domain.LeaderboardEventStore for testing.
+type mockLeaderboardEventStore struct {
+	published  []domain.LeaderboardEvent
+	publishErr error
+
+	history         []domain.LeaderboardEvent
+	historyComplete bool
+	historyErr      error
+
+	// receivers gets the dispatch function of every ReceiveLeaderboardEvents call
+	receivers chan func(domain.LeaderboardEvent)
+}
+
+func newMockLeaderboardEventStore() *mockLeaderboardEventStore {
+	return &mockLeaderboardEventStore{
+		historyComplete: true,
+		receivers:       make(chan func(domain.LeaderboardEvent), 1),
+	}
+}
+
+func (m *mockLeaderboardEventStore) PublishLeaderboardEvent(ctx context.Context, board domain.LeaderboardBoard, event *domain.LeaderboardEvent) error {
+	if m.publishErr != nil {
+		return m.publishErr
+	}
+	event.Board = board.Key()
+	m.published = append(m.published, *event)
+	return nil
+}
+
+func (m *mockLeaderboardEventStore) ListLeaderboardEventsSince(ctx context.Context, board domain.LeaderboardBoard, lastEventID string) ([]domain.LeaderboardEvent, bool, error) {
+	return m.history, m.historyComplete, m.historyErr
+}
+
+func (m *mockLeaderboardEventStore) ReceiveLeaderboardEvents(ctx context.Context, fn func(domain.LeaderboardEvent)) error {
+	m.receivers <- fn
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func runLeaderboardStreamHub(t *testing.T, events *mockLeaderboardEventStore) (*domain.LeaderboardStreamHub, func(domain.LeaderboardEvent)) {
+	t.Helper()
+	hub := domain.NewLeaderboardStreamHub(events, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go hub.Run(ctx)
+
+	select {
+	case dispatch := <-events.receivers:
+		return hub, dispatch
+	case <-time.After(time.Second):
+		t.Fatal("hub did not start receiving events")
+		return nil, nil
+	}
+}
+
+func receiveLeaderboardEvent(t *testing.T, sub *domain.LeaderboardSubscription) (domain.LeaderboardEvent, bool) {
+	t.Helper()
+	select {
+	case event, ok := <-sub.Events():
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return domain.LeaderboardEvent{}, false
+	}
+}
+
+func TestLeaderboardStreamHub(t *testing.T) {
+	ctx := context.Background()
+	contestID := uuid.New()
+	board := domain.ContestLeaderboardBoard(contestID)
+	userID := uuid.New()
+
+	t.Run("delivers events of the subscribed board", func(t *testing.T) {
+		hub, dispatch := runLeaderboardStreamHub(t, newMockLeaderboardEventStore())
+
+		sub, err := hub.Subscribe(ctx, board, "")
+		require.NoError(t, err)
+		defer sub.Close()
+
+		dispatch(domain.LeaderboardEvent{ID: "1-0", Board: domain.GlobalLeaderboardBoard().Key(), Type: domain.LeaderboardEventScore})
+		dispatch(domain.LeaderboardEvent{ID: "2-0", Board: board.Key(), Type: domain.LeaderboardEventScore, UserID: &userID, Score: 10, Rank: 1})
+
+		event, ok := receiveLeaderboardEvent(t, sub)
+		require.True(t, ok)
+		assert.Equal(t, "2-0", event.ID)
+		assert.Equal(t, &userID, event.UserID)
+		assert.Equal(t, 1, event.Rank)
+		assert.Empty(t, sub.Replay)
+	})
+
+	t.Run("replays missed events and skips them when broadcast", func(t *testing.T) {
+		events := newMockLeaderboardEventStore()
+		events.history = []domain.LeaderboardEvent{
+			{ID: "5-0", Board: board.Key(), Type: domain.LeaderboardEventScore},
+			{ID: "5-1", Board: board.Key(), Type: domain.LeaderboardEventScore},
+		}
+		hub, dispatch := runLeaderboardStreamHub(t, events)
+
+		sub, err := hub.Subscribe(ctx, board, "4-0")
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.Len(t, sub.Replay, 2)
+		assert.Equal(t, "5-1", sub.Replay[1].ID)
+
+		dispatch(domain.LeaderboardEvent{ID: "5-1", Board: board.Key(), Type: domain.LeaderboardEventScore})
+		dispatch(domain.LeaderboardEvent{ID: "6-0", Board: board.Key(), Type: domain.LeaderboardEventScore})
+
+		event, ok := receiveLeaderboardEvent(t, sub)
+		require.True(t, ok)
+		assert.Equal(t, "6-0", event.ID)
+	})
+
+	t.Run("asks for a rebuild when the history was trimmed", func(t *testing.T) {
+		events := newMockLeaderboardEventStore()
+		events.historyComplete = false
+		events.history = []domain.LeaderboardEvent{
+			{ID: "9-0", Board: board.Key(), Type: domain.LeaderboardEventScore},
+		}
+		hub, _ := runLeaderboardStreamHub(t, events)
+
+		sub, err := hub.Subscribe(ctx, board, "1-0")
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.Len(t, sub.Replay, 2)
+		assert.Equal(t, domain.LeaderboardEventRebuild, sub.Replay[0].Type)
+		assert.Empty(t, sub.Replay[0].ID)
+		assert.Equal(t, "9-0", sub.Replay[1].ID)
+	})
+
+	t.Run("rejects invalid last event id", func(t *testing.T) {
+		hub, _ := runLeaderboardStreamHub(t, newMockLeaderboardEventStore())
+
+		_, err := hub.Subscribe(ctx, board, "not-an-id")
+
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("returns replay error", func(t *testing.T) {
+		replayErr := errors.New("valkey error")
+		events := newMockLeaderboardEventStore()
+		events.historyErr = replayErr
+		hub, _ := runLeaderboardStreamHub(t, events)
+
+		_, err := hub.Subscribe(ctx, board, "1-0")
+
+		assert.ErrorIs(t, err, replayErr)
+	})
+
+	t.Run("drops subscribers that fall behind", func(t *testing.T) {
+		hub, dispatch := runLeaderboardStreamHub(t, newMockLeaderboardEventStore())
+
+		sub, err := hub.Subscribe(ctx, board, "")
+		require.NoError(t, err)
+		defer sub.Close()
+
+		for i := 0; i < 3; i++ {
+			dispatch(domain.LeaderboardEvent{ID: "1-0", Board: board.Key(), Type: domain.LeaderboardEventScore})
+		}
+
+		for i := 0; i < 2; i++ {
+			_, ok := receiveLeaderboardEvent(t, sub)
+			assert.True(t, ok)
+		}
+		_, ok := receiveLeaderboardEvent(t, sub)
+		assert.False(t, ok)
+	})
+
+	t.Run("close disconnects subscribers and rejects new ones", func(t *testing.T) {
+		hub, _ := runLeaderboardStreamHub(t, newMockLeaderboardEventStore())
+
+		sub, err := hub.Subscribe(ctx, board, "")
+		require.NoError(t, err)
+
+		hub.Close()
+
+		_, ok := receiveLeaderboardEvent(t, sub)
+		assert.False(t, ok)
+		sub.Close()
+
+		_, err = hub.Subscribe(ctx, board, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestLeaderboardUpdater_PublishesEvents(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	contestID := uuid.New()
+	year := 2026
+
+	t.Run("publishes contest score update", func(t *testing.T) {
+		store := &mockLeaderboardStore{updateContestExists: true}
+		repo := &mockLeaderboardRepo{userContestScore: 42}
+		events := newMockLeaderboardEventStore()
+		updater := domain.NewLeaderboardUpdaterWithEvents(store, repo, events)
+
+		err := updater.UpdateUserContestScore(ctx, contestID, userID)
+
+		require.NoError(t, err)
+		require.Len(t, events.published, 1)
+		assert.Equal(t, domain.ContestLeaderboardBoard(contestID).Key(), events.published[0].Board)
+		assert.Equal(t, domain.LeaderboardEventScore, events.published[0].Type)
+		assert.Equal(t, &userID, events.published[0].UserID)
+		assert.InDelta(t, 42, events.published[0].Score, 0.01)
+	})
+
+	t.Run("publishes yearly and global score updates", func(t *testing.T) {
+		store := &mockLeaderboardStore{updateOfficialYearly: true, updateOfficialGlobal: true}
+		repo := &mockLeaderboardRepo{userYearlyScore: 200, userGlobalScore: 500}
+		events := newMockLeaderboardEventStore()
+		updater := domain.NewLeaderboardUpdaterWithEvents(store, repo, events)
+
+		err := updater.UpdateUserOfficialScores(ctx, year, userID)
+
+		require.NoError(t, err)
+		require.Len(t, events.published, 2)
+		assert.Equal(t, "yearly:2026", events.published[0].Board)
+		assert.InDelta(t, 200, events.published[0].Score, 0.01)
+		assert.Equal(t, "global", events.published[1].Board)
+		assert.InDelta(t, 500, events.published[1].Score, 0.01)
+	})
+
+	t.Run("publishes rebuild events", func(t *testing.T) {
+		store := &mockLeaderboardStore{}
+		repo := &mockLeaderboardRepo{}
+		events := newMockLeaderboardEventStore()
+		updater := domain.NewLeaderboardUpdaterWithEvents(store, repo, events)
+
+		require.NoError(t, updater.RebuildContestLeaderboard(ctx, contestID))
+		require.NoError(t, updater.RebuildOfficialLeaderboards(ctx, year))
+
+		require.Len(t, events.published, 3)
+		for _, event := range events.published {
+			assert.Equal(t, domain.LeaderboardEventRebuild, event.Type)
+			assert.Nil(t, event.UserID)
+		}
+	})
+
+	t.Run("does not fail the update when publishing fails", func(t *testing.T) {
+		store := &mockLeaderboardStore{updateContestExists: true}
+		repo := &mockLeaderboardRepo{userContestScore: 42}
+		events := newMockLeaderboardEventStore()
+		events.publishErr = errors.New("valkey error")
+		updater := domain.NewLeaderboardUpdaterWithEvents(store, repo, events)
+
+		err := updater.UpdateUserContestScore(ctx, contestID, userID)
+
+		assert.NoError(t, err)
+		require.Len(t, store.updateContestCalls, 1)
+	})
+}
+
+func TestCompareLeaderboardEventIDs(t *testing.T) {
+	assert.Equal(t, -1, domain.CompareLeaderboardEventIDs("1-5", "2-0"))
+	assert.Equal(t, -1, domain.CompareLeaderboardEventIDs("2-1", "2-10"))
+	assert.Equal(t, 0, domain.CompareLeaderboardEventIDs("3-0", "3-0"))
+	assert.Equal(t, 1, domain.CompareLeaderboardEventIDs("10-0", "9-9"))
+}
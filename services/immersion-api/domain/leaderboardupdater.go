@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -47,6 +48,9 @@ type LeaderboardStore interface {
 
 	// RebuildOfficialLeaderboards atomically replaces both yearly and global leaderboards.
 	RebuildOfficialLeaderboards(ctx context.Context, year int, yearlyScores []LeaderboardScore, globalScores []LeaderboardScore) error
+
+	// RebuildContestTeamLeaderboard atomically replaces the team leaderboard of a contest.
+	RebuildContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID, scores []TeamLeaderboardScore) error
 }
 
 // LeaderboardRepository provides queries for fetching leaderboard scores
@@ -58,19 +62,28 @@ type LeaderboardRepository interface {
 	FetchUserContestScore(ctx context.Context, contestID uuid.UUID, userID uuid.UUID) (float64, error)
 	FetchUserYearlyScore(ctx context.Context, year int, userID uuid.UUID) (float64, error)
 	FetchUserGlobalScore(ctx context.Context, userID uuid.UUID) (float64, error)
+	ContestTeamScoreRepository
 }
 
 // LeaderboardUpdater provides methods to update individual user scores and
 // full rebuild methods for reconciliation.
 type LeaderboardUpdater struct {
-	store LeaderboardStore
-	repo  LeaderboardRepository
+	store  LeaderboardStore
+	repo   LeaderboardRepository
+	events LeaderboardEventStore
 }
 
 func NewLeaderboardUpdater(store LeaderboardStore, repo LeaderboardRepository) *LeaderboardUpdater {
+	return NewLeaderboardUpdaterWithEvents(store, repo, nil)
+}
+
+// NewLeaderboardUpdaterWithEvents also publishes every applied update, so it
+// can be streamed to clients. events may be nil.
+func NewLeaderboardUpdaterWithEvents(store LeaderboardStore, repo LeaderboardRepository, events LeaderboardEventStore) *LeaderboardUpdater {
 	return &LeaderboardUpdater{
-		store: store,
-		repo:  repo,
+		store:  store,
+		repo:   repo,
+		events: events,
 	}
 }
 
@@ -88,6 +101,11 @@ func (u *LeaderboardUpdater) UpdateUserContestScore(ctx context.Context, contest
 		return fmt.Errorf("update user contest score: %w", err)
 	}
 	if updated {
+		publishLeaderboardEvent(ctx, u.events, ContestLeaderboardBoard(contestID), LeaderboardEvent{
+			Type:   LeaderboardEventScore,
+			UserID: &userID,
+			Score:  score,
+		})
 		return nil
 	}
 
@@ -119,6 +137,17 @@ func (u *LeaderboardUpdater) UpdateUserOfficialScores(ctx context.Context, year
 		return u.RebuildOfficialLeaderboards(ctx, year)
 	}
 
+	publishLeaderboardEvent(ctx, u.events, YearlyLeaderboardBoard(year), LeaderboardEvent{
+		Type:   LeaderboardEventScore,
+		UserID: &userID,
+		Score:  yearlyScore,
+	})
+	publishLeaderboardEvent(ctx, u.events, GlobalLeaderboardBoard(), LeaderboardEvent{
+		Type:   LeaderboardEventScore,
+		UserID: &userID,
+		Score:  globalScore,
+	})
+
 	return nil
 }
 
@@ -134,6 +163,8 @@ func (u *LeaderboardUpdater) RebuildContestLeaderboard(ctx context.Context, cont
 		return fmt.Errorf("rebuild contest leaderboard: %w", err)
 	}
 
+	publishLeaderboardEvent(ctx, u.events, ContestLeaderboardBoard(contestID), LeaderboardEvent{Type: LeaderboardEventRebuild})
+
 	return nil
 }
 
@@ -154,5 +185,31 @@ func (u *LeaderboardUpdater) RebuildOfficialLeaderboards(ctx context.Context, ye
 		return fmt.Errorf("rebuild official leaderboards: %w", err)
 	}
 
+	publishLeaderboardEvent(ctx, u.events, YearlyLeaderboardBoard(year), LeaderboardEvent{Type: LeaderboardEventRebuild})
+	publishLeaderboardEvent(ctx, u.events, GlobalLeaderboardBoard(), LeaderboardEvent{Type: LeaderboardEventRebuild})
+
+	return nil
+}
+
+// RefreshContestTeamLeaderboard aggregates the member scores of all teams in
+// a contest and rebuilds its team leaderboard. Team scores depend on every
+// member, so the board is always rebuilt instead of updated per team.
+// Contests without team mode are ignored.
+func (u *LeaderboardUpdater) RefreshContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID) error {
+	scores, teamMode, err := fetchTeamLeaderboardScores(ctx, u.repo, contestID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("fetch contest team scores for rebuild: %w", err)
+	}
+	if !teamMode {
+		return nil
+	}
+
+	if err := u.store.RebuildContestTeamLeaderboard(ctx, contestID, scores); err != nil {
+		return fmt.Errorf("rebuild contest team leaderboard: %w", err)
+	}
+
 	return nil
 }
@@ -17,6 +17,7 @@ type mockLeaderboardStore struct {
 	updateOfficialCalls  []storeUpdateOfficialCall
 	rebuildContestCalls  []storeRebuildContestCall
 	rebuildOfficialCalls []storeRebuildOfficialCall
+	rebuildTeamCalls     []storeRebuildTeamCall
 
 	// Control behavior
 	updateContestExists  bool
@@ -26,6 +27,7 @@ type mockLeaderboardStore struct {
 	updateOfficialErr    error
 	rebuildContestErr    error
 	rebuildOfficialErr   error
+	rebuildTeamErr       error
 }
 
 type storeUpdateContestCall struct {
@@ -46,6 +48,11 @@ type storeRebuildContestCall struct {
 	Scores    []domain.LeaderboardScore
 }
 
+type storeRebuildTeamCall struct {
+	ContestID uuid.UUID
+	Scores    []domain.TeamLeaderboardScore
+}
+
 type storeRebuildOfficialCall struct {
 	Year         int
 	YearlyScores []domain.LeaderboardScore
@@ -80,6 +87,13 @@ func (m *mockLeaderboardStore) RebuildOfficialLeaderboards(ctx context.Context,
 	return m.rebuildOfficialErr
 }
 
+func (m *mockLeaderboardStore) RebuildContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID, scores []domain.TeamLeaderboardScore) error {
+	m.rebuildTeamCalls = append(m.rebuildTeamCalls, storeRebuildTeamCall{
+		ContestID: contestID, Scores: scores,
+	})
+	return m.rebuildTeamErr
+}
+
 // mockLeaderboardRepo implements domain.LeaderboardRepository for testing.
 type mockLeaderboardRepo struct {
 	contestScores    []domain.LeaderboardScore
@@ -94,6 +108,10 @@ type mockLeaderboardRepo struct {
 	userContestErr   error
 	userYearlyErr    error
 	userGlobalErr    error
+	teamSettings     *domain.ContestTeamSettings
+	teamScores       []domain.ContestTeamScore
+	teamSettingsErr  error
+	teamScoresErr    error
 }
 
 func (m *mockLeaderboardRepo) FetchAllContestLeaderboardScores(ctx context.Context, contestID uuid.UUID) ([]domain.LeaderboardScore, error) {
@@ -120,6 +138,20 @@ func (m *mockLeaderboardRepo) FetchUserGlobalScore(ctx context.Context, userID u
 	return m.userGlobalScore, m.userGlobalErr
 }
 
+func (m *mockLeaderboardRepo) FindContestTeamSettings(ctx context.Context, contestID uuid.UUID) (*domain.ContestTeamSettings, error) {
+	if m.teamSettingsErr != nil {
+		return nil, m.teamSettingsErr
+	}
+	if m.teamSettings == nil {
+		return &domain.ContestTeamSettings{ScoreAggregation: domain.ContestTeamScoreSum}, nil
+	}
+	return m.teamSettings, nil
+}
+
+func (m *mockLeaderboardRepo) FetchContestTeamScores(ctx context.Context, contestID uuid.UUID) ([]domain.ContestTeamScore, error) {
+	return m.teamScores, m.teamScoresErr
+}
+
 func TestLeaderboardUpdater_UpdateUserContestScore(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
@@ -374,3 +406,77 @@ func TestLeaderboardUpdater_UpdateUserOfficialScores(t *testing.T) {
 		require.Len(t, store.rebuildOfficialCalls, 1)
 	})
 }
+
+func TestLeaderboardUpdater_RefreshContestTeamLeaderboard(t *testing.T) {
+	ctx := context.Background()
+	contestID := uuid.New()
+	teamA := uuid.New()
+	teamB := uuid.New()
+	teamScores := []domain.ContestTeamScore{
+		{TeamID: teamA, MemberCount: 2, TotalScore: 300},
+		{TeamID: teamB, MemberCount: 3, TotalScore: 360},
+	}
+
+	t.Run("rebuilds with summed scores", func(t *testing.T) {
+		store := &mockLeaderboardStore{}
+		repo := &mockLeaderboardRepo{
+			teamSettings: &domain.ContestTeamSettings{TeamMode: true, ScoreAggregation: domain.ContestTeamScoreSum},
+			teamScores:   teamScores,
+		}
+		updater := domain.NewLeaderboardUpdater(store, repo)
+
+		require.NoError(t, updater.RefreshContestTeamLeaderboard(ctx, contestID))
+
+		require.Len(t, store.rebuildTeamCalls, 1)
+		assert.Equal(t, contestID, store.rebuildTeamCalls[0].ContestID)
+		assert.Equal(t, []domain.TeamLeaderboardScore{
+			{TeamID: teamA, Score: 300},
+			{TeamID: teamB, Score: 360},
+		}, store.rebuildTeamCalls[0].Scores)
+	})
+
+	t.Run("rebuilds with averaged scores", func(t *testing.T) {
+		store := &mockLeaderboardStore{}
+		repo := &mockLeaderboardRepo{
+			teamSettings: &domain.ContestTeamSettings{TeamMode: true, ScoreAggregation: domain.ContestTeamScoreAverage},
+			teamScores:   teamScores,
+		}
+		updater := domain.NewLeaderboardUpdater(store, repo)
+
+		require.NoError(t, updater.RefreshContestTeamLeaderboard(ctx, contestID))
+
+		require.Len(t, store.rebuildTeamCalls, 1)
+		assert.Equal(t, []domain.TeamLeaderboardScore{
+			{TeamID: teamA, Score: 150},
+			{TeamID: teamB, Score: 120},
+		}, store.rebuildTeamCalls[0].Scores)
+	})
+
+	t.Run("skips contests without team mode", func(t *testing.T) {
+		store := &mockLeaderboardStore{}
+		updater := domain.NewLeaderboardUpdater(store, &mockLeaderboardRepo{teamScores: teamScores})
+
+		require.NoError(t, updater.RefreshContestTeamLeaderboard(ctx, contestID))
+		assert.Empty(t, store.rebuildTeamCalls)
+	})
+
+	t.Run("skips deleted contests", func(t *testing.T) {
+		store := &mockLeaderboardStore{}
+		updater := domain.NewLeaderboardUpdater(store, &mockLeaderboardRepo{teamSettingsErr: domain.ErrNotFound})
+
+		require.NoError(t, updater.RefreshContestTeamLeaderboard(ctx, contestID))
+		assert.Empty(t, store.rebuildTeamCalls)
+	})
+
+	t.Run("returns store error", func(t *testing.T) {
+		store := &mockLeaderboardStore{rebuildTeamErr: errors.New("valkey down")}
+		repo := &mockLeaderboardRepo{
+			teamSettings: &domain.ContestTeamSettings{TeamMode: true, ScoreAggregation: domain.ContestTeamScoreSum},
+			teamScores:   teamScores,
+		}
+		updater := domain.NewLeaderboardUpdater(store, repo)
+
+		err := updater.RefreshContestTeamLeaderboard(ctx, contestID)
+		assert.ErrorContains(t, err, "rebuild contest team leaderboard")
+	})
+}
@@ -14,6 +14,8 @@ type LogContestUpdateRepository interface {
 	FindLogByID(context.Context, *LogFindRequest) (*Log, error)
 	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
 	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+	FindUserSettings(context.Context, uuid.UUID) (*UserSettings, error)
 	UpdateLogContests(ctx context.Context, req *LogContestUpdateDBRequest) error
 }
 
@@ -105,6 +107,10 @@ func (s *LogContestUpdate) Execute(ctx context.Context, req *LogContestUpdateReq
 	// Build lookup: registration_id → ContestRegistration
 	validRegistrations := map[uuid.UUID]ContestRegistration{}
 	for _, r := range registrations.Registrations {
+		// Cancelled contests no longer take logs
+		if r.Contest != nil && r.Contest.Deleted {
+			continue
+		}
 		validRegistrations[r.ID] = r
 	}
 
@@ -185,11 +191,17 @@ func (s *LogContestUpdate) Execute(ctx context.Context, req *LogContestUpdateReq
 	}
 
 	if s.useScoringEngine {
-		input := scoringInputFromTracking(int32(log.ActivityID), log.LanguageCode, log.Tags, log.Tracking)
+		input := scoringInputFromTracking(int32(log.ActivityID), log.LanguageCode, log.Tags, log.Tracking, log.CreatedAt)
+		input.Location, err = findUserLocation(ctx, s.repo, log.UserID)
+		if err != nil {
+			return nil, err
+		}
+		usage := scoringUsageScope{userID: log.UserID, logID: &log.ID}
 		for i := range toAttach {
 			contestTracking, scoringErr := resolveContestLogTracking(
 				ctx,
 				s.repo,
+				usage,
 				toAttach[i].ContestID,
 				toAttach[i].RegistrationID,
 				log.Tracking,
@@ -36,6 +36,14 @@ func (m *mockLogContestUpdateRepository) FindActivePlatformScoringRuleSet(contex
 	return defaultScoringShadowRuleSet(), nil
 }
 
+func (m *mockLogContestUpdateRepository) FindScoringRuleUsage(context.Context, *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	return &domain.ScoringRuleUsage{}, nil
+}
+
+func (m *mockLogContestUpdateRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	return nil, domain.ErrNotFound
+}
+
 func (m *mockLogContestUpdateRepository) FindContestScoringRuleSets(_ context.Context, contestID uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
 	if m.contestScoringErr != nil {
 		return nil, nil, m.contestScoringErr
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -15,8 +16,11 @@ type LogCreateRepository interface {
 	FindUnitForTrackingByKey(context.Context, *UnitFindForTrackingByKeyRequest) (*Unit, error)
 	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
 	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+	FindUserSettings(context.Context, uuid.UUID) (*UserSettings, error)
 	CreateLog(context.Context, *LogCreateRequest) (*uuid.UUID, error)
 	FindLogByID(context.Context, *LogFindRequest) (*Log, error)
+	MediaExists(context.Context, uuid.UUID) (bool, error)
 }
 
 type LogCreateRequest struct {
@@ -31,11 +35,13 @@ type LogCreateRequest struct {
 
 	// Optional
 	Description *string
+	MediaID     *uuid.UUID
 
 	// Set by domain layer (unexported: only domain can write, others read via getters)
 	userID                      uuid.UUID
 	eligibleOfficialLeaderboard bool
 	year                        int16
+	loggedAt                    time.Time
 	tracking                    LogTracking
 	contestTrackings            []ContestLogTracking
 }
@@ -108,66 +114,122 @@ func (s *LogCreate) Execute(ctx context.Context, req *LogCreateRequest) (*Log, e
 	}
 	req.userID = uuid.MustParse(session.Subject)
 
+	registrations, err := s.ongoingRegistrations(ctx, req.userID, len(req.RegistrationIDs) > 0)
+	if err != nil {
+		return nil, err
+	}
+	req.loggedAt = s.clock.Now()
+	if err := s.prepare(ctx, req, registrations); err != nil {
+		return nil, err
+	}
+
+	req.year = int16(req.loggedAt.Year())
+
+	logId, err := s.repo.CreateLog(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create log: %w", err)
+	}
+
+	log, err := s.repo.FindLogByID(ctx, &LogFindRequest{
+		ID:             *logId,
+		IncludeDeleted: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := hydrateLogActivity(log); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// ongoingRegistrations fetches the caller's ongoing contest registrations keyed
+// by ID. Nothing is fetched when the submission does not reference any.
+func (s *LogCreate) ongoingRegistrations(
+	ctx context.Context,
+	userID uuid.UUID,
+	needed bool,
+) (map[uuid.UUID]ContestRegistration, error) {
+	result := map[uuid.UUID]ContestRegistration{}
+	if !needed {
+		return result, nil
+	}
+	registrations, err := s.repo.FetchOngoingContestRegistrations(ctx, &RegistrationListOngoingRequest{
+		UserID: userID,
+		Now:    s.clock.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch registrations: %w", err)
+	}
+	for _, r := range registrations.Registrations {
+		// Cancelled contests no longer take logs
+		if r.Contest != nil && r.Contest.Deleted {
+			continue
+		}
+		result[r.ID] = r
+	}
+	return result, nil
+}
+
+// prepare validates a submission and resolves its platform and contest
+// tracking. It is shared with bulk imports so every log is validated and
+// scored through the same path.
+func (s *LogCreate) prepare(
+	ctx context.Context,
+	req *LogCreateRequest,
+	validContestRegistrations map[uuid.UUID]ContestRegistration,
+) error {
 	err := s.validate.Struct(req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to validate: %w", ErrInvalidLog)
+		return fmt.Errorf("unable to validate: %w", ErrInvalidLog)
 	}
 
 	// Validate and normalize tags
 	req.Tags, err = ValidateAndNormalizeTags(req.Tags)
 	if err != nil {
-		return nil, fmt.Errorf("unable to validate tags: %w", err)
+		return fmt.Errorf("unable to validate tags: %w", err)
 	}
 
-	validContestRegistrations := map[uuid.UUID]ContestRegistration{}
-	if len(req.RegistrationIDs) > 0 {
-		registrations, fetchErr := s.repo.FetchOngoingContestRegistrations(ctx, &RegistrationListOngoingRequest{
-			UserID: req.userID,
-			Now:    s.clock.Now(),
-		})
-		if fetchErr != nil {
-			return nil, fmt.Errorf("unable to fetch registrations: %w", fetchErr)
-		}
+	if err := validateLogMedia(ctx, s.repo, req.MediaID); err != nil {
+		return err
+	}
 
-		for _, r := range registrations.Registrations {
-			validContestRegistrations[r.ID] = r
+	// validate registrations
+	for _, id := range req.RegistrationIDs {
+		registration, ok := validContestRegistrations[id]
+		if !ok {
+			return fmt.Errorf("registration is not found as ongoing for the current user: %w", ErrInvalidLog)
 		}
 
-		// validate registrations
-		for _, id := range req.RegistrationIDs {
-			registration, ok := validContestRegistrations[id]
-			if !ok {
-				return nil, fmt.Errorf("registration is not found as ongoing for the current user: %w", ErrInvalidLog)
-			}
-
-			if registration.Contest.Official {
-				req.eligibleOfficialLeaderboard = true
-			}
+		if registration.Contest.Official {
+			req.eligibleOfficialLeaderboard = true
+		}
 
-			// validate language is part of registration
-			found := false
-			for _, lang := range registration.Languages {
-				if lang.Code == req.LanguageCode {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return nil, fmt.Errorf("language is not allowed for registration: %w", ErrInvalidLog)
+		// validate language is part of registration
+		found := false
+		for _, lang := range registration.Languages {
+			if lang.Code == req.LanguageCode {
+				found = true
+				break
 			}
+		}
+		if !found {
+			return fmt.Errorf("language is not allowed for registration: %w", ErrInvalidLog)
+		}
 
-			// validate activity is allowed by the contest
-			found = false
-			for _, act := range registration.Contest.AllowedActivities {
-				if act.ID == req.ActivityID {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return nil, fmt.Errorf("activity is not allowed for registration: %w", ErrInvalidLog)
+		// validate activity is allowed by the contest
+		found = false
+		for _, act := range registration.Contest.AllowedActivities {
+			if act.ID == req.ActivityID {
+				found = true
+				break
 			}
 		}
+		if !found {
+			return fmt.Errorf("activity is not allowed for registration: %w", ErrInvalidLog)
+		}
 	}
 
 	req.tracking, err = resolveLogTracking(
@@ -179,10 +241,16 @@ func (s *LogCreate) Execute(ctx context.Context, req *LogCreateRequest) (*Log, e
 		req.UnitKey,
 		req.Amount,
 		req.DurationSeconds,
+		nil,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	location, err := findUserLocation(ctx, s.repo, req.userID)
+	if err != nil {
+		return err
+	}
+	usage := scoringUsageScope{userID: req.userID}
 	scoringInput := ScoringInput{
 		ActivityID:      req.ActivityID,
 		UnitKey:         req.tracking.UnitKey,
@@ -190,6 +258,9 @@ func (s *LogCreate) Execute(ctx context.Context, req *LogCreateRequest) (*Log, e
 		Tags:            req.Tags,
 		Amount:          req.Amount,
 		DurationSeconds: req.DurationSeconds,
+		LoggedAt:        req.loggedAt,
+		Location:        location,
+		Usage:           usage.lookup(ctx, s.repo, nil),
 	}
 	mode := ScoringShadowModeShadow
 	if s.useScoringEngine {
@@ -206,7 +277,7 @@ func (s *LogCreate) Execute(ctx context.Context, req *LogCreateRequest) (*Log, e
 	)
 	if s.useScoringEngine {
 		if scoringErr != nil {
-			return nil, fmt.Errorf("could not score log: %w", scoringErr)
+			return fmt.Errorf("could not score log: %w", scoringErr)
 		}
 		ApplyScoringResult(&req.tracking, result)
 		for _, registrationID := range req.RegistrationIDs {
@@ -214,36 +285,18 @@ func (s *LogCreate) Execute(ctx context.Context, req *LogCreateRequest) (*Log, e
 			contestTracking, contestErr := resolveContestLogTracking(
 				ctx,
 				s.repo,
+				usage,
 				registration.ContestID,
 				registrationID,
 				req.tracking,
 				scoringInput,
 			)
 			if contestErr != nil {
-				return nil, fmt.Errorf("could not score contest %s: %w", registration.ContestID, contestErr)
+				return fmt.Errorf("could not score contest %s: %w", registration.ContestID, contestErr)
 			}
 			req.contestTrackings = append(req.contestTrackings, contestTracking)
 		}
 	}
 
-	req.year = int16(s.clock.Now().Year())
-
-	logId, err := s.repo.CreateLog(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("could not create log: %w", err)
-	}
-
-	log, err := s.repo.FindLogByID(ctx, &LogFindRequest{
-		ID:             *logId,
-		IncludeDeleted: false,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	if err := hydrateLogActivity(log); err != nil {
-		return nil, err
-	}
-
-	return log, nil
+	return nil
 }
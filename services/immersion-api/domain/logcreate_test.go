@@ -3,6 +3,7 @@ package domain_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -24,12 +25,20 @@ type mockLogCreateRepository struct {
 	contestRuleSets   map[uuid.UUID]*domain.ScoringRuleSet
 	contestFallbacks  map[uuid.UUID]*domain.ScoringRuleSet
 	contestScoringErr error
+	ruleUsage         map[uuid.UUID]domain.ScoringRuleUsage
+	usageRequests     []domain.ScoringRuleUsageRequest
+	settings          *domain.UserSettings
 	createdLogID      *uuid.UUID
 	createErr         error
 	log               *domain.Log
 	findErr           error
 	createCalled      bool
 	createCalledWith  *domain.LogCreateRequest
+	media             map[uuid.UUID]bool
+}
+
+func (m *mockLogCreateRepository) MediaExists(_ context.Context, id uuid.UUID) (bool, error) {
+	return m.media[id], nil
 }
 
 func (m *mockLogCreateRepository) FindContestScoringRuleSets(_ context.Context, contestID uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
@@ -39,6 +48,19 @@ func (m *mockLogCreateRepository) FindContestScoringRuleSets(_ context.Context,
 	return m.contestRuleSets[contestID], m.contestFallbacks[contestID], nil
 }
 
+func (m *mockLogCreateRepository) FindScoringRuleUsage(_ context.Context, req *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	m.usageRequests = append(m.usageRequests, *req)
+	usage := m.ruleUsage[req.RuleID]
+	return &usage, nil
+}
+
+func (m *mockLogCreateRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	if m.settings == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.settings, nil
+}
+
 func (m *mockLogCreateRepository) FetchOngoingContestRegistrations(ctx context.Context, req *domain.RegistrationListOngoingRequest) (*domain.ContestRegistrations, error) {
 	return m.registrations, m.fetchRegErr
 }
@@ -66,6 +88,10 @@ func (m *mockLogCreateRepository) FindUnitForTrackingByKey(_ context.Context, re
 	if m.unit != nil {
 		return m.unit, nil
 	}
+	// Like the seeded catalog, keys only resolve for their own activity.
+	if definition, ok := domain.UnitDefinitionByKey(req.Key); !ok || definition.ActivityID != req.ActivityID {
+		return nil, fmt.Errorf("unit key %q is not valid for activity %d: %w", req.Key, req.ActivityID, domain.ErrInvalidLog)
+	}
 	return &domain.Unit{
 		ID:            uuid.New(),
 		Key:           req.Key,
@@ -218,6 +244,27 @@ func TestLogCreate_Execute(t *testing.T) {
 		assert.False(t, repo.createCalled)
 	})
 
+	t.Run("returns error for unknown media", func(t *testing.T) {
+		repo := &mockLogCreateRepository{}
+		clock := commondomain.NewMockClock(now)
+		svc := newLogCreateService(repo, clock)
+
+		ctx := ctxWithUserSubject(userID.String())
+		mediaID := uuid.New()
+		unitKey := domain.UnitKeyReadingCharacter
+
+		_, err := svc.Execute(ctx, &domain.LogCreateRequest{
+			ActivityID:   1,
+			LanguageCode: "jpn",
+			Amount:       &amount100,
+			UnitKey:      &unitKey,
+			MediaID:      &mediaID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLog)
+		assert.False(t, repo.createCalled)
+	})
+
 	t.Run("returns error for invalid request with only amount", func(t *testing.T) {
 		repo := &mockLogCreateRepository{}
 		clock := commondomain.NewMockClock(now)
@@ -290,6 +337,31 @@ func TestLogCreate_Execute(t *testing.T) {
 		assert.False(t, repo.createCalled)
 	})
 
+	t.Run("returns error when the contest was cancelled", func(t *testing.T) {
+		cancelled := *validRegistrations.Registrations[0].Contest
+		cancelled.Deleted = true
+		registration := validRegistrations.Registrations[0]
+		registration.Contest = &cancelled
+		repo := &mockLogCreateRepository{
+			registrations: &domain.ContestRegistrations{Registrations: []domain.ContestRegistration{registration}},
+		}
+		clock := commondomain.NewMockClock(now)
+		svc := newLogCreateService(repo, clock)
+
+		ctx := ctxWithUserSubject(userID.String())
+
+		_, err := svc.Execute(ctx, &domain.LogCreateRequest{
+			RegistrationIDs: []uuid.UUID{registrationID},
+			UnitID:          &unitID,
+			ActivityID:      1,
+			LanguageCode:    "jpn",
+			Amount:          &amount100,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLog)
+		assert.False(t, repo.createCalled)
+	})
+
 	t.Run("returns error when language not allowed by registration", func(t *testing.T) {
 		repo := &mockLogCreateRepository{
 			registrations: validRegistrations,
@@ -460,14 +532,40 @@ func TestLogCreate_Execute(t *testing.T) {
 		assert.False(t, repo.createCalled)
 	})
 
-	t.Run("rejects a legacy unit that has no code-owned key", func(t *testing.T) {
+	t.Run("accepts a catalog unit without a built-in key", func(t *testing.T) {
 		repo := &mockLogCreateRepository{
 			unit: &domain.Unit{
 				ID:            unitID,
-				Key:           "unknown",
+				Key:           "reading_manga_page",
 				LogActivityID: 1,
 				Modifier:      1,
 			},
+			createdLogID: &logID,
+			log:          createdLog,
+		}
+		clock := commondomain.NewMockClock(now)
+		svc := newLogCreateService(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogCreateRequest{
+			UnitID:       &unitID,
+			ActivityID:   1,
+			LanguageCode: "jpn",
+			Amount:       &amount100,
+		})
+
+		require.NoError(t, err)
+		require.True(t, repo.createCalled)
+		assert.Equal(t, "reading_manga_page", repo.createCalledWith.Tracking().UnitKey)
+	})
+
+	t.Run("rejects a unit of another activity", func(t *testing.T) {
+		repo := &mockLogCreateRepository{
+			unit: &domain.Unit{
+				ID:            unitID,
+				Key:           domain.UnitKeyListeningMinute,
+				LogActivityID: 2,
+				Modifier:      1,
+			},
 		}
 		clock := commondomain.NewMockClock(now)
 		svc := newLogCreateService(repo, clock)
@@ -568,6 +666,44 @@ func TestLogCreate_Execute(t *testing.T) {
 		assert.Equal(t, domain.ScoreSourceAmount, tracking.ScoreProvenance.Source)
 	})
 
+	t.Run("counts caps from midnight in the user's time zone", func(t *testing.T) {
+		capValue := float32(50)
+		repo := &mockLogCreateRepository{
+			scoringRuleSet: &domain.ScoringRuleSet{
+				ID: uuid.New(),
+				Rules: []domain.ScoringRule{{
+					ID:          uuid.New(),
+					Priority:    1,
+					ActivityID:  1,
+					UnitKey:     domain.UnitKeyReadingPage,
+					ScoreSource: domain.ScoreSourceAmount,
+					Rate:        1,
+					CapPeriod:   domain.ScoringCapPeriodDay,
+					CapValue:    &capValue,
+				}},
+			},
+			settings:     &domain.UserSettings{UserID: userID, Timezone: "Asia/Tokyo"},
+			createdLogID: &logID,
+			log:          createdLog,
+		}
+		// 01:30 on March 16th in Tokyo.
+		loggedAt := time.Date(2026, 3, 15, 16, 30, 0, 0, time.UTC)
+		clock := commondomain.NewMockClock(loggedAt)
+		svc := newLogCreateServiceWithScoringEngine(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogCreateRequest{
+			UnitID:       &unitID,
+			ActivityID:   1,
+			LanguageCode: "jpn",
+			Amount:       &amount100,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, repo.usageRequests, 1)
+		assert.Equal(t, time.Date(2026, 3, 15, 15, 0, 0, 0, time.UTC), repo.usageRequests[0].From)
+		assert.Equal(t, float32(50), repo.createCalledWith.Tracking().ComputedScore)
+	})
+
 	t.Run("writes zero for an unmatched input when enabled", func(t *testing.T) {
 		repo := &mockLogCreateRepository{
 			scoringRuleSet: &domain.ScoringRuleSet{
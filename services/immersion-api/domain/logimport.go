@@ -0,0 +1,484 @@
+package domain
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type LogImportFormat string
+
+const (
+	LogImportFormatCSV   LogImportFormat = "csv"
+	LogImportFormatJSONL LogImportFormat = "jsonl"
+)
+
+const (
+	MaxLogImportRows = 1000
+
+	// logImportListSeparator separates tags and registration IDs inside a
+	// single CSV cell, since commas already delimit columns.
+	logImportListSeparator = ";"
+)
+
+type LogImportRepository interface {
+	ImportLogs(context.Context, *LogImportBatch) ([]uuid.UUID, error)
+}
+
+type LogImportRequest struct {
+	Format  LogImportFormat
+	Content string
+	DryRun  bool
+}
+
+// LogImportEntry is a validated and scored row that is ready to be persisted.
+type LogImportEntry struct {
+	Log      *LogCreateRequest
+	LoggedAt time.Time
+}
+
+// LogImportBatch is written in a single transaction. Leaderboard outbox
+// events are enqueued once per affected contest and official year instead of
+// once per entry.
+type LogImportBatch struct {
+	UserID  uuid.UUID
+	Entries []LogImportEntry
+}
+
+type LogImportRowResult struct {
+	Row      int
+	Accepted bool
+	Reason   string
+	LogID    *uuid.UUID
+	Platform *ScoreEstimate
+	Contests []ContestScoreEstimate
+}
+
+type LogImportResult struct {
+	DryRun        bool
+	AcceptedCount int
+	RejectedCount int
+	Rows          []LogImportRowResult
+}
+
+// logImportRow is the format independent representation of a single row.
+type logImportRow struct {
+	Row             int
+	Date            string
+	LanguageCode    string
+	ActivityID      int32
+	Activity        string
+	UnitKey         *string
+	Amount          *float32
+	DurationSeconds *int32
+	Tags            []string
+	Description     *string
+	RegistrationIDs []uuid.UUID
+	parseErr        error
+}
+
+type LogImport struct {
+	repo      LogImportRepository
+	logCreate *LogCreate
+	clock     commondomain.Clock
+}
+
+func NewLogImport(repo LogImportRepository, logCreate *LogCreate, clock commondomain.Clock) *LogImport {
+	return &LogImport{
+		repo:      repo,
+		logCreate: logCreate,
+		clock:     clock,
+	}
+}
+
+func (s *LogImport) Execute(ctx context.Context, req *LogImportRequest) (*LogImportResult, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	userID := uuid.MustParse(session.Subject)
+
+	rows, err := parseLogImport(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.DryRun {
+		if err := s.logCreate.userUpsert.Execute(ctx); err != nil {
+			return nil, fmt.Errorf("could not update user: %w", err)
+		}
+	}
+
+	needsRegistrations := false
+	for _, row := range rows {
+		if len(row.RegistrationIDs) > 0 {
+			needsRegistrations = true
+			break
+		}
+	}
+	registrations, err := s.logCreate.ongoingRegistrations(ctx, userID, needsRegistrations)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	result := &LogImportResult{
+		DryRun: req.DryRun,
+		Rows:   make([]LogImportRowResult, len(rows)),
+	}
+	batch := &LogImportBatch{UserID: userID}
+	batchRows := make([]int, 0, len(rows))
+
+	for i, row := range rows {
+		result.Rows[i] = LogImportRowResult{Row: row.Row}
+
+		entry, rowErr := s.prepareRow(ctx, userID, row, registrations, now)
+		if rowErr != nil {
+			if !isLogImportRowError(rowErr) {
+				return nil, fmt.Errorf("could not import row %d: %w", row.Row, rowErr)
+			}
+			result.Rows[i].Reason = rowErr.Error()
+			result.RejectedCount++
+			continue
+		}
+
+		platform := scoreEstimateFromTracking(entry.Log.Tracking())
+		result.Rows[i].Accepted = true
+		result.Rows[i].Platform = &platform
+		result.Rows[i].Contests = contestScoreEstimates(entry.Log)
+		result.AcceptedCount++
+
+		batch.Entries = append(batch.Entries, *entry)
+		batchRows = append(batchRows, i)
+	}
+
+	if req.DryRun || len(batch.Entries) == 0 {
+		return result, nil
+	}
+
+	ids, err := s.repo.ImportLogs(ctx, batch)
+	if err != nil {
+		return nil, fmt.Errorf("could not import logs: %w", err)
+	}
+	for i, id := range ids {
+		id := id
+		result.Rows[batchRows[i]].LogID = &id
+	}
+
+	return result, nil
+}
+
+func (s *LogImport) prepareRow(
+	ctx context.Context,
+	userID uuid.UUID,
+	row logImportRow,
+	registrations map[uuid.UUID]ContestRegistration,
+	now time.Time,
+) (*LogImportEntry, error) {
+	if row.parseErr != nil {
+		return nil, row.parseErr
+	}
+
+	loggedAt := now
+	if row.Date != "" {
+		parsed, err := parseLogImportDate(row.Date)
+		if err != nil {
+			return nil, err
+		}
+		loggedAt = parsed
+	}
+	if loggedAt.After(now) {
+		return nil, fmt.Errorf("date cannot be in the future: %w", ErrInvalidLog)
+	}
+
+	activityID := row.ActivityID
+	if activityID == 0 && row.Activity != "" {
+		activity, ok := activityByName(row.Activity)
+		if !ok {
+			return nil, fmt.Errorf("activity %q is not valid: %w", row.Activity, ErrInvalidLog)
+		}
+		activityID = activity.ID
+	}
+
+	for _, registrationID := range row.RegistrationIDs {
+		registration, ok := registrations[registrationID]
+		if ok && registration.Contest != nil && loggedAt.Before(registration.Contest.ContestStart) {
+			return nil, fmt.Errorf("date is before the start of the contest: %w", ErrInvalidLog)
+		}
+	}
+
+	log := &LogCreateRequest{
+		RegistrationIDs: row.RegistrationIDs,
+		UnitKey:         row.UnitKey,
+		ActivityID:      activityID,
+		LanguageCode:    row.LanguageCode,
+		Amount:          row.Amount,
+		DurationSeconds: row.DurationSeconds,
+		Tags:            row.Tags,
+		Description:     row.Description,
+		userID:          userID,
+		year:            int16(loggedAt.Year()),
+		// Caps count the logs stored before the import, not the rows
+		// imported along with this one.
+		loggedAt: loggedAt,
+	}
+	if err := s.logCreate.prepare(ctx, log, registrations); err != nil {
+		return nil, err
+	}
+
+	return &LogImportEntry{Log: log, LoggedAt: loggedAt}, nil
+}
+
+// isLogImportRowError reports whether err rejects a single row rather than
+// the whole import.
+func isLogImportRowError(err error) bool {
+	return errors.Is(err, ErrInvalidLog) || errors.Is(err, ErrInvalidTags)
+}
+
+func contestScoreEstimates(req *LogCreateRequest) []ContestScoreEstimate {
+	trackings := req.ContestTrackings()
+	result := make([]ContestScoreEstimate, len(trackings))
+	for i, tracking := range trackings {
+		result[i] = ContestScoreEstimate{
+			RegistrationID: tracking.RegistrationID,
+			ContestID:      tracking.ContestID,
+			Estimate:       scoreEstimateFromTracking(tracking.Tracking),
+		}
+	}
+	return result
+}
+
+func activityByName(name string) (Activity, bool) {
+	for _, activity := range Activities() {
+		if strings.EqualFold(activity.Name, strings.TrimSpace(name)) {
+			return activity, true
+		}
+	}
+	return Activity{}, false
+}
+
+func parseLogImportDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("date %q must be YYYY-MM-DD or RFC 3339: %w", value, ErrInvalidLog)
+}
+
+func parseLogImport(format LogImportFormat, content string) ([]logImportRow, error) {
+	var rows []logImportRow
+	var err error
+	switch format {
+	case LogImportFormatCSV:
+		rows, err = parseLogImportCSV(content)
+	case LogImportFormatJSONL:
+		rows, err = parseLogImportJSONL(content)
+	default:
+		return nil, fmt.Errorf("unknown import format %q: %w", format, ErrInvalidLogImport)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("import does not contain any rows: %w", ErrInvalidLogImport)
+	}
+	if len(rows) > MaxLogImportRows {
+		return nil, fmt.Errorf("import has %d rows, maximum is %d: %w", len(rows), MaxLogImportRows, ErrInvalidLogImport)
+	}
+	return rows, nil
+}
+
+func parseLogImportCSV(content string) ([]logImportRow, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read csv header: %w", ErrInvalidLogImport)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["language_code"]; !ok {
+		return nil, fmt.Errorf("csv header must contain language_code: %w", ErrInvalidLogImport)
+	}
+	_, hasActivityID := columns["activity_id"]
+	_, hasActivity := columns["activity"]
+	if !hasActivityID && !hasActivity {
+		return nil, fmt.Errorf("csv header must contain activity_id or activity: %w", ErrInvalidLogImport)
+	}
+
+	var rows []logImportRow
+	for {
+		record, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		rowNumber := len(rows) + 1
+		if readErr != nil {
+			rows = append(rows, logImportRow{
+				Row:      rowNumber,
+				parseErr: fmt.Errorf("could not read csv row: %w", ErrInvalidLog),
+			})
+			continue
+		}
+		if len(rows) >= MaxLogImportRows {
+			return nil, fmt.Errorf("import exceeds maximum of %d rows: %w", MaxLogImportRows, ErrInvalidLogImport)
+		}
+
+		cell := func(name string) string {
+			index, ok := columns[name]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		row := logImportRow{
+			Row:          rowNumber,
+			Date:         cell("date"),
+			LanguageCode: cell("language_code"),
+			Activity:     cell("activity"),
+			UnitKey:      optionalImportString(cell("unit_key")),
+			Description:  optionalImportString(cell("description")),
+			Tags:         splitImportList(cell("tags")),
+		}
+		row.parseErr = parseLogImportCSVNumbers(&row, cell)
+		if row.parseErr == nil {
+			row.RegistrationIDs, row.parseErr = parseImportUUIDs(splitImportList(cell("registration_ids")))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseLogImportCSVNumbers(row *logImportRow, cell func(string) string) error {
+	if value := cell("activity_id"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("activity_id %q is not a number: %w", value, ErrInvalidLog)
+		}
+		row.ActivityID = int32(parsed)
+	}
+	if value := cell("amount"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return fmt.Errorf("amount %q is not a number: %w", value, ErrInvalidLog)
+		}
+		amount := float32(parsed)
+		row.Amount = &amount
+	}
+	if value := cell("duration_seconds"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("duration_seconds %q is not a number: %w", value, ErrInvalidLog)
+		}
+		duration := int32(parsed)
+		row.DurationSeconds = &duration
+	}
+	return nil
+}
+
+type logImportJSONRow struct {
+	Date            string      `json:"date"`
+	LanguageCode    string      `json:"language_code"`
+	ActivityID      int32       `json:"activity_id"`
+	Activity        string      `json:"activity"`
+	UnitKey         *string     `json:"unit_key"`
+	Amount          *float32    `json:"amount"`
+	DurationSeconds *int32      `json:"duration_seconds"`
+	Tags            []string    `json:"tags"`
+	Description     *string     `json:"description"`
+	RegistrationIDs []uuid.UUID `json:"registration_ids"`
+}
+
+func parseLogImportJSONL(content string) ([]logImportRow, error) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []logImportRow
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if len(rows) >= MaxLogImportRows {
+			return nil, fmt.Errorf("import exceeds maximum of %d rows: %w", MaxLogImportRows, ErrInvalidLogImport)
+		}
+
+		row := logImportRow{Row: len(rows) + 1}
+		var parsed logImportJSONRow
+		if err := json.Unmarshal([]byte(line), &parsed); err != nil {
+			row.parseErr = fmt.Errorf("could not parse json line: %w", ErrInvalidLog)
+			rows = append(rows, row)
+			continue
+		}
+		row.Date = parsed.Date
+		row.LanguageCode = parsed.LanguageCode
+		row.ActivityID = parsed.ActivityID
+		row.Activity = parsed.Activity
+		row.UnitKey = parsed.UnitKey
+		row.Amount = parsed.Amount
+		row.DurationSeconds = parsed.DurationSeconds
+		row.Tags = parsed.Tags
+		row.Description = parsed.Description
+		row.RegistrationIDs = parsed.RegistrationIDs
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read json lines: %w", ErrInvalidLogImport)
+	}
+	return rows, nil
+}
+
+func splitImportList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	parts := strings.Split(value, logImportListSeparator)
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+func parseImportUUIDs(values []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("registration id %q is not valid: %w", value, ErrInvalidLog)
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func optionalImportString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
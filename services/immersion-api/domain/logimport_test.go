@@ -0,0 +1,202 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockLogImportRepository struct {
+	batch     *domain.LogImportBatch
+	importErr error
+}
+
+func (m *mockLogImportRepository) ImportLogs(_ context.Context, batch *domain.LogImportBatch) ([]uuid.UUID, error) {
+	m.batch = batch
+	if m.importErr != nil {
+		return nil, m.importErr
+	}
+	ids := make([]uuid.UUID, len(batch.Entries))
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	return ids, nil
+}
+
+func newLogImportService(
+	importRepo *mockLogImportRepository,
+	createRepo *mockLogCreateRepository,
+	clock commondomain.Clock,
+) *domain.LogImport {
+	userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForLog{})
+	logCreate := domain.NewLogCreateWithScoringEngine(createRepo, clock, userUpsert, true)
+	return domain.NewLogImport(importRepo, logCreate, clock)
+}
+
+func TestLogImport_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		importRepo := &mockLogImportRepository{}
+		svc := newLogImportService(importRepo, &mockLogCreateRepository{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.LogImportRequest{
+			Format:  domain.LogImportFormatCSV,
+			Content: "language_code,activity_id\njpn,1\n",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Nil(t, importRepo.batch)
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		svc := newLogImportService(&mockLogImportRepository{}, &mockLogCreateRepository{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format:  "xml",
+			Content: "<logs />",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLogImport)
+	})
+
+	t.Run("rejects csv without required columns", func(t *testing.T) {
+		svc := newLogImportService(&mockLogImportRepository{}, &mockLogCreateRepository{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format:  domain.LogImportFormatCSV,
+			Content: "date,amount\n2026-01-01,10\n",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLogImport)
+	})
+
+	t.Run("dry run reports scores per row without persisting", func(t *testing.T) {
+		importRepo := &mockLogImportRepository{}
+		svc := newLogImportService(importRepo, &mockLogCreateRepository{}, commondomain.NewMockClock(now))
+
+		result, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format: domain.LogImportFormatCSV,
+			Content: "date,language_code,activity,unit_key,amount,duration_seconds,tags\n" +
+				"2025-12-31,jpn,Reading,reading_page,12,,Book;Fiction\n" +
+				"2026-01-02,jpn,Reading,listening_minute,5,,\n" +
+				"2026-01-03,jpn,Listening,,,600,\n",
+			DryRun: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 2, result.AcceptedCount)
+		assert.Equal(t, 1, result.RejectedCount)
+		require.Len(t, result.Rows, 3)
+
+		assert.True(t, result.Rows[0].Accepted)
+		require.NotNil(t, result.Rows[0].Platform)
+		assert.InDelta(t, 12, result.Rows[0].Platform.Score, 0.0001)
+		assert.Nil(t, result.Rows[0].LogID)
+
+		assert.False(t, result.Rows[1].Accepted)
+		assert.Contains(t, result.Rows[1].Reason, "listening_minute")
+
+		assert.True(t, result.Rows[2].Accepted)
+		assert.InDelta(t, 10, result.Rows[2].Platform.Score, 0.0001)
+
+		assert.Nil(t, importRepo.batch)
+	})
+
+	t.Run("persists accepted json lines in a single batch", func(t *testing.T) {
+		importRepo := &mockLogImportRepository{}
+		svc := newLogImportService(importRepo, &mockLogCreateRepository{}, commondomain.NewMockClock(now))
+
+		result, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format: domain.LogImportFormatJSONL,
+			Content: `{"date":"2024-05-01","language_code":"jpn","activity_id":1,"unit_key":"reading_page","amount":3,"tags":["Manga"," manga "]}` + "\n" +
+				"not json\n" +
+				"\n" +
+				`{"date":"2099-01-01","language_code":"jpn","activity_id":2,"duration_seconds":60}` + "\n" +
+				`{"language_code":"jpn","activity_id":2,"duration_seconds":120}` + "\n",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.AcceptedCount)
+		assert.Equal(t, 2, result.RejectedCount)
+		require.Len(t, result.Rows, 4)
+		assert.Equal(t, []int{1, 2, 3, 4}, []int{result.Rows[0].Row, result.Rows[1].Row, result.Rows[2].Row, result.Rows[3].Row})
+		assert.NotNil(t, result.Rows[0].LogID)
+		assert.Nil(t, result.Rows[1].LogID)
+		assert.Contains(t, result.Rows[2].Reason, "future")
+		assert.NotNil(t, result.Rows[3].LogID)
+
+		require.NotNil(t, importRepo.batch)
+		assert.Equal(t, userID, importRepo.batch.UserID)
+		require.Len(t, importRepo.batch.Entries, 2)
+
+		first := importRepo.batch.Entries[0]
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), first.LoggedAt)
+		assert.Equal(t, int16(2024), first.Log.Year())
+		assert.Equal(t, userID, first.Log.UserID())
+		assert.Equal(t, []string{"manga"}, first.Log.Tags)
+
+		second := importRepo.batch.Entries[1]
+		assert.Equal(t, now, second.LoggedAt)
+		assert.Equal(t, int16(2026), second.Log.Year())
+	})
+
+	t.Run("rejects rows before the contest started", func(t *testing.T) {
+		registrationID := uuid.New()
+		contestID := uuid.New()
+		createRepo := &mockLogCreateRepository{
+			registrations: &domain.ContestRegistrations{
+				Registrations: []domain.ContestRegistration{{
+					ID:        registrationID,
+					ContestID: contestID,
+					Languages: []domain.Language{{Code: "jpn"}},
+					Contest: &domain.ContestView{
+						ID:                contestID,
+						ContestStart:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+						AllowedActivities: []domain.Activity{{ID: 1}},
+					},
+				}},
+			},
+		}
+		importRepo := &mockLogImportRepository{}
+		svc := newLogImportService(importRepo, createRepo, commondomain.NewMockClock(now))
+
+		result, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format: domain.LogImportFormatCSV,
+			Content: "date,language_code,activity_id,unit_key,amount,registration_ids\n" +
+				"2026-02-28,jpn,1,reading_page,1," + registrationID.String() + "\n" +
+				"2026-03-02,jpn,1,reading_page,1," + registrationID.String() + "\n",
+		})
+
+		require.NoError(t, err)
+		assert.False(t, result.Rows[0].Accepted)
+		assert.True(t, result.Rows[1].Accepted)
+		require.Len(t, result.Rows[1].Contests, 1)
+		assert.Equal(t, contestID, result.Rows[1].Contests[0].ContestID)
+		require.Len(t, importRepo.batch.Entries, 1)
+		assert.Equal(t, []uuid.UUID{registrationID}, importRepo.batch.Entries[0].Log.RegistrationIDs)
+	})
+
+	t.Run("aborts on repository failures", func(t *testing.T) {
+		repoErr := errors.New("database unavailable")
+		importRepo := &mockLogImportRepository{}
+		svc := newLogImportService(importRepo, &mockLogCreateRepository{findUnitErr: repoErr}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogImportRequest{
+			Format:  domain.LogImportFormatCSV,
+			Content: "language_code,activity_id,unit_key,amount\njpn,1,reading_page,10\n",
+		})
+
+		assert.ErrorIs(t, err, repoErr)
+		assert.Nil(t, importRepo.batch)
+	})
+}
@@ -0,0 +1,315 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type LogScoreExplanationRepository interface {
+	FindLogByID(context.Context, *LogFindRequest) (*Log, error)
+	ListContestLogTrackingsForLog(context.Context, uuid.UUID) ([]ContestLogTracking, error)
+	FindScoringRuleSetByID(context.Context, uuid.UUID) (*ScoringRuleSet, error)
+	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
+	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+	FindUserSettings(context.Context, uuid.UUID) (*UserSettings, error)
+}
+
+type ScoreExplanationRule struct {
+	RuleID uuid.UUID
+	// Rate is the rate stored with the score, the rule may have changed since.
+	Rate float32
+	// Rule is nil when the rule set no longer has the rule.
+	Rule        *ScoringRule
+	Description string
+}
+
+type ScoreExplanation struct {
+	Score     float32
+	Source    ScoreSource
+	BaseValue float32
+	// Legacy is set for scores without provenance, computed before the
+	// scoring engine.
+	Legacy         bool
+	RuleSetID      *uuid.UUID
+	RuleSetVersion int32
+	// Fallback is set when a contest score came from a platform rule set,
+	// because the contest overrides it or has no rule set of its own.
+	Fallback bool
+	Rules    []ScoreExplanationRule
+
+	CurrentScore     float32
+	CurrentRuleSetID *uuid.UUID
+	// Changed is set when the active rule sets would score the log
+	// differently today.
+	Changed bool
+}
+
+type ContestScoreExplanation struct {
+	RegistrationID uuid.UUID
+	ContestID      uuid.UUID
+	ContestTitle   string
+	Explanation    ScoreExplanation
+}
+
+type LogScoreExplanationResult struct {
+	LogID    uuid.UUID
+	Platform ScoreExplanation
+	Contests []ContestScoreExplanation
+}
+
+type LogScoreExplanation struct {
+	repo LogScoreExplanationRepository
+}
+
+func NewLogScoreExplanation(repo LogScoreExplanationRepository) *LogScoreExplanation {
+	return &LogScoreExplanation{repo: repo}
+}
+
+// Execute explains the stored platform and contest scores of a log. Contest
+// scores are only explained to the owner of the log and admins, like the
+// registrations of a log.
+func (s *LogScoreExplanation) Execute(ctx context.Context, logID uuid.UUID) (*LogScoreExplanationResult, error) {
+	session := commondomain.ParseUserIdentity(ctx)
+	userID := uuid.Nil
+	admin := false
+	if session != nil && session.Subject != "guest" {
+		var err error
+		userID, err = uuid.Parse(session.Subject)
+		if err != nil {
+			return nil, ErrUnauthorized
+		}
+		admin = isAdmin(ctx)
+	}
+
+	log, err := s.repo.FindLogByID(ctx, &LogFindRequest{ID: logID, IncludeDeleted: admin})
+	if err != nil {
+		return nil, err
+	}
+
+	explainer := &scoreExplainer{repo: s.repo, ruleSets: map[uuid.UUID]*ScoringRuleSet{}}
+	location, err := findUserLocation(ctx, s.repo, log.UserID)
+	if err != nil {
+		return nil, err
+	}
+	usage := scoringUsageScope{userID: log.UserID, logID: &log.ID}
+	input := scoringInputFromTracking(int32(log.ActivityID), log.LanguageCode, log.Tags, log.Tracking, log.CreatedAt)
+	input.Location = location
+	input.Usage = usage.lookup(ctx, s.repo, nil)
+
+	platform, err := explainer.explain(ctx, log.Tracking, input, ScoringRuleSetScopePlatform)
+	if err != nil {
+		return nil, err
+	}
+	current, err := EvaluateActivePlatformScore(ctx, s.repo, input)
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate platform score: %w", err)
+	}
+	currentTracking := log.Tracking
+	ApplyScoringResult(&currentTracking, current)
+	compareWithCurrentScore(&platform, currentTracking)
+
+	result := &LogScoreExplanationResult{
+		LogID:    log.ID,
+		Platform: platform,
+		Contests: []ContestScoreExplanation{},
+	}
+	if !admin && log.UserID != userID {
+		return result, nil
+	}
+
+	contestTrackings, err := s.repo.ListContestLogTrackingsForLog(ctx, log.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest scores: %w", err)
+	}
+	titles := make(map[uuid.UUID]string, len(log.Registrations))
+	for _, registration := range log.Registrations {
+		titles[registration.RegistrationID] = registration.Title
+	}
+	for _, contestTracking := range contestTrackings {
+		contestInput := scoringInputFromTracking(int32(log.ActivityID), log.LanguageCode, log.Tags, contestTracking.Tracking, log.CreatedAt)
+		contestInput.Location = location
+		contestInput.Usage = usage.lookup(ctx, s.repo, &contestTracking.ContestID)
+		explanation, err := explainer.explain(ctx, contestTracking.Tracking, contestInput, ScoringRuleSetScopeContest)
+		if err != nil {
+			return nil, err
+		}
+		current, err := resolveContestLogTracking(
+			ctx,
+			s.repo,
+			usage,
+			contestTracking.ContestID,
+			contestTracking.RegistrationID,
+			currentTracking,
+			contestInput,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not evaluate contest %s score: %w", contestTracking.ContestID, err)
+		}
+		compareWithCurrentScore(&explanation, current.Tracking)
+
+		result.Contests = append(result.Contests, ContestScoreExplanation{
+			RegistrationID: contestTracking.RegistrationID,
+			ContestID:      contestTracking.ContestID,
+			ContestTitle:   titles[contestTracking.RegistrationID],
+			Explanation:    explanation,
+		})
+	}
+	return result, nil
+}
+
+// scoreExplainer resolves the rule sets referenced by stored scores once,
+// including archived ones.
+type scoreExplainer struct {
+	repo     LogScoreExplanationRepository
+	ruleSets map[uuid.UUID]*ScoringRuleSet
+}
+
+func (e *scoreExplainer) explain(
+	ctx context.Context,
+	tracking LogTracking,
+	input ScoringInput,
+	scope ScoringRuleSetScope,
+) (ScoreExplanation, error) {
+	source, value, err := scoringSourceAndValue(input)
+	if err != nil {
+		return ScoreExplanation{}, err
+	}
+	explanation := ScoreExplanation{
+		Score:     tracking.ComputedScore,
+		Source:    source,
+		BaseValue: value,
+		Rules:     []ScoreExplanationRule{},
+	}
+	provenance := tracking.ScoreProvenance
+	if provenance == nil || provenance.RuleSetID == nil {
+		explanation.Legacy = provenance == nil
+		return explanation, nil
+	}
+	if provenance.Source != "" {
+		explanation.Source = provenance.Source
+	}
+	explanation.RuleSetID = provenance.RuleSetID
+
+	ruleSet, err := e.findRuleSet(ctx, *provenance.RuleSetID)
+	if err != nil {
+		return ScoreExplanation{}, err
+	}
+	rules := map[uuid.UUID]ScoringRule{}
+	if ruleSet != nil {
+		explanation.RuleSetVersion = ruleSet.Version
+		explanation.Fallback = scope == ScoringRuleSetScopeContest && ruleSet.Scope == ScoringRuleSetScopePlatform
+		for _, rule := range ruleSet.Rules {
+			rules[rule.ID] = rule
+		}
+	}
+	for i, ruleID := range provenance.RuleIDs {
+		explained := ScoreExplanationRule{RuleID: ruleID}
+		if i < len(provenance.Rates) {
+			explained.Rate = provenance.Rates[i]
+		}
+		if rule, ok := rules[ruleID]; ok {
+			explained.Rule = &rule
+			explained.Description = DescribeScoringRule(rule)
+		}
+		explanation.Rules = append(explanation.Rules, explained)
+	}
+	return explanation, nil
+}
+
+func (e *scoreExplainer) findRuleSet(ctx context.Context, id uuid.UUID) (*ScoringRuleSet, error) {
+	if ruleSet, ok := e.ruleSets[id]; ok {
+		return ruleSet, nil
+	}
+	ruleSet, err := e.repo.FindScoringRuleSetByID(ctx, id)
+	if errors.Is(err, ErrScoringRuleSetNotFound) {
+		ruleSet, err = nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not find scoring rule set %s: %w", id, err)
+	}
+	e.ruleSets[id] = ruleSet
+	return ruleSet, nil
+}
+
+func compareWithCurrentScore(explanation *ScoreExplanation, current LogTracking) {
+	explanation.CurrentScore = current.ComputedScore
+	if current.ScoreProvenance != nil {
+		explanation.CurrentRuleSetID = current.ScoreProvenance.RuleSetID
+	}
+	explanation.Changed = !scoringScoresEqual(explanation.Score, current.ComputedScore)
+}
+
+// DescribeScoringRule summarizes what a rule matches and how it scores, e.g.
+// "Reading, Page, jpn, tagged novel: 1.5 points per page".
+func DescribeScoringRule(rule ScoringRule) string {
+	criteria := make([]string, 0, 8)
+	if activity, ok := ActivityByID(rule.ActivityID); ok {
+		criteria = append(criteria, activity.Name)
+	}
+	unitName := "unit"
+	if rule.UnitKey != "" {
+		if unit, ok := UnitDefinitionByKey(rule.UnitKey); ok {
+			unitName = strings.ToLower(unit.Name)
+			criteria = append(criteria, unit.Name)
+		} else {
+			// Units added through the catalog have no built-in name.
+			criteria = append(criteria, rule.UnitKey)
+		}
+	}
+	if rule.ScoreSource == ScoreSourceDurationMinutes {
+		unitName = "minute"
+	}
+	if rule.LanguageCode != "" {
+		criteria = append(criteria, rule.LanguageCode)
+	}
+	if rule.Tag != "" {
+		criteria = append(criteria, "tagged "+rule.Tag)
+	}
+	if len(rule.Tags) == 1 {
+		criteria = append(criteria, "tagged "+rule.Tags[0])
+	} else if len(rule.Tags) > 1 {
+		match := rule.TagMatch
+		if match == "" {
+			match = ScoringTagMatchAny
+		}
+		criteria = append(criteria, fmt.Sprintf("tagged %s of %s", match, strings.Join(rule.Tags, ", ")))
+	}
+	switch {
+	case rule.MinValue != nil && rule.MaxValue != nil:
+		criteria = append(criteria, fmt.Sprintf("%s to %s %ss", formatFloat32(*rule.MinValue), formatFloat32(*rule.MaxValue), unitName))
+	case rule.MinValue != nil:
+		criteria = append(criteria, fmt.Sprintf("at least %s %ss", formatFloat32(*rule.MinValue), unitName))
+	case rule.MaxValue != nil:
+		criteria = append(criteria, fmt.Sprintf("at most %s %ss", formatFloat32(*rule.MaxValue), unitName))
+	}
+	if rule.EffectiveFrom != nil {
+		criteria = append(criteria, "logged from "+rule.EffectiveFrom.UTC().Format(time.RFC3339))
+	}
+	if rule.EffectiveUntil != nil {
+		criteria = append(criteria, "logged before "+rule.EffectiveUntil.UTC().Format(time.RFC3339))
+	}
+	if rule.CapValue != nil {
+		criteria = append(criteria, fmt.Sprintf("first %s %ss per %s", formatFloat32(*rule.CapValue), unitName, rule.CapPeriod))
+	}
+	if rule.CapScore != nil {
+		criteria = append(criteria, fmt.Sprintf("up to %s points per %s", formatFloat32(*rule.CapScore), rule.CapPeriod))
+	}
+
+	scoring := fmt.Sprintf("%s points per %s", formatFloat32(rule.Rate), unitName)
+	if rule.Stackable {
+		scoring = "multiplied by " + formatFloat32(rule.Rate)
+	}
+	return strings.Join(criteria, ", ") + ": " + scoring
+}
+
+func formatFloat32(value float32) string {
+	return strconv.FormatFloat(float64(value), 'g', -1, 32)
+}
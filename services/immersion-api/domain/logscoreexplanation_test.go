@@ -0,0 +1,217 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockLogScoreExplanationRepository struct {
+	log                   *domain.Log
+	contestTrackings      []domain.ContestLogTracking
+	listedContestScores   bool
+	ruleSets              map[uuid.UUID]*domain.ScoringRuleSet
+	activePlatform        *domain.ScoringRuleSet
+	activeContest         *domain.ScoringRuleSet
+	activeContestFallback *domain.ScoringRuleSet
+}
+
+func (m *mockLogScoreExplanationRepository) FindLogByID(context.Context, *domain.LogFindRequest) (*domain.Log, error) {
+	return m.log, nil
+}
+
+func (m *mockLogScoreExplanationRepository) ListContestLogTrackingsForLog(context.Context, uuid.UUID) ([]domain.ContestLogTracking, error) {
+	m.listedContestScores = true
+	return m.contestTrackings, nil
+}
+
+func (m *mockLogScoreExplanationRepository) FindScoringRuleSetByID(_ context.Context, id uuid.UUID) (*domain.ScoringRuleSet, error) {
+	ruleSet, ok := m.ruleSets[id]
+	if !ok {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return ruleSet, nil
+}
+
+func (m *mockLogScoreExplanationRepository) FindActivePlatformScoringRuleSet(context.Context) (*domain.ScoringRuleSet, error) {
+	return m.activePlatform, nil
+}
+
+func (m *mockLogScoreExplanationRepository) FindContestScoringRuleSets(context.Context, uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
+	return m.activeContest, m.activeContestFallback, nil
+}
+
+func (m *mockLogScoreExplanationRepository) FindScoringRuleUsage(context.Context, *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	return &domain.ScoringRuleUsage{}, nil
+}
+
+func (m *mockLogScoreExplanationRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	return nil, domain.ErrNotFound
+}
+
+func TestLogScoreExplanation_Execute(t *testing.T) {
+	ownerID := uuid.New()
+	logID := uuid.New()
+	contestID := uuid.New()
+	registrationID := uuid.New()
+	oldRuleSetID := uuid.New()
+	oldRuleID := uuid.New()
+	oldRuleSet := &domain.ScoringRuleSet{
+		ID:      oldRuleSetID,
+		Scope:   domain.ScoringRuleSetScopePlatform,
+		Version: 2,
+		Status:  domain.ScoringRuleSetStatusArchived,
+		Rules: []domain.ScoringRule{{
+			ID:           oldRuleID,
+			Priority:     1,
+			ActivityID:   1,
+			UnitKey:      domain.UnitKeyReadingPage,
+			LanguageCode: "jpn",
+			ScoreSource:  domain.ScoreSourceAmount,
+			Rate:         1,
+		}},
+	}
+	activeRuleSet := &domain.ScoringRuleSet{
+		ID:      uuid.New(),
+		Scope:   domain.ScoringRuleSetScopePlatform,
+		Version: 3,
+		Status:  domain.ScoringRuleSetStatusPublished,
+		Rules: []domain.ScoringRule{{
+			ID:          uuid.New(),
+			Priority:    1,
+			ActivityID:  1,
+			ScoreSource: domain.ScoreSourceAmount,
+			Rate:        1.5,
+		}},
+	}
+	storedTracking := domain.LogTracking{
+		Kind:          domain.LogTrackingAmountUnit,
+		UnitKey:       domain.UnitKeyReadingPage,
+		Amount:        20,
+		Modifier:      1,
+		ComputedScore: 20,
+		ScoreProvenance: &domain.ScoreProvenance{
+			RuleSetID: &oldRuleSetID,
+			RuleIDs:   []uuid.UUID{oldRuleID},
+			Rates:     []float32{1},
+			Source:    domain.ScoreSourceAmount,
+		},
+	}
+	newRepo := func() *mockLogScoreExplanationRepository {
+		return &mockLogScoreExplanationRepository{
+			log: &domain.Log{
+				ID:           logID,
+				UserID:       ownerID,
+				LanguageCode: "jpn",
+				ActivityID:   1,
+				Tracking:     storedTracking,
+				Registrations: []domain.ContestRegistrationReference{{
+					RegistrationID: registrationID,
+					ContestID:      contestID,
+					Title:          "Summer reading",
+				}},
+				CreatedAt: time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			},
+			contestTrackings: []domain.ContestLogTracking{{
+				RegistrationID: registrationID,
+				ContestID:      contestID,
+				Tracking:       storedTracking,
+			}},
+			ruleSets:       map[uuid.UUID]*domain.ScoringRuleSet{oldRuleSetID: oldRuleSet},
+			activePlatform: activeRuleSet,
+		}
+	}
+
+	t.Run("explains stored scores and flags changes by the active rule sets", func(t *testing.T) {
+		repo := newRepo()
+		service := domain.NewLogScoreExplanation(repo)
+
+		result, err := service.Execute(ctxWithUserSubject(ownerID.String()), logID)
+
+		require.NoError(t, err)
+		platform := result.Platform
+		assert.Equal(t, float32(20), platform.Score)
+		assert.Equal(t, float32(20), platform.BaseValue)
+		assert.Equal(t, domain.ScoreSourceAmount, platform.Source)
+		assert.Equal(t, &oldRuleSetID, platform.RuleSetID)
+		assert.Equal(t, int32(2), platform.RuleSetVersion)
+		assert.False(t, platform.Legacy)
+		assert.False(t, platform.Fallback)
+		require.Len(t, platform.Rules, 1)
+		assert.Equal(t, oldRuleID, platform.Rules[0].RuleID)
+		require.NotNil(t, platform.Rules[0].Rule)
+		assert.Equal(t, "Reading, Page, jpn: 1 points per page", platform.Rules[0].Description)
+		assert.Equal(t, float32(30), platform.CurrentScore)
+		assert.Equal(t, &activeRuleSet.ID, platform.CurrentRuleSetID)
+		assert.True(t, platform.Changed)
+
+		require.Len(t, result.Contests, 1)
+		contest := result.Contests[0]
+		assert.Equal(t, "Summer reading", contest.ContestTitle)
+		assert.True(t, contest.Explanation.Fallback)
+		assert.Equal(t, float32(30), contest.Explanation.CurrentScore)
+		assert.True(t, contest.Explanation.Changed)
+	})
+
+	t.Run("hides contest scores from other users", func(t *testing.T) {
+		repo := newRepo()
+		service := domain.NewLogScoreExplanation(repo)
+
+		result, err := service.Execute(ctxWithUserSubject(uuid.New().String()), logID)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Contests)
+		assert.False(t, repo.listedContestScores)
+	})
+
+	t.Run("marks scores without provenance as legacy", func(t *testing.T) {
+		repo := newRepo()
+		repo.log.Tracking.ScoreProvenance = nil
+		repo.log.Tracking.ComputedScore = 30
+		service := domain.NewLogScoreExplanation(repo)
+
+		result, err := service.Execute(ctxWithGuest(), logID)
+
+		require.NoError(t, err)
+		assert.True(t, result.Platform.Legacy)
+		assert.Empty(t, result.Platform.Rules)
+		assert.False(t, result.Platform.Changed)
+	})
+
+	t.Run("keeps applied rules of rule sets that no longer exist", func(t *testing.T) {
+		repo := newRepo()
+		repo.ruleSets = map[uuid.UUID]*domain.ScoringRuleSet{}
+		service := domain.NewLogScoreExplanation(repo)
+
+		result, err := service.Execute(ctxWithGuest(), logID)
+
+		require.NoError(t, err)
+		require.Len(t, result.Platform.Rules, 1)
+		assert.Nil(t, result.Platform.Rules[0].Rule)
+		assert.Equal(t, float32(1), result.Platform.Rules[0].Rate)
+	})
+}
+
+func TestDescribeScoringRule(t *testing.T) {
+	capScore := float32(50)
+	minValue := float32(10)
+	assert.Equal(t,
+		"Listening, tagged all of anime, dense, at least 10 minutes, up to 50 points per week: multiplied by 2",
+		domain.DescribeScoringRule(domain.ScoringRule{
+			Stackable:   true,
+			ActivityID:  2,
+			Tags:        []string{"anime", "dense"},
+			TagMatch:    domain.ScoringTagMatchAll,
+			MinValue:    &minValue,
+			ScoreSource: domain.ScoreSourceDurationMinutes,
+			Rate:        2,
+			CapPeriod:   domain.ScoringCapPeriodWeek,
+			CapScore:    &capScore,
+		}),
+	)
+}
@@ -7,14 +7,6 @@ import (
 	"github.com/google/uuid"
 )
 
-const (
-	readingDurationScorePerMinute   = float32(0.2)
-	listeningDurationScorePerMinute = float32(0.4)
-	writingDurationScorePerMinute   = float32(0.2)
-	speakingDurationScorePerMinute  = float32(0.5)
-	studyDurationScorePerMinute     = float32(0.5)
-)
-
 type LogTrackingKind string
 
 const (
@@ -38,7 +30,9 @@ type ScoreProvenance struct {
 	RuleSetID *uuid.UUID
 	RuleIDs   []uuid.UUID
 	Rates     []float32
-	Source    ScoreSource
+	// Values holds the part of the log's value each rule scored.
+	Values []float32
+	Source ScoreSource
 }
 
 func ApplyScoringResult(tracking *LogTracking, result ScoringResult) {
@@ -52,9 +46,11 @@ func ApplyScoringResult(tracking *LogTracking, result ScoringResult) {
 	tracking.ScoreProvenance.RuleSetID = result.AppliedRuleSetID
 	tracking.ScoreProvenance.RuleIDs = make([]uuid.UUID, len(result.AppliedRules))
 	tracking.ScoreProvenance.Rates = make([]float32, len(result.AppliedRules))
+	tracking.ScoreProvenance.Values = make([]float32, len(result.AppliedRules))
 	for i, appliedRule := range result.AppliedRules {
 		tracking.ScoreProvenance.RuleIDs[i] = appliedRule.RuleID
 		tracking.ScoreProvenance.Rates[i] = appliedRule.Rate
+		tracking.ScoreProvenance.Values[i] = appliedRule.Value
 	}
 }
 
@@ -71,12 +67,18 @@ type UnitFindForTrackingRequest struct {
 	ID           uuid.UUID
 	ActivityID   int32
 	LanguageCode string
+	// CurrentUnitID is the unit the log already uses, it can still be found
+	// after it was deprecated.
+	CurrentUnitID *uuid.UUID
 }
 
 type UnitFindForTrackingByKeyRequest struct {
 	Key          string
 	ActivityID   int32
 	LanguageCode string
+	// CurrentUnitID is the unit the log already uses, it can still be found
+	// after it was deprecated.
+	CurrentUnitID *uuid.UUID
 }
 
 type logTrackingUnitFinder interface {
@@ -131,21 +133,12 @@ func ComputeInterimLogScore(input LogTrackingInput) (float32, error) {
 		return *input.Amount * *input.Modifier, nil
 	}
 
-	minutes := float32(*input.DurationSeconds) / 60
-	switch input.ActivityID {
-	case 1:
-		return minutes * readingDurationScorePerMinute, nil
-	case 2:
-		return minutes * listeningDurationScorePerMinute, nil
-	case 3:
-		return minutes * writingDurationScorePerMinute, nil
-	case 4:
-		return minutes * speakingDurationScorePerMinute, nil
-	case 5:
-		return minutes * studyDurationScorePerMinute, nil
-	default:
+	activity, ok := ActivityByID(input.ActivityID)
+	if !ok {
 		return 0, fmt.Errorf("activity %d is not valid: %w", input.ActivityID, ErrInvalidLog)
 	}
+	minutes := float32(*input.DurationSeconds) / 60
+	return minutes * activity.DurationScorePerMinute, nil
 }
 
 func resolveLogTracking(
@@ -157,23 +150,21 @@ func resolveLogTracking(
 	unitKey *string,
 	amount *float32,
 	durationSeconds *int32,
+	currentUnitID *uuid.UUID,
 ) (LogTracking, error) {
 	if !IsValidActivityID(activityID) {
 		return LogTracking{}, fmt.Errorf("activity %d is not valid: %w", activityID, ErrInvalidLog)
 	}
 
+	// Units are managed in the catalog, so keys are validated by resolving
+	// them for the activity rather than against the built-in definitions.
 	var unit *Unit
-	if unitKey != nil {
-		definition, ok := UnitDefinitionByKey(*unitKey)
-		if !ok || definition.ActivityID != activityID {
-			return LogTracking{}, fmt.Errorf("unit key %q is not valid for activity %d: %w", *unitKey, activityID, ErrInvalidLog)
-		}
-	}
 	if amount != nil && unitID != nil {
 		resolved, err := finder.FindUnitForTracking(ctx, &UnitFindForTrackingRequest{
-			ID:           *unitID,
-			ActivityID:   activityID,
-			LanguageCode: languageCode,
+			ID:            *unitID,
+			ActivityID:    activityID,
+			LanguageCode:  languageCode,
+			CurrentUnitID: currentUnitID,
 		})
 		if err != nil {
 			return LogTracking{}, err
@@ -182,9 +173,10 @@ func resolveLogTracking(
 	}
 	if amount != nil && unitID == nil && unitKey != nil {
 		resolved, err := finder.FindUnitForTrackingByKey(ctx, &UnitFindForTrackingByKeyRequest{
-			Key:          *unitKey,
-			ActivityID:   activityID,
-			LanguageCode: languageCode,
+			Key:           *unitKey,
+			ActivityID:    activityID,
+			LanguageCode:  languageCode,
+			CurrentUnitID: currentUnitID,
 		})
 		if err != nil {
 			return LogTracking{}, err
@@ -192,8 +184,7 @@ func resolveLogTracking(
 		unit = resolved
 	}
 	if unit != nil {
-		definition, ok := UnitDefinitionByKey(unit.Key)
-		if !ok || definition.ActivityID != activityID {
+		if unit.LogActivityID != int(activityID) {
 			return LogTracking{}, fmt.Errorf("resolved unit key %q is not valid for activity %d: %w", unit.Key, activityID, ErrInvalidLog)
 		}
 	}
@@ -16,7 +16,10 @@ type LogUpdateRepository interface {
 	FindUnitForTrackingByKey(context.Context, *UnitFindForTrackingByKeyRequest) (*Unit, error)
 	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
 	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+	FindUserSettings(context.Context, uuid.UUID) (*UserSettings, error)
 	UpdateLog(context.Context, *LogUpdateRequest) error
+	MediaExists(context.Context, uuid.UUID) (bool, error)
 }
 
 type LogUpdateRequest struct {
@@ -27,6 +30,7 @@ type LogUpdateRequest struct {
 	DurationSeconds *int32
 	Tags            []string
 	Description     *string
+	MediaID         *uuid.UUID
 
 	// Set by domain layer (unexported: only domain can write, others read via getters)
 	now              time.Time
@@ -114,6 +118,15 @@ func (s *LogUpdate) Execute(ctx context.Context, req *LogUpdateRequest) (*Log, e
 		return nil, fmt.Errorf("unable to validate tags: %w", err)
 	}
 
+	if err := validateLogMedia(ctx, s.repo, req.MediaID); err != nil {
+		return nil, err
+	}
+
+	// The log keeps its unit when it was deprecated after the log was made.
+	var currentUnitID *uuid.UUID
+	if log.UnitID != uuid.Nil {
+		currentUnitID = &log.UnitID
+	}
 	req.tracking, err = resolveLogTracking(
 		ctx,
 		s.repo,
@@ -123,10 +136,16 @@ func (s *LogUpdate) Execute(ctx context.Context, req *LogUpdateRequest) (*Log, e
 		req.UnitKey,
 		req.Amount,
 		req.DurationSeconds,
+		currentUnitID,
 	)
 	if err != nil {
 		return nil, err
 	}
+	location, err := findUserLocation(ctx, s.repo, log.UserID)
+	if err != nil {
+		return nil, err
+	}
+	usage := scoringUsageScope{userID: log.UserID, logID: &log.ID}
 	scoringInput := ScoringInput{
 		ActivityID:      int32(log.ActivityID),
 		UnitKey:         req.tracking.UnitKey,
@@ -134,6 +153,9 @@ func (s *LogUpdate) Execute(ctx context.Context, req *LogUpdateRequest) (*Log, e
 		Tags:            req.Tags,
 		Amount:          req.Amount,
 		DurationSeconds: req.DurationSeconds,
+		LoggedAt:        log.CreatedAt,
+		Location:        location,
+		Usage:           usage.lookup(ctx, s.repo, nil),
 	}
 	mode := ScoringShadowModeShadow
 	if s.useScoringEngine {
@@ -161,6 +183,7 @@ func (s *LogUpdate) Execute(ctx context.Context, req *LogUpdateRequest) (*Log, e
 			contestTracking, contestErr := resolveContestLogTracking(
 				ctx,
 				s.repo,
+				usage,
 				registration.ContestID,
 				registration.RegistrationID,
 				req.tracking,
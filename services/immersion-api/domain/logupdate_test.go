@@ -18,6 +18,7 @@ type mockLogUpdateRepository struct {
 	updatedLog        *domain.Log
 	unit              *domain.Unit
 	findUnitErr       error
+	deprecatedUnits   map[uuid.UUID]bool
 	scoringRuleSet    *domain.ScoringRuleSet
 	scoringRuleErr    error
 	scoringRuleCalls  int
@@ -29,6 +30,19 @@ type mockLogUpdateRepository struct {
 	updateCalled      bool
 	updateCalledWith  *domain.LogUpdateRequest
 	findCallCount     int
+	media             map[uuid.UUID]bool
+}
+
+func (m *mockLogUpdateRepository) MediaExists(_ context.Context, id uuid.UUID) (bool, error) {
+	return m.media[id], nil
+}
+
+func (m *mockLogUpdateRepository) FindScoringRuleUsage(context.Context, *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	return &domain.ScoringRuleUsage{}, nil
+}
+
+func (m *mockLogUpdateRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	return nil, domain.ErrNotFound
 }
 
 func (m *mockLogUpdateRepository) FindContestScoringRuleSets(_ context.Context, contestID uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
@@ -50,6 +64,9 @@ func (m *mockLogUpdateRepository) FindUnitForTracking(_ context.Context, req *do
 	if m.findUnitErr != nil {
 		return nil, m.findUnitErr
 	}
+	if m.deprecatedUnits[req.ID] && (req.CurrentUnitID == nil || *req.CurrentUnitID != req.ID) {
+		return nil, domain.ErrInvalidLog
+	}
 	if m.unit != nil {
 		return m.unit, nil
 	}
@@ -166,6 +183,48 @@ func TestLogUpdate_Execute(t *testing.T) {
 		assert.Equal(t, updatedLog, result)
 	})
 
+	t.Run("keeps a unit that was deprecated after the log was made", func(t *testing.T) {
+		log := makeLog(userID)
+		log.UnitID = unitID
+		repo := &mockLogUpdateRepository{
+			log:             log,
+			deprecatedUnits: map[uuid.UUID]bool{unitID: true},
+		}
+		clock := commondomain.NewMockClock(now)
+		svc := domain.NewLogUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogUpdateRequest{
+			LogID:  logID,
+			UnitID: &unitID,
+			Amount: &amount20,
+		})
+
+		require.NoError(t, err)
+		require.True(t, repo.updateCalled)
+		assert.Equal(t, unitID, repo.updateCalledWith.Tracking().UnitID)
+	})
+
+	t.Run("rejects switching to a deprecated unit", func(t *testing.T) {
+		deprecatedUnitID := uuid.New()
+		log := makeLog(userID)
+		log.UnitID = unitID
+		repo := &mockLogUpdateRepository{
+			log:             log,
+			deprecatedUnits: map[uuid.UUID]bool{deprecatedUnitID: true},
+		}
+		clock := commondomain.NewMockClock(now)
+		svc := domain.NewLogUpdate(repo, clock)
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.LogUpdateRequest{
+			LogID:  logID,
+			UnitID: &deprecatedUnitID,
+			Amount: &amount20,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLog)
+		assert.False(t, repo.updateCalled)
+	})
+
 	t.Run("returns forbidden for non-owner non-admin", func(t *testing.T) {
 		repo := &mockLogUpdateRepository{
 			log: makeLog(otherUserID),
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MediaType string
+
+const (
+	MediaTypeBook  MediaType = "book"
+	MediaTypeComic MediaType = "comic"
+	MediaTypeTV    MediaType = "tv"
+	MediaTypeMovie MediaType = "movie"
+	MediaTypeGame  MediaType = "game"
+	MediaTypeAudio MediaType = "audio"
+	MediaTypeWeb   MediaType = "web"
+	MediaTypeOther MediaType = "other"
+)
+
+func (t MediaType) IsValid() bool {
+	switch t {
+	case MediaTypeBook, MediaTypeComic, MediaTypeTV, MediaTypeMovie, MediaTypeGame, MediaTypeAudio, MediaTypeWeb, MediaTypeOther:
+		return true
+	}
+	return false
+}
+
+type MediaLengthUnit string
+
+const (
+	MediaLengthUnitPage      MediaLengthUnit = "page"
+	MediaLengthUnitEpisode   MediaLengthUnit = "episode"
+	MediaLengthUnitCharacter MediaLengthUnit = "character"
+	MediaLengthUnitMinute    MediaLengthUnit = "minute"
+)
+
+func (u MediaLengthUnit) IsValid() bool {
+	switch u {
+	case MediaLengthUnitPage, MediaLengthUnitEpisode, MediaLengthUnitCharacter, MediaLengthUnitMinute:
+		return true
+	}
+	return false
+}
+
+// MediaExternalIDISBN is the external identifier key of ISBNs, they are
+// stored as 10 or 13 digits without separators.
+const MediaExternalIDISBN = "isbn"
+
+type Media struct {
+	ID           uuid.UUID
+	Title        string
+	Type         MediaType
+	LanguageCode *string
+	// TotalLength is measured in LengthUnit, both are nil when the length is
+	// unknown.
+	TotalLength     *float32
+	LengthUnit      *MediaLengthUnit
+	ExternalIDs     map[string]string
+	CreatedByUserID *uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type MediaStatus string
+
+const (
+	MediaStatusNotStarted MediaStatus = "not_started"
+	MediaStatusInProgress MediaStatus = "in_progress"
+	MediaStatusCompleted  MediaStatus = "completed"
+)
+
+// MediaLogTotal sums a user's logs linked to a media per length unit their
+// units count towards. LengthUnit is nil for logs in other units.
+type MediaLogTotal struct {
+	MediaID         uuid.UUID
+	LengthUnit      *MediaLengthUnit
+	Amount          float32
+	DurationSeconds int64
+	LogCount        int
+	LastLoggedAt    time.Time
+}
+
+type MediaProgress struct {
+	Media    Media
+	LogCount int
+	// Progress is measured in the length unit of the media, it is zero when
+	// the media has no length.
+	Progress     float32
+	LastLoggedAt *time.Time
+	Status       MediaStatus
+}
+
+var (
+	mediaExternalIDKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+	isbnPattern               = regexp.MustCompile(`^(\d{9}[\dX]|\d{13})$`)
+)
+
+// NormalizeMediaExternalIDs trims identifiers and strips separators from
+// ISBNs, so they can be matched exactly.
+func NormalizeMediaExternalIDs(ids map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(ids))
+	for key, value := range ids {
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if !mediaExternalIDKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("%w: external identifier key %q is invalid", ErrRequestInvalid, key)
+		}
+		if value == "" || len(value) > 100 {
+			return nil, fmt.Errorf("%w: external identifier %q must be between 1 and 100 characters", ErrRequestInvalid, key)
+		}
+		if key == MediaExternalIDISBN {
+			value = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(value))
+			if !isbnPattern.MatchString(value) {
+				return nil, fmt.Errorf("%w: isbn must have 10 or 13 digits", ErrRequestInvalid)
+			}
+		}
+		normalized[key] = value
+	}
+	return normalized, nil
+}
+
+// ComputeMediaProgress sums the linked logs of a user in the length unit of
+// the media. Minutes come from the tracked duration of the logs.
+func ComputeMediaProgress(media Media, totals []MediaLogTotal) MediaProgress {
+	progress := MediaProgress{Media: media, Status: MediaStatusNotStarted}
+	for _, total := range totals {
+		if total.MediaID != media.ID {
+			continue
+		}
+		progress.LogCount += total.LogCount
+		if progress.LastLoggedAt == nil || total.LastLoggedAt.After(*progress.LastLoggedAt) {
+			lastLoggedAt := total.LastLoggedAt
+			progress.LastLoggedAt = &lastLoggedAt
+		}
+		if media.LengthUnit == nil {
+			continue
+		}
+		if *media.LengthUnit == MediaLengthUnitMinute {
+			progress.Progress += float32(total.DurationSeconds) / 60
+		} else if total.LengthUnit != nil && *total.LengthUnit == *media.LengthUnit {
+			progress.Progress += total.Amount
+		}
+	}
+
+	switch {
+	case progress.LogCount == 0:
+		progress.Status = MediaStatusNotStarted
+	case media.TotalLength != nil && progress.Progress >= *media.TotalLength:
+		progress.Status = MediaStatusCompleted
+	default:
+		progress.Status = MediaStatusInProgress
+	}
+	return progress
+}
+
+func validateMedia(media *Media) error {
+	media.Title = strings.TrimSpace(media.Title)
+	if media.Title == "" || len(media.Title) > 255 {
+		return fmt.Errorf("%w: title must be between 1 and 255 characters", ErrRequestInvalid)
+	}
+	if !media.Type.IsValid() {
+		return fmt.Errorf("%w: unknown media type %q", ErrRequestInvalid, media.Type)
+	}
+	if (media.TotalLength == nil) != (media.LengthUnit == nil) {
+		return fmt.Errorf("%w: total length and length unit must be supplied together", ErrRequestInvalid)
+	}
+	if media.TotalLength != nil {
+		length := float64(*media.TotalLength)
+		if !(length > 0) || math.IsInf(length, 0) {
+			return fmt.Errorf("%w: total length must be a positive number", ErrRequestInvalid)
+		}
+		if !media.LengthUnit.IsValid() {
+			return fmt.Errorf("%w: unknown length unit %q", ErrRequestInvalid, *media.LengthUnit)
+		}
+	}
+	ids, err := NormalizeMediaExternalIDs(media.ExternalIDs)
+	if err != nil {
+		return err
+	}
+	media.ExternalIDs = ids
+	return nil
+}
+
+type logMediaRepository interface {
+	MediaExists(context.Context, uuid.UUID) (bool, error)
+}
+
+// validateLogMedia checks that the media a log is linked to exists, logs
+// without a media are valid.
+func validateLogMedia(ctx context.Context, repo logMediaRepository, mediaID *uuid.UUID) error {
+	if mediaID == nil {
+		return nil
+	}
+	exists, err := repo.MediaExists(ctx, *mediaID)
+	if err != nil {
+		return fmt.Errorf("could not check if media exists: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("media is not found: %w", ErrInvalidLog)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type MediaLibraryRepository interface {
+	SearchMedia(ctx context.Context, req *MediaSearchRequest) ([]Media, error)
+	FindMediaByID(ctx context.Context, id uuid.UUID) (*Media, error)
+	// FindMediaIDByExternalID returns nil when no media has the identifier.
+	FindMediaIDByExternalID(ctx context.Context, key string, value string) (*uuid.UUID, error)
+	ListMediaByIDs(ctx context.Context, ids []uuid.UUID) ([]Media, error)
+	CreateMedia(ctx context.Context, media *Media) (*Media, error)
+	UpdateMedia(ctx context.Context, media *Media) (*Media, error)
+	// SumMediaLogsForUser sums the user's logs of one media, or of all media
+	// when mediaID is nil.
+	SumMediaLogsForUser(ctx context.Context, userID uuid.UUID, mediaID *uuid.UUID) ([]MediaLogTotal, error)
+	LinkLogsToMediaByDescription(ctx context.Context, userID uuid.UUID, mediaID uuid.UUID, descriptions []string) (int64, error)
+	LanguageExists(ctx context.Context, code string) (bool, error)
+}
+
+type MediaSearchRequest struct {
+	Query        string
+	LanguageCode *string
+	Limit        int32
+}
+
+type MediaSaveRequest struct {
+	Title        string
+	Type         MediaType
+	LanguageCode *string
+	TotalLength  *float32
+	LengthUnit   *MediaLengthUnit
+	ExternalIDs  map[string]string
+}
+
+const (
+	defaultMediaSearchLimit  = 20
+	maxMediaSearchLimit      = 50
+	maxMediaLinkDescriptions = 100
+)
+
+type MediaLibrary struct {
+	repo MediaLibraryRepository
+}
+
+func NewMediaLibrary(repo MediaLibraryRepository) *MediaLibrary {
+	return &MediaLibrary{repo: repo}
+}
+
+// Search finds media by title, tolerating typos, or by ISBN.
+func (s *MediaLibrary) Search(ctx context.Context, req *MediaSearchRequest) ([]Media, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+
+	req.Query = strings.TrimSpace(req.Query)
+	if req.Query == "" || len(req.Query) > 255 {
+		return nil, fmt.Errorf("%w: query must be between 1 and 255 characters", ErrRequestInvalid)
+	}
+	if isbn, err := NormalizeMediaExternalIDs(map[string]string{MediaExternalIDISBN: req.Query}); err == nil {
+		req.Query = isbn[MediaExternalIDISBN]
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultMediaSearchLimit
+	}
+	if req.Limit > maxMediaSearchLimit {
+		req.Limit = maxMediaSearchLimit
+	}
+
+	media, err := s.repo.SearchMedia(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not search media: %w", err)
+	}
+	return media, nil
+}
+
+// Find returns a media with the progress of the current user.
+func (s *MediaLibrary) Find(ctx context.Context, id uuid.UUID) (*MediaProgress, error) {
+	userID, err := mediaLibraryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := s.repo.FindMediaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.repo.SumMediaLogsForUser(ctx, userID, &media.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute media progress: %w", err)
+	}
+
+	progress := ComputeMediaProgress(*media, totals)
+	return &progress, nil
+}
+
+// ListProgress returns every media the current user linked logs to, most
+// recently logged first.
+func (s *MediaLibrary) ListProgress(ctx context.Context) ([]MediaProgress, error) {
+	userID, err := mediaLibraryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.repo.SumMediaLogsForUser(ctx, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute media progress: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(totals))
+	seen := make(map[uuid.UUID]struct{}, len(totals))
+	for _, total := range totals {
+		if _, ok := seen[total.MediaID]; !ok {
+			seen[total.MediaID] = struct{}{}
+			ids = append(ids, total.MediaID)
+		}
+	}
+	if len(ids) == 0 {
+		return []MediaProgress{}, nil
+	}
+
+	media, err := s.repo.ListMediaByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not list media: %w", err)
+	}
+	result := make([]MediaProgress, len(media))
+	for i, it := range media {
+		result[i] = ComputeMediaProgress(it, totals)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].LastLoggedAt.After(*result[j].LastLoggedAt)
+	})
+	return result, nil
+}
+
+// Create adds a media to the shared library, any signed in user can add one.
+func (s *MediaLibrary) Create(ctx context.Context, req *MediaSaveRequest) (*Media, error) {
+	userID, err := mediaLibraryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	media := &Media{
+		ID:              uuid.New(),
+		CreatedByUserID: &userID,
+	}
+	if err := s.prepare(ctx, media, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreateMedia(ctx, media)
+	if err != nil {
+		return nil, fmt.Errorf("could not create media: %w", err)
+	}
+	return created, nil
+}
+
+// Update changes a media, only its creator and admins can change it.
+func (s *MediaLibrary) Update(ctx context.Context, id uuid.UUID, req *MediaSaveRequest) (*Media, error) {
+	userID, err := mediaLibraryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := s.repo.FindMediaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin(ctx) && (media.CreatedByUserID == nil || *media.CreatedByUserID != userID) {
+		return nil, ErrForbidden
+	}
+	if err := s.prepare(ctx, media, req); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateMedia(ctx, media)
+	if err != nil {
+		return nil, fmt.Errorf("could not update media: %w", err)
+	}
+	return updated, nil
+}
+
+// LinkLogs links the current user's unlinked logs with one of the given
+// descriptions to a media, descriptions match regardless of case and
+// surrounding whitespace. It returns the number of linked logs.
+func (s *MediaLibrary) LinkLogs(ctx context.Context, mediaID uuid.UUID, descriptions []string) (int64, error) {
+	userID, err := mediaLibraryUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	normalized := make([]string, 0, len(descriptions))
+	seen := make(map[string]struct{}, len(descriptions))
+	for _, description := range descriptions {
+		description = strings.ToLower(strings.TrimSpace(description))
+		if description == "" {
+			continue
+		}
+		if _, ok := seen[description]; !ok {
+			seen[description] = struct{}{}
+			normalized = append(normalized, description)
+		}
+	}
+	if len(normalized) == 0 || len(normalized) > maxMediaLinkDescriptions {
+		return 0, fmt.Errorf("%w: between 1 and %d descriptions are required", ErrRequestInvalid, maxMediaLinkDescriptions)
+	}
+
+	if _, err := s.repo.FindMediaByID(ctx, mediaID); err != nil {
+		return 0, err
+	}
+
+	linked, err := s.repo.LinkLogsToMediaByDescription(ctx, userID, mediaID, normalized)
+	if err != nil {
+		return 0, fmt.Errorf("could not link logs to media: %w", err)
+	}
+	return linked, nil
+}
+
+func (s *MediaLibrary) prepare(ctx context.Context, media *Media, req *MediaSaveRequest) error {
+	media.Title = req.Title
+	media.Type = req.Type
+	media.LanguageCode = req.LanguageCode
+	media.TotalLength = req.TotalLength
+	media.LengthUnit = req.LengthUnit
+	media.ExternalIDs = req.ExternalIDs
+	if err := validateMedia(media); err != nil {
+		return err
+	}
+
+	if media.LanguageCode != nil {
+		exists, err := s.repo.LanguageExists(ctx, *media.LanguageCode)
+		if err != nil {
+			return fmt.Errorf("could not check if language exists: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("%w: unknown language %q", ErrRequestInvalid, *media.LanguageCode)
+		}
+	}
+
+	if isbn, ok := media.ExternalIDs[MediaExternalIDISBN]; ok {
+		existing, err := s.repo.FindMediaIDByExternalID(ctx, MediaExternalIDISBN, isbn)
+		if err != nil {
+			return fmt.Errorf("could not check for duplicate media: %w", err)
+		}
+		if existing != nil && *existing != media.ID {
+			return fmt.Errorf("%w: media with isbn %s already exists", ErrConflict, isbn)
+		}
+	}
+	return nil
+}
+
+func mediaLibraryUserID(ctx context.Context) (uuid.UUID, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return uuid.Nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return uuid.Nil, ErrUnauthorized
+	}
+	userID, err := uuid.Parse(session.Subject)
+	if err != nil {
+		return uuid.Nil, ErrUnauthorized
+	}
+	return userID, nil
+}
@@ -0,0 +1,337 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockMediaLibraryRepository struct {
+	media           map[uuid.UUID]domain.Media
+	totals          []domain.MediaLogTotal
+	linkedWith      []string
+	linkedCount     int64
+	searchedWith    *domain.MediaSearchRequest
+	unknownLanguage bool
+}
+
+func (m *mockMediaLibraryRepository) SearchMedia(_ context.Context, req *domain.MediaSearchRequest) ([]domain.Media, error) {
+	m.searchedWith = req
+	return []domain.Media{}, nil
+}
+
+func (m *mockMediaLibraryRepository) FindMediaByID(_ context.Context, id uuid.UUID) (*domain.Media, error) {
+	media, ok := m.media[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &media, nil
+}
+
+func (m *mockMediaLibraryRepository) FindMediaIDByExternalID(_ context.Context, key string, value string) (*uuid.UUID, error) {
+	for id, media := range m.media {
+		if media.ExternalIDs[key] == value {
+			return &id, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockMediaLibraryRepository) ListMediaByIDs(_ context.Context, ids []uuid.UUID) ([]domain.Media, error) {
+	result := []domain.Media{}
+	for _, id := range ids {
+		if media, ok := m.media[id]; ok {
+			result = append(result, media)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockMediaLibraryRepository) CreateMedia(_ context.Context, media *domain.Media) (*domain.Media, error) {
+	if m.media == nil {
+		m.media = map[uuid.UUID]domain.Media{}
+	}
+	m.media[media.ID] = *media
+	return media, nil
+}
+
+func (m *mockMediaLibraryRepository) UpdateMedia(_ context.Context, media *domain.Media) (*domain.Media, error) {
+	m.media[media.ID] = *media
+	return media, nil
+}
+
+func (m *mockMediaLibraryRepository) SumMediaLogsForUser(_ context.Context, _ uuid.UUID, mediaID *uuid.UUID) ([]domain.MediaLogTotal, error) {
+	result := []domain.MediaLogTotal{}
+	for _, total := range m.totals {
+		if mediaID == nil || total.MediaID == *mediaID {
+			result = append(result, total)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockMediaLibraryRepository) LinkLogsToMediaByDescription(_ context.Context, _ uuid.UUID, _ uuid.UUID, descriptions []string) (int64, error) {
+	m.linkedWith = descriptions
+	return m.linkedCount, nil
+}
+
+func (m *mockMediaLibraryRepository) LanguageExists(context.Context, string) (bool, error) {
+	return !m.unknownLanguage, nil
+}
+
+func TestMediaLibrary_Create(t *testing.T) {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	t.Run("creates a media with a normalized isbn", func(t *testing.T) {
+		repo := &mockMediaLibraryRepository{}
+		svc := domain.NewMediaLibrary(repo)
+
+		pages := float32(320)
+		unit := domain.MediaLengthUnitPage
+		media, err := svc.Create(ctxWithUserSubject(userID.String()), &domain.MediaSaveRequest{
+			Title:       " Kiki's Delivery Service ",
+			Type:        domain.MediaTypeBook,
+			TotalLength: &pages,
+			LengthUnit:  &unit,
+			ExternalIDs: map[string]string{"isbn": "978-4-04-102622-9"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Kiki's Delivery Service", media.Title)
+		assert.Equal(t, "9784041026229", media.ExternalIDs["isbn"])
+		assert.Equal(t, &userID, media.CreatedByUserID)
+	})
+
+	t.Run("rejects a duplicate isbn", func(t *testing.T) {
+		existing := uuid.New()
+		repo := &mockMediaLibraryRepository{media: map[uuid.UUID]domain.Media{
+			existing: {ID: existing, Title: "Existing", Type: domain.MediaTypeBook, ExternalIDs: map[string]string{"isbn": "9784041026229"}},
+		}}
+		svc := domain.NewMediaLibrary(repo)
+
+		_, err := svc.Create(ctxWithUserSubject(userID.String()), &domain.MediaSaveRequest{
+			Title:       "Duplicate",
+			Type:        domain.MediaTypeBook,
+			ExternalIDs: map[string]string{"isbn": "978 4041026229"},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("rejects invalid media", func(t *testing.T) {
+		repo := &mockMediaLibraryRepository{}
+		svc := domain.NewMediaLibrary(repo)
+		ctx := ctxWithUserSubject(userID.String())
+
+		pages := float32(100)
+		_, err := svc.Create(ctx, &domain.MediaSaveRequest{Title: "No unit", Type: domain.MediaTypeBook, TotalLength: &pages})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+
+		_, err = svc.Create(ctx, &domain.MediaSaveRequest{Title: "Bad isbn", Type: domain.MediaTypeBook, ExternalIDs: map[string]string{"isbn": "123"}})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+
+		_, err = svc.Create(ctx, &domain.MediaSaveRequest{Title: "Bad type", Type: "podcast"})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+
+		repo.unknownLanguage = true
+		language := "xyz"
+		_, err = svc.Create(ctx, &domain.MediaSaveRequest{Title: "Bad language", Type: domain.MediaTypeBook, LanguageCode: &language})
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(&mockMediaLibraryRepository{})
+
+		_, err := svc.Create(ctxWithGuest(), &domain.MediaSaveRequest{Title: "Guest", Type: domain.MediaTypeBook})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
+
+func TestMediaLibrary_Update(t *testing.T) {
+	creatorID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	mediaID := uuid.New()
+	newRepo := func() *mockMediaLibraryRepository {
+		return &mockMediaLibraryRepository{media: map[uuid.UUID]domain.Media{
+			mediaID: {ID: mediaID, Title: "Original", Type: domain.MediaTypeBook, CreatedByUserID: &creatorID},
+		}}
+	}
+
+	t.Run("allows the creator", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(newRepo())
+
+		media, err := svc.Update(ctxWithUserSubject(creatorID.String()), mediaID, &domain.MediaSaveRequest{Title: "Renamed", Type: domain.MediaTypeComic})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", media.Title)
+		assert.Equal(t, &creatorID, media.CreatedByUserID)
+	})
+
+	t.Run("allows admins", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(newRepo())
+
+		_, err := svc.Update(ctxWithAdmin(), mediaID, &domain.MediaSaveRequest{Title: "Renamed", Type: domain.MediaTypeBook})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("forbids other users", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(newRepo())
+
+		_, err := svc.Update(ctxWithUserSubject(uuid.NewString()), mediaID, &domain.MediaSaveRequest{Title: "Renamed", Type: domain.MediaTypeBook})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("keeps its own isbn", func(t *testing.T) {
+		repo := newRepo()
+		media := repo.media[mediaID]
+		media.ExternalIDs = map[string]string{"isbn": "9784041026229"}
+		repo.media[mediaID] = media
+		svc := domain.NewMediaLibrary(repo)
+
+		_, err := svc.Update(ctxWithUserSubject(creatorID.String()), mediaID, &domain.MediaSaveRequest{
+			Title:       "Renamed",
+			Type:        domain.MediaTypeBook,
+			ExternalIDs: map[string]string{"isbn": "9784041026229"},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestMediaLibrary_Progress(t *testing.T) {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	bookID, movieID := uuid.New(), uuid.New()
+	pages := float32(300)
+	pageUnit, minuteUnit := domain.MediaLengthUnitPage, domain.MediaLengthUnitMinute
+	characterUnit := domain.MediaLengthUnitCharacter
+	minutes := float32(120)
+
+	repo := &mockMediaLibraryRepository{
+		media: map[uuid.UUID]domain.Media{
+			bookID:  {ID: bookID, Title: "Book", Type: domain.MediaTypeBook, TotalLength: &pages, LengthUnit: &pageUnit},
+			movieID: {ID: movieID, Title: "Movie", Type: domain.MediaTypeMovie, TotalLength: &minutes, LengthUnit: &minuteUnit},
+		},
+		totals: []domain.MediaLogTotal{
+			{MediaID: bookID, LengthUnit: &pageUnit, Amount: 120, LogCount: 3, LastLoggedAt: now.Add(-48 * time.Hour)},
+			{MediaID: bookID, LengthUnit: &characterUnit, Amount: 5000, LogCount: 1, LastLoggedAt: now.Add(-72 * time.Hour)},
+			{MediaID: movieID, DurationSeconds: 7200, LogCount: 2, LastLoggedAt: now},
+		},
+	}
+	svc := domain.NewMediaLibrary(repo)
+	ctx := ctxWithUserSubject(userID.String())
+
+	t.Run("sums logs in the length unit of the media", func(t *testing.T) {
+		progress, err := svc.Find(ctx, bookID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, progress.LogCount)
+		assert.InDelta(t, 120, progress.Progress, 0.0001)
+		assert.Equal(t, domain.MediaStatusInProgress, progress.Status)
+	})
+
+	t.Run("completes media measured in minutes from durations", func(t *testing.T) {
+		progress, err := svc.Find(ctx, movieID)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 120, progress.Progress, 0.0001)
+		assert.Equal(t, domain.MediaStatusCompleted, progress.Status)
+	})
+
+	t.Run("lists media most recently logged first", func(t *testing.T) {
+		list, err := svc.ListProgress(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, movieID, list[0].Media.ID)
+		assert.Equal(t, bookID, list[1].Media.ID)
+	})
+
+	t.Run("completes media measured in episodes from episode units", func(t *testing.T) {
+		showID := uuid.New()
+		episodes := float32(12)
+		episodeUnit := domain.MediaLengthUnitEpisode
+		show := domain.Media{ID: showID, Title: "Show", Type: domain.MediaTypeTV, TotalLength: &episodes, LengthUnit: &episodeUnit}
+
+		progress := domain.ComputeMediaProgress(show, []domain.MediaLogTotal{
+			{MediaID: showID, LengthUnit: &episodeUnit, Amount: 12, LogCount: 4, LastLoggedAt: now},
+			{MediaID: showID, DurationSeconds: 3600, LogCount: 1, LastLoggedAt: now},
+		})
+
+		assert.InDelta(t, 12, progress.Progress, 0.0001)
+		assert.Equal(t, domain.MediaStatusCompleted, progress.Status)
+	})
+
+	t.Run("reports media without logs as not started", func(t *testing.T) {
+		progress := domain.ComputeMediaProgress(repo.media[bookID], nil)
+
+		assert.Equal(t, domain.MediaStatusNotStarted, progress.Status)
+		assert.Nil(t, progress.LastLoggedAt)
+	})
+}
+
+func TestMediaLibrary_LinkLogs(t *testing.T) {
+	mediaID := uuid.New()
+	ctx := ctxWithUserSubject("00000000-0000-0000-0000-000000000002")
+
+	t.Run("normalizes and deduplicates descriptions", func(t *testing.T) {
+		repo := &mockMediaLibraryRepository{
+			media:       map[uuid.UUID]domain.Media{mediaID: {ID: mediaID, Title: "Book", Type: domain.MediaTypeBook}},
+			linkedCount: 7,
+		}
+		svc := domain.NewMediaLibrary(repo)
+
+		linked, err := svc.LinkLogs(ctx, mediaID, []string{" Kiki ", "kiki", "KIKI vol 2", ""})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), linked)
+		assert.Equal(t, []string{"kiki", "kiki vol 2"}, repo.linkedWith)
+	})
+
+	t.Run("rejects empty descriptions", func(t *testing.T) {
+		repo := &mockMediaLibraryRepository{
+			media: map[uuid.UUID]domain.Media{mediaID: {ID: mediaID, Title: "Book", Type: domain.MediaTypeBook}},
+		}
+		svc := domain.NewMediaLibrary(repo)
+
+		_, err := svc.LinkLogs(ctx, mediaID, []string{" "})
+
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("returns not found for unknown media", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(&mockMediaLibraryRepository{})
+
+		_, err := svc.LinkLogs(ctx, uuid.New(), []string{"kiki"})
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestMediaLibrary_Search(t *testing.T) {
+	t.Run("normalizes isbn queries and caps the limit", func(t *testing.T) {
+		repo := &mockMediaLibraryRepository{}
+		svc := domain.NewMediaLibrary(repo)
+
+		_, err := svc.Search(ctxWithUser(), &domain.MediaSearchRequest{Query: "978-4-04-102622-9", Limit: 500})
+
+		require.NoError(t, err)
+		assert.Equal(t, "9784041026229", repo.searchedWith.Query)
+		assert.Equal(t, int32(50), repo.searchedWith.Limit)
+	})
+
+	t.Run("rejects empty queries", func(t *testing.T) {
+		svc := domain.NewMediaLibrary(&mockMediaLibraryRepository{})
+
+		_, err := svc.Search(ctxWithUser(), &domain.MediaSearchRequest{Query: "  "})
+
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+}
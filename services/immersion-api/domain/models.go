@@ -12,10 +12,15 @@ type Language struct {
 }
 
 type Activity struct {
-	ID        int32
+	ID int32
+	// Key is derived from the name when the activity is created and never
+	// changes, it prefixes the stable keys of the activity's units.
+	Key       string
 	Name      string
 	Default   bool
 	InputType ActivityInputType
+	// DurationScorePerMinute is the interim score of duration-only tracking.
+	DurationScorePerMinute float32
 }
 
 type ContestView struct {
@@ -31,6 +36,10 @@ type ContestView struct {
 	Private              bool
 	AllowedLanguages     []Language
 	AllowedActivities    []Activity
+	TeamMode             bool
+	TeamSizeLimit        *int32
+	TeamScoreAggregation ContestTeamScoreAggregation
+	TemplateID           *uuid.UUID
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	Deleted              bool
@@ -49,6 +58,7 @@ type Contest struct {
 	Private                 bool
 	LanguageCodeAllowList   []string
 	ActivityTypeIDAllowList []int32
+	TeamMode                bool
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
 	Deleted                 bool
@@ -93,6 +103,21 @@ type LeaderboardEntry struct {
 	IsTie           bool
 }
 
+type TeamLeaderboard struct {
+	Entries       []TeamLeaderboardEntry
+	TotalSize     int
+	NextPageToken string
+}
+
+type TeamLeaderboardEntry struct {
+	Rank        int
+	TeamID      uuid.UUID
+	TeamName    string
+	MemberCount int
+	Score       float32
+	IsTie       bool
+}
+
 type Score struct {
 	LanguageCode string
 	LanguageName *string
@@ -129,6 +154,12 @@ type Unit struct {
 	Name          string
 	Modifier      float32
 	LanguageCode  *string
+	// DeprecatedAt is set for units that are still referenced by logs but can
+	// no longer be logged.
+	DeprecatedAt *time.Time
+	// MediaLengthUnit is the length unit of media that logs in the unit count
+	// towards, nil when they don't count towards any.
+	MediaLengthUnit *MediaLengthUnit
 }
 
 type Tag struct {
@@ -141,6 +172,7 @@ type Log struct {
 	UserID                      uuid.UUID
 	UserDisplayName             *string
 	Description                 *string
+	MediaID                     *uuid.UUID
 	LanguageCode                string
 	LanguageName                string
 	ActivityID                  int
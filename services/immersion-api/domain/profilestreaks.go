@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// ActivityDay is a local calendar date on which a user logged in a language.
+type ActivityDay struct {
+	LanguageCode string
+	LanguageName string
+	Date         time.Time
+}
+
+type ProfileStreaksRepository interface {
+	UserSettingsRepository
+	ActivityDaysForUser(ctx context.Context, userID uuid.UUID, timezone string) ([]ActivityDay, error)
+}
+
+type ProfileStreaksRequest struct {
+	UserID uuid.UUID
+}
+
+type LanguageStreak struct {
+	LanguageCode string
+	LanguageName string
+	Streak       Streak
+}
+
+type ProfileStreaksResponse struct {
+	// Today is the current local date of the user.
+	Today     time.Time
+	GraceDays int
+	Overall   Streak
+	Languages []LanguageStreak
+}
+
+type ProfileStreaks struct {
+	repo  ProfileStreaksRepository
+	clock commondomain.Clock
+}
+
+func NewProfileStreaks(repo ProfileStreaksRepository, clock commondomain.Clock) *ProfileStreaks {
+	return &ProfileStreaks{repo: repo, clock: clock}
+}
+
+func (s *ProfileStreaks) Execute(ctx context.Context, req *ProfileStreaksRequest) (*ProfileStreaksResponse, error) {
+	settings, err := findUserSettingsOrDefault(ctx, s.repo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := settings.Location()
+
+	days, err := s.repo.ActivityDaysForUser(ctx, req.UserID, loc.String())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch activity days: %w", err)
+	}
+
+	today := LocalDate(s.clock.Now(), loc)
+	res := &ProfileStreaksResponse{
+		Today:     today,
+		GraceDays: settings.StreakGraceDays,
+		Languages: []LanguageStreak{},
+	}
+
+	overall := make([]time.Time, 0, len(days))
+	byLanguage := map[string][]time.Time{}
+	for _, day := range days {
+		overall = append(overall, day.Date)
+		if _, ok := byLanguage[day.LanguageCode]; !ok {
+			res.Languages = append(res.Languages, LanguageStreak{
+				LanguageCode: day.LanguageCode,
+				LanguageName: day.LanguageName,
+			})
+		}
+		byLanguage[day.LanguageCode] = append(byLanguage[day.LanguageCode], day.Date)
+	}
+
+	res.Overall = ComputeStreak(overall, today, settings.StreakGraceDays)
+	for i := range res.Languages {
+		streak := &res.Languages[i]
+		streak.Streak = ComputeStreak(byLanguage[streak.LanguageCode], today, settings.StreakGraceDays)
+	}
+	return res, nil
+}
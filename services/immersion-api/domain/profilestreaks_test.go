@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockProfileStreaksRepository struct {
+	settings *domain.UserSettings
+	days     []domain.ActivityDay
+	timezone string
+}
+
+func (m *mockProfileStreaksRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	if m.settings == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.settings, nil
+}
+
+func (m *mockProfileStreaksRepository) ActivityDaysForUser(_ context.Context, _ uuid.UUID, timezone string) ([]domain.ActivityDay, error) {
+	m.timezone = timezone
+	return m.days, nil
+}
+
+func TestProfileStreaks_Execute(t *testing.T) {
+	userID := uuid.New()
+	// Already March 20 in Tokyo
+	now := time.Date(2026, time.March, 19, 23, 30, 0, 0, time.UTC)
+	day := func(language string, d int) domain.ActivityDay {
+		return domain.ActivityDay{LanguageCode: language, LanguageName: language, Date: date(2026, time.March, d)}
+	}
+	days := []domain.ActivityDay{
+		day("jpa", 17), day("kor", 17),
+		day("jpa", 18),
+		day("kor", 19),
+		day("jpa", 20),
+	}
+
+	t.Run("uses UTC without settings", func(t *testing.T) {
+		repo := &mockProfileStreaksRepository{days: days}
+		svc := domain.NewProfileStreaks(repo, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(context.Background(), &domain.ProfileStreaksRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", repo.timezone)
+		assert.Equal(t, date(2026, time.March, 19), res.Today)
+		assert.Equal(t, 4, res.Overall.Current)
+	})
+
+	t.Run("computes streaks per language in the user's timezone", func(t *testing.T) {
+		repo := &mockProfileStreaksRepository{
+			settings: &domain.UserSettings{UserID: userID, Timezone: "Asia/Tokyo", StreakGraceDays: 1},
+			days:     days,
+		}
+		svc := domain.NewProfileStreaks(repo, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(context.Background(), &domain.ProfileStreaksRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", repo.timezone)
+		assert.Equal(t, date(2026, time.March, 20), res.Today)
+		assert.Equal(t, 1, res.GraceDays)
+		assert.Equal(t, 4, res.Overall.Current)
+
+		require.Len(t, res.Languages, 2)
+		assert.Equal(t, "jpa", res.Languages[0].LanguageCode)
+		assert.Equal(t, 3, res.Languages[0].Streak.Current)
+		assert.Equal(t, "kor", res.Languages[1].LanguageCode)
+		assert.Equal(t, 2, res.Languages[1].Streak.Current)
+	})
+}
@@ -3,19 +3,33 @@ package domain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type ProfileYearlyActivityRepository interface {
+	UserSettingsRepository
 	YearlyActivityForUser(context.Context, *ProfileYearlyActivityRequest) ([]UserActivityScore, error)
 }
 
 type ProfileYearlyActivityRequest struct {
 	UserID uuid.UUID
 	Year   int
+
+	// set by the service from the user's settings
+	timezone string
+	from     time.Time
+	to       time.Time
 }
 
+// Timezone is the IANA time zone days are bucketed in.
+func (r *ProfileYearlyActivityRequest) Timezone() string { return r.timezone }
+
+// From and To bound the local year in UTC.
+func (r *ProfileYearlyActivityRequest) From() time.Time { return r.from }
+func (r *ProfileYearlyActivityRequest) To() time.Time   { return r.to }
+
 type ProfileYearlyActivityResponse struct {
 	Scores       []UserActivityScore
 	TotalUpdates int
@@ -30,6 +44,15 @@ func NewProfileYearlyActivity(repo ProfileYearlyActivityRepository) *ProfileYear
 }
 
 func (s *ProfileYearlyActivity) Execute(ctx context.Context, req *ProfileYearlyActivityRequest) (*ProfileYearlyActivityResponse, error) {
+	settings, err := findUserSettingsOrDefault(ctx, s.repo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := settings.Location()
+	req.timezone = loc.String()
+	req.from = time.Date(req.Year, time.January, 1, 0, 0, 0, 0, loc).UTC()
+	req.to = time.Date(req.Year+1, time.January, 1, 0, 0, 0, 0, loc).UTC()
+
 	scores, err := s.repo.YearlyActivityForUser(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch activity summary: %w", err)
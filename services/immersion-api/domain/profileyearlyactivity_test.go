@@ -13,10 +13,18 @@ import (
 
 type profileYearlyActivityRepositoryMock struct {
 	scores          []domain.UserActivityScore
+	settings        *domain.UserSettings
 	err             error
 	capturedRequest *domain.ProfileYearlyActivityRequest
 }
 
+func (m *profileYearlyActivityRepositoryMock) FindUserSettings(ctx context.Context, userID uuid.UUID) (*domain.UserSettings, error) {
+	if m.settings == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.settings, nil
+}
+
 func (m *profileYearlyActivityRepositoryMock) YearlyActivityForUser(ctx context.Context, req *domain.ProfileYearlyActivityRequest) ([]domain.UserActivityScore, error) {
 	m.capturedRequest = req
 	return m.scores, m.err
@@ -89,6 +97,26 @@ func TestProfileYearlyActivity_Execute(t *testing.T) {
 			assert.NotNil(t, repo.capturedRequest)
 			assert.Equal(t, userID, repo.capturedRequest.UserID)
 			assert.Equal(t, 2024, repo.capturedRequest.Year)
+			assert.Equal(t, "UTC", repo.capturedRequest.Timezone())
+			assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), repo.capturedRequest.From())
+			assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), repo.capturedRequest.To())
 		})
 	}
+
+	t.Run("bounds the year in the user's timezone", func(t *testing.T) {
+		repo := &profileYearlyActivityRepositoryMock{
+			settings: &domain.UserSettings{UserID: userID, Timezone: "Asia/Tokyo"},
+		}
+		service := domain.NewProfileYearlyActivity(repo)
+
+		_, err := service.Execute(context.Background(), &domain.ProfileYearlyActivityRequest{
+			UserID: userID,
+			Year:   2024,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", repo.capturedRequest.Timezone())
+		assert.Equal(t, time.Date(2023, 12, 31, 15, 0, 0, 0, time.UTC), repo.capturedRequest.From())
+		assert.Equal(t, time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC), repo.capturedRequest.To())
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	commondomain "github.com/tadoku/tadoku/services/common/domain"
@@ -21,11 +22,16 @@ type RegistrationUpsertRequest struct {
 	ContestID     uuid.UUID
 	LanguageCodes []string
 
+	// InviteCode grants access to a private contest the user was not invited
+	// to yet.
+	InviteCode string
+
 	// Set by domain layer (unexported: only domain can write, others read via getters)
 	id              uuid.UUID
 	userID          uuid.UUID
 	officialContest bool
 	year            int16
+	inviteRedeem    *ContestInviteRedeemRequest
 }
 
 func (r *RegistrationUpsertRequest) ID() uuid.UUID         { return r.id }
@@ -33,6 +39,12 @@ func (r *RegistrationUpsertRequest) UserID() uuid.UUID     { return r.userID }
 func (r *RegistrationUpsertRequest) OfficialContest() bool { return r.officialContest }
 func (r *RegistrationUpsertRequest) Year() int16           { return r.year }
 
+// InviteRedeem is the invite code to redeem in the same transaction as the
+// registration, if the user joins a private contest with one.
+func (r *RegistrationUpsertRequest) InviteRedeem() *ContestInviteRedeemRequest {
+	return r.inviteRedeem
+}
+
 type DetachContestLogsForLanguagesRequest struct {
 	ContestID       uuid.UUID
 	UserID          uuid.UUID
@@ -43,15 +55,21 @@ type DetachContestLogsForLanguagesRequest struct {
 
 type RegistrationUpsert struct {
 	repo       RegistrationUpsertRepository
+	access     ContestAccessClient
+	clock      commondomain.Clock
 	userUpsert *UserUpsert
 }
 
 func NewRegistrationUpsert(
 	repo RegistrationUpsertRepository,
+	access ContestAccessClient,
+	clock commondomain.Clock,
 	userUpsert *UserUpsert,
 ) *RegistrationUpsert {
 	return &RegistrationUpsert{
 		repo:       repo,
+		access:     access,
+		clock:      clock,
 		userUpsert: userUpsert,
 	}
 }
@@ -115,6 +133,13 @@ func (s *RegistrationUpsert) Execute(ctx context.Context, req *RegistrationUpser
 		return err
 	}
 
+	// private contests need an invitation or an invite code to join
+	if registration == nil && contest.Private {
+		if err := s.requireContestAccess(ctx, contest, req); err != nil {
+			return err
+		}
+	}
+
 	// detach logs for any removed languages
 	if registration != nil {
 		req.id = registration.ID
@@ -152,5 +177,42 @@ func (s *RegistrationUpsert) Execute(ctx context.Context, req *RegistrationUpser
 		return err
 	}
 
+	// Access is only granted once the registration and the redeemed code
+	// are committed, so a failed registration does not leave an invitee.
+	if req.inviteRedeem != nil {
+		if err := s.access.AddContestInvitee(ctx, contest.ID, req.userID); err != nil {
+			return fmt.Errorf("could not add contest invitee: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *RegistrationUpsert) requireContestAccess(ctx context.Context, contest *ContestView, req *RegistrationUpsertRequest) error {
+	if contest.OwnerUserID == req.userID || isAdmin(ctx) {
+		return nil
+	}
+
+	allowed, err := s.access.CanRegisterForContest(ctx, contest.ID, req.userID)
+	if err != nil {
+		return fmt.Errorf("could not check contest access: %w", err)
+	}
+	if allowed {
+		return nil
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(req.InviteCode))
+	if code == "" {
+		return fmt.Errorf("user is not invited to private contest: %w", ErrForbidden)
+	}
+
+	// The code is redeemed together with the registration
+	req.inviteRedeem = &ContestInviteRedeemRequest{
+		ContestID: contest.ID,
+		UserID:    req.userID,
+		Code:      code,
+		Now:       s.clock.Now(),
+	}
+
 	return nil
 }
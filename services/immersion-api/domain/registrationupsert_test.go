@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
 	"github.com/tadoku/tadoku/services/immersion-api/domain"
 )
 
@@ -27,6 +28,9 @@ type mockRegistrationUpsertRepository struct {
 	languageExists    map[string]bool
 	languageExistsErr error
 	languageBatches   [][]string
+
+	redeemed  *domain.ContestInviteRedeemRequest
+	redeemErr error
 }
 
 func (m *mockRegistrationUpsertRepository) FindContestByID(ctx context.Context, req *domain.ContestFindRequest) (*domain.ContestView, error) {
@@ -38,6 +42,13 @@ func (m *mockRegistrationUpsertRepository) FindRegistrationForUser(ctx context.C
 }
 
 func (m *mockRegistrationUpsertRepository) UpsertContestRegistration(ctx context.Context, req *domain.RegistrationUpsertRequest) error {
+	// The code is redeemed in the same transaction as the registration
+	if redeem := req.InviteRedeem(); redeem != nil {
+		m.redeemed = redeem
+		if m.redeemErr != nil {
+			return m.redeemErr
+		}
+	}
 	m.upsertCalled = true
 	m.upsertCalledWith = req
 	return m.upsertErr
@@ -92,7 +103,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 		userRepo := &mockUserUpsertRepositoryForReg{}
 		userUpsert := domain.NewUserUpsert(userRepo)
 		repo := &mockRegistrationUpsertRepository{}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithGuest()
 
@@ -109,7 +120,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 		userRepo := &mockUserUpsertRepositoryForReg{}
 		userUpsert := domain.NewUserUpsert(userRepo)
 		repo := &mockRegistrationUpsertRepository{}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		err := svc.Execute(context.Background(), &domain.RegistrationUpsertRequest{
 			ContestID:     contestID,
@@ -127,7 +138,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:    validContest,
 			findRegErr: domain.ErrNotFound,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -147,7 +158,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:    validContest,
 			findRegErr: domain.ErrNotFound,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -176,7 +187,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:    contestWithAllowList,
 			findRegErr: domain.ErrNotFound,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -206,7 +217,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:      validContest,
 			registration: existingRegistration,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -238,7 +249,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:      validContest,
 			registration: existingRegistration,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -259,7 +270,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:    validContest,
 			findRegErr: domain.ErrNotFound,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -290,7 +301,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			contest:      validContest,
 			registration: existingRegistration,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		ctx := ctxWithUserSubject(userID.String())
 
@@ -312,7 +323,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			findRegErr:     domain.ErrNotFound,
 			languageExists: map[string]bool{"jpn": true, "kor": true},
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
 			ContestID:     contestID,
@@ -332,7 +343,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			findRegErr:     domain.ErrNotFound,
 			languageExists: map[string]bool{"invalid": false},
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
 			ContestID:     contestID,
@@ -352,7 +363,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			findRegErr:     domain.ErrNotFound,
 			languageExists: map[string]bool{"jpn": true, "invalid": false},
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
 			ContestID:     contestID,
@@ -373,7 +384,7 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 			findRegErr:        domain.ErrNotFound,
 			languageExistsErr: lookupErr,
 		}
-		svc := domain.NewRegistrationUpsert(repo, userUpsert)
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
 
 		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
 			ContestID:     contestID,
@@ -384,4 +395,141 @@ func TestRegistrationUpsert_Execute(t *testing.T) {
 		assert.Equal(t, [][]string{{"jpn"}}, repo.languageBatches)
 		assert.False(t, repo.upsertCalled)
 	})
+
+	privateContest := &domain.ContestView{
+		ID:               contestID,
+		ContestStart:     now.Add(-time.Hour),
+		ContestEnd:       now.Add(time.Hour * 24),
+		RegistrationEnd:  now.Add(time.Hour * 12),
+		Title:            "Private Contest",
+		Private:          true,
+		OwnerUserID:      uuid.New(),
+		AllowedLanguages: []domain.Language{},
+	}
+
+	t.Run("allows invited users to join a private contest", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		repo := &mockRegistrationUpsertRepository{
+			contest:    privateContest,
+			findRegErr: domain.ErrNotFound,
+		}
+		access := &mockContestAccessClient{allowed: true}
+		svc := domain.NewRegistrationUpsert(repo, access, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn"},
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, repo.redeemed)
+		assert.Empty(t, access.added)
+		assert.True(t, repo.upsertCalled)
+	})
+
+	t.Run("forbids uninvited users without an invite code", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		repo := &mockRegistrationUpsertRepository{
+			contest:    privateContest,
+			findRegErr: domain.ErrNotFound,
+		}
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn"},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.False(t, repo.upsertCalled)
+	})
+
+	t.Run("redeems an invite code for uninvited users", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		repo := &mockRegistrationUpsertRepository{
+			contest:    privateContest,
+			findRegErr: domain.ErrNotFound,
+		}
+		access := &mockContestAccessClient{}
+		svc := domain.NewRegistrationUpsert(repo, access, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn"},
+			InviteCode:    " k7mx2qh9pa ",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.redeemed)
+		assert.Equal(t, "K7MX2QH9PA", repo.redeemed.Code)
+		assert.Equal(t, userID, repo.redeemed.UserID)
+		assert.Equal(t, []uuid.UUID{userID}, access.added)
+		assert.True(t, repo.upsertCalled)
+	})
+
+	t.Run("rejects an unusable invite code", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		repo := &mockRegistrationUpsertRepository{
+			contest:    privateContest,
+			findRegErr: domain.ErrNotFound,
+			redeemErr:  domain.ErrInvalidContestInvite,
+		}
+		access := &mockContestAccessClient{}
+		svc := domain.NewRegistrationUpsert(repo, access, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn"},
+			InviteCode:    "EXPIRED123",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidContestInvite)
+		assert.Empty(t, access.added)
+		assert.False(t, repo.upsertCalled)
+	})
+
+	t.Run("does not add an invitee when the registration fails", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		upsertErr := errors.New("connection reset")
+		repo := &mockRegistrationUpsertRepository{
+			contest:    privateContest,
+			findRegErr: domain.ErrNotFound,
+			upsertErr:  upsertErr,
+		}
+		access := &mockContestAccessClient{}
+		svc := domain.NewRegistrationUpsert(repo, access, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn"},
+			InviteCode:    "K7MX2QH9PA",
+		})
+
+		assert.ErrorIs(t, err, upsertErr)
+		require.NotNil(t, repo.upsertCalledWith)
+		assert.Equal(t, "K7MX2QH9PA", repo.upsertCalledWith.InviteRedeem().Code)
+		assert.Empty(t, access.added)
+	})
+
+	t.Run("lets existing participants of a private contest change languages", func(t *testing.T) {
+		userUpsert := domain.NewUserUpsert(&mockUserUpsertRepositoryForReg{})
+		repo := &mockRegistrationUpsertRepository{
+			contest: privateContest,
+			registration: &domain.ContestRegistration{
+				ID:        uuid.New(),
+				ContestID: contestID,
+				UserID:    userID,
+				Languages: []domain.Language{{Code: "jpn", Name: "Japanese"}},
+			},
+		}
+		svc := domain.NewRegistrationUpsert(repo, &mockContestAccessClient{}, commondomain.NewMockClock(now), userUpsert)
+
+		err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.RegistrationUpsertRequest{
+			ContestID:     contestID,
+			LanguageCodes: []string{"jpn", "kor"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, repo.upsertCalled)
+	})
 }
@@ -15,6 +15,8 @@ type ScorePreviewRepository interface {
 	FindUnitForTrackingByKey(context.Context, *UnitFindForTrackingByKeyRequest) (*Unit, error)
 	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
 	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+	FindUserSettings(context.Context, uuid.UUID) (*UserSettings, error)
 }
 
 type ScorePreviewRequest struct {
@@ -92,10 +94,16 @@ func (s *ScorePreview) Execute(ctx context.Context, req *ScorePreviewRequest) (*
 		req.UnitKey,
 		req.Amount,
 		req.DurationSeconds,
+		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	location, err := findUserLocation(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage := scoringUsageScope{userID: userID}
 	input := ScoringInput{
 		ActivityID:      req.ActivityID,
 		UnitKey:         tracking.UnitKey,
@@ -103,6 +111,9 @@ func (s *ScorePreview) Execute(ctx context.Context, req *ScorePreviewRequest) (*
 		Tags:            req.Tags,
 		Amount:          req.Amount,
 		DurationSeconds: req.DurationSeconds,
+		LoggedAt:        s.clock.Now(),
+		Location:        location,
+		Usage:           usage.lookup(ctx, s.repo, nil),
 	}
 	platformResult, err := EvaluateActivePlatformScore(ctx, s.repo, input)
 	if err != nil {
@@ -119,6 +130,7 @@ func (s *ScorePreview) Execute(ctx context.Context, req *ScorePreviewRequest) (*
 		contestTracking, scoringErr := resolveContestLogTracking(
 			ctx,
 			s.repo,
+			usage,
 			registration.ContestID,
 			registrationID,
 			tracking,
@@ -199,6 +211,9 @@ func scoreEstimateFromTracking(tracking LogTracking) ScoreEstimate {
 			RuleID: ruleID,
 			Rate:   tracking.ScoreProvenance.Rates[i],
 		}
+		if i < len(tracking.ScoreProvenance.Values) {
+			estimate.Rules[i].Value = tracking.ScoreProvenance.Values[i]
+		}
 	}
 	return estimate
 }
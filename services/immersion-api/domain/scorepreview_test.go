@@ -47,6 +47,14 @@ func (m *mockScorePreviewRepository) FindActivePlatformScoringRuleSet(context.Co
 	return m.platformRuleSet, nil
 }
 
+func (m *mockScorePreviewRepository) FindScoringRuleUsage(context.Context, *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	return &domain.ScoringRuleUsage{}, nil
+}
+
+func (m *mockScorePreviewRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	return nil, domain.ErrNotFound
+}
+
 func (m *mockScorePreviewRepository) FindContestScoringRuleSets(_ context.Context, contestID uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
 	return m.contestRuleSets[contestID], m.fallbacks[contestID], nil
 }
@@ -112,13 +120,13 @@ func TestScorePreviewExecute(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, float32(200), result.Platform.Score)
 	assert.Equal(t, &platformRuleSetID, result.Platform.RuleSetID)
-	assert.Equal(t, []domain.AppliedScoringRule{{RuleID: platformRuleID, Rate: 2}}, result.Platform.Rules)
+	assert.Equal(t, []domain.AppliedScoringRule{{RuleID: platformRuleID, Rate: 2, Value: 100}}, result.Platform.Rules)
 	require.Len(t, result.Contests, 1)
 	assert.Equal(t, registrationID, result.Contests[0].RegistrationID)
 	assert.Equal(t, contestID, result.Contests[0].ContestID)
 	assert.Equal(t, float32(50), result.Contests[0].Estimate.Score)
 	assert.Equal(t, &contestRuleSetID, result.Contests[0].Estimate.RuleSetID)
-	assert.Equal(t, []domain.AppliedScoringRule{{RuleID: contestRuleID, Rate: 0.5}}, result.Contests[0].Estimate.Rules)
+	assert.Equal(t, []domain.AppliedScoringRule{{RuleID: contestRuleID, Rate: 0.5, Value: 100}}, result.Contests[0].Estimate.Rules)
 }
 
 func TestScorePreviewExecuteReturnsZeroForUncoveredInput(t *testing.T) {
@@ -35,8 +35,30 @@ type ScoringRuleSetStatus string
 const (
 	ScoringRuleSetStatusDraft     ScoringRuleSetStatus = "draft"
 	ScoringRuleSetStatusPublished ScoringRuleSetStatus = "published"
+	// ScoringRuleSetStatusArchived hides a published rule set from listings,
+	// scores that reference it still resolve it.
+	ScoringRuleSetStatusArchived ScoringRuleSetStatus = "archived"
 )
 
+type ScoringTagMatch string
+
+const (
+	ScoringTagMatchAny ScoringTagMatch = "any"
+	ScoringTagMatchAll ScoringTagMatch = "all"
+)
+
+// ScoringCapPeriod is the period over which caps count a user's logs. Periods
+// follow the user's time zone, weeks start on Monday.
+type ScoringCapPeriod string
+
+const (
+	ScoringCapPeriodDay  ScoringCapPeriod = "day"
+	ScoringCapPeriodWeek ScoringCapPeriod = "week"
+)
+
+// maxScoringRuleTags bounds how many tags a single rule can match on.
+const maxScoringRuleTags = 10
+
 type ScoringRule struct {
 	ID           uuid.UUID
 	Priority     int32
@@ -45,8 +67,31 @@ type ScoringRule struct {
 	UnitKey      string
 	LanguageCode string
 	Tag          string
-	ScoreSource  ScoreSource
-	Rate         float32
+	// Tags are matched according to TagMatch, any of them by default.
+	Tags     []string
+	TagMatch ScoringTagMatch
+	// MinValue and MaxValue bound the scoreable value of the log, its amount
+	// or its duration in minutes depending on the score source.
+	MinValue *float32
+	MaxValue *float32
+	// EffectiveFrom and EffectiveUntil bound when the log was made, until is
+	// exclusive.
+	EffectiveFrom  *time.Time
+	EffectiveUntil *time.Time
+	ScoreSource    ScoreSource
+	Rate           float32
+	// CapPeriod is required when the rule has a cap.
+	CapPeriod ScoringCapPeriod
+	// CapValue limits the value a base rule scores per period, the rest of
+	// the value is left to the next matching base rule.
+	CapValue *float32
+	// CapScore limits the score of the logs the rule applies to per period.
+	CapScore *float32
+}
+
+// HasCap reports whether evaluating the rule depends on the user's other logs.
+func (r ScoringRule) HasCap() bool {
+	return r.CapValue != nil || r.CapScore != nil
 }
 
 type ScoringRuleSet struct {
@@ -61,6 +106,7 @@ type ScoringRuleSet struct {
 	Rules             []ScoringRule
 	CreatedAt         time.Time
 	PublishedAt       *time.Time
+	ArchivedAt        *time.Time
 }
 
 type ScoringInput struct {
@@ -70,11 +116,48 @@ type ScoringInput struct {
 	Tags            []string
 	Amount          *float32
 	DurationSeconds *int32
+	// LoggedAt is matched against the effective window of rules and decides
+	// the period of capped rules.
+	LoggedAt time.Time
+	// Location is the user's time zone, cap periods start at midnight in it.
+	// Periods start in UTC without it.
+	Location *time.Location
+	// Usage looks up what the user's earlier logs counted towards capped
+	// rules. Without it caps only limit the log itself.
+	Usage ScoringUsageLookup
+}
+
+// ScoringRuleUsage is the value and score of the logs a rule applied to.
+type ScoringRuleUsage struct {
+	Value float32
+	Score float32
+}
+
+// ScoringUsageLookup returns the usage of a rule by the user's logs made from
+// the start of the rule's period until the scored log.
+type ScoringUsageLookup func(rule ScoringRule, from, until time.Time) (ScoringRuleUsage, error)
+
+// Start returns the start of the period containing t, days start at midnight
+// in loc and weeks on Monday. The start is returned in UTC like log times.
+func (p ScoringCapPeriod) Start(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	if p == ScoringCapPeriodWeek {
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day.UTC()
 }
 
 type AppliedScoringRule struct {
 	RuleID uuid.UUID
 	Rate   float32
+	// Value is the part of the log's value the rule scored, it counts
+	// towards the rule's value cap. Stackable rules multiplied the value of
+	// every base rule.
+	Value float32
 }
 
 type ScoringResult struct {
@@ -87,6 +170,9 @@ type ScoringResult struct {
 
 // EvaluateScoringRuleSet applies the first matching base rule in ascending
 // priority order, then multiplies the rates of every matching stackable rule.
+// Once a base rule's value cap is used up, the rest of the value is scored by
+// the next matching base rule. Score caps of the applied rules limit the
+// final score.
 func EvaluateScoringRuleSet(input ScoringInput, ruleSet ScoringRuleSet) (ScoringResult, error) {
 	source, scoreableValue, err := scoringSourceAndValue(input)
 	if err != nil {
@@ -110,35 +196,80 @@ func EvaluateScoringRuleSet(input ScoringInput, ruleSet ScoringRuleSet) (Scoring
 		tags[tag] = struct{}{}
 	}
 
-	var baseRule *ScoringRule
+	baseRules := make([]ScoringRule, 0)
 	modifierRules := make([]ScoringRule, 0)
 	for _, rule := range rules {
-		if !scoringRuleMatches(rule, input, source, tags) {
+		if !scoringRuleMatches(rule, input, source, scoreableValue, tags) {
 			continue
 		}
 
 		if rule.Stackable {
 			modifierRules = append(modifierRules, rule)
-		} else if baseRule == nil {
-			baseRule = &rule
+		} else {
+			baseRules = append(baseRules, rule)
 		}
 	}
 
-	if baseRule == nil {
+	if len(baseRules) == 0 {
 		result.Score = 0
 		return result, nil
 	}
 
-	result.Score *= baseRule.Rate
-	result.AppliedRules = append(result.AppliedRules, AppliedScoringRule{
-		RuleID: baseRule.ID,
-		Rate:   baseRule.Rate,
-	})
+	usage := scoringUsage{input: input}
+	appliedRules := make([]ScoringRule, 0, len(baseRules)+len(modifierRules))
+	appliedValues := make([]float32, 0, len(baseRules)+len(modifierRules))
+	score := float32(0)
+	remaining := scoreableValue
+	for _, rule := range baseRules {
+		portion := remaining
+		if rule.CapValue != nil {
+			used, err := usage.find(rule)
+			if err != nil {
+				return ScoringResult{}, err
+			}
+			portion = min(portion, max(*rule.CapValue-used.Value, 0))
+		}
+		if portion <= 0 {
+			continue
+		}
+		score += portion * rule.Rate
+		remaining -= portion
+		appliedRules = append(appliedRules, rule)
+		appliedValues = append(appliedValues, portion)
+		if remaining <= 0 {
+			break
+		}
+	}
+	if len(appliedRules) == 0 {
+		// Every matching base rule is capped, the log is attributed to the
+		// first one without scoring anything.
+		appliedRules = append(appliedRules, baseRules[0])
+		appliedValues = append(appliedValues, 0)
+	}
+
 	for _, rule := range modifierRules {
-		result.Score *= rule.Rate
+		score *= rule.Rate
+		appliedRules = append(appliedRules, rule)
+		appliedValues = append(appliedValues, scoreableValue-remaining)
+	}
+
+	for _, rule := range appliedRules {
+		if rule.CapScore == nil {
+			continue
+		}
+		used, err := usage.find(rule)
+		if err != nil {
+			return ScoringResult{}, err
+		}
+		score = min(score, max(*rule.CapScore-used.Score, 0))
+	}
+
+	result.Score = score
+	for i, rule := range appliedRules {
 		result.AppliedRules = append(result.AppliedRules, AppliedScoringRule{
 			RuleID: rule.ID,
 			Rate:   rule.Rate,
+			Value:  appliedValues[i],
 		})
 	}
 
@@ -148,6 +279,30 @@ func EvaluateScoringRuleSet(input ScoringInput, ruleSet ScoringRuleSet) (Scoring
 	return result, nil
 }
 
+// scoringUsage looks up the usage of each capped rule at most once.
+type scoringUsage struct {
+	input ScoringInput
+	found map[uuid.UUID]ScoringRuleUsage
+}
+
+func (u *scoringUsage) find(rule ScoringRule) (ScoringRuleUsage, error) {
+	if u.input.Usage == nil {
+		return ScoringRuleUsage{}, nil
+	}
+	if used, ok := u.found[rule.ID]; ok {
+		return used, nil
+	}
+	used, err := u.input.Usage(rule, rule.CapPeriod.Start(u.input.LoggedAt, u.input.Location), u.input.LoggedAt)
+	if err != nil {
+		return ScoringRuleUsage{}, fmt.Errorf("could not find usage of scoring rule %s: %w", rule.ID, err)
+	}
+	if u.found == nil {
+		u.found = map[uuid.UUID]ScoringRuleUsage{}
+	}
+	u.found[rule.ID] = used
+	return used, nil
+}
+
 // EvaluateContestScore evaluates a contest rule set according to its mode.
 // Override sets fall back to their pinned platform set; replace sets do not.
 func EvaluateContestScore(
@@ -212,6 +367,64 @@ func validateScoringRules(rules []ScoringRule) error {
 		if !isFiniteFloat32(rule.Rate) || rule.Rate < 0 {
 			return fmt.Errorf("scoring rule rate must be non-negative and finite: %w", ErrInvalidScoringRuleSet)
 		}
+		if err := validateScoringRuleConditions(rule); err != nil {
+			return err
+		}
+		if err := validateScoringRuleCaps(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateScoringRuleConditions(rule ScoringRule) error {
+	if len(rule.Tags) > maxScoringRuleTags {
+		return fmt.Errorf("scoring rule can match at most %d tags: %w", maxScoringRuleTags, ErrInvalidScoringRuleSet)
+	}
+	switch rule.TagMatch {
+	case "", ScoringTagMatchAny, ScoringTagMatchAll:
+	default:
+		return fmt.Errorf("scoring rule tag match %q is not valid: %w", rule.TagMatch, ErrInvalidScoringRuleSet)
+	}
+	if rule.MinValue != nil && (!isFiniteFloat32(*rule.MinValue) || *rule.MinValue < 0) {
+		return fmt.Errorf("scoring rule minimum value must be non-negative and finite: %w", ErrInvalidScoringRuleSet)
+	}
+	if rule.MaxValue != nil && (!isFiniteFloat32(*rule.MaxValue) || *rule.MaxValue <= 0) {
+		return fmt.Errorf("scoring rule maximum value must be positive and finite: %w", ErrInvalidScoringRuleSet)
+	}
+	if rule.MinValue != nil && rule.MaxValue != nil && *rule.MinValue > *rule.MaxValue {
+		return fmt.Errorf("scoring rule minimum value exceeds its maximum value: %w", ErrInvalidScoringRuleSet)
+	}
+	if rule.EffectiveFrom != nil && rule.EffectiveUntil != nil && !rule.EffectiveUntil.After(*rule.EffectiveFrom) {
+		return fmt.Errorf("scoring rule must be effective until after it is effective from: %w", ErrInvalidScoringRuleSet)
+	}
+	return nil
+}
+
+func validateScoringRuleCaps(rule ScoringRule) error {
+	switch rule.CapPeriod {
+	case "":
+		if rule.HasCap() {
+			return fmt.Errorf("scoring rule caps require a period: %w", ErrInvalidScoringRuleSet)
+		}
+		return nil
+	case ScoringCapPeriodDay, ScoringCapPeriodWeek:
+		if !rule.HasCap() {
+			return fmt.Errorf("scoring rule cap period requires a cap: %w", ErrInvalidScoringRuleSet)
+		}
+	default:
+		return fmt.Errorf("scoring rule cap period %q is not valid: %w", rule.CapPeriod, ErrInvalidScoringRuleSet)
+	}
+	if rule.CapValue != nil {
+		if rule.Stackable {
+			return fmt.Errorf("value caps only apply to base scoring rules: %w", ErrInvalidScoringRuleSet)
+		}
+		if !isFiniteFloat32(*rule.CapValue) || *rule.CapValue <= 0 {
+			return fmt.Errorf("scoring rule value cap must be positive and finite: %w", ErrInvalidScoringRuleSet)
+		}
+	}
+	if rule.CapScore != nil && (!isFiniteFloat32(*rule.CapScore) || *rule.CapScore <= 0) {
+		return fmt.Errorf("scoring rule score cap must be positive and finite: %w", ErrInvalidScoringRuleSet)
 	}
 	return nil
 }
@@ -220,6 +433,7 @@ func scoringRuleMatches(
 	rule ScoringRule,
 	input ScoringInput,
 	source ScoreSource,
+	value float32,
 	tags map[string]struct{},
 ) bool {
 	if rule.ScoreSource != source || rule.ActivityID != input.ActivityID {
@@ -236,9 +450,37 @@ func scoringRuleMatches(
 			return false
 		}
 	}
+	if len(rule.Tags) > 0 && !scoringRuleTagsMatch(rule, tags) {
+		return false
+	}
+	if rule.MinValue != nil && value < *rule.MinValue {
+		return false
+	}
+	if rule.MaxValue != nil && value > *rule.MaxValue {
+		return false
+	}
+	if rule.EffectiveFrom != nil && input.LoggedAt.Before(*rule.EffectiveFrom) {
+		return false
+	}
+	if rule.EffectiveUntil != nil && !input.LoggedAt.Before(*rule.EffectiveUntil) {
+		return false
+	}
 	return true
 }
 
+func scoringRuleTagsMatch(rule ScoringRule, tags map[string]struct{}) bool {
+	for _, tag := range rule.Tags {
+		_, ok := tags[tag]
+		if ok && rule.TagMatch != ScoringTagMatchAll {
+			return true
+		}
+		if !ok && rule.TagMatch == ScoringTagMatchAll {
+			return false
+		}
+	}
+	return rule.TagMatch == ScoringTagMatchAll
+}
+
 func isFiniteFloat32(value float32) bool {
 	return !math.IsNaN(float64(value)) && !math.IsInf(float64(value), 0)
 }
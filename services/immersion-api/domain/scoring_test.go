@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -44,7 +45,7 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, float32(150), result.Score)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: earlierRuleID, Rate: 1.5},
+			{RuleID: earlierRuleID, Rate: 1.5, Value: 100},
 		}, result.AppliedRules)
 	})
 
@@ -94,9 +95,9 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, float32(900), result.Score)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: baseRuleID, Rate: 1.5},
-			{RuleID: earlierModifierID, Rate: 2},
-			{RuleID: laterModifierID, Rate: 3},
+			{RuleID: baseRuleID, Rate: 1.5, Value: 100},
+			{RuleID: earlierModifierID, Rate: 2, Value: 100},
+			{RuleID: laterModifierID, Rate: 3, Value: 100},
 		}, result.AppliedRules)
 	})
 
@@ -173,7 +174,7 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, float32(160), matchingResult.Score)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: specificRuleID, Rate: 1.6},
+			{RuleID: specificRuleID, Rate: 1.6, Value: 100},
 		}, matchingResult.AppliedRules)
 
 		tests := []struct {
@@ -237,7 +238,7 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 					return
 				}
 				assert.Equal(t, []domain.AppliedScoringRule{
-					{RuleID: fallbackRuleID, Rate: 1},
+					{RuleID: fallbackRuleID, Rate: 1, Value: 100},
 				}, result.AppliedRules)
 			})
 		}
@@ -270,7 +271,7 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 		assert.True(t, result.Matched)
 		assert.Equal(t, ruleSetID, *result.AppliedRuleSetID)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: ruleID, Rate: 1},
+			{RuleID: ruleID, Rate: 1, Value: 200},
 		}, result.AppliedRules)
 	})
 
@@ -329,7 +330,7 @@ func TestEvaluateScoringRuleSet(t *testing.T) {
 		assert.Equal(t, float32(5), result.Score)
 		assert.Equal(t, domain.ScoreSourceAmount, result.ScoreSource)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: amountRuleID, Rate: 0.5},
+			{RuleID: amountRuleID, Rate: 0.5, Value: 10},
 		}, result.AppliedRules)
 	})
 
@@ -420,7 +421,7 @@ func TestDenseListeningTagAppliesOnlyToDurationMinutes(t *testing.T) {
 			wantScore:  24,
 			wantSource: domain.ScoreSourceDurationMinutes,
 			wantAppliedRules: []domain.AppliedScoringRule{
-				{RuleID: durationBaseRuleID, Rate: 0.4},
+				{RuleID: durationBaseRuleID, Rate: 0.4, Value: 60},
 			},
 		},
 		{
@@ -433,8 +434,8 @@ func TestDenseListeningTagAppliesOnlyToDurationMinutes(t *testing.T) {
 			wantScore:  36,
 			wantSource: domain.ScoreSourceDurationMinutes,
 			wantAppliedRules: []domain.AppliedScoringRule{
-				{RuleID: durationBaseRuleID, Rate: 0.4},
-				{RuleID: denseTagRuleID, Rate: 1.5},
+				{RuleID: durationBaseRuleID, Rate: 0.4, Value: 60},
+				{RuleID: denseTagRuleID, Rate: 1.5, Value: 60},
 			},
 		},
 		{
@@ -448,8 +449,8 @@ func TestDenseListeningTagAppliesOnlyToDurationMinutes(t *testing.T) {
 			wantScore:  36,
 			wantSource: domain.ScoreSourceAmount,
 			wantAppliedRules: []domain.AppliedScoringRule{
-				{RuleID: amountBaseRuleID, Rate: 0.4},
-				{RuleID: legacyDenseRuleID, Rate: 1.5},
+				{RuleID: amountBaseRuleID, Rate: 0.4, Value: 60},
+				{RuleID: legacyDenseRuleID, Rate: 1.5, Value: 60},
 			},
 		},
 		{
@@ -463,7 +464,7 @@ func TestDenseListeningTagAppliesOnlyToDurationMinutes(t *testing.T) {
 			wantScore:  24,
 			wantSource: domain.ScoreSourceAmount,
 			wantAppliedRules: []domain.AppliedScoringRule{
-				{RuleID: amountBaseRuleID, Rate: 0.4},
+				{RuleID: amountBaseRuleID, Rate: 0.4, Value: 60},
 			},
 		},
 		{
@@ -478,8 +479,8 @@ func TestDenseListeningTagAppliesOnlyToDurationMinutes(t *testing.T) {
 			wantScore:  36,
 			wantSource: domain.ScoreSourceAmount,
 			wantAppliedRules: []domain.AppliedScoringRule{
-				{RuleID: amountBaseRuleID, Rate: 0.4},
-				{RuleID: legacyDenseRuleID, Rate: 1.5},
+				{RuleID: amountBaseRuleID, Rate: 0.4, Value: 60},
+				{RuleID: legacyDenseRuleID, Rate: 1.5, Value: 60},
 			},
 		},
 	}
@@ -669,7 +670,7 @@ func TestEvaluateContestScore(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, float32(100), result.Score)
 		assert.Equal(t, []domain.AppliedScoringRule{
-			{RuleID: platformRuleID, Rate: 1},
+			{RuleID: platformRuleID, Rate: 1, Value: 100},
 		}, result.AppliedRules)
 		assert.Equal(t, platformSet.ID, *result.AppliedRuleSetID)
 	})
@@ -728,3 +729,211 @@ func TestEvaluateContestScore(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrInvalidScoringRuleSet)
 	})
 }
+
+func TestEvaluateScoringRuleSetConditions(t *testing.T) {
+	ptr := func(value float32) *float32 { return &value }
+	amount := float32(100)
+	loggedAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ruleID := uuid.New()
+	evaluate := func(t *testing.T, input domain.ScoringInput, rule domain.ScoringRule) domain.ScoringResult {
+		t.Helper()
+		rule.ID = ruleID
+		rule.Priority = 10
+		rule.ActivityID = 1
+		rule.ScoreSource = domain.ScoreSourceAmount
+		rule.Rate = 2
+		input.ActivityID = 1
+		input.Amount = &amount
+		input.LoggedAt = loggedAt
+		result, err := domain.EvaluateScoringRuleSet(input, domain.ScoringRuleSet{
+			ID:    uuid.New(),
+			Rules: []domain.ScoringRule{rule},
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("matches any of the rule's tags by default", func(t *testing.T) {
+		result := evaluate(t, domain.ScoringInput{Tags: []string{"manga"}}, domain.ScoringRule{
+			Tags: []string{"novel", "manga"},
+		})
+		assert.True(t, result.Matched)
+		assert.Equal(t, float32(200), result.Score)
+	})
+
+	t.Run("requires every tag when matching all", func(t *testing.T) {
+		rule := domain.ScoringRule{
+			Tags:     []string{"novel", "fiction"},
+			TagMatch: domain.ScoringTagMatchAll,
+		}
+		assert.False(t, evaluate(t, domain.ScoringInput{Tags: []string{"novel"}}, rule).Matched)
+		assert.True(t, evaluate(t, domain.ScoringInput{Tags: []string{"fiction", "novel"}}, rule).Matched)
+	})
+
+	t.Run("matches values within the inclusive range", func(t *testing.T) {
+		assert.True(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{MinValue: ptr(100), MaxValue: ptr(100)}).Matched)
+		assert.False(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{MinValue: ptr(101)}).Matched)
+		assert.False(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{MaxValue: ptr(99)}).Matched)
+	})
+
+	t.Run("matches logs made within the effective window", func(t *testing.T) {
+		from := loggedAt
+		until := loggedAt.Add(time.Hour)
+		assert.True(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{EffectiveFrom: &from, EffectiveUntil: &until}).Matched)
+		assert.False(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{EffectiveUntil: &from}).Matched)
+		assert.False(t, evaluate(t, domain.ScoringInput{}, domain.ScoringRule{EffectiveFrom: &until}).Matched)
+	})
+}
+
+func TestEvaluateScoringRuleSetCaps(t *testing.T) {
+	ptr := func(value float32) *float32 { return &value }
+	loggedAt := time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC)
+	bonusRuleID := uuid.New()
+	baseRuleID := uuid.New()
+	modifierRuleID := uuid.New()
+	ruleSet := domain.ScoringRuleSet{
+		ID: uuid.New(),
+		Rules: []domain.ScoringRule{
+			{
+				ID:          bonusRuleID,
+				Priority:    10,
+				ActivityID:  1,
+				ScoreSource: domain.ScoreSourceAmount,
+				Rate:        2,
+				CapPeriod:   domain.ScoringCapPeriodWeek,
+				CapValue:    ptr(50),
+			},
+			{
+				ID:          baseRuleID,
+				Priority:    20,
+				ActivityID:  1,
+				ScoreSource: domain.ScoreSourceAmount,
+				Rate:        1,
+			},
+			{
+				ID:          modifierRuleID,
+				Priority:    30,
+				Stackable:   true,
+				ActivityID:  1,
+				ScoreSource: domain.ScoreSourceAmount,
+				Rate:        1,
+				CapPeriod:   domain.ScoringCapPeriodDay,
+				CapScore:    ptr(100),
+			},
+		},
+	}
+
+	t.Run("scores the rest of a value cap with the next base rule", func(t *testing.T) {
+		amount := float32(40)
+		var requests [][2]time.Time
+		result, err := domain.EvaluateScoringRuleSet(domain.ScoringInput{
+			ActivityID: 1,
+			Amount:     &amount,
+			LoggedAt:   loggedAt,
+			Usage: func(rule domain.ScoringRule, from, until time.Time) (domain.ScoringRuleUsage, error) {
+				requests = append(requests, [2]time.Time{from, until})
+				if rule.ID == bonusRuleID {
+					return domain.ScoringRuleUsage{Value: 30}, nil
+				}
+				return domain.ScoringRuleUsage{}, nil
+			},
+		}, ruleSet)
+
+		require.NoError(t, err)
+		assert.Equal(t, float32(20*2+20), result.Score)
+		assert.Equal(t, []domain.AppliedScoringRule{
+			{RuleID: bonusRuleID, Rate: 2, Value: 20},
+			{RuleID: baseRuleID, Rate: 1, Value: 20},
+			{RuleID: modifierRuleID, Rate: 1, Value: 40},
+		}, result.AppliedRules)
+		assert.Equal(t, [][2]time.Time{
+			{time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), loggedAt},
+			{time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), loggedAt},
+		}, requests)
+	})
+
+	t.Run("limits the score to what is left of a score cap", func(t *testing.T) {
+		amount := float32(40)
+		result, err := domain.EvaluateScoringRuleSet(domain.ScoringInput{
+			ActivityID: 1,
+			Amount:     &amount,
+			LoggedAt:   loggedAt,
+			Usage: func(rule domain.ScoringRule, from, until time.Time) (domain.ScoringRuleUsage, error) {
+				if rule.ID == modifierRuleID {
+					return domain.ScoringRuleUsage{Score: 90}, nil
+				}
+				return domain.ScoringRuleUsage{Value: 50}, nil
+			},
+		}, ruleSet)
+
+		require.NoError(t, err)
+		assert.Equal(t, float32(10), result.Score)
+		assert.Equal(t, []domain.AppliedScoringRule{
+			{RuleID: baseRuleID, Rate: 1, Value: 40},
+			{RuleID: modifierRuleID, Rate: 1, Value: 40},
+		}, result.AppliedRules)
+	})
+
+	t.Run("attributes a fully capped log to its first base rule", func(t *testing.T) {
+		amount := float32(40)
+		result, err := domain.EvaluateScoringRuleSet(domain.ScoringInput{
+			ActivityID: 1,
+			Amount:     &amount,
+			LoggedAt:   loggedAt,
+			Usage: func(domain.ScoringRule, time.Time, time.Time) (domain.ScoringRuleUsage, error) {
+				return domain.ScoringRuleUsage{Value: 50}, nil
+			},
+		}, domain.ScoringRuleSet{ID: uuid.New(), Rules: ruleSet.Rules[:1]})
+
+		require.NoError(t, err)
+		assert.True(t, result.Matched)
+		assert.Equal(t, float32(0), result.Score)
+		assert.Equal(t, []domain.AppliedScoringRule{{RuleID: bonusRuleID, Rate: 2}}, result.AppliedRules)
+	})
+}
+
+func TestScoringCapPeriodStart(t *testing.T) {
+	sunday := time.Date(2026, 3, 15, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), domain.ScoringCapPeriodDay.Start(sunday, nil))
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), domain.ScoringCapPeriodWeek.Start(sunday, nil))
+
+	t.Run("starts periods at midnight in the user's time zone", func(t *testing.T) {
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		// It is already Monday morning in Tokyo.
+		assert.Equal(t, time.Date(2026, 3, 15, 15, 0, 0, 0, time.UTC), domain.ScoringCapPeriodDay.Start(sunday, tokyo))
+		assert.Equal(t, time.Date(2026, 3, 15, 15, 0, 0, 0, time.UTC), domain.ScoringCapPeriodWeek.Start(sunday, tokyo))
+
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 3, 15, 4, 0, 0, 0, time.UTC), domain.ScoringCapPeriodDay.Start(sunday, newYork))
+		assert.Equal(t, time.Date(2026, 3, 9, 4, 0, 0, 0, time.UTC), domain.ScoringCapPeriodWeek.Start(sunday, newYork))
+	})
+}
+
+func TestEvaluateScoringRuleSetRejectsInvalidConditionsAndCaps(t *testing.T) {
+	ptr := func(value float32) *float32 { return &value }
+	from := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	amount := float32(10)
+	for name, rule := range map[string]domain.ScoringRule{
+		"unknown tag match":      {TagMatch: "some"},
+		"inverted value range":   {MinValue: ptr(10), MaxValue: ptr(5)},
+		"empty effective window": {EffectiveFrom: &from, EffectiveUntil: &from},
+		"cap without period":     {CapScore: ptr(10)},
+		"period without cap":     {CapPeriod: domain.ScoringCapPeriodDay},
+		"value cap on stackable": {Stackable: true, CapPeriod: domain.ScoringCapPeriodDay, CapValue: ptr(10)},
+		"non-positive score cap": {CapPeriod: domain.ScoringCapPeriodDay, CapScore: ptr(0)},
+		"unknown cap period":     {CapPeriod: "month", CapScore: ptr(10)},
+		"too many tags":          {Tags: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rule.ActivityID = 1
+			rule.ScoreSource = domain.ScoreSourceAmount
+			rule.Rate = 1
+			_, err := domain.EvaluateScoringRuleSet(domain.ScoringInput{ActivityID: 1, Amount: &amount}, domain.ScoringRuleSet{
+				Rules: []domain.ScoringRule{rule},
+			})
+			assert.ErrorIs(t, err, domain.ErrInvalidScoringRuleSet)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type ScoringRescoreJobStatus string
+
+const (
+	ScoringRescoreJobStatusPending    ScoringRescoreJobStatus = "pending"
+	ScoringRescoreJobStatusRunning    ScoringRescoreJobStatus = "running"
+	ScoringRescoreJobStatusCompleted  ScoringRescoreJobStatus = "completed"
+	ScoringRescoreJobStatusFailed     ScoringRescoreJobStatus = "failed"
+	ScoringRescoreJobStatusSuperseded ScoringRescoreJobStatus = "superseded"
+)
+
+// maxScoringRescoreJobsListed bounds the job history returned by List.
+const maxScoringRescoreJobsListed = 50
+
+// ScoringRescoreJob re-evaluates existing logs against a rule set. Platform
+// jobs rescore every log along with the logs of ongoing contests that copy
+// platform scores, contest jobs only rescore the logs of their contest. Dry
+// runs record the per-user diff without writing any scores, so their caps
+// only count the earlier logs of the same batch.
+type ScoringRescoreJob struct {
+	ID        uuid.UUID
+	Scope     ScoringRuleSetScope
+	ContestID *uuid.UUID
+	RuleSetID uuid.UUID
+	DryRun    bool
+	Status    ScoringRescoreJobStatus
+	// CursorLogID is the last processed log and CursorLoggedAt when it was
+	// made. Logs are walked in the order they were made so caps count the
+	// rescored earlier logs.
+	CursorLogID    *uuid.UUID
+	CursorLoggedAt *time.Time
+	TotalLogs      int32
+	ProcessedLogs  int32
+	ChangedLogs    int32
+	SkippedLogs    int32
+	LastError      *string
+	// RequestedByUserID is nil for jobs scheduled by activating a rule set.
+	RequestedByUserID *uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	CompletedAt       *time.Time
+}
+
+// Progress is the share of logs processed so far. Logs created while the job
+// runs are included when they sort after the cursor, so it is capped at 1.
+func (j *ScoringRescoreJob) Progress() float32 {
+	if j.Status == ScoringRescoreJobStatusCompleted {
+		return 1
+	}
+	if j.TotalLogs == 0 {
+		return 0
+	}
+	return min(float32(j.ProcessedLogs)/float32(j.TotalLogs), 1)
+}
+
+// Runnable reports whether the worker still has to process the job.
+func (j *ScoringRescoreJob) Runnable() bool {
+	return j.Status == ScoringRescoreJobStatusPending || j.Status == ScoringRescoreJobStatusRunning
+}
+
+// ScoringRescoreUserDiff sums the score change of a user's changed logs.
+type ScoringRescoreUserDiff struct {
+	UserID          uuid.UUID
+	UserDisplayName string
+	ChangedLogs     int32
+	ScoreBefore     float32
+	ScoreAfter      float32
+}
+
+type ScoringRescoreJobManagementRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	FindScoringRuleSetByID(context.Context, uuid.UUID) (*ScoringRuleSet, error)
+	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
+	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+
+	// CreateScoringRescoreJob counts the logs to process and stores the job.
+	// Creating a job that writes scores supersedes the unfinished jobs that
+	// write scores for the same platform or contest.
+	CreateScoringRescoreJob(context.Context, *ScoringRescoreJob) (*ScoringRescoreJob, error)
+	FindScoringRescoreJobByID(context.Context, uuid.UUID) (*ScoringRescoreJob, error)
+	ListScoringRescoreJobs(ctx context.Context, contestID *uuid.UUID, limit int32) ([]ScoringRescoreJob, error)
+	ListScoringRescoreJobUserDiffs(context.Context, *ScoringRescoreJobDiffRequest) (*ScoringRescoreJobDiffResponse, error)
+}
+
+type ScoringRescoreJobCreateRequest struct {
+	// ContestID is nil to rescore the platform.
+	ContestID *uuid.UUID
+	// RuleSetID defaults to the active rule set. Dry runs can preview any
+	// published rule set of the scope before it is activated.
+	RuleSetID *uuid.UUID
+	DryRun    bool
+}
+
+type ScoringRescoreJobDiffRequest struct {
+	JobID    uuid.UUID
+	PageSize int
+	Page     int
+}
+
+type ScoringRescoreJobDiffResponse struct {
+	Users         []ScoringRescoreUserDiff
+	TotalSize     int
+	NextPageToken string
+}
+
+type ScoringRescoreJobManagement struct {
+	repo                 ScoringRescoreJobManagementRepository
+	organizers           ContestOrganizerChecker
+	clock                commondomain.Clock
+	scoringEngineEnabled bool
+}
+
+func NewScoringRescoreJobManagement(
+	repo ScoringRescoreJobManagementRepository,
+	organizers ContestOrganizerChecker,
+	clock commondomain.Clock,
+	scoringEngineEnabled bool,
+) *ScoringRescoreJobManagement {
+	return &ScoringRescoreJobManagement{
+		repo:                 repo,
+		organizers:           organizers,
+		clock:                clock,
+		scoringEngineEnabled: scoringEngineEnabled,
+	}
+}
+
+// Create schedules a rescoring job on demand. Jobs that write scores always
+// apply the active rule set and need the scoring engine to be enabled, as
+// stored scores are not engine scores otherwise.
+func (s *ScoringRescoreJobManagement) Create(
+	ctx context.Context,
+	req *ScoringRescoreJobCreateRequest,
+) (*ScoringRescoreJob, error) {
+	if err := s.authorizeScope(ctx, req.ContestID); err != nil {
+		return nil, err
+	}
+	if !req.DryRun && !s.scoringEngineEnabled {
+		return nil, fmt.Errorf("scores can only be rewritten while the scoring engine is enabled: %w", ErrConflict)
+	}
+
+	ruleSet, err := s.resolveRuleSet(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &ScoringRescoreJob{
+		Scope:     ruleSet.Scope,
+		ContestID: req.ContestID,
+		RuleSetID: ruleSet.ID,
+		DryRun:    req.DryRun,
+		Status:    ScoringRescoreJobStatusPending,
+		CreatedAt: s.clock.Now(),
+	}
+	if session := commondomain.ParseUserIdentity(ctx); session != nil {
+		if userID, err := uuid.Parse(session.Subject); err == nil {
+			job.RequestedByUserID = &userID
+		}
+	}
+	return s.repo.CreateScoringRescoreJob(ctx, job)
+}
+
+// ScheduleActivationRescore rescores the logs affected by a rule set that was
+// just activated. Nothing is scheduled while the scoring engine is disabled.
+func (s *ScoringRescoreJobManagement) ScheduleActivationRescore(ctx context.Context, ruleSet *ScoringRuleSet) error {
+	if !s.scoringEngineEnabled {
+		return nil
+	}
+	if ruleSet.Scope == ScoringRuleSetScopeContest && ruleSet.ContestID == nil {
+		return ErrInvalidScoringRuleSet
+	}
+
+	_, err := s.repo.CreateScoringRescoreJob(ctx, &ScoringRescoreJob{
+		Scope:     ruleSet.Scope,
+		ContestID: ruleSet.ContestID,
+		RuleSetID: ruleSet.ID,
+		Status:    ScoringRescoreJobStatusPending,
+		CreatedAt: s.clock.Now(),
+	})
+	return err
+}
+
+func (s *ScoringRescoreJobManagement) Find(ctx context.Context, id uuid.UUID) (*ScoringRescoreJob, error) {
+	job, err := s.repo.FindScoringRescoreJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeScope(ctx, job.ContestID); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// List returns the most recent jobs of the platform, or of a contest.
+func (s *ScoringRescoreJobManagement) List(ctx context.Context, contestID *uuid.UUID) ([]ScoringRescoreJob, error) {
+	if err := s.authorizeScope(ctx, contestID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListScoringRescoreJobs(ctx, contestID, maxScoringRescoreJobsListed)
+}
+
+// Diff lists the per-user score changes of a job, largest changes first.
+func (s *ScoringRescoreJobManagement) Diff(
+	ctx context.Context,
+	req *ScoringRescoreJobDiffRequest,
+) (*ScoringRescoreJobDiffResponse, error) {
+	if _, err := s.Find(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+
+	if req.PageSize == 0 {
+		req.PageSize = 25
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+	return s.repo.ListScoringRescoreJobUserDiffs(ctx, req)
+}
+
+func (s *ScoringRescoreJobManagement) resolveRuleSet(
+	ctx context.Context,
+	req *ScoringRescoreJobCreateRequest,
+) (*ScoringRuleSet, error) {
+	var active *ScoringRuleSet
+	if req.ContestID == nil {
+		ruleSet, err := s.repo.FindActivePlatformScoringRuleSet(ctx)
+		if err != nil {
+			return nil, err
+		}
+		active = ruleSet
+	} else {
+		ruleSet, _, err := s.repo.FindContestScoringRuleSets(ctx, *req.ContestID)
+		if err != nil {
+			return nil, err
+		}
+		active = ruleSet
+	}
+
+	if req.RuleSetID == nil {
+		if active == nil {
+			return nil, fmt.Errorf("contest has no active rule set, its logs follow the platform scores: %w", ErrConflict)
+		}
+		return active, nil
+	}
+
+	ruleSet, err := s.repo.FindScoringRuleSetByID(ctx, *req.RuleSetID)
+	if err != nil {
+		return nil, err
+	}
+	if ruleSet.Status != ScoringRuleSetStatusPublished {
+		return nil, fmt.Errorf("only published rule sets can be applied: %w", ErrInvalidScoringRuleSet)
+	}
+	if req.ContestID == nil && ruleSet.Scope != ScoringRuleSetScopePlatform {
+		return nil, fmt.Errorf("rule set is not a platform rule set: %w", ErrInvalidScoringRuleSet)
+	}
+	if req.ContestID != nil && (ruleSet.Scope != ScoringRuleSetScopeContest || ruleSet.ContestID == nil || *ruleSet.ContestID != *req.ContestID) {
+		return nil, fmt.Errorf("rule set does not belong to the contest: %w", ErrInvalidScoringRuleSet)
+	}
+	if !req.DryRun && (active == nil || active.ID != ruleSet.ID) {
+		return nil, fmt.Errorf("only the active rule set can be applied, use a dry run to preview others: %w", ErrConflict)
+	}
+	return ruleSet, nil
+}
+
+// authorizeScope lets admins manage every job, contest organizers can manage
+// the jobs of their own contest, like they manage its rule sets.
+func (s *ScoringRescoreJobManagement) authorizeScope(ctx context.Context, contestID *uuid.UUID) error {
+	if err := requireAuthentication(ctx); err != nil {
+		return err
+	}
+	if contestID == nil {
+		if !isAdmin(ctx) {
+			return ErrForbidden
+		}
+		return nil
+	}
+
+	_, err := requireContestOrganizer(ctx, s.repo, s.organizers, *contestID)
+	return err
+}
+
+// scoreProvenanceEqual compares provenance field by field. Rates are copied
+// from the rules as is and values are computed the same way every time, so
+// they are compared exactly.
+func scoreProvenanceEqual(left, right *ScoreProvenance) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return uuidPtrEqual(left.RuleSetID, right.RuleSetID) &&
+		left.Source == right.Source &&
+		slices.Equal(left.RuleIDs, right.RuleIDs) &&
+		slices.Equal(left.Rates, right.Rates) &&
+		slices.Equal(left.Values, right.Values)
+}
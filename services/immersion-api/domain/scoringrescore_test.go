@@ -0,0 +1,303 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockScoringRescoreJobManagementRepository struct {
+	contest        *domain.ContestView
+	ruleSets       map[uuid.UUID]*domain.ScoringRuleSet
+	activePlatform *domain.ScoringRuleSet
+	activeContest  *domain.ScoringRuleSet
+	job            *domain.ScoringRescoreJob
+	created        *domain.ScoringRescoreJob
+	diffRequest    *domain.ScoringRescoreJobDiffRequest
+}
+
+func (m *mockScoringRescoreJobManagementRepository) FindContestByID(context.Context, *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contest == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.contest, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) FindScoringRuleSetByID(_ context.Context, id uuid.UUID) (*domain.ScoringRuleSet, error) {
+	ruleSet, ok := m.ruleSets[id]
+	if !ok {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return ruleSet, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) FindActivePlatformScoringRuleSet(context.Context) (*domain.ScoringRuleSet, error) {
+	if m.activePlatform == nil {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return m.activePlatform, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) FindContestScoringRuleSets(context.Context, uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
+	return m.activeContest, nil, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) CreateScoringRescoreJob(_ context.Context, job *domain.ScoringRescoreJob) (*domain.ScoringRescoreJob, error) {
+	m.created = job
+	return job, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) FindScoringRescoreJobByID(context.Context, uuid.UUID) (*domain.ScoringRescoreJob, error) {
+	if m.job == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.job, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) ListScoringRescoreJobs(context.Context, *uuid.UUID, int32) ([]domain.ScoringRescoreJob, error) {
+	return nil, nil
+}
+
+func (m *mockScoringRescoreJobManagementRepository) ListScoringRescoreJobUserDiffs(_ context.Context, req *domain.ScoringRescoreJobDiffRequest) (*domain.ScoringRescoreJobDiffResponse, error) {
+	m.diffRequest = req
+	return &domain.ScoringRescoreJobDiffResponse{}, nil
+}
+
+func newRescoreRepositoryWithPlatformSets() (*mockScoringRescoreJobManagementRepository, *domain.ScoringRuleSet, *domain.ScoringRuleSet) {
+	active := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+	}
+	next := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+	}
+	repo := &mockScoringRescoreJobManagementRepository{
+		activePlatform: active,
+		ruleSets:       map[uuid.UUID]*domain.ScoringRuleSet{active.ID: active, next.ID: next},
+	}
+	return repo, active, next
+}
+
+func TestScoringRescoreJobManagement_Create(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	adminID := uuid.New()
+
+	t.Run("creates a platform job for the active rule set", func(t *testing.T) {
+		repo, active, _ := newRescoreRepositoryWithPlatformSets()
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		job, err := service.Create(ctxWithAdminSubject(adminID.String()), &domain.ScoringRescoreJobCreateRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ScoringRuleSetScopePlatform, job.Scope)
+		assert.Equal(t, active.ID, job.RuleSetID)
+		assert.False(t, job.DryRun)
+		assert.Equal(t, domain.ScoringRescoreJobStatusPending, job.Status)
+		assert.Equal(t, &adminID, job.RequestedByUserID)
+		assert.Equal(t, now, job.CreatedAt)
+	})
+
+	t.Run("previews an inactive rule set as a dry run", func(t *testing.T) {
+		repo, _, next := newRescoreRepositoryWithPlatformSets()
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), false)
+
+		job, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{RuleSetID: &next.ID, DryRun: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, next.ID, job.RuleSetID)
+		assert.True(t, job.DryRun)
+	})
+
+	t.Run("only writes scores for the active rule set", func(t *testing.T) {
+		repo, _, next := newRescoreRepositoryWithPlatformSets()
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		_, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{RuleSetID: &next.ID})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("only writes scores while the scoring engine is enabled", func(t *testing.T) {
+		repo, _, _ := newRescoreRepositoryWithPlatformSets()
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), false)
+
+		_, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("rejects draft rule sets", func(t *testing.T) {
+		repo, _, next := newRescoreRepositoryWithPlatformSets()
+		next.Status = domain.ScoringRuleSetStatusDraft
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		_, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{RuleSetID: &next.ID, DryRun: true})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidScoringRuleSet)
+	})
+
+	t.Run("platform jobs are admin only", func(t *testing.T) {
+		repo, _, _ := newRescoreRepositoryWithPlatformSets()
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		_, err := service.Create(ctxWithUser(), &domain.ScoringRescoreJobCreateRequest{DryRun: true})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("contest owners can rescore their contest", func(t *testing.T) {
+		ownerID := uuid.New()
+		contestID := uuid.New()
+		ruleSet := &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Status:    domain.ScoringRuleSetStatusPublished,
+		}
+		repo := &mockScoringRescoreJobManagementRepository{
+			contest:       &domain.ContestView{ID: contestID, OwnerUserID: ownerID},
+			activeContest: ruleSet,
+		}
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		job, err := service.Create(ctxWithUserSubject(ownerID.String()), &domain.ScoringRescoreJobCreateRequest{ContestID: &contestID})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ScoringRuleSetScopeContest, job.Scope)
+		assert.Equal(t, &contestID, job.ContestID)
+		assert.Equal(t, ruleSet.ID, job.RuleSetID)
+
+		_, err = service.Create(ctxWithUser(), &domain.ScoringRescoreJobCreateRequest{ContestID: &contestID})
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("contest organizers can rescore the contest", func(t *testing.T) {
+		organizerID := uuid.New()
+		contestID := uuid.New()
+		ruleSet := &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Status:    domain.ScoringRuleSetStatusPublished,
+		}
+		repo := &mockScoringRescoreJobManagementRepository{
+			contest:       &domain.ContestView{ID: contestID, OwnerUserID: uuid.New()},
+			activeContest: ruleSet,
+		}
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		service := domain.NewScoringRescoreJobManagement(repo, organizers, commondomain.NewMockClock(now), true)
+
+		job, err := service.Create(ctxWithUserSubject(organizerID.String()), &domain.ScoringRescoreJobCreateRequest{ContestID: &contestID})
+
+		require.NoError(t, err)
+		assert.Equal(t, ruleSet.ID, job.RuleSetID)
+
+		_, err = service.List(ctxWithUserSubject(organizerID.String()), &contestID)
+		require.NoError(t, err)
+	})
+
+	t.Run("contests without a rule set follow the platform", func(t *testing.T) {
+		contestID := uuid.New()
+		repo := &mockScoringRescoreJobManagementRepository{
+			contest: &domain.ContestView{ID: contestID, OwnerUserID: uuid.New()},
+		}
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		_, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{ContestID: &contestID})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("rejects rule sets of other contests", func(t *testing.T) {
+		contestID := uuid.New()
+		otherContestID := uuid.New()
+		ruleSet := &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &otherContestID,
+			Status:    domain.ScoringRuleSetStatusPublished,
+		}
+		repo := &mockScoringRescoreJobManagementRepository{
+			contest:  &domain.ContestView{ID: contestID, OwnerUserID: uuid.New()},
+			ruleSets: map[uuid.UUID]*domain.ScoringRuleSet{ruleSet.ID: ruleSet},
+		}
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now), true)
+
+		_, err := service.Create(ctxWithAdmin(), &domain.ScoringRescoreJobCreateRequest{
+			ContestID: &contestID,
+			RuleSetID: &ruleSet.ID,
+			DryRun:    true,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidScoringRuleSet)
+	})
+}
+
+func TestScoringRescoreJobManagement_ScheduleActivationRescore(t *testing.T) {
+	contestID := uuid.New()
+	ruleSet := &domain.ScoringRuleSet{
+		ID:        uuid.New(),
+		Scope:     domain.ScoringRuleSetScopeContest,
+		ContestID: &contestID,
+	}
+
+	t.Run("schedules a job writing scores", func(t *testing.T) {
+		repo := &mockScoringRescoreJobManagementRepository{}
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()), true)
+
+		err := service.ScheduleActivationRescore(ctxWithUser(), ruleSet)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.created)
+		assert.Equal(t, ruleSet.ID, repo.created.RuleSetID)
+		assert.Equal(t, &contestID, repo.created.ContestID)
+		assert.False(t, repo.created.DryRun)
+		assert.Nil(t, repo.created.RequestedByUserID)
+	})
+
+	t.Run("does nothing while the scoring engine is disabled", func(t *testing.T) {
+		repo := &mockScoringRescoreJobManagementRepository{}
+		service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()), false)
+
+		err := service.ScheduleActivationRescore(ctxWithUser(), ruleSet)
+
+		require.NoError(t, err)
+		assert.Nil(t, repo.created)
+	})
+}
+
+func TestScoringRescoreJobManagement_Diff(t *testing.T) {
+	repo := &mockScoringRescoreJobManagementRepository{
+		job: &domain.ScoringRescoreJob{ID: uuid.New(), Scope: domain.ScoringRuleSetScopePlatform},
+	}
+	service := domain.NewScoringRescoreJobManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()), true)
+
+	_, err := service.Diff(ctxWithUser(), &domain.ScoringRescoreJobDiffRequest{JobID: repo.job.ID})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = service.Diff(ctxWithAdmin(), &domain.ScoringRescoreJobDiffRequest{JobID: repo.job.ID, PageSize: 500})
+	require.NoError(t, err)
+	assert.Equal(t, 100, repo.diffRequest.PageSize)
+}
+
+func TestScoringRescoreJob_Progress(t *testing.T) {
+	job := domain.ScoringRescoreJob{Status: domain.ScoringRescoreJobStatusRunning, TotalLogs: 200, ProcessedLogs: 50}
+	assert.InDelta(t, 0.25, job.Progress(), 0.0001)
+
+	job.ProcessedLogs = 250
+	assert.InDelta(t, 1, job.Progress(), 0.0001)
+
+	job = domain.ScoringRescoreJob{Status: domain.ScoringRescoreJobStatusCompleted}
+	assert.InDelta(t, 1, job.Progress(), 0.0001)
+}
@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// maxScoringRescoreBatchesPerRun bounds how many batches are processed in a
+// single pass so one large job does not hold the worker indefinitely.
+const maxScoringRescoreBatchesPerRun = 20
+
+// ScoringRescoreCandidate is a log as stored for the job's scope, i.e. the
+// contest log for contest jobs.
+type ScoringRescoreCandidate struct {
+	LogID                       uuid.UUID
+	UserID                      uuid.UUID
+	ActivityID                  int32
+	LanguageCode                string
+	Tags                        []string
+	Year                        int16
+	LoggedAt                    time.Time
+	EligibleOfficialLeaderboard bool
+	// ContestIDs are the ongoing contests without a rule set of their own,
+	// whose contest logs copy the platform score. Only set for platform jobs.
+	ContestIDs []uuid.UUID
+	Tracking   LogTracking
+	// Timezone is the user's IANA time zone name, cap periods start in it.
+	Timezone string
+}
+
+// ScoringRescoreChange is a candidate whose score or provenance changed.
+type ScoringRescoreChange struct {
+	Candidate ScoringRescoreCandidate
+	Tracking  LogTracking
+}
+
+// ScoringRescoreBatch is the outcome of processing the logs after the job's
+// cursor.
+type ScoringRescoreBatch struct {
+	// Job is the job as read before processing the batch.
+	Job            *ScoringRescoreJob
+	CursorLogID    *uuid.UUID
+	CursorLoggedAt *time.Time
+	Processed      int32
+	Skipped        int32
+	Changes        []ScoringRescoreChange
+	UserDiffs      []ScoringRescoreUserDiff
+	Done           bool
+	Now            time.Time
+}
+
+// ScoringRescoreWorkerRepository processes rescoring jobs. SaveScoringRescoreBatch
+// returns ErrConflict when the job moved on or stopped since it was read, so
+// multiple API instances can run the worker at the same time.
+type ScoringRescoreWorkerRepository interface {
+	// FindNextScoringRescoreJob returns the oldest runnable job, or ErrNotFound.
+	FindNextScoringRescoreJob(ctx context.Context) (*ScoringRescoreJob, error)
+	FindScoringRuleSetByID(ctx context.Context, id uuid.UUID) (*ScoringRuleSet, error)
+	ListScoringRescoreCandidates(ctx context.Context, job *ScoringRescoreJob, limit int32, now time.Time) ([]ScoringRescoreCandidate, error)
+	FindScoringRuleUsage(ctx context.Context, req *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+
+	// SaveScoringRescoreBatch writes the changed scores along with their
+	// leaderboard outbox events, unless the job is a dry run, records the
+	// per-user diff and advances the cursor in a single transaction.
+	SaveScoringRescoreBatch(ctx context.Context, batch *ScoringRescoreBatch) error
+	FailScoringRescoreJob(ctx context.Context, id uuid.UUID, reason string, now time.Time) error
+}
+
+// ScoringRescoreWorker processes rescoring jobs in batches, oldest job first.
+type ScoringRescoreWorker struct {
+	repo      ScoringRescoreWorkerRepository
+	clock     commondomain.Clock
+	interval  time.Duration
+	batchSize int32
+}
+
+func NewScoringRescoreWorker(
+	repo ScoringRescoreWorkerRepository,
+	clock commondomain.Clock,
+	interval time.Duration,
+	batchSize int32,
+) *ScoringRescoreWorker {
+	return &ScoringRescoreWorker{repo: repo, clock: clock, interval: interval, batchSize: batchSize}
+}
+
+// Run processes jobs at the configured interval until the context is
+// cancelled.
+func (w *ScoringRescoreWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.processAll(ctx)
+		}
+	}
+}
+
+// ProcessAllForTest exposes processAll for unit testing.
+func (w *ScoringRescoreWorker) ProcessAllForTest(ctx context.Context) {
+	w.processAll(ctx)
+}
+
+func (w *ScoringRescoreWorker) processAll(ctx context.Context) {
+	for range maxScoringRescoreBatchesPerRun {
+		if ctx.Err() != nil {
+			return
+		}
+
+		job, err := w.repo.FindNextScoringRescoreJob(ctx)
+		if errors.Is(err, ErrNotFound) {
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "scoring rescore worker: could not find job", "error", err)
+			return
+		}
+
+		err = w.processBatch(ctx, job)
+		switch {
+		case err == nil, errors.Is(err, ErrConflict):
+			continue
+		case errors.Is(err, ErrInvalidScoringRuleSet), errors.Is(err, ErrScoringRuleSetNotFound):
+			// Retrying cannot help, the rule set itself cannot be applied.
+			slog.ErrorContext(ctx, "scoring rescore worker: job failed", "job_id", job.ID, "error", err)
+			if err := w.repo.FailScoringRescoreJob(ctx, job.ID, err.Error(), w.clock.Now()); err != nil {
+				slog.ErrorContext(ctx, "scoring rescore worker: could not mark job as failed", "job_id", job.ID, "error", err)
+				return
+			}
+		default:
+			slog.ErrorContext(ctx, "scoring rescore worker: could not process batch", "job_id", job.ID, "error", err)
+			return
+		}
+	}
+}
+
+func (w *ScoringRescoreWorker) processBatch(ctx context.Context, job *ScoringRescoreJob) error {
+	now := w.clock.Now()
+
+	ruleSet, err := w.repo.FindScoringRuleSetByID(ctx, job.RuleSetID)
+	if err != nil {
+		return err
+	}
+	var fallback *ScoringRuleSet
+	if job.Scope == ScoringRuleSetScopeContest && ruleSet.FallbackRuleSetID != nil {
+		fallback, err = w.repo.FindScoringRuleSetByID(ctx, *ruleSet.FallbackRuleSetID)
+		if err != nil {
+			return fmt.Errorf("could not find fallback rule set: %w", err)
+		}
+	}
+
+	candidates, err := w.repo.ListScoringRescoreCandidates(ctx, job, w.batchSize, now)
+	if err != nil {
+		return err
+	}
+
+	batch := &ScoringRescoreBatch{
+		Job:            job,
+		CursorLogID:    job.CursorLogID,
+		CursorLoggedAt: job.CursorLoggedAt,
+		Processed:      int32(len(candidates)),
+		Done:           int32(len(candidates)) < w.batchSize,
+		Now:            now,
+	}
+	diffs := map[uuid.UUID]*ScoringRescoreUserDiff{}
+	replay := newScoringUsageReplay()
+	locations := userLocations{}
+
+	for _, candidate := range candidates {
+		input := scoringInputFromTracking(candidate.ActivityID, candidate.LanguageCode, candidate.Tags, candidate.Tracking, candidate.LoggedAt)
+		input.Location = locations.load(candidate.Timezone)
+		input.Usage = w.usageLookup(ctx, job, candidate, replay, candidates[0].LoggedAt)
+
+		var result ScoringResult
+		if job.Scope == ScoringRuleSetScopeContest {
+			result, err = EvaluateContestScore(input, *ruleSet, fallback)
+		} else {
+			result, err = EvaluateScoringRuleSet(input, *ruleSet)
+		}
+		if errors.Is(err, ErrInvalidLog) {
+			// Logs from before tracking was introduced cannot be scored.
+			batch.Skipped++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not evaluate log %s: %w", candidate.LogID, err)
+		}
+		replay.record(candidate.UserID, input, result)
+
+		tracking := candidate.Tracking
+		ApplyScoringResult(&tracking, result)
+		if scoringScoresEqual(candidate.Tracking.ComputedScore, tracking.ComputedScore) &&
+			scoreProvenanceEqual(candidate.Tracking.ScoreProvenance, tracking.ScoreProvenance) {
+			continue
+		}
+
+		batch.Changes = append(batch.Changes, ScoringRescoreChange{Candidate: candidate, Tracking: tracking})
+		diff, ok := diffs[candidate.UserID]
+		if !ok {
+			diff = &ScoringRescoreUserDiff{UserID: candidate.UserID}
+			diffs[candidate.UserID] = diff
+		}
+		diff.ChangedLogs++
+		diff.ScoreBefore += candidate.Tracking.ComputedScore
+		diff.ScoreAfter += tracking.ComputedScore
+	}
+
+	if len(candidates) > 0 {
+		last := candidates[len(candidates)-1]
+		batch.CursorLogID = &last.LogID
+		batch.CursorLoggedAt = &last.LoggedAt
+	}
+	for _, diff := range diffs {
+		batch.UserDiffs = append(batch.UserDiffs, *diff)
+	}
+	sort.Slice(batch.UserDiffs, func(i, j int) bool {
+		return batch.UserDiffs[i].UserID.String() < batch.UserDiffs[j].UserID.String()
+	})
+
+	return w.repo.SaveScoringRescoreBatch(ctx, batch)
+}
+
+// usageLookup counts the logs rescored by earlier batches as stored, and the
+// earlier logs of the batch as they were just evaluated. Dry runs store no
+// scores, so their caps only count the earlier logs of the batch.
+func (w *ScoringRescoreWorker) usageLookup(
+	ctx context.Context,
+	job *ScoringRescoreJob,
+	candidate ScoringRescoreCandidate,
+	replay *scoringUsageReplay,
+	batchStart time.Time,
+) ScoringUsageLookup {
+	replayed := replay.lookup(candidate.UserID)
+	if job.DryRun {
+		return replayed
+	}
+	stored := scoringUsageScope{userID: candidate.UserID, logID: &candidate.LogID}.lookup(ctx, w.repo, job.ContestID)
+
+	return func(rule ScoringRule, from, until time.Time) (ScoringRuleUsage, error) {
+		usage, err := replayed(rule, from, until)
+		if err != nil || !from.Before(batchStart) {
+			return usage, err
+		}
+		storedUsage, err := stored(rule, from, minTime(until, batchStart))
+		if err != nil {
+			return ScoringRuleUsage{}, err
+		}
+		usage.Value += storedUsage.Value
+		usage.Score += storedUsage.Score
+		return usage, nil
+	}
+}
+
+func minTime(left, right time.Time) time.Time {
+	if left.Before(right) {
+		return left
+	}
+	return right
+}
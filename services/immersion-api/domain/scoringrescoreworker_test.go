@@ -0,0 +1,278 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockScoringRescoreWorkerRepository struct {
+	jobs       []*domain.ScoringRescoreJob
+	ruleSets   map[uuid.UUID]*domain.ScoringRuleSet
+	candidates []domain.ScoringRescoreCandidate
+	batches    []*domain.ScoringRescoreBatch
+	failed     map[uuid.UUID]string
+	stored     map[uuid.UUID]domain.ScoringRuleUsage
+}
+
+func (m *mockScoringRescoreWorkerRepository) FindNextScoringRescoreJob(context.Context) (*domain.ScoringRescoreJob, error) {
+	for _, job := range m.jobs {
+		if job.Runnable() {
+			copied := *job
+			return &copied, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockScoringRescoreWorkerRepository) FindScoringRuleSetByID(_ context.Context, id uuid.UUID) (*domain.ScoringRuleSet, error) {
+	ruleSet, ok := m.ruleSets[id]
+	if !ok {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return ruleSet, nil
+}
+
+func (m *mockScoringRescoreWorkerRepository) ListScoringRescoreCandidates(_ context.Context, job *domain.ScoringRescoreJob, limit int32, _ time.Time) ([]domain.ScoringRescoreCandidate, error) {
+	start := 0
+	if job.CursorLogID != nil {
+		for i, candidate := range m.candidates {
+			if candidate.LogID == *job.CursorLogID {
+				start = i + 1
+			}
+		}
+	}
+	end := min(start+int(limit), len(m.candidates))
+	return m.candidates[start:end], nil
+}
+
+func (m *mockScoringRescoreWorkerRepository) FindScoringRuleUsage(_ context.Context, req *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	usage := m.stored[req.RuleID]
+	return &usage, nil
+}
+
+func (m *mockScoringRescoreWorkerRepository) SaveScoringRescoreBatch(_ context.Context, batch *domain.ScoringRescoreBatch) error {
+	m.batches = append(m.batches, batch)
+	for _, job := range m.jobs {
+		if job.ID != batch.Job.ID {
+			continue
+		}
+		job.CursorLogID = batch.CursorLogID
+		job.ProcessedLogs += batch.Processed
+		job.SkippedLogs += batch.Skipped
+		job.ChangedLogs += int32(len(batch.Changes))
+		job.Status = domain.ScoringRescoreJobStatusRunning
+		if batch.Done {
+			job.Status = domain.ScoringRescoreJobStatusCompleted
+		}
+	}
+	return nil
+}
+
+func (m *mockScoringRescoreWorkerRepository) FailScoringRescoreJob(_ context.Context, id uuid.UUID, reason string, _ time.Time) error {
+	if m.failed == nil {
+		m.failed = map[uuid.UUID]string{}
+	}
+	m.failed[id] = reason
+	for _, job := range m.jobs {
+		if job.ID == id {
+			job.Status = domain.ScoringRescoreJobStatusFailed
+		}
+	}
+	return nil
+}
+
+// rescoreCandidate returns a page reading log of 10 pages scored at the
+// interim rate without provenance.
+func rescoreCandidate(logID, userID uuid.UUID) domain.ScoringRescoreCandidate {
+	return domain.ScoringRescoreCandidate{
+		LogID:        logID,
+		UserID:       userID,
+		ActivityID:   1,
+		LanguageCode: "jpn",
+		Year:         2026,
+		Tracking: domain.LogTracking{
+			Kind:          domain.LogTrackingAmountUnit,
+			UnitKey:       domain.UnitKeyReadingPage,
+			Amount:        10,
+			Modifier:      1,
+			ComputedScore: 10,
+		},
+	}
+}
+
+func TestScoringRescoreWorker_ProcessAll(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	ruleSet := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+		Rules: []domain.ScoringRule{{
+			ID:          uuid.New(),
+			Priority:    1,
+			ActivityID:  1,
+			UnitKey:     domain.UnitKeyReadingPage,
+			ScoreSource: domain.ScoreSourceAmount,
+			Rate:        2,
+		}},
+	}
+	userA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	userB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+
+	newRepo := func(dryRun bool) *mockScoringRescoreWorkerRepository {
+		unchanged := rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000003"), userB)
+		domain.ApplyScoringResult(&unchanged.Tracking, domain.ScoringResult{
+			Score:            20,
+			ScoreSource:      domain.ScoreSourceAmount,
+			Matched:          true,
+			AppliedRuleSetID: &ruleSet.ID,
+			AppliedRules:     []domain.AppliedScoringRule{{RuleID: ruleSet.Rules[0].ID, Rate: 2, Value: 10}},
+		})
+		legacy := rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000004"), userB)
+		legacy.Tracking = domain.LogTracking{ComputedScore: 5}
+
+		return &mockScoringRescoreWorkerRepository{
+			jobs: []*domain.ScoringRescoreJob{{
+				ID:        uuid.New(),
+				Scope:     domain.ScoringRuleSetScopePlatform,
+				RuleSetID: ruleSet.ID,
+				DryRun:    dryRun,
+				Status:    domain.ScoringRescoreJobStatusPending,
+				TotalLogs: 4,
+			}},
+			ruleSets: map[uuid.UUID]*domain.ScoringRuleSet{ruleSet.ID: ruleSet},
+			candidates: []domain.ScoringRescoreCandidate{
+				rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000001"), userA),
+				rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000002"), userA),
+				unchanged,
+				legacy,
+			},
+		}
+	}
+
+	t.Run("processes the job in batches until done", func(t *testing.T) {
+		repo := newRepo(false)
+		worker := domain.NewScoringRescoreWorker(repo, commondomain.NewMockClock(now), time.Minute, 2)
+
+		worker.ProcessAllForTest(context.Background())
+
+		require.Len(t, repo.batches, 3)
+		job := repo.jobs[0]
+		assert.Equal(t, domain.ScoringRescoreJobStatusCompleted, job.Status)
+		assert.Equal(t, int32(4), job.ProcessedLogs)
+		assert.Equal(t, int32(2), job.ChangedLogs)
+		assert.Equal(t, int32(1), job.SkippedLogs)
+
+		first := repo.batches[0]
+		require.Len(t, first.Changes, 2)
+		assert.InDelta(t, 20, first.Changes[0].Tracking.ComputedScore, 0.0001)
+		require.NotNil(t, first.Changes[0].Tracking.ScoreProvenance)
+		assert.Equal(t, &ruleSet.ID, first.Changes[0].Tracking.ScoreProvenance.RuleSetID)
+		assert.Equal(t, []domain.ScoringRescoreUserDiff{{
+			UserID:      userA,
+			ChangedLogs: 2,
+			ScoreBefore: 20,
+			ScoreAfter:  40,
+		}}, first.UserDiffs)
+
+		second := repo.batches[1]
+		assert.Empty(t, second.Changes)
+		assert.Equal(t, int32(1), second.Skipped)
+		assert.False(t, second.Done)
+
+		last := repo.batches[2]
+		assert.Equal(t, int32(0), last.Processed)
+		assert.True(t, last.Done)
+	})
+
+	t.Run("dry runs compute the same diff", func(t *testing.T) {
+		repo := newRepo(true)
+		worker := domain.NewScoringRescoreWorker(repo, commondomain.NewMockClock(now), time.Minute, 10)
+
+		worker.ProcessAllForTest(context.Background())
+
+		require.Len(t, repo.batches, 1)
+		assert.True(t, repo.batches[0].Job.DryRun)
+		assert.True(t, repo.batches[0].Done)
+		assert.Len(t, repo.batches[0].Changes, 2)
+	})
+
+	t.Run("resumes from the cursor", func(t *testing.T) {
+		repo := newRepo(false)
+		cursor := repo.candidates[1].LogID
+		repo.jobs[0].Status = domain.ScoringRescoreJobStatusRunning
+		repo.jobs[0].CursorLogID = &cursor
+		worker := domain.NewScoringRescoreWorker(repo, commondomain.NewMockClock(now), time.Minute, 10)
+
+		worker.ProcessAllForTest(context.Background())
+
+		require.Len(t, repo.batches, 1)
+		assert.Equal(t, int32(2), repo.batches[0].Processed)
+		assert.Empty(t, repo.batches[0].Changes)
+	})
+
+	t.Run("fails jobs whose rule set is gone", func(t *testing.T) {
+		repo := newRepo(false)
+		repo.ruleSets = map[uuid.UUID]*domain.ScoringRuleSet{}
+		worker := domain.NewScoringRescoreWorker(repo, commondomain.NewMockClock(now), time.Minute, 10)
+
+		worker.ProcessAllForTest(context.Background())
+
+		assert.Empty(t, repo.batches)
+		assert.Contains(t, repo.failed, repo.jobs[0].ID)
+	})
+}
+
+func TestScoringRescoreWorker_TieredValueCaps(t *testing.T) {
+	ptr := func(value float32) *float32 { return &value }
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	// The first 30 pages a day score double, the next 60 one and a half times
+	// and the rest once.
+	ruleSet := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+		Rules: []domain.ScoringRule{
+			{ID: uuid.New(), Priority: 1, ActivityID: 1, ScoreSource: domain.ScoreSourceAmount, Rate: 2, CapPeriod: domain.ScoringCapPeriodDay, CapValue: ptr(30)},
+			{ID: uuid.New(), Priority: 2, ActivityID: 1, ScoreSource: domain.ScoreSourceAmount, Rate: 1.5, CapPeriod: domain.ScoringCapPeriodDay, CapValue: ptr(60)},
+			{ID: uuid.New(), Priority: 3, ActivityID: 1, ScoreSource: domain.ScoreSourceAmount, Rate: 1},
+		},
+	}
+	first := rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000001"), userID)
+	first.Tracking.Amount = 60
+	first.LoggedAt = time.Date(2026, 7, 29, 9, 0, 0, 0, time.UTC)
+	second := rescoreCandidate(uuid.MustParse("00000000-0000-0000-0000-000000000002"), userID)
+	second.Tracking.Amount = 30
+	second.LoggedAt = time.Date(2026, 7, 29, 18, 0, 0, 0, time.UTC)
+
+	repo := &mockScoringRescoreWorkerRepository{
+		jobs: []*domain.ScoringRescoreJob{{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopePlatform,
+			RuleSetID: ruleSet.ID,
+			Status:    domain.ScoringRescoreJobStatusPending,
+			TotalLogs: 2,
+		}},
+		ruleSets:   map[uuid.UUID]*domain.ScoringRuleSet{ruleSet.ID: ruleSet},
+		candidates: []domain.ScoringRescoreCandidate{first, second},
+	}
+	worker := domain.NewScoringRescoreWorker(repo, commondomain.NewMockClock(now), time.Minute, 10)
+
+	worker.ProcessAllForTest(context.Background())
+
+	require.Len(t, repo.batches, 1)
+	changes := repo.batches[0].Changes
+	require.Len(t, changes, 2)
+	assert.InDelta(t, 30*2+30*1.5, changes[0].Tracking.ComputedScore, 0.0001)
+	assert.Equal(t, []float32{30, 30}, changes[0].Tracking.ScoreProvenance.Values)
+	// The first log only used up 30 pages of the second tier, so 30 are left.
+	assert.InDelta(t, 30*1.5, changes[1].Tracking.ComputedScore, 0.0001)
+	assert.Equal(t, []float32{30}, changes[1].Tracking.ScoreProvenance.Values)
+}
@@ -0,0 +1,539 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+const (
+	DefaultScoringImpactSampleSize = 1000
+	MaxScoringImpactSampleSize     = 5000
+
+	// scoringImpactTopUsers is how many of the most affected users are listed.
+	scoringImpactTopUsers = 10
+)
+
+type ScoringRuleSetImpactRepository interface {
+	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
+	FindScoringRuleSetByID(context.Context, uuid.UUID) (*ScoringRuleSet, error)
+	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
+	FindContestScoringRuleSets(context.Context, uuid.UUID) (*ScoringRuleSet, *ScoringRuleSet, error)
+	ListScoringImpactSample(context.Context, *ScoringImpactSampleRequest) ([]ScoringImpactSampleLog, error)
+}
+
+// ScoringImpactSampleRequest selects the most recent logs matching every set
+// filter.
+type ScoringImpactSampleRequest struct {
+	// ContestID limits the sample to logs submitted to the contest.
+	ContestID *uuid.UUID
+	// RegisteredContestID limits the sample to logs of users registered for
+	// the contest.
+	RegisteredContestID *uuid.UUID
+	LanguageCodes       []string
+	ActivityIDs         []int32
+	Limit               int32
+}
+
+type ScoringImpactSampleLog struct {
+	LogID           uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	ActivityID      int32
+	LanguageCode    string
+	Tags            []string
+	Tracking        LogTracking
+	LoggedAt        time.Time
+	// Timezone is the user's IANA time zone name, cap periods start in it.
+	Timezone string
+}
+
+type ScoringRuleSetImpactRequest struct {
+	RuleSetID uuid.UUID
+	// BaseRuleSetID defaults to the rule set currently scoring the logs, the
+	// active contest rule set or otherwise the active platform rule set.
+	BaseRuleSetID *uuid.UUID
+	SampleSize    int
+}
+
+type ScoringRuleChange struct {
+	Before ScoringRule
+	After  ScoringRule
+}
+
+// ScoringRuleSetDiff matches rules by their conditions, as rule IDs differ
+// between versions. Matched rules changed when their rate or priority did.
+type ScoringRuleSetDiff struct {
+	Added           []ScoringRule
+	Removed         []ScoringRule
+	Changed         []ScoringRuleChange
+	UnchangedCount  int32
+	ModeChanged     bool
+	FallbackChanged bool
+}
+
+// ScoringImpactGroup aggregates the simulated logs sharing a key.
+type ScoringImpactGroup struct {
+	Key         string
+	Logs        int32
+	ChangedLogs int32
+	ScoreBefore float32
+	ScoreAfter  float32
+}
+
+type ScoringImpactUser struct {
+	UserID          uuid.UUID
+	UserDisplayName string
+	Logs            int32
+	ChangedLogs     int32
+	ScoreBefore     float32
+	ScoreAfter      float32
+}
+
+type ScoringImpactSimulation struct {
+	SampledLogs int32
+	// SkippedLogs have no tracking data and cannot be scored by either set.
+	SkippedLogs int32
+	ChangedLogs int32
+	// UnmatchedLogs match no rule of the new rule set and would score zero.
+	UnmatchedLogs int32
+	ScoreBefore   float32
+	ScoreAfter    float32
+	// ByActivity is keyed by activity ID, ByUnitKey has an empty key for logs
+	// tracked by duration only.
+	ByActivity []ScoringImpactGroup
+	ByUnitKey  []ScoringImpactGroup
+	ByLanguage []ScoringImpactGroup
+	TopUsers   []ScoringImpactUser
+}
+
+type ScoringRuleSetImpactResponse struct {
+	BaseRuleSet *ScoringRuleSet
+	RuleSet     *ScoringRuleSet
+	Diff        ScoringRuleSetDiff
+	Simulation  ScoringImpactSimulation
+}
+
+// ScoringRuleSetImpact shows what activating a rule set would change by
+// comparing its rules to another rule set and replaying a sample of real logs
+// against both.
+type ScoringRuleSetImpact struct {
+	repo       ScoringRuleSetImpactRepository
+	organizers ContestOrganizerChecker
+	clock      commondomain.Clock
+}
+
+func NewScoringRuleSetImpact(
+	repo ScoringRuleSetImpactRepository,
+	organizers ContestOrganizerChecker,
+	clock commondomain.Clock,
+) *ScoringRuleSetImpact {
+	return &ScoringRuleSetImpact{repo: repo, organizers: organizers, clock: clock}
+}
+
+func (s *ScoringRuleSetImpact) Execute(
+	ctx context.Context,
+	req *ScoringRuleSetImpactRequest,
+) (*ScoringRuleSetImpactResponse, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	if req.SampleSize <= 0 {
+		req.SampleSize = DefaultScoringImpactSampleSize
+	}
+	if req.SampleSize > MaxScoringImpactSampleSize {
+		req.SampleSize = MaxScoringImpactSampleSize
+	}
+
+	ruleSet, err := s.repo.FindScoringRuleSetByID(ctx, req.RuleSetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Platform simulations sample logs of every user, contest simulations
+	// are left to the people organizing the contest.
+	var contest *ContestView
+	switch ruleSet.Scope {
+	case ScoringRuleSetScopePlatform:
+		if !isAdmin(ctx) {
+			return nil, ErrForbidden
+		}
+	case ScoringRuleSetScopeContest:
+		if ruleSet.ContestID == nil {
+			return nil, ErrInvalidScoringRuleSet
+		}
+		contest, err = requireContestOrganizer(ctx, s.repo, s.organizers, *ruleSet.ContestID)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidScoringRuleSet
+	}
+
+	activePlatform, err := s.repo.FindActivePlatformScoringRuleSet(ctx)
+	if err != nil && !errors.Is(err, ErrScoringRuleSetNotFound) {
+		return nil, err
+	}
+	var activeContest *ScoringRuleSet
+	if contest != nil {
+		activeContest, _, err = s.repo.FindContestScoringRuleSets(ctx, contest.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	markActive := func(candidate *ScoringRuleSet) {
+		candidate.Active = (activePlatform != nil && activePlatform.ID == candidate.ID) ||
+			(activeContest != nil && activeContest.ID == candidate.ID)
+	}
+	markActive(ruleSet)
+
+	base, err := s.resolveBase(ctx, req, contest, activePlatform, activeContest)
+	if err != nil {
+		return nil, err
+	}
+	markActive(base)
+
+	baseFallback, err := s.findFallback(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	targetFallback, err := s.findFallback(ctx, ruleSet)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleReq := &ScoringImpactSampleRequest{Limit: int32(req.SampleSize)}
+	if contest != nil {
+		if s.clock.Now().Before(contest.ContestStart) {
+			// Nothing was logged for the contest yet, sample the logs it
+			// would have accepted instead. Only participants are sampled,
+			// organizers don't get to see scores of other users.
+			sampleReq.RegisteredContestID = &contest.ID
+			for _, language := range contest.AllowedLanguages {
+				sampleReq.LanguageCodes = append(sampleReq.LanguageCodes, language.Code)
+			}
+			for _, activity := range contest.AllowedActivities {
+				sampleReq.ActivityIDs = append(sampleReq.ActivityIDs, activity.ID)
+			}
+		} else {
+			sampleReq.ContestID = &contest.ID
+		}
+	}
+	sample, err := s.repo.ListScoringImpactSample(ctx, sampleReq)
+	if err != nil {
+		return nil, fmt.Errorf("could not sample logs: %w", err)
+	}
+
+	simulation, err := simulateScoringImpact(sample, *base, baseFallback, *ruleSet, targetFallback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScoringRuleSetImpactResponse{
+		BaseRuleSet: base,
+		RuleSet:     ruleSet,
+		Diff:        DiffScoringRuleSets(*base, *ruleSet),
+		Simulation:  simulation,
+	}, nil
+}
+
+func (s *ScoringRuleSetImpact) resolveBase(
+	ctx context.Context,
+	req *ScoringRuleSetImpactRequest,
+	contest *ContestView,
+	activePlatform *ScoringRuleSet,
+	activeContest *ScoringRuleSet,
+) (*ScoringRuleSet, error) {
+	if req.BaseRuleSetID == nil {
+		if activeContest != nil {
+			return activeContest, nil
+		}
+		if activePlatform == nil {
+			return nil, fmt.Errorf("there is no active rule set to compare with: %w", ErrConflict)
+		}
+		return activePlatform, nil
+	}
+
+	base, err := s.repo.FindScoringRuleSetByID(ctx, *req.BaseRuleSetID)
+	if err != nil {
+		return nil, err
+	}
+	switch base.Scope {
+	case ScoringRuleSetScopePlatform:
+		if base.Status != ScoringRuleSetStatusPublished && !isAdmin(ctx) {
+			return nil, ErrForbidden
+		}
+	case ScoringRuleSetScopeContest:
+		if contest == nil || base.ContestID == nil || *base.ContestID != contest.ID {
+			return nil, fmt.Errorf("contest rule sets can only be compared within their contest: %w", ErrInvalidScoringRuleSet)
+		}
+	default:
+		return nil, ErrInvalidScoringRuleSet
+	}
+	return base, nil
+}
+
+func (s *ScoringRuleSetImpact) findFallback(ctx context.Context, ruleSet *ScoringRuleSet) (*ScoringRuleSet, error) {
+	if ruleSet.Scope != ScoringRuleSetScopeContest || ruleSet.FallbackRuleSetID == nil {
+		return nil, nil
+	}
+	fallback, err := s.repo.FindScoringRuleSetByID(ctx, *ruleSet.FallbackRuleSetID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find fallback rule set: %w", err)
+	}
+	return fallback, nil
+}
+
+type scoringRuleConditions struct {
+	stackable    bool
+	activityID   int32
+	unitKey      string
+	languageCode string
+	tag          string
+	tags         string
+	tagMatch     ScoringTagMatch
+	valueRange   string
+	window       string
+	scoreSource  ScoreSource
+}
+
+func scoringRuleConditionsOf(rule ScoringRule) scoringRuleConditions {
+	tags := append([]string(nil), rule.Tags...)
+	sort.Strings(tags)
+	tagMatch := rule.TagMatch
+	if len(tags) > 0 && tagMatch == "" {
+		tagMatch = ScoringTagMatchAny
+	}
+	return scoringRuleConditions{
+		stackable:    rule.Stackable,
+		activityID:   rule.ActivityID,
+		unitKey:      rule.UnitKey,
+		languageCode: rule.LanguageCode,
+		tag:          rule.Tag,
+		tags:         strings.Join(tags, ","),
+		tagMatch:     tagMatch,
+		valueRange:   fmt.Sprintf("%s-%s", formatOptionalFloat32(rule.MinValue), formatOptionalFloat32(rule.MaxValue)),
+		window:       fmt.Sprintf("%s-%s", formatOptionalTime(rule.EffectiveFrom), formatOptionalTime(rule.EffectiveUntil)),
+		scoreSource:  rule.ScoreSource,
+	}
+}
+
+// scoringRuleCapsEqual compares what a rule scores once its conditions match.
+func scoringRuleCapsEqual(left, right ScoringRule) bool {
+	return left.CapPeriod == right.CapPeriod &&
+		formatOptionalFloat32(left.CapValue) == formatOptionalFloat32(right.CapValue) &&
+		formatOptionalFloat32(left.CapScore) == formatOptionalFloat32(right.CapScore)
+}
+
+func formatOptionalFloat32(value *float32) string {
+	if value == nil {
+		return ""
+	}
+	return formatFloat32(*value)
+}
+
+func formatOptionalTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339Nano)
+}
+
+// DiffScoringRuleSets lists how the rules of target differ from base. Rules
+// with the same conditions are paired in priority order, paired rules changed
+// when their rate, priority or caps did.
+func DiffScoringRuleSets(base, target ScoringRuleSet) ScoringRuleSetDiff {
+	group := func(rules []ScoringRule) map[scoringRuleConditions][]ScoringRule {
+		sorted := append([]ScoringRule(nil), rules...)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+		grouped := map[scoringRuleConditions][]ScoringRule{}
+		for _, rule := range sorted {
+			key := scoringRuleConditionsOf(rule)
+			grouped[key] = append(grouped[key], rule)
+		}
+		return grouped
+	}
+	before := group(base.Rules)
+	after := group(target.Rules)
+
+	diff := ScoringRuleSetDiff{
+		ModeChanged:     base.Mode != target.Mode,
+		FallbackChanged: !uuidPtrEqual(base.FallbackRuleSetID, target.FallbackRuleSetID),
+	}
+	for key, afterRules := range after {
+		beforeRules := before[key]
+		for i, rule := range afterRules {
+			if i >= len(beforeRules) {
+				diff.Added = append(diff.Added, rule)
+				continue
+			}
+			previous := beforeRules[i]
+			if previous.Rate == rule.Rate && previous.Priority == rule.Priority && scoringRuleCapsEqual(previous, rule) {
+				diff.UnchangedCount++
+				continue
+			}
+			diff.Changed = append(diff.Changed, ScoringRuleChange{Before: previous, After: rule})
+		}
+	}
+	for key, beforeRules := range before {
+		if len(beforeRules) > len(after[key]) {
+			diff.Removed = append(diff.Removed, beforeRules[len(after[key]):]...)
+		}
+	}
+
+	byPriority := func(rules []ScoringRule) {
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Priority < rules[j].Priority })
+	}
+	byPriority(diff.Added)
+	byPriority(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool { return diff.Changed[i].After.Priority < diff.Changed[j].After.Priority })
+	return diff
+}
+
+func simulateScoringImpact(
+	sample []ScoringImpactSampleLog,
+	base ScoringRuleSet,
+	baseFallback *ScoringRuleSet,
+	target ScoringRuleSet,
+	targetFallback *ScoringRuleSet,
+) (ScoringImpactSimulation, error) {
+	simulation := ScoringImpactSimulation{SampledLogs: int32(len(sample))}
+	byActivity := map[string]*ScoringImpactGroup{}
+	byUnitKey := map[string]*ScoringImpactGroup{}
+	byLanguage := map[string]*ScoringImpactGroup{}
+	users := map[uuid.UUID]*ScoringImpactUser{}
+
+	addToGroup := func(groups map[string]*ScoringImpactGroup, key string, changed bool, before, after float32) {
+		group, ok := groups[key]
+		if !ok {
+			group = &ScoringImpactGroup{Key: key}
+			groups[key] = group
+		}
+		group.Logs++
+		if changed {
+			group.ChangedLogs++
+		}
+		group.ScoreBefore += before
+		group.ScoreAfter += after
+	}
+
+	// Caps count the user's earlier logs, so the sample is replayed in the
+	// order it was logged. Logs outside of the sample are not counted.
+	sample = append([]ScoringImpactSampleLog(nil), sample...)
+	sort.SliceStable(sample, func(i, j int) bool { return sample[i].LoggedAt.Before(sample[j].LoggedAt) })
+	baseReplay := newScoringUsageReplay()
+	targetReplay := newScoringUsageReplay()
+	locations := userLocations{}
+
+	for _, log := range sample {
+		input := scoringInputFromTracking(log.ActivityID, log.LanguageCode, log.Tags, log.Tracking, log.LoggedAt)
+		input.Location = locations.load(log.Timezone)
+		comparison, err := CompareScoringRuleSets(
+			input,
+			base,
+			baseFallback,
+			baseReplay.lookup(log.UserID),
+			target,
+			targetFallback,
+			targetReplay.lookup(log.UserID),
+		)
+		if errors.Is(err, ErrInvalidLog) {
+			simulation.SkippedLogs++
+			continue
+		}
+		if err != nil {
+			return ScoringImpactSimulation{}, fmt.Errorf("could not simulate log %s: %w", log.LogID, err)
+		}
+		baseReplay.record(log.UserID, input, comparison.Base)
+		targetReplay.record(log.UserID, input, comparison.Target)
+
+		before := comparison.Base.Score
+		after := comparison.Target.Score
+		changed := !scoringScoresEqual(before, after)
+		if changed {
+			simulation.ChangedLogs++
+		}
+		if comparison.Outcome == ScoringShadowOutcomeUnmatched {
+			simulation.UnmatchedLogs++
+		}
+		simulation.ScoreBefore += before
+		simulation.ScoreAfter += after
+
+		addToGroup(byActivity, strconv.Itoa(int(log.ActivityID)), changed, before, after)
+		addToGroup(byUnitKey, log.Tracking.UnitKey, changed, before, after)
+		addToGroup(byLanguage, log.LanguageCode, changed, before, after)
+
+		user, ok := users[log.UserID]
+		if !ok {
+			user = &ScoringImpactUser{UserID: log.UserID, UserDisplayName: log.UserDisplayName}
+			users[log.UserID] = user
+		}
+		user.Logs++
+		if changed {
+			user.ChangedLogs++
+		}
+		user.ScoreBefore += before
+		user.ScoreAfter += after
+	}
+
+	simulation.ByActivity = sortedScoringImpactGroups(byActivity)
+	simulation.ByUnitKey = sortedScoringImpactGroups(byUnitKey)
+	simulation.ByLanguage = sortedScoringImpactGroups(byLanguage)
+
+	simulation.TopUsers = []ScoringImpactUser{}
+	for _, user := range users {
+		if user.ChangedLogs > 0 {
+			simulation.TopUsers = append(simulation.TopUsers, *user)
+		}
+	}
+	sort.Slice(simulation.TopUsers, func(i, j int) bool {
+		left := scoringImpactDelta(simulation.TopUsers[i].ScoreBefore, simulation.TopUsers[i].ScoreAfter)
+		right := scoringImpactDelta(simulation.TopUsers[j].ScoreBefore, simulation.TopUsers[j].ScoreAfter)
+		if left != right {
+			return left > right
+		}
+		return simulation.TopUsers[i].UserID.String() < simulation.TopUsers[j].UserID.String()
+	})
+	if len(simulation.TopUsers) > scoringImpactTopUsers {
+		simulation.TopUsers = simulation.TopUsers[:scoringImpactTopUsers]
+	}
+
+	return simulation, nil
+}
+
+// sortedScoringImpactGroups orders groups by the size of their score change.
+func sortedScoringImpactGroups(groups map[string]*ScoringImpactGroup) []ScoringImpactGroup {
+	result := make([]ScoringImpactGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		left := scoringImpactDelta(result[i].ScoreBefore, result[i].ScoreAfter)
+		right := scoringImpactDelta(result[j].ScoreBefore, result[j].ScoreAfter)
+		if left != right {
+			return left > right
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+func scoringImpactDelta(before, after float32) float64 {
+	return math.Abs(float64(after - before))
+}
+
+func uuidPtrEqual(left, right *uuid.UUID) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return *left == *right
+}
@@ -0,0 +1,339 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockScoringRuleSetImpactRepository struct {
+	contest        *domain.ContestView
+	ruleSets       map[uuid.UUID]*domain.ScoringRuleSet
+	activePlatform *domain.ScoringRuleSet
+	activeContest  *domain.ScoringRuleSet
+	sample         []domain.ScoringImpactSampleLog
+
+	sampleReq *domain.ScoringImpactSampleRequest
+}
+
+func (m *mockScoringRuleSetImpactRepository) FindContestByID(context.Context, *domain.ContestFindRequest) (*domain.ContestView, error) {
+	if m.contest == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.contest, nil
+}
+
+func (m *mockScoringRuleSetImpactRepository) FindScoringRuleSetByID(_ context.Context, id uuid.UUID) (*domain.ScoringRuleSet, error) {
+	ruleSet, ok := m.ruleSets[id]
+	if !ok {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	copied := *ruleSet
+	return &copied, nil
+}
+
+func (m *mockScoringRuleSetImpactRepository) FindActivePlatformScoringRuleSet(context.Context) (*domain.ScoringRuleSet, error) {
+	if m.activePlatform == nil {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return m.activePlatform, nil
+}
+
+func (m *mockScoringRuleSetImpactRepository) FindContestScoringRuleSets(context.Context, uuid.UUID) (*domain.ScoringRuleSet, *domain.ScoringRuleSet, error) {
+	return m.activeContest, nil, nil
+}
+
+func (m *mockScoringRuleSetImpactRepository) ListScoringImpactSample(_ context.Context, req *domain.ScoringImpactSampleRequest) ([]domain.ScoringImpactSampleLog, error) {
+	m.sampleReq = req
+	return m.sample, nil
+}
+
+func impactRule(activityID int32, unitKey string, rate float32, priority int32) domain.ScoringRule {
+	return domain.ScoringRule{
+		ID:          uuid.New(),
+		Priority:    priority,
+		ActivityID:  activityID,
+		UnitKey:     unitKey,
+		ScoreSource: domain.ScoreSourceAmount,
+		Rate:        rate,
+	}
+}
+
+func impactSampleLog(userID uuid.UUID, activityID int32, unitKey, language string, amount float32) domain.ScoringImpactSampleLog {
+	return domain.ScoringImpactSampleLog{
+		LogID:           uuid.New(),
+		UserID:          userID,
+		UserDisplayName: userID.String()[:8],
+		ActivityID:      activityID,
+		LanguageCode:    language,
+		Tracking: domain.LogTracking{
+			Kind:     domain.LogTrackingAmountUnit,
+			UnitKey:  unitKey,
+			Amount:   amount,
+			Modifier: 1,
+		},
+	}
+}
+
+func TestDiffScoringRuleSets(t *testing.T) {
+	kept := impactRule(1, domain.UnitKeyReadingPage, 1, 1)
+	changedBefore := impactRule(2, "listening_minute", 0.5, 2)
+	removed := impactRule(3, "writing_character", 0.1, 3)
+
+	changedAfter := changedBefore
+	changedAfter.ID = uuid.New()
+	changedAfter.Rate = 0.75
+	keptAfter := kept
+	keptAfter.ID = uuid.New()
+	added := impactRule(1, "reading_book", 100, 4)
+
+	fallbackID := uuid.New()
+	diff := domain.DiffScoringRuleSets(
+		domain.ScoringRuleSet{Rules: []domain.ScoringRule{kept, changedBefore, removed}},
+		domain.ScoringRuleSet{
+			Mode:              domain.ScoringRuleSetModeOverride,
+			FallbackRuleSetID: &fallbackID,
+			Rules:             []domain.ScoringRule{added, changedAfter, keptAfter},
+		},
+	)
+
+	assert.Equal(t, []domain.ScoringRule{added}, diff.Added)
+	assert.Equal(t, []domain.ScoringRule{removed}, diff.Removed)
+	assert.Equal(t, []domain.ScoringRuleChange{{Before: changedBefore, After: changedAfter}}, diff.Changed)
+	assert.Equal(t, int32(1), diff.UnchangedCount)
+	assert.True(t, diff.ModeChanged)
+	assert.True(t, diff.FallbackChanged)
+}
+
+func TestScoringRuleSetImpact_Execute(t *testing.T) {
+	now := time.Date(2026, 8, 10, 12, 0, 0, 0, time.UTC)
+	userA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	userB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+
+	active := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+		Rules: []domain.ScoringRule{
+			impactRule(1, domain.UnitKeyReadingPage, 1, 1),
+			impactRule(2, "listening_minute", 0.5, 2),
+		},
+	}
+	draftRules := []domain.ScoringRule{active.Rules[1], impactRule(1, domain.UnitKeyReadingPage, 2, 1)}
+	draft := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusDraft,
+		Rules:  draftRules,
+	}
+
+	newRepo := func() *mockScoringRuleSetImpactRepository {
+		return &mockScoringRuleSetImpactRepository{
+			ruleSets:       map[uuid.UUID]*domain.ScoringRuleSet{active.ID: active, draft.ID: draft},
+			activePlatform: active,
+			sample: []domain.ScoringImpactSampleLog{
+				impactSampleLog(userA, 1, domain.UnitKeyReadingPage, "jpn", 10),
+				impactSampleLog(userA, 1, domain.UnitKeyReadingPage, "kor", 5),
+				impactSampleLog(userB, 1, domain.UnitKeyReadingPage, "jpn", 1),
+				impactSampleLog(userB, 2, "listening_minute", "jpn", 30),
+				{LogID: uuid.New(), UserID: userB, ActivityID: 1, LanguageCode: "jpn", Tracking: domain.LogTracking{ComputedScore: 5}},
+			},
+		}
+	}
+
+	t.Run("compares to the active rule set and aggregates the simulation", func(t *testing.T) {
+		repo := newRepo()
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: draft.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, active.ID, res.BaseRuleSet.ID)
+		assert.True(t, res.BaseRuleSet.Active)
+		assert.False(t, res.RuleSet.Active)
+		require.Len(t, res.Diff.Changed, 1)
+		assert.Equal(t, float32(2), res.Diff.Changed[0].After.Rate)
+		assert.Equal(t, int32(1), res.Diff.UnchangedCount)
+		assert.Equal(t, int32(domain.DefaultScoringImpactSampleSize), repo.sampleReq.Limit)
+		assert.Nil(t, repo.sampleReq.ContestID)
+
+		sim := res.Simulation
+		assert.Equal(t, int32(5), sim.SampledLogs)
+		assert.Equal(t, int32(1), sim.SkippedLogs)
+		assert.Equal(t, int32(3), sim.ChangedLogs)
+		assert.Equal(t, int32(0), sim.UnmatchedLogs)
+		assert.InDelta(t, 31, sim.ScoreBefore, 0.0001)
+		assert.InDelta(t, 47, sim.ScoreAfter, 0.0001)
+
+		require.Len(t, sim.ByActivity, 2)
+		assert.Equal(t, "1", sim.ByActivity[0].Key)
+		assert.Equal(t, int32(3), sim.ByActivity[0].ChangedLogs)
+		assert.Equal(t, "2", sim.ByActivity[1].Key)
+		assert.Equal(t, int32(0), sim.ByActivity[1].ChangedLogs)
+
+		require.Len(t, sim.ByLanguage, 2)
+		assert.Equal(t, "jpn", sim.ByLanguage[0].Key)
+		assert.InDelta(t, 26, sim.ByLanguage[0].ScoreBefore, 0.0001)
+		assert.InDelta(t, 37, sim.ByLanguage[0].ScoreAfter, 0.0001)
+
+		require.Len(t, sim.ByUnitKey, 2)
+		assert.Equal(t, domain.UnitKeyReadingPage, sim.ByUnitKey[0].Key)
+
+		require.Len(t, sim.TopUsers, 2)
+		assert.Equal(t, userA, sim.TopUsers[0].UserID)
+		assert.InDelta(t, 15, sim.TopUsers[0].ScoreBefore, 0.0001)
+		assert.InDelta(t, 30, sim.TopUsers[0].ScoreAfter, 0.0001)
+		assert.Equal(t, userB, sim.TopUsers[1].UserID)
+		assert.Equal(t, int32(2), sim.TopUsers[1].Logs)
+		assert.Equal(t, int32(1), sim.TopUsers[1].ChangedLogs)
+	})
+
+	t.Run("caps the sample size", func(t *testing.T) {
+		repo := newRepo()
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: draft.ID, SampleSize: 100000})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.MaxScoringImpactSampleSize), repo.sampleReq.Limit)
+	})
+
+	t.Run("compares to an explicit base rule set", func(t *testing.T) {
+		repo := newRepo()
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: active.ID, BaseRuleSetID: &draft.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, draft.ID, res.BaseRuleSet.ID)
+		assert.InDelta(t, 47, res.Simulation.ScoreBefore, 0.0001)
+		assert.InDelta(t, 31, res.Simulation.ScoreAfter, 0.0001)
+	})
+
+	t.Run("fails without an active rule set to compare with", func(t *testing.T) {
+		repo := newRepo()
+		repo.activePlatform = nil
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: draft.ID})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		svc := domain.NewScoringRuleSetImpact(newRepo(), &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.ScoringRuleSetImpactRequest{RuleSetID: draft.ID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("platform rule sets are admin only", func(t *testing.T) {
+		svc := domain.NewScoringRuleSetImpact(newRepo(), &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUser(), &domain.ScoringRuleSetImpactRequest{RuleSetID: draft.ID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestScoringRuleSetImpact_ExecuteContest(t *testing.T) {
+	now := time.Date(2026, 8, 10, 12, 0, 0, 0, time.UTC)
+	contestID := uuid.New()
+	organizerID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	platform := &domain.ScoringRuleSet{
+		ID:     uuid.New(),
+		Scope:  domain.ScoringRuleSetScopePlatform,
+		Status: domain.ScoringRuleSetStatusPublished,
+		Rules:  []domain.ScoringRule{impactRule(1, domain.UnitKeyReadingPage, 1, 1)},
+	}
+	contestDraft := &domain.ScoringRuleSet{
+		ID:                uuid.New(),
+		Scope:             domain.ScoringRuleSetScopeContest,
+		ContestID:         &contestID,
+		Status:            domain.ScoringRuleSetStatusDraft,
+		Mode:              domain.ScoringRuleSetModeOverride,
+		FallbackRuleSetID: &platform.ID,
+		Rules:             []domain.ScoringRule{impactRule(1, domain.UnitKeyReadingPage, 3, 1)},
+	}
+
+	newRepo := func(contestStart time.Time) *mockScoringRuleSetImpactRepository {
+		return &mockScoringRuleSetImpactRepository{
+			contest: &domain.ContestView{
+				ID:                contestID,
+				OwnerUserID:       uuid.New(),
+				ContestStart:      contestStart,
+				AllowedLanguages:  []domain.Language{{Code: "jpn", Name: "Japanese"}},
+				AllowedActivities: []domain.Activity{{ID: 1, Name: "Reading"}},
+			},
+			ruleSets:       map[uuid.UUID]*domain.ScoringRuleSet{platform.ID: platform, contestDraft.ID: contestDraft},
+			activePlatform: platform,
+			sample: []domain.ScoringImpactSampleLog{
+				impactSampleLog(organizerID, 1, domain.UnitKeyReadingPage, "jpn", 10),
+			},
+		}
+	}
+
+	t.Run("samples the participant logs an upcoming contest would accept", func(t *testing.T) {
+		repo := newRepo(now.Add(24 * time.Hour))
+		organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+		svc := domain.NewScoringRuleSetImpact(repo, organizers, commondomain.NewMockClock(now))
+
+		res, err := svc.Execute(ctxWithUserSubject(organizerID.String()), &domain.ScoringRuleSetImpactRequest{RuleSetID: contestDraft.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, platform.ID, res.BaseRuleSet.ID)
+		assert.Nil(t, repo.sampleReq.ContestID)
+		assert.Equal(t, &contestID, repo.sampleReq.RegisteredContestID)
+		assert.Equal(t, []string{"jpn"}, repo.sampleReq.LanguageCodes)
+		assert.Equal(t, []int32{1}, repo.sampleReq.ActivityIDs)
+		assert.InDelta(t, 10, res.Simulation.ScoreBefore, 0.0001)
+		assert.InDelta(t, 30, res.Simulation.ScoreAfter, 0.0001)
+	})
+
+	t.Run("samples the contest logs once it started", func(t *testing.T) {
+		repo := newRepo(now.Add(-24 * time.Hour))
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: contestDraft.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, &contestID, repo.sampleReq.ContestID)
+		assert.Nil(t, repo.sampleReq.RegisteredContestID)
+		assert.Empty(t, repo.sampleReq.LanguageCodes)
+	})
+
+	t.Run("forbids users not organizing the contest", func(t *testing.T) {
+		repo := newRepo(now.Add(24 * time.Hour))
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUser(), &domain.ScoringRuleSetImpactRequest{RuleSetID: contestDraft.ID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, repo.sampleReq)
+	})
+
+	t.Run("rejects base rule sets of other contests", func(t *testing.T) {
+		otherContestID := uuid.New()
+		other := &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &otherContestID,
+			Status:    domain.ScoringRuleSetStatusPublished,
+		}
+		repo := newRepo(now.Add(24 * time.Hour))
+		repo.ruleSets[other.ID] = other
+		svc := domain.NewScoringRuleSetImpact(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdmin(), &domain.ScoringRuleSetImpactRequest{RuleSetID: contestDraft.ID, BaseRuleSetID: &other.ID})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidScoringRuleSet)
+	})
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -14,10 +15,14 @@ type ScoringRuleSetManagementRepository interface {
 	FindContestByID(context.Context, *ContestFindRequest) (*ContestView, error)
 	FindScoringRuleSetByID(context.Context, uuid.UUID) (*ScoringRuleSet, error)
 	ListLanguages(context.Context) ([]Language, error)
+	ListUnits(ctx context.Context, includeDeprecated bool) ([]Unit, error)
 	ListPlatformScoringRuleSets(context.Context) ([]ScoringRuleSet, error)
 	ListContestScoringRuleSets(context.Context, uuid.UUID) ([]ScoringRuleSet, error)
 	CreateScoringRuleSetDraft(context.Context, *ScoringRuleSetDraftCreateRequest) (*ScoringRuleSet, error)
+	UpdateScoringRuleSetDraft(context.Context, uuid.UUID, *ScoringRuleSetDraftCreateRequest) (*ScoringRuleSet, error)
+	DeleteScoringRuleSetDraft(context.Context, uuid.UUID) error
 	PublishScoringRuleSet(context.Context, uuid.UUID, time.Time) (*ScoringRuleSet, error)
+	ArchiveScoringRuleSet(context.Context, uuid.UUID, time.Time) (*ScoringRuleSet, error)
 	ActivatePlatformScoringRuleSet(context.Context, uuid.UUID) error
 	ActivateContestScoringRuleSet(context.Context, uuid.UUID, uuid.UUID, time.Time) error
 }
@@ -33,16 +38,34 @@ type ScoringRuleSetDraftCreateRequest struct {
 
 func (r *ScoringRuleSetDraftCreateRequest) Scope() ScoringRuleSetScope { return r.scope }
 
+// ScoringRescoreScheduler schedules rescoring the logs affected by a rule set
+// that was just activated.
+type ScoringRescoreScheduler interface {
+	ScheduleActivationRescore(context.Context, *ScoringRuleSet) error
+}
+
 type ScoringRuleSetManagement struct {
-	repo  ScoringRuleSetManagementRepository
-	clock commondomain.Clock
+	repo       ScoringRuleSetManagementRepository
+	organizers ContestOrganizerChecker
+	clock      commondomain.Clock
+	rescoring  ScoringRescoreScheduler
 }
 
 func NewScoringRuleSetManagement(
 	repo ScoringRuleSetManagementRepository,
+	organizers ContestOrganizerChecker,
+	clock commondomain.Clock,
+) *ScoringRuleSetManagement {
+	return &ScoringRuleSetManagement{repo: repo, organizers: organizers, clock: clock}
+}
+
+func NewScoringRuleSetManagementWithRescoring(
+	repo ScoringRuleSetManagementRepository,
+	organizers ContestOrganizerChecker,
 	clock commondomain.Clock,
+	rescoring ScoringRescoreScheduler,
 ) *ScoringRuleSetManagement {
-	return &ScoringRuleSetManagement{repo: repo, clock: clock}
+	return &ScoringRuleSetManagement{repo: repo, organizers: organizers, clock: clock, rescoring: rescoring}
 }
 
 func (s *ScoringRuleSetManagement) ListPlatform(ctx context.Context) ([]ScoringRuleSet, error) {
@@ -69,7 +92,7 @@ func (s *ScoringRuleSetManagement) ListContest(
 	ctx context.Context,
 	contestID uuid.UUID,
 ) ([]ScoringRuleSet, error) {
-	if _, err := s.requireContestOwner(ctx, contestID); err != nil {
+	if _, err := requireContestOrganizer(ctx, s.repo, s.organizers, contestID); err != nil {
 		return nil, err
 	}
 	return s.repo.ListContestScoringRuleSets(ctx, contestID)
@@ -97,7 +120,12 @@ func (s *ScoringRuleSetManagement) CreateContestDraft(
 	contestID uuid.UUID,
 	req *ScoringRuleSetDraftCreateRequest,
 ) (*ScoringRuleSet, error) {
-	if err := s.requireContestOwnerBeforeStart(ctx, contestID); err != nil {
+	// Co-organizers can draft, publishing and activating is left to the owner
+	contest, err := requireContestOrganizer(ctx, s.repo, s.organizers, contestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireBeforeStart(contest); err != nil {
 		return nil, err
 	}
 	req.scope = ScoringRuleSetScopeContest
@@ -108,6 +136,69 @@ func (s *ScoringRuleSetManagement) CreateContestDraft(
 	return s.repo.CreateScoringRuleSetDraft(ctx, req)
 }
 
+// UpdateDraft replaces the mode, fallback and rules of a draft.
+func (s *ScoringRuleSetManagement) UpdateDraft(
+	ctx context.Context,
+	ruleSetID uuid.UUID,
+	req *ScoringRuleSetDraftCreateRequest,
+) (*ScoringRuleSet, error) {
+	ruleSet, err := s.findDraft(ctx, ruleSetID)
+	if err != nil {
+		return nil, err
+	}
+	req.scope = ruleSet.Scope
+	req.ContestID = ruleSet.ContestID
+	if ruleSet.Scope == ScoringRuleSetScopePlatform {
+		req.Mode = ""
+		req.FallbackRuleSetID = nil
+	}
+	if err := s.validateDraft(ctx, req); err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateScoringRuleSetDraft(ctx, ruleSetID, req)
+}
+
+func (s *ScoringRuleSetManagement) DeleteDraft(
+	ctx context.Context,
+	ruleSetID uuid.UUID,
+) error {
+	if _, err := s.findDraft(ctx, ruleSetID); err != nil {
+		return err
+	}
+	return s.repo.DeleteScoringRuleSetDraft(ctx, ruleSetID)
+}
+
+// Clone creates a draft with the next version from a copy of any rule set in
+// the same scope, as the starting point for changing it.
+func (s *ScoringRuleSetManagement) Clone(
+	ctx context.Context,
+	ruleSetID uuid.UUID,
+) (*ScoringRuleSet, error) {
+	source, err := s.repo.FindScoringRuleSetByID(ctx, ruleSetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeDraftChange(ctx, source); err != nil {
+		return nil, err
+	}
+	rules := make([]ScoringRule, len(source.Rules))
+	for i, rule := range source.Rules {
+		rule.ID = uuid.Nil
+		rules[i] = rule
+	}
+	req := &ScoringRuleSetDraftCreateRequest{
+		ContestID:         source.ContestID,
+		Mode:              source.Mode,
+		FallbackRuleSetID: source.FallbackRuleSetID,
+		Rules:             rules,
+		scope:             source.Scope,
+	}
+	if err := s.validateDraft(ctx, req); err != nil {
+		return nil, err
+	}
+	return s.repo.CreateScoringRuleSetDraft(ctx, req)
+}
+
 func (s *ScoringRuleSetManagement) Publish(
 	ctx context.Context,
 	ruleSetID uuid.UUID,
@@ -141,15 +232,75 @@ func (s *ScoringRuleSetManagement) Activate(
 	}
 	switch ruleSet.Scope {
 	case ScoringRuleSetScopePlatform:
-		return s.repo.ActivatePlatformScoringRuleSet(ctx, ruleSetID)
+		err = s.repo.ActivatePlatformScoringRuleSet(ctx, ruleSetID)
 	case ScoringRuleSetScopeContest:
 		if ruleSet.ContestID == nil {
 			return ErrInvalidScoringRuleSet
 		}
-		return s.repo.ActivateContestScoringRuleSet(ctx, *ruleSet.ContestID, ruleSetID, s.clock.Now())
+		err = s.repo.ActivateContestScoringRuleSet(ctx, *ruleSet.ContestID, ruleSetID, s.clock.Now())
 	default:
 		return ErrInvalidScoringRuleSet
 	}
+	if err != nil {
+		return err
+	}
+
+	// The rule set is active either way, a missed rescore can be requested
+	// on demand.
+	if s.rescoring != nil {
+		if err := s.rescoring.ScheduleActivationRescore(ctx, ruleSet); err != nil {
+			slog.ErrorContext(ctx, "could not schedule rescoring after activating rule set", "rule_set_id", ruleSetID, "error", err)
+		}
+	}
+	return nil
+}
+
+// Archive hides a published rule set that is no longer active. Archiving
+// old contest versions stays possible after the contest starts.
+func (s *ScoringRuleSetManagement) Archive(
+	ctx context.Context,
+	ruleSetID uuid.UUID,
+) (*ScoringRuleSet, error) {
+	ruleSet, err := s.repo.FindScoringRuleSetByID(ctx, ruleSetID)
+	if err != nil {
+		return nil, err
+	}
+	switch ruleSet.Scope {
+	case ScoringRuleSetScopePlatform:
+		if !isAdmin(ctx) {
+			return nil, ErrForbidden
+		}
+	case ScoringRuleSetScopeContest:
+		if ruleSet.ContestID == nil {
+			return nil, ErrInvalidScoringRuleSet
+		}
+		if _, err := s.requireContestOwner(ctx, *ruleSet.ContestID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidScoringRuleSet
+	}
+	if ruleSet.Status != ScoringRuleSetStatusPublished {
+		return nil, fmt.Errorf("only published rule sets can be archived: %w", ErrConflict)
+	}
+	return s.repo.ArchiveScoringRuleSet(ctx, ruleSetID, s.clock.Now())
+}
+
+func (s *ScoringRuleSetManagement) findDraft(
+	ctx context.Context,
+	ruleSetID uuid.UUID,
+) (*ScoringRuleSet, error) {
+	ruleSet, err := s.repo.FindScoringRuleSetByID(ctx, ruleSetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeDraftChange(ctx, ruleSet); err != nil {
+		return nil, err
+	}
+	if ruleSet.Status != ScoringRuleSetStatusDraft {
+		return nil, fmt.Errorf("only draft rule sets can be changed: %w", ErrConflict)
+	}
+	return ruleSet, nil
 }
 
 func (s *ScoringRuleSetManagement) validateDraft(
@@ -185,6 +336,15 @@ func (s *ScoringRuleSetManagement) validateDraft(
 	for _, language := range languages {
 		languageCodes[language.Code] = struct{}{}
 	}
+	// Deprecated units stay valid so existing rules for them can be kept.
+	units, err := s.repo.ListUnits(ctx, true)
+	if err != nil {
+		return fmt.Errorf("could not validate scoring rule units: %w", err)
+	}
+	unitActivities := make(map[string]int, len(units))
+	for _, unit := range units {
+		unitActivities[unit.Key] = unit.LogActivityID
+	}
 	for i := range req.Rules {
 		rule := &req.Rules[i]
 		rule.Tag = strings.ToLower(strings.TrimSpace(rule.Tag))
@@ -193,8 +353,8 @@ func (s *ScoringRuleSetManagement) validateDraft(
 			return fmt.Errorf("rule priority must be non-negative: %w", ErrInvalidScoringRuleSet)
 		}
 		if rule.UnitKey != "" {
-			unit, ok := UnitDefinitionByKey(rule.UnitKey)
-			if !ok || unit.ActivityID != rule.ActivityID {
+			activityID, ok := unitActivities[rule.UnitKey]
+			if !ok || int32(activityID) != rule.ActivityID {
 				return fmt.Errorf("unit key %q is not valid for activity %d: %w", rule.UnitKey, rule.ActivityID, ErrInvalidScoringRuleSet)
 			}
 		}
@@ -213,6 +373,27 @@ func (s *ScoringRuleSetManagement) validateDraft(
 			}
 			rule.Tag = tags[0]
 		}
+		if len(rule.Tags) > 0 {
+			tags, err := ValidateAndNormalizeTags(rule.Tags)
+			if err != nil {
+				return fmt.Errorf("rule tags are invalid: %w", ErrInvalidScoringRuleSet)
+			}
+			rule.Tags = tags
+		}
+		if len(rule.Tags) > 0 && rule.TagMatch == "" {
+			rule.TagMatch = ScoringTagMatchAny
+		}
+		if len(rule.Tags) == 0 && rule.TagMatch != "" {
+			return fmt.Errorf("rule tag match requires tags: %w", ErrInvalidScoringRuleSet)
+		}
+		if rule.EffectiveFrom != nil {
+			from := rule.EffectiveFrom.UTC()
+			rule.EffectiveFrom = &from
+		}
+		if rule.EffectiveUntil != nil {
+			until := rule.EffectiveUntil.UTC()
+			rule.EffectiveUntil = &until
+		}
 	}
 	if err := validateScoringRules(req.Rules); err != nil {
 		return err
@@ -240,6 +421,32 @@ func (s *ScoringRuleSetManagement) authorizeRuleSetChange(
 	}
 }
 
+// authorizeDraftChange is like authorizeRuleSetChange but also lets contest
+// co-organizers through, like creating drafts does.
+func (s *ScoringRuleSetManagement) authorizeDraftChange(
+	ctx context.Context,
+	ruleSet *ScoringRuleSet,
+) error {
+	switch ruleSet.Scope {
+	case ScoringRuleSetScopePlatform:
+		if !isAdmin(ctx) {
+			return ErrForbidden
+		}
+		return nil
+	case ScoringRuleSetScopeContest:
+		if ruleSet.ContestID == nil {
+			return ErrInvalidScoringRuleSet
+		}
+		contest, err := requireContestOrganizer(ctx, s.repo, s.organizers, *ruleSet.ContestID)
+		if err != nil {
+			return err
+		}
+		return s.requireBeforeStart(contest)
+	default:
+		return ErrInvalidScoringRuleSet
+	}
+}
+
 func (s *ScoringRuleSetManagement) requireContestOwnerBeforeStart(
 	ctx context.Context,
 	contestID uuid.UUID,
@@ -248,6 +455,10 @@ func (s *ScoringRuleSetManagement) requireContestOwnerBeforeStart(
 	if err != nil {
 		return err
 	}
+	return s.requireBeforeStart(contest)
+}
+
+func (s *ScoringRuleSetManagement) requireBeforeStart(contest *ContestView) error {
 	if !s.clock.Now().Before(contest.ContestStart) {
 		return fmt.Errorf("contest scoring cannot change after the contest starts: %w", ErrConflict)
 	}
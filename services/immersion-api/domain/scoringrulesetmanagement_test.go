@@ -16,7 +16,10 @@ type mockScoringRuleSetManagementRepository struct {
 	contest          *domain.ContestView
 	ruleSet          *domain.ScoringRuleSet
 	createdWith      *domain.ScoringRuleSetDraftCreateRequest
+	updatedWith      *domain.ScoringRuleSetDraftCreateRequest
+	deleted          uuid.UUID
 	published        bool
+	archived         uuid.UUID
 	activatedContest uuid.UUID
 	activatedRuleSet uuid.UUID
 }
@@ -37,6 +40,15 @@ func (m *mockScoringRuleSetManagementRepository) ListLanguages(context.Context)
 	return []domain.Language{{Code: "jpn", Name: "Japanese"}}, nil
 }
 
+func (m *mockScoringRuleSetManagementRepository) ListUnits(context.Context, bool) ([]domain.Unit, error) {
+	definitions := domain.UnitDefinitions()
+	units := make([]domain.Unit, len(definitions))
+	for i, definition := range definitions {
+		units[i] = domain.Unit{Key: definition.Key, LogActivityID: int(definition.ActivityID), Name: definition.Name}
+	}
+	return units, nil
+}
+
 func (m *mockScoringRuleSetManagementRepository) ListContestScoringRuleSets(context.Context, uuid.UUID) ([]domain.ScoringRuleSet, error) {
 	return nil, nil
 }
@@ -46,6 +58,21 @@ func (m *mockScoringRuleSetManagementRepository) CreateScoringRuleSetDraft(_ con
 	return &domain.ScoringRuleSet{ID: uuid.New(), Status: domain.ScoringRuleSetStatusDraft}, nil
 }
 
+func (m *mockScoringRuleSetManagementRepository) UpdateScoringRuleSetDraft(_ context.Context, id uuid.UUID, req *domain.ScoringRuleSetDraftCreateRequest) (*domain.ScoringRuleSet, error) {
+	m.updatedWith = req
+	return &domain.ScoringRuleSet{ID: id, Status: domain.ScoringRuleSetStatusDraft}, nil
+}
+
+func (m *mockScoringRuleSetManagementRepository) DeleteScoringRuleSetDraft(_ context.Context, id uuid.UUID) error {
+	m.deleted = id
+	return nil
+}
+
+func (m *mockScoringRuleSetManagementRepository) ArchiveScoringRuleSet(_ context.Context, id uuid.UUID, _ time.Time) (*domain.ScoringRuleSet, error) {
+	m.archived = id
+	return &domain.ScoringRuleSet{ID: id, Status: domain.ScoringRuleSetStatusArchived}, nil
+}
+
 func (m *mockScoringRuleSetManagementRepository) PublishScoringRuleSet(context.Context, uuid.UUID, time.Time) (*domain.ScoringRuleSet, error) {
 	m.published = true
 	return &domain.ScoringRuleSet{Status: domain.ScoringRuleSetStatusPublished}, nil
@@ -84,7 +111,7 @@ func TestScoringRuleSetManagementCreatesNormalizedContestDraft(t *testing.T) {
 			Status: domain.ScoringRuleSetStatusPublished,
 		},
 	}
-	service := domain.NewScoringRuleSetManagement(repo, commondomain.NewMockClock(now))
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
 
 	_, err := service.CreateContestDraft(
 		ctxWithUserSubject(userID.String()),
@@ -114,7 +141,7 @@ func TestScoringRuleSetManagementCreatesNormalizedContestDraft(t *testing.T) {
 
 func TestScoringRuleSetManagementRejectsMismatchedUnit(t *testing.T) {
 	repo := &mockScoringRuleSetManagementRepository{}
-	service := domain.NewScoringRuleSetManagement(repo, commondomain.NewMockClock(time.Now()))
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()))
 
 	_, err := service.CreatePlatformDraft(ctxWithAdmin(), &domain.ScoringRuleSetDraftCreateRequest{
 		Rules: []domain.ScoringRule{{
@@ -141,7 +168,7 @@ func TestScoringRuleSetManagementRejectsContestChangeAfterStart(t *testing.T) {
 			ContestStart: now,
 		},
 	}
-	service := domain.NewScoringRuleSetManagement(repo, commondomain.NewMockClock(now))
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
 
 	_, err := service.CreateContestDraft(
 		ctxWithUserSubject(userID.String()),
@@ -170,7 +197,7 @@ func TestScoringRuleSetManagementActivatesPublishedContestVersion(t *testing.T)
 			Status:    domain.ScoringRuleSetStatusPublished,
 		},
 	}
-	service := domain.NewScoringRuleSetManagement(repo, commondomain.NewMockClock(now))
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
 
 	err := service.Activate(ctxWithUserSubject(userID.String()), ruleSetID)
 
@@ -188,10 +215,224 @@ func TestScoringRuleSetManagementDoesNotRepublishPublishedVersion(t *testing.T)
 			Status: domain.ScoringRuleSetStatusPublished,
 		},
 	}
-	service := domain.NewScoringRuleSetManagement(repo, commondomain.NewMockClock(time.Now()))
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()))
 
 	_, err := service.Publish(ctxWithAdmin(), ruleSetID)
 
 	assert.ErrorIs(t, err, domain.ErrConflict)
 	assert.False(t, repo.published)
 }
+
+func TestScoringRuleSetManagementLetsCoOrganizersDraftButNotPublish(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	organizerID := uuid.New()
+	contestID := uuid.New()
+	ruleSetID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		contest: &domain.ContestView{
+			ID:           contestID,
+			OwnerUserID:  uuid.New(),
+			ContestStart: now.Add(time.Hour),
+		},
+		ruleSet: &domain.ScoringRuleSet{
+			ID:        ruleSetID,
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Status:    domain.ScoringRuleSetStatusDraft,
+		},
+	}
+	organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{organizerID}}
+	service := domain.NewScoringRuleSetManagement(repo, organizers, commondomain.NewMockClock(now))
+	ctx := ctxWithUserSubject(organizerID.String())
+
+	_, err := service.CreateContestDraft(ctx, contestID, &domain.ScoringRuleSetDraftCreateRequest{
+		Mode: domain.ScoringRuleSetModeReplace,
+		Rules: []domain.ScoringRule{{
+			Priority:    1,
+			ActivityID:  1,
+			ScoreSource: domain.ScoreSourceAmount,
+			Rate:        1,
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, repo.createdWith)
+
+	_, err = service.Publish(ctx, ruleSetID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.False(t, repo.published)
+}
+
+type mockScoringRescoreScheduler struct {
+	scheduled *domain.ScoringRuleSet
+}
+
+func (m *mockScoringRescoreScheduler) ScheduleActivationRescore(_ context.Context, ruleSet *domain.ScoringRuleSet) error {
+	m.scheduled = ruleSet
+	return nil
+}
+
+func TestScoringRuleSetManagementSchedulesRescoreOnActivation(t *testing.T) {
+	ruleSetID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		ruleSet: &domain.ScoringRuleSet{
+			ID:     ruleSetID,
+			Scope:  domain.ScoringRuleSetScopePlatform,
+			Status: domain.ScoringRuleSetStatusPublished,
+		},
+	}
+	rescoring := &mockScoringRescoreScheduler{}
+	service := domain.NewScoringRuleSetManagementWithRescoring(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()), rescoring)
+
+	err := service.Activate(ctxWithAdmin(), ruleSetID)
+
+	require.NoError(t, err)
+	assert.Equal(t, ruleSetID, repo.activatedRuleSet)
+	require.NotNil(t, rescoring.scheduled)
+	assert.Equal(t, ruleSetID, rescoring.scheduled.ID)
+}
+
+func TestScoringRuleSetManagementUpdatesOnlyDrafts(t *testing.T) {
+	ruleSetID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		ruleSet: &domain.ScoringRuleSet{
+			ID:     ruleSetID,
+			Scope:  domain.ScoringRuleSetScopePlatform,
+			Status: domain.ScoringRuleSetStatusDraft,
+		},
+	}
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(time.Now()))
+	req := func() *domain.ScoringRuleSetDraftCreateRequest {
+		return &domain.ScoringRuleSetDraftCreateRequest{
+			Mode: domain.ScoringRuleSetModeReplace,
+			Rules: []domain.ScoringRule{{
+				Priority:    1,
+				ActivityID:  1,
+				Tags:        []string{" Novel "},
+				ScoreSource: domain.ScoreSourceAmount,
+				Rate:        1,
+			}},
+		}
+	}
+
+	_, err := service.UpdateDraft(ctxWithAdmin(), ruleSetID, req())
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.updatedWith)
+	assert.Equal(t, domain.ScoringRuleSetScopePlatform, repo.updatedWith.Scope())
+	assert.Empty(t, repo.updatedWith.Mode)
+	assert.Equal(t, []string{"novel"}, repo.updatedWith.Rules[0].Tags)
+	assert.Equal(t, domain.ScoringTagMatchAny, repo.updatedWith.Rules[0].TagMatch)
+
+	repo.updatedWith = nil
+	repo.ruleSet.Status = domain.ScoringRuleSetStatusPublished
+	_, err = service.UpdateDraft(ctxWithAdmin(), ruleSetID, req())
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Nil(t, repo.updatedWith)
+
+	err = service.DeleteDraft(ctxWithAdmin(), ruleSetID)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Equal(t, uuid.Nil, repo.deleted)
+}
+
+func TestScoringRuleSetManagementLetsCoOrganizersDeleteDrafts(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	contestID := uuid.New()
+	coOrganizerID := uuid.New()
+	ruleSetID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		contest: &domain.ContestView{
+			ID:           contestID,
+			OwnerUserID:  uuid.New(),
+			ContestStart: now.Add(time.Hour),
+		},
+		ruleSet: &domain.ScoringRuleSet{
+			ID:        ruleSetID,
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Status:    domain.ScoringRuleSetStatusDraft,
+		},
+	}
+	organizers := &mockContestOrganizerClient{organizers: []uuid.UUID{coOrganizerID}}
+	service := domain.NewScoringRuleSetManagement(repo, organizers, commondomain.NewMockClock(now))
+
+	err := service.DeleteDraft(ctxWithUserSubject(coOrganizerID.String()), ruleSetID)
+
+	require.NoError(t, err)
+	assert.Equal(t, ruleSetID, repo.deleted)
+}
+
+func TestScoringRuleSetManagementClonesRulesIntoNewDraft(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	contestID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		contest: &domain.ContestView{
+			ID:           contestID,
+			OwnerUserID:  userID,
+			ContestStart: now.Add(time.Hour),
+		},
+		ruleSet: &domain.ScoringRuleSet{
+			ID:        uuid.New(),
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Version:   3,
+			Status:    domain.ScoringRuleSetStatusArchived,
+			Mode:      domain.ScoringRuleSetModeReplace,
+			Rules: []domain.ScoringRule{{
+				ID:          uuid.New(),
+				Priority:    1,
+				ActivityID:  1,
+				ScoreSource: domain.ScoreSourceAmount,
+				Rate:        2,
+			}},
+		},
+	}
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+
+	_, err := service.Clone(ctxWithUserSubject(userID.String()), repo.ruleSet.ID)
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.createdWith)
+	assert.Equal(t, domain.ScoringRuleSetScopeContest, repo.createdWith.Scope())
+	assert.Equal(t, &contestID, repo.createdWith.ContestID)
+	assert.Equal(t, domain.ScoringRuleSetModeReplace, repo.createdWith.Mode)
+	require.Len(t, repo.createdWith.Rules, 1)
+	assert.Equal(t, uuid.Nil, repo.createdWith.Rules[0].ID)
+	assert.Equal(t, float32(2), repo.createdWith.Rules[0].Rate)
+	assert.NotEqual(t, uuid.Nil, repo.ruleSet.Rules[0].ID)
+}
+
+func TestScoringRuleSetManagementArchivesPublishedVersionsAfterContestStart(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	contestID := uuid.New()
+	ruleSetID := uuid.New()
+	repo := &mockScoringRuleSetManagementRepository{
+		contest: &domain.ContestView{
+			ID:           contestID,
+			OwnerUserID:  userID,
+			ContestStart: now.Add(-time.Hour),
+		},
+		ruleSet: &domain.ScoringRuleSet{
+			ID:        ruleSetID,
+			Scope:     domain.ScoringRuleSetScopeContest,
+			ContestID: &contestID,
+			Status:    domain.ScoringRuleSetStatusDraft,
+		},
+	}
+	service := domain.NewScoringRuleSetManagement(repo, &mockContestOrganizerClient{}, commondomain.NewMockClock(now))
+	ctx := ctxWithUserSubject(userID.String())
+
+	_, err := service.Archive(ctx, ruleSetID)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Equal(t, uuid.Nil, repo.archived)
+
+	repo.ruleSet.Status = domain.ScoringRuleSetStatusPublished
+	ruleSet, err := service.Archive(ctx, ruleSetID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ScoringRuleSetStatusArchived, ruleSet.Status)
+	assert.Equal(t, ruleSetID, repo.archived)
+
+	_, err = service.Archive(ctxWithUserSubject(uuid.New().String()), ruleSetID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
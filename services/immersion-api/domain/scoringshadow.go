@@ -191,3 +191,56 @@ func scoringScoresEqual(left, right float32) bool {
 	scale := math.Max(1, math.Max(math.Abs(float64(left)), math.Abs(float64(right))))
 	return difference <= 0.00001*scale
 }
+
+// ScoringRuleSetComparison is the outcome of scoring a log with two rule sets,
+// e.g. a draft against the active one. Unlike the shadow comparison both sides
+// are engine results.
+type ScoringRuleSetComparison struct {
+	Base    ScoringResult
+	Target  ScoringResult
+	Outcome ScoringShadowOutcome
+}
+
+// CompareScoringRuleSets evaluates the input with both rule sets. Contest rule
+// sets are evaluated according to their mode with the given fallback. Caps
+// count the user's earlier logs through baseUsage and targetUsage, as those
+// logs score differently under each rule set. The outcome is unmatched when
+// the target matches no rule.
+func CompareScoringRuleSets(
+	input ScoringInput,
+	base ScoringRuleSet,
+	baseFallback *ScoringRuleSet,
+	baseUsage ScoringUsageLookup,
+	target ScoringRuleSet,
+	targetFallback *ScoringRuleSet,
+	targetUsage ScoringUsageLookup,
+) (ScoringRuleSetComparison, error) {
+	input.Usage = baseUsage
+	baseResult, err := evaluateScoringRuleSetForScope(input, base, baseFallback)
+	if err != nil {
+		return ScoringRuleSetComparison{}, err
+	}
+	input.Usage = targetUsage
+	targetResult, err := evaluateScoringRuleSetForScope(input, target, targetFallback)
+	if err != nil {
+		return ScoringRuleSetComparison{}, err
+	}
+
+	comparison := ScoringRuleSetComparison{Base: baseResult, Target: targetResult}
+	switch {
+	case !targetResult.Matched:
+		comparison.Outcome = ScoringShadowOutcomeUnmatched
+	case scoringScoresEqual(baseResult.Score, targetResult.Score):
+		comparison.Outcome = ScoringShadowOutcomeMatch
+	default:
+		comparison.Outcome = ScoringShadowOutcomeMismatch
+	}
+	return comparison, nil
+}
+
+func evaluateScoringRuleSetForScope(input ScoringInput, ruleSet ScoringRuleSet, fallback *ScoringRuleSet) (ScoringResult, error) {
+	if ruleSet.Scope == ScoringRuleSetScopeContest {
+		return EvaluateContestScore(input, ruleSet, fallback)
+	}
+	return EvaluateScoringRuleSet(input, ruleSet)
+}
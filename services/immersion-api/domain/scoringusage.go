@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScoringRuleUsageRequest sums the stored scores of a user's logs that applied
+// a rule, made from From until Until. A log split across several base rules
+// only counts the part of its value each rule scored, its score counts
+// towards each of them.
+type ScoringRuleUsageRequest struct {
+	UserID uuid.UUID
+	// ContestID sums the user's logs in the contest instead of their platform
+	// logs.
+	ContestID *uuid.UUID
+	// ExcludeLogID is the log being scored when it is already stored.
+	ExcludeLogID *uuid.UUID
+	RuleID       uuid.UUID
+	From         time.Time
+	Until        time.Time
+}
+
+type scoringRuleUsageFinder interface {
+	FindScoringRuleUsage(context.Context, *ScoringRuleUsageRequest) (*ScoringRuleUsage, error)
+}
+
+// scoringUsageScope identifies whose logs count towards caps when scoring a
+// log.
+type scoringUsageScope struct {
+	userID uuid.UUID
+	logID  *uuid.UUID
+}
+
+// lookup reads usage from the scores stored for the user's platform logs, or
+// for their logs in the contest.
+func (s scoringUsageScope) lookup(
+	ctx context.Context,
+	finder scoringRuleUsageFinder,
+	contestID *uuid.UUID,
+) ScoringUsageLookup {
+	return func(rule ScoringRule, from, until time.Time) (ScoringRuleUsage, error) {
+		usage, err := finder.FindScoringRuleUsage(ctx, &ScoringRuleUsageRequest{
+			UserID:       s.userID,
+			ContestID:    contestID,
+			ExcludeLogID: s.logID,
+			RuleID:       rule.ID,
+			From:         from,
+			Until:        until,
+		})
+		if err != nil {
+			return ScoringRuleUsage{}, err
+		}
+		return *usage, nil
+	}
+}
+
+// scoringUsageReplay tracks usage in memory while logs are scored in the order
+// they were made, for evaluations that do not store their scores.
+type scoringUsageReplay struct {
+	entries map[scoringUsageReplayKey][]scoringUsageReplayEntry
+}
+
+type scoringUsageReplayKey struct {
+	userID uuid.UUID
+	ruleID uuid.UUID
+}
+
+type scoringUsageReplayEntry struct {
+	loggedAt time.Time
+	value    float32
+	score    float32
+}
+
+func newScoringUsageReplay() *scoringUsageReplay {
+	return &scoringUsageReplay{entries: map[scoringUsageReplayKey][]scoringUsageReplayEntry{}}
+}
+
+func (r *scoringUsageReplay) lookup(userID uuid.UUID) ScoringUsageLookup {
+	return func(rule ScoringRule, from, until time.Time) (ScoringRuleUsage, error) {
+		var usage ScoringRuleUsage
+		for _, entry := range r.entries[scoringUsageReplayKey{userID, rule.ID}] {
+			if !entry.loggedAt.Before(from) && entry.loggedAt.Before(until) {
+				usage.Value += entry.value
+				usage.Score += entry.score
+			}
+		}
+		return usage, nil
+	}
+}
+
+// record adds a scored log to the usage of every rule applied to it.
+func (r *scoringUsageReplay) record(userID uuid.UUID, input ScoringInput, result ScoringResult) {
+	for _, rule := range result.AppliedRules {
+		key := scoringUsageReplayKey{userID, rule.RuleID}
+		r.entries[key] = append(r.entries[key], scoringUsageReplayEntry{
+			loggedAt: input.LoggedAt,
+			value:    rule.Value,
+			score:    result.Score,
+		})
+	}
+}
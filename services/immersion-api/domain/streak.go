@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Streak describes consecutive days with logs. Days are local calendar dates
+// in the user's time zone, stored as midnight UTC.
+type Streak struct {
+	Current int
+	Longest int
+	// CurrentStartedOn is the first day of the current streak, if any.
+	CurrentStartedOn *time.Time
+	LastActiveOn     *time.Time
+}
+
+// ComputeStreak derives the current and longest streak from the days with
+// activity. Up to graceDays missing days in a row keep a streak alive but do
+// not count towards it. The current streak stays alive until today and the
+// grace days have passed without a log.
+func ComputeStreak(days []time.Time, today time.Time, graceDays int) Streak {
+	if len(days) == 0 {
+		return Streak{}
+	}
+
+	sorted := make([]time.Time, len(days))
+	copy(sorted, days)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	var streak Streak
+	runStart := sorted[0]
+	runLength := 1
+	previous := sorted[0]
+	for _, day := range sorted[1:] {
+		gap := daysBetween(previous, day)
+		if gap == 0 {
+			continue
+		}
+		if gap > graceDays+1 {
+			streak.Longest = max(streak.Longest, runLength)
+			runStart = day
+			runLength = 0
+		}
+		runLength++
+		previous = day
+	}
+	streak.Longest = max(streak.Longest, runLength)
+
+	lastActive := previous
+	streak.LastActiveOn = &lastActive
+	if daysBetween(lastActive, today) <= graceDays+1 {
+		streak.Current = runLength
+		streak.CurrentStartedOn = &runStart
+	}
+	return streak
+}
+
+// LocalDate truncates t to its calendar date in loc, expressed as midnight UTC
+// so that dates from different zones compare by value.
+func LocalDate(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestComputeStreak(t *testing.T) {
+	today := date(2026, time.March, 20)
+	days := func(dayOfMonth ...int) []time.Time {
+		res := make([]time.Time, len(dayOfMonth))
+		for i, d := range dayOfMonth {
+			res[i] = date(2026, time.March, d)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		days      []time.Time
+		graceDays int
+		current   int
+		longest   int
+	}{
+		{"no activity", nil, 0, 0, 0},
+		{"active today", days(18, 19, 20), 0, 3, 3},
+		{"still alive when today has no log yet", days(18, 19), 0, 2, 2},
+		{"broken after a missed day", days(17, 18), 0, 0, 2},
+		{"longest streak in the past", days(1, 2, 3, 4, 10, 19, 20), 0, 2, 4},
+		{"unsorted input with duplicates", days(20, 18, 19, 19), 0, 3, 3},
+		{"grace day bridges a gap", days(15, 16, 18, 19), 1, 4, 4},
+		{"grace days do not bridge longer gaps", days(15, 16, 19, 20), 1, 2, 2},
+		{"grace days keep the current streak alive", days(16, 17, 18), 1, 3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := domain.ComputeStreak(tt.days, today, tt.graceDays)
+			assert.Equal(t, tt.current, streak.Current)
+			assert.Equal(t, tt.longest, streak.Longest)
+		})
+	}
+
+	t.Run("reports start and last active day", func(t *testing.T) {
+		streak := domain.ComputeStreak(days(10, 18, 19), today, 0)
+
+		require.NotNil(t, streak.CurrentStartedOn)
+		require.NotNil(t, streak.LastActiveOn)
+		assert.Equal(t, date(2026, time.March, 18), *streak.CurrentStartedOn)
+		assert.Equal(t, date(2026, time.March, 19), *streak.LastActiveOn)
+	})
+
+	t.Run("has no start day once broken", func(t *testing.T) {
+		streak := domain.ComputeStreak(days(10), today, 0)
+
+		assert.Nil(t, streak.CurrentStartedOn)
+		require.NotNil(t, streak.LastActiveOn)
+	})
+}
+
+func TestLocalDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 23:30 UTC is already the next day in Tokyo
+	instant := time.Date(2026, time.March, 19, 23, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, date(2026, time.March, 19), domain.LocalDate(instant, time.UTC))
+	assert.Equal(t, date(2026, time.March, 20), domain.LocalDate(instant, tokyo))
+}
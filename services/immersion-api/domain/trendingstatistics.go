@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// TagStatistic sums the logs with a tag.
+type TagStatistic struct {
+	Tag        string
+	LogCount   int
+	UserCount  int
+	TotalScore float32
+}
+
+// MediaStatistic sums the logs linked to a media.
+type MediaStatistic struct {
+	MediaID    uuid.UUID
+	Title      string
+	Type       MediaType
+	LogCount   int
+	UserCount  int
+	TotalScore float32
+}
+
+type StatisticsWindow string
+
+const (
+	StatisticsWindowDay   StatisticsWindow = "day"
+	StatisticsWindowWeek  StatisticsWindow = "week"
+	StatisticsWindowMonth StatisticsWindow = "month"
+)
+
+func (w StatisticsWindow) Duration() (time.Duration, bool) {
+	switch w {
+	case StatisticsWindowDay:
+		return 24 * time.Hour, true
+	case StatisticsWindowWeek:
+		return 7 * 24 * time.Hour, true
+	case StatisticsWindowMonth:
+		return 30 * 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+type TrendingStatisticsRepository interface {
+	ListTrendingTags(ctx context.Context, req *TrendingStatisticsQuery) ([]TagStatistic, error)
+	ListTrendingMedia(ctx context.Context, req *TrendingStatisticsQuery) ([]MediaStatistic, error)
+}
+
+type TrendingStatisticsQuery struct {
+	LanguageCode *string
+	Since        time.Time
+	Limit        int32
+}
+
+type TrendingStatisticsRequest struct {
+	LanguageCode *string
+	Window       StatisticsWindow
+}
+
+type TrendingStatistics struct {
+	LanguageCode *string
+	Window       StatisticsWindow
+	Since        time.Time
+	GeneratedAt  time.Time
+	Tags         []TagStatistic
+	Media        []MediaStatistic
+}
+
+const (
+	trendingStatisticsLimit    = 20
+	trendingStatisticsCacheTTL = 5 * time.Minute
+)
+
+type trendingStatisticsCacheEntry struct {
+	statistics *TrendingStatistics
+	expiresAt  time.Time
+}
+
+// TrendingStatisticsFetch computes the most used tags and media across all
+// users. Results are cached per language and window, as they scan every log
+// in the window.
+type TrendingStatisticsFetch struct {
+	repo  TrendingStatisticsRepository
+	clock commondomain.Clock
+
+	mu    sync.Mutex
+	cache map[string]trendingStatisticsCacheEntry
+}
+
+func NewTrendingStatisticsFetch(repo TrendingStatisticsRepository, clock commondomain.Clock) *TrendingStatisticsFetch {
+	return &TrendingStatisticsFetch{
+		repo:  repo,
+		clock: clock,
+		cache: map[string]trendingStatisticsCacheEntry{},
+	}
+}
+
+func (s *TrendingStatisticsFetch) Execute(ctx context.Context, req *TrendingStatisticsRequest) (*TrendingStatistics, error) {
+	if req.Window == "" {
+		req.Window = StatisticsWindowWeek
+	}
+	window, ok := req.Window.Duration()
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown window %q", ErrRequestInvalid, req.Window)
+	}
+	if req.LanguageCode != nil && (*req.LanguageCode == "" || len(*req.LanguageCode) > 10) {
+		return nil, fmt.Errorf("%w: language code is invalid", ErrRequestInvalid)
+	}
+
+	now := s.clock.Now()
+	key := string(req.Window)
+	if req.LanguageCode != nil {
+		key += ":" + *req.LanguageCode
+	}
+
+	s.mu.Lock()
+	entry, found := s.cache[key]
+	s.mu.Unlock()
+	if found && now.Before(entry.expiresAt) {
+		return entry.statistics, nil
+	}
+
+	query := &TrendingStatisticsQuery{
+		LanguageCode: req.LanguageCode,
+		Since:        now.Add(-window),
+		Limit:        trendingStatisticsLimit,
+	}
+	tags, err := s.repo.ListTrendingTags(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("could not list trending tags: %w", err)
+	}
+	media, err := s.repo.ListTrendingMedia(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("could not list trending media: %w", err)
+	}
+
+	statistics := &TrendingStatistics{
+		LanguageCode: req.LanguageCode,
+		Window:       req.Window,
+		Since:        query.Since,
+		GeneratedAt:  now,
+		Tags:         tags,
+		Media:        media,
+	}
+
+	s.mu.Lock()
+	for k, e := range s.cache {
+		if !now.Before(e.expiresAt) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = trendingStatisticsCacheEntry{
+		statistics: statistics,
+		expiresAt:  now.Add(trendingStatisticsCacheTTL),
+	}
+	s.mu.Unlock()
+
+	return statistics, nil
+}
@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockTrendingStatisticsRepository struct {
+	queries []domain.TrendingStatisticsQuery
+	tags    []domain.TagStatistic
+}
+
+func (m *mockTrendingStatisticsRepository) ListTrendingTags(_ context.Context, req *domain.TrendingStatisticsQuery) ([]domain.TagStatistic, error) {
+	m.queries = append(m.queries, *req)
+	return m.tags, nil
+}
+
+func (m *mockTrendingStatisticsRepository) ListTrendingMedia(context.Context, *domain.TrendingStatisticsQuery) ([]domain.MediaStatistic, error) {
+	return []domain.MediaStatistic{}, nil
+}
+
+func TestTrendingStatisticsFetch_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("defaults to the last week", func(t *testing.T) {
+		repo := &mockTrendingStatisticsRepository{tags: []domain.TagStatistic{{Tag: "book", LogCount: 3, UserCount: 2, TotalScore: 10}}}
+		svc := domain.NewTrendingStatisticsFetch(repo, commondomain.NewMockClock(now))
+
+		statistics, err := svc.Execute(context.Background(), &domain.TrendingStatisticsRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatisticsWindowWeek, statistics.Window)
+		assert.Equal(t, now.Add(-7*24*time.Hour), statistics.Since)
+		assert.Equal(t, "book", statistics.Tags[0].Tag)
+	})
+
+	t.Run("caches results per language and window", func(t *testing.T) {
+		repo := &mockTrendingStatisticsRepository{}
+		clock := commondomain.NewMockClock(now)
+		svc := domain.NewTrendingStatisticsFetch(repo, clock)
+		jpa := "jpa"
+
+		for i := 0; i < 2; i++ {
+			_, err := svc.Execute(context.Background(), &domain.TrendingStatisticsRequest{LanguageCode: &jpa, Window: domain.StatisticsWindowDay})
+			require.NoError(t, err)
+		}
+		_, err := svc.Execute(context.Background(), &domain.TrendingStatisticsRequest{Window: domain.StatisticsWindowDay})
+		require.NoError(t, err)
+
+		require.Len(t, repo.queries, 2)
+		assert.Equal(t, &jpa, repo.queries[0].LanguageCode)
+		assert.Nil(t, repo.queries[1].LanguageCode)
+
+		clock.SetTime(now.Add(6 * time.Minute))
+		_, err = svc.Execute(context.Background(), &domain.TrendingStatisticsRequest{LanguageCode: &jpa, Window: domain.StatisticsWindowDay})
+		require.NoError(t, err)
+		assert.Len(t, repo.queries, 3)
+	})
+
+	t.Run("rejects unknown windows", func(t *testing.T) {
+		svc := domain.NewTrendingStatisticsFetch(&mockTrendingStatisticsRepository{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(context.Background(), &domain.TrendingStatisticsRequest{Window: "decade"})
+
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+}
@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type UnitManagementRepository interface {
+	ListUnits(ctx context.Context, includeDeprecated bool) ([]Unit, error)
+	FindUnitByID(ctx context.Context, id uuid.UUID) (*Unit, error)
+	CreateUnit(ctx context.Context, unit *Unit) (*Unit, error)
+	UpdateUnit(ctx context.Context, unit *Unit) (*Unit, error)
+	// DeleteOrDeprecateUnit deletes a unit that no log references and
+	// deprecates it otherwise, reporting whether it was deprecated.
+	DeleteOrDeprecateUnit(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+	LanguageExists(ctx context.Context, code string) (bool, error)
+	FindActivePlatformScoringRuleSet(context.Context) (*ScoringRuleSet, error)
+}
+
+// ScoringRuleSetDrafter creates the platform draft that scores a new unit,
+// it is implemented by ScoringRuleSetManagement.
+type ScoringRuleSetDrafter interface {
+	CreatePlatformDraft(context.Context, *ScoringRuleSetDraftCreateRequest) (*ScoringRuleSet, error)
+}
+
+type UnitCreateRequest struct {
+	Key          string
+	ActivityID   int
+	Name         string
+	Modifier     float32
+	LanguageCode *string
+	// MediaLengthUnit is the length unit of media that logs in the unit
+	// count towards, if any.
+	MediaLengthUnit *MediaLengthUnit
+}
+
+type UnitCreateResult struct {
+	Unit *Unit
+	// ScoringRuleSetDraftID is the platform draft that adds a base rule for
+	// the unit, nil when the active rule set already had one or creating the
+	// draft failed.
+	ScoringRuleSetDraftID *uuid.UUID
+}
+
+type UnitUpdateRequest struct {
+	ID         uuid.UUID
+	Name       string
+	Modifier   float32
+	Deprecated bool
+	// MediaLengthUnit is left unchanged when nil.
+	MediaLengthUnit *MediaLengthUnit
+}
+
+var unitKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+const maxUnitKeyLength = 50
+
+type UnitManagement struct {
+	repo    UnitManagementRepository
+	drafter ScoringRuleSetDrafter
+	clock   commondomain.Clock
+}
+
+func NewUnitManagement(
+	repo UnitManagementRepository,
+	drafter ScoringRuleSetDrafter,
+	clock commondomain.Clock,
+) *UnitManagement {
+	return &UnitManagement{repo: repo, drafter: drafter, clock: clock}
+}
+
+func (s *UnitManagement) List(ctx context.Context) ([]Unit, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	units, err := s.repo.ListUnits(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not list units: %w", err)
+	}
+
+	return units, nil
+}
+
+// Create adds a unit to the catalog. Keys are stable identifiers: they are
+// prefixed with the activity, always refer to the same activity and exist at
+// most once per language, like the keys backfilled by the migrations.
+func (s *UnitManagement) Create(ctx context.Context, req *UnitCreateRequest) (*UnitCreateResult, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	activity, ok := ActivityByID(int32(req.ActivityID))
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown activity %d", ErrRequestInvalid, req.ActivityID)
+	}
+	prefix := activityKeyPrefix(activity)
+	if !unitKeyPattern.MatchString(req.Key) || len(req.Key) > maxUnitKeyLength || !strings.HasPrefix(req.Key, prefix) || req.Key == prefix {
+		return nil, fmt.Errorf("%w: key must be lowercase snake case of at most %d characters starting with %q", ErrRequestInvalid, maxUnitKeyLength, prefix)
+	}
+	if err := validateUnitDetails(req.Name, req.Modifier); err != nil {
+		return nil, err
+	}
+	if err := validateUnitMediaLengthUnit(req.MediaLengthUnit); err != nil {
+		return nil, err
+	}
+	if req.LanguageCode != nil {
+		exists, err := s.repo.LanguageExists(ctx, *req.LanguageCode)
+		if err != nil {
+			return nil, fmt.Errorf("could not check if language exists: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: unknown language %q", ErrRequestInvalid, *req.LanguageCode)
+		}
+	}
+
+	units, err := s.repo.ListUnits(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not list units: %w", err)
+	}
+	for _, unit := range units {
+		if unit.Key != req.Key {
+			continue
+		}
+		if unit.LogActivityID != req.ActivityID {
+			return nil, fmt.Errorf("%w: key '%s' already belongs to another activity", ErrConflict, req.Key)
+		}
+		if unitLanguage(unit.LanguageCode) == unitLanguage(req.LanguageCode) {
+			return nil, fmt.Errorf("%w: unit with key '%s' already exists for this language", ErrConflict, req.Key)
+		}
+	}
+
+	unit, err := s.repo.CreateUnit(ctx, &Unit{
+		ID:              uuid.New(),
+		Key:             req.Key,
+		LogActivityID:   req.ActivityID,
+		Name:            req.Name,
+		Modifier:        req.Modifier,
+		LanguageCode:    req.LanguageCode,
+		MediaLengthUnit: req.MediaLengthUnit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create unit: %w", err)
+	}
+
+	// The unit exists either way, a missing draft only means scoring has to
+	// be configured by hand.
+	draftID, err := s.createScoringDraft(ctx, unit)
+	if err != nil {
+		slog.ErrorContext(ctx, "could not create scoring rule set draft for unit", "unit_key", unit.Key, "error", err)
+	}
+
+	return &UnitCreateResult{Unit: unit, ScoringRuleSetDraftID: draftID}, nil
+}
+
+// Update changes how a unit is presented and scored. The key, activity and
+// language identify the unit and cannot be changed.
+func (s *UnitManagement) Update(ctx context.Context, req *UnitUpdateRequest) (*Unit, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := validateUnitDetails(req.Name, req.Modifier); err != nil {
+		return nil, err
+	}
+	if err := validateUnitMediaLengthUnit(req.MediaLengthUnit); err != nil {
+		return nil, err
+	}
+
+	unit, err := s.repo.FindUnitByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	unit.Name = req.Name
+	unit.Modifier = req.Modifier
+	if req.MediaLengthUnit != nil {
+		unit.MediaLengthUnit = req.MediaLengthUnit
+	}
+	switch {
+	case !req.Deprecated:
+		unit.DeprecatedAt = nil
+	case unit.DeprecatedAt == nil:
+		now := s.clock.Now()
+		unit.DeprecatedAt = &now
+	}
+
+	updated, err := s.repo.UpdateUnit(ctx, unit)
+	if err != nil {
+		return nil, fmt.Errorf("could not update unit: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Delete removes a unit, or deprecates it when logs still reference it so
+// their tracking stays intact. It reports whether the unit was deprecated.
+func (s *UnitManagement) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+
+	deprecated, err := s.repo.DeleteOrDeprecateUnit(ctx, id, s.clock.Now())
+	if err != nil {
+		return false, err
+	}
+
+	return deprecated, nil
+}
+
+func validateUnitDetails(name string, modifier float32) error {
+	if name == "" || len(name) > 100 {
+		return fmt.Errorf("%w: name must be between 1 and 100 characters", ErrRequestInvalid)
+	}
+	if !(modifier > 0) || math.IsInf(float64(modifier), 0) {
+		return fmt.Errorf("%w: modifier must be a positive number", ErrRequestInvalid)
+	}
+	return nil
+}
+
+// validateUnitMediaLengthUnit checks the length unit of media a unit counts
+// towards. Minutes are counted from the duration of logs in any unit.
+func validateUnitMediaLengthUnit(unit *MediaLengthUnit) error {
+	if unit == nil {
+		return nil
+	}
+	if !unit.IsValid() || *unit == MediaLengthUnitMinute {
+		return fmt.Errorf("%w: units cannot count towards media measured in %q", ErrRequestInvalid, *unit)
+	}
+	return nil
+}
+
+func unitLanguage(code *string) string {
+	if code == nil {
+		return ""
+	}
+	return *code
+}
+
+// createScoringDraft copies the active platform rule set into a draft with a
+// base rule scoring the unit by its modifier, so it scores like the interim
+// score once the draft is published.
+func (s *UnitManagement) createScoringDraft(ctx context.Context, unit *Unit) (*uuid.UUID, error) {
+	active, err := s.repo.FindActivePlatformScoringRuleSet(ctx)
+	if err != nil && !errors.Is(err, ErrScoringRuleSetNotFound) {
+		return nil, fmt.Errorf("could not find active platform rule set: %w", err)
+	}
+	var rules []ScoringRule
+	if active != nil {
+		rules = active.Rules
+	}
+
+	rule := ScoringRule{
+		ActivityID:   int32(unit.LogActivityID),
+		UnitKey:      unit.Key,
+		LanguageCode: unitLanguage(unit.LanguageCode),
+		ScoreSource:  ScoreSourceAmount,
+		Rate:         unit.Modifier,
+	}
+	for _, existing := range rules {
+		if !existing.Stackable && isPlainBaseRule(existing) &&
+			existing.ActivityID == rule.ActivityID &&
+			existing.UnitKey == rule.UnitKey &&
+			existing.LanguageCode == rule.LanguageCode &&
+			existing.ScoreSource == rule.ScoreSource {
+			return nil, nil
+		}
+	}
+
+	draft, err := s.drafter.CreatePlatformDraft(ctx, &ScoringRuleSetDraftCreateRequest{
+		Rules: insertUnitScoringRule(rules, rule),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &draft.ID, nil
+}
+
+// insertUnitScoringRule places rule right before the first base rule that
+// would otherwise score the unit, so more specific rules keep precedence.
+func insertUnitScoringRule(rules []ScoringRule, rule ScoringRule) []ScoringRule {
+	result := make([]ScoringRule, len(rules), len(rules)+1)
+	for i, existing := range rules {
+		existing.ID = uuid.Nil
+		result[i] = existing
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Priority < result[j].Priority
+	})
+
+	target := -1
+	for i, existing := range result {
+		if !existing.Stackable && isPlainBaseRule(existing) &&
+			existing.ActivityID == rule.ActivityID &&
+			existing.ScoreSource == rule.ScoreSource &&
+			(existing.UnitKey == "" || existing.UnitKey == rule.UnitKey) &&
+			(existing.LanguageCode == "" || existing.LanguageCode == rule.LanguageCode) {
+			target = i
+			break
+		}
+	}
+
+	if target == -1 {
+		rule.Priority = 10
+		if len(result) > 0 {
+			rule.Priority = result[len(result)-1].Priority + 10
+		}
+		return append(result, rule)
+	}
+
+	// Priorities must be unique, so the rules are renumbered when there is no
+	// gap before the target left.
+	previous := func() int32 {
+		if target == 0 {
+			return -1
+		}
+		return result[target-1].Priority
+	}
+	if result[target].Priority-previous() < 2 {
+		for i := range result {
+			result[i].Priority = int32(i+1) * 10
+		}
+	}
+	rule.Priority = previous() + (result[target].Priority-previous())/2
+
+	result = append(result, ScoringRule{})
+	copy(result[target+1:], result[target:])
+	result[target] = rule
+	return result
+}
+
+// isPlainBaseRule reports whether a rule matches logs regardless of their
+// tags, amount and time.
+func isPlainBaseRule(rule ScoringRule) bool {
+	return rule.Tag == "" && len(rule.Tags) == 0 &&
+		rule.MinValue == nil && rule.MaxValue == nil &&
+		rule.EffectiveFrom == nil && rule.EffectiveUntil == nil
+}
@@ -0,0 +1,309 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockUnitManagementRepository struct {
+	units          []domain.Unit
+	created        *domain.Unit
+	updated        *domain.Unit
+	referenced     bool
+	deleted        uuid.UUID
+	activePlatform *domain.ScoringRuleSet
+}
+
+func (m *mockUnitManagementRepository) ListUnits(context.Context, bool) ([]domain.Unit, error) {
+	return m.units, nil
+}
+
+func (m *mockUnitManagementRepository) FindUnitByID(_ context.Context, id uuid.UUID) (*domain.Unit, error) {
+	for _, unit := range m.units {
+		if unit.ID == id {
+			return &unit, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockUnitManagementRepository) CreateUnit(_ context.Context, unit *domain.Unit) (*domain.Unit, error) {
+	m.created = unit
+	return unit, nil
+}
+
+func (m *mockUnitManagementRepository) UpdateUnit(_ context.Context, unit *domain.Unit) (*domain.Unit, error) {
+	m.updated = unit
+	return unit, nil
+}
+
+func (m *mockUnitManagementRepository) DeleteOrDeprecateUnit(_ context.Context, id uuid.UUID, _ time.Time) (bool, error) {
+	m.deleted = id
+	return m.referenced, nil
+}
+
+func (m *mockUnitManagementRepository) LanguageExists(_ context.Context, code string) (bool, error) {
+	return code == "jpn" || code == "kor", nil
+}
+
+func (m *mockUnitManagementRepository) FindActivePlatformScoringRuleSet(context.Context) (*domain.ScoringRuleSet, error) {
+	if m.activePlatform == nil {
+		return nil, domain.ErrScoringRuleSetNotFound
+	}
+	return m.activePlatform, nil
+}
+
+type mockScoringRuleSetDrafter struct {
+	draft *domain.ScoringRuleSetDraftCreateRequest
+	err   error
+}
+
+func (m *mockScoringRuleSetDrafter) CreatePlatformDraft(_ context.Context, req *domain.ScoringRuleSetDraftCreateRequest) (*domain.ScoringRuleSet, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.draft = req
+	return &domain.ScoringRuleSet{ID: uuid.New(), Status: domain.ScoringRuleSetStatusDraft}, nil
+}
+
+func TestUnitManagement_Create(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	kor := "kor"
+	newRepo := func() *mockUnitManagementRepository {
+		return &mockUnitManagementRepository{
+			units: []domain.Unit{
+				{ID: uuid.New(), Key: domain.UnitKeyReadingComicPage, LogActivityID: 1, Name: "Comic page", Modifier: 0.2},
+			},
+			activePlatform: &domain.ScoringRuleSet{
+				ID:     uuid.New(),
+				Scope:  domain.ScoringRuleSetScopePlatform,
+				Status: domain.ScoringRuleSetStatusPublished,
+				Rules: []domain.ScoringRule{
+					{ID: uuid.New(), Priority: 1, ActivityID: 1, UnitKey: domain.UnitKeyReadingComicPage, ScoreSource: domain.ScoreSourceAmount, Rate: 0.2},
+					{ID: uuid.New(), Priority: 2, ActivityID: 1, ScoreSource: domain.ScoreSourceAmount, Rate: 1},
+					{ID: uuid.New(), Priority: 3, ActivityID: 2, ScoreSource: domain.ScoreSourceDurationMinutes, Rate: 0.3},
+				},
+			},
+		}
+	}
+	validRequest := func() *domain.UnitCreateRequest {
+		return &domain.UnitCreateRequest{
+			Key:          domain.UnitKeyReadingComicPage,
+			ActivityID:   1,
+			Name:         "Manhwa page",
+			Modifier:     0.25,
+			LanguageCode: &kor,
+		}
+	}
+
+	t.Run("creates the unit and drafts a base rule before the rules it overrides", func(t *testing.T) {
+		repo := newRepo()
+		drafter := &mockScoringRuleSetDrafter{}
+		svc := domain.NewUnitManagement(repo, drafter, commondomain.NewMockClock(now))
+
+		result, err := svc.Create(ctxWithAdmin(), validRequest())
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.created)
+		assert.Equal(t, domain.UnitKeyReadingComicPage, result.Unit.Key)
+		require.NotNil(t, result.ScoringRuleSetDraftID)
+		require.NotNil(t, drafter.draft)
+
+		rules := drafter.draft.Rules
+		require.Len(t, rules, 4)
+		assert.Equal(t, domain.UnitKeyReadingComicPage, rules[0].UnitKey)
+		assert.Equal(t, "kor", rules[0].LanguageCode)
+		assert.Equal(t, float32(0.25), rules[0].Rate)
+		assert.Equal(t, "", rules[1].LanguageCode)
+		assert.Equal(t, "", rules[2].UnitKey)
+		for i := 1; i < len(rules); i++ {
+			assert.Less(t, rules[i-1].Priority, rules[i].Priority)
+		}
+		for _, rule := range rules {
+			assert.Equal(t, uuid.Nil, rule.ID)
+		}
+	})
+
+	t.Run("appends the base rule when no generic rule scores the unit", func(t *testing.T) {
+		repo := newRepo()
+		repo.activePlatform.Rules = repo.activePlatform.Rules[2:]
+		drafter := &mockScoringRuleSetDrafter{}
+		svc := domain.NewUnitManagement(repo, drafter, commondomain.NewMockClock(now))
+
+		_, err := svc.Create(ctxWithAdmin(), validRequest())
+
+		require.NoError(t, err)
+		require.Len(t, drafter.draft.Rules, 2)
+		assert.Equal(t, int32(13), drafter.draft.Rules[1].Priority)
+	})
+
+	t.Run("drafts only the base rule when no platform rule set is active", func(t *testing.T) {
+		repo := newRepo()
+		repo.activePlatform = nil
+		drafter := &mockScoringRuleSetDrafter{}
+		svc := domain.NewUnitManagement(repo, drafter, commondomain.NewMockClock(now))
+
+		result, err := svc.Create(ctxWithAdmin(), validRequest())
+
+		require.NoError(t, err)
+		require.NotNil(t, result.ScoringRuleSetDraftID)
+		require.Len(t, drafter.draft.Rules, 1)
+		assert.Equal(t, domain.UnitKeyReadingComicPage, drafter.draft.Rules[0].UnitKey)
+	})
+
+	t.Run("still returns the unit when the draft cannot be created", func(t *testing.T) {
+		repo := newRepo()
+		drafter := &mockScoringRuleSetDrafter{err: errors.New("boom")}
+		svc := domain.NewUnitManagement(repo, drafter, commondomain.NewMockClock(now))
+
+		result, err := svc.Create(ctxWithAdmin(), validRequest())
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.Unit)
+		assert.Nil(t, result.ScoringRuleSetDraftID)
+	})
+
+	t.Run("rejects keys used for the same language", func(t *testing.T) {
+		repo := newRepo()
+		svc := domain.NewUnitManagement(repo, &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+		req := validRequest()
+		req.LanguageCode = nil
+
+		_, err := svc.Create(ctxWithAdmin(), req)
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("rejects keys that belong to another activity", func(t *testing.T) {
+		repo := newRepo()
+		repo.units[0].LogActivityID = 3
+		svc := domain.NewUnitManagement(repo, &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Create(ctxWithAdmin(), validRequest())
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("rejects keys that are not prefixed with the activity", func(t *testing.T) {
+		svc := domain.NewUnitManagement(newRepo(), &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+		for _, key := range []string{"manhwa_page", "reading_", "Reading_Page", "reading-page"} {
+			req := validRequest()
+			req.Key = key
+
+			_, err := svc.Create(ctxWithAdmin(), req)
+
+			assert.ErrorIs(t, err, domain.ErrRequestInvalid, key)
+		}
+	})
+
+	t.Run("keeps the key prefix of a renamed activity", func(t *testing.T) {
+		activities := withDefaultActivityCatalog(t)
+		activities[0].Name = "Books"
+		require.NoError(t, domain.RefreshActivityCatalog(context.Background(), &mockActivityManagementRepository{activities: activities}))
+		svc := domain.NewUnitManagement(newRepo(), &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Create(ctxWithAdmin(), validRequest())
+		require.NoError(t, err)
+
+		req := validRequest()
+		req.Key = "books_comic_page"
+		_, err = svc.Create(ctxWithAdmin(), req)
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("rejects non-positive modifiers and unknown languages", func(t *testing.T) {
+		svc := domain.NewUnitManagement(newRepo(), &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+		req := validRequest()
+		req.Modifier = 0
+		_, err := svc.Create(ctxWithAdmin(), req)
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+
+		unknown := "xxx"
+		req = validRequest()
+		req.LanguageCode = &unknown
+		_, err = svc.Create(ctxWithAdmin(), req)
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("stores the media length unit the unit counts towards", func(t *testing.T) {
+		repo := newRepo()
+		svc := domain.NewUnitManagement(repo, &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+		episode := domain.MediaLengthUnitEpisode
+		req := validRequest()
+		req.MediaLengthUnit = &episode
+
+		_, err := svc.Create(ctxWithAdmin(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &episode, repo.created.MediaLengthUnit)
+	})
+
+	t.Run("rejects minutes as a media length unit", func(t *testing.T) {
+		svc := domain.NewUnitManagement(newRepo(), &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+		minute := domain.MediaLengthUnitMinute
+		req := validRequest()
+		req.MediaLengthUnit = &minute
+
+		_, err := svc.Create(ctxWithAdmin(), req)
+
+		assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+	})
+
+	t.Run("requires an admin", func(t *testing.T) {
+		svc := domain.NewUnitManagement(newRepo(), &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+
+		_, err := svc.Create(ctxWithUserSubject(uuid.New().String()), validRequest())
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUnitManagement_Update(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	unitID := uuid.New()
+	repo := &mockUnitManagementRepository{
+		units: []domain.Unit{{ID: unitID, Key: domain.UnitKeyReadingPage, LogActivityID: 1, Name: "Page", Modifier: 1}},
+	}
+	svc := domain.NewUnitManagement(repo, &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+
+	unit, err := svc.Update(ctxWithAdmin(), &domain.UnitUpdateRequest{
+		ID:         unitID,
+		Name:       "Book page",
+		Modifier:   1.5,
+		Deprecated: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Book page", unit.Name)
+	assert.Equal(t, domain.UnitKeyReadingPage, unit.Key)
+	require.NotNil(t, unit.DeprecatedAt)
+	assert.Equal(t, now, *unit.DeprecatedAt)
+
+	_, err = svc.Update(ctxWithAdmin(), &domain.UnitUpdateRequest{ID: uuid.New(), Name: "Page", Modifier: 1})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestUnitManagement_Delete(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	unitID := uuid.New()
+	repo := &mockUnitManagementRepository{referenced: true}
+	svc := domain.NewUnitManagement(repo, &mockScoringRuleSetDrafter{}, commondomain.NewMockClock(now))
+
+	deprecated, err := svc.Delete(ctxWithAdmin(), unitID)
+
+	require.NoError(t, err)
+	assert.True(t, deprecated)
+	assert.Equal(t, unitID, repo.deleted)
+
+	_, err = svc.Delete(ctxWithUserSubject(uuid.New().String()), unitID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
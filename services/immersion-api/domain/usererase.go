@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// ErasedUserDisplayName replaces the display name on records that must
+// outlive an erased user, such as contests other users participated in.
+const ErasedUserDisplayName = "Deleted user"
+
+type UserErasureStatus string
+
+const (
+	UserErasureStatusPending   UserErasureStatus = "pending"
+	UserErasureStatusCompleted UserErasureStatus = "completed"
+)
+
+// UserErasureStep is a unit of erasure work that is tracked separately so an
+// erasure can resume where it left off when a dependency is unavailable.
+type UserErasureStep string
+
+const (
+	UserErasureStepDatabase      UserErasureStep = "database"
+	UserErasureStepLeaderboards  UserErasureStep = "leaderboards"
+	UserErasureStepRelationships UserErasureStep = "relationships"
+)
+
+// UserErasureSteps lists all steps in the order they must run. The database
+// goes first so no new leaderboard events can be produced for the user once
+// the sorted sets are cleaned up. content-api does not store user
+// identifiers, so it needs no step of its own.
+var UserErasureSteps = []UserErasureStep{
+	UserErasureStepDatabase,
+	UserErasureStepLeaderboards,
+	UserErasureStepRelationships,
+}
+
+const (
+	UserErasureAuditEventRequested     = "requested"
+	UserErasureAuditEventStepCompleted = "step_completed"
+	UserErasureAuditEventStepFailed    = "step_failed"
+	UserErasureAuditEventCompleted     = "completed"
+)
+
+type UserErasure struct {
+	ID                    uuid.UUID
+	UserID                uuid.UUID
+	RequestedByUserID     uuid.UUID
+	Status                UserErasureStatus
+	Attempts              int
+	LastError             *string
+	DatabaseErasedAt      *time.Time
+	LeaderboardsErasedAt  *time.Time
+	RelationshipsErasedAt *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	CompletedAt           *time.Time
+}
+
+func (e *UserErasure) StepCompleted(step UserErasureStep) bool {
+	switch step {
+	case UserErasureStepDatabase:
+		return e.DatabaseErasedAt != nil
+	case UserErasureStepLeaderboards:
+		return e.LeaderboardsErasedAt != nil
+	case UserErasureStepRelationships:
+		return e.RelationshipsErasedAt != nil
+	}
+	return false
+}
+
+// UserErasureAuditEntry is a single link in the hash-chained erasure audit
+// trail. Entries never contain the erased user's ID, they reference the
+// erasure instead.
+type UserErasureAuditEntry struct {
+	ErasureID    uuid.UUID
+	Event        string
+	Metadata     map[string]any
+	CreatedAt    time.Time
+	PreviousHash string
+	Hash         string
+}
+
+// NewUserErasureAuditEntry builds an entry chained to previousHash. The
+// timestamp is truncated to the precision stored by postgres so the hash can
+// be recomputed from persisted rows.
+func NewUserErasureAuditEntry(
+	previousHash string,
+	erasureID uuid.UUID,
+	event string,
+	metadata map[string]any,
+	createdAt time.Time,
+) (*UserErasureAuditEntry, error) {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	entry := &UserErasureAuditEntry{
+		ErasureID:    erasureID,
+		Event:        event,
+		Metadata:     metadata,
+		CreatedAt:    createdAt.UTC().Truncate(time.Microsecond),
+		PreviousHash: previousHash,
+	}
+	hash, err := entry.ComputeHash()
+	if err != nil {
+		return nil, err
+	}
+	entry.Hash = hash
+	return entry, nil
+}
+
+// ComputeHash returns the hex encoded SHA-256 over the previous hash and the
+// entry contents. Metadata is hashed in its canonical JSON encoding.
+func (e *UserErasureAuditEntry) ComputeHash() (string, error) {
+	metadata, err := json.Marshal(e.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("could not encode audit metadata: %w", err)
+	}
+
+	h := sha256.New()
+	for _, part := range []string{
+		e.PreviousHash,
+		e.ErasureID.String(),
+		e.Event,
+		string(metadata),
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyUserErasureAuditChain checks that entries, in insertion order, form
+// an unbroken hash chain. It returns the index of the first bad entry.
+func VerifyUserErasureAuditChain(entries []UserErasureAuditEntry) (int, error) {
+	previousHash := ""
+	for i := range entries {
+		entry := &entries[i]
+		if i > 0 && entry.PreviousHash != previousHash {
+			return i, ErrUserErasureAuditTampered
+		}
+		hash, err := entry.ComputeHash()
+		if err != nil {
+			return i, err
+		}
+		if hash != entry.Hash {
+			return i, ErrUserErasureAuditTampered
+		}
+		previousHash = entry.Hash
+	}
+	return -1, nil
+}
+
+type UserEraseRepository interface {
+	// CreateUserErasure schedules an erasure for the user. When one is still
+	// pending it is returned unchanged so that requests are idempotent, a
+	// completed erasure does not stop the user from being erased again.
+	CreateUserErasure(ctx context.Context, userID uuid.UUID, requestedBy uuid.UUID, now time.Time) (*UserErasure, error)
+}
+
+type UserEraseRequest struct {
+	UserID uuid.UUID
+}
+
+// UserErase schedules the erasure of all personal data of a user. The actual
+// work is carried out asynchronously by UserErasureWorker.
+type UserErase struct {
+	repo  UserEraseRepository
+	clock commondomain.Clock
+}
+
+func NewUserErase(repo UserEraseRepository, clock commondomain.Clock) *UserErase {
+	return &UserErase{repo: repo, clock: clock}
+}
+
+func (s *UserErase) Execute(ctx context.Context, req *UserEraseRequest) (*UserErasure, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	if session.Subject != req.UserID.String() && !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	requestedBy, err := uuid.Parse(session.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse user id: %w", err)
+	}
+
+	erasure, err := s.repo.CreateUserErasure(ctx, req.UserID, requestedBy, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule user erasure: %w", err)
+	}
+	return erasure, nil
+}
@@ -0,0 +1,142 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockUserEraseRepository struct {
+	userID      uuid.UUID
+	requestedBy uuid.UUID
+	err         error
+}
+
+func (m *mockUserEraseRepository) CreateUserErasure(_ context.Context, userID uuid.UUID, requestedBy uuid.UUID, now time.Time) (*domain.UserErasure, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.userID = userID
+	m.requestedBy = requestedBy
+	return &domain.UserErasure{
+		ID:                uuid.New(),
+		UserID:            userID,
+		RequestedByUserID: requestedBy,
+		Status:            domain.UserErasureStatusPending,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+func TestUserErase_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		repo := &mockUserEraseRepository{}
+		svc := domain.NewUserErase(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.UserEraseRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		assert.Equal(t, uuid.Nil, repo.userID)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		repo := &mockUserEraseRepository{}
+		svc := domain.NewUserErase(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.UserEraseRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Equal(t, uuid.Nil, repo.userID)
+	})
+
+	t.Run("schedules erasure for self", func(t *testing.T) {
+		repo := &mockUserEraseRepository{}
+		svc := domain.NewUserErase(repo, commondomain.NewMockClock(now))
+
+		erasure, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.UserEraseRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserErasureStatusPending, erasure.Status)
+		assert.Equal(t, userID, repo.userID)
+		assert.Equal(t, userID, repo.requestedBy)
+	})
+
+	t.Run("allows admins to schedule erasure of other users", func(t *testing.T) {
+		adminID := uuid.New()
+		repo := &mockUserEraseRepository{}
+		svc := domain.NewUserErase(repo, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithAdminSubject(adminID.String()), &domain.UserEraseRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, repo.userID)
+		assert.Equal(t, adminID, repo.requestedBy)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repoErr := errors.New("database unavailable")
+		svc := domain.NewUserErase(&mockUserEraseRepository{err: repoErr}, commondomain.NewMockClock(now))
+
+		_, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.UserEraseRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
+
+func TestUserErasureAuditChain(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 123456789, time.UTC)
+	erasureID := uuid.New()
+
+	buildChain := func(t *testing.T) []domain.UserErasureAuditEntry {
+		t.Helper()
+		first, err := domain.NewUserErasureAuditEntry("", erasureID, domain.UserErasureAuditEventRequested, map[string]any{"self_service": true}, now)
+		require.NoError(t, err)
+		second, err := domain.NewUserErasureAuditEntry(first.Hash, erasureID, domain.UserErasureAuditEventStepCompleted, map[string]any{"step": "database"}, now.Add(time.Second))
+		require.NoError(t, err)
+		third, err := domain.NewUserErasureAuditEntry(second.Hash, erasureID, domain.UserErasureAuditEventCompleted, nil, now.Add(2*time.Second))
+		require.NoError(t, err)
+		return []domain.UserErasureAuditEntry{*first, *second, *third}
+	}
+
+	t.Run("truncates timestamps to postgres precision", func(t *testing.T) {
+		entries := buildChain(t)
+		assert.Equal(t, now.Truncate(time.Microsecond), entries[0].CreatedAt)
+	})
+
+	t.Run("accepts an intact chain", func(t *testing.T) {
+		index, err := domain.VerifyUserErasureAuditChain(buildChain(t))
+
+		require.NoError(t, err)
+		assert.Equal(t, -1, index)
+	})
+
+	t.Run("detects modified entries", func(t *testing.T) {
+		entries := buildChain(t)
+		entries[1].Metadata = map[string]any{"step": "leaderboards"}
+
+		index, err := domain.VerifyUserErasureAuditChain(entries)
+
+		assert.ErrorIs(t, err, domain.ErrUserErasureAuditTampered)
+		assert.Equal(t, 1, index)
+	})
+
+	t.Run("detects removed entries", func(t *testing.T) {
+		entries := buildChain(t)
+		entries = append(entries[:1], entries[2:]...)
+
+		index, err := domain.VerifyUserErasureAuditChain(entries)
+
+		assert.ErrorIs(t, err, domain.ErrUserErasureAuditTampered)
+		assert.Equal(t, 1, index)
+	})
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+// UserErasureWorkerRepository tracks erasure progress. Every method that
+// changes progress also appends to the erasure audit trail in the same
+// transaction.
+type UserErasureWorkerRepository interface {
+	ListPendingUserErasures(ctx context.Context, limit int32) ([]UserErasure, error)
+
+	// EraseUserData deletes or anonymizes all rows referencing the user and
+	// marks the database step as completed.
+	EraseUserData(ctx context.Context, erasure *UserErasure, now time.Time) error
+	MarkUserErasureStepCompleted(ctx context.Context, erasureID uuid.UUID, step UserErasureStep, now time.Time) error
+	RecordUserErasureFailure(ctx context.Context, erasureID uuid.UUID, step UserErasureStep, reason string, now time.Time) error
+	CompleteUserErasure(ctx context.Context, erasureID uuid.UUID, now time.Time) error
+
+	// Contest invitations and co-organizers are kept by the database step as
+	// they are needed to find the Keto tuples to remove, they are deleted once
+	// those are gone.
+	ListInvitedContestIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	DeleteContestInvitationsForUser(ctx context.Context, userID uuid.UUID) error
+	ListOrganizedContestIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	DeleteContestOrganizersForUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserErasureLeaderboardStore removes a user from every cached leaderboard.
+type UserErasureLeaderboardStore interface {
+	RemoveUserFromLeaderboards(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserErasureRelationshipRemover removes all authorization relationships
+// held by a user.
+type UserErasureRelationshipRemover interface {
+	RemoveUserRelationships(ctx context.Context, userID uuid.UUID) error
+	RemoveContestInvitee(ctx context.Context, contestID, userID uuid.UUID) error
+	RemoveContestOrganizer(ctx context.Context, contestID, userID uuid.UUID) error
+}
+
+// UserErasureWorker polls for pending erasures and runs the outstanding
+// steps of each. All steps are idempotent, so a step that failed halfway or
+// is picked up by multiple API instances can safely run again.
+type UserErasureWorker struct {
+	repo          UserErasureWorkerRepository
+	leaderboards  UserErasureLeaderboardStore
+	relationships UserErasureRelationshipRemover
+	clock         commondomain.Clock
+	interval      time.Duration
+}
+
+func NewUserErasureWorker(
+	repo UserErasureWorkerRepository,
+	leaderboards UserErasureLeaderboardStore,
+	relationships UserErasureRelationshipRemover,
+	clock commondomain.Clock,
+	interval time.Duration,
+) *UserErasureWorker {
+	return &UserErasureWorker{
+		repo:          repo,
+		leaderboards:  leaderboards,
+		relationships: relationships,
+		clock:         clock,
+		interval:      interval,
+	}
+}
+
+// Run processes pending erasures at the configured interval until the
+// context is cancelled.
+func (w *UserErasureWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.processPending(ctx)
+		}
+	}
+}
+
+// ProcessPendingForTest exposes processPending for unit testing.
+func (w *UserErasureWorker) ProcessPendingForTest(ctx context.Context) {
+	w.processPending(ctx)
+}
+
+func (w *UserErasureWorker) processPending(ctx context.Context) {
+	erasures, err := w.repo.ListPendingUserErasures(ctx, 10)
+	if err != nil {
+		slog.ErrorContext(ctx, "user erasure worker: could not list pending erasures", "error", err)
+		return
+	}
+
+	for i := range erasures {
+		erasure := &erasures[i]
+		if err := w.process(ctx, erasure); err != nil {
+			slog.ErrorContext(ctx, "user erasure worker: erasure failed", "erasure_id", erasure.ID, "error", err)
+		}
+	}
+}
+
+func (w *UserErasureWorker) process(ctx context.Context, erasure *UserErasure) error {
+	for _, step := range UserErasureSteps {
+		if erasure.StepCompleted(step) {
+			continue
+		}
+
+		// Later steps are not attempted after a failure so the ordering
+		// guarantees documented on UserErasureSteps hold on every retry.
+		if err := w.runStep(ctx, erasure, step); err != nil {
+			if recordErr := w.repo.RecordUserErasureFailure(ctx, erasure.ID, step, err.Error(), w.clock.Now()); recordErr != nil {
+				slog.ErrorContext(ctx, "user erasure worker: could not record failure", "erasure_id", erasure.ID, "error", recordErr)
+			}
+			return fmt.Errorf("step %s: %w", step, err)
+		}
+	}
+
+	return w.repo.CompleteUserErasure(ctx, erasure.ID, w.clock.Now())
+}
+
+func (w *UserErasureWorker) runStep(ctx context.Context, erasure *UserErasure, step UserErasureStep) error {
+	switch step {
+	case UserErasureStepDatabase:
+		return w.repo.EraseUserData(ctx, erasure, w.clock.Now())
+	case UserErasureStepLeaderboards:
+		if err := w.leaderboards.RemoveUserFromLeaderboards(ctx, erasure.UserID); err != nil {
+			return err
+		}
+	case UserErasureStepRelationships:
+		if err := w.removeRelationships(ctx, erasure.UserID); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown erasure step %q", step)
+	}
+	return w.repo.MarkUserErasureStepCompleted(ctx, erasure.ID, step, w.clock.Now())
+}
+
+func (w *UserErasureWorker) removeRelationships(ctx context.Context, userID uuid.UUID) error {
+	if err := w.relationships.RemoveUserRelationships(ctx, userID); err != nil {
+		return err
+	}
+
+	contestIDs, err := w.repo.ListInvitedContestIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, contestID := range contestIDs {
+		if err := w.relationships.RemoveContestInvitee(ctx, contestID, userID); err != nil {
+			return err
+		}
+	}
+
+	if err := w.repo.DeleteContestInvitationsForUser(ctx, userID); err != nil {
+		return err
+	}
+
+	contestIDs, err = w.repo.ListOrganizedContestIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, contestID := range contestIDs {
+		if err := w.relationships.RemoveContestOrganizer(ctx, contestID, userID); err != nil {
+			return err
+		}
+	}
+
+	return w.repo.DeleteContestOrganizersForUser(ctx, userID)
+}
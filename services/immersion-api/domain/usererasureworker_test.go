@@ -0,0 +1,221 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockUserErasureWorkerRepository struct {
+	pending             []domain.UserErasure
+	invitedContestIDs   []uuid.UUID
+	invitationsDeleted  bool
+	organizedContestIDs []uuid.UUID
+	organizersDeleted   bool
+	eraseErr            error
+	erased              []uuid.UUID
+	completed           []domain.UserErasureStep
+	failures            []domain.UserErasureStep
+	finished            []uuid.UUID
+}
+
+func (m *mockUserErasureWorkerRepository) ListPendingUserErasures(context.Context, int32) ([]domain.UserErasure, error) {
+	return m.pending, nil
+}
+
+func (m *mockUserErasureWorkerRepository) EraseUserData(_ context.Context, erasure *domain.UserErasure, _ time.Time) error {
+	if m.eraseErr != nil {
+		return m.eraseErr
+	}
+	m.erased = append(m.erased, erasure.UserID)
+	m.completed = append(m.completed, domain.UserErasureStepDatabase)
+	return nil
+}
+
+func (m *mockUserErasureWorkerRepository) MarkUserErasureStepCompleted(_ context.Context, _ uuid.UUID, step domain.UserErasureStep, _ time.Time) error {
+	m.completed = append(m.completed, step)
+	return nil
+}
+
+func (m *mockUserErasureWorkerRepository) RecordUserErasureFailure(_ context.Context, _ uuid.UUID, step domain.UserErasureStep, _ string, _ time.Time) error {
+	m.failures = append(m.failures, step)
+	return nil
+}
+
+func (m *mockUserErasureWorkerRepository) CompleteUserErasure(_ context.Context, erasureID uuid.UUID, _ time.Time) error {
+	m.finished = append(m.finished, erasureID)
+	return nil
+}
+
+func (m *mockUserErasureWorkerRepository) ListInvitedContestIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return m.invitedContestIDs, nil
+}
+
+func (m *mockUserErasureWorkerRepository) DeleteContestInvitationsForUser(context.Context, uuid.UUID) error {
+	m.invitationsDeleted = true
+	return nil
+}
+
+func (m *mockUserErasureWorkerRepository) ListOrganizedContestIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return m.organizedContestIDs, nil
+}
+
+func (m *mockUserErasureWorkerRepository) DeleteContestOrganizersForUser(context.Context, uuid.UUID) error {
+	m.organizersDeleted = true
+	return nil
+}
+
+type mockUserErasureLeaderboardStore struct {
+	removed []uuid.UUID
+}
+
+func (m *mockUserErasureLeaderboardStore) RemoveUserFromLeaderboards(_ context.Context, userID uuid.UUID) error {
+	m.removed = append(m.removed, userID)
+	return nil
+}
+
+type mockUserErasureRelationshipRemover struct {
+	err              error
+	removed          []uuid.UUID
+	removedContests  []uuid.UUID
+	removedOrganized []uuid.UUID
+}
+
+func (m *mockUserErasureRelationshipRemover) RemoveUserRelationships(_ context.Context, userID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removed = append(m.removed, userID)
+	return nil
+}
+
+func (m *mockUserErasureRelationshipRemover) RemoveContestInvitee(_ context.Context, contestID, _ uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removedContests = append(m.removedContests, contestID)
+	return nil
+}
+
+func (m *mockUserErasureRelationshipRemover) RemoveContestOrganizer(_ context.Context, contestID, _ uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removedOrganized = append(m.removedOrganized, contestID)
+	return nil
+}
+
+func TestUserErasureWorker_ProcessPending(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("runs all steps and completes the erasure", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}}
+		leaderboards := &mockUserErasureLeaderboardStore{}
+		relationships := &mockUserErasureRelationshipRemover{}
+		worker := domain.NewUserErasureWorker(repo, leaderboards, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Equal(t, []uuid.UUID{erasure.UserID}, repo.erased)
+		assert.Equal(t, []uuid.UUID{erasure.UserID}, leaderboards.removed)
+		assert.Equal(t, []uuid.UUID{erasure.UserID}, relationships.removed)
+		assert.Equal(t, domain.UserErasureSteps, repo.completed)
+		assert.Equal(t, []uuid.UUID{erasure.ID}, repo.finished)
+	})
+
+	t.Run("resumes after completed steps", func(t *testing.T) {
+		erasedAt := now.Add(-time.Hour)
+		erasure := domain.UserErasure{
+			ID:                   uuid.New(),
+			UserID:               uuid.New(),
+			Status:               domain.UserErasureStatusPending,
+			DatabaseErasedAt:     &erasedAt,
+			LeaderboardsErasedAt: &erasedAt,
+		}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}}
+		leaderboards := &mockUserErasureLeaderboardStore{}
+		relationships := &mockUserErasureRelationshipRemover{}
+		worker := domain.NewUserErasureWorker(repo, leaderboards, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Empty(t, repo.erased)
+		assert.Empty(t, leaderboards.removed)
+		assert.Equal(t, []domain.UserErasureStep{domain.UserErasureStepRelationships}, repo.completed)
+		assert.Equal(t, []uuid.UUID{erasure.ID}, repo.finished)
+	})
+
+	t.Run("records failure and leaves erasure pending when a service is down", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}}
+		relationships := &mockUserErasureRelationshipRemover{err: errors.New("authz-api unavailable")}
+		worker := domain.NewUserErasureWorker(repo, &mockUserErasureLeaderboardStore{}, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Equal(t, []domain.UserErasureStep{domain.UserErasureStepDatabase, domain.UserErasureStepLeaderboards}, repo.completed)
+		assert.Equal(t, []domain.UserErasureStep{domain.UserErasureStepRelationships}, repo.failures)
+		assert.Empty(t, repo.finished)
+	})
+
+	t.Run("removes contest invitations after their Keto tuples", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		contestIDs := []uuid.UUID{uuid.New(), uuid.New()}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}, invitedContestIDs: contestIDs}
+		relationships := &mockUserErasureRelationshipRemover{}
+		worker := domain.NewUserErasureWorker(repo, &mockUserErasureLeaderboardStore{}, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Equal(t, contestIDs, relationships.removedContests)
+		assert.True(t, repo.invitationsDeleted)
+		assert.Equal(t, []uuid.UUID{erasure.ID}, repo.finished)
+	})
+
+	t.Run("removes contest co-organizers after their Keto tuples", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		contestIDs := []uuid.UUID{uuid.New()}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}, organizedContestIDs: contestIDs}
+		relationships := &mockUserErasureRelationshipRemover{}
+		worker := domain.NewUserErasureWorker(repo, &mockUserErasureLeaderboardStore{}, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Equal(t, contestIDs, relationships.removedOrganized)
+		assert.True(t, repo.organizersDeleted)
+		assert.Equal(t, []uuid.UUID{erasure.ID}, repo.finished)
+	})
+
+	t.Run("keeps contest invitations when Keto is down", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}, invitedContestIDs: []uuid.UUID{uuid.New()}}
+		relationships := &mockUserErasureRelationshipRemover{err: errors.New("authz-api unavailable")}
+		worker := domain.NewUserErasureWorker(repo, &mockUserErasureLeaderboardStore{}, relationships, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.False(t, repo.invitationsDeleted)
+		assert.False(t, repo.organizersDeleted)
+		assert.Empty(t, repo.finished)
+	})
+
+	t.Run("does not run later steps when the database step fails", func(t *testing.T) {
+		erasure := domain.UserErasure{ID: uuid.New(), UserID: uuid.New(), Status: domain.UserErasureStatusPending}
+		repo := &mockUserErasureWorkerRepository{pending: []domain.UserErasure{erasure}, eraseErr: errors.New("deadlock")}
+		leaderboards := &mockUserErasureLeaderboardStore{}
+		worker := domain.NewUserErasureWorker(repo, leaderboards, &mockUserErasureRelationshipRemover{}, commondomain.NewMockClock(now), time.Minute)
+
+		worker.ProcessPendingForTest(context.Background())
+
+		assert.Empty(t, leaderboards.removed)
+		assert.Equal(t, []domain.UserErasureStep{domain.UserErasureStepDatabase}, repo.failures)
+		assert.Empty(t, repo.finished)
+	})
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxStreakGraceDays caps how many days without logs a user may allow before
+// a streak breaks.
+const MaxStreakGraceDays = 3
+
+type UserSettings struct {
+	UserID uuid.UUID
+	// Timezone is an IANA time zone name, logs are bucketed into days in it.
+	Timezone string
+	// StreakGraceDays is the number of consecutive days without logs that do
+	// not break a streak. Those days do not count towards the streak either.
+	StreakGraceDays int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// DefaultUserSettings are used for users who never changed their settings.
+func DefaultUserSettings(userID uuid.UUID) *UserSettings {
+	return &UserSettings{
+		UserID:   userID,
+		Timezone: "UTC",
+	}
+}
+
+// Location returns the time zone of the user, falling back to UTC when the
+// stored name is no longer known.
+func (s *UserSettings) Location() *time.Location {
+	return loadUserLocation(s.Timezone)
+}
+
+func loadUserLocation(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// userLocations caches time zones by name while a batch of logs is scored.
+type userLocations map[string]*time.Location
+
+func (l userLocations) load(timezone string) *time.Location {
+	loc, ok := l[timezone]
+	if !ok {
+		loc = loadUserLocation(timezone)
+		l[timezone] = loc
+	}
+	return loc
+}
+
+type UserSettingsRepository interface {
+	// FindUserSettings returns ErrNotFound when the user has no settings yet.
+	FindUserSettings(ctx context.Context, userID uuid.UUID) (*UserSettings, error)
+}
+
+// findUserSettingsOrDefault loads the user's settings, or the defaults when
+// none were saved yet.
+func findUserSettingsOrDefault(ctx context.Context, repo UserSettingsRepository, userID uuid.UUID) (*UserSettings, error) {
+	settings, err := repo.FindUserSettings(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return DefaultUserSettings(userID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch user settings: %w", err)
+	}
+	return settings, nil
+}
+
+// findUserLocation returns the time zone of the user, UTC unless they set one.
+func findUserLocation(ctx context.Context, repo UserSettingsRepository, userID uuid.UUID) (*time.Location, error) {
+	settings, err := findUserSettingsOrDefault(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Location(), nil
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type UserSettingsFindRequest struct {
+	UserID uuid.UUID
+}
+
+type UserSettingsFind struct {
+	repo UserSettingsRepository
+}
+
+func NewUserSettingsFind(repo UserSettingsRepository) *UserSettingsFind {
+	return &UserSettingsFind{repo: repo}
+}
+
+func (s *UserSettingsFind) Execute(ctx context.Context, req *UserSettingsFindRequest) (*UserSettings, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	if session.Subject != req.UserID.String() && !isAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	return findUserSettingsOrDefault(ctx, s.repo, req.UserID)
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func TestUserSettingsFind_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("returns unauthorized for guest", func(t *testing.T) {
+		svc := domain.NewUserSettingsFind(&mockUserSettingsRepository{})
+
+		_, err := svc.Execute(ctxWithGuest(), &domain.UserSettingsFindRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("returns forbidden for other users", func(t *testing.T) {
+		svc := domain.NewUserSettingsFind(&mockUserSettingsRepository{})
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), &domain.UserSettingsFindRequest{UserID: userID})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("returns defaults when nothing was saved", func(t *testing.T) {
+		svc := domain.NewUserSettingsFind(&mockUserSettingsRepository{})
+
+		settings, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.UserSettingsFindRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", settings.Timezone)
+		assert.Equal(t, 0, settings.StreakGraceDays)
+	})
+
+	t.Run("allows admins", func(t *testing.T) {
+		stored := &domain.UserSettings{UserID: userID, Timezone: "Asia/Tokyo", StreakGraceDays: 1}
+		svc := domain.NewUserSettingsFind(&mockUserSettingsRepository{settings: stored})
+
+		settings, err := svc.Execute(ctxWithAdminSubject(uuid.NewString()), &domain.UserSettingsFindRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, settings)
+	})
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+)
+
+type UserSettingsUpdateRepository interface {
+	UpsertUserSettings(ctx context.Context, settings *UserSettings, now time.Time) error
+}
+
+type UserSettingsUpdateRequest struct {
+	UserID          uuid.UUID
+	Timezone        string
+	StreakGraceDays int
+}
+
+type UserSettingsUpdate struct {
+	repo  UserSettingsUpdateRepository
+	clock commondomain.Clock
+}
+
+func NewUserSettingsUpdate(repo UserSettingsUpdateRepository, clock commondomain.Clock) *UserSettingsUpdate {
+	return &UserSettingsUpdate{repo: repo, clock: clock}
+}
+
+func (s *UserSettingsUpdate) Execute(ctx context.Context, req *UserSettingsUpdateRequest) (*UserSettings, error) {
+	if err := requireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	session := commondomain.ParseUserIdentity(ctx)
+	if session == nil {
+		return nil, ErrUnauthorized
+	}
+	if session.Subject != req.UserID.String() {
+		return nil, ErrForbidden
+	}
+
+	// LoadLocation also accepts "Local" and "", which depend on the server.
+	if req.Timezone == "" || req.Timezone == "Local" {
+		return nil, fmt.Errorf("%w: timezone is required", ErrRequestInvalid)
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrRequestInvalid, req.Timezone)
+	}
+	if req.StreakGraceDays < 0 || req.StreakGraceDays > MaxStreakGraceDays {
+		return nil, fmt.Errorf("%w: streak grace days must be between 0 and %d", ErrRequestInvalid, MaxStreakGraceDays)
+	}
+
+	now := s.clock.Now()
+	settings := &UserSettings{
+		UserID:          req.UserID,
+		Timezone:        req.Timezone,
+		StreakGraceDays: req.StreakGraceDays,
+		UpdatedAt:       now,
+	}
+	if err := s.repo.UpsertUserSettings(ctx, settings, now); err != nil {
+		return nil, fmt.Errorf("could not update user settings: %w", err)
+	}
+	return settings, nil
+}
@@ -0,0 +1,87 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commondomain "github.com/tadoku/tadoku/services/common/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+type mockUserSettingsRepository struct {
+	settings *domain.UserSettings
+}
+
+func (m *mockUserSettingsRepository) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	if m.settings == nil {
+		return nil, domain.ErrNotFound
+	}
+	return m.settings, nil
+}
+
+func (m *mockUserSettingsRepository) UpsertUserSettings(_ context.Context, settings *domain.UserSettings, _ time.Time) error {
+	m.settings = settings
+	return nil
+}
+
+func TestUserSettingsUpdate_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2026, time.March, 11, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns forbidden for other users and admins", func(t *testing.T) {
+		repo := &mockUserSettingsRepository{}
+		svc := domain.NewUserSettingsUpdate(repo, commondomain.NewMockClock(now))
+		req := &domain.UserSettingsUpdateRequest{UserID: userID, Timezone: "Europe/Amsterdam"}
+
+		_, err := svc.Execute(ctxWithUserSubject(uuid.NewString()), req)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		_, err = svc.Execute(ctxWithAdminSubject(uuid.NewString()), req)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+
+		assert.Nil(t, repo.settings)
+	})
+
+	t.Run("stores valid settings", func(t *testing.T) {
+		repo := &mockUserSettingsRepository{}
+		svc := domain.NewUserSettingsUpdate(repo, commondomain.NewMockClock(now))
+
+		settings, err := svc.Execute(ctxWithUserSubject(userID.String()), &domain.UserSettingsUpdateRequest{
+			UserID:          userID,
+			Timezone:        "Asia/Tokyo",
+			StreakGraceDays: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", settings.Timezone)
+		assert.Equal(t, settings, repo.settings)
+	})
+
+	invalid := []struct {
+		name string
+		req  domain.UserSettingsUpdateRequest
+	}{
+		{"unknown timezone", domain.UserSettingsUpdateRequest{Timezone: "Mars/Olympus_Mons"}},
+		{"server local timezone", domain.UserSettingsUpdateRequest{Timezone: "Local"}},
+		{"empty timezone", domain.UserSettingsUpdateRequest{}},
+		{"negative grace days", domain.UserSettingsUpdateRequest{Timezone: "UTC", StreakGraceDays: -1}},
+		{"too many grace days", domain.UserSettingsUpdateRequest{Timezone: "UTC", StreakGraceDays: domain.MaxStreakGraceDays + 1}},
+	}
+	for _, tt := range invalid {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			repo := &mockUserSettingsRepository{}
+			svc := domain.NewUserSettingsUpdate(repo, commondomain.NewMockClock(now))
+			req := tt.req
+			req.UserID = userID
+
+			_, err := svc.Execute(ctxWithUserSubject(userID.String()), &req)
+
+			assert.ErrorIs(t, err, domain.ErrRequestInvalid)
+			assert.Nil(t, repo.settings)
+		})
+	}
+}
@@ -29,9 +29,10 @@ func domainLeaderboardToAPI(leaderboard domain.Leaderboard) *openapi.Leaderboard
 func activityToAPI(activity domain.Activity, includeDefault bool) openapi.Activity {
 	inputType := openapi.ActivityInputType(activity.InputType)
 	res := openapi.Activity{
-		Id:        activity.ID,
-		InputType: &inputType,
-		Name:      activity.Name,
+		Id:                     activity.ID,
+		InputType:              &inputType,
+		Name:                   activity.Name,
+		DurationScorePerMinute: &activity.DurationScorePerMinute,
 	}
 	if includeDefault {
 		res.Default = &activity.Default
@@ -83,6 +84,7 @@ func logToAPI(log *domain.Log) *openapi.Log {
 		CreatedAt:       log.CreatedAt,
 		Deleted:         log.Deleted,
 		Description:     log.Description,
+		MediaId:         log.MediaID,
 		Registrations:   &refs,
 	}
 	if log.UnitKey != "" {
@@ -132,3 +134,44 @@ func contestRegistrationToAPI(r *domain.ContestRegistration) *openapi.ContestReg
 
 	return &registration
 }
+
+func contestViewToAPI(contest *domain.ContestView) openapi.ContestView {
+	langs := make([]openapi.Language, len(contest.AllowedLanguages))
+	for i, it := range contest.AllowedLanguages {
+		langs[i] = openapi.Language{
+			Code: it.Code,
+			Name: it.Name,
+		}
+	}
+
+	if len(langs) == 0 {
+		langs = nil
+	}
+
+	acts := make([]openapi.Activity, len(contest.AllowedActivities))
+	for i, it := range contest.AllowedActivities {
+		acts[i] = activityToAPI(it, false)
+	}
+
+	return openapi.ContestView{
+		Id:                   &contest.ID,
+		ContestStart:         types.Date{Time: contest.ContestStart},
+		ContestEnd:           types.Date{Time: contest.ContestEnd},
+		RegistrationEnd:      types.Date{Time: contest.RegistrationEnd},
+		Title:                contest.Title,
+		Description:          contest.Description,
+		OwnerUserId:          &contest.OwnerUserID,
+		OwnerUserDisplayName: &contest.OwnerUserDisplayName,
+		Official:             contest.Official,
+		Private:              contest.Private,
+		AllowedLanguages:     langs,
+		AllowedActivities:    acts,
+		TeamMode:             &contest.TeamMode,
+		TeamSizeLimit:        contest.TeamSizeLimit,
+		TeamScoreAggregation: teamScoreAggregationToAPI(contest.TeamMode, contest.TeamScoreAggregation),
+		TemplateId:           contest.TemplateID,
+		CreatedAt:            &contest.CreatedAt,
+		UpdatedAt:            &contest.UpdatedAt,
+		Deleted:              &contest.Deleted,
+	}
+}
@@ -23,16 +23,107 @@ const (
 	TimePrimary   ActivityInputType = "time_primary"
 )
 
+// Defines values for ContestRecurrenceFrequency.
+const (
+	ContestRecurrenceFrequencyMonthly ContestRecurrenceFrequency = "monthly"
+	ContestRecurrenceFrequencyWeekly  ContestRecurrenceFrequency = "weekly"
+)
+
+// Defines values for GoalMetric.
+const (
+	GoalMetricAmount   GoalMetric = "amount"
+	GoalMetricDuration GoalMetric = "duration"
+	GoalMetricScore    GoalMetric = "score"
+)
+
+// Defines values for GoalPeriod.
+const (
+	GoalPeriodCustom  GoalPeriod = "custom"
+	GoalPeriodDaily   GoalPeriod = "daily"
+	GoalPeriodMonthly GoalPeriod = "monthly"
+	GoalPeriodWeekly  GoalPeriod = "weekly"
+	GoalPeriodYearly  GoalPeriod = "yearly"
+)
+
+// Defines values for GoalInputMetric.
+const (
+	GoalInputMetricAmount   GoalInputMetric = "amount"
+	GoalInputMetricDuration GoalInputMetric = "duration"
+	GoalInputMetricScore    GoalInputMetric = "score"
+)
+
+// Defines values for GoalInputPeriod.
+const (
+	Custom  GoalInputPeriod = "custom"
+	Daily   GoalInputPeriod = "daily"
+	Monthly GoalInputPeriod = "monthly"
+	Weekly  GoalInputPeriod = "weekly"
+	Yearly  GoalInputPeriod = "yearly"
+)
+
+// Defines values for MediaLengthUnit.
+const (
+	Character MediaLengthUnit = "character"
+	Episode   MediaLengthUnit = "episode"
+	Minute    MediaLengthUnit = "minute"
+	Page      MediaLengthUnit = "page"
+)
+
+// Defines values for MediaType.
+const (
+	Audio MediaType = "audio"
+	Book  MediaType = "book"
+	Comic MediaType = "comic"
+	Game  MediaType = "game"
+	Movie MediaType = "movie"
+	Other MediaType = "other"
+	Tv    MediaType = "tv"
+	Web   MediaType = "web"
+)
+
 // Defines values for ScoreEstimateSource.
 const (
 	ScoreEstimateSourceAmount          ScoreEstimateSource = "amount"
 	ScoreEstimateSourceDurationMinutes ScoreEstimateSource = "duration_minutes"
 )
 
+// Defines values for ScoreExplanationSource.
+const (
+	ScoreExplanationSourceAmount          ScoreExplanationSource = "amount"
+	ScoreExplanationSourceDurationMinutes ScoreExplanationSource = "duration_minutes"
+)
+
+// Defines values for ScoringRescoreJobScope.
+const (
+	ScoringRescoreJobScopeContest  ScoringRescoreJobScope = "contest"
+	ScoringRescoreJobScopePlatform ScoringRescoreJobScope = "platform"
+)
+
+// Defines values for ScoringRescoreJobStatus.
+const (
+	ScoringRescoreJobStatusCompleted  ScoringRescoreJobStatus = "completed"
+	ScoringRescoreJobStatusFailed     ScoringRescoreJobStatus = "failed"
+	ScoringRescoreJobStatusPending    ScoringRescoreJobStatus = "pending"
+	ScoringRescoreJobStatusRunning    ScoringRescoreJobStatus = "running"
+	ScoringRescoreJobStatusSuperseded ScoringRescoreJobStatus = "superseded"
+)
+
+// Defines values for ScoringRuleCapPeriod.
+const (
+	Day  ScoringRuleCapPeriod = "day"
+	Week ScoringRuleCapPeriod = "week"
+)
+
 // Defines values for ScoringRuleScoreSource.
 const (
-	ScoringRuleScoreSourceAmount          ScoringRuleScoreSource = "amount"
-	ScoringRuleScoreSourceDurationMinutes ScoringRuleScoreSource = "duration_minutes"
+	Amount          ScoringRuleScoreSource = "amount"
+	DurationMinutes ScoringRuleScoreSource = "duration_minutes"
+)
+
+// Defines values for ScoringRuleTagMatch.
+const (
+	All ScoringRuleTagMatch = "all"
+	Any ScoringRuleTagMatch = "any"
 )
 
 // Defines values for ScoringRuleSetMode.
@@ -49,6 +140,7 @@ const (
 
 // Defines values for ScoringRuleSetStatus.
 const (
+	Archived  ScoringRuleSetStatus = "archived"
 	Draft     ScoringRuleSetStatus = "draft"
 	Published ScoringRuleSetStatus = "published"
 )
@@ -59,6 +151,31 @@ const (
 	ScoringRuleSetDraftModeReplace  ScoringRuleSetDraftMode = "replace"
 )
 
+// Defines values for TeamScoreAggregation.
+const (
+	Average TeamScoreAggregation = "average"
+	Sum     TeamScoreAggregation = "sum"
+)
+
+// Defines values for UserErasureCompletedSteps.
+const (
+	Database      UserErasureCompletedSteps = "database"
+	Leaderboards  UserErasureCompletedSteps = "leaderboards"
+	Relationships UserErasureCompletedSteps = "relationships"
+)
+
+// Defines values for UserErasureStatus.
+const (
+	UserErasureStatusCompleted UserErasureStatus = "completed"
+	UserErasureStatusPending   UserErasureStatus = "pending"
+)
+
+// Defines values for LogImportJSONBodyFormat.
+const (
+	Csv   LogImportJSONBodyFormat = "csv"
+	Jsonl LogImportJSONBodyFormat = "jsonl"
+)
+
 // Activities defines model for Activities.
 type Activities struct {
 	Activities []Activity `json:"activities"`
@@ -66,13 +183,27 @@ type Activities struct {
 
 // Activity defines model for Activity.
 type Activity struct {
-	Default   *bool              `json:"default,omitempty"`
-	Id        int32              `json:"id"`
-	InputType *ActivityInputType `json:"input_type,omitempty"`
-	Name      string             `json:"name"`
+	Default *bool `json:"default,omitempty"`
+
+	// DurationScorePerMinute score of a minute of duration-only tracking
+	DurationScorePerMinute *float32           `json:"duration_score_per_minute,omitempty"`
+	Id                     int32              `json:"id"`
+	InputType              *ActivityInputType `json:"input_type,omitempty"`
+	Name                   string             `json:"name"`
+}
+
+// ActivityInput defines model for ActivityInput.
+type ActivityInput struct {
+	// Default shown by default when logging
+	Default bool `json:"default"`
+
+	// DurationScorePerMinute score of a minute of duration-only tracking
+	DurationScorePerMinute float32           `json:"duration_score_per_minute"`
+	InputType              ActivityInputType `json:"input_type"`
+	Name                   string            `json:"name"`
 }
 
-// ActivityInputType defines model for Activity.InputType.
+// ActivityInputType defines model for ActivityInputType.
 type ActivityInputType string
 
 // ActivitySplit defines model for ActivitySplit.
@@ -108,8 +239,16 @@ type Contest struct {
 	OwnerUserId             *openapi_types.UUID `json:"owner_user_id,omitempty"`
 	Private                 bool                `json:"private"`
 	RegistrationEnd         openapi_types.Date  `json:"registration_end"`
-	Title                   string              `json:"title"`
-	UpdatedAt               *time.Time          `json:"updated_at,omitempty"`
+	TeamMode                *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TemplateId template the contest was created from
+	TemplateId *openapi_types.UUID `json:"template_id,omitempty"`
+	Title      string              `json:"title"`
+	UpdatedAt  *time.Time          `json:"updated_at,omitempty"`
 }
 
 // ContestBase defines model for ContestBase.
@@ -125,8 +264,16 @@ type ContestBase struct {
 	OwnerUserId          *openapi_types.UUID `json:"owner_user_id,omitempty"`
 	Private              bool                `json:"private"`
 	RegistrationEnd      openapi_types.Date  `json:"registration_end"`
-	Title                string              `json:"title"`
-	UpdatedAt            *time.Time          `json:"updated_at,omitempty"`
+	TeamMode             *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TemplateId template the contest was created from
+	TemplateId *openapi_types.UUID `json:"template_id,omitempty"`
+	Title      string              `json:"title"`
+	UpdatedAt  *time.Time          `json:"updated_at,omitempty"`
 }
 
 // ContestConfigurationOptions defines model for ContestConfigurationOptions.
@@ -136,6 +283,65 @@ type ContestConfigurationOptions struct {
 	Languages              []Language `json:"languages"`
 }
 
+// ContestInvitation defines model for ContestInvitation.
+type ContestInvitation struct {
+	CreatedAt       time.Time           `json:"created_at"`
+	InviteCodeId    *openapi_types.UUID `json:"invite_code_id,omitempty"`
+	InvitedByUserId *openapi_types.UUID `json:"invited_by_user_id,omitempty"`
+	UserDisplayName string              `json:"user_display_name"`
+	UserId          openapi_types.UUID  `json:"user_id"`
+}
+
+// ContestInvitations defines model for ContestInvitations.
+type ContestInvitations struct {
+	Invitations []ContestInvitation `json:"invitations"`
+}
+
+// ContestInviteCode defines model for ContestInviteCode.
+type ContestInviteCode struct {
+	Code      string             `json:"code"`
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	MaxUses   *int32             `json:"max_uses,omitempty"`
+	RevokedAt *time.Time         `json:"revoked_at,omitempty"`
+	UseCount  int32              `json:"use_count"`
+}
+
+// ContestInviteCodes defines model for ContestInviteCodes.
+type ContestInviteCodes struct {
+	InviteCodes []ContestInviteCode `json:"invite_codes"`
+}
+
+// ContestOrganizer defines model for ContestOrganizer.
+type ContestOrganizer struct {
+	AddedByUserId   *openapi_types.UUID `json:"added_by_user_id,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UserDisplayName string              `json:"user_display_name"`
+	UserId          openapi_types.UUID  `json:"user_id"`
+}
+
+// ContestOrganizers defines model for ContestOrganizers.
+type ContestOrganizers struct {
+	Organizers []ContestOrganizer `json:"organizers"`
+}
+
+// ContestPermissions defines model for ContestPermissions.
+type ContestPermissions struct {
+	Admin                  bool `json:"admin"`
+	CanDraftScoringRules   bool `json:"can_draft_scoring_rules"`
+	CanEdit                bool `json:"can_edit"`
+	CanManageInvites       bool `json:"can_manage_invites"`
+	CanManageOrganizers    bool `json:"can_manage_organizers"`
+	CanModerateLogs        bool `json:"can_moderate_logs"`
+	CanPublishScoringRules bool `json:"can_publish_scoring_rules"`
+	CanRemoveParticipants  bool `json:"can_remove_participants"`
+
+	// Organizer Whether the user is a co-organizer of the contest
+	Organizer bool `json:"organizer"`
+	Owner     bool `json:"owner"`
+}
+
 // ContestProfileActivity defines model for ContestProfileActivity.
 type ContestProfileActivity struct {
 	Rows []ContestProfileActivityRow `json:"rows"`
@@ -155,6 +361,9 @@ type ContestProfileScores struct {
 	Scores       Scores              `json:"scores"`
 }
 
+// ContestRecurrenceFrequency defines model for ContestRecurrenceFrequency.
+type ContestRecurrenceFrequency string
+
 // ContestRegistration defines model for ContestRegistration.
 type ContestRegistration struct {
 	Contest         *ContestView        `json:"contest,omitempty"`
@@ -191,11 +400,202 @@ type ContestScoreEstimate struct {
 	RegistrationId openapi_types.UUID `json:"registration_id"`
 }
 
+// ContestScoreExplanation defines model for ContestScoreExplanation.
+type ContestScoreExplanation struct {
+	ContestId      openapi_types.UUID `json:"contest_id"`
+	ContestTitle   string             `json:"contest_title"`
+	Explanation    ScoreExplanation   `json:"explanation"`
+	RegistrationId openapi_types.UUID `json:"registration_id"`
+}
+
 // ContestSummary defines model for ContestSummary.
 type ContestSummary struct {
-	LanguageCount    int     `json:"language_count"`
-	ParticipantCount int     `json:"participant_count"`
-	TotalScore       float32 `json:"total_score"`
+	LanguageCount    int             `json:"language_count"`
+	ParticipantCount int             `json:"participant_count"`
+	TopTags          *[]TagStatistic `json:"top_tags,omitempty"`
+	TotalScore       float32         `json:"total_score"`
+}
+
+// ContestTeam defines model for ContestTeam.
+type ContestTeam struct {
+	ContestId openapi_types.UUID  `json:"contest_id"`
+	CreatedAt time.Time           `json:"created_at"`
+	Id        openapi_types.UUID  `json:"id"`
+	Members   []ContestTeamMember `json:"members"`
+	Name      string              `json:"name"`
+}
+
+// ContestTeamMember defines model for ContestTeamMember.
+type ContestTeamMember struct {
+	JoinedAt        time.Time          `json:"joined_at"`
+	UserDisplayName string             `json:"user_display_name"`
+	UserId          openapi_types.UUID `json:"user_id"`
+}
+
+// ContestTeams defines model for ContestTeams.
+type ContestTeams struct {
+	Teams []ContestTeam `json:"teams"`
+}
+
+// ContestTemplate defines model for ContestTemplate.
+type ContestTemplate struct {
+	Active                  bool      `json:"active"`
+	ActivityTypeIdAllowList []int32   `json:"activity_type_id_allow_list"`
+	CreatedAt               time.Time `json:"created_at"`
+	Description             *string   `json:"description,omitempty"`
+
+	// DurationDays leave out to run every contest until the next one starts
+	DurationDays          *int32                     `json:"duration_days,omitempty"`
+	FirstContestStart     openapi_types.Date         `json:"first_contest_start"`
+	Frequency             ContestRecurrenceFrequency `json:"frequency"`
+	Id                    openapi_types.UUID         `json:"id"`
+	IntervalCount         int32                      `json:"interval_count"`
+	LanguageCodeAllowList []string                   `json:"language_code_allow_list"`
+
+	// LeadDays how many days before its start a contest is created
+	LeadDays                        int32              `json:"lead_days"`
+	NextContestStart                openapi_types.Date `json:"next_contest_start"`
+	Official                        bool               `json:"official"`
+	OwnerUserDisplayName            string             `json:"owner_user_display_name"`
+	OwnerUserId                     openapi_types.UUID `json:"owner_user_id"`
+	Private                         bool               `json:"private"`
+	RegistrationClosesBeforeEndDays int32              `json:"registration_closes_before_end_days"`
+
+	// ScoringRuleSetId published contest scoring rule set copied into every contest
+	ScoringRuleSetId *openapi_types.UUID `json:"scoring_rule_set_id,omitempty"`
+	TeamMode         *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TitlePattern supports the {year}, {month}, {month_name}, {quarter}, {number} and {year_number} placeholders
+	TitlePattern string    `json:"title_pattern"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ContestTemplateContest defines model for ContestTemplateContest.
+type ContestTemplateContest struct {
+	ContestEnd   openapi_types.Date `json:"contest_end"`
+	ContestStart openapi_types.Date `json:"contest_start"`
+	Deleted      bool               `json:"deleted"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// Occurrence zero-based position of the contest in the series
+	Occurrence      int32              `json:"occurrence"`
+	Private         bool               `json:"private"`
+	RegistrationEnd openapi_types.Date `json:"registration_end"`
+	Title           string             `json:"title"`
+}
+
+// ContestTemplateCreate defines model for ContestTemplateCreate.
+type ContestTemplateCreate struct {
+	ActivityTypeIdAllowList []int32 `json:"activity_type_id_allow_list"`
+	Description             *string `json:"description,omitempty"`
+
+	// DurationDays leave out to run every contest until the next one starts
+	DurationDays          *int32                     `json:"duration_days,omitempty"`
+	FirstContestStart     openapi_types.Date         `json:"first_contest_start"`
+	Frequency             ContestRecurrenceFrequency `json:"frequency"`
+	IntervalCount         int32                      `json:"interval_count"`
+	LanguageCodeAllowList []string                   `json:"language_code_allow_list"`
+
+	// LeadDays how many days before its start a contest is created
+	LeadDays                        int32 `json:"lead_days"`
+	Official                        bool  `json:"official"`
+	Private                         bool  `json:"private"`
+	RegistrationClosesBeforeEndDays int32 `json:"registration_closes_before_end_days"`
+
+	// ScoringRuleSetId published contest scoring rule set copied into every contest
+	ScoringRuleSetId *openapi_types.UUID `json:"scoring_rule_set_id,omitempty"`
+	TeamMode         *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TitlePattern supports the {year}, {month}, {month_name}, {quarter}, {number} and {year_number} placeholders
+	TitlePattern string `json:"title_pattern"`
+}
+
+// ContestTemplateDetails defines model for ContestTemplateDetails.
+type ContestTemplateDetails struct {
+	// Contests contests of the series, newest first
+	Contests []ContestTemplateContest `json:"contests"`
+	Template ContestTemplate          `json:"template"`
+}
+
+// ContestTemplateSettings defines model for ContestTemplateSettings.
+type ContestTemplateSettings struct {
+	ActivityTypeIdAllowList []int32 `json:"activity_type_id_allow_list"`
+	Description             *string `json:"description,omitempty"`
+
+	// DurationDays leave out to run every contest until the next one starts
+	DurationDays          *int32   `json:"duration_days,omitempty"`
+	LanguageCodeAllowList []string `json:"language_code_allow_list"`
+
+	// LeadDays how many days before its start a contest is created
+	LeadDays                        int32 `json:"lead_days"`
+	Private                         bool  `json:"private"`
+	RegistrationClosesBeforeEndDays int32 `json:"registration_closes_before_end_days"`
+
+	// ScoringRuleSetId published contest scoring rule set copied into every contest
+	ScoringRuleSetId *openapi_types.UUID `json:"scoring_rule_set_id,omitempty"`
+	TeamMode         *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TitlePattern supports the {year}, {month}, {month_name}, {quarter}, {number} and {year_number} placeholders
+	TitlePattern string `json:"title_pattern"`
+}
+
+// ContestTemplateUpdate defines model for ContestTemplateUpdate.
+type ContestTemplateUpdate struct {
+	Active                  bool    `json:"active"`
+	ActivityTypeIdAllowList []int32 `json:"activity_type_id_allow_list"`
+	Description             *string `json:"description,omitempty"`
+
+	// DurationDays leave out to run every contest until the next one starts
+	DurationDays          *int32   `json:"duration_days,omitempty"`
+	LanguageCodeAllowList []string `json:"language_code_allow_list"`
+
+	// LeadDays how many days before its start a contest is created
+	LeadDays                        int32 `json:"lead_days"`
+	Private                         bool  `json:"private"`
+	RegistrationClosesBeforeEndDays int32 `json:"registration_closes_before_end_days"`
+
+	// ScoringRuleSetId published contest scoring rule set copied into every contest
+	ScoringRuleSetId *openapi_types.UUID `json:"scoring_rule_set_id,omitempty"`
+	TeamMode         *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TitlePattern supports the {year}, {month}, {month_name}, {quarter}, {number} and {year_number} placeholders
+	TitlePattern string `json:"title_pattern"`
+}
+
+// ContestTemplates defines model for ContestTemplates.
+type ContestTemplates struct {
+	Templates []ContestTemplate `json:"templates"`
+}
+
+// ContestUpdate defines model for ContestUpdate.
+type ContestUpdate struct {
+	ActivityTypeIdAllowList []int32            `json:"activity_type_id_allow_list"`
+	ContestEnd              openapi_types.Date `json:"contest_end"`
+	ContestStart            openapi_types.Date `json:"contest_start"`
+	Description             *string            `json:"description,omitempty"`
+	LanguageCodeAllowList   []string           `json:"language_code_allow_list"`
+	Private                 bool               `json:"private"`
+
+	// Reason Recorded in the moderation audit log when changed by someone other than the owner
+	Reason          *string            `json:"reason,omitempty"`
+	RegistrationEnd openapi_types.Date `json:"registration_end"`
+	Title           string             `json:"title"`
 }
 
 // ContestView defines model for ContestView.
@@ -213,8 +613,16 @@ type ContestView struct {
 	OwnerUserId          *openapi_types.UUID `json:"owner_user_id,omitempty"`
 	Private              bool                `json:"private"`
 	RegistrationEnd      openapi_types.Date  `json:"registration_end"`
-	Title                string              `json:"title"`
-	UpdatedAt            *time.Time          `json:"updated_at,omitempty"`
+	TeamMode             *bool               `json:"team_mode,omitempty"`
+
+	// TeamScoreAggregation How member scores are combined into a team score
+	TeamScoreAggregation *TeamScoreAggregation `json:"team_score_aggregation,omitempty"`
+	TeamSizeLimit        *int32                `json:"team_size_limit,omitempty"`
+
+	// TemplateId template the contest was created from
+	TemplateId *openapi_types.UUID `json:"template_id,omitempty"`
+	Title      string              `json:"title"`
+	UpdatedAt  *time.Time          `json:"updated_at,omitempty"`
 }
 
 // Contests defines model for Contests.
@@ -226,6 +634,76 @@ type Contests struct {
 	TotalSize     int    `json:"total_size"`
 }
 
+// Goal defines model for Goal.
+type Goal struct {
+	ActivityId   *int32              `json:"activity_id,omitempty"`
+	Completed    bool                `json:"completed"`
+	CreatedAt    time.Time           `json:"created_at"`
+	Current      float32             `json:"current"`
+	EndsOn       *openapi_types.Date `json:"ends_on,omitempty"`
+	Id           openapi_types.UUID  `json:"id"`
+	LanguageCode *string             `json:"language_code,omitempty"`
+	Metric       GoalMetric          `json:"metric"`
+
+	// OnTrack whether the recent pace completes the goal before the period ends
+	OnTrack    bool       `json:"on_track"`
+	Percentage float32    `json:"percentage"`
+	Period     GoalPeriod `json:"period"`
+
+	// PeriodEnd exclusive end of the current period
+	PeriodEnd   time.Time `json:"period_end"`
+	PeriodStart time.Time `json:"period_start"`
+
+	// ProjectedCompletion when the goal is reached at the recent pace, omitted without recent activity
+	ProjectedCompletion *time.Time          `json:"projected_completion,omitempty"`
+	StartsOn            *openapi_types.Date `json:"starts_on,omitempty"`
+	Tag                 *string             `json:"tag,omitempty"`
+	Target              float32             `json:"target"`
+	Title               string              `json:"title"`
+	UnitKey             *string             `json:"unit_key,omitempty"`
+	UpdatedAt           time.Time           `json:"updated_at"`
+	UserId              openapi_types.UUID  `json:"user_id"`
+}
+
+// GoalMetric defines model for Goal.Metric.
+type GoalMetric string
+
+// GoalPeriod defines model for Goal.Period.
+type GoalPeriod string
+
+// GoalInput defines model for GoalInput.
+type GoalInput struct {
+	ActivityId *int32 `json:"activity_id,omitempty"`
+
+	// EndsOn last day of a custom period, inclusive
+	EndsOn       *openapi_types.Date `json:"ends_on,omitempty"`
+	LanguageCode *string             `json:"language_code,omitempty"`
+	Metric       GoalInputMetric     `json:"metric"`
+	Period       GoalInputPeriod     `json:"period"`
+
+	// StartsOn first day of a custom period
+	StartsOn *openapi_types.Date `json:"starts_on,omitempty"`
+	Tag      *string             `json:"tag,omitempty"`
+
+	// Target target amount, duration in seconds or score
+	Target float32 `json:"target"`
+	Title  string  `json:"title"`
+
+	// UnitKey required for amount goals
+	UnitKey *string `json:"unit_key,omitempty"`
+}
+
+// GoalInputMetric defines model for GoalInput.Metric.
+type GoalInputMetric string
+
+// GoalInputPeriod defines model for GoalInput.Period.
+type GoalInputPeriod string
+
+// Goals defines model for Goals.
+type Goals struct {
+	Goals []Goal `json:"goals"`
+}
+
 // Language defines model for Language.
 type Language struct {
 	// Code In ISO-639-3 https://en.wikipedia.org/wiki/Wikipedia:WikiProject_Languages/List_of_ISO_639-3_language_codes_(2019)
@@ -233,6 +711,12 @@ type Language struct {
 	Name string `json:"name"`
 }
 
+// LanguageStreak defines model for LanguageStreak.
+type LanguageStreak struct {
+	Language Language `json:"language"`
+	Streak   Streak   `json:"streak"`
+}
+
 // Languages defines model for Languages.
 type Languages struct {
 	Languages []Language `json:"languages"`
@@ -256,6 +740,16 @@ type LeaderboardEntry struct {
 	UserId          openapi_types.UUID `json:"user_id"`
 }
 
+// LeaderboardStreamEvent defines model for LeaderboardStreamEvent.
+type LeaderboardStreamEvent struct {
+	Rank  *int     `json:"rank,omitempty"`
+	Score *float32 `json:"score,omitempty"`
+
+	// Type score when a user's score changed, rebuild when the leaderboard should be refetched
+	Type   string              `json:"type"`
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
 // Log defines model for Log.
 type Log struct {
 	Activity        Activity                        `json:"activity"`
@@ -266,6 +760,7 @@ type Log struct {
 	DurationSeconds *int32                          `json:"duration_seconds,omitempty"`
 	Id              openapi_types.UUID              `json:"id"`
 	Language        Language                        `json:"language"`
+	MediaId         *openapi_types.UUID             `json:"media_id,omitempty"`
 	Modifier        float32                         `json:"modifier"`
 	Registrations   *[]ContestRegistrationReference `json:"registrations,omitempty"`
 	Score           float32                         `json:"score"`
@@ -286,6 +781,31 @@ type LogConfigurationOptions struct {
 	UserLanguageCodes    *[]string  `json:"user_language_codes,omitempty"`
 }
 
+// LogImportReport defines model for LogImportReport.
+type LogImportReport struct {
+	AcceptedCount int            `json:"accepted_count"`
+	DryRun        bool           `json:"dry_run"`
+	RejectedCount int            `json:"rejected_count"`
+	Rows          []LogImportRow `json:"rows"`
+}
+
+// LogImportRow defines model for LogImportRow.
+type LogImportRow struct {
+	Accepted bool                   `json:"accepted"`
+	Contests []ContestScoreEstimate `json:"contests"`
+	LogId    *openapi_types.UUID    `json:"log_id,omitempty"`
+	Platform *ScoreEstimate         `json:"platform,omitempty"`
+	Reason   *string                `json:"reason,omitempty"`
+	Row      int                    `json:"row"`
+}
+
+// LogScoreExplanation defines model for LogScoreExplanation.
+type LogScoreExplanation struct {
+	Contests []ContestScoreExplanation `json:"contests"`
+	LogId    openapi_types.UUID        `json:"log_id"`
+	Platform ScoreExplanation          `json:"platform"`
+}
+
 // Logs defines model for Logs.
 type Logs struct {
 	Logs []Log `json:"logs"`
@@ -295,6 +815,77 @@ type Logs struct {
 	TotalSize     int    `json:"total_size"`
 }
 
+// Media defines model for Media.
+type Media struct {
+	CreatedAt       time.Time           `json:"created_at"`
+	CreatedByUserId *openapi_types.UUID `json:"created_by_user_id,omitempty"`
+	ExternalIds     map[string]string   `json:"external_ids"`
+	Id              openapi_types.UUID  `json:"id"`
+	LanguageCode    *string             `json:"language_code,omitempty"`
+	LengthUnit      *MediaLengthUnit    `json:"length_unit,omitempty"`
+	MediaType       MediaType           `json:"media_type"`
+	Title           string              `json:"title"`
+	TotalLength     *float32            `json:"total_length,omitempty"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// MediaInput defines model for MediaInput.
+type MediaInput struct {
+	ExternalIds  *map[string]string `json:"external_ids,omitempty"`
+	LanguageCode *string            `json:"language_code,omitempty"`
+	LengthUnit   *MediaLengthUnit   `json:"length_unit,omitempty"`
+	MediaType    MediaType          `json:"media_type"`
+	Title        string             `json:"title"`
+
+	// TotalLength length in length_unit, required together with length_unit
+	TotalLength *float32 `json:"total_length,omitempty"`
+}
+
+// MediaLengthUnit defines model for MediaLengthUnit.
+type MediaLengthUnit string
+
+// MediaLinkLogsResult defines model for MediaLinkLogsResult.
+type MediaLinkLogsResult struct {
+	// LinkedLogs number of logs linked to the media
+	LinkedLogs int64 `json:"linked_logs"`
+}
+
+// MediaList defines model for MediaList.
+type MediaList struct {
+	Media []Media `json:"media"`
+}
+
+// MediaProgress defines model for MediaProgress.
+type MediaProgress struct {
+	LastLoggedAt *time.Time `json:"last_logged_at,omitempty"`
+	LogCount     int        `json:"log_count"`
+	Media        Media      `json:"media"`
+
+	// Progress logged amount in the length unit of the media
+	Progress float32 `json:"progress"`
+
+	// Status one of not_started, in_progress or completed
+	Status string `json:"status"`
+}
+
+// MediaProgressList defines model for MediaProgressList.
+type MediaProgressList struct {
+	Media []MediaProgress `json:"media"`
+}
+
+// MediaStatistic defines model for MediaStatistic.
+type MediaStatistic struct {
+	LogCount   int                `json:"log_count"`
+	MediaId    openapi_types.UUID `json:"media_id"`
+	MediaType  MediaType          `json:"media_type"`
+	Title      string             `json:"title"`
+	TotalScore float32            `json:"total_score"`
+	UserCount  int                `json:"user_count"`
+}
+
+// MediaType defines model for MediaType.
+type MediaType string
+
 // PaginatedList defines model for PaginatedList.
 type PaginatedList struct {
 	// NextPageToken is empty if there's no next page
@@ -326,6 +917,32 @@ type ScoreEstimate struct {
 // ScoreEstimateSource defines model for ScoreEstimate.Source.
 type ScoreEstimateSource string
 
+// ScoreExplanation defines model for ScoreExplanation.
+type ScoreExplanation struct {
+	BaseValue        float32                `json:"base_value"`
+	Changed          bool                   `json:"changed"`
+	CurrentRuleSetId *openapi_types.UUID    `json:"current_rule_set_id,omitempty"`
+	CurrentScore     float32                `json:"current_score"`
+	Fallback         bool                   `json:"fallback"`
+	Legacy           bool                   `json:"legacy"`
+	RuleSetId        *openapi_types.UUID    `json:"rule_set_id,omitempty"`
+	RuleSetVersion   *int32                 `json:"rule_set_version,omitempty"`
+	Rules            []ScoreExplanationRule `json:"rules"`
+	Score            float32                `json:"score"`
+	Source           ScoreExplanationSource `json:"source"`
+}
+
+// ScoreExplanationSource defines model for ScoreExplanation.Source.
+type ScoreExplanationSource string
+
+// ScoreExplanationRule defines model for ScoreExplanationRule.
+type ScoreExplanationRule struct {
+	Description *string            `json:"description,omitempty"`
+	Rate        float32            `json:"rate"`
+	Rule        *ScoringRule       `json:"rule,omitempty"`
+	RuleId      openapi_types.UUID `json:"rule_id"`
+}
+
 // ScorePreview defines model for ScorePreview.
 type ScorePreview struct {
 	Contests []ContestScoreEstimate `json:"contests"`
@@ -335,25 +952,149 @@ type ScorePreview struct {
 // Scores defines model for Scores.
 type Scores = []Score
 
+// ScoringImpactGroup defines model for ScoringImpactGroup.
+type ScoringImpactGroup struct {
+	ChangedLogs int32 `json:"changed_logs"`
+
+	// Key activity id, unit key (empty for duration only logs) or language code
+	Key         string  `json:"key"`
+	Logs        int32   `json:"logs"`
+	ScoreAfter  float32 `json:"score_after"`
+	ScoreBefore float32 `json:"score_before"`
+}
+
+// ScoringImpactSimulation defines model for ScoringImpactSimulation.
+type ScoringImpactSimulation struct {
+	ByActivity  []ScoringImpactGroup `json:"by_activity"`
+	ByLanguage  []ScoringImpactGroup `json:"by_language"`
+	ByUnitKey   []ScoringImpactGroup `json:"by_unit_key"`
+	ChangedLogs int32                `json:"changed_logs"`
+	SampledLogs int32                `json:"sampled_logs"`
+	ScoreAfter  float32              `json:"score_after"`
+	ScoreBefore float32              `json:"score_before"`
+
+	// SkippedLogs logs without tracking data that cannot be scored
+	SkippedLogs int32               `json:"skipped_logs"`
+	TopUsers    []ScoringImpactUser `json:"top_users"`
+
+	// UnmatchedLogs logs matching no rule of the new rule set
+	UnmatchedLogs int32 `json:"unmatched_logs"`
+}
+
+// ScoringImpactUser defines model for ScoringImpactUser.
+type ScoringImpactUser struct {
+	ChangedLogs     int32              `json:"changed_logs"`
+	Logs            int32              `json:"logs"`
+	ScoreAfter      float32            `json:"score_after"`
+	ScoreBefore     float32            `json:"score_before"`
+	UserDisplayName string             `json:"user_display_name"`
+	UserId          openapi_types.UUID `json:"user_id"`
+}
+
+// ScoringRescoreJob defines model for ScoringRescoreJob.
+type ScoringRescoreJob struct {
+	ChangedLogs   int32               `json:"changed_logs"`
+	CompletedAt   *time.Time          `json:"completed_at,omitempty"`
+	ContestId     *openapi_types.UUID `json:"contest_id,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	DryRun        bool                `json:"dry_run"`
+	Id            openapi_types.UUID  `json:"id"`
+	LastError     *string             `json:"last_error,omitempty"`
+	ProcessedLogs int32               `json:"processed_logs"`
+
+	// Progress share of logs processed, between 0 and 1
+	Progress  float32                `json:"progress"`
+	RuleSetId openapi_types.UUID     `json:"rule_set_id"`
+	Scope     ScoringRescoreJobScope `json:"scope"`
+
+	// SkippedLogs logs without tracking data that cannot be scored
+	SkippedLogs int32                   `json:"skipped_logs"`
+	Status      ScoringRescoreJobStatus `json:"status"`
+	TotalLogs   int32                   `json:"total_logs"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// ScoringRescoreJobScope defines model for ScoringRescoreJob.Scope.
+type ScoringRescoreJobScope string
+
+// ScoringRescoreJobStatus defines model for ScoringRescoreJob.Status.
+type ScoringRescoreJobStatus string
+
+// ScoringRescoreJobCreate defines model for ScoringRescoreJobCreate.
+type ScoringRescoreJobCreate struct {
+	// ContestId rescores the logs of this contest instead of the platform
+	ContestId *openapi_types.UUID `json:"contest_id,omitempty"`
+	DryRun    bool                `json:"dry_run"`
+
+	// RuleSetId defaults to the active rule set, dry runs can preview any published rule set
+	RuleSetId *openapi_types.UUID `json:"rule_set_id,omitempty"`
+}
+
+// ScoringRescoreJobDiff defines model for ScoringRescoreJobDiff.
+type ScoringRescoreJobDiff struct {
+	// NextPageToken is empty if there's no next page
+	NextPageToken string                   `json:"next_page_token"`
+	TotalSize     int                      `json:"total_size"`
+	Users         []ScoringRescoreUserDiff `json:"users"`
+}
+
+// ScoringRescoreJobs defines model for ScoringRescoreJobs.
+type ScoringRescoreJobs struct {
+	Jobs []ScoringRescoreJob `json:"jobs"`
+}
+
+// ScoringRescoreUserDiff defines model for ScoringRescoreUserDiff.
+type ScoringRescoreUserDiff struct {
+	ChangedLogs int32   `json:"changed_logs"`
+	ScoreAfter  float32 `json:"score_after"`
+
+	// ScoreBefore sum of the changed logs' scores before rescoring
+	ScoreBefore     float32            `json:"score_before"`
+	UserDisplayName string             `json:"user_display_name"`
+	UserId          openapi_types.UUID `json:"user_id"`
+}
+
 // ScoringRule defines model for ScoringRule.
 type ScoringRule struct {
-	ActivityId   int32                  `json:"activity_id"`
-	Id           *openapi_types.UUID    `json:"id,omitempty"`
-	LanguageCode *string                `json:"language_code,omitempty"`
-	Priority     int32                  `json:"priority"`
-	Rate         float32                `json:"rate"`
-	ScoreSource  ScoringRuleScoreSource `json:"score_source"`
-	Stackable    bool                   `json:"stackable"`
-	Tag          *string                `json:"tag,omitempty"`
-	UnitKey      *string                `json:"unit_key,omitempty"`
-}
+	ActivityId     int32                  `json:"activity_id"`
+	CapPeriod      *ScoringRuleCapPeriod  `json:"cap_period,omitempty"`
+	CapScore       *float32               `json:"cap_score,omitempty"`
+	CapValue       *float32               `json:"cap_value,omitempty"`
+	EffectiveFrom  *time.Time             `json:"effective_from,omitempty"`
+	EffectiveUntil *time.Time             `json:"effective_until,omitempty"`
+	Id             *openapi_types.UUID    `json:"id,omitempty"`
+	LanguageCode   *string                `json:"language_code,omitempty"`
+	MaxValue       *float32               `json:"max_value,omitempty"`
+	MinValue       *float32               `json:"min_value,omitempty"`
+	Priority       int32                  `json:"priority"`
+	Rate           float32                `json:"rate"`
+	ScoreSource    ScoringRuleScoreSource `json:"score_source"`
+	Stackable      bool                   `json:"stackable"`
+	Tag            *string                `json:"tag,omitempty"`
+	TagMatch       *ScoringRuleTagMatch   `json:"tag_match,omitempty"`
+	Tags           *[]string              `json:"tags,omitempty"`
+	UnitKey        *string                `json:"unit_key,omitempty"`
+}
+
+// ScoringRuleCapPeriod defines model for ScoringRule.CapPeriod.
+type ScoringRuleCapPeriod string
 
 // ScoringRuleScoreSource defines model for ScoringRule.ScoreSource.
 type ScoringRuleScoreSource string
 
+// ScoringRuleTagMatch defines model for ScoringRule.TagMatch.
+type ScoringRuleTagMatch string
+
+// ScoringRuleChange defines model for ScoringRuleChange.
+type ScoringRuleChange struct {
+	After  ScoringRule `json:"after"`
+	Before ScoringRule `json:"before"`
+}
+
 // ScoringRuleSet defines model for ScoringRuleSet.
 type ScoringRuleSet struct {
 	Active            bool                 `json:"active"`
+	ArchivedAt        *time.Time           `json:"archived_at,omitempty"`
 	ContestId         *openapi_types.UUID  `json:"contest_id,omitempty"`
 	CreatedAt         time.Time            `json:"created_at"`
 	FallbackRuleSetId *openapi_types.UUID  `json:"fallback_rule_set_id,omitempty"`
@@ -375,6 +1116,16 @@ type ScoringRuleSetScope string
 // ScoringRuleSetStatus defines model for ScoringRuleSet.Status.
 type ScoringRuleSetStatus string
 
+// ScoringRuleSetDiff rules are matched by their conditions, matched rules changed when their rate or priority did
+type ScoringRuleSetDiff struct {
+	Added           []ScoringRule       `json:"added"`
+	Changed         []ScoringRuleChange `json:"changed"`
+	FallbackChanged bool                `json:"fallback_changed"`
+	ModeChanged     bool                `json:"mode_changed"`
+	Removed         []ScoringRule       `json:"removed"`
+	UnchangedCount  int32               `json:"unchanged_count"`
+}
+
 // ScoringRuleSetDraft defines model for ScoringRuleSetDraft.
 type ScoringRuleSetDraft struct {
 	FallbackRuleSetId *openapi_types.UUID      `json:"fallback_rule_set_id,omitempty"`
@@ -385,11 +1136,37 @@ type ScoringRuleSetDraft struct {
 // ScoringRuleSetDraftMode defines model for ScoringRuleSetDraft.Mode.
 type ScoringRuleSetDraftMode string
 
+// ScoringRuleSetImpact defines model for ScoringRuleSetImpact.
+type ScoringRuleSetImpact struct {
+	BaseRuleSet ScoringRuleSet `json:"base_rule_set"`
+
+	// Diff rules are matched by their conditions, matched rules changed when their rate or priority did
+	Diff       ScoringRuleSetDiff      `json:"diff"`
+	RuleSet    ScoringRuleSet          `json:"rule_set"`
+	Simulation ScoringImpactSimulation `json:"simulation"`
+}
+
 // ScoringRuleSets defines model for ScoringRuleSets.
 type ScoringRuleSets struct {
 	RuleSets []ScoringRuleSet `json:"rule_sets"`
 }
 
+// Streak defines model for Streak.
+type Streak struct {
+	Current          int                 `json:"current"`
+	CurrentStartedOn *openapi_types.Date `json:"current_started_on,omitempty"`
+	LastActiveOn     *openapi_types.Date `json:"last_active_on,omitempty"`
+	Longest          int                 `json:"longest"`
+}
+
+// TagStatistic defines model for TagStatistic.
+type TagStatistic struct {
+	LogCount   int     `json:"log_count"`
+	Tag        string  `json:"tag"`
+	TotalScore float32 `json:"total_score"`
+	UserCount  int     `json:"user_count"`
+}
+
 // TagSuggestion defines model for TagSuggestion.
 type TagSuggestion struct {
 	Count int    `json:"count"`
@@ -401,14 +1178,62 @@ type TagSuggestions struct {
 	Suggestions []TagSuggestion `json:"suggestions"`
 }
 
+// TeamLeaderboard defines model for TeamLeaderboard.
+type TeamLeaderboard struct {
+	Entries []TeamLeaderboardEntry `json:"entries"`
+
+	// NextPageToken is empty if there's no next page
+	NextPageToken string `json:"next_page_token"`
+	TotalSize     int    `json:"total_size"`
+}
+
+// TeamLeaderboardEntry defines model for TeamLeaderboardEntry.
+type TeamLeaderboardEntry struct {
+	IsTie       bool               `json:"is_tie"`
+	MemberCount int                `json:"member_count"`
+	Rank        int                `json:"rank"`
+	Score       float32            `json:"score"`
+	TeamId      openapi_types.UUID `json:"team_id"`
+	TeamName    string             `json:"team_name"`
+}
+
+// TeamScoreAggregation How member scores are combined into a team score
+type TeamScoreAggregation string
+
+// TrendingStatistics defines model for TrendingStatistics.
+type TrendingStatistics struct {
+	GeneratedAt  time.Time        `json:"generated_at"`
+	LanguageCode *string          `json:"language_code,omitempty"`
+	Media        []MediaStatistic `json:"media"`
+	Since        time.Time        `json:"since"`
+	Tags         []TagStatistic   `json:"tags"`
+	Window       string           `json:"window"`
+}
+
 // Unit defines model for Unit.
 type Unit struct {
-	Id            openapi_types.UUID `json:"id"`
-	LanguageCode  *string            `json:"language_code,omitempty"`
-	LogActivityId int                `json:"log_activity_id"`
-	Modifier      float32            `json:"modifier"`
-	Name          string             `json:"name"`
-	UnitKey       string             `json:"unit_key"`
+	// DeprecatedAt set for units that can no longer be logged
+	DeprecatedAt    *time.Time         `json:"deprecated_at,omitempty"`
+	Id              openapi_types.UUID `json:"id"`
+	LanguageCode    *string            `json:"language_code,omitempty"`
+	LogActivityId   int                `json:"log_activity_id"`
+	MediaLengthUnit *MediaLengthUnit   `json:"media_length_unit,omitempty"`
+	Modifier        float32            `json:"modifier"`
+	Name            string             `json:"name"`
+	UnitKey         string             `json:"unit_key"`
+}
+
+// UnitCreateResult defines model for UnitCreateResult.
+type UnitCreateResult struct {
+	// ScoringRuleSetDraftId platform draft with a base rule for the unit, to be reviewed and published
+	ScoringRuleSetDraftId *openapi_types.UUID `json:"scoring_rule_set_draft_id,omitempty"`
+	Unit                  Unit                `json:"unit"`
+}
+
+// UnitDeleteResult defines model for UnitDeleteResult.
+type UnitDeleteResult struct {
+	// Deprecated the unit was deprecated instead of deleted because logs reference it
+	Deprecated bool `json:"deprecated"`
 }
 
 // Units defines model for Units.
@@ -428,6 +1253,21 @@ type UserActivityScore struct {
 	Score float32            `json:"score"`
 }
 
+// UserErasure defines model for UserErasure.
+type UserErasure struct {
+	CompletedAt    *time.Time                  `json:"completed_at,omitempty"`
+	CompletedSteps []UserErasureCompletedSteps `json:"completed_steps"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	Id             openapi_types.UUID          `json:"id"`
+	Status         UserErasureStatus           `json:"status"`
+}
+
+// UserErasureCompletedSteps defines model for UserErasure.CompletedSteps.
+type UserErasureCompletedSteps string
+
+// UserErasureStatus defines model for UserErasure.Status.
+type UserErasureStatus string
+
 // UserProfile defines model for UserProfile.
 type UserProfile struct {
 	CreatedAt   time.Time          `json:"created_at"`
@@ -435,6 +1275,31 @@ type UserProfile struct {
 	Id          openapi_types.UUID `json:"id"`
 }
 
+// UserSettings defines model for UserSettings.
+type UserSettings struct {
+	// StreakGraceDays days without logs that do not break a streak
+	StreakGraceDays int `json:"streak_grace_days"`
+
+	// Timezone IANA time zone name
+	Timezone string `json:"timezone"`
+}
+
+// UserStreaks defines model for UserStreaks.
+type UserStreaks struct {
+	GraceDays int              `json:"grace_days"`
+	Languages []LanguageStreak `json:"languages"`
+	Overall   Streak           `json:"overall"`
+
+	// Today current date in the user's time zone
+	Today openapi_types.Date `json:"today"`
+}
+
+// ContestTemplateListParams defines parameters for ContestTemplateList.
+type ContestTemplateListParams struct {
+	// All list templates of every user, admins only
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
 // ContestListParams defines parameters for ContestList.
 type ContestListParams struct {
 	PageSize       *int                `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -444,6 +1309,22 @@ type ContestListParams struct {
 	UserId         *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
 }
 
+// ContestCancelJSONBody defines parameters for ContestCancel.
+type ContestCancelJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ContestInvitationCreateJSONBody defines parameters for ContestInvitationCreate.
+type ContestInvitationCreateJSONBody struct {
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// ContestInviteCodeCreateJSONBody defines parameters for ContestInviteCodeCreate.
+type ContestInviteCodeCreateJSONBody struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	MaxUses   *int32     `json:"max_uses,omitempty"`
+}
+
 // ContestFetchLeaderboardParams defines parameters for ContestFetchLeaderboard.
 type ContestFetchLeaderboardParams struct {
 	PageSize     *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -452,6 +1333,17 @@ type ContestFetchLeaderboardParams struct {
 	ActivityId   *int    `form:"activity_id,omitempty" json:"activity_id,omitempty"`
 }
 
+// ContestStreamLeaderboardParams defines parameters for ContestStreamLeaderboard.
+type ContestStreamLeaderboardParams struct {
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ContestFetchTeamLeaderboardParams defines parameters for ContestFetchTeamLeaderboard.
+type ContestFetchTeamLeaderboardParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+}
+
 // ContestListLogsParams defines parameters for ContestListLogs.
 type ContestListLogsParams struct {
 	IncludeDeleted *bool               `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
@@ -465,11 +1357,28 @@ type ContestModerationDetachLogJSONBody struct {
 	Reason string `json:"reason"`
 }
 
+// ContestOrganizerAddJSONBody defines parameters for ContestOrganizerAdd.
+type ContestOrganizerAddJSONBody struct {
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// ContestParticipantRemoveJSONBody defines parameters for ContestParticipantRemove.
+type ContestParticipantRemoveJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
 // ContestRegistrationUpsertJSONBody defines parameters for ContestRegistrationUpsert.
 type ContestRegistrationUpsertJSONBody struct {
+	// InviteCode Required to join a private contest without an invitation
+	InviteCode    *string  `json:"invite_code,omitempty"`
 	LanguageCodes []string `json:"language_codes"`
 }
 
+// ContestTeamCreateJSONBody defines parameters for ContestTeamCreate.
+type ContestTeamCreateJSONBody struct {
+	Name string `json:"name"`
+}
+
 // LanguageUpdateJSONBody defines parameters for LanguageUpdate.
 type LanguageUpdateJSONBody struct {
 	Name string `json:"name"`
@@ -483,6 +1392,11 @@ type FetchLeaderboardGlobalParams struct {
 	ActivityId   *int    `form:"activity_id,omitempty" json:"activity_id,omitempty"`
 }
 
+// StreamLeaderboardGlobalParams defines parameters for StreamLeaderboardGlobal.
+type StreamLeaderboardGlobalParams struct {
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // FetchLeaderboardForYearParams defines parameters for FetchLeaderboardForYear.
 type FetchLeaderboardForYearParams struct {
 	PageSize     *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
@@ -491,19 +1405,41 @@ type FetchLeaderboardForYearParams struct {
 	ActivityId   *int    `form:"activity_id,omitempty" json:"activity_id,omitempty"`
 }
 
+// StreamLeaderboardForYearParams defines parameters for StreamLeaderboardForYear.
+type StreamLeaderboardForYearParams struct {
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // LogCreateJSONBody defines parameters for LogCreate.
 type LogCreateJSONBody struct {
-	ActivityId      int32                 `json:"activity_id"`
-	Amount          *float32              `json:"amount,omitempty"`
-	Description     *string               `json:"description,omitempty"`
-	DurationSeconds *int32                `json:"duration_seconds,omitempty"`
-	LanguageCode    string                `json:"language_code"`
+	ActivityId      int32    `json:"activity_id"`
+	Amount          *float32 `json:"amount,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	DurationSeconds *int32   `json:"duration_seconds,omitempty"`
+	LanguageCode    string   `json:"language_code"`
+
+	// MediaId media from the library this log is about
+	MediaId         *openapi_types.UUID   `json:"media_id,omitempty"`
 	RegistrationIds *[]openapi_types.UUID `json:"registration_ids,omitempty"`
 	Tags            []string              `json:"tags"`
 	UnitId          *openapi_types.UUID   `json:"unit_id,omitempty"`
 	UnitKey         *string               `json:"unit_key,omitempty"`
 }
 
+// LogImportJSONBody defines parameters for LogImport.
+type LogImportJSONBody struct {
+	// Content CSV with a header row, or one JSON object per line. Columns/keys are
+	// date, language_code, activity_id or activity, unit_key, amount,
+	// duration_seconds, tags, description and registration_ids. In CSV,
+	// tags and registration_ids are separated by semicolons.
+	Content string                  `json:"content"`
+	DryRun  *bool                   `json:"dry_run,omitempty"`
+	Format  LogImportJSONBodyFormat `json:"format"`
+}
+
+// LogImportJSONBodyFormat defines parameters for LogImport.
+type LogImportJSONBodyFormat string
+
 // ScorePreviewJSONBody defines parameters for ScorePreview.
 type ScorePreviewJSONBody struct {
 	ActivityId      int32                 `json:"activity_id"`
@@ -523,12 +1459,15 @@ type LogTagSuggestionsParams struct {
 
 // LogUpdateJSONBody defines parameters for LogUpdate.
 type LogUpdateJSONBody struct {
-	Amount          *float32            `json:"amount,omitempty"`
-	Description     *string             `json:"description,omitempty"`
-	DurationSeconds *int32              `json:"duration_seconds,omitempty"`
-	Tags            []string            `json:"tags"`
-	UnitId          *openapi_types.UUID `json:"unit_id,omitempty"`
-	UnitKey         *string             `json:"unit_key,omitempty"`
+	Amount          *float32 `json:"amount,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	DurationSeconds *int32   `json:"duration_seconds,omitempty"`
+
+	// MediaId media from the library this log is about
+	MediaId *openapi_types.UUID `json:"media_id,omitempty"`
+	Tags    []string            `json:"tags"`
+	UnitId  *openapi_types.UUID `json:"unit_id,omitempty"`
+	UnitKey *string             `json:"unit_key,omitempty"`
 }
 
 // LogContestRegistrationUpdateJSONBody defines parameters for LogContestRegistrationUpdate.
@@ -536,6 +1475,68 @@ type LogContestRegistrationUpdateJSONBody struct {
 	RegistrationIds []openapi_types.UUID `json:"registration_ids"`
 }
 
+// MediaSearchParams defines parameters for MediaSearch.
+type MediaSearchParams struct {
+	Query        string  `form:"query" json:"query"`
+	LanguageCode *string `form:"language_code,omitempty" json:"language_code,omitempty"`
+	Limit        *int32  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// MediaLinkLogsJSONBody defines parameters for MediaLinkLogs.
+type MediaLinkLogsJSONBody struct {
+	// Descriptions log descriptions to match, ignoring case and surrounding whitespace
+	Descriptions []string `json:"descriptions"`
+}
+
+// ScoringRescoreJobListParams defines parameters for ScoringRescoreJobList.
+type ScoringRescoreJobListParams struct {
+	ContestId *openapi_types.UUID `form:"contest_id,omitempty" json:"contest_id,omitempty"`
+}
+
+// ScoringRescoreJobDiffParams defines parameters for ScoringRescoreJobDiff.
+type ScoringRescoreJobDiffParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+}
+
+// ScoringRuleSetImpactParams defines parameters for ScoringRuleSetImpact.
+type ScoringRuleSetImpactParams struct {
+	// BaseRuleSetId defaults to the rule set currently scoring the logs
+	BaseRuleSetId *openapi_types.UUID `form:"base_rule_set_id,omitempty" json:"base_rule_set_id,omitempty"`
+
+	// SampleSize number of recent logs to simulate, at most 5000
+	SampleSize *int `form:"sample_size,omitempty" json:"sample_size,omitempty"`
+}
+
+// StatisticsTrendingParams defines parameters for StatisticsTrending.
+type StatisticsTrendingParams struct {
+	LanguageCode *string `form:"language_code,omitempty" json:"language_code,omitempty"`
+
+	// Window one of day, week or month, defaults to week
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// UnitCreateJSONBody defines parameters for UnitCreate.
+type UnitCreateJSONBody struct {
+	// LanguageCode limits the unit to one language
+	LanguageCode    *string          `json:"language_code,omitempty"`
+	LogActivityId   int              `json:"log_activity_id"`
+	MediaLengthUnit *MediaLengthUnit `json:"media_length_unit,omitempty"`
+	Modifier        float32          `json:"modifier"`
+	Name            string           `json:"name"`
+
+	// UnitKey stable key prefixed with the activity name
+	UnitKey string `json:"unit_key"`
+}
+
+// UnitUpdateJSONBody defines parameters for UnitUpdate.
+type UnitUpdateJSONBody struct {
+	Deprecated      bool             `json:"deprecated"`
+	MediaLengthUnit *MediaLengthUnit `json:"media_length_unit,omitempty"`
+	Modifier        float32          `json:"modifier"`
+	Name            string           `json:"name"`
+}
+
 // ProfileListLogsParams defines parameters for ProfileListLogs.
 type ProfileListLogsParams struct {
 	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
@@ -543,18 +1544,57 @@ type ProfileListLogsParams struct {
 	Page           *int  `form:"page,omitempty" json:"page,omitempty"`
 }
 
+// ActivityCreateJSONRequestBody defines body for ActivityCreate for application/json ContentType.
+type ActivityCreateJSONRequestBody = ActivityInput
+
+// ActivityUpdateJSONRequestBody defines body for ActivityUpdate for application/json ContentType.
+type ActivityUpdateJSONRequestBody = ActivityInput
+
+// ContestTemplateCreateJSONRequestBody defines body for ContestTemplateCreate for application/json ContentType.
+type ContestTemplateCreateJSONRequestBody = ContestTemplateCreate
+
+// ContestTemplateUpdateJSONRequestBody defines body for ContestTemplateUpdate for application/json ContentType.
+type ContestTemplateUpdateJSONRequestBody = ContestTemplateUpdate
+
 // ContestCreateJSONRequestBody defines body for ContestCreate for application/json ContentType.
 type ContestCreateJSONRequestBody = Contest
 
+// ContestCancelJSONRequestBody defines body for ContestCancel for application/json ContentType.
+type ContestCancelJSONRequestBody ContestCancelJSONBody
+
+// ContestUpdateJSONRequestBody defines body for ContestUpdate for application/json ContentType.
+type ContestUpdateJSONRequestBody = ContestUpdate
+
+// ContestInvitationCreateJSONRequestBody defines body for ContestInvitationCreate for application/json ContentType.
+type ContestInvitationCreateJSONRequestBody ContestInvitationCreateJSONBody
+
+// ContestInviteCodeCreateJSONRequestBody defines body for ContestInviteCodeCreate for application/json ContentType.
+type ContestInviteCodeCreateJSONRequestBody ContestInviteCodeCreateJSONBody
+
 // ContestModerationDetachLogJSONRequestBody defines body for ContestModerationDetachLog for application/json ContentType.
 type ContestModerationDetachLogJSONRequestBody ContestModerationDetachLogJSONBody
 
+// ContestOrganizerAddJSONRequestBody defines body for ContestOrganizerAdd for application/json ContentType.
+type ContestOrganizerAddJSONRequestBody ContestOrganizerAddJSONBody
+
+// ContestParticipantRemoveJSONRequestBody defines body for ContestParticipantRemove for application/json ContentType.
+type ContestParticipantRemoveJSONRequestBody ContestParticipantRemoveJSONBody
+
 // ContestRegistrationUpsertJSONRequestBody defines body for ContestRegistrationUpsert for application/json ContentType.
 type ContestRegistrationUpsertJSONRequestBody ContestRegistrationUpsertJSONBody
 
 // ScoringRuleSetCreateContestJSONRequestBody defines body for ScoringRuleSetCreateContest for application/json ContentType.
 type ScoringRuleSetCreateContestJSONRequestBody = ScoringRuleSetDraft
 
+// ContestTeamCreateJSONRequestBody defines body for ContestTeamCreate for application/json ContentType.
+type ContestTeamCreateJSONRequestBody ContestTeamCreateJSONBody
+
+// GoalCreateJSONRequestBody defines body for GoalCreate for application/json ContentType.
+type GoalCreateJSONRequestBody = GoalInput
+
+// GoalUpdateJSONRequestBody defines body for GoalUpdate for application/json ContentType.
+type GoalUpdateJSONRequestBody = GoalInput
+
 // LanguageCreateJSONRequestBody defines body for LanguageCreate for application/json ContentType.
 type LanguageCreateJSONRequestBody = Language
 
@@ -564,6 +1604,9 @@ type LanguageUpdateJSONRequestBody LanguageUpdateJSONBody
 // LogCreateJSONRequestBody defines body for LogCreate for application/json ContentType.
 type LogCreateJSONRequestBody LogCreateJSONBody
 
+// LogImportJSONRequestBody defines body for LogImport for application/json ContentType.
+type LogImportJSONRequestBody LogImportJSONBody
+
 // ScorePreviewJSONRequestBody defines body for ScorePreview for application/json ContentType.
 type ScorePreviewJSONRequestBody ScorePreviewJSONBody
 
@@ -573,11 +1616,56 @@ type LogUpdateJSONRequestBody LogUpdateJSONBody
 // LogContestRegistrationUpdateJSONRequestBody defines body for LogContestRegistrationUpdate for application/json ContentType.
 type LogContestRegistrationUpdateJSONRequestBody LogContestRegistrationUpdateJSONBody
 
+// MediaCreateJSONRequestBody defines body for MediaCreate for application/json ContentType.
+type MediaCreateJSONRequestBody = MediaInput
+
+// MediaUpdateJSONRequestBody defines body for MediaUpdate for application/json ContentType.
+type MediaUpdateJSONRequestBody = MediaInput
+
+// MediaLinkLogsJSONRequestBody defines body for MediaLinkLogs for application/json ContentType.
+type MediaLinkLogsJSONRequestBody MediaLinkLogsJSONBody
+
+// ScoringRescoreJobCreateJSONRequestBody defines body for ScoringRescoreJobCreate for application/json ContentType.
+type ScoringRescoreJobCreateJSONRequestBody = ScoringRescoreJobCreate
+
 // ScoringRuleSetCreatePlatformJSONRequestBody defines body for ScoringRuleSetCreatePlatform for application/json ContentType.
 type ScoringRuleSetCreatePlatformJSONRequestBody = ScoringRuleSetDraft
 
+// ScoringRuleSetUpdateDraftJSONRequestBody defines body for ScoringRuleSetUpdateDraft for application/json ContentType.
+type ScoringRuleSetUpdateDraftJSONRequestBody = ScoringRuleSetDraft
+
+// UnitCreateJSONRequestBody defines body for UnitCreate for application/json ContentType.
+type UnitCreateJSONRequestBody UnitCreateJSONBody
+
+// UnitUpdateJSONRequestBody defines body for UnitUpdate for application/json ContentType.
+type UnitUpdateJSONRequestBody UnitUpdateJSONBody
+
+// UserSettingsUpdateJSONRequestBody defines body for UserSettingsUpdate for application/json ContentType.
+type UserSettingsUpdateJSONRequestBody = UserSettings
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Lists all activities (admin only)
+	// (GET /activities)
+	ActivityList(ctx echo.Context) error
+	// Creates a new activity (admin only)
+	// (POST /activities)
+	ActivityCreate(ctx echo.Context) error
+	// Updates an existing activity (admin only)
+	// (PUT /activities/{id})
+	ActivityUpdate(ctx echo.Context, id int32) error
+	// Lists the contest templates of the current user, or all of them for admins
+	// (GET /contest-templates)
+	ContestTemplateList(ctx echo.Context, params ContestTemplateListParams) error
+	// Creates a template for a recurring contest series
+	// (POST /contest-templates)
+	ContestTemplateCreate(ctx echo.Context) error
+	// Fetches a contest template with the contests created from it
+	// (GET /contest-templates/{id})
+	ContestTemplateFindByID(ctx echo.Context, id openapi_types.UUID) error
+	// Updates the settings of future contests of a series, or pauses it
+	// (PUT /contest-templates/{id})
+	ContestTemplateUpdate(ctx echo.Context, id openapi_types.UUID) error
 	// Lists all the contests, paginated
 	// (GET /contests)
 	ContestList(ctx echo.Context, params ContestListParams) error
@@ -596,18 +1684,60 @@ type ServerInterface interface {
 	// Fetches all the ongoing contest registrations of the logged in user, always in a single page
 	// (GET /contests/ongoing-registrations)
 	ContestFindOngoingRegistrations(ctx echo.Context) error
+	// Cancels a contest
+	// (DELETE /contests/{id})
+	ContestCancel(ctx echo.Context, id openapi_types.UUID) error
 	// Fetches a contest by id
 	// (GET /contests/{id})
 	ContestFindByID(ctx echo.Context, id openapi_types.UUID) error
+	// Updates a contest
+	// (PUT /contests/{id})
+	ContestUpdate(ctx echo.Context, id openapi_types.UUID) error
+	// Lists the users invited to a private contest
+	// (GET /contests/{id}/invitations)
+	ContestInvitationList(ctx echo.Context, id openapi_types.UUID) error
+	// Invites a user to a private contest
+	// (POST /contests/{id}/invitations)
+	ContestInvitationCreate(ctx echo.Context, id openapi_types.UUID) error
+	// Lists the invite codes of a private contest
+	// (GET /contests/{id}/invite-codes)
+	ContestInviteCodeList(ctx echo.Context, id openapi_types.UUID) error
+	// Creates an invite code for a private contest
+	// (POST /contests/{id}/invite-codes)
+	ContestInviteCodeCreate(ctx echo.Context, id openapi_types.UUID) error
+	// Revokes an invite code
+	// (DELETE /contests/{id}/invite-codes/{code_id})
+	ContestInviteCodeRevoke(ctx echo.Context, id openapi_types.UUID, codeId openapi_types.UUID) error
 	// Fetches the leaderboard for a contest
 	// (GET /contests/{id}/leaderboard)
 	ContestFetchLeaderboard(ctx echo.Context, id openapi_types.UUID, params ContestFetchLeaderboardParams) error
+	// Streams rank changes of a contest leaderboard
+	// (GET /contests/{id}/leaderboard/stream)
+	ContestStreamLeaderboard(ctx echo.Context, id openapi_types.UUID, params ContestStreamLeaderboardParams) error
+	// Fetches the team leaderboard for a team contest
+	// (GET /contests/{id}/leaderboard/teams)
+	ContestFetchTeamLeaderboard(ctx echo.Context, id openapi_types.UUID, params ContestFetchTeamLeaderboardParams) error
 	// Lists the logs attached to a contest
 	// (GET /contests/{id}/logs)
 	ContestListLogs(ctx echo.Context, id openapi_types.UUID, params ContestListLogsParams) error
 	// Detaches a log from a contest (moderation action)
 	// (POST /contests/{id}/moderation/detach/{log_id})
 	ContestModerationDetachLog(ctx echo.Context, id openapi_types.UUID, logId openapi_types.UUID) error
+	// Lists the co-organizers of a contest
+	// (GET /contests/{id}/organizers)
+	ContestOrganizerList(ctx echo.Context, id openapi_types.UUID) error
+	// Adds a co-organizer to a contest
+	// (POST /contests/{id}/organizers)
+	ContestOrganizerAdd(ctx echo.Context, id openapi_types.UUID) error
+	// Removes a co-organizer from a contest
+	// (DELETE /contests/{id}/organizers/{user_id})
+	ContestOrganizerRemove(ctx echo.Context, id openapi_types.UUID, userId openapi_types.UUID) error
+	// Removes a participant from a private contest
+	// (DELETE /contests/{id}/participants/{user_id})
+	ContestParticipantRemove(ctx echo.Context, id openapi_types.UUID, userId openapi_types.UUID) error
+	// Fetches the capabilities of the current user on a contest
+	// (GET /contests/{id}/permissions)
+	ContestPermissionFetch(ctx echo.Context, id openapi_types.UUID) error
 	// Fetches the activity of a user profile in a contest
 	// (GET /contests/{id}/profile/{user_id}/activity)
 	ContestProfileFetchActivity(ctx echo.Context, id openapi_types.UUID, userId openapi_types.UUID) error
@@ -629,6 +1759,30 @@ type ServerInterface interface {
 	// Fetches the summary for a contest
 	// (GET /contests/{id}/summary)
 	ContestFetchSummary(ctx echo.Context, id openapi_types.UUID) error
+	// Lists the teams of a contest with their members
+	// (GET /contests/{id}/teams)
+	ContestTeamList(ctx echo.Context, id openapi_types.UUID) error
+	// Creates a team and joins it
+	// (POST /contests/{id}/teams)
+	ContestTeamCreate(ctx echo.Context, id openapi_types.UUID) error
+	// Leaves the current team
+	// (DELETE /contests/{id}/teams/membership)
+	ContestTeamLeave(ctx echo.Context, id openapi_types.UUID) error
+	// Joins a team, leaving the current team if any
+	// (POST /contests/{id}/teams/{team_id}/membership)
+	ContestTeamJoin(ctx echo.Context, id openapi_types.UUID, teamId openapi_types.UUID) error
+	// Creates a new goal for the current user
+	// (POST /goals)
+	GoalCreate(ctx echo.Context) error
+	// Deletes a goal by id
+	// (DELETE /goals/{id})
+	GoalDeleteByID(ctx echo.Context, id openapi_types.UUID) error
+	// Fetches a goal with its current progress
+	// (GET /goals/{id})
+	GoalFindByID(ctx echo.Context, id openapi_types.UUID) error
+	// Updates an existing goal
+	// (PUT /goals/{id})
+	GoalUpdate(ctx echo.Context, id openapi_types.UUID) error
 	// Lists all languages (admin only)
 	// (GET /languages)
 	LanguageList(ctx echo.Context) error
@@ -641,15 +1795,24 @@ type ServerInterface interface {
 	// Fetches the global leaderboard
 	// (GET /leaderboard/global)
 	FetchLeaderboardGlobal(ctx echo.Context, params FetchLeaderboardGlobalParams) error
+	// Streams rank changes of the global leaderboard
+	// (GET /leaderboard/global/stream)
+	StreamLeaderboardGlobal(ctx echo.Context, params StreamLeaderboardGlobalParams) error
 	// Fetches the leaderboard for a given year
 	// (GET /leaderboard/yearly/{year})
 	FetchLeaderboardForYear(ctx echo.Context, year int, params FetchLeaderboardForYearParams) error
+	// Streams rank changes of the leaderboard for a given year
+	// (GET /leaderboard/yearly/{year}/stream)
+	StreamLeaderboardForYear(ctx echo.Context, year int, params StreamLeaderboardForYearParams) error
 	// Submits a new log
 	// (POST /logs)
 	LogCreate(ctx echo.Context) error
 	// Fetches the configuration options for a log
 	// (GET /logs/configuration-options)
 	LogGetConfigurations(ctx echo.Context) error
+	// Imports logs in bulk from a CSV or JSON lines export
+	// (POST /logs/import)
+	LogImport(ctx echo.Context) error
 	// Previews platform and contest scores without creating a log
 	// (POST /logs/score-preview)
 	ScorePreview(ctx echo.Context) error
@@ -668,21 +1831,84 @@ type ServerInterface interface {
 	// Updates the contest registrations for a log
 	// (PUT /logs/{id}/contest-registrations)
 	LogContestRegistrationUpdate(ctx echo.Context, id openapi_types.UUID) error
+	// Explains how the platform and contest scores of a log were computed
+	// (GET /logs/{id}/score-explanation)
+	LogScoreExplanation(ctx echo.Context, id openapi_types.UUID) error
+	// Searches the media library by title or ISBN
+	// (GET /media)
+	MediaSearch(ctx echo.Context, params MediaSearchParams) error
+	// Adds a media to the library
+	// (POST /media)
+	MediaCreate(ctx echo.Context) error
+	// Lists the progress of the current user on the media they logged
+	// (GET /media/progress)
+	MediaProgressList(ctx echo.Context) error
+	// Fetches a media with the progress of the current user
+	// (GET /media/{id})
+	MediaFindByID(ctx echo.Context, id openapi_types.UUID) error
+	// Updates a media, only its creator and admins can update it
+	// (PUT /media/{id})
+	MediaUpdate(ctx echo.Context, id openapi_types.UUID) error
+	// Links the current user's existing logs to a media by their description
+	// (POST /media/{id}/link-logs)
+	MediaLinkLogs(ctx echo.Context, id openapi_types.UUID) error
 	// Checks if service is responsive
 	// (GET /ping)
 	Ping(ctx echo.Context) error
+	// Lists recent rescoring jobs of the platform or of a contest
+	// (GET /scoring/rescore-jobs)
+	ScoringRescoreJobList(ctx echo.Context, params ScoringRescoreJobListParams) error
+	// Schedules rescoring existing logs, or a dry run reporting the per-user diff
+	// (POST /scoring/rescore-jobs)
+	ScoringRescoreJobCreate(ctx echo.Context) error
+	// Fetches the progress of a rescoring job
+	// (GET /scoring/rescore-jobs/{id})
+	ScoringRescoreJobFind(ctx echo.Context, id openapi_types.UUID) error
+	// Lists the per-user score changes of a rescoring job, largest first
+	// (GET /scoring/rescore-jobs/{id}/diff)
+	ScoringRescoreJobDiff(ctx echo.Context, id openapi_types.UUID, params ScoringRescoreJobDiffParams) error
 	// Lists platform scoring rule-set versions
 	// (GET /scoring/rule-sets)
 	ScoringRuleSetListPlatform(ctx echo.Context) error
 	// Creates a draft platform scoring rule-set version
 	// (POST /scoring/rule-sets)
 	ScoringRuleSetCreatePlatform(ctx echo.Context) error
+	// Deletes a draft scoring rule-set version
+	// (DELETE /scoring/rule-sets/{id})
+	ScoringRuleSetDeleteDraft(ctx echo.Context, id openapi_types.UUID) error
+	// Replaces the rules of a draft scoring rule-set version
+	// (PUT /scoring/rule-sets/{id})
+	ScoringRuleSetUpdateDraft(ctx echo.Context, id openapi_types.UUID) error
 	// Activates a published scoring rule-set version
 	// (POST /scoring/rule-sets/{id}/activate)
 	ScoringRuleSetActivate(ctx echo.Context, id openapi_types.UUID) error
+	// Archives an inactive published scoring rule-set version
+	// (POST /scoring/rule-sets/{id}/archive)
+	ScoringRuleSetArchive(ctx echo.Context, id openapi_types.UUID) error
+	// Creates a draft scoring rule-set version from a copy of another version
+	// (POST /scoring/rule-sets/{id}/clone)
+	ScoringRuleSetClone(ctx echo.Context, id openapi_types.UUID) error
+	// Compares a scoring rule-set to another one and simulates both against a sample of recent logs
+	// (GET /scoring/rule-sets/{id}/impact)
+	ScoringRuleSetImpact(ctx echo.Context, id openapi_types.UUID, params ScoringRuleSetImpactParams) error
 	// Publishes an immutable scoring rule-set version
 	// (POST /scoring/rule-sets/{id}/publish)
 	ScoringRuleSetPublish(ctx echo.Context, id openapi_types.UUID) error
+	// Lists the most used tags and media across all users
+	// (GET /statistics/trending)
+	StatisticsTrending(ctx echo.Context, params StatisticsTrendingParams) error
+	// Lists all units including deprecated ones (admin only)
+	// (GET /units)
+	UnitList(ctx echo.Context) error
+	// Adds a unit to the catalog and drafts a platform scoring rule for it (admin only)
+	// (POST /units)
+	UnitCreate(ctx echo.Context) error
+	// Deletes a unit, or deprecates it when logs reference it (admin only)
+	// (DELETE /units/{id})
+	UnitDelete(ctx echo.Context, id openapi_types.UUID) error
+	// Updates the name, modifier or deprecation of a unit (admin only)
+	// (PUT /units/{id})
+	UnitUpdate(ctx echo.Context, id openapi_types.UUID) error
 	// Fetches a activity split summary of a user for a given year
 	// (GET /users/{userId}/activity-split/{year})
 	ProfileYearlyActivitySplitByUserID(ctx echo.Context, userId openapi_types.UUID, year int) error
@@ -692,12 +1918,30 @@ type ServerInterface interface {
 	// Fetches the contest registrations of a user for a given year
 	// (GET /users/{userId}/contest-registrations/{year})
 	ProfileYearlyContestRegistrationsByUserID(ctx echo.Context, userId openapi_types.UUID, year int) error
+	// Schedules the erasure of all personal data of a user
+	// (POST /users/{userId}/erasure)
+	UserErasureCreate(ctx echo.Context, userId openapi_types.UUID) error
+	// Exports all logs, contest registrations and yearly scores of a user as a ZIP archive
+	// (GET /users/{userId}/export)
+	UserDataExport(ctx echo.Context, userId openapi_types.UUID) error
+	// Lists the goals of a user with their current progress
+	// (GET /users/{userId}/goals)
+	GoalListForUser(ctx echo.Context, userId openapi_types.UUID) error
 	// Fetches a profile of a user
 	// (GET /users/{userId}/profile)
 	ProfileFindByUserID(ctx echo.Context, userId openapi_types.UUID) error
 	// Fetches the scores of a user for a given year
 	// (GET /users/{userId}/scores/{year})
 	ProfileYearlyScoresByUserID(ctx echo.Context, userId openapi_types.UUID, year int) error
+	// Fetches the settings of a user
+	// (GET /users/{userId}/settings)
+	UserSettingsFind(ctx echo.Context, userId openapi_types.UUID) error
+	// Updates the settings of the current user
+	// (PUT /users/{userId}/settings)
+	UserSettingsUpdate(ctx echo.Context, userId openapi_types.UUID) error
+	// Fetches the current and longest streaks of a user
+	// (GET /users/{userId}/streaks)
+	ProfileStreaksByUserID(ctx echo.Context, userId openapi_types.UUID) error
 	// Lists the logs of a user
 	// (GET /users/{user_id}/logs)
 	ProfileListLogs(ctx echo.Context, userId openapi_types.UUID, params ProfileListLogsParams) error
@@ -708,6 +1952,111 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ActivityList converts echo context to params.
+func (w *ServerInterfaceWrapper) ActivityList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ActivityList(ctx)
+	return err
+}
+
+// ActivityCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ActivityCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ActivityCreate(ctx)
+	return err
+}
+
+// ActivityUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ActivityUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ActivityUpdate(ctx, id)
+	return err
+}
+
+// ContestTemplateList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTemplateList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContestTemplateListParams
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", ctx.QueryParams(), &params.All)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTemplateList(ctx, params)
+	return err
+}
+
+// ContestTemplateCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTemplateCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTemplateCreate(ctx)
+	return err
+}
+
+// ContestTemplateFindByID converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTemplateFindByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTemplateFindByID(ctx, id)
+	return err
+}
+
+// ContestTemplateUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTemplateUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTemplateUpdate(ctx, id)
+	return err
+}
+
 // ContestList converts echo context to params.
 func (w *ServerInterfaceWrapper) ContestList(ctx echo.Context) error {
 	var err error
@@ -807,8 +2156,8 @@ func (w *ServerInterfaceWrapper) ContestFindOngoingRegistrations(ctx echo.Contex
 	return err
 }
 
-// ContestFindByID converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestFindByID(ctx echo.Context) error {
+// ContestCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestCancel(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -818,13 +2167,15 @@ func (w *ServerInterfaceWrapper) ContestFindByID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestFindByID(ctx, id)
+	err = w.Handler.ContestCancel(ctx, id)
 	return err
 }
 
-// ContestFetchLeaderboard converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestFetchLeaderboard(ctx echo.Context) error {
+// ContestFindByID converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestFindByID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -834,43 +2185,13 @@ func (w *ServerInterfaceWrapper) ContestFetchLeaderboard(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ContestFetchLeaderboardParams
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
-	}
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
-
-	// ------------- Optional query parameter "language_code" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
-	}
-
-	// ------------- Optional query parameter "activity_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestFetchLeaderboard(ctx, id, params)
+	err = w.Handler.ContestFindByID(ctx, id)
 	return err
 }
 
-// ContestListLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestListLogs(ctx echo.Context) error {
+// ContestUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -880,43 +2201,15 @@ func (w *ServerInterfaceWrapper) ContestListLogs(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ContestListLogsParams
-	// ------------- Optional query parameter "include_deleted" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
-	}
-
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
-	}
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
-
-	// ------------- Optional query parameter "user_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestListLogs(ctx, id, params)
+	err = w.Handler.ContestUpdate(ctx, id)
 	return err
 }
 
-// ContestModerationDetachLog converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestModerationDetachLog(ctx echo.Context) error {
+// ContestInvitationList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestInvitationList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -926,23 +2219,15 @@ func (w *ServerInterfaceWrapper) ContestModerationDetachLog(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Path parameter "log_id" -------------
-	var logId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "log_id", runtime.ParamLocationPath, ctx.Param("log_id"), &logId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter log_id: %s", err))
-	}
-
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestModerationDetachLog(ctx, id, logId)
+	err = w.Handler.ContestInvitationList(ctx, id)
 	return err
 }
 
-// ContestProfileFetchActivity converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestProfileFetchActivity(ctx echo.Context) error {
+// ContestInvitationCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestInvitationCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -952,21 +2237,15 @@ func (w *ServerInterfaceWrapper) ContestProfileFetchActivity(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Path parameter "user_id" -------------
-	var userId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestProfileFetchActivity(ctx, id, userId)
+	err = w.Handler.ContestInvitationCreate(ctx, id)
 	return err
 }
 
-// ContestProfileFetchScores converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestProfileFetchScores(ctx echo.Context) error {
+// ContestInviteCodeList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestInviteCodeList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -976,21 +2255,15 @@ func (w *ServerInterfaceWrapper) ContestProfileFetchScores(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Path parameter "user_id" -------------
-	var userId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestProfileFetchScores(ctx, id, userId)
+	err = w.Handler.ContestInviteCodeList(ctx, id)
 	return err
 }
 
-// ContestFindRegistration converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestFindRegistration(ctx echo.Context) error {
+// ContestInviteCodeCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestInviteCodeCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1003,12 +2276,12 @@ func (w *ServerInterfaceWrapper) ContestFindRegistration(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestFindRegistration(ctx, id)
+	err = w.Handler.ContestInviteCodeCreate(ctx, id)
 	return err
 }
 
-// ContestRegistrationUpsert converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestRegistrationUpsert(ctx echo.Context) error {
+// ContestInviteCodeRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestInviteCodeRevoke(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1018,15 +2291,23 @@ func (w *ServerInterfaceWrapper) ContestRegistrationUpsert(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// ------------- Path parameter "code_id" -------------
+	var codeId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code_id", runtime.ParamLocationPath, ctx.Param("code_id"), &codeId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code_id: %s", err))
+	}
+
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestRegistrationUpsert(ctx, id)
+	err = w.Handler.ContestInviteCodeRevoke(ctx, id, codeId)
 	return err
 }
 
-// ScoringRuleSetListContest converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetListContest(ctx echo.Context) error {
+// ContestFetchLeaderboard converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestFetchLeaderboard(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1036,15 +2317,854 @@ func (w *ServerInterfaceWrapper) ScoringRuleSetListContest(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(CookieAuthScopes, []string{""})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContestFetchLeaderboardParams
+	// ------------- Optional query parameter "page_size" -------------
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetListContest(ctx, id)
-	return err
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "language_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
+	}
+
+	// ------------- Optional query parameter "activity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestFetchLeaderboard(ctx, id, params)
+	return err
+}
+
+// ContestStreamLeaderboard converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestStreamLeaderboard(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContestStreamLeaderboardParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestStreamLeaderboard(ctx, id, params)
+	return err
+}
+
+// ContestFetchTeamLeaderboard converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestFetchTeamLeaderboard(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContestFetchTeamLeaderboardParams
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestFetchTeamLeaderboard(ctx, id, params)
+	return err
+}
+
+// ContestListLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestListLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ContestListLogsParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestListLogs(ctx, id, params)
+	return err
+}
+
+// ContestModerationDetachLog converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestModerationDetachLog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "log_id" -------------
+	var logId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "log_id", runtime.ParamLocationPath, ctx.Param("log_id"), &logId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter log_id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestModerationDetachLog(ctx, id, logId)
+	return err
+}
+
+// ContestOrganizerList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestOrganizerList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestOrganizerList(ctx, id)
+	return err
+}
+
+// ContestOrganizerAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestOrganizerAdd(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestOrganizerAdd(ctx, id)
+	return err
+}
+
+// ContestOrganizerRemove converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestOrganizerRemove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestOrganizerRemove(ctx, id, userId)
+	return err
+}
+
+// ContestParticipantRemove converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestParticipantRemove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestParticipantRemove(ctx, id, userId)
+	return err
+}
+
+// ContestPermissionFetch converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestPermissionFetch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestPermissionFetch(ctx, id)
+	return err
+}
+
+// ContestProfileFetchActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestProfileFetchActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestProfileFetchActivity(ctx, id, userId)
+	return err
+}
+
+// ContestProfileFetchScores converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestProfileFetchScores(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestProfileFetchScores(ctx, id, userId)
+	return err
+}
+
+// ContestFindRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestFindRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestFindRegistration(ctx, id)
+	return err
+}
+
+// ContestRegistrationUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestRegistrationUpsert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestRegistrationUpsert(ctx, id)
+	return err
+}
+
+// ScoringRuleSetListContest converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetListContest(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScoringRuleSetListContest(ctx, id)
+	return err
+}
+
+// ScoringRuleSetCreateContest converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetCreateContest(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScoringRuleSetCreateContest(ctx, id)
+	return err
+}
+
+// ContestFetchSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestFetchSummary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestFetchSummary(ctx, id)
+	return err
+}
+
+// ContestTeamList converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTeamList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTeamList(ctx, id)
+	return err
+}
+
+// ContestTeamCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTeamCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTeamCreate(ctx, id)
+	return err
+}
+
+// ContestTeamLeave converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTeamLeave(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTeamLeave(ctx, id)
+	return err
+}
+
+// ContestTeamJoin converts echo context to params.
+func (w *ServerInterfaceWrapper) ContestTeamJoin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "team_id" -------------
+	var teamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "team_id", runtime.ParamLocationPath, ctx.Param("team_id"), &teamId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ContestTeamJoin(ctx, id, teamId)
+	return err
+}
+
+// GoalCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) GoalCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoalCreate(ctx)
+	return err
+}
+
+// GoalDeleteByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GoalDeleteByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoalDeleteByID(ctx, id)
+	return err
+}
+
+// GoalFindByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GoalFindByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoalFindByID(ctx, id)
+	return err
+}
+
+// GoalUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) GoalUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoalUpdate(ctx, id)
+	return err
+}
+
+// LanguageList converts echo context to params.
+func (w *ServerInterfaceWrapper) LanguageList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LanguageList(ctx)
+	return err
+}
+
+// LanguageCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) LanguageCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LanguageCreate(ctx)
+	return err
+}
+
+// LanguageUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) LanguageUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, ctx.Param("code"), &code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LanguageUpdate(ctx, code)
+	return err
+}
+
+// FetchLeaderboardGlobal converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchLeaderboardGlobal(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FetchLeaderboardGlobalParams
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "language_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
+	}
+
+	// ------------- Optional query parameter "activity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchLeaderboardGlobal(ctx, params)
+	return err
+}
+
+// StreamLeaderboardGlobal converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamLeaderboardGlobal(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamLeaderboardGlobalParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamLeaderboardGlobal(ctx, params)
+	return err
+}
+
+// FetchLeaderboardForYear converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchLeaderboardForYear(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "year" -------------
+	var year int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, ctx.Param("year"), &year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FetchLeaderboardForYearParams
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "language_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
+	}
+
+	// ------------- Optional query parameter "activity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchLeaderboardForYear(ctx, year, params)
+	return err
 }
 
-// ScoringRuleSetCreateContest converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetCreateContest(ctx echo.Context) error {
+// StreamLeaderboardForYear converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamLeaderboardForYear(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "year" -------------
+	var year int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, ctx.Param("year"), &year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamLeaderboardForYearParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamLeaderboardForYear(ctx, year, params)
+	return err
+}
+
+// LogCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) LogCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogCreate(ctx)
+	return err
+}
+
+// LogGetConfigurations converts echo context to params.
+func (w *ServerInterfaceWrapper) LogGetConfigurations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogGetConfigurations(ctx)
+	return err
+}
+
+// LogImport converts echo context to params.
+func (w *ServerInterfaceWrapper) LogImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogImport(ctx)
+	return err
+}
+
+// ScorePreview converts echo context to params.
+func (w *ServerInterfaceWrapper) ScorePreview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScorePreview(ctx)
+	return err
+}
+
+// LogTagSuggestions converts echo context to params.
+func (w *ServerInterfaceWrapper) LogTagSuggestions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogTagSuggestionsParams
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogTagSuggestions(ctx, params)
+	return err
+}
+
+// LogDeleteByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LogDeleteByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogDeleteByID(ctx, id)
+	return err
+}
+
+// LogFindByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LogFindByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogFindByID(ctx, id)
+	return err
+}
+
+// LogUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) LogUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1057,12 +3177,12 @@ func (w *ServerInterfaceWrapper) ScoringRuleSetCreateContest(ctx echo.Context) e
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetCreateContest(ctx, id)
+	err = w.Handler.LogUpdate(ctx, id)
 	return err
 }
 
-// ContestFetchSummary converts echo context to params.
-func (w *ServerInterfaceWrapper) ContestFetchSummary(ctx echo.Context) error {
+// LogContestRegistrationUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) LogContestRegistrationUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1072,103 +3192,212 @@ func (w *ServerInterfaceWrapper) ContestFetchSummary(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ContestFetchSummary(ctx, id)
+	err = w.Handler.LogContestRegistrationUpdate(ctx, id)
 	return err
 }
 
-// LanguageList converts echo context to params.
-func (w *ServerInterfaceWrapper) LanguageList(ctx echo.Context) error {
+// LogScoreExplanation converts echo context to params.
+func (w *ServerInterfaceWrapper) LogScoreExplanation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogScoreExplanation(ctx, id)
+	return err
+}
+
+// MediaSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaSearch(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MediaSearchParams
+	// ------------- Required query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "language_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LanguageList(ctx)
+	err = w.Handler.MediaSearch(ctx, params)
 	return err
 }
 
-// LanguageCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) LanguageCreate(ctx echo.Context) error {
+// MediaCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaCreate(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LanguageCreate(ctx)
+	err = w.Handler.MediaCreate(ctx)
 	return err
 }
 
-// LanguageUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) LanguageUpdate(ctx echo.Context) error {
+// MediaProgressList converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaProgressList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "code" -------------
-	var code string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, ctx.Param("code"), &code)
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MediaProgressList(ctx)
+	return err
+}
+
+// MediaFindByID converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaFindByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LanguageUpdate(ctx, code)
+	err = w.Handler.MediaFindByID(ctx, id)
 	return err
 }
 
-// FetchLeaderboardGlobal converts echo context to params.
-func (w *ServerInterfaceWrapper) FetchLeaderboardGlobal(ctx echo.Context) error {
+// MediaUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MediaUpdate(ctx, id)
+	return err
+}
+
+// MediaLinkLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) MediaLinkLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MediaLinkLogs(ctx, id)
+	return err
+}
+
+// Ping converts echo context to params.
+func (w *ServerInterfaceWrapper) Ping(ctx echo.Context) error {
 	var err error
 
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Ping(ctx)
+	return err
+}
+
+// ScoringRescoreJobList converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRescoreJobList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FetchLeaderboardGlobalParams
-	// ------------- Optional query parameter "page_size" -------------
+	var params ScoringRescoreJobListParams
+	// ------------- Optional query parameter "contest_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "contest_id", ctx.QueryParams(), &params.ContestId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contest_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScoringRescoreJobList(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+// ScoringRescoreJobCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRescoreJobCreate(ctx echo.Context) error {
+	var err error
 
-	// ------------- Optional query parameter "language_code" -------------
+	ctx.Set(CookieAuthScopes, []string{""})
 
-	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScoringRescoreJobCreate(ctx)
+	return err
+}
 
-	// ------------- Optional query parameter "activity_id" -------------
+// ScoringRescoreJobFind converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRescoreJobFind(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.FetchLeaderboardGlobal(ctx, params)
+	err = w.Handler.ScoringRescoreJobFind(ctx, id)
 	return err
 }
 
-// FetchLeaderboardForYear converts echo context to params.
-func (w *ServerInterfaceWrapper) FetchLeaderboardForYear(ctx echo.Context) error {
+// ScoringRescoreJobDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRescoreJobDiff(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "year" -------------
-	var year int
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, ctx.Param("year"), &year)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FetchLeaderboardForYearParams
+	var params ScoringRescoreJobDiffParams
 	// ------------- Optional query parameter "page_size" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
@@ -1183,80 +3412,89 @@ func (w *ServerInterfaceWrapper) FetchLeaderboardForYear(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
 	}
 
-	// ------------- Optional query parameter "language_code" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
-	}
-
-	// ------------- Optional query parameter "activity_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "activity_id", ctx.QueryParams(), &params.ActivityId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter activity_id: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.FetchLeaderboardForYear(ctx, year, params)
+	err = w.Handler.ScoringRescoreJobDiff(ctx, id, params)
 	return err
 }
 
-// LogCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) LogCreate(ctx echo.Context) error {
+// ScoringRuleSetListPlatform converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetListPlatform(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogCreate(ctx)
+	err = w.Handler.ScoringRuleSetListPlatform(ctx)
 	return err
 }
 
-// LogGetConfigurations converts echo context to params.
-func (w *ServerInterfaceWrapper) LogGetConfigurations(ctx echo.Context) error {
+// ScoringRuleSetCreatePlatform converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetCreatePlatform(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogGetConfigurations(ctx)
+	err = w.Handler.ScoringRuleSetCreatePlatform(ctx)
 	return err
 }
 
-// ScorePreview converts echo context to params.
-func (w *ServerInterfaceWrapper) ScorePreview(ctx echo.Context) error {
+// ScoringRuleSetDeleteDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetDeleteDraft(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScorePreview(ctx)
+	err = w.Handler.ScoringRuleSetDeleteDraft(ctx, id)
 	return err
 }
 
-// LogTagSuggestions converts echo context to params.
-func (w *ServerInterfaceWrapper) LogTagSuggestions(ctx echo.Context) error {
+// ScoringRuleSetUpdateDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetUpdateDraft(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LogTagSuggestionsParams
-	// ------------- Optional query parameter "query" -------------
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ScoringRuleSetUpdateDraft(ctx, id)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+// ScoringRuleSetActivate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetActivate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogTagSuggestions(ctx, params)
+	err = w.Handler.ScoringRuleSetActivate(ctx, id)
 	return err
 }
 
-// LogDeleteByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LogDeleteByID(ctx echo.Context) error {
+// ScoringRuleSetArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetArchive(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1266,13 +3504,15 @@ func (w *ServerInterfaceWrapper) LogDeleteByID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogDeleteByID(ctx, id)
+	err = w.Handler.ScoringRuleSetArchive(ctx, id)
 	return err
 }
 
-// LogFindByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LogFindByID(ctx echo.Context) error {
+// ScoringRuleSetClone converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetClone(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1282,13 +3522,15 @@ func (w *ServerInterfaceWrapper) LogFindByID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(CookieAuthScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogFindByID(ctx, id)
+	err = w.Handler.ScoringRuleSetClone(ctx, id)
 	return err
 }
 
-// LogUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) LogUpdate(ctx echo.Context) error {
+// ScoringRuleSetImpact converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetImpact(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1300,13 +3542,29 @@ func (w *ServerInterfaceWrapper) LogUpdate(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScoringRuleSetImpactParams
+	// ------------- Optional query parameter "base_rule_set_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "base_rule_set_id", ctx.QueryParams(), &params.BaseRuleSetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter base_rule_set_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "sample_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sample_size", ctx.QueryParams(), &params.SampleSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sample_size: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogUpdate(ctx, id)
+	err = w.Handler.ScoringRuleSetImpact(ctx, id, params)
 	return err
 }
 
-// LogContestRegistrationUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) LogContestRegistrationUpdate(ctx echo.Context) error {
+// ScoringRuleSetPublish converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoringRuleSetPublish(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1319,43 +3577,59 @@ func (w *ServerInterfaceWrapper) LogContestRegistrationUpdate(ctx echo.Context)
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LogContestRegistrationUpdate(ctx, id)
+	err = w.Handler.ScoringRuleSetPublish(ctx, id)
 	return err
 }
 
-// Ping converts echo context to params.
-func (w *ServerInterfaceWrapper) Ping(ctx echo.Context) error {
+// StatisticsTrending converts echo context to params.
+func (w *ServerInterfaceWrapper) StatisticsTrending(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StatisticsTrendingParams
+	// ------------- Optional query parameter "language_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language_code", ctx.QueryParams(), &params.LanguageCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language_code: %s", err))
+	}
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.Ping(ctx)
+	err = w.Handler.StatisticsTrending(ctx, params)
 	return err
 }
 
-// ScoringRuleSetListPlatform converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetListPlatform(ctx echo.Context) error {
+// UnitList converts echo context to params.
+func (w *ServerInterfaceWrapper) UnitList(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetListPlatform(ctx)
+	err = w.Handler.UnitList(ctx)
 	return err
 }
 
-// ScoringRuleSetCreatePlatform converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetCreatePlatform(ctx echo.Context) error {
+// UnitCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) UnitCreate(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetCreatePlatform(ctx)
+	err = w.Handler.UnitCreate(ctx)
 	return err
 }
 
-// ScoringRuleSetActivate converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetActivate(ctx echo.Context) error {
+// UnitDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) UnitDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1368,12 +3642,12 @@ func (w *ServerInterfaceWrapper) ScoringRuleSetActivate(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetActivate(ctx, id)
+	err = w.Handler.UnitDelete(ctx, id)
 	return err
 }
 
-// ScoringRuleSetPublish converts echo context to params.
-func (w *ServerInterfaceWrapper) ScoringRuleSetPublish(ctx echo.Context) error {
+// UnitUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) UnitUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
@@ -1386,7 +3660,7 @@ func (w *ServerInterfaceWrapper) ScoringRuleSetPublish(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{""})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScoringRuleSetPublish(ctx, id)
+	err = w.Handler.UnitUpdate(ctx, id)
 	return err
 }
 
@@ -1462,6 +3736,60 @@ func (w *ServerInterfaceWrapper) ProfileYearlyContestRegistrationsByUserID(ctx e
 	return err
 }
 
+// UserErasureCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) UserErasureCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UserErasureCreate(ctx, userId)
+	return err
+}
+
+// UserDataExport converts echo context to params.
+func (w *ServerInterfaceWrapper) UserDataExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UserDataExport(ctx, userId)
+	return err
+}
+
+// GoalListForUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GoalListForUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoalListForUser(ctx, userId)
+	return err
+}
+
 // ProfileFindByUserID converts echo context to params.
 func (w *ServerInterfaceWrapper) ProfileFindByUserID(ctx echo.Context) error {
 	var err error
@@ -1502,6 +3830,58 @@ func (w *ServerInterfaceWrapper) ProfileYearlyScoresByUserID(ctx echo.Context) e
 	return err
 }
 
+// UserSettingsFind converts echo context to params.
+func (w *ServerInterfaceWrapper) UserSettingsFind(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UserSettingsFind(ctx, userId)
+	return err
+}
+
+// UserSettingsUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) UserSettingsUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UserSettingsUpdate(ctx, userId)
+	return err
+}
+
+// ProfileStreaksByUserID converts echo context to params.
+func (w *ServerInterfaceWrapper) ProfileStreaksByUserID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ProfileStreaksByUserID(ctx, userId)
+	return err
+}
+
 // ProfileListLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) ProfileListLogs(ctx echo.Context) error {
 	var err error
@@ -1569,16 +3949,37 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/activities", wrapper.ActivityList)
+	router.POST(baseURL+"/activities", wrapper.ActivityCreate)
+	router.PUT(baseURL+"/activities/:id", wrapper.ActivityUpdate)
+	router.GET(baseURL+"/contest-templates", wrapper.ContestTemplateList)
+	router.POST(baseURL+"/contest-templates", wrapper.ContestTemplateCreate)
+	router.GET(baseURL+"/contest-templates/:id", wrapper.ContestTemplateFindByID)
+	router.PUT(baseURL+"/contest-templates/:id", wrapper.ContestTemplateUpdate)
 	router.GET(baseURL+"/contests", wrapper.ContestList)
 	router.POST(baseURL+"/contests", wrapper.ContestCreate)
 	router.GET(baseURL+"/contests/configuration-options", wrapper.ContestGetConfigurations)
 	router.GET(baseURL+"/contests/create-permissions", wrapper.ContestCreatePermissionCheck)
 	router.GET(baseURL+"/contests/latest-official", wrapper.ContestFindLatestOfficial)
 	router.GET(baseURL+"/contests/ongoing-registrations", wrapper.ContestFindOngoingRegistrations)
+	router.DELETE(baseURL+"/contests/:id", wrapper.ContestCancel)
 	router.GET(baseURL+"/contests/:id", wrapper.ContestFindByID)
+	router.PUT(baseURL+"/contests/:id", wrapper.ContestUpdate)
+	router.GET(baseURL+"/contests/:id/invitations", wrapper.ContestInvitationList)
+	router.POST(baseURL+"/contests/:id/invitations", wrapper.ContestInvitationCreate)
+	router.GET(baseURL+"/contests/:id/invite-codes", wrapper.ContestInviteCodeList)
+	router.POST(baseURL+"/contests/:id/invite-codes", wrapper.ContestInviteCodeCreate)
+	router.DELETE(baseURL+"/contests/:id/invite-codes/:code_id", wrapper.ContestInviteCodeRevoke)
 	router.GET(baseURL+"/contests/:id/leaderboard", wrapper.ContestFetchLeaderboard)
+	router.GET(baseURL+"/contests/:id/leaderboard/stream", wrapper.ContestStreamLeaderboard)
+	router.GET(baseURL+"/contests/:id/leaderboard/teams", wrapper.ContestFetchTeamLeaderboard)
 	router.GET(baseURL+"/contests/:id/logs", wrapper.ContestListLogs)
 	router.POST(baseURL+"/contests/:id/moderation/detach/:log_id", wrapper.ContestModerationDetachLog)
+	router.GET(baseURL+"/contests/:id/organizers", wrapper.ContestOrganizerList)
+	router.POST(baseURL+"/contests/:id/organizers", wrapper.ContestOrganizerAdd)
+	router.DELETE(baseURL+"/contests/:id/organizers/:user_id", wrapper.ContestOrganizerRemove)
+	router.DELETE(baseURL+"/contests/:id/participants/:user_id", wrapper.ContestParticipantRemove)
+	router.GET(baseURL+"/contests/:id/permissions", wrapper.ContestPermissionFetch)
 	router.GET(baseURL+"/contests/:id/profile/:user_id/activity", wrapper.ContestProfileFetchActivity)
 	router.GET(baseURL+"/contests/:id/profile/:user_id/scores", wrapper.ContestProfileFetchScores)
 	router.GET(baseURL+"/contests/:id/registration", wrapper.ContestFindRegistration)
@@ -1586,29 +3987,67 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/contests/:id/scoring/rule-sets", wrapper.ScoringRuleSetListContest)
 	router.POST(baseURL+"/contests/:id/scoring/rule-sets", wrapper.ScoringRuleSetCreateContest)
 	router.GET(baseURL+"/contests/:id/summary", wrapper.ContestFetchSummary)
+	router.GET(baseURL+"/contests/:id/teams", wrapper.ContestTeamList)
+	router.POST(baseURL+"/contests/:id/teams", wrapper.ContestTeamCreate)
+	router.DELETE(baseURL+"/contests/:id/teams/membership", wrapper.ContestTeamLeave)
+	router.POST(baseURL+"/contests/:id/teams/:team_id/membership", wrapper.ContestTeamJoin)
+	router.POST(baseURL+"/goals", wrapper.GoalCreate)
+	router.DELETE(baseURL+"/goals/:id", wrapper.GoalDeleteByID)
+	router.GET(baseURL+"/goals/:id", wrapper.GoalFindByID)
+	router.PUT(baseURL+"/goals/:id", wrapper.GoalUpdate)
 	router.GET(baseURL+"/languages", wrapper.LanguageList)
 	router.POST(baseURL+"/languages", wrapper.LanguageCreate)
 	router.PUT(baseURL+"/languages/:code", wrapper.LanguageUpdate)
 	router.GET(baseURL+"/leaderboard/global", wrapper.FetchLeaderboardGlobal)
+	router.GET(baseURL+"/leaderboard/global/stream", wrapper.StreamLeaderboardGlobal)
 	router.GET(baseURL+"/leaderboard/yearly/:year", wrapper.FetchLeaderboardForYear)
+	router.GET(baseURL+"/leaderboard/yearly/:year/stream", wrapper.StreamLeaderboardForYear)
 	router.POST(baseURL+"/logs", wrapper.LogCreate)
 	router.GET(baseURL+"/logs/configuration-options", wrapper.LogGetConfigurations)
+	router.POST(baseURL+"/logs/import", wrapper.LogImport)
 	router.POST(baseURL+"/logs/score-preview", wrapper.ScorePreview)
 	router.GET(baseURL+"/logs/tag-suggestions", wrapper.LogTagSuggestions)
 	router.DELETE(baseURL+"/logs/:id", wrapper.LogDeleteByID)
 	router.GET(baseURL+"/logs/:id", wrapper.LogFindByID)
 	router.PUT(baseURL+"/logs/:id", wrapper.LogUpdate)
 	router.PUT(baseURL+"/logs/:id/contest-registrations", wrapper.LogContestRegistrationUpdate)
+	router.GET(baseURL+"/logs/:id/score-explanation", wrapper.LogScoreExplanation)
+	router.GET(baseURL+"/media", wrapper.MediaSearch)
+	router.POST(baseURL+"/media", wrapper.MediaCreate)
+	router.GET(baseURL+"/media/progress", wrapper.MediaProgressList)
+	router.GET(baseURL+"/media/:id", wrapper.MediaFindByID)
+	router.PUT(baseURL+"/media/:id", wrapper.MediaUpdate)
+	router.POST(baseURL+"/media/:id/link-logs", wrapper.MediaLinkLogs)
 	router.GET(baseURL+"/ping", wrapper.Ping)
+	router.GET(baseURL+"/scoring/rescore-jobs", wrapper.ScoringRescoreJobList)
+	router.POST(baseURL+"/scoring/rescore-jobs", wrapper.ScoringRescoreJobCreate)
+	router.GET(baseURL+"/scoring/rescore-jobs/:id", wrapper.ScoringRescoreJobFind)
+	router.GET(baseURL+"/scoring/rescore-jobs/:id/diff", wrapper.ScoringRescoreJobDiff)
 	router.GET(baseURL+"/scoring/rule-sets", wrapper.ScoringRuleSetListPlatform)
 	router.POST(baseURL+"/scoring/rule-sets", wrapper.ScoringRuleSetCreatePlatform)
+	router.DELETE(baseURL+"/scoring/rule-sets/:id", wrapper.ScoringRuleSetDeleteDraft)
+	router.PUT(baseURL+"/scoring/rule-sets/:id", wrapper.ScoringRuleSetUpdateDraft)
 	router.POST(baseURL+"/scoring/rule-sets/:id/activate", wrapper.ScoringRuleSetActivate)
+	router.POST(baseURL+"/scoring/rule-sets/:id/archive", wrapper.ScoringRuleSetArchive)
+	router.POST(baseURL+"/scoring/rule-sets/:id/clone", wrapper.ScoringRuleSetClone)
+	router.GET(baseURL+"/scoring/rule-sets/:id/impact", wrapper.ScoringRuleSetImpact)
 	router.POST(baseURL+"/scoring/rule-sets/:id/publish", wrapper.ScoringRuleSetPublish)
+	router.GET(baseURL+"/statistics/trending", wrapper.StatisticsTrending)
+	router.GET(baseURL+"/units", wrapper.UnitList)
+	router.POST(baseURL+"/units", wrapper.UnitCreate)
+	router.DELETE(baseURL+"/units/:id", wrapper.UnitDelete)
+	router.PUT(baseURL+"/units/:id", wrapper.UnitUpdate)
 	router.GET(baseURL+"/users/:userId/activity-split/:year", wrapper.ProfileYearlyActivitySplitByUserID)
 	router.GET(baseURL+"/users/:userId/activity/:year", wrapper.ProfileYearlyActivityByUserID)
 	router.GET(baseURL+"/users/:userId/contest-registrations/:year", wrapper.ProfileYearlyContestRegistrationsByUserID)
+	router.POST(baseURL+"/users/:userId/erasure", wrapper.UserErasureCreate)
+	router.GET(baseURL+"/users/:userId/export", wrapper.UserDataExport)
+	router.GET(baseURL+"/users/:userId/goals", wrapper.GoalListForUser)
 	router.GET(baseURL+"/users/:userId/profile", wrapper.ProfileFindByUserID)
 	router.GET(baseURL+"/users/:userId/scores/:year", wrapper.ProfileYearlyScoresByUserID)
+	router.GET(baseURL+"/users/:userId/settings", wrapper.UserSettingsFind)
+	router.PUT(baseURL+"/users/:userId/settings", wrapper.UserSettingsUpdate)
+	router.GET(baseURL+"/users/:userId/streaks", wrapper.ProfileStreaksByUserID)
 	router.GET(baseURL+"/users/:user_id/logs", wrapper.ProfileListLogs)
 
 }
@@ -42,6 +42,45 @@ func NewServer(
 	logContestUpdate *domain.LogContestUpdate,
 	scorePreview *domain.ScorePreview,
 	scoringRuleSetManagement *domain.ScoringRuleSetManagement,
+	logImport *domain.LogImport,
+	dataExport *domain.DataExport,
+	userErase *domain.UserErase,
+	goalCreate *domain.GoalCreate,
+	goalUpdate *domain.GoalUpdate,
+	goalDelete *domain.GoalDelete,
+	goalFind *domain.GoalFind,
+	goalList *domain.GoalList,
+	userSettingsFind *domain.UserSettingsFind,
+	userSettingsUpdate *domain.UserSettingsUpdate,
+	profileStreaks *domain.ProfileStreaks,
+	contestTeamCreate *domain.ContestTeamCreate,
+	contestTeamJoin *domain.ContestTeamJoin,
+	contestTeamLeave *domain.ContestTeamLeave,
+	contestTeamList *domain.ContestTeamList,
+	contestTeamLeaderboardFetch *domain.ContestTeamLeaderboardFetch,
+	contestUpdate *domain.ContestUpdate,
+	contestCancel *domain.ContestCancel,
+	contestInviteCodeCreate *domain.ContestInviteCodeCreate,
+	contestInviteCodeList *domain.ContestInviteCodeList,
+	contestInviteCodeRevoke *domain.ContestInviteCodeRevoke,
+	contestInvitationCreate *domain.ContestInvitationCreate,
+	contestInvitationList *domain.ContestInvitationList,
+	contestParticipantRemove *domain.ContestParticipantRemove,
+	contestOrganizerAdd *domain.ContestOrganizerAdd,
+	contestOrganizerList *domain.ContestOrganizerList,
+	contestOrganizerRemove *domain.ContestOrganizerRemove,
+	contestTemplateCreate *domain.ContestTemplateCreate,
+	contestTemplateList *domain.ContestTemplateList,
+	contestTemplateFind *domain.ContestTemplateFind,
+	contestTemplateUpdate *domain.ContestTemplateUpdate,
+	scoringRescoreJobManagement *domain.ScoringRescoreJobManagement,
+	scoringRuleSetImpact *domain.ScoringRuleSetImpact,
+	logScoreExplanation *domain.LogScoreExplanation,
+	unitManagement *domain.UnitManagement,
+	activityManagement *domain.ActivityManagement,
+	mediaLibrary *domain.MediaLibrary,
+	trendingStatisticsFetch *domain.TrendingStatisticsFetch,
+	leaderboardStreamHub *domain.LeaderboardStreamHub,
 ) openapi.ServerInterface {
 	return &Server{
 		contestConfigurationOptions: contestConfigurationOptions,
@@ -79,6 +118,45 @@ func NewServer(
 		logContestUpdate:            logContestUpdate,
 		scorePreview:                scorePreview,
 		scoringRuleSetManagement:    scoringRuleSetManagement,
+		logImport:                   logImport,
+		dataExport:                  dataExport,
+		userErase:                   userErase,
+		goalCreate:                  goalCreate,
+		goalUpdate:                  goalUpdate,
+		goalDelete:                  goalDelete,
+		goalFind:                    goalFind,
+		goalList:                    goalList,
+		userSettingsFind:            userSettingsFind,
+		userSettingsUpdate:          userSettingsUpdate,
+		profileStreaks:              profileStreaks,
+		contestTeamCreate:           contestTeamCreate,
+		contestTeamJoin:             contestTeamJoin,
+		contestTeamLeave:            contestTeamLeave,
+		contestTeamList:             contestTeamList,
+		contestTeamLeaderboardFetch: contestTeamLeaderboardFetch,
+		contestUpdate:               contestUpdate,
+		contestCancel:               contestCancel,
+		contestInviteCodeCreate:     contestInviteCodeCreate,
+		contestInviteCodeList:       contestInviteCodeList,
+		contestInviteCodeRevoke:     contestInviteCodeRevoke,
+		contestInvitationCreate:     contestInvitationCreate,
+		contestInvitationList:       contestInvitationList,
+		contestParticipantRemove:    contestParticipantRemove,
+		contestOrganizerAdd:         contestOrganizerAdd,
+		contestOrganizerList:        contestOrganizerList,
+		contestOrganizerRemove:      contestOrganizerRemove,
+		contestTemplateCreate:       contestTemplateCreate,
+		contestTemplateList:         contestTemplateList,
+		contestTemplateFind:         contestTemplateFind,
+		contestTemplateUpdate:       contestTemplateUpdate,
+		scoringRescoreJobManagement: scoringRescoreJobManagement,
+		scoringRuleSetImpact:        scoringRuleSetImpact,
+		logScoreExplanation:         logScoreExplanation,
+		unitManagement:              unitManagement,
+		activityManagement:          activityManagement,
+		mediaLibrary:                mediaLibrary,
+		trendingStatisticsFetch:     trendingStatisticsFetch,
+		leaderboardStreamHub:        leaderboardStreamHub,
 	}
 }
 
@@ -118,4 +196,43 @@ type Server struct {
 	logContestUpdate            *domain.LogContestUpdate
 	scorePreview                *domain.ScorePreview
 	scoringRuleSetManagement    *domain.ScoringRuleSetManagement
+	logImport                   *domain.LogImport
+	dataExport                  *domain.DataExport
+	userErase                   *domain.UserErase
+	goalCreate                  *domain.GoalCreate
+	goalUpdate                  *domain.GoalUpdate
+	goalDelete                  *domain.GoalDelete
+	goalFind                    *domain.GoalFind
+	goalList                    *domain.GoalList
+	userSettingsFind            *domain.UserSettingsFind
+	userSettingsUpdate          *domain.UserSettingsUpdate
+	profileStreaks              *domain.ProfileStreaks
+	contestTeamCreate           *domain.ContestTeamCreate
+	contestTeamJoin             *domain.ContestTeamJoin
+	contestTeamLeave            *domain.ContestTeamLeave
+	contestTeamList             *domain.ContestTeamList
+	contestTeamLeaderboardFetch *domain.ContestTeamLeaderboardFetch
+	contestUpdate               *domain.ContestUpdate
+	contestCancel               *domain.ContestCancel
+	contestInviteCodeCreate     *domain.ContestInviteCodeCreate
+	contestInviteCodeList       *domain.ContestInviteCodeList
+	contestInviteCodeRevoke     *domain.ContestInviteCodeRevoke
+	contestInvitationCreate     *domain.ContestInvitationCreate
+	contestInvitationList       *domain.ContestInvitationList
+	contestParticipantRemove    *domain.ContestParticipantRemove
+	contestOrganizerAdd         *domain.ContestOrganizerAdd
+	contestOrganizerList        *domain.ContestOrganizerList
+	contestOrganizerRemove      *domain.ContestOrganizerRemove
+	contestTemplateCreate       *domain.ContestTemplateCreate
+	contestTemplateList         *domain.ContestTemplateList
+	contestTemplateFind         *domain.ContestTemplateFind
+	contestTemplateUpdate       *domain.ContestTemplateUpdate
+	scoringRescoreJobManagement *domain.ScoringRescoreJobManagement
+	scoringRuleSetImpact        *domain.ScoringRuleSetImpact
+	logScoreExplanation         *domain.LogScoreExplanation
+	unitManagement              *domain.UnitManagement
+	activityManagement          *domain.ActivityManagement
+	mediaLibrary                *domain.MediaLibrary
+	trendingStatisticsFetch     *domain.TrendingStatisticsFetch
+	leaderboardStreamHub        *domain.LeaderboardStreamHub
 }
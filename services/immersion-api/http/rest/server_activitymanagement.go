@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists all activities (admin only)
+// (GET /activities)
+func (s *Server) ActivityList(ctx echo.Context) error {
+	activities, err := s.activityManagement.List(ctx.Request().Context())
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := make([]openapi.Activity, len(activities))
+	for i, activity := range activities {
+		result[i] = activityToAPI(activity, true)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.Activities{
+		Activities: result,
+	})
+}
+
+// Creates a new activity (admin only)
+// (POST /activities)
+func (s *Server) ActivityCreate(ctx echo.Context) error {
+	var req openapi.ActivityCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	activity, err := s.activityManagement.Create(ctx.Request().Context(), &domain.ActivityCreateRequest{
+		Name:                   req.Name,
+		Default:                req.Default,
+		InputType:              domain.ActivityInputType(req.InputType),
+		DurationScorePerMinute: req.DurationScorePerMinute,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, activityToAPI(*activity, true))
+}
+
+// Updates an existing activity (admin only)
+// (PUT /activities/{id})
+func (s *Server) ActivityUpdate(ctx echo.Context, id int32) error {
+	var req openapi.ActivityUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	activity, err := s.activityManagement.Update(ctx.Request().Context(), &domain.ActivityUpdateRequest{
+		ID:                     id,
+		Name:                   req.Name,
+		Default:                req.Default,
+		InputType:              domain.ActivityInputType(req.InputType),
+		DurationScorePerMinute: req.DurationScorePerMinute,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, activityToAPI(*activity, true))
+}
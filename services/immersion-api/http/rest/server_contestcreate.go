@@ -30,6 +30,9 @@ func (s *Server) ContestCreate(ctx echo.Context) error {
 		Description:             req.Description,
 		LanguageCodeAllowList:   req.LanguageCodeAllowList,
 		ActivityTypeIDAllowList: req.ActivityTypeIdAllowList,
+		TeamMode:                req.TeamMode != nil && *req.TeamMode,
+		TeamSizeLimit:           req.TeamSizeLimit,
+		TeamScoreAggregation:    teamScoreAggregationFromAPI(req.TeamScoreAggregation),
 	})
 	if err != nil {
 		if handled, respErr := handleCommonErrors(ctx, err); handled {
@@ -57,6 +60,9 @@ func (s *Server) ContestCreate(ctx echo.Context) error {
 		Private:                 contest.Private,
 		LanguageCodeAllowList:   contest.LanguageCodeAllowList,
 		ActivityTypeIdAllowList: contest.ActivityTypeIDAllowList,
+		TeamMode:                &contest.TeamMode,
+		TeamSizeLimit:           contest.TeamSizeLimit,
+		TeamScoreAggregation:    teamScoreAggregationToAPI(contest.TeamMode, contest.TeamScoreAggregation),
 		CreatedAt:               &contest.CreatedAt,
 		UpdatedAt:               &contest.UpdatedAt,
 	})
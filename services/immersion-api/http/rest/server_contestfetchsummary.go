@@ -23,9 +23,12 @@ func (s *Server) ContestFetchSummary(ctx echo.Context, id types.UUID) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	topTags := tagStatisticsToAPI(summary.TopTags)
+
 	return ctx.JSON(http.StatusOK, &openapi.ContestSummary{
 		ParticipantCount: summary.ParticipantCount,
 		LanguageCount:    summary.LanguageCount,
 		TotalScore:       summary.TotalScore,
+		TopTags:          &topTags,
 	})
 }
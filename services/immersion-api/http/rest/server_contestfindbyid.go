@@ -6,7 +6,6 @@ import (
 	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/labstack/echo/v4"
 	"github.com/tadoku/tadoku/services/immersion-api/domain"
-	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
 )
 
 // Fetches a contest by id
@@ -24,38 +23,5 @@ func (s *Server) ContestFindByID(ctx echo.Context, id types.UUID) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	langs := make([]openapi.Language, len(contest.AllowedLanguages))
-	for i, it := range contest.AllowedLanguages {
-		langs[i] = openapi.Language{
-			Code: it.Code,
-			Name: it.Name,
-		}
-	}
-
-	if len(langs) == 0 {
-		langs = nil
-	}
-
-	acts := make([]openapi.Activity, len(contest.AllowedActivities))
-	for i, it := range contest.AllowedActivities {
-		acts[i] = activityToAPI(it, false)
-	}
-
-	return ctx.JSON(http.StatusOK, openapi.ContestView{
-		Id:                   &contest.ID,
-		ContestStart:         types.Date{Time: contest.ContestStart},
-		ContestEnd:           types.Date{Time: contest.ContestEnd},
-		RegistrationEnd:      types.Date{Time: contest.RegistrationEnd},
-		Title:                contest.Title,
-		Description:          contest.Description,
-		OwnerUserId:          &contest.OwnerUserID,
-		OwnerUserDisplayName: &contest.OwnerUserDisplayName,
-		Official:             contest.Official,
-		Private:              contest.Private,
-		AllowedLanguages:     langs,
-		AllowedActivities:    acts,
-		CreatedAt:            &contest.CreatedAt,
-		UpdatedAt:            &contest.UpdatedAt,
-		Deleted:              &contest.Deleted,
-	})
+	return ctx.JSON(http.StatusOK, contestViewToAPI(contest))
 }
@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists the invite codes of a private contest
+// (GET /contests/{id}/invite-codes)
+func (s *Server) ContestInviteCodeList(ctx echo.Context, id types.UUID) error {
+	res, err := s.contestInviteCodeList.Execute(ctx.Request().Context(), &domain.ContestInviteCodeListRequest{
+		ContestID: id,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	codes := make([]openapi.ContestInviteCode, len(res.InviteCodes))
+	for i := range res.InviteCodes {
+		codes[i] = *contestInviteCodeToAPI(&res.InviteCodes[i])
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestInviteCodes{InviteCodes: codes})
+}
+
+// Creates an invite code for a private contest
+// (POST /contests/{id}/invite-codes)
+func (s *Server) ContestInviteCodeCreate(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestInviteCodeCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	code, err := s.contestInviteCodeCreate.Execute(ctx.Request().Context(), &domain.ContestInviteCodeCreateRequest{
+		ContestID: id,
+		ExpiresAt: req.ExpiresAt,
+		MaxUses:   req.MaxUses,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, contestInviteCodeToAPI(code))
+}
+
+// Revokes an invite code
+// (DELETE /contests/{id}/invite-codes/{code_id})
+func (s *Server) ContestInviteCodeRevoke(ctx echo.Context, id types.UUID, codeID types.UUID) error {
+	err := s.contestInviteCodeRevoke.Execute(ctx.Request().Context(), &domain.ContestInviteCodeRevokeRequest{
+		ContestID:    id,
+		InviteCodeID: codeID,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Lists the users invited to a private contest
+// (GET /contests/{id}/invitations)
+func (s *Server) ContestInvitationList(ctx echo.Context, id types.UUID) error {
+	res, err := s.contestInvitationList.Execute(ctx.Request().Context(), &domain.ContestInvitationListRequest{
+		ContestID: id,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	invitations := make([]openapi.ContestInvitation, len(res.Invitations))
+	for i, it := range res.Invitations {
+		invitations[i] = openapi.ContestInvitation{
+			UserId:          it.UserID,
+			UserDisplayName: it.UserDisplayName,
+			InviteCodeId:    it.InviteCodeID,
+			InvitedByUserId: it.InvitedByUserID,
+			CreatedAt:       it.CreatedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestInvitations{Invitations: invitations})
+}
+
+// Invites a user to a private contest
+// (POST /contests/{id}/invitations)
+func (s *Server) ContestInvitationCreate(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestInvitationCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	err := s.contestInvitationCreate.Execute(ctx.Request().Context(), &domain.ContestInvitationCreateRequest{
+		ContestID: id,
+		UserID:    req.UserId,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Removes a participant from a private contest
+// (DELETE /contests/{id}/participants/{user_id})
+func (s *Server) ContestParticipantRemove(ctx echo.Context, id types.UUID, userID types.UUID) error {
+	var req openapi.ContestParticipantRemoveJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	err := s.contestParticipantRemove.Execute(ctx.Request().Context(), &domain.ContestParticipantRemoveRequest{
+		ContestID: id,
+		UserID:    userID,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return handleContestInviteError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func contestInviteCodeToAPI(code *domain.ContestInviteCode) *openapi.ContestInviteCode {
+	return &openapi.ContestInviteCode{
+		Id:        code.ID,
+		Code:      code.Code,
+		ExpiresAt: code.ExpiresAt,
+		MaxUses:   code.MaxUses,
+		UseCount:  code.UseCount,
+		RevokedAt: code.RevokedAt,
+		CreatedAt: code.CreatedAt,
+	}
+}
+
+func handleContestInviteError(ctx echo.Context, err error) error {
+	if handled, respErr := handleCommonErrors(ctx, err); handled {
+		return respErr
+	}
+	if errors.Is(err, domain.ErrInvalidContestInvite) {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	ctx.Echo().Logger.Error("could not process request: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
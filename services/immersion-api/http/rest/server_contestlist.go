@@ -70,6 +70,7 @@ func (s *Server) ContestList(ctx echo.Context, params openapi.ContestListParams)
 			Private:                 contest.Private,
 			LanguageCodeAllowList:   contest.LanguageCodeAllowList,
 			ActivityTypeIdAllowList: contest.ActivityTypeIDAllowList,
+			TeamMode:                &contest.TeamMode,
 			CreatedAt:               &contest.CreatedAt,
 			UpdatedAt:               &contest.UpdatedAt,
 			Deleted:                 &contest.Deleted,
@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists the co-organizers of a contest
+// (GET /contests/{id}/organizers)
+func (s *Server) ContestOrganizerList(ctx echo.Context, id types.UUID) error {
+	res, err := s.contestOrganizerList.Execute(ctx.Request().Context(), &domain.ContestOrganizerListRequest{
+		ContestID: id,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	organizers := make([]openapi.ContestOrganizer, len(res.Organizers))
+	for i, it := range res.Organizers {
+		organizers[i] = openapi.ContestOrganizer{
+			UserId:          it.UserID,
+			UserDisplayName: it.UserDisplayName,
+			AddedByUserId:   it.AddedByUserID,
+			CreatedAt:       it.CreatedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestOrganizers{Organizers: organizers})
+}
+
+// Adds a co-organizer to a contest
+// (POST /contests/{id}/organizers)
+func (s *Server) ContestOrganizerAdd(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestOrganizerAddJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	err := s.contestOrganizerAdd.Execute(ctx.Request().Context(), &domain.ContestOrganizerAddRequest{
+		ContestID: id,
+		UserID:    req.UserId,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Removes a co-organizer from a contest
+// (DELETE /contests/{id}/organizers/{user_id})
+func (s *Server) ContestOrganizerRemove(ctx echo.Context, id types.UUID, userID types.UUID) error {
+	err := s.contestOrganizerRemove.Execute(ctx.Request().Context(), &domain.ContestOrganizerRemoveRequest{
+		ContestID: id,
+		UserID:    userID,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Fetches the capabilities of the current user on a contest
+// (GET /contests/{id}/permissions)
+func (s *Server) ContestPermissionFetch(ctx echo.Context, id types.UUID) error {
+	caps, err := s.contestPermissionCheck.ExecuteForContest(ctx.Request().Context(), id)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error("could not fetch contest permissions: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestPermissions{
+		Owner:                  caps.Owner,
+		Organizer:              caps.Organizer,
+		Admin:                  caps.Admin,
+		CanEdit:                caps.CanEdit,
+		CanManageOrganizers:    caps.CanManageOrganizers,
+		CanManageInvites:       caps.CanManageInvites,
+		CanModerateLogs:        caps.CanModerateLogs,
+		CanRemoveParticipants:  caps.CanRemoveParticipants,
+		CanDraftScoringRules:   caps.CanDraftScoringRules,
+		CanPublishScoringRules: caps.CanPublishScoringRules,
+	})
+}
@@ -19,15 +19,20 @@ func (s *Server) ContestRegistrationUpsert(ctx echo.Context, id types.UUID) erro
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
-	err := s.registrationUpsert.Execute(ctx.Request().Context(), &domain.RegistrationUpsertRequest{
+	registration := &domain.RegistrationUpsertRequest{
 		ContestID:     id,
 		LanguageCodes: req.LanguageCodes,
-	})
+	}
+	if req.InviteCode != nil {
+		registration.InviteCode = *req.InviteCode
+	}
+
+	err := s.registrationUpsert.Execute(ctx.Request().Context(), registration)
 	if err != nil {
 		if handled, respErr := handleCommonErrors(ctx, err); handled {
 			return respErr
 		}
-		if errors.Is(err, domain.ErrInvalidContestRegistration) {
+		if errors.Is(err, domain.ErrInvalidContestRegistration) || errors.Is(err, domain.ErrInvalidContestInvite) {
 			return ctx.NoContent(http.StatusBadRequest)
 		}
 
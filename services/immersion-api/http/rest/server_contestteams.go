@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists the teams of a contest with their members
+// (GET /contests/{id}/teams)
+func (s *Server) ContestTeamList(ctx echo.Context, id types.UUID) error {
+	res, err := s.contestTeamList.Execute(ctx.Request().Context(), &domain.ContestTeamListRequest{ContestID: id})
+	if err != nil {
+		return handleContestTeamError(ctx, err)
+	}
+
+	teams := openapi.ContestTeams{Teams: make([]openapi.ContestTeam, len(res.Teams))}
+	for i := range res.Teams {
+		teams.Teams[i] = contestTeamToAPI(&res.Teams[i])
+	}
+	return ctx.JSON(http.StatusOK, teams)
+}
+
+// Creates a team and joins it
+// (POST /contests/{id}/teams)
+func (s *Server) ContestTeamCreate(ctx echo.Context, id types.UUID) error {
+	var body openapi.ContestTeamCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	team, err := s.contestTeamCreate.Execute(ctx.Request().Context(), &domain.ContestTeamCreateRequest{
+		ContestID: id,
+		Name:      body.Name,
+	})
+	if err != nil {
+		return handleContestTeamError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, contestTeamToAPI(team))
+}
+
+// Joins a team, leaving the current team if any
+// (POST /contests/{id}/teams/{team_id}/membership)
+func (s *Server) ContestTeamJoin(ctx echo.Context, id types.UUID, teamId types.UUID) error {
+	err := s.contestTeamJoin.Execute(ctx.Request().Context(), &domain.ContestTeamJoinRequest{
+		ContestID: id,
+		TeamID:    teamId,
+	})
+	if err != nil {
+		return handleContestTeamError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Leaves the current team
+// (DELETE /contests/{id}/teams/membership)
+func (s *Server) ContestTeamLeave(ctx echo.Context, id types.UUID) error {
+	if err := s.contestTeamLeave.Execute(ctx.Request().Context(), &domain.ContestTeamLeaveRequest{ContestID: id}); err != nil {
+		return handleContestTeamError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Fetches the team leaderboard for a team contest
+// (GET /contests/{id}/leaderboard/teams)
+func (s *Server) ContestFetchTeamLeaderboard(ctx echo.Context, id types.UUID, params openapi.ContestFetchTeamLeaderboardParams) error {
+	req := &domain.ContestTeamLeaderboardFetchRequest{ContestID: id}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+
+	leaderboard, err := s.contestTeamLeaderboardFetch.Execute(ctx.Request().Context(), req)
+	if err != nil {
+		return handleContestTeamError(ctx, err)
+	}
+
+	res := openapi.TeamLeaderboard{
+		Entries:       make([]openapi.TeamLeaderboardEntry, len(leaderboard.Entries)),
+		NextPageToken: leaderboard.NextPageToken,
+		TotalSize:     leaderboard.TotalSize,
+	}
+	for i, entry := range leaderboard.Entries {
+		res.Entries[i] = openapi.TeamLeaderboardEntry{
+			Rank:        entry.Rank,
+			TeamId:      entry.TeamID,
+			TeamName:    entry.TeamName,
+			MemberCount: entry.MemberCount,
+			Score:       entry.Score,
+			IsTie:       entry.IsTie,
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func handleContestTeamError(ctx echo.Context, err error) error {
+	if handled, respErr := handleCommonErrors(ctx, err); handled {
+		return respErr
+	}
+	if errors.Is(err, domain.ErrInvalidContestTeam) {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	if errors.Is(err, domain.ErrContestTeamFull) {
+		return ctx.NoContent(http.StatusConflict)
+	}
+
+	ctx.Echo().Logger.Error("could not process request: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
+
+func contestTeamToAPI(team *domain.ContestTeam) openapi.ContestTeam {
+	res := openapi.ContestTeam{
+		Id:        team.ID,
+		ContestId: team.ContestID,
+		Name:      team.Name,
+		Members:   make([]openapi.ContestTeamMember, len(team.Members)),
+		CreatedAt: team.CreatedAt,
+	}
+	for i, m := range team.Members {
+		res.Members[i] = openapi.ContestTeamMember{
+			UserId:          m.UserID,
+			UserDisplayName: m.UserDisplayName,
+			JoinedAt:        m.JoinedAt,
+		}
+	}
+	return res
+}
+
+func teamScoreAggregationFromAPI(value *openapi.TeamScoreAggregation) domain.ContestTeamScoreAggregation {
+	if value == nil {
+		return ""
+	}
+	return domain.ContestTeamScoreAggregation(*value)
+}
+
+// teamScoreAggregationToAPI omits the aggregation for contests without teams.
+func teamScoreAggregationToAPI(teamMode bool, value domain.ContestTeamScoreAggregation) *openapi.TeamScoreAggregation {
+	if !teamMode {
+		return nil
+	}
+	res := openapi.TeamScoreAggregation(value)
+	return &res
+}
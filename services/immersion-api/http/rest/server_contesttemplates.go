@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists the contest templates of the current user, or all of them for admins
+// (GET /contest-templates)
+func (s *Server) ContestTemplateList(ctx echo.Context, params openapi.ContestTemplateListParams) error {
+	res, err := s.contestTemplateList.Execute(ctx.Request().Context(), &domain.ContestTemplateListRequest{
+		All: params.All != nil && *params.All,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	templates := make([]openapi.ContestTemplate, len(res.Templates))
+	for i := range res.Templates {
+		templates[i] = contestTemplateToAPI(&res.Templates[i])
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestTemplates{Templates: templates})
+}
+
+// Creates a template for a recurring contest series
+// (POST /contest-templates)
+func (s *Server) ContestTemplateCreate(ctx echo.Context) error {
+	var req openapi.ContestTemplateCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	template, err := s.contestTemplateCreate.Execute(ctx.Request().Context(), &domain.ContestTemplateCreateRequest{
+		TitlePattern:                    req.TitlePattern,
+		Description:                     req.Description,
+		Official:                        req.Official,
+		Private:                         req.Private,
+		DurationDays:                    req.DurationDays,
+		RegistrationClosesBeforeEndDays: req.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           req.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         req.ActivityTypeIdAllowList,
+		TeamMode:                        req.TeamMode != nil && *req.TeamMode,
+		TeamSizeLimit:                   req.TeamSizeLimit,
+		TeamScoreAggregation:            teamScoreAggregationFromAPI(req.TeamScoreAggregation),
+		ScoringRuleSetID:                req.ScoringRuleSetId,
+		Frequency:                       domain.ContestRecurrenceFrequency(req.Frequency),
+		IntervalCount:                   req.IntervalCount,
+		FirstContestStart:               req.FirstContestStart.Time,
+		LeadDays:                        req.LeadDays,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, contestTemplateToAPI(template))
+}
+
+// Fetches a contest template with the contests created from it
+// (GET /contest-templates/{id})
+func (s *Server) ContestTemplateFindByID(ctx echo.Context, id types.UUID) error {
+	res, err := s.contestTemplateFind.Execute(ctx.Request().Context(), &domain.ContestTemplateFindRequest{
+		ID: id,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	contests := make([]openapi.ContestTemplateContest, len(res.Contests))
+	for i, it := range res.Contests {
+		contests[i] = openapi.ContestTemplateContest{
+			Id:              it.ID,
+			Occurrence:      it.Occurrence,
+			Title:           it.Title,
+			ContestStart:    types.Date{Time: it.ContestStart},
+			ContestEnd:      types.Date{Time: it.ContestEnd},
+			RegistrationEnd: types.Date{Time: it.RegistrationEnd},
+			Private:         it.Private,
+			Deleted:         it.Deleted,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ContestTemplateDetails{
+		Template: contestTemplateToAPI(res.Template),
+		Contests: contests,
+	})
+}
+
+// Updates the settings of future contests of a series, or pauses it
+// (PUT /contest-templates/{id})
+func (s *Server) ContestTemplateUpdate(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestTemplateUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	template, err := s.contestTemplateUpdate.Execute(ctx.Request().Context(), &domain.ContestTemplateUpdateRequest{
+		ID:                              id,
+		TitlePattern:                    req.TitlePattern,
+		Description:                     req.Description,
+		Private:                         req.Private,
+		DurationDays:                    req.DurationDays,
+		RegistrationClosesBeforeEndDays: req.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           req.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         req.ActivityTypeIdAllowList,
+		TeamMode:                        req.TeamMode != nil && *req.TeamMode,
+		TeamSizeLimit:                   req.TeamSizeLimit,
+		TeamScoreAggregation:            teamScoreAggregationFromAPI(req.TeamScoreAggregation),
+		ScoringRuleSetID:                req.ScoringRuleSetId,
+		LeadDays:                        req.LeadDays,
+		Active:                          req.Active,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, contestTemplateToAPI(template))
+}
+
+func contestTemplateToAPI(template *domain.ContestTemplate) openapi.ContestTemplate {
+	return openapi.ContestTemplate{
+		Id:                              template.ID,
+		OwnerUserId:                     template.OwnerUserID,
+		OwnerUserDisplayName:            template.OwnerUserDisplayName,
+		TitlePattern:                    template.TitlePattern,
+		Description:                     template.Description,
+		Official:                        template.Official,
+		Private:                         template.Private,
+		DurationDays:                    template.DurationDays,
+		RegistrationClosesBeforeEndDays: template.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           template.LanguageCodeAllowList,
+		ActivityTypeIdAllowList:         template.ActivityTypeIDAllowList,
+		TeamMode:                        &template.TeamMode,
+		TeamSizeLimit:                   template.TeamSizeLimit,
+		TeamScoreAggregation:            teamScoreAggregationToAPI(template.TeamMode, template.TeamScoreAggregation),
+		ScoringRuleSetId:                template.ScoringRuleSetID,
+		Frequency:                       openapi.ContestRecurrenceFrequency(template.Frequency),
+		IntervalCount:                   template.IntervalCount,
+		FirstContestStart:               types.Date{Time: template.FirstContestStart},
+		LeadDays:                        template.LeadDays,
+		NextContestStart:                types.Date{Time: template.OccurrenceStart(template.NextOccurrence)},
+		Active:                          template.Active,
+		CreatedAt:                       template.CreatedAt,
+		UpdatedAt:                       template.UpdatedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Updates a contest
+// (PUT /contests/{id})
+func (s *Server) ContestUpdate(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	contest, err := s.contestUpdate.Execute(ctx.Request().Context(), &domain.ContestUpdateRequest{
+		ID:                      id,
+		ContestStart:            req.ContestStart.Time,
+		ContestEnd:              req.ContestEnd.Time,
+		RegistrationEnd:         req.RegistrationEnd.Time,
+		Title:                   req.Title,
+		Description:             req.Description,
+		Private:                 req.Private,
+		LanguageCodeAllowList:   req.LanguageCodeAllowList,
+		ActivityTypeIDAllowList: req.ActivityTypeIdAllowList,
+		Reason:                  req.Reason,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, contestViewToAPI(contest))
+}
+
+// Cancels a contest
+// (DELETE /contests/{id})
+func (s *Server) ContestCancel(ctx echo.Context, id types.UUID) error {
+	var req openapi.ContestCancelJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	err := s.contestCancel.Execute(ctx.Request().Context(), &domain.ContestCancelRequest{
+		ID:     id,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		return handleContestChangeError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func handleContestChangeError(ctx echo.Context, err error) error {
+	if handled, respErr := handleCommonErrors(ctx, err); handled {
+		return respErr
+	}
+	if errors.Is(err, domain.ErrInvalidContest) {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	ctx.Echo().Logger.Error("could not process request: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
@@ -0,0 +1,164 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Creates a new goal for the current user
+// (POST /goals)
+func (s *Server) GoalCreate(ctx echo.Context) error {
+	var body openapi.GoalCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	status, err := s.goalCreate.Execute(ctx.Request().Context(), &domain.GoalCreateRequest{
+		Title:        body.Title,
+		LanguageCode: optionalString(stringValue(body.LanguageCode)),
+		ActivityID:   intFromAPI(body.ActivityId),
+		UnitKey:      optionalString(stringValue(body.UnitKey)),
+		Tag:          optionalString(stringValue(body.Tag)),
+		Metric:       domain.GoalMetric(body.Metric),
+		Target:       body.Target,
+		Period:       domain.GoalPeriod(body.Period),
+		StartsOn:     dateFromAPI(body.StartsOn),
+		EndsOn:       dateFromAPI(body.EndsOn),
+	})
+	if err != nil {
+		return handleGoalError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, goalStatusToAPI(status))
+}
+
+// Fetches a goal with its current progress
+// (GET /goals/{id})
+func (s *Server) GoalFindByID(ctx echo.Context, id types.UUID) error {
+	status, err := s.goalFind.Execute(ctx.Request().Context(), &domain.GoalFindRequest{ID: id})
+	if err != nil {
+		return handleGoalError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, goalStatusToAPI(status))
+}
+
+// Updates an existing goal
+// (PUT /goals/{id})
+func (s *Server) GoalUpdate(ctx echo.Context, id types.UUID) error {
+	var body openapi.GoalUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	status, err := s.goalUpdate.Execute(ctx.Request().Context(), &domain.GoalUpdateRequest{
+		ID:           id,
+		Title:        body.Title,
+		LanguageCode: optionalString(stringValue(body.LanguageCode)),
+		ActivityID:   intFromAPI(body.ActivityId),
+		UnitKey:      optionalString(stringValue(body.UnitKey)),
+		Tag:          optionalString(stringValue(body.Tag)),
+		Metric:       domain.GoalMetric(body.Metric),
+		Target:       body.Target,
+		Period:       domain.GoalPeriod(body.Period),
+		StartsOn:     dateFromAPI(body.StartsOn),
+		EndsOn:       dateFromAPI(body.EndsOn),
+	})
+	if err != nil {
+		return handleGoalError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, goalStatusToAPI(status))
+}
+
+// Deletes a goal by id
+// (DELETE /goals/{id})
+func (s *Server) GoalDeleteByID(ctx echo.Context, id types.UUID) error {
+	if err := s.goalDelete.Execute(ctx.Request().Context(), &domain.GoalDeleteRequest{ID: id}); err != nil {
+		return handleGoalError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Lists the goals of a user with their current progress
+// (GET /users/{userId}/goals)
+func (s *Server) GoalListForUser(ctx echo.Context, userId types.UUID) error {
+	res, err := s.goalList.Execute(ctx.Request().Context(), &domain.GoalListRequest{UserID: userId})
+	if err != nil {
+		return handleGoalError(ctx, err)
+	}
+
+	goals := openapi.Goals{Goals: make([]openapi.Goal, len(res.Goals))}
+	for i := range res.Goals {
+		goals.Goals[i] = goalStatusToAPI(&res.Goals[i])
+	}
+	return ctx.JSON(http.StatusOK, goals)
+}
+
+func handleGoalError(ctx echo.Context, err error) error {
+	if handled, respErr := handleCommonErrors(ctx, err); handled {
+		return respErr
+	}
+	if errors.Is(err, domain.ErrInvalidGoal) {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	ctx.Echo().Logger.Error("could not process request: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
+
+func goalStatusToAPI(status *domain.GoalStatus) openapi.Goal {
+	goal := status.Goal
+	res := openapi.Goal{
+		Id:                  goal.ID,
+		UserId:              goal.UserID,
+		Title:               goal.Title,
+		LanguageCode:        goal.LanguageCode,
+		UnitKey:             goal.UnitKey,
+		Tag:                 goal.Tag,
+		Metric:              openapi.GoalMetric(goal.Metric),
+		Target:              goal.Target,
+		Period:              openapi.GoalPeriod(goal.Period),
+		PeriodStart:         status.PeriodStart,
+		PeriodEnd:           status.PeriodEnd,
+		Current:             status.Current,
+		Percentage:          status.Percentage,
+		Completed:           status.Completed,
+		OnTrack:             status.OnTrack,
+		ProjectedCompletion: status.ProjectedCompletion,
+		CreatedAt:           goal.CreatedAt,
+		UpdatedAt:           goal.UpdatedAt,
+	}
+	if goal.ActivityID != nil {
+		activityID := int32(*goal.ActivityID)
+		res.ActivityId = &activityID
+	}
+	if goal.StartsOn != nil {
+		res.StartsOn = &types.Date{Time: *goal.StartsOn}
+	}
+	if goal.EndsOn != nil {
+		res.EndsOn = &types.Date{Time: *goal.EndsOn}
+	}
+	return res
+}
+
+func intFromAPI(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(*value)
+	return &v
+}
+
+func dateFromAPI(value *types.Date) *time.Time {
+	if value == nil {
+		return nil
+	}
+	return &value.Time
+}
@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+const (
+	leaderboardStreamHeartbeat = 15 * time.Second
+	// leaderboardStreamRetry tells clients how long to wait before reconnecting.
+	leaderboardStreamRetry = 3 * time.Second
+)
+
+// Streams rank changes of a contest leaderboard
+// (GET /contests/{id}/leaderboard/stream)
+func (s *Server) ContestStreamLeaderboard(ctx echo.Context, id types.UUID, params openapi.ContestStreamLeaderboardParams) error {
+	return s.streamLeaderboard(ctx, domain.ContestLeaderboardBoard(id), params.LastEventID)
+}
+
+// Streams rank changes of the leaderboard for a given year
+// (GET /leaderboard/yearly/{year}/stream)
+func (s *Server) StreamLeaderboardForYear(ctx echo.Context, year int, params openapi.StreamLeaderboardForYearParams) error {
+	return s.streamLeaderboard(ctx, domain.YearlyLeaderboardBoard(year), params.LastEventID)
+}
+
+// Streams rank changes of the global leaderboard
+// (GET /leaderboard/global/stream)
+func (s *Server) StreamLeaderboardGlobal(ctx echo.Context, params openapi.StreamLeaderboardGlobalParams) error {
+	return s.streamLeaderboard(ctx, domain.GlobalLeaderboardBoard(), params.LastEventID)
+}
+
+// streamLeaderboard writes the events of a board as server-sent events until
+// the client disconnects or the subscription is dropped.
+func (s *Server) streamLeaderboard(ctx echo.Context, board domain.LeaderboardBoard, lastEventID *string) error {
+	reqCtx := ctx.Request().Context()
+
+	after := ""
+	if lastEventID != nil {
+		after = *lastEventID
+	}
+
+	sub, err := s.leaderboardStreamHub.Subscribe(reqCtx, board, after)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	defer sub.Close()
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Disables response buffering in nginx.
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(res, "retry: %d\n\n", leaderboardStreamRetry.Milliseconds()); err != nil {
+		return nil
+	}
+	lastSentID := after
+	for _, event := range sub.Replay {
+		if err := writeLeaderboardStreamEvent(res, event); err != nil {
+			return nil
+		}
+		if event.ID != "" {
+			lastSentID = event.ID
+		}
+	}
+	res.Flush()
+
+	heartbeat := time.NewTicker(leaderboardStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if lastSentID != "" && domain.CompareLeaderboardEventIDs(event.ID, lastSentID) <= 0 {
+				continue
+			}
+			if err := writeLeaderboardStreamEvent(res, event); err != nil {
+				return nil
+			}
+			lastSentID = event.ID
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		}
+		res.Flush()
+	}
+}
+
+func writeLeaderboardStreamEvent(w http.ResponseWriter, event domain.LeaderboardEvent) error {
+	payload := openapi.LeaderboardStreamEvent{
+		Type:   string(event.Type),
+		UserId: event.UserID,
+	}
+	if event.Type == domain.LeaderboardEventScore {
+		score := float32(event.Score)
+		rank := event.Rank
+		payload.Score = &score
+		payload.Rank = &rank
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	if event.ID != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", event.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
@@ -34,6 +34,7 @@ func (s *Server) LogCreate(ctx echo.Context) error {
 		DurationSeconds: req.DurationSeconds,
 		Tags:            req.Tags,
 		Description:     req.Description,
+		MediaID:         req.MediaId,
 	})
 	if err != nil {
 		if handled, respErr := handleCommonErrors(ctx, err); handled {
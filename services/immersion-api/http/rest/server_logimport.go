@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Imports logs in bulk from a CSV or JSON lines export
+// (POST /logs/import)
+func (s *Server) LogImport(ctx echo.Context) error {
+	var req openapi.LogImportJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	dryRun := false
+	if req.DryRun != nil {
+		dryRun = *req.DryRun
+	}
+
+	result, err := s.logImport.Execute(ctx.Request().Context(), &domain.LogImportRequest{
+		Format:  domain.LogImportFormat(req.Format),
+		Content: req.Content,
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		if errors.Is(err, domain.ErrInvalidLogImport) {
+			return ctx.NoContent(http.StatusBadRequest)
+		}
+
+		ctx.Echo().Logger.Error("could not import logs: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := openapi.LogImportReport{
+		DryRun:        result.DryRun,
+		AcceptedCount: result.AcceptedCount,
+		RejectedCount: result.RejectedCount,
+		Rows:          make([]openapi.LogImportRow, len(result.Rows)),
+	}
+	for i, row := range result.Rows {
+		response.Rows[i] = openapi.LogImportRow{
+			Row:      row.Row,
+			Accepted: row.Accepted,
+			Reason:   optionalString(row.Reason),
+			LogId:    row.LogID,
+			Contests: make([]openapi.ContestScoreEstimate, len(row.Contests)),
+		}
+		if row.Platform != nil {
+			platform := scoreEstimateToAPI(*row.Platform)
+			response.Rows[i].Platform = &platform
+		}
+		for j, contest := range row.Contests {
+			response.Rows[i].Contests[j] = openapi.ContestScoreEstimate{
+				RegistrationId: contest.RegistrationID,
+				ContestId:      contest.ContestID,
+				Estimate:       scoreEstimateToAPI(contest.Estimate),
+			}
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
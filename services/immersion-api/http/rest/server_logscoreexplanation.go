@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Explains how the platform and contest scores of a log were computed
+// (GET /logs/{id}/score-explanation)
+func (s *Server) LogScoreExplanation(ctx echo.Context, id uuid.UUID) error {
+	result, err := s.logScoreExplanation.Execute(ctx.Request().Context(), id)
+	if err != nil {
+		if handled, responseErr := handleCommonErrors(ctx, err); handled {
+			return responseErr
+		}
+		if errors.Is(err, domain.ErrInvalidLog) {
+			return ctx.NoContent(http.StatusBadRequest)
+		}
+		ctx.Echo().Logger.Error("could not explain log score: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := openapi.LogScoreExplanation{
+		LogId:    result.LogID,
+		Platform: scoreExplanationToAPI(result.Platform),
+		Contests: make([]openapi.ContestScoreExplanation, len(result.Contests)),
+	}
+	for i, contest := range result.Contests {
+		response.Contests[i] = openapi.ContestScoreExplanation{
+			RegistrationId: contest.RegistrationID,
+			ContestId:      contest.ContestID,
+			ContestTitle:   contest.ContestTitle,
+			Explanation:    scoreExplanationToAPI(contest.Explanation),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func scoreExplanationToAPI(explanation domain.ScoreExplanation) openapi.ScoreExplanation {
+	rules := make([]openapi.ScoreExplanationRule, len(explanation.Rules))
+	for i, rule := range explanation.Rules {
+		rules[i] = openapi.ScoreExplanationRule{
+			RuleId:      rule.RuleID,
+			Rate:        rule.Rate,
+			Description: optionalString(rule.Description),
+		}
+		if rule.Rule != nil {
+			apiRule := scoringRuleToAPI(*rule.Rule)
+			rules[i].Rule = &apiRule
+		}
+	}
+	result := openapi.ScoreExplanation{
+		Score:            explanation.Score,
+		Source:           openapi.ScoreExplanationSource(explanation.Source),
+		BaseValue:        explanation.BaseValue,
+		Legacy:           explanation.Legacy,
+		RuleSetId:        explanation.RuleSetID,
+		Fallback:         explanation.Fallback,
+		Rules:            rules,
+		CurrentScore:     explanation.CurrentScore,
+		CurrentRuleSetId: explanation.CurrentRuleSetID,
+		Changed:          explanation.Changed,
+	}
+	if explanation.RuleSetVersion != 0 {
+		result.RuleSetVersion = &explanation.RuleSetVersion
+	}
+	return result
+}
@@ -27,6 +27,7 @@ func (s *Server) LogUpdate(ctx echo.Context, id openapi_types.UUID) error {
 		DurationSeconds: req.DurationSeconds,
 		Tags:            req.Tags,
 		Description:     req.Description,
+		MediaID:         req.MediaId,
 	})
 	if err != nil {
 		if handled, respErr := handleCommonErrors(ctx, err); handled {
@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"net/http"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Searches the media library by title or ISBN
+// (GET /media)
+func (s *Server) MediaSearch(ctx echo.Context, params openapi.MediaSearchParams) error {
+	req := &domain.MediaSearchRequest{
+		Query:        params.Query,
+		LanguageCode: params.LanguageCode,
+	}
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	media, err := s.mediaLibrary.Search(ctx.Request().Context(), req)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := make([]openapi.Media, len(media))
+	for i := range media {
+		result[i] = mediaToAPI(&media[i])
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MediaList{
+		Media: result,
+	})
+}
+
+// Adds a media to the library
+// (POST /media)
+func (s *Server) MediaCreate(ctx echo.Context) error {
+	var req openapi.MediaCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	media, err := s.mediaLibrary.Create(ctx.Request().Context(), mediaSaveRequestFromAPI(req))
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, mediaToAPI(media))
+}
+
+// Lists the progress of the current user on the media they logged
+// (GET /media/progress)
+func (s *Server) MediaProgressList(ctx echo.Context) error {
+	progress, err := s.mediaLibrary.ListProgress(ctx.Request().Context())
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := make([]openapi.MediaProgress, len(progress))
+	for i := range progress {
+		result[i] = mediaProgressToAPI(&progress[i])
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MediaProgressList{
+		Media: result,
+	})
+}
+
+// Fetches a media with the progress of the current user
+// (GET /media/{id})
+func (s *Server) MediaFindByID(ctx echo.Context, id openapi_types.UUID) error {
+	progress, err := s.mediaLibrary.Find(ctx.Request().Context(), id)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, mediaProgressToAPI(progress))
+}
+
+// Updates a media, only its creator and admins can update it
+// (PUT /media/{id})
+func (s *Server) MediaUpdate(ctx echo.Context, id openapi_types.UUID) error {
+	var req openapi.MediaUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	media, err := s.mediaLibrary.Update(ctx.Request().Context(), id, mediaSaveRequestFromAPI(req))
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, mediaToAPI(media))
+}
+
+// Links the current user's existing logs to a media by their description
+// (POST /media/{id}/link-logs)
+func (s *Server) MediaLinkLogs(ctx echo.Context, id openapi_types.UUID) error {
+	var req openapi.MediaLinkLogsJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	linked, err := s.mediaLibrary.LinkLogs(ctx.Request().Context(), id, req.Descriptions)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.MediaLinkLogsResult{
+		LinkedLogs: linked,
+	})
+}
+
+func mediaSaveRequestFromAPI(req openapi.MediaInput) *domain.MediaSaveRequest {
+	res := &domain.MediaSaveRequest{
+		Title:        req.Title,
+		Type:         domain.MediaType(req.MediaType),
+		LanguageCode: req.LanguageCode,
+		TotalLength:  req.TotalLength,
+	}
+	if req.LengthUnit != nil {
+		unit := domain.MediaLengthUnit(*req.LengthUnit)
+		res.LengthUnit = &unit
+	}
+	if req.ExternalIds != nil {
+		res.ExternalIDs = *req.ExternalIds
+	}
+	return res
+}
+
+func mediaToAPI(media *domain.Media) openapi.Media {
+	res := openapi.Media{
+		Id:              media.ID,
+		Title:           media.Title,
+		MediaType:       openapi.MediaType(media.Type),
+		LanguageCode:    media.LanguageCode,
+		TotalLength:     media.TotalLength,
+		ExternalIds:     media.ExternalIDs,
+		CreatedByUserId: media.CreatedByUserID,
+		CreatedAt:       media.CreatedAt,
+		UpdatedAt:       media.UpdatedAt,
+	}
+	if res.ExternalIds == nil {
+		res.ExternalIds = map[string]string{}
+	}
+	if media.LengthUnit != nil {
+		unit := openapi.MediaLengthUnit(*media.LengthUnit)
+		res.LengthUnit = &unit
+	}
+	return res
+}
+
+func mediaProgressToAPI(progress *domain.MediaProgress) openapi.MediaProgress {
+	return openapi.MediaProgress{
+		Media:        mediaToAPI(&progress.Media),
+		LogCount:     progress.LogCount,
+		Progress:     progress.Progress,
+		LastLoggedAt: progress.LastLoggedAt,
+		Status:       string(progress.Status),
+	}
+}
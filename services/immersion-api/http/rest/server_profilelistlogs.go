@@ -64,6 +64,7 @@ func (s *Server) ProfileListLogs(ctx echo.Context, userId types.UUID, params ope
 			CreatedAt:       it.CreatedAt,
 			Deleted:         it.Deleted,
 			Description:     it.Description,
+			MediaId:         it.MediaID,
 		}
 	}
 
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Fetches the current and longest streaks of a user
+// (GET /users/{userId}/streaks)
+func (s *Server) ProfileStreaksByUserID(ctx echo.Context, userId types.UUID) error {
+	streaks, err := s.profileStreaks.Execute(ctx.Request().Context(), &domain.ProfileStreaksRequest{UserID: userId})
+	if err != nil {
+		ctx.Echo().Logger.Error("could not fetch streaks: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := openapi.UserStreaks{
+		Today:     types.Date{Time: streaks.Today},
+		GraceDays: streaks.GraceDays,
+		Overall:   streakToAPI(streaks.Overall),
+		Languages: make([]openapi.LanguageStreak, len(streaks.Languages)),
+	}
+	for i, it := range streaks.Languages {
+		res.Languages[i] = openapi.LanguageStreak{
+			Language: openapi.Language{
+				Code: it.LanguageCode,
+				Name: it.LanguageName,
+			},
+			Streak: streakToAPI(it.Streak),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func streakToAPI(streak domain.Streak) openapi.Streak {
+	res := openapi.Streak{
+		Current: streak.Current,
+		Longest: streak.Longest,
+	}
+	if streak.CurrentStartedOn != nil {
+		res.CurrentStartedOn = &types.Date{Time: *streak.CurrentStartedOn}
+	}
+	if streak.LastActiveOn != nil {
+		res.LastActiveOn = &types.Date{Time: *streak.LastActiveOn}
+	}
+	return res
+}
@@ -55,6 +55,14 @@ func (s *scorePreviewRepositoryStub) FindContestScoringRuleSets(context.Context,
 	return nil, nil, nil
 }
 
+func (s *scorePreviewRepositoryStub) FindScoringRuleUsage(context.Context, *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	return &domain.ScoringRuleUsage{}, nil
+}
+
+func (s *scorePreviewRepositoryStub) FindUserSettings(context.Context, uuid.UUID) (*domain.UserSettings, error) {
+	return nil, domain.ErrNotFound
+}
+
 func TestScorePreviewReturnsAppliedRules(t *testing.T) {
 	ruleSetID := uuid.New()
 	ruleID := uuid.New()
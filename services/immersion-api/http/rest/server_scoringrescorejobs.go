@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists recent rescoring jobs of the platform or of a contest
+// (GET /scoring/rescore-jobs)
+func (s *Server) ScoringRescoreJobList(ctx echo.Context, params openapi.ScoringRescoreJobListParams) error {
+	jobs, err := s.scoringRescoreJobManagement.List(ctx.Request().Context(), params.ContestId)
+	if err != nil {
+		return handleScoringRescoreJobError(ctx, err)
+	}
+
+	res := openapi.ScoringRescoreJobs{Jobs: make([]openapi.ScoringRescoreJob, len(jobs))}
+	for i := range jobs {
+		res.Jobs[i] = scoringRescoreJobToAPI(&jobs[i])
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Schedules rescoring existing logs, or a dry run reporting the per-user diff
+// (POST /scoring/rescore-jobs)
+func (s *Server) ScoringRescoreJobCreate(ctx echo.Context) error {
+	var body openapi.ScoringRescoreJobCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	job, err := s.scoringRescoreJobManagement.Create(ctx.Request().Context(), &domain.ScoringRescoreJobCreateRequest{
+		ContestID: body.ContestId,
+		RuleSetID: body.RuleSetId,
+		DryRun:    body.DryRun,
+	})
+	if err != nil {
+		return handleScoringRescoreJobError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, scoringRescoreJobToAPI(job))
+}
+
+// Fetches the progress of a rescoring job
+// (GET /scoring/rescore-jobs/{id})
+func (s *Server) ScoringRescoreJobFind(ctx echo.Context, id uuid.UUID) error {
+	job, err := s.scoringRescoreJobManagement.Find(ctx.Request().Context(), id)
+	if err != nil {
+		return handleScoringRescoreJobError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, scoringRescoreJobToAPI(job))
+}
+
+// Lists the per-user score changes of a rescoring job, largest first
+// (GET /scoring/rescore-jobs/{id}/diff)
+func (s *Server) ScoringRescoreJobDiff(ctx echo.Context, id uuid.UUID, params openapi.ScoringRescoreJobDiffParams) error {
+	req := &domain.ScoringRescoreJobDiffRequest{JobID: id}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+
+	diff, err := s.scoringRescoreJobManagement.Diff(ctx.Request().Context(), req)
+	if err != nil {
+		return handleScoringRescoreJobError(ctx, err)
+	}
+
+	res := openapi.ScoringRescoreJobDiff{
+		Users:         make([]openapi.ScoringRescoreUserDiff, len(diff.Users)),
+		TotalSize:     diff.TotalSize,
+		NextPageToken: diff.NextPageToken,
+	}
+	for i, user := range diff.Users {
+		res.Users[i] = openapi.ScoringRescoreUserDiff{
+			UserId:          user.UserID,
+			UserDisplayName: user.UserDisplayName,
+			ChangedLogs:     user.ChangedLogs,
+			ScoreBefore:     user.ScoreBefore,
+			ScoreAfter:      user.ScoreAfter,
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func handleScoringRescoreJobError(ctx echo.Context, err error) error {
+	if handled, responseErr := handleCommonErrors(ctx, err); handled {
+		return responseErr
+	}
+	if errors.Is(err, domain.ErrInvalidScoringRuleSet) {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	if errors.Is(err, domain.ErrScoringRuleSetNotFound) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	ctx.Echo().Logger.Error("could not manage scoring rescore job: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
+
+func scoringRescoreJobToAPI(job *domain.ScoringRescoreJob) openapi.ScoringRescoreJob {
+	return openapi.ScoringRescoreJob{
+		Id:            job.ID,
+		Scope:         openapi.ScoringRescoreJobScope(job.Scope),
+		ContestId:     job.ContestID,
+		RuleSetId:     job.RuleSetID,
+		DryRun:        job.DryRun,
+		Status:        openapi.ScoringRescoreJobStatus(job.Status),
+		TotalLogs:     job.TotalLogs,
+		ProcessedLogs: job.ProcessedLogs,
+		ChangedLogs:   job.ChangedLogs,
+		SkippedLogs:   job.SkippedLogs,
+		Progress:      job.Progress(),
+		LastError:     job.LastError,
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     job.UpdatedAt,
+		CompletedAt:   job.CompletedAt,
+	}
+}
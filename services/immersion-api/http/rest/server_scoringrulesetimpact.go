@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Compares a scoring rule-set to another one and simulates both against a sample of recent logs
+// (GET /scoring/rule-sets/{id}/impact)
+func (s *Server) ScoringRuleSetImpact(ctx echo.Context, id uuid.UUID, params openapi.ScoringRuleSetImpactParams) error {
+	req := &domain.ScoringRuleSetImpactRequest{
+		RuleSetID:     id,
+		BaseRuleSetID: params.BaseRuleSetId,
+	}
+	if params.SampleSize != nil {
+		req.SampleSize = *params.SampleSize
+	}
+
+	impact, err := s.scoringRuleSetImpact.Execute(ctx.Request().Context(), req)
+	if err != nil {
+		return handleScoringRuleSetImpactError(ctx, err)
+	}
+
+	diff := openapi.ScoringRuleSetDiff{
+		Added:           make([]openapi.ScoringRule, len(impact.Diff.Added)),
+		Removed:         make([]openapi.ScoringRule, len(impact.Diff.Removed)),
+		Changed:         make([]openapi.ScoringRuleChange, len(impact.Diff.Changed)),
+		UnchangedCount:  impact.Diff.UnchangedCount,
+		ModeChanged:     impact.Diff.ModeChanged,
+		FallbackChanged: impact.Diff.FallbackChanged,
+	}
+	for i, rule := range impact.Diff.Added {
+		diff.Added[i] = scoringRuleToAPI(rule)
+	}
+	for i, rule := range impact.Diff.Removed {
+		diff.Removed[i] = scoringRuleToAPI(rule)
+	}
+	for i, change := range impact.Diff.Changed {
+		diff.Changed[i] = openapi.ScoringRuleChange{
+			Before: scoringRuleToAPI(change.Before),
+			After:  scoringRuleToAPI(change.After),
+		}
+	}
+
+	simulation := openapi.ScoringImpactSimulation{
+		SampledLogs:   impact.Simulation.SampledLogs,
+		SkippedLogs:   impact.Simulation.SkippedLogs,
+		ChangedLogs:   impact.Simulation.ChangedLogs,
+		UnmatchedLogs: impact.Simulation.UnmatchedLogs,
+		ScoreBefore:   impact.Simulation.ScoreBefore,
+		ScoreAfter:    impact.Simulation.ScoreAfter,
+		ByActivity:    scoringImpactGroupsToAPI(impact.Simulation.ByActivity),
+		ByUnitKey:     scoringImpactGroupsToAPI(impact.Simulation.ByUnitKey),
+		ByLanguage:    scoringImpactGroupsToAPI(impact.Simulation.ByLanguage),
+		TopUsers:      make([]openapi.ScoringImpactUser, len(impact.Simulation.TopUsers)),
+	}
+	for i, user := range impact.Simulation.TopUsers {
+		simulation.TopUsers[i] = openapi.ScoringImpactUser{
+			UserId:          user.UserID,
+			UserDisplayName: user.UserDisplayName,
+			Logs:            user.Logs,
+			ChangedLogs:     user.ChangedLogs,
+			ScoreBefore:     user.ScoreBefore,
+			ScoreAfter:      user.ScoreAfter,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.ScoringRuleSetImpact{
+		BaseRuleSet: scoringRuleSetToAPI(*impact.BaseRuleSet),
+		RuleSet:     scoringRuleSetToAPI(*impact.RuleSet),
+		Diff:        diff,
+		Simulation:  simulation,
+	})
+}
+
+func handleScoringRuleSetImpactError(ctx echo.Context, err error) error {
+	if handled, responseErr := handleCommonErrors(ctx, err); handled {
+		return responseErr
+	}
+	if errors.Is(err, domain.ErrInvalidScoringRuleSet) {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	if errors.Is(err, domain.ErrScoringRuleSetNotFound) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	ctx.Echo().Logger.Error("could not simulate scoring rule set impact: ", err)
+	return ctx.NoContent(http.StatusInternalServerError)
+}
+
+func scoringImpactGroupsToAPI(groups []domain.ScoringImpactGroup) []openapi.ScoringImpactGroup {
+	result := make([]openapi.ScoringImpactGroup, len(groups))
+	for i, group := range groups {
+		result[i] = openapi.ScoringImpactGroup{
+			Key:         group.Key,
+			Logs:        group.Logs,
+			ChangedLogs: group.ChangedLogs,
+			ScoreBefore: group.ScoreBefore,
+			ScoreAfter:  group.ScoreAfter,
+		}
+	}
+	return result
+}
@@ -57,6 +57,45 @@ func (s *Server) ScoringRuleSetCreateContest(ctx echo.Context, id uuid.UUID) err
 	return ctx.JSON(http.StatusOK, scoringRuleSetToAPI(*ruleSet))
 }
 
+func (s *Server) ScoringRuleSetUpdateDraft(ctx echo.Context, id uuid.UUID) error {
+	var body openapi.ScoringRuleSetUpdateDraftJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	ruleSet, err := s.scoringRuleSetManagement.UpdateDraft(
+		ctx.Request().Context(),
+		id,
+		scoringRuleSetDraftToDomain(body),
+	)
+	if err != nil {
+		return handleScoringRuleSetError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, scoringRuleSetToAPI(*ruleSet))
+}
+
+func (s *Server) ScoringRuleSetDeleteDraft(ctx echo.Context, id uuid.UUID) error {
+	if err := s.scoringRuleSetManagement.DeleteDraft(ctx.Request().Context(), id); err != nil {
+		return handleScoringRuleSetError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) ScoringRuleSetClone(ctx echo.Context, id uuid.UUID) error {
+	ruleSet, err := s.scoringRuleSetManagement.Clone(ctx.Request().Context(), id)
+	if err != nil {
+		return handleScoringRuleSetError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, scoringRuleSetToAPI(*ruleSet))
+}
+
+func (s *Server) ScoringRuleSetArchive(ctx echo.Context, id uuid.UUID) error {
+	ruleSet, err := s.scoringRuleSetManagement.Archive(ctx.Request().Context(), id)
+	if err != nil {
+		return handleScoringRuleSetError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, scoringRuleSetToAPI(*ruleSet))
+}
+
 func (s *Server) ScoringRuleSetPublish(ctx echo.Context, id uuid.UUID) error {
 	ruleSet, err := s.scoringRuleSetManagement.Publish(ctx.Request().Context(), id)
 	if err != nil {
@@ -87,14 +126,29 @@ func scoringRuleSetDraftToDomain(body openapi.ScoringRuleSetDraft) *domain.Scori
 	rules := make([]domain.ScoringRule, len(body.Rules))
 	for i, rule := range body.Rules {
 		rules[i] = domain.ScoringRule{
-			Priority:     rule.Priority,
-			Stackable:    rule.Stackable,
-			ActivityID:   rule.ActivityId,
-			UnitKey:      stringValue(rule.UnitKey),
-			LanguageCode: stringValue(rule.LanguageCode),
-			Tag:          stringValue(rule.Tag),
-			ScoreSource:  domain.ScoreSource(rule.ScoreSource),
-			Rate:         rule.Rate,
+			Priority:       rule.Priority,
+			Stackable:      rule.Stackable,
+			ActivityID:     rule.ActivityId,
+			UnitKey:        stringValue(rule.UnitKey),
+			LanguageCode:   stringValue(rule.LanguageCode),
+			Tag:            stringValue(rule.Tag),
+			MinValue:       rule.MinValue,
+			MaxValue:       rule.MaxValue,
+			EffectiveFrom:  rule.EffectiveFrom,
+			EffectiveUntil: rule.EffectiveUntil,
+			ScoreSource:    domain.ScoreSource(rule.ScoreSource),
+			Rate:           rule.Rate,
+			CapValue:       rule.CapValue,
+			CapScore:       rule.CapScore,
+		}
+		if rule.Tags != nil {
+			rules[i].Tags = *rule.Tags
+		}
+		if rule.TagMatch != nil {
+			rules[i].TagMatch = domain.ScoringTagMatch(*rule.TagMatch)
+		}
+		if rule.CapPeriod != nil {
+			rules[i].CapPeriod = domain.ScoringCapPeriod(*rule.CapPeriod)
 		}
 	}
 	mode := ""
@@ -119,17 +173,7 @@ func scoringRuleSetsToAPI(ruleSets []domain.ScoringRuleSet) openapi.ScoringRuleS
 func scoringRuleSetToAPI(ruleSet domain.ScoringRuleSet) openapi.ScoringRuleSet {
 	rules := make([]openapi.ScoringRule, len(ruleSet.Rules))
 	for i, rule := range ruleSet.Rules {
-		rules[i] = openapi.ScoringRule{
-			Id:           &rule.ID,
-			Priority:     rule.Priority,
-			Stackable:    rule.Stackable,
-			ActivityId:   rule.ActivityID,
-			UnitKey:      optionalString(rule.UnitKey),
-			LanguageCode: optionalString(rule.LanguageCode),
-			Tag:          optionalString(rule.Tag),
-			ScoreSource:  openapi.ScoringRuleScoreSource(rule.ScoreSource),
-			Rate:         rule.Rate,
-		}
+		rules[i] = scoringRuleToAPI(rule)
 	}
 	var mode *openapi.ScoringRuleSetMode
 	if ruleSet.Mode != "" {
@@ -148,6 +192,7 @@ func scoringRuleSetToAPI(ruleSet domain.ScoringRuleSet) openapi.ScoringRuleSet {
 		Rules:             rules,
 		CreatedAt:         ruleSet.CreatedAt,
 		PublishedAt:       ruleSet.PublishedAt,
+		ArchivedAt:        ruleSet.ArchivedAt,
 	}
 }
 
@@ -164,3 +209,36 @@ func optionalString(value string) *string {
 	}
 	return &value
 }
+
+func scoringRuleToAPI(rule domain.ScoringRule) openapi.ScoringRule {
+	result := openapi.ScoringRule{
+		Id:             &rule.ID,
+		Priority:       rule.Priority,
+		Stackable:      rule.Stackable,
+		ActivityId:     rule.ActivityID,
+		UnitKey:        optionalString(rule.UnitKey),
+		LanguageCode:   optionalString(rule.LanguageCode),
+		Tag:            optionalString(rule.Tag),
+		MinValue:       rule.MinValue,
+		MaxValue:       rule.MaxValue,
+		EffectiveFrom:  rule.EffectiveFrom,
+		EffectiveUntil: rule.EffectiveUntil,
+		ScoreSource:    openapi.ScoringRuleScoreSource(rule.ScoreSource),
+		Rate:           rule.Rate,
+		CapValue:       rule.CapValue,
+		CapScore:       rule.CapScore,
+	}
+	if len(rule.Tags) > 0 {
+		tags := append([]string{}, rule.Tags...)
+		result.Tags = &tags
+	}
+	if rule.TagMatch != "" {
+		tagMatch := openapi.ScoringRuleTagMatch(rule.TagMatch)
+		result.TagMatch = &tagMatch
+	}
+	if rule.CapPeriod != "" {
+		capPeriod := openapi.ScoringRuleCapPeriod(rule.CapPeriod)
+		result.CapPeriod = &capPeriod
+	}
+	return result
+}
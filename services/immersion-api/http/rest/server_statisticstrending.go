@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists the most used tags and media across all users
+// (GET /statistics/trending)
+func (s *Server) StatisticsTrending(ctx echo.Context, params openapi.StatisticsTrendingParams) error {
+	req := &domain.TrendingStatisticsRequest{
+		LanguageCode: params.LanguageCode,
+	}
+	if params.Window != nil {
+		req.Window = domain.StatisticsWindow(*params.Window)
+	}
+
+	statistics, err := s.trendingStatisticsFetch.Execute(ctx.Request().Context(), req)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := openapi.TrendingStatistics{
+		LanguageCode: statistics.LanguageCode,
+		Window:       string(statistics.Window),
+		Since:        statistics.Since,
+		GeneratedAt:  statistics.GeneratedAt,
+		Tags:         tagStatisticsToAPI(statistics.Tags),
+		Media:        make([]openapi.MediaStatistic, len(statistics.Media)),
+	}
+	for i, it := range statistics.Media {
+		res.Media[i] = openapi.MediaStatistic{
+			MediaId:    it.MediaID,
+			Title:      it.Title,
+			MediaType:  openapi.MediaType(it.Type),
+			LogCount:   it.LogCount,
+			UserCount:  it.UserCount,
+			TotalScore: it.TotalScore,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func tagStatisticsToAPI(tags []domain.TagStatistic) []openapi.TagStatistic {
+	res := make([]openapi.TagStatistic, len(tags))
+	for i, it := range tags {
+		res[i] = openapi.TagStatistic{
+			Tag:        it.Tag,
+			LogCount:   it.LogCount,
+			UserCount:  it.UserCount,
+			TotalScore: it.TotalScore,
+		}
+	}
+	return res
+}
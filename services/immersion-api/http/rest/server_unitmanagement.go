@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Lists all units including deprecated ones (admin only)
+// (GET /units)
+func (s *Server) UnitList(ctx echo.Context) error {
+	units, err := s.unitManagement.List(ctx.Request().Context())
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := make([]openapi.Unit, len(units))
+	for i := range units {
+		result[i] = unitToAPI(&units[i])
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.Units{
+		Units: result,
+	})
+}
+
+// Adds a unit to the catalog and drafts a platform scoring rule for it (admin only)
+// (POST /units)
+func (s *Server) UnitCreate(ctx echo.Context) error {
+	var req openapi.UnitCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	result, err := s.unitManagement.Create(ctx.Request().Context(), &domain.UnitCreateRequest{
+		Key:             req.UnitKey,
+		ActivityID:      req.LogActivityId,
+		Name:            req.Name,
+		Modifier:        req.Modifier,
+		LanguageCode:    req.LanguageCode,
+		MediaLengthUnit: unitMediaLengthUnitFromAPI(req.MediaLengthUnit),
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.UnitCreateResult{
+		Unit:                  unitToAPI(result.Unit),
+		ScoringRuleSetDraftId: result.ScoringRuleSetDraftID,
+	})
+}
+
+// Updates the name, modifier or deprecation of a unit (admin only)
+// (PUT /units/{id})
+func (s *Server) UnitUpdate(ctx echo.Context, id uuid.UUID) error {
+	var req openapi.UnitUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	unit, err := s.unitManagement.Update(ctx.Request().Context(), &domain.UnitUpdateRequest{
+		ID:              id,
+		Name:            req.Name,
+		Modifier:        req.Modifier,
+		Deprecated:      req.Deprecated,
+		MediaLengthUnit: unitMediaLengthUnitFromAPI(req.MediaLengthUnit),
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, unitToAPI(unit))
+}
+
+// Deletes a unit, or deprecates it when logs reference it (admin only)
+// (DELETE /units/{id})
+func (s *Server) UnitDelete(ctx echo.Context, id uuid.UUID) error {
+	deprecated, err := s.unitManagement.Delete(ctx.Request().Context(), id)
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error(err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.UnitDeleteResult{
+		Deprecated: deprecated,
+	})
+}
+
+func unitToAPI(unit *domain.Unit) openapi.Unit {
+	res := openapi.Unit{
+		Id:            unit.ID,
+		UnitKey:       unit.Key,
+		LogActivityId: unit.LogActivityID,
+		Name:          unit.Name,
+		Modifier:      unit.Modifier,
+		LanguageCode:  unit.LanguageCode,
+		DeprecatedAt:  unit.DeprecatedAt,
+	}
+	if unit.MediaLengthUnit != nil {
+		lengthUnit := openapi.MediaLengthUnit(*unit.MediaLengthUnit)
+		res.MediaLengthUnit = &lengthUnit
+	}
+	return res
+}
+
+func unitMediaLengthUnitFromAPI(lengthUnit *openapi.MediaLengthUnit) *domain.MediaLengthUnit {
+	if lengthUnit == nil {
+		return nil
+	}
+	res := domain.MediaLengthUnit(*lengthUnit)
+	return &res
+}
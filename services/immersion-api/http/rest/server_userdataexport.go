@@ -0,0 +1,38 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+// Exports all logs, contest registrations and yearly scores of a user as a ZIP archive
+// (GET /users/{userId}/export)
+func (s *Server) UserDataExport(ctx echo.Context, userId openapi_types.UUID) error {
+	req := &domain.DataExportRequest{UserID: userId}
+
+	// Authorization is checked up front so errors can still be reported with a
+	// proper status code before the archive starts streaming.
+	if err := s.dataExport.Authorize(ctx.Request().Context(), req); err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+		ctx.Echo().Logger.Error("could not authorize data export: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "application/zip")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", s.dataExport.Filename(req)))
+	res.WriteHeader(http.StatusOK)
+
+	if err := s.dataExport.Execute(ctx.Request().Context(), req, res); err != nil {
+		// Headers are already sent, so the truncated archive is all the client
+		// will see.
+		ctx.Echo().Logger.Error("could not export user data: ", err)
+	}
+	return nil
+}
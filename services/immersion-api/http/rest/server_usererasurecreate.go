@@ -0,0 +1,38 @@
+package rest
+
+import (
+	"net/http"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Schedules the erasure of all personal data of a user
+// (POST /users/{userId}/erasure)
+func (s *Server) UserErasureCreate(ctx echo.Context, userId openapi_types.UUID) error {
+	erasure, err := s.userErase.Execute(ctx.Request().Context(), &domain.UserEraseRequest{UserID: userId})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not schedule user erasure: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := openapi.UserErasure{
+		Id:             erasure.ID,
+		Status:         openapi.UserErasureStatus(erasure.Status),
+		CompletedSteps: []openapi.UserErasureCompletedSteps{},
+		CreatedAt:      erasure.CreatedAt,
+		CompletedAt:    erasure.CompletedAt,
+	}
+	for _, step := range domain.UserErasureSteps {
+		if erasure.StepCompleted(step) {
+			response.CompletedSteps = append(response.CompletedSteps, openapi.UserErasureCompletedSteps(step))
+		}
+	}
+	return ctx.JSON(http.StatusAccepted, response)
+}
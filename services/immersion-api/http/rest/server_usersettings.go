@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/http/rest/openapi"
+)
+
+// Fetches the settings of a user
+// (GET /users/{userId}/settings)
+func (s *Server) UserSettingsFind(ctx echo.Context, userId types.UUID) error {
+	settings, err := s.userSettingsFind.Execute(ctx.Request().Context(), &domain.UserSettingsFindRequest{UserID: userId})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not fetch user settings: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, userSettingsToAPI(settings))
+}
+
+// Updates the settings of the current user
+// (PUT /users/{userId}/settings)
+func (s *Server) UserSettingsUpdate(ctx echo.Context, userId types.UUID) error {
+	var req openapi.UserSettingsUpdateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		ctx.Echo().Logger.Error("could not process request: ", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	settings, err := s.userSettingsUpdate.Execute(ctx.Request().Context(), &domain.UserSettingsUpdateRequest{
+		UserID:          userId,
+		Timezone:        req.Timezone,
+		StreakGraceDays: req.StreakGraceDays,
+	})
+	if err != nil {
+		if handled, respErr := handleCommonErrors(ctx, err); handled {
+			return respErr
+		}
+
+		ctx.Echo().Logger.Error("could not update user settings: ", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, userSettingsToAPI(settings))
+}
+
+func userSettingsToAPI(settings *domain.UserSettings) openapi.UserSettings {
+	return openapi.UserSettings{
+		Timezone:        settings.Timezone,
+		StreakGraceDays: settings.StreakGraceDays,
+	}
+}
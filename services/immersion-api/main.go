@@ -8,11 +8,15 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// user settings accept any IANA time zone, independent of the base image
+	_ "time/tzdata"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
 	commonroles "github.com/tadoku/tadoku/services/common/authz/roles"
+	commonauthz "github.com/tadoku/tadoku/services/common/client/authz"
 	ketoclient "github.com/tadoku/tadoku/services/common/client/keto"
+	"github.com/tadoku/tadoku/services/common/client/s2s"
 	"github.com/tadoku/tadoku/services/common/domain"
 	"github.com/tadoku/tadoku/services/common/health"
 	tadokumiddleware "github.com/tadoku/tadoku/services/common/middleware"
@@ -42,6 +46,7 @@ type Config struct {
 	KetoReadURL            string  `validate:"required" envconfig:"keto_read_url"`
 	KetoWriteURL           string  `validate:"required" envconfig:"keto_write_url"`
 	ValkeyURL              string  `validate:"required" envconfig:"valkey_url"`
+	AuthzURL               string  `validate:"required" envconfig:"authz_url"`
 	ServiceName            string  `envconfig:"service_name" default:"immersion-api"`
 	SentryDSN              string  `envconfig:"sentry_dns"`
 	SentryTracesSampleRate float64 `validate:"required_with=SentryDSN" envconfig:"sentry_traces_sample_rate"`
@@ -91,7 +96,8 @@ func main() {
 	}
 
 	leaderboardStore := valkeystore.NewLeaderboardStore(valkeyClient, clock)
-	leaderboardUpdater := immersiondomain.NewLeaderboardUpdater(leaderboardStore, postgresRepository)
+	leaderboardEventStore := valkeystore.NewLeaderboardEventStore(valkeyClient)
+	leaderboardUpdater := immersiondomain.NewLeaderboardUpdaterWithEvents(leaderboardStore, postgresRepository, leaderboardEventStore)
 	serviceMetrics := commonobservability.NewMetrics(psql, cfg.ServiceName)
 	scoringMetrics := observability.NewScoringShadowMetrics(serviceMetrics.Registry(), cfg.ScoringEngineEnabled)
 	scoringObserver := observability.NewScoringShadowObserver(
@@ -107,11 +113,42 @@ func main() {
 	}
 
 	// Start leaderboard outbox worker for async leaderboard sync
-	outboxWorker := immersiondomain.NewLeaderboardOutboxWorker(postgresRepository, leaderboardUpdater, clock, 500*time.Millisecond)
+	goalProgressUpdater := immersiondomain.NewGoalProgressUpdater(postgresRepository, clock)
+	outboxWorker := immersiondomain.NewLeaderboardOutboxWorker(postgresRepository, leaderboardUpdater, goalProgressUpdater, clock, 500*time.Millisecond)
 	workerCtx, workerCancel := context.WithCancel(context.Background())
 	defer workerCancel()
 	go outboxWorker.Run(workerCtx)
 
+	// Fan leaderboard updates published by any instance out to the open
+	// leaderboard streams of this instance
+	leaderboardStreamHub := immersiondomain.NewLeaderboardStreamHub(leaderboardEventStore, 64)
+	go leaderboardStreamHub.Run(workerCtx)
+
+	// Start user erasure worker, Keto tuples are removed through authz-api
+	authzClient := commonauthz.NewClientWithTransport(
+		cfg.AuthzURL,
+		s2s.NewAuthTransport(s2s.NewClient(cfg.OathkeeperURL), "authz-api", nil),
+	)
+	relationshipClient := ory.NewRelationshipClient(authzClient, "app", "tadoku")
+	erasureWorker := immersiondomain.NewUserErasureWorker(postgresRepository, leaderboardStore, relationshipClient, clock, time.Minute)
+	go erasureWorker.Run(workerCtx)
+
+	// Start contest template worker, creates upcoming contests of recurring series
+	contestTemplateWorker := immersiondomain.NewContestTemplateWorker(postgresRepository, clock, time.Hour)
+	go contestTemplateWorker.Run(workerCtx)
+
+	// Load the activity catalog and keep it in sync with changes made through
+	// other instances
+	if err := immersiondomain.RefreshActivityCatalog(workerCtx, postgresRepository); err != nil {
+		panic(fmt.Errorf("could not load activity catalog: %w", err))
+	}
+	activityCatalogWorker := immersiondomain.NewActivityCatalogWorker(postgresRepository, time.Minute)
+	go activityCatalogWorker.Run(workerCtx)
+
+	// Start scoring rescore worker, applies activated rule sets to existing logs
+	scoringRescoreWorker := immersiondomain.NewScoringRescoreWorker(postgresRepository, clock, 30*time.Second, 500)
+	go scoringRescoreWorker.Run(workerCtx)
+
 	e := echo.New()
 	e.Use(serviceMetrics.Middleware())
 	e.Use(middleware.Recover())
@@ -163,11 +200,11 @@ func main() {
 	profileYearlyScores := immersiondomain.NewProfileYearlyScores(postgresRepository)
 	profileFetch := immersiondomain.NewProfileFetch(kratosClient)
 	registrationListOngoing := immersiondomain.NewRegistrationListOngoing(postgresRepository, clock)
-	contestPermissionCheck := immersiondomain.NewContestPermissionCheck(postgresRepository, kratosClient, clock)
+	contestPermissionCheck := immersiondomain.NewContestPermissionCheck(postgresRepository, kratosClient, relationshipClient, clock)
 	logDelete := immersiondomain.NewLogDelete(postgresRepository, clock)
-	contestModerationDetachLog := immersiondomain.NewContestModerationDetachLog(postgresRepository)
+	contestModerationDetachLog := immersiondomain.NewContestModerationDetachLog(postgresRepository, relationshipClient)
 	userUpsert := immersiondomain.NewUserUpsert(postgresRepository)
-	registrationUpsert := immersiondomain.NewRegistrationUpsert(postgresRepository, userUpsert)
+	registrationUpsert := immersiondomain.NewRegistrationUpsert(postgresRepository, relationshipClient, clock, userUpsert)
 	logCreate := immersiondomain.NewLogCreateWithScoringObserver(postgresRepository, clock, userUpsert, cfg.ScoringEngineEnabled, scoringObserver)
 	logUpdate := immersiondomain.NewLogUpdateWithScoringObserver(postgresRepository, clock, cfg.ScoringEngineEnabled, scoringObserver)
 	contestCreate := immersiondomain.NewContestCreate(postgresRepository, clock, userUpsert)
@@ -177,7 +214,45 @@ func main() {
 	tagSuggestions := immersiondomain.NewTagSuggestions(postgresRepository)
 	logContestUpdate := immersiondomain.NewLogContestUpdateWithScoringEngine(postgresRepository, clock, cfg.ScoringEngineEnabled)
 	scorePreview := immersiondomain.NewScorePreview(postgresRepository, clock)
-	scoringRuleSetManagement := immersiondomain.NewScoringRuleSetManagement(postgresRepository, clock)
+	scoringRescoreJobManagement := immersiondomain.NewScoringRescoreJobManagement(postgresRepository, relationshipClient, clock, cfg.ScoringEngineEnabled)
+	scoringRuleSetManagement := immersiondomain.NewScoringRuleSetManagementWithRescoring(postgresRepository, relationshipClient, clock, scoringRescoreJobManagement)
+	scoringRuleSetImpact := immersiondomain.NewScoringRuleSetImpact(postgresRepository, relationshipClient, clock)
+	logScoreExplanation := immersiondomain.NewLogScoreExplanation(postgresRepository)
+	unitManagement := immersiondomain.NewUnitManagement(postgresRepository, scoringRuleSetManagement, clock)
+	activityManagement := immersiondomain.NewActivityManagement(postgresRepository)
+	mediaLibrary := immersiondomain.NewMediaLibrary(postgresRepository)
+	trendingStatisticsFetch := immersiondomain.NewTrendingStatisticsFetch(postgresRepository, clock)
+	logImport := immersiondomain.NewLogImport(postgresRepository, logCreate, clock)
+	dataExport := immersiondomain.NewDataExport(postgresRepository, clock)
+	userErase := immersiondomain.NewUserErase(postgresRepository, clock)
+	goalCreate := immersiondomain.NewGoalCreate(postgresRepository, clock)
+	goalUpdate := immersiondomain.NewGoalUpdate(postgresRepository, clock)
+	goalDelete := immersiondomain.NewGoalDelete(postgresRepository, clock)
+	goalFind := immersiondomain.NewGoalFind(postgresRepository, clock)
+	goalList := immersiondomain.NewGoalList(postgresRepository, clock)
+	userSettingsFind := immersiondomain.NewUserSettingsFind(postgresRepository)
+	userSettingsUpdate := immersiondomain.NewUserSettingsUpdate(postgresRepository, clock)
+	profileStreaks := immersiondomain.NewProfileStreaks(postgresRepository, clock)
+	contestTeamCreate := immersiondomain.NewContestTeamCreate(postgresRepository, clock)
+	contestTeamJoin := immersiondomain.NewContestTeamJoin(postgresRepository, clock)
+	contestTeamLeave := immersiondomain.NewContestTeamLeave(postgresRepository, clock)
+	contestTeamList := immersiondomain.NewContestTeamList(postgresRepository)
+	contestTeamLeaderboardFetch := immersiondomain.NewContestTeamLeaderboardFetch(postgresRepository, leaderboardStore)
+	contestUpdate := immersiondomain.NewContestUpdate(postgresRepository, clock)
+	contestCancel := immersiondomain.NewContestCancel(postgresRepository, clock)
+	contestInviteCodeCreate := immersiondomain.NewContestInviteCodeCreate(postgresRepository, clock)
+	contestInviteCodeList := immersiondomain.NewContestInviteCodeList(postgresRepository)
+	contestInviteCodeRevoke := immersiondomain.NewContestInviteCodeRevoke(postgresRepository)
+	contestInvitationCreate := immersiondomain.NewContestInvitationCreate(postgresRepository, relationshipClient)
+	contestInvitationList := immersiondomain.NewContestInvitationList(postgresRepository)
+	contestParticipantRemove := immersiondomain.NewContestParticipantRemove(postgresRepository, relationshipClient, relationshipClient)
+	contestOrganizerAdd := immersiondomain.NewContestOrganizerAdd(postgresRepository, relationshipClient)
+	contestOrganizerList := immersiondomain.NewContestOrganizerList(postgresRepository, relationshipClient)
+	contestOrganizerRemove := immersiondomain.NewContestOrganizerRemove(postgresRepository, relationshipClient)
+	contestTemplateCreate := immersiondomain.NewContestTemplateCreate(postgresRepository, clock, userUpsert)
+	contestTemplateList := immersiondomain.NewContestTemplateList(postgresRepository)
+	contestTemplateFind := immersiondomain.NewContestTemplateFind(postgresRepository)
+	contestTemplateUpdate := immersiondomain.NewContestTemplateUpdate(postgresRepository, clock)
 
 	server := rest.NewServer(
 		contestConfigurationOptions,
@@ -215,6 +290,45 @@ func main() {
 		logContestUpdate,
 		scorePreview,
 		scoringRuleSetManagement,
+		logImport,
+		dataExport,
+		userErase,
+		goalCreate,
+		goalUpdate,
+		goalDelete,
+		goalFind,
+		goalList,
+		userSettingsFind,
+		userSettingsUpdate,
+		profileStreaks,
+		contestTeamCreate,
+		contestTeamJoin,
+		contestTeamLeave,
+		contestTeamList,
+		contestTeamLeaderboardFetch,
+		contestUpdate,
+		contestCancel,
+		contestInviteCodeCreate,
+		contestInviteCodeList,
+		contestInviteCodeRevoke,
+		contestInvitationCreate,
+		contestInvitationList,
+		contestParticipantRemove,
+		contestOrganizerAdd,
+		contestOrganizerList,
+		contestOrganizerRemove,
+		contestTemplateCreate,
+		contestTemplateList,
+		contestTemplateFind,
+		contestTemplateUpdate,
+		scoringRescoreJobManagement,
+		scoringRuleSetImpact,
+		logScoreExplanation,
+		unitManagement,
+		activityManagement,
+		mediaLibrary,
+		trendingStatisticsFetch,
+		leaderboardStreamHub,
 	)
 
 	openapi.RegisterHandlersWithBaseURL(api, server, "")
@@ -239,6 +353,8 @@ func main() {
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
+	// Open leaderboard streams never finish on their own
+	leaderboardStreamHub.Close()
 	if err := e.Shutdown(ctx); err != nil {
 		slog.Error("could not gracefully shut down application server", "error", err)
 	}
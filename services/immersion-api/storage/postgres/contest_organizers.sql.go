@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: contest_organizers.sql
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createContestOrganizer = `-- name: CreateContestOrganizer :exec
+insert into contest_organizers (
+  contest_id,
+  user_id,
+  added_by_user_id
+) values (
+  $1,
+  $2,
+  $3
+) on conflict (contest_id, user_id) do nothing
+`
+
+type CreateContestOrganizerParams struct {
+	ContestID     uuid.UUID
+	UserID        uuid.UUID
+	AddedByUserID uuid.NullUUID
+}
+
+func (q *Queries) CreateContestOrganizer(ctx context.Context, arg CreateContestOrganizerParams) error {
+	_, err := q.db.ExecContext(ctx, createContestOrganizer, arg.ContestID, arg.UserID, arg.AddedByUserID)
+	return err
+}
+
+const deleteContestOrganizer = `-- name: DeleteContestOrganizer :execrows
+delete from contest_organizers
+where
+  contest_id = $1
+  and user_id = $2
+`
+
+type DeleteContestOrganizerParams struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+}
+
+func (q *Queries) DeleteContestOrganizer(ctx context.Context, arg DeleteContestOrganizerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteContestOrganizer, arg.ContestID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteContestOrganizersForUser = `-- name: DeleteContestOrganizersForUser :exec
+delete from contest_organizers
+where user_id = $1
+`
+
+func (q *Queries) DeleteContestOrganizersForUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteContestOrganizersForUser, userID)
+	return err
+}
+
+const listContestOrganizers = `-- name: ListContestOrganizers :many
+select
+  contest_organizers.contest_id,
+  contest_organizers.user_id,
+  coalesce(users.display_name, '')::varchar as user_display_name,
+  contest_organizers.added_by_user_id,
+  contest_organizers.created_at
+from contest_organizers
+left join users on (users.id = contest_organizers.user_id)
+where contest_organizers.contest_id = $1
+order by contest_organizers.created_at asc
+`
+
+type ListContestOrganizersRow struct {
+	ContestID       uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	AddedByUserID   uuid.NullUUID
+	CreatedAt       time.Time
+}
+
+func (q *Queries) ListContestOrganizers(ctx context.Context, contestID uuid.UUID) ([]ListContestOrganizersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listContestOrganizers, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListContestOrganizersRow
+	for rows.Next() {
+		var i ListContestOrganizersRow
+		if err := rows.Scan(
+			&i.ContestID,
+			&i.UserID,
+			&i.UserDisplayName,
+			&i.AddedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizedContestIDsForUser = `-- name: ListOrganizedContestIDsForUser :many
+select contest_id
+from contest_organizers
+where user_id = $1
+`
+
+func (q *Queries) ListOrganizedContestIDsForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizedContestIDsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var contest_id uuid.UUID
+		if err := rows.Scan(&contest_id); err != nil {
+			return nil, err
+		}
+		items = append(items, contest_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,395 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: contest_teams.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const contestTeamNameExists = `-- name: ContestTeamNameExists :one
+select exists(
+  select 1
+  from contest_teams
+  where
+    contest_id = $1
+    and lower("name") = lower($2)
+)
+`
+
+type ContestTeamNameExistsParams struct {
+	ContestID uuid.UUID
+	Name      string
+}
+
+func (q *Queries) ContestTeamNameExists(ctx context.Context, arg ContestTeamNameExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, contestTeamNameExists, arg.ContestID, arg.Name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const contestTeamScores = `-- name: ContestTeamScores :many
+select
+  contest_teams.id as team_id,
+  count(members.user_id)::integer as member_count,
+  coalesce(sum(scores.score), 0)::real as total_score
+from contest_teams
+left join contest_team_members members
+  on members.team_id = contest_teams.id
+  and exists (
+    select 1
+    from contest_registrations cr
+    where
+      cr.contest_id = members.contest_id
+      and cr.user_id = members.user_id
+      and cr.deleted_at is null
+  )
+left join (
+  select
+    logs.user_id,
+    sum(coalesce(contest_logs.computed_score, contest_logs.score)) as score
+  from contest_logs
+  inner join logs on logs.id = contest_logs.log_id
+  where
+    contest_logs.contest_id = $1
+    and logs.deleted_at is null
+  group by logs.user_id
+) scores on scores.user_id = members.user_id
+where contest_teams.contest_id = $1
+group by contest_teams.id
+`
+
+type ContestTeamScoresRow struct {
+	TeamID      uuid.UUID
+	MemberCount int32
+	TotalScore  float32
+}
+
+// Returns the summed member scores of every team in a contest. Members are
+// only counted while they are registered for the contest.
+func (q *Queries) ContestTeamScores(ctx context.Context, contestID uuid.UUID) ([]ContestTeamScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, contestTeamScores, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContestTeamScoresRow
+	for rows.Next() {
+		var i ContestTeamScoresRow
+		if err := rows.Scan(&i.TeamID, &i.MemberCount, &i.TotalScore); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countContestTeamMembers = `-- name: CountContestTeamMembers :one
+select count(*)::integer
+from contest_team_members
+where
+  team_id = $1
+  and user_id != $2
+`
+
+type CountContestTeamMembersParams struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) CountContestTeamMembers(ctx context.Context, arg CountContestTeamMembersParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countContestTeamMembers, arg.TeamID, arg.UserID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createContestTeam = `-- name: CreateContestTeam :one
+insert into contest_teams (
+  contest_id,
+  "name"
+) values (
+  $1,
+  $2
+) returning id
+`
+
+type CreateContestTeamParams struct {
+	ContestID uuid.UUID
+	Name      string
+}
+
+func (q *Queries) CreateContestTeam(ctx context.Context, arg CreateContestTeamParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createContestTeam, arg.ContestID, arg.Name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteContestTeamIfEmpty = `-- name: DeleteContestTeamIfEmpty :exec
+delete from contest_teams
+where
+  id = $1
+  and not exists (
+    select 1
+    from contest_team_members
+    where team_id = contest_teams.id
+  )
+`
+
+func (q *Queries) DeleteContestTeamIfEmpty(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteContestTeamIfEmpty, id)
+	return err
+}
+
+const deleteContestTeamMember = `-- name: DeleteContestTeamMember :one
+delete from contest_team_members
+where
+  contest_id = $1
+  and user_id = $2
+returning team_id
+`
+
+type DeleteContestTeamMemberParams struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+}
+
+func (q *Queries) DeleteContestTeamMember(ctx context.Context, arg DeleteContestTeamMemberParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, deleteContestTeamMember, arg.ContestID, arg.UserID)
+	var team_id uuid.UUID
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const eraseUserContestTeamMemberships = `-- name: EraseUserContestTeamMemberships :many
+delete from contest_team_members
+where user_id = $1
+returning contest_id, team_id
+`
+
+type EraseUserContestTeamMembershipsRow struct {
+	ContestID uuid.UUID
+	TeamID    uuid.UUID
+}
+
+func (q *Queries) EraseUserContestTeamMemberships(ctx context.Context, userID uuid.UUID) ([]EraseUserContestTeamMembershipsRow, error) {
+	rows, err := q.db.QueryContext(ctx, eraseUserContestTeamMemberships, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EraseUserContestTeamMembershipsRow
+	for rows.Next() {
+		var i EraseUserContestTeamMembershipsRow
+		if err := rows.Scan(&i.ContestID, &i.TeamID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findContestTeamIDForUser = `-- name: FindContestTeamIDForUser :one
+select team_id
+from contest_team_members
+where
+  contest_id = $1
+  and user_id = $2
+`
+
+type FindContestTeamIDForUserParams struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+}
+
+func (q *Queries) FindContestTeamIDForUser(ctx context.Context, arg FindContestTeamIDForUserParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, findContestTeamIDForUser, arg.ContestID, arg.UserID)
+	var team_id uuid.UUID
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const findContestTeamSettings = `-- name: FindContestTeamSettings :one
+select
+  team_mode,
+  team_size_limit,
+  team_score_aggregation
+from contests
+where
+  id = $1
+  and deleted_at is null
+`
+
+type FindContestTeamSettingsRow struct {
+	TeamMode             bool
+	TeamSizeLimit        sql.NullInt16
+	TeamScoreAggregation string
+}
+
+func (q *Queries) FindContestTeamSettings(ctx context.Context, id uuid.UUID) (FindContestTeamSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, findContestTeamSettings, id)
+	var i FindContestTeamSettingsRow
+	err := row.Scan(&i.TeamMode, &i.TeamSizeLimit, &i.TeamScoreAggregation)
+	return i, err
+}
+
+const listContestTeamMembers = `-- name: ListContestTeamMembers :many
+select
+  contest_team_members.team_id,
+  contest_team_members.user_id,
+  users.display_name as user_display_name,
+  contest_team_members.joined_at
+from contest_team_members
+inner join users on users.id = contest_team_members.user_id
+where contest_team_members.contest_id = $1
+order by contest_team_members.joined_at asc
+`
+
+type ListContestTeamMembersRow struct {
+	TeamID          uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	JoinedAt        time.Time
+}
+
+func (q *Queries) ListContestTeamMembers(ctx context.Context, contestID uuid.UUID) ([]ListContestTeamMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listContestTeamMembers, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListContestTeamMembersRow
+	for rows.Next() {
+		var i ListContestTeamMembersRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.UserID,
+			&i.UserDisplayName,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContestTeams = `-- name: ListContestTeams :many
+select
+  contest_teams.id,
+  contest_teams.contest_id,
+  contest_teams."name",
+  contest_teams.created_at
+from contest_teams
+where contest_teams.contest_id = $1
+order by lower(contest_teams."name") asc
+`
+
+type ListContestTeamsRow struct {
+	ID        uuid.UUID
+	ContestID uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListContestTeams(ctx context.Context, contestID uuid.UUID) ([]ListContestTeamsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listContestTeams, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListContestTeamsRow
+	for rows.Next() {
+		var i ListContestTeamsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ContestID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockContestTeam = `-- name: LockContestTeam :one
+select id
+from contest_teams
+where
+  id = $1
+  and contest_id = $2
+for update
+`
+
+type LockContestTeamParams struct {
+	ID        uuid.UUID
+	ContestID uuid.UUID
+}
+
+// Locks the team so concurrent joins cannot exceed the team size limit.
+func (q *Queries) LockContestTeam(ctx context.Context, arg LockContestTeamParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, lockContestTeam, arg.ID, arg.ContestID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const upsertContestTeamMember = `-- name: UpsertContestTeamMember :exec
+insert into contest_team_members (
+  contest_id,
+  user_id,
+  team_id
+) values (
+  $1,
+  $2,
+  $3
+) on conflict (contest_id, user_id) do
+update set
+  team_id = $3,
+  joined_at = now()
+`
+
+type UpsertContestTeamMemberParams struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+	TeamID    uuid.UUID
+}
+
+func (q *Queries) UpsertContestTeamMember(ctx context.Context, arg UpsertContestTeamMemberParams) error {
+	_, err := q.db.ExecContext(ctx, upsertContestTeamMember, arg.ContestID, arg.UserID, arg.TeamID)
+	return err
+}
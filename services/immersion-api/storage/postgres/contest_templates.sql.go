@@ -0,0 +1,606 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: contest_templates.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const advanceContestTemplate = `-- name: AdvanceContestTemplate :execrows
+update contest_templates
+set
+  next_occurrence = $1,
+  updated_at = $2
+where
+  id = $3
+  and next_occurrence = $4
+`
+
+type AdvanceContestTemplateParams struct {
+	NextOccurrence    int32
+	Now               time.Time
+	ID                uuid.UUID
+	CurrentOccurrence int32
+}
+
+func (q *Queries) AdvanceContestTemplate(ctx context.Context, arg AdvanceContestTemplateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, advanceContestTemplate,
+		arg.NextOccurrence,
+		arg.Now,
+		arg.ID,
+		arg.CurrentOccurrence,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const copyScoringRuleSetToContest = `-- name: CopyScoringRuleSetToContest :one
+insert into scoring_rule_sets (
+  scope,
+  contest_id,
+  version,
+  status,
+  mode,
+  fallback_rule_set_id,
+  published_at
+) select
+  'contest',
+  $1::uuid,
+  1,
+  'published',
+  mode,
+  fallback_rule_set_id,
+  $2::timestamp
+from scoring_rule_sets as source
+where source.id = $3
+returning id
+`
+
+type CopyScoringRuleSetToContestParams struct {
+	ContestID       uuid.UUID
+	PublishedAt     time.Time
+	SourceRuleSetID uuid.UUID
+}
+
+func (q *Queries) CopyScoringRuleSetToContest(ctx context.Context, arg CopyScoringRuleSetToContestParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, copyScoringRuleSetToContest, arg.ContestID, arg.PublishedAt, arg.SourceRuleSetID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const copyScoringRules = `-- name: CopyScoringRules :exec
+insert into scoring_rules (
+  rule_set_id,
+  priority,
+  stackable,
+  activity_id,
+  unit_key,
+  language_code,
+  tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
+  score_source,
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
+) select
+  $1,
+  priority,
+  stackable,
+  activity_id,
+  unit_key,
+  language_code,
+  tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
+  score_source,
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
+from scoring_rules as source
+where source.rule_set_id = $2
+`
+
+type CopyScoringRulesParams struct {
+	RuleSetID       uuid.UUID
+	SourceRuleSetID uuid.UUID
+}
+
+func (q *Queries) CopyScoringRules(ctx context.Context, arg CopyScoringRulesParams) error {
+	_, err := q.db.ExecContext(ctx, copyScoringRules, arg.RuleSetID, arg.SourceRuleSetID)
+	return err
+}
+
+const createContestFromTemplate = `-- name: CreateContestFromTemplate :one
+insert into contests (
+  owner_user_id,
+  owner_user_display_name,
+  official,
+  "private",
+  contest_start,
+  contest_end,
+  registration_end,
+  title,
+  "description",
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template_id,
+  template_occurrence
+) select
+  owner_user_id,
+  owner_user_display_name,
+  official,
+  "private",
+  $1,
+  $2,
+  $3,
+  $4,
+  "description",
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template.id,
+  $5::integer
+from contest_templates as template
+where template.id = $6
+on conflict do nothing
+returning id
+`
+
+type CreateContestFromTemplateParams struct {
+	ContestStart    time.Time
+	ContestEnd      time.Time
+	RegistrationEnd time.Time
+	Title           string
+	Occurrence      int32
+	TemplateID      uuid.UUID
+}
+
+func (q *Queries) CreateContestFromTemplate(ctx context.Context, arg CreateContestFromTemplateParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createContestFromTemplate,
+		arg.ContestStart,
+		arg.ContestEnd,
+		arg.RegistrationEnd,
+		arg.Title,
+		arg.Occurrence,
+		arg.TemplateID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createContestTemplate = `-- name: CreateContestTemplate :one
+insert into contest_templates (
+  owner_user_id,
+  owner_user_display_name,
+  title_pattern,
+  "description",
+  official,
+  "private",
+  duration_days,
+  registration_closes_before_end_days,
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  scoring_rule_set_id,
+  frequency,
+  interval_count,
+  first_contest_start,
+  lead_days
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17,
+  $18
+) returning id, owner_user_id, owner_user_display_name, title_pattern, description, official, private, duration_days, registration_closes_before_end_days, language_code_allow_list, activity_type_id_allow_list, team_mode, team_size_limit, team_score_aggregation, scoring_rule_set_id, frequency, interval_count, first_contest_start, lead_days, next_occurrence, active, created_at, updated_at
+`
+
+type CreateContestTemplateParams struct {
+	OwnerUserID                     uuid.UUID
+	OwnerUserDisplayName            string
+	TitlePattern                    string
+	Description                     sql.NullString
+	Official                        bool
+	Private                         bool
+	DurationDays                    sql.NullInt32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   sql.NullInt16
+	TeamScoreAggregation            string
+	ScoringRuleSetID                uuid.NullUUID
+	Frequency                       string
+	IntervalCount                   int32
+	FirstContestStart               time.Time
+	LeadDays                        int32
+}
+
+func (q *Queries) CreateContestTemplate(ctx context.Context, arg CreateContestTemplateParams) (ContestTemplate, error) {
+	row := q.db.QueryRowContext(ctx, createContestTemplate,
+		arg.OwnerUserID,
+		arg.OwnerUserDisplayName,
+		arg.TitlePattern,
+		arg.Description,
+		arg.Official,
+		arg.Private,
+		arg.DurationDays,
+		arg.RegistrationClosesBeforeEndDays,
+		pq.Array(arg.LanguageCodeAllowList),
+		pq.Array(arg.ActivityTypeIDAllowList),
+		arg.TeamMode,
+		arg.TeamSizeLimit,
+		arg.TeamScoreAggregation,
+		arg.ScoringRuleSetID,
+		arg.Frequency,
+		arg.IntervalCount,
+		arg.FirstContestStart,
+		arg.LeadDays,
+	)
+	var i ContestTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerUserID,
+		&i.OwnerUserDisplayName,
+		&i.TitlePattern,
+		&i.Description,
+		&i.Official,
+		&i.Private,
+		&i.DurationDays,
+		&i.RegistrationClosesBeforeEndDays,
+		pq.Array(&i.LanguageCodeAllowList),
+		pq.Array(&i.ActivityTypeIDAllowList),
+		&i.TeamMode,
+		&i.TeamSizeLimit,
+		&i.TeamScoreAggregation,
+		&i.ScoringRuleSetID,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.FirstContestStart,
+		&i.LeadDays,
+		&i.NextOccurrence,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findContestTemplateByID = `-- name: FindContestTemplateByID :one
+select id, owner_user_id, owner_user_display_name, title_pattern, description, official, private, duration_days, registration_closes_before_end_days, language_code_allow_list, activity_type_id_allow_list, team_mode, team_size_limit, team_score_aggregation, scoring_rule_set_id, frequency, interval_count, first_contest_start, lead_days, next_occurrence, active, created_at, updated_at
+from contest_templates
+where id = $1
+`
+
+func (q *Queries) FindContestTemplateByID(ctx context.Context, id uuid.UUID) (ContestTemplate, error) {
+	row := q.db.QueryRowContext(ctx, findContestTemplateByID, id)
+	var i ContestTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerUserID,
+		&i.OwnerUserDisplayName,
+		&i.TitlePattern,
+		&i.Description,
+		&i.Official,
+		&i.Private,
+		&i.DurationDays,
+		&i.RegistrationClosesBeforeEndDays,
+		pq.Array(&i.LanguageCodeAllowList),
+		pq.Array(&i.ActivityTypeIDAllowList),
+		&i.TeamMode,
+		&i.TeamSizeLimit,
+		&i.TeamScoreAggregation,
+		&i.ScoringRuleSetID,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.FirstContestStart,
+		&i.LeadDays,
+		&i.NextOccurrence,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveContestTemplates = `-- name: ListActiveContestTemplates :many
+select id, owner_user_id, owner_user_display_name, title_pattern, description, official, private, duration_days, registration_closes_before_end_days, language_code_allow_list, activity_type_id_allow_list, team_mode, team_size_limit, team_score_aggregation, scoring_rule_set_id, frequency, interval_count, first_contest_start, lead_days, next_occurrence, active, created_at, updated_at
+from contest_templates
+where active
+order by created_at asc
+`
+
+func (q *Queries) ListActiveContestTemplates(ctx context.Context) ([]ContestTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveContestTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContestTemplate
+	for rows.Next() {
+		var i ContestTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerUserID,
+			&i.OwnerUserDisplayName,
+			&i.TitlePattern,
+			&i.Description,
+			&i.Official,
+			&i.Private,
+			&i.DurationDays,
+			&i.RegistrationClosesBeforeEndDays,
+			pq.Array(&i.LanguageCodeAllowList),
+			pq.Array(&i.ActivityTypeIDAllowList),
+			&i.TeamMode,
+			&i.TeamSizeLimit,
+			&i.TeamScoreAggregation,
+			&i.ScoringRuleSetID,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.FirstContestStart,
+			&i.LeadDays,
+			&i.NextOccurrence,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContestTemplates = `-- name: ListContestTemplates :many
+select id, owner_user_id, owner_user_display_name, title_pattern, description, official, private, duration_days, registration_closes_before_end_days, language_code_allow_list, activity_type_id_allow_list, team_mode, team_size_limit, team_score_aggregation, scoring_rule_set_id, frequency, interval_count, first_contest_start, lead_days, next_occurrence, active, created_at, updated_at
+from contest_templates
+where (owner_user_id = $1 or $1 is null)
+order by created_at desc
+`
+
+func (q *Queries) ListContestTemplates(ctx context.Context, userID uuid.NullUUID) ([]ContestTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listContestTemplates, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContestTemplate
+	for rows.Next() {
+		var i ContestTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerUserID,
+			&i.OwnerUserDisplayName,
+			&i.TitlePattern,
+			&i.Description,
+			&i.Official,
+			&i.Private,
+			&i.DurationDays,
+			&i.RegistrationClosesBeforeEndDays,
+			pq.Array(&i.LanguageCodeAllowList),
+			pq.Array(&i.ActivityTypeIDAllowList),
+			&i.TeamMode,
+			&i.TeamSizeLimit,
+			&i.TeamScoreAggregation,
+			&i.ScoringRuleSetID,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.FirstContestStart,
+			&i.LeadDays,
+			&i.NextOccurrence,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContestsForTemplate = `-- name: ListContestsForTemplate :many
+select
+  contests.id,
+  contests.template_occurrence,
+  contests.title,
+  contests.contest_start,
+  contests.contest_end,
+  contests.registration_end,
+  contests."private",
+  contests.deleted_at
+from contests
+where
+  contests.template_id = $1::uuid
+  and ($2::boolean or contests."private" = false)
+order by contests.template_occurrence desc
+`
+
+type ListContestsForTemplateParams struct {
+	TemplateID     uuid.UUID
+	IncludePrivate bool
+}
+
+type ListContestsForTemplateRow struct {
+	ID                 uuid.UUID
+	TemplateOccurrence sql.NullInt32
+	Title              string
+	ContestStart       time.Time
+	ContestEnd         time.Time
+	RegistrationEnd    time.Time
+	Private            bool
+	DeletedAt          sql.NullTime
+}
+
+func (q *Queries) ListContestsForTemplate(ctx context.Context, arg ListContestsForTemplateParams) ([]ListContestsForTemplateRow, error) {
+	rows, err := q.db.QueryContext(ctx, listContestsForTemplate, arg.TemplateID, arg.IncludePrivate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListContestsForTemplateRow
+	for rows.Next() {
+		var i ListContestsForTemplateRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateOccurrence,
+			&i.Title,
+			&i.ContestStart,
+			&i.ContestEnd,
+			&i.RegistrationEnd,
+			&i.Private,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateContestTemplate = `-- name: UpdateContestTemplate :one
+update contest_templates
+set
+  title_pattern = $1,
+  "description" = $2,
+  "private" = $3,
+  duration_days = $4,
+  registration_closes_before_end_days = $5,
+  language_code_allow_list = $6,
+  activity_type_id_allow_list = $7,
+  team_mode = $8,
+  team_size_limit = $9,
+  team_score_aggregation = $10,
+  scoring_rule_set_id = $11,
+  lead_days = $12,
+  active = $13,
+  updated_at = $14
+where id = $15
+returning id, owner_user_id, owner_user_display_name, title_pattern, description, official, private, duration_days, registration_closes_before_end_days, language_code_allow_list, activity_type_id_allow_list, team_mode, team_size_limit, team_score_aggregation, scoring_rule_set_id, frequency, interval_count, first_contest_start, lead_days, next_occurrence, active, created_at, updated_at
+`
+
+type UpdateContestTemplateParams struct {
+	TitlePattern                    string
+	Description                     sql.NullString
+	Private                         bool
+	DurationDays                    sql.NullInt32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   sql.NullInt16
+	TeamScoreAggregation            string
+	ScoringRuleSetID                uuid.NullUUID
+	LeadDays                        int32
+	Active                          bool
+	Now                             time.Time
+	ID                              uuid.UUID
+}
+
+func (q *Queries) UpdateContestTemplate(ctx context.Context, arg UpdateContestTemplateParams) (ContestTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updateContestTemplate,
+		arg.TitlePattern,
+		arg.Description,
+		arg.Private,
+		arg.DurationDays,
+		arg.RegistrationClosesBeforeEndDays,
+		pq.Array(arg.LanguageCodeAllowList),
+		pq.Array(arg.ActivityTypeIDAllowList),
+		arg.TeamMode,
+		arg.TeamSizeLimit,
+		arg.TeamScoreAggregation,
+		arg.ScoringRuleSetID,
+		arg.LeadDays,
+		arg.Active,
+		arg.Now,
+		arg.ID,
+	)
+	var i ContestTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerUserID,
+		&i.OwnerUserDisplayName,
+		&i.TitlePattern,
+		&i.Description,
+		&i.Official,
+		&i.Private,
+		&i.DurationDays,
+		&i.RegistrationClosesBeforeEndDays,
+		pq.Array(&i.LanguageCodeAllowList),
+		pq.Array(&i.ActivityTypeIDAllowList),
+		&i.TeamMode,
+		&i.TeamSizeLimit,
+		&i.TeamScoreAggregation,
+		&i.ScoringRuleSetID,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.FirstContestStart,
+		&i.LeadDays,
+		&i.NextOccurrence,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -100,7 +100,10 @@ insert into contests (
   title,
   "description",
   language_code_allow_list,
-  activity_type_id_allow_list
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation
 ) values (
   $1,
   $2,
@@ -112,7 +115,10 @@ insert into contests (
   $8,
   $9,
   $10,
-  $11
+  $11,
+  $12,
+  $13,
+  $14
 ) returning id
 `
 
@@ -128,6 +134,9 @@ type CreateContestParams struct {
 	Description             sql.NullString
 	LanguageCodeAllowList   []string
 	ActivityTypeIDAllowList []int32
+	TeamMode                bool
+	TeamSizeLimit           sql.NullInt16
+	TeamScoreAggregation    string
 }
 
 func (q *Queries) CreateContest(ctx context.Context, arg CreateContestParams) (uuid.UUID, error) {
@@ -143,12 +152,60 @@ func (q *Queries) CreateContest(ctx context.Context, arg CreateContestParams) (u
 		arg.Description,
 		pq.Array(arg.LanguageCodeAllowList),
 		pq.Array(arg.ActivityTypeIDAllowList),
+		arg.TeamMode,
+		arg.TeamSizeLimit,
+		arg.TeamScoreAggregation,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
+const detachContestLogsOutsideLanguages = `-- name: DetachContestLogsOutsideLanguages :many
+with detached as (
+  delete from contest_logs
+  where
+    contest_logs.contest_id = $1
+    and contest_logs.log_id in (
+      select logs.id
+      from logs
+      where logs.language_code <> all($2::varchar[])
+    )
+  returning contest_logs.log_id
+)
+select distinct logs.user_id
+from detached
+inner join logs on (logs.id = detached.log_id)
+`
+
+type DetachContestLogsOutsideLanguagesParams struct {
+	ContestID     uuid.UUID
+	LanguageCodes []string
+}
+
+func (q *Queries) DetachContestLogsOutsideLanguages(ctx context.Context, arg DetachContestLogsOutsideLanguagesParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, detachContestLogsOutsideLanguages, arg.ContestID, pq.Array(arg.LanguageCodes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findContestById = `-- name: FindContestById :one
 select
   contests.id,
@@ -163,6 +220,10 @@ select
   language_code_allow_list,
   activity_type_id_allow_list,
   official,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template_id,
   contests.created_at,
   contests.updated_at,
   contests.deleted_at
@@ -192,6 +253,10 @@ type FindContestByIdRow struct {
 	LanguageCodeAllowList   []string
 	ActivityTypeIDAllowList []int32
 	Official                bool
+	TeamMode                bool
+	TeamSizeLimit           sql.NullInt16
+	TeamScoreAggregation    string
+	TemplateID              uuid.NullUUID
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
 	DeletedAt               sql.NullTime
@@ -213,6 +278,10 @@ func (q *Queries) FindContestById(ctx context.Context, arg FindContestByIdParams
 		pq.Array(&i.LanguageCodeAllowList),
 		pq.Array(&i.ActivityTypeIDAllowList),
 		&i.Official,
+		&i.TeamMode,
+		&i.TeamSizeLimit,
+		&i.TeamScoreAggregation,
+		&i.TemplateID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
@@ -320,6 +389,9 @@ select
   language_code_allow_list,
   activity_type_id_allow_list,
   official,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
   contests.created_at,
   contests.updated_at,
   contests.deleted_at
@@ -357,6 +429,9 @@ type ListContestsRow struct {
 	LanguageCodeAllowList   []string
 	ActivityTypeIDAllowList []int32
 	Official                bool
+	TeamMode                bool
+	TeamSizeLimit           sql.NullInt16
+	TeamScoreAggregation    string
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
 	DeletedAt               sql.NullTime
@@ -391,6 +466,9 @@ func (q *Queries) ListContests(ctx context.Context, arg ListContestsParams) ([]L
 			pq.Array(&i.LanguageCodeAllowList),
 			pq.Array(&i.ActivityTypeIDAllowList),
 			&i.Official,
+			&i.TeamMode,
+			&i.TeamSizeLimit,
+			&i.TeamScoreAggregation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -408,6 +486,31 @@ func (q *Queries) ListContests(ctx context.Context, arg ListContestsParams) ([]L
 	return items, nil
 }
 
+const restrictContestRegistrationLanguages = `-- name: RestrictContestRegistrationLanguages :exec
+update contest_registrations
+set
+  language_codes = array(
+    select code
+    from unnest(contest_registrations.language_codes) as code
+    where code = any($1::varchar[])
+  ),
+  updated_at = now()
+where
+  contest_id = $2
+  and deleted_at is null
+  and not (language_codes <@ $1::varchar[])
+`
+
+type RestrictContestRegistrationLanguagesParams struct {
+	LanguageCodes []string
+	ContestID     uuid.UUID
+}
+
+func (q *Queries) RestrictContestRegistrationLanguages(ctx context.Context, arg RestrictContestRegistrationLanguagesParams) error {
+	_, err := q.db.ExecContext(ctx, restrictContestRegistrationLanguages, pq.Array(arg.LanguageCodes), arg.ContestID)
+	return err
+}
+
 const updateContest = `-- name: UpdateContest :one
 update contests
 set
@@ -417,21 +520,25 @@ set
   registration_end = $4,
   title = $5,
   "description" = $6,
+  language_code_allow_list = $7,
+  activity_type_id_allow_list = $8,
   updated_at = now()
 where
-  id = $7
+  id = $9
   and deleted_at is null
 returning id
 `
 
 type UpdateContestParams struct {
-	Private         bool
-	ContestStart    time.Time
-	ContestEnd      time.Time
-	RegistrationEnd time.Time
-	Title           string
-	Description     sql.NullString
-	ID              uuid.UUID
+	Private                 bool
+	ContestStart            time.Time
+	ContestEnd              time.Time
+	RegistrationEnd         time.Time
+	Title                   string
+	Description             sql.NullString
+	LanguageCodeAllowList   []string
+	ActivityTypeIDAllowList []int32
+	ID                      uuid.UUID
 }
 
 func (q *Queries) UpdateContest(ctx context.Context, arg UpdateContestParams) (uuid.UUID, error) {
@@ -442,6 +549,8 @@ func (q *Queries) UpdateContest(ctx context.Context, arg UpdateContestParams) (u
 		arg.RegistrationEnd,
 		arg.Title,
 		arg.Description,
+		pq.Array(arg.LanguageCodeAllowList),
+		pq.Array(arg.ActivityTypeIDAllowList),
 		arg.ID,
 	)
 	var id uuid.UUID
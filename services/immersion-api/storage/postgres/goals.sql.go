@@ -0,0 +1,415 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: goals.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createGoal = `-- name: CreateGoal :exec
+insert into goals (
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  created_at,
+  updated_at
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $13
+)
+`
+
+type CreateGoalParams struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Title         string
+	LanguageCode  sql.NullString
+	LogActivityID sql.NullInt16
+	UnitKey       sql.NullString
+	Tag           sql.NullString
+	Metric        string
+	Target        float32
+	Period        string
+	StartsOn      sql.NullTime
+	EndsOn        sql.NullTime
+	Now           time.Time
+}
+
+func (q *Queries) CreateGoal(ctx context.Context, arg CreateGoalParams) error {
+	_, err := q.db.ExecContext(ctx, createGoal,
+		arg.ID,
+		arg.UserID,
+		arg.Title,
+		arg.LanguageCode,
+		arg.LogActivityID,
+		arg.UnitKey,
+		arg.Tag,
+		arg.Metric,
+		arg.Target,
+		arg.Period,
+		arg.StartsOn,
+		arg.EndsOn,
+		arg.Now,
+	)
+	return err
+}
+
+const deleteGoal = `-- name: DeleteGoal :exec
+update goals
+set deleted_at = $1
+where id = $2 and deleted_at is null
+`
+
+type DeleteGoalParams struct {
+	Now sql.NullTime
+	ID  uuid.UUID
+}
+
+func (q *Queries) DeleteGoal(ctx context.Context, arg DeleteGoalParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGoal, arg.Now, arg.ID)
+	return err
+}
+
+const deleteGoalsForUser = `-- name: DeleteGoalsForUser :exec
+delete from goals
+where user_id = $1
+`
+
+func (q *Queries) DeleteGoalsForUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteGoalsForUser, userID)
+	return err
+}
+
+const fetchGoalMetricTotals = `-- name: FetchGoalMetricTotals :one
+select
+  coalesce(sum(coalesce(logs.computed_score, logs.score)), 0)::real as score,
+  coalesce(sum(logs.amount), 0)::real as amount,
+  coalesce(sum(logs.duration_seconds), 0)::real as duration_seconds
+from logs
+where
+  logs.user_id = $1
+  and logs.deleted_at is null
+  and logs.created_at >= $2
+  and logs.created_at < $3
+  and (logs.language_code = $4 or $4 is null)
+  and (logs.log_activity_id = $5::integer or $5 is null)
+  and (logs.unit_key = $6 or $6 is null)
+  and (
+    $7::varchar is null
+    or exists (
+      select 1 from log_tags
+      where log_tags.log_id = logs.id and log_tags.tag = $7::varchar
+    )
+  )
+`
+
+type FetchGoalMetricTotalsParams struct {
+	UserID       uuid.UUID
+	From         time.Time
+	To           time.Time
+	LanguageCode sql.NullString
+	ActivityID   sql.NullInt32
+	UnitKey      sql.NullString
+	Tag          sql.NullString
+}
+
+type FetchGoalMetricTotalsRow struct {
+	Score           float32
+	Amount          float32
+	DurationSeconds float32
+}
+
+func (q *Queries) FetchGoalMetricTotals(ctx context.Context, arg FetchGoalMetricTotalsParams) (FetchGoalMetricTotalsRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchGoalMetricTotals,
+		arg.UserID,
+		arg.From,
+		arg.To,
+		arg.LanguageCode,
+		arg.ActivityID,
+		arg.UnitKey,
+		arg.Tag,
+	)
+	var i FetchGoalMetricTotalsRow
+	err := row.Scan(&i.Score, &i.Amount, &i.DurationSeconds)
+	return i, err
+}
+
+const findGoalByID = `-- name: FindGoalByID :one
+select
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  progress_period_start,
+  progress_period_end,
+  progress_current,
+  progress_recent_per_day,
+  progress_refreshed_at,
+  created_at,
+  updated_at
+from goals
+where id = $1 and deleted_at is null
+`
+
+type FindGoalByIDRow struct {
+	ID                   uuid.UUID
+	UserID               uuid.UUID
+	Title                string
+	LanguageCode         sql.NullString
+	LogActivityID        sql.NullInt16
+	UnitKey              sql.NullString
+	Tag                  sql.NullString
+	Metric               string
+	Target               float32
+	Period               string
+	StartsOn             sql.NullTime
+	EndsOn               sql.NullTime
+	ProgressPeriodStart  sql.NullTime
+	ProgressPeriodEnd    sql.NullTime
+	ProgressCurrent      sql.NullFloat64
+	ProgressRecentPerDay sql.NullFloat64
+	ProgressRefreshedAt  sql.NullTime
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func (q *Queries) FindGoalByID(ctx context.Context, id uuid.UUID) (FindGoalByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, findGoalByID, id)
+	var i FindGoalByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.LanguageCode,
+		&i.LogActivityID,
+		&i.UnitKey,
+		&i.Tag,
+		&i.Metric,
+		&i.Target,
+		&i.Period,
+		&i.StartsOn,
+		&i.EndsOn,
+		&i.ProgressPeriodStart,
+		&i.ProgressPeriodEnd,
+		&i.ProgressCurrent,
+		&i.ProgressRecentPerDay,
+		&i.ProgressRefreshedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listGoalsForUser = `-- name: ListGoalsForUser :many
+select
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  progress_period_start,
+  progress_period_end,
+  progress_current,
+  progress_recent_per_day,
+  progress_refreshed_at,
+  created_at,
+  updated_at
+from goals
+where user_id = $1 and deleted_at is null
+order by created_at asc
+`
+
+type ListGoalsForUserRow struct {
+	ID                   uuid.UUID
+	UserID               uuid.UUID
+	Title                string
+	LanguageCode         sql.NullString
+	LogActivityID        sql.NullInt16
+	UnitKey              sql.NullString
+	Tag                  sql.NullString
+	Metric               string
+	Target               float32
+	Period               string
+	StartsOn             sql.NullTime
+	EndsOn               sql.NullTime
+	ProgressPeriodStart  sql.NullTime
+	ProgressPeriodEnd    sql.NullTime
+	ProgressCurrent      sql.NullFloat64
+	ProgressRecentPerDay sql.NullFloat64
+	ProgressRefreshedAt  sql.NullTime
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func (q *Queries) ListGoalsForUser(ctx context.Context, userID uuid.UUID) ([]ListGoalsForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listGoalsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGoalsForUserRow
+	for rows.Next() {
+		var i ListGoalsForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.LanguageCode,
+			&i.LogActivityID,
+			&i.UnitKey,
+			&i.Tag,
+			&i.Metric,
+			&i.Target,
+			&i.Period,
+			&i.StartsOn,
+			&i.EndsOn,
+			&i.ProgressPeriodStart,
+			&i.ProgressPeriodEnd,
+			&i.ProgressCurrent,
+			&i.ProgressRecentPerDay,
+			&i.ProgressRefreshedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateGoal = `-- name: UpdateGoal :exec
+update goals
+set
+  title = $1,
+  language_code = $2,
+  log_activity_id = $3,
+  unit_key = $4,
+  tag = $5,
+  metric = $6,
+  target = $7,
+  period = $8,
+  starts_on = $9,
+  ends_on = $10,
+  progress_period_start = null,
+  progress_period_end = null,
+  progress_current = null,
+  progress_recent_per_day = null,
+  progress_refreshed_at = null,
+  updated_at = $11
+where id = $12 and deleted_at is null
+`
+
+type UpdateGoalParams struct {
+	Title         string
+	LanguageCode  sql.NullString
+	LogActivityID sql.NullInt16
+	UnitKey       sql.NullString
+	Tag           sql.NullString
+	Metric        string
+	Target        float32
+	Period        string
+	StartsOn      sql.NullTime
+	EndsOn        sql.NullTime
+	Now           time.Time
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdateGoal(ctx context.Context, arg UpdateGoalParams) error {
+	_, err := q.db.ExecContext(ctx, updateGoal,
+		arg.Title,
+		arg.LanguageCode,
+		arg.LogActivityID,
+		arg.UnitKey,
+		arg.Tag,
+		arg.Metric,
+		arg.Target,
+		arg.Period,
+		arg.StartsOn,
+		arg.EndsOn,
+		arg.Now,
+		arg.ID,
+	)
+	return err
+}
+
+const updateGoalProgress = `-- name: UpdateGoalProgress :exec
+update goals
+set
+  progress_period_start = $1,
+  progress_period_end = $2,
+  progress_current = $3,
+  progress_recent_per_day = $4,
+  progress_refreshed_at = $5
+where id = $6 and deleted_at is null
+`
+
+type UpdateGoalProgressParams struct {
+	PeriodStart  sql.NullTime
+	PeriodEnd    sql.NullTime
+	Current      sql.NullFloat64
+	RecentPerDay sql.NullFloat64
+	RefreshedAt  sql.NullTime
+	ID           uuid.UUID
+}
+
+func (q *Queries) UpdateGoalProgress(ctx context.Context, arg UpdateGoalProgressParams) error {
+	_, err := q.db.ExecContext(ctx, updateGoalProgress,
+		arg.PeriodStart,
+		arg.PeriodEnd,
+		arg.Current,
+		arg.RecentPerDay,
+		arg.RefreshedAt,
+		arg.ID,
+	)
+	return err
+}
@@ -99,6 +99,13 @@ func NewUUIDFromNullUUID(val uuid.NullUUID) uuid.UUID {
 	return val.UUID
 }
 
+func NewUUIDPtrFromNullUUID(val uuid.NullUUID) *uuid.UUID {
+	if !val.Valid {
+		return nil
+	}
+	return &val.UUID
+}
+
 func NewNullInt32(val *int32) sql.NullInt32 {
 	if val == nil {
 		return sql.NullInt32{
@@ -133,6 +140,28 @@ func NewNullInt16(val *int16) sql.NullInt16 {
 	}
 }
 
+func NewNullInt16FromInt32(val *int32) sql.NullInt16 {
+	if val == nil {
+		return sql.NullInt16{
+			Valid: false,
+		}
+	}
+
+	return sql.NullInt16{
+		Valid: true,
+		Int16: int16(*val),
+	}
+}
+
+func NewInt32PtrFromNullInt16(val sql.NullInt16) *int32 {
+	if !val.Valid {
+		return nil
+	}
+
+	res := int32(val.Int16)
+	return &res
+}
+
 // StringArrayFromInterface converts the any result from array_agg to []string.
 // PostgreSQL returns array data as []byte in text format like "{foo,bar}" which we parse.
 func StringArrayFromInterface(val any) []string {
@@ -17,6 +17,7 @@ select
   cr.user_id,
   coalesce(scores.score, 0)::real as score
 from contest_registrations cr
+inner join contests on contests.id = cr.contest_id
 left join (
   select
     logs.user_id,
@@ -31,6 +32,7 @@ left join (
 where
   cr.contest_id = $1
   and cr.deleted_at is null
+  and contests.deleted_at is null
 `
 
 type ContestLeaderboardAllScoresRow struct {
@@ -39,7 +41,8 @@ type ContestLeaderboardAllScoresRow struct {
 }
 
 // Returns all user scores for a contest without pagination/ranking.
-// Used for rebuilding the Redis leaderboard sorted set.
+// Used for rebuilding the Redis leaderboard sorted set. Cancelled contests
+// have no scores, so rebuilding their board clears it.
 func (q *Queries) ContestLeaderboardAllScores(ctx context.Context, contestID uuid.UUID) ([]ContestLeaderboardAllScoresRow, error) {
 	rows, err := q.db.QueryContext(ctx, contestLeaderboardAllScores, contestID)
 	if err != nil {
@@ -14,6 +14,54 @@ import (
 	"github.com/lib/pq"
 )
 
+const activityDaysForUser = `-- name: ActivityDaysForUser :many
+select distinct
+  logs.language_code,
+  languages.name as language_name,
+  (logs.created_at at time zone 'UTC' at time zone $1::text)::date as "date"
+from logs
+inner join languages on (languages.code = logs.language_code)
+where
+  logs.user_id = $2
+  and logs.deleted_at is null
+order by "date" asc, logs.language_code asc
+`
+
+type ActivityDaysForUserParams struct {
+	Timezone string
+	UserID   uuid.UUID
+}
+
+type ActivityDaysForUserRow struct {
+	LanguageCode string
+	LanguageName string
+	Date         time.Time
+}
+
+// Distinct days with logs per language, bucketed in the user's time zone.
+func (q *Queries) ActivityDaysForUser(ctx context.Context, arg ActivityDaysForUserParams) ([]ActivityDaysForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, activityDaysForUser, arg.Timezone, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityDaysForUserRow
+	for rows.Next() {
+		var i ActivityDaysForUserRow
+		if err := rows.Scan(&i.LanguageCode, &i.LanguageName, &i.Date); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const checkIfLogCanBeDeleted = `-- name: CheckIfLogCanBeDeleted :one
 select (not(true = any(
   select
@@ -49,6 +97,7 @@ insert into contest_logs (
   score_rule_set_id,
   score_rule_ids,
   score_rates,
+  score_rule_values,
   score_source
 ) values (
   (select contest_id from contest_registrations where id = $1),
@@ -61,7 +110,8 @@ insert into contest_logs (
   $8,
   $9,
   $10,
-  $11
+  $11,
+  $12
 )
 `
 
@@ -76,6 +126,7 @@ type CreateContestLogRelationParams struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 }
 
@@ -91,11 +142,103 @@ func (q *Queries) CreateContestLogRelation(ctx context.Context, arg CreateContes
 		arg.ScoreRuleSetID,
 		pq.Array(arg.ScoreRuleIds),
 		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
 		arg.ScoreSource,
 	)
 	return err
 }
 
+const createImportedLog = `-- name: CreateImportedLog :one
+insert into logs (
+  id,
+  user_id,
+  language_code,
+  log_activity_id,
+  unit_id,
+  unit_key,
+  amount,
+  modifier,
+  duration_seconds,
+  computed_score,
+  score_rule_set_id,
+  score_rule_ids,
+  score_rates,
+  score_rule_values,
+  score_source,
+  eligible_official_leaderboard,
+  "description",
+  created_at,
+  updated_at
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17,
+  $18,
+  $18
+) returning id
+`
+
+type CreateImportedLogParams struct {
+	ID                          uuid.UUID
+	UserID                      uuid.UUID
+	LanguageCode                string
+	LogActivityID               int16
+	UnitID                      uuid.NullUUID
+	UnitKey                     sql.NullString
+	Amount                      sql.NullFloat64
+	Modifier                    sql.NullFloat64
+	DurationSeconds             sql.NullInt32
+	ComputedScore               sql.NullFloat64
+	ScoreRuleSetID              uuid.NullUUID
+	ScoreRuleIds                []uuid.UUID
+	ScoreRates                  []float32
+	ScoreRuleValues             []float32
+	ScoreSource                 sql.NullString
+	EligibleOfficialLeaderboard bool
+	Description                 sql.NullString
+	CreatedAt                   time.Time
+}
+
+func (q *Queries) CreateImportedLog(ctx context.Context, arg CreateImportedLogParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createImportedLog,
+		arg.ID,
+		arg.UserID,
+		arg.LanguageCode,
+		arg.LogActivityID,
+		arg.UnitID,
+		arg.UnitKey,
+		arg.Amount,
+		arg.Modifier,
+		arg.DurationSeconds,
+		arg.ComputedScore,
+		arg.ScoreRuleSetID,
+		pq.Array(arg.ScoreRuleIds),
+		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
+		arg.ScoreSource,
+		arg.EligibleOfficialLeaderboard,
+		arg.Description,
+		arg.CreatedAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createLog = `-- name: CreateLog :one
 insert into logs (
   id,
@@ -111,9 +254,11 @@ insert into logs (
   score_rule_set_id,
   score_rule_ids,
   score_rates,
+  score_rule_values,
   score_source,
   eligible_official_leaderboard,
-  "description"
+  "description",
+  media_id
 ) values (
   $1,
   $2,
@@ -130,7 +275,9 @@ insert into logs (
   $13,
   $14,
   $15,
-  $16
+  $16,
+  $17,
+  $18
 ) returning id
 `
 
@@ -148,9 +295,11 @@ type CreateLogParams struct {
 	ScoreRuleSetID              uuid.NullUUID
 	ScoreRuleIds                []uuid.UUID
 	ScoreRates                  []float32
+	ScoreRuleValues             []float32
 	ScoreSource                 sql.NullString
 	EligibleOfficialLeaderboard bool
 	Description                 sql.NullString
+	MediaID                     uuid.NullUUID
 }
 
 func (q *Queries) CreateLog(ctx context.Context, arg CreateLogParams) (uuid.UUID, error) {
@@ -168,9 +317,11 @@ func (q *Queries) CreateLog(ctx context.Context, arg CreateLogParams) (uuid.UUID
 		arg.ScoreRuleSetID,
 		pq.Array(arg.ScoreRuleIds),
 		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
 		arg.ScoreSource,
 		arg.EligibleOfficialLeaderboard,
 		arg.Description,
+		arg.MediaID,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -449,6 +600,7 @@ select
   coalesce(logs.unit_key, '') as unit_key,
   coalesce(log_units.name, '') as unit_name,
   logs.description,
+  logs.media_id,
   logs.amount,
   logs.modifier,
   logs.duration_seconds,
@@ -456,6 +608,7 @@ select
   logs.score_rule_set_id,
   logs.score_rule_ids,
   logs.score_rates,
+  logs.score_rule_values,
   logs.score_source,
   logs.eligible_official_leaderboard,
   logs.created_at,
@@ -490,6 +643,7 @@ type FindLogByIDRow struct {
 	UnitKey                     string
 	UnitName                    string
 	Description                 sql.NullString
+	MediaID                     uuid.NullUUID
 	Amount                      sql.NullFloat64
 	Modifier                    sql.NullFloat64
 	DurationSeconds             sql.NullInt32
@@ -497,6 +651,7 @@ type FindLogByIDRow struct {
 	ScoreRuleSetID              uuid.NullUUID
 	ScoreRuleIds                []uuid.UUID
 	ScoreRates                  []float32
+	ScoreRuleValues             []float32
 	ScoreSource                 sql.NullString
 	EligibleOfficialLeaderboard bool
 	CreatedAt                   time.Time
@@ -519,6 +674,7 @@ func (q *Queries) FindLogByID(ctx context.Context, arg FindLogByIDParams) (FindL
 		&i.UnitKey,
 		&i.UnitName,
 		&i.Description,
+		&i.MediaID,
 		&i.Amount,
 		&i.Modifier,
 		&i.DurationSeconds,
@@ -526,6 +682,7 @@ func (q *Queries) FindLogByID(ctx context.Context, arg FindLogByIDParams) (FindL
 		&i.ScoreRuleSetID,
 		pq.Array(&i.ScoreRuleIds),
 		pq.Array(&i.ScoreRates),
+		pq.Array(&i.ScoreRuleValues),
 		&i.ScoreSource,
 		&i.EligibleOfficialLeaderboard,
 		&i.CreatedAt,
@@ -536,6 +693,84 @@ func (q *Queries) FindLogByID(ctx context.Context, arg FindLogByIDParams) (FindL
 	return i, err
 }
 
+const listContestLogScoresForLog = `-- name: ListContestLogScoresForLog :many
+select
+  contest_registrations.id as registration_id,
+  contest_logs.contest_id,
+  logs.unit_id,
+  coalesce(contest_logs.unit_key, '') as unit_key,
+  contest_logs.amount,
+  contest_logs.modifier,
+  contest_logs.duration_seconds,
+  coalesce(contest_logs.computed_score, contest_logs.score) as score,
+  contest_logs.score_rule_set_id,
+  contest_logs.score_rule_ids,
+  contest_logs.score_rates,
+  contest_logs.score_rule_values,
+  contest_logs.score_source
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+inner join contest_registrations on (
+  contest_registrations.contest_id = contest_logs.contest_id
+  and contest_registrations.user_id = logs.user_id
+)
+where contest_logs.log_id = $1
+order by contest_logs.contest_id
+`
+
+type ListContestLogScoresForLogRow struct {
+	RegistrationID  uuid.UUID
+	ContestID       uuid.UUID
+	UnitID          uuid.NullUUID
+	UnitKey         string
+	Amount          sql.NullFloat64
+	Modifier        sql.NullFloat64
+	DurationSeconds sql.NullInt32
+	Score           sql.NullFloat64
+	ScoreRuleSetID  uuid.NullUUID
+	ScoreRuleIds    []uuid.UUID
+	ScoreRates      []float32
+	ScoreRuleValues []float32
+	ScoreSource     sql.NullString
+}
+
+func (q *Queries) ListContestLogScoresForLog(ctx context.Context, logID uuid.UUID) ([]ListContestLogScoresForLogRow, error) {
+	rows, err := q.db.QueryContext(ctx, listContestLogScoresForLog, logID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListContestLogScoresForLogRow
+	for rows.Next() {
+		var i ListContestLogScoresForLogRow
+		if err := rows.Scan(
+			&i.RegistrationID,
+			&i.ContestID,
+			&i.UnitID,
+			&i.UnitKey,
+			&i.Amount,
+			&i.Modifier,
+			&i.DurationSeconds,
+			&i.Score,
+			&i.ScoreRuleSetID,
+			pq.Array(&i.ScoreRuleIds),
+			pq.Array(&i.ScoreRates),
+			pq.Array(&i.ScoreRuleValues),
+			&i.ScoreSource,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listLogsForContest = `-- name: ListLogsForContest :many
 with eligible_logs as (
   select
@@ -555,6 +790,7 @@ with eligible_logs as (
     contest_logs.score_rule_set_id,
     contest_logs.score_rule_ids,
     contest_logs.score_rates,
+    contest_logs.score_rule_values,
     contest_logs.score_source,
     logs.created_at,
     logs.updated_at,
@@ -575,7 +811,7 @@ with eligible_logs as (
     and contest_logs.contest_id = $5
 )
 select
-  id, user_id, language_code, language_name, activity_id, unit_id, unit_key, unit_name, description, amount, modifier, duration_seconds, score, score_rule_set_id, score_rule_ids, score_rates, score_source, created_at, updated_at, deleted_at, user_display_name, tags,
+  id, user_id, language_code, language_name, activity_id, unit_id, unit_key, unit_name, description, amount, modifier, duration_seconds, score, score_rule_set_id, score_rule_ids, score_rates, score_rule_values, score_source, created_at, updated_at, deleted_at, user_display_name, tags,
   (select count(eligible_logs.id) from eligible_logs) as total_size
 from eligible_logs
 order by created_at desc
@@ -608,6 +844,7 @@ type ListLogsForContestRow struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -649,6 +886,7 @@ func (q *Queries) ListLogsForContest(ctx context.Context, arg ListLogsForContest
 			&i.ScoreRuleSetID,
 			pq.Array(&i.ScoreRuleIds),
 			pq.Array(&i.ScoreRates),
+			pq.Array(&i.ScoreRuleValues),
 			&i.ScoreSource,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -682,6 +920,7 @@ with eligible_logs as (
     coalesce(logs.unit_key, '') as unit_key,
     coalesce(log_units.name, '') as unit_name,
     logs.description,
+    logs.media_id,
     logs.amount,
     logs.modifier,
     logs.duration_seconds,
@@ -689,6 +928,7 @@ with eligible_logs as (
     logs.score_rule_set_id,
     logs.score_rule_ids,
     logs.score_rates,
+    logs.score_rule_values,
     logs.score_source,
     logs.created_at,
     logs.updated_at,
@@ -705,7 +945,7 @@ with eligible_logs as (
     and logs.user_id = $4
 )
 select
-  id, user_id, language_code, language_name, activity_id, unit_id, unit_key, unit_name, description, amount, modifier, duration_seconds, score, score_rule_set_id, score_rule_ids, score_rates, score_source, created_at, updated_at, deleted_at, tags,
+  id, user_id, language_code, language_name, activity_id, unit_id, unit_key, unit_name, description, media_id, amount, modifier, duration_seconds, score, score_rule_set_id, score_rule_ids, score_rates, score_rule_values, score_source, created_at, updated_at, deleted_at, tags,
   (select count(eligible_logs.id) from eligible_logs) as total_size
 from eligible_logs
 order by created_at desc
@@ -730,6 +970,7 @@ type ListLogsForUserRow struct {
 	UnitKey         string
 	UnitName        string
 	Description     sql.NullString
+	MediaID         uuid.NullUUID
 	Amount          sql.NullFloat64
 	Modifier        sql.NullFloat64
 	DurationSeconds sql.NullInt32
@@ -737,6 +978,7 @@ type ListLogsForUserRow struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -769,6 +1011,7 @@ func (q *Queries) ListLogsForUser(ctx context.Context, arg ListLogsForUserParams
 			&i.UnitKey,
 			&i.UnitName,
 			&i.Description,
+			&i.MediaID,
 			&i.Amount,
 			&i.Modifier,
 			&i.DurationSeconds,
@@ -776,6 +1019,7 @@ func (q *Queries) ListLogsForUser(ctx context.Context, arg ListLogsForUserParams
 			&i.ScoreRuleSetID,
 			pq.Array(&i.ScoreRuleIds),
 			pq.Array(&i.ScoreRates),
+			pq.Array(&i.ScoreRuleValues),
 			&i.ScoreSource,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -808,11 +1052,13 @@ set
   score_rule_set_id = $7,
   score_rule_ids = $8,
   score_rates = $9,
-  score_source = $10,
-  "description" = $11,
-  updated_at = $12
+  score_rule_values = $10,
+  score_source = $11,
+  "description" = $12,
+  media_id = $13,
+  updated_at = $14
 where
-  id = $13
+  id = $15
   and deleted_at is null
 `
 
@@ -826,8 +1072,10 @@ type UpdateLogParams struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 	Description     sql.NullString
+	MediaID         uuid.NullUUID
 	Now             time.Time
 	LogID           uuid.UUID
 }
@@ -843,8 +1091,10 @@ func (q *Queries) UpdateLog(ctx context.Context, arg UpdateLogParams) error {
 		arg.ScoreRuleSetID,
 		pq.Array(arg.ScoreRuleIds),
 		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
 		arg.ScoreSource,
 		arg.Description,
+		arg.MediaID,
 		arg.Now,
 		arg.LogID,
 	)
@@ -879,13 +1129,14 @@ set
   score_rule_set_id = $6,
   score_rule_ids = $7,
   score_rates = $8,
-  score_source = $9
+  score_rule_values = $9,
+  score_source = $10
 from contests
 where
-  contest_logs.log_id = $10
-  and contest_logs.contest_id = $11
+  contest_logs.log_id = $11
+  and contest_logs.contest_id = $12
   and contest_logs.contest_id = contests.id
-  and contests.contest_end >= $12
+  and contests.contest_end >= $13
 `
 
 type UpdateOngoingContestLogParams struct {
@@ -897,6 +1148,7 @@ type UpdateOngoingContestLogParams struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 	LogID           uuid.UUID
 	ContestID       uuid.UUID
@@ -913,6 +1165,7 @@ func (q *Queries) UpdateOngoingContestLog(ctx context.Context, arg UpdateOngoing
 		arg.ScoreRuleSetID,
 		pq.Array(arg.ScoreRuleIds),
 		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
 		arg.ScoreSource,
 		arg.LogID,
 		arg.ContestID,
@@ -932,12 +1185,13 @@ set
   score_rule_set_id = $6,
   score_rule_ids = $7,
   score_rates = $8,
-  score_source = $9
+  score_rule_values = $9,
+  score_source = $10
 from contests
 where
-  contest_logs.log_id = $10
+  contest_logs.log_id = $11
   and contest_logs.contest_id = contests.id
-  and contests.contest_end >= $11
+  and contests.contest_end >= $12
 `
 
 type UpdateOngoingContestLogsParams struct {
@@ -949,6 +1203,7 @@ type UpdateOngoingContestLogsParams struct {
 	ScoreRuleSetID  uuid.NullUUID
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
+	ScoreRuleValues []float32
 	ScoreSource     sql.NullString
 	LogID           uuid.UUID
 	Now             time.Time
@@ -964,6 +1219,7 @@ func (q *Queries) UpdateOngoingContestLogs(ctx context.Context, arg UpdateOngoin
 		arg.ScoreRuleSetID,
 		pq.Array(arg.ScoreRuleIds),
 		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
 		arg.ScoreSource,
 		arg.LogID,
 		arg.Now,
@@ -975,19 +1231,22 @@ const yearlyActivityForUser = `-- name: YearlyActivityForUser :many
 select
   sum(coalesce(computed_score, score))::real as score,
   count(id) as update_count,
-  created_at::date as "date"
+  (created_at at time zone 'UTC' at time zone $1::text)::date as "date"
 from logs
 where
-  user_id = $1
-  and year = $2
+  user_id = $2
+  and created_at >= $3
+  and created_at < $4
   and deleted_at is null
 group by "date"
 order by date asc
 `
 
 type YearlyActivityForUserParams struct {
-	UserID uuid.UUID
-	Year   int16
+	Timezone string
+	UserID   uuid.UUID
+	From     time.Time
+	To       time.Time
 }
 
 type YearlyActivityForUserRow struct {
@@ -996,8 +1255,14 @@ type YearlyActivityForUserRow struct {
 	Date        time.Time
 }
 
+// Days are bucketed in the user's time zone, the range bounds the local year.
 func (q *Queries) YearlyActivityForUser(ctx context.Context, arg YearlyActivityForUserParams) ([]YearlyActivityForUserRow, error) {
-	rows, err := q.db.QueryContext(ctx, yearlyActivityForUser, arg.UserID, arg.Year)
+	rows, err := q.db.QueryContext(ctx, yearlyActivityForUser,
+		arg.Timezone,
+		arg.UserID,
+		arg.From,
+		arg.To,
+	)
 	if err != nil {
 		return nil, err
 	}
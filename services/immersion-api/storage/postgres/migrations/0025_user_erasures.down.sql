@@ -0,0 +1,6 @@
+begin;
+
+drop table if exists user_erasure_audit_log;
+drop table if exists user_erasures;
+
+commit;
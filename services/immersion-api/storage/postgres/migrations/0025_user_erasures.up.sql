@@ -0,0 +1,43 @@
+begin;
+
+create table user_erasures (
+  id uuid primary key default uuid_generate_v4(),
+  user_id uuid not null,
+  requested_by_user_id uuid not null,
+  status text not null default 'pending',
+  attempts integer not null default 0,
+  last_error text,
+  database_erased_at timestamp,
+  leaderboards_erased_at timestamp,
+  relationships_erased_at timestamp,
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+  completed_at timestamp,
+
+  constraint user_erasures_status_valid
+    check (status in ('pending', 'completed')),
+  constraint user_erasures_status_timestamp_valid
+    check (
+      (status = 'pending' and completed_at is null)
+      or (status = 'completed' and completed_at is not null)
+    )
+);
+
+create unique index user_erasures_user_id on user_erasures(user_id);
+create index user_erasures_pending on user_erasures(created_at) where status = 'pending';
+
+-- Append-only audit trail for erasures. Every entry stores the hash of the
+-- previous entry, so removing or editing a row breaks the chain.
+create table user_erasure_audit_log (
+  id bigserial primary key,
+  erasure_id uuid not null references user_erasures(id),
+  event text not null,
+  metadata jsonb not null default '{}'::jsonb,
+  created_at timestamp not null,
+  previous_hash text not null,
+  hash text not null unique
+);
+
+create index user_erasure_audit_log_erasure_id on user_erasure_audit_log(erasure_id);
+
+commit;
@@ -0,0 +1,46 @@
+begin;
+
+create table goals (
+  id uuid primary key default uuid_generate_v4(),
+  user_id uuid not null,
+  title varchar(100) not null,
+
+  -- scope, a null column matches all logs
+  language_code varchar(10),
+  log_activity_id smallint,
+  unit_key text,
+  tag varchar(50),
+
+  metric text not null,
+  target real not null,
+  period text not null,
+  starts_on date,
+  ends_on date,
+
+  -- cached progress, refreshed by the leaderboard outbox worker
+  progress_period_start timestamp,
+  progress_period_end timestamp,
+  progress_current real,
+  progress_recent_per_day real,
+  progress_refreshed_at timestamp,
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+  deleted_at timestamp default null,
+
+  constraint goals_metric_valid
+    check (metric in ('amount', 'duration', 'score')),
+  constraint goals_period_valid
+    check (period in ('daily', 'weekly', 'monthly', 'yearly', 'custom')),
+  constraint goals_target_positive
+    check (target > 0),
+  constraint goals_custom_range_valid
+    check (
+      (period = 'custom' and starts_on is not null and ends_on is not null and starts_on <= ends_on)
+      or (period <> 'custom' and starts_on is null and ends_on is null)
+    )
+);
+
+create index goals_user_id on goals(user_id) where deleted_at is null;
+
+commit;
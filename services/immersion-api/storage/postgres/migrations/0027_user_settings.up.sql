@@ -0,0 +1,17 @@
+begin;
+
+create table user_settings (
+  user_id uuid primary key not null,
+  -- IANA time zone name used to bucket logs into days
+  timezone text not null default 'UTC',
+  -- days without logs that do not break a streak
+  streak_grace_days smallint not null default 0,
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+
+  constraint user_settings_streak_grace_days_valid
+    check (streak_grace_days >= 0)
+);
+
+commit;
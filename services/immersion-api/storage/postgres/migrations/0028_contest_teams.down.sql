@@ -0,0 +1,13 @@
+begin;
+
+drop table if exists contest_team_members;
+drop table if exists contest_teams;
+
+alter table contests
+  drop constraint if exists contests_team_size_limit_valid,
+  drop constraint if exists contests_team_score_aggregation_valid,
+  drop column if exists team_score_aggregation,
+  drop column if exists team_size_limit,
+  drop column if exists team_mode;
+
+commit;
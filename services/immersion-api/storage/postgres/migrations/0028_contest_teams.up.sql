@@ -0,0 +1,41 @@
+begin;
+
+alter table contests
+  add column team_mode boolean not null default false,
+  -- maximum number of members per team, only set in team mode
+  add column team_size_limit smallint,
+  -- how member scores are combined into a team score
+  add column team_score_aggregation text not null default 'sum',
+  add constraint contests_team_score_aggregation_valid
+    check (team_score_aggregation in ('sum', 'average')),
+  add constraint contests_team_size_limit_valid
+    check (
+      (team_mode and team_size_limit is not null and team_size_limit > 0)
+      or (not team_mode and team_size_limit is null)
+    );
+
+create table contest_teams (
+  id uuid primary key default uuid_generate_v4(),
+  contest_id uuid not null,
+  "name" varchar(50) not null,
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now()
+);
+
+create unique index contest_teams_contest_id_name on contest_teams(contest_id, lower("name"));
+
+create table contest_team_members (
+  contest_id uuid not null,
+  user_id uuid not null,
+  team_id uuid not null references contest_teams(id) on delete cascade,
+
+  joined_at timestamp not null default now(),
+
+  -- a user can be part of at most one team per contest
+  primary key (contest_id, user_id)
+);
+
+create index contest_team_members_team_id on contest_team_members(team_id);
+
+commit;
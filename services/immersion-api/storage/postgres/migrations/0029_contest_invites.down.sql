@@ -0,0 +1,6 @@
+begin;
+
+drop table contest_invitations;
+drop table contest_invite_codes;
+
+commit;
@@ -0,0 +1,40 @@
+begin;
+
+create table contest_invite_codes (
+  id uuid primary key default uuid_generate_v4(),
+  contest_id uuid not null,
+  code varchar(32) not null,
+  -- null once the creator has been erased
+  created_by_user_id uuid,
+
+  expires_at timestamp,
+  max_uses integer,
+  use_count integer not null default 0,
+  revoked_at timestamp,
+
+  created_at timestamp not null default now(),
+
+  constraint contest_invite_codes_max_uses_valid check (max_uses is null or max_uses > 0)
+);
+
+create unique index contest_invite_codes_code on contest_invite_codes(code);
+create index contest_invite_codes_contest_id on contest_invite_codes(contest_id);
+
+-- Mirrors the contest#invitees relation tuples in Keto, which decide access.
+-- Kept to list invitations and to find the tuples to remove on user erasure.
+create table contest_invitations (
+  contest_id uuid not null,
+  user_id uuid not null,
+  -- set when the invitation came from redeeming a code
+  invite_code_id uuid references contest_invite_codes(id) on delete set null,
+  -- set when the invitation was sent explicitly, null once erased
+  invited_by_user_id uuid,
+
+  created_at timestamp not null default now(),
+
+  primary key (contest_id, user_id)
+);
+
+create index contest_invitations_user_id on contest_invitations(user_id);
+
+commit;
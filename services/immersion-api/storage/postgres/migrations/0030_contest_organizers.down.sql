@@ -0,0 +1,5 @@
+begin;
+
+drop table contest_organizers;
+
+commit;
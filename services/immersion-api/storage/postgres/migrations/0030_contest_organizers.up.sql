@@ -0,0 +1,19 @@
+begin;
+
+-- Mirrors the contest#organizers relation tuples in Keto, which decide who
+-- may moderate a contest besides its owner. Kept to list co-organizers and to
+-- find the tuples to remove on user erasure.
+create table contest_organizers (
+  contest_id uuid not null,
+  user_id uuid not null,
+  -- null once the user who added the co-organizer has been erased
+  added_by_user_id uuid,
+
+  created_at timestamp not null default now(),
+
+  primary key (contest_id, user_id)
+);
+
+create index contest_organizers_user_id on contest_organizers(user_id);
+
+commit;
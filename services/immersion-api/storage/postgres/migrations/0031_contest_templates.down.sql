@@ -0,0 +1,11 @@
+begin;
+
+drop index contests_template_occurrence;
+
+alter table contests
+  drop column template_occurrence,
+  drop column template_id;
+
+drop table contest_templates;
+
+commit;
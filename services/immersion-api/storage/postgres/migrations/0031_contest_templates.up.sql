@@ -0,0 +1,77 @@
+begin;
+
+-- Templates describe a recurring contest series. The template worker creates
+-- the next contest of each active template ahead of time, replacing the
+-- hard-coded official rounds of create_official_contest.
+create table contest_templates (
+  id uuid primary key default uuid_generate_v4(),
+  owner_user_id uuid not null,
+  owner_user_display_name varchar(255) not null,
+  -- supports the {year}, {month}, {month_name}, {quarter}, {number} and
+  -- {year_number} placeholders
+  title_pattern varchar(100) not null,
+  "description" text,
+  official boolean not null default false,
+  "private" boolean not null default false,
+  -- null runs every contest until the next one of the series starts
+  duration_days integer,
+  -- registration closes this many days before the contest ends
+  registration_closes_before_end_days integer not null default 0,
+  language_code_allow_list varchar(10)[],
+  activity_type_id_allow_list integer[] not null,
+  team_mode boolean not null default false,
+  team_size_limit smallint,
+  team_score_aggregation text not null default 'sum',
+  -- published contest rule set whose rules are copied into every contest
+  scoring_rule_set_id uuid references scoring_rule_sets(id),
+
+  frequency varchar(10) not null,
+  interval_count integer not null,
+  first_contest_start date not null,
+  -- contests are created this many days before they start
+  lead_days integer not null,
+  -- index of the next occurrence the worker will create, starting at 0
+  next_occurrence integer not null default 0,
+  active boolean not null default true,
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+
+  constraint contest_templates_frequency_valid
+    check (frequency in ('weekly', 'monthly')),
+  constraint contest_templates_interval_positive
+    check (interval_count > 0),
+  constraint contest_templates_duration_positive
+    check (duration_days is null or duration_days > 0),
+  constraint contest_templates_registration_valid
+    check (
+      registration_closes_before_end_days >= 0
+      and (duration_days is null or registration_closes_before_end_days < duration_days)
+    ),
+  constraint contest_templates_team_score_aggregation_valid
+    check (team_score_aggregation in ('sum', 'average')),
+  constraint contest_templates_team_size_limit_valid
+    check (
+      (team_mode and team_size_limit is not null and team_size_limit > 0)
+      or (not team_mode and team_size_limit is null)
+    ),
+  constraint contest_templates_lead_days_non_negative
+    check (lead_days >= 0),
+  constraint contest_templates_next_occurrence_non_negative
+    check (next_occurrence >= 0)
+);
+
+create index contest_templates_owner_user_id on contest_templates(owner_user_id);
+create index contest_templates_active on contest_templates(active) where active;
+
+alter table contests
+  add column template_id uuid references contest_templates(id),
+  add column template_occurrence integer;
+
+-- Guards against creating the same occurrence twice when several instances
+-- run the template worker.
+create unique index contests_template_occurrence
+  on contests(template_id, template_occurrence)
+  where template_id is not null;
+
+commit;
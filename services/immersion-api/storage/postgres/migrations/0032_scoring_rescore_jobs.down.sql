@@ -0,0 +1,6 @@
+begin;
+
+drop table scoring_rescore_job_user_diffs;
+drop table scoring_rescore_jobs;
+
+commit;
@@ -0,0 +1,55 @@
+begin;
+
+-- Re-evaluates existing logs against a scoring rule set. Jobs walk logs in id
+-- order so they can resume from the cursor after a restart.
+create table scoring_rescore_jobs (
+  id uuid primary key default uuid_generate_v4(),
+  scope varchar(20) not null,
+  -- set for contest jobs, which only rescore that contest's logs
+  contest_id uuid references contests(id),
+  rule_set_id uuid not null references scoring_rule_sets(id),
+  -- dry runs only record the per-user diff without writing scores
+  dry_run boolean not null default false,
+  "status" varchar(20) not null default 'pending',
+
+  -- id of the last processed log
+  cursor_log_id uuid,
+  total_logs integer not null default 0,
+  processed_logs integer not null default 0,
+  changed_logs integer not null default 0,
+  skipped_logs integer not null default 0,
+  last_error text,
+
+  -- null for jobs scheduled by activating a rule set, or once the user has
+  -- been erased
+  requested_by_user_id uuid,
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+  completed_at timestamp,
+
+  constraint scoring_rescore_jobs_scope check (
+    (scope = 'platform' and contest_id is null)
+    or (scope = 'contest' and contest_id is not null)
+  ),
+  constraint scoring_rescore_jobs_status check (
+    "status" in ('pending', 'running', 'completed', 'failed', 'superseded')
+  )
+);
+
+create index scoring_rescore_jobs_runnable on scoring_rescore_jobs(created_at)
+  where "status" in ('pending', 'running');
+create index scoring_rescore_jobs_contest_id on scoring_rescore_jobs(contest_id);
+
+create table scoring_rescore_job_user_diffs (
+  job_id uuid not null references scoring_rescore_jobs(id) on delete cascade,
+  user_id uuid not null,
+  changed_logs integer not null default 0,
+  -- sums over the changed logs only
+  score_before real not null default 0,
+  score_after real not null default 0,
+
+  primary key (job_id, user_id)
+);
+
+commit;
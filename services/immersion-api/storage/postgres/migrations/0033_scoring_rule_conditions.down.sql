@@ -0,0 +1,24 @@
+begin;
+
+alter table scoring_rescore_jobs
+  drop column cursor_logged_at;
+
+drop index logs_created_at_id;
+drop index logs_user_id_created_at;
+
+alter table scoring_rules
+  drop constraint scoring_rules_cap_valid,
+  drop constraint scoring_rules_effective_window_valid,
+  drop constraint scoring_rules_value_range_valid,
+  drop constraint scoring_rules_tag_match_valid,
+  drop column cap_score,
+  drop column cap_value,
+  drop column cap_period,
+  drop column effective_until,
+  drop column effective_from,
+  drop column max_value,
+  drop column min_value,
+  drop column tag_match,
+  drop column tags;
+
+commit;
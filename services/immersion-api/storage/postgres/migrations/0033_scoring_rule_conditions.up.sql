@@ -0,0 +1,71 @@
+begin;
+
+alter table scoring_rules
+  -- matched according to tag_match, in addition to tag
+  add column tags text[] not null default array[]::text[],
+  add column tag_match varchar(10),
+  -- bound the amount, or the duration in minutes, depending on score_source
+  add column min_value real,
+  add column max_value real,
+  -- bound when the log was made, effective_until is exclusive
+  add column effective_from timestamp,
+  add column effective_until timestamp,
+  -- caps count the user's logs within the utc day or week of the log
+  add column cap_period varchar(10),
+  add column cap_value real,
+  add column cap_score real;
+
+alter table scoring_rules
+  add constraint scoring_rules_tag_match_valid
+    check (
+      (cardinality(tags) = 0 and tag_match is null)
+      or (cardinality(tags) > 0 and tag_match in ('any', 'all'))
+    ),
+  add constraint scoring_rules_value_range_valid
+    check (
+      (min_value is null or min_value >= 0)
+      and (max_value is null or max_value > 0)
+      and (min_value is null or max_value is null or min_value <= max_value)
+    ),
+  add constraint scoring_rules_effective_window_valid
+    check (
+      effective_from is null
+      or effective_until is null
+      or effective_from < effective_until
+    ),
+  add constraint scoring_rules_cap_valid
+    check (
+      (cap_period is null and cap_value is null and cap_score is null)
+      or (
+        cap_period in ('day', 'week')
+        and (cap_value is not null or cap_score is not null)
+        and (cap_value is null or (cap_value > 0 and not stackable))
+        and (cap_score is null or cap_score > 0)
+      )
+    );
+
+-- Caps sum the user's earlier logs within a period
+create index logs_user_id_created_at on logs(user_id, created_at);
+
+-- Rescoring walks logs in the order they were made, so caps count the logs
+-- rescored before them. Unfinished jobs start over in that order.
+create index logs_created_at_id on logs(created_at, id);
+
+alter table scoring_rescore_jobs
+  add column cursor_logged_at timestamp;
+
+delete from scoring_rescore_job_user_diffs
+where job_id in (
+  select id from scoring_rescore_jobs where "status" in ('pending', 'running')
+);
+
+update scoring_rescore_jobs
+set
+  "status" = 'pending',
+  cursor_log_id = null,
+  processed_logs = 0,
+  changed_logs = 0,
+  skipped_logs = 0
+where "status" in ('pending', 'running');
+
+commit;
@@ -0,0 +1,23 @@
+begin;
+
+update scoring_rule_sets
+set status = 'published'
+where status = 'archived';
+
+alter table scoring_rule_sets
+  drop constraint scoring_rule_sets_status_valid,
+  drop constraint scoring_rule_sets_status_timestamp_valid;
+
+alter table scoring_rule_sets
+  drop column archived_at;
+
+alter table scoring_rule_sets
+  add constraint scoring_rule_sets_status_valid
+    check (status in ('draft', 'published')),
+  add constraint scoring_rule_sets_status_timestamp_valid
+    check (
+      (status = 'draft' and published_at is null)
+      or (status = 'published' and published_at is not null)
+    );
+
+commit;
@@ -0,0 +1,20 @@
+begin;
+
+alter table scoring_rule_sets
+  add column archived_at timestamp;
+
+alter table scoring_rule_sets
+  drop constraint scoring_rule_sets_status_valid,
+  drop constraint scoring_rule_sets_status_timestamp_valid;
+
+alter table scoring_rule_sets
+  add constraint scoring_rule_sets_status_valid
+    check (status in ('draft', 'published', 'archived')),
+  add constraint scoring_rule_sets_status_timestamp_valid
+    check (
+      (status = 'draft' and published_at is null and archived_at is null)
+      or (status = 'published' and published_at is not null and archived_at is null)
+      or (status = 'archived' and published_at is not null and archived_at is not null)
+    );
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+drop index log_units_unit_key_language;
+
+alter table log_units
+  drop constraint log_units_unit_key_format,
+  drop column deprecated_at;
+
+commit;
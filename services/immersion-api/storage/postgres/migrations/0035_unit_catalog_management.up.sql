@@ -0,0 +1,32 @@
+begin;
+
+do $$
+begin
+  if exists (
+    select 1
+    from log_units
+    group by unit_key
+    having count(distinct log_activity_id) > 1
+  ) then
+    raise exception 'cannot manage unit keys that are shared between activities';
+  end if;
+
+  if exists (
+    select 1
+    from log_units
+    group by unit_key, language_code
+    having count(*) > 1
+  ) then
+    raise exception 'cannot manage unit keys that are duplicated for a language';
+  end if;
+end $$;
+
+alter table log_units
+  add column deprecated_at timestamp,
+  add constraint log_units_unit_key_format
+    check (unit_key ~ '^[a-z][a-z0-9_]*$' and length(unit_key) <= 50);
+
+create unique index log_units_unit_key_language
+  on log_units(unit_key, coalesce(language_code, ''));
+
+commit;
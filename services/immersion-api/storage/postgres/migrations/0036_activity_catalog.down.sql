@@ -0,0 +1,5 @@
+begin;
+
+drop table log_activities;
+
+commit;
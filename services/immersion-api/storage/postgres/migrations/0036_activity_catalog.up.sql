@@ -0,0 +1,27 @@
+begin;
+
+create table log_activities (
+  id smallint primary key not null,
+  name varchar(100) not null,
+  "default" boolean not null default false,
+  input_type varchar(20) not null,
+  -- interim score of a minute of duration-only tracking
+  duration_score_per_minute real not null,
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+  constraint log_activities_input_type check (input_type in ('amount_primary', 'time_primary')),
+  constraint log_activities_duration_score_per_minute check (duration_score_per_minute > 0)
+);
+
+create unique index log_activities_name on log_activities(lower(name));
+
+insert into log_activities
+  (id, name, "default", input_type, duration_score_per_minute)
+values
+  (1, 'Reading', true, 'amount_primary', 0.2),
+  (2, 'Listening', true, 'time_primary', 0.4),
+  (3, 'Writing', false, 'amount_primary', 0.2),
+  (4, 'Speaking', false, 'time_primary', 0.5),
+  (5, 'Study', false, 'time_primary', 0.5);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists logs_user_id_media_id;
+alter table logs drop column if exists media_id;
+drop table media;
+
+commit;
@@ -0,0 +1,33 @@
+begin;
+
+create extension if not exists pg_trgm;
+
+create table media (
+  id uuid primary key not null,
+  title varchar(255) not null,
+  media_type varchar(20) not null,
+  language_code varchar(10),
+  -- optional total length, progress is measured in the same unit
+  total_length real,
+  length_unit varchar(20),
+  -- external identifiers such as {"isbn": "9784041026229"}
+  external_ids jsonb not null default '{}'::jsonb,
+  created_by_user_id uuid,
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+  constraint media_media_type check (media_type in ('book', 'comic', 'tv', 'movie', 'game', 'audio', 'web', 'other')),
+  constraint media_length_unit check (length_unit in ('page', 'episode', 'character', 'minute')),
+  constraint media_length check (
+    (total_length is null and length_unit is null)
+    or (total_length > 0 and length_unit is not null)
+  )
+);
+
+create index media_title_trgm on media using gin (lower(title) gin_trgm_ops);
+create unique index media_isbn on media ((external_ids->>'isbn')) where external_ids ? 'isbn';
+
+alter table logs add column media_id uuid references media(id);
+
+create index logs_user_id_media_id on logs(user_id, media_id) where media_id is not null;
+
+commit;
@@ -0,0 +1,11 @@
+begin;
+
+alter table contest_logs
+  drop constraint contest_logs_score_rule_values_valid,
+  drop column score_rule_values;
+
+alter table logs
+  drop constraint logs_score_rule_values_valid,
+  drop column score_rule_values;
+
+commit;
@@ -0,0 +1,44 @@
+begin;
+
+-- The part of the log's value each applied rule scored, value caps only count
+-- that part instead of the whole log.
+alter table logs add column score_rule_values real[];
+alter table contest_logs add column score_rule_values real[];
+
+-- Logs scored before were counted in full towards each rule, which is kept
+-- until they are scored again.
+update logs
+set score_rule_values = array_fill(
+  coalesce(case when score_source = 'amount' then amount else duration_seconds / 60.0 end, 0)::real,
+  array[cardinality(score_rule_ids)]
+)
+where score_rule_ids is not null;
+
+update contest_logs
+set score_rule_values = array_fill(
+  coalesce(case when score_source = 'amount' then amount else duration_seconds / 60.0 end, 0)::real,
+  array[cardinality(score_rule_ids)]
+)
+where score_rule_ids is not null;
+
+alter table logs
+  add constraint logs_score_rule_values_valid
+  check (
+    (score_rule_ids is null and score_rule_values is null)
+    or (
+      score_rule_values is not null
+      and cardinality(score_rule_values) = cardinality(score_rule_ids)
+    )
+  );
+
+alter table contest_logs
+  add constraint contest_logs_score_rule_values_valid
+  check (
+    (score_rule_ids is null and score_rule_values is null)
+    or (
+      score_rule_values is not null
+      and cardinality(score_rule_values) = cardinality(score_rule_ids)
+    )
+  );
+
+commit;
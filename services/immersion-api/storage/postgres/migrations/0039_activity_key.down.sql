@@ -0,0 +1,9 @@
+begin;
+
+alter table log_activities alter column id drop identity;
+
+drop index log_activities_key;
+
+alter table log_activities drop column key;
+
+commit;
@@ -0,0 +1,20 @@
+begin;
+
+-- Activities can be renamed, units keep the prefix the activity was created
+-- with.
+alter table log_activities add column key varchar(100);
+
+update log_activities
+set key = regexp_replace(lower(name), '[^a-z0-9]', '_', 'g');
+
+alter table log_activities alter column key set not null;
+
+create unique index log_activities_key on log_activities(key);
+
+-- IDs were picked as max(id) + 1, which races between concurrent creates.
+alter table log_activities alter column id add generated by default as identity;
+
+select setval(pg_get_serial_sequence('log_activities', 'id'), coalesce(max(id), 0) + 1, false)
+from log_activities;
+
+commit;
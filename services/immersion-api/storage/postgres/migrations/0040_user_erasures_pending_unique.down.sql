@@ -0,0 +1,7 @@
+begin;
+
+drop index user_erasures_pending_user_id;
+
+create unique index user_erasures_user_id on user_erasures(user_id);
+
+commit;
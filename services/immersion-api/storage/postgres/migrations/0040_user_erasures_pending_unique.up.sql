@@ -0,0 +1,9 @@
+begin;
+
+-- Only one erasure per user can be in flight. Users who sign in again after
+-- their erasure completed can be erased again.
+drop index user_erasures_user_id;
+
+create unique index user_erasures_pending_user_id on user_erasures(user_id) where status = 'pending';
+
+commit;
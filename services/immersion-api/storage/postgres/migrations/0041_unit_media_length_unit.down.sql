@@ -0,0 +1,5 @@
+begin;
+
+alter table log_units drop column media_length_unit;
+
+commit;
@@ -0,0 +1,19 @@
+begin;
+
+-- The length unit of media that logs in the unit count towards. Minutes are
+-- counted from the duration of logs instead, so they are not mapped.
+alter table log_units add column media_length_unit text;
+
+alter table log_units
+  add constraint log_units_media_length_unit
+  check (media_length_unit in ('page', 'episode', 'character'));
+
+update log_units
+set media_length_unit = 'page'
+where unit_key in ('reading_page', 'reading_two_column_page', 'reading_comic_page', 'writing_page');
+
+update log_units
+set media_length_unit = 'character'
+where unit_key in ('reading_character', 'writing_character');
+
+commit;
@@ -29,6 +29,31 @@ type Contest struct {
 	UpdatedAt               time.Time
 	DeletedAt               sql.NullTime
 	ScoringRuleSetID        uuid.NullUUID
+	TeamMode                bool
+	TeamSizeLimit           sql.NullInt16
+	TeamScoreAggregation    string
+	TemplateID              uuid.NullUUID
+	TemplateOccurrence      sql.NullInt32
+}
+
+type ContestInvitation struct {
+	ContestID       uuid.UUID
+	UserID          uuid.UUID
+	InviteCodeID    uuid.NullUUID
+	InvitedByUserID uuid.NullUUID
+	CreatedAt       time.Time
+}
+
+type ContestInviteCode struct {
+	ID              uuid.UUID
+	ContestID       uuid.UUID
+	Code            string
+	CreatedByUserID uuid.NullUUID
+	ExpiresAt       sql.NullTime
+	MaxUses         sql.NullInt32
+	UseCount        int32
+	RevokedAt       sql.NullTime
+	CreatedAt       time.Time
 }
 
 type ContestLog struct {
@@ -44,6 +69,14 @@ type ContestLog struct {
 	ScoreRuleIds    []uuid.UUID
 	ScoreRates      []float32
 	ScoreSource     sql.NullString
+	ScoreRuleValues []float32
+}
+
+type ContestOrganizer struct {
+	ContestID     uuid.UUID
+	UserID        uuid.UUID
+	AddedByUserID uuid.NullUUID
+	CreatedAt     time.Time
 }
 
 type ContestRegistration struct {
@@ -56,6 +89,70 @@ type ContestRegistration struct {
 	DeletedAt     sql.NullTime
 }
 
+type ContestTeam struct {
+	ID        uuid.UUID
+	ContestID uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ContestTeamMember struct {
+	ContestID uuid.UUID
+	UserID    uuid.UUID
+	TeamID    uuid.UUID
+	JoinedAt  time.Time
+}
+
+type ContestTemplate struct {
+	ID                              uuid.UUID
+	OwnerUserID                     uuid.UUID
+	OwnerUserDisplayName            string
+	TitlePattern                    string
+	Description                     sql.NullString
+	Official                        bool
+	Private                         bool
+	DurationDays                    sql.NullInt32
+	RegistrationClosesBeforeEndDays int32
+	LanguageCodeAllowList           []string
+	ActivityTypeIDAllowList         []int32
+	TeamMode                        bool
+	TeamSizeLimit                   sql.NullInt16
+	TeamScoreAggregation            string
+	ScoringRuleSetID                uuid.NullUUID
+	Frequency                       string
+	IntervalCount                   int32
+	FirstContestStart               time.Time
+	LeadDays                        int32
+	NextOccurrence                  int32
+	Active                          bool
+	CreatedAt                       time.Time
+	UpdatedAt                       time.Time
+}
+
+type Goal struct {
+	ID                   uuid.UUID
+	UserID               uuid.UUID
+	Title                string
+	LanguageCode         sql.NullString
+	LogActivityID        sql.NullInt16
+	UnitKey              sql.NullString
+	Tag                  sql.NullString
+	Metric               string
+	Target               float32
+	Period               string
+	StartsOn             sql.NullTime
+	EndsOn               sql.NullTime
+	ProgressPeriodStart  sql.NullTime
+	ProgressPeriodEnd    sql.NullTime
+	ProgressCurrent      sql.NullFloat64
+	ProgressRecentPerDay sql.NullFloat64
+	ProgressRefreshedAt  sql.NullTime
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	DeletedAt            sql.NullTime
+}
+
 type Language struct {
 	// See https://en.wikipedia.org/wiki/Wikipedia:WikiProject_Languages/List_of_ISO_639-3_language_codes_(2019)
 	Code string
@@ -94,6 +191,19 @@ type Log struct {
 	ScoreRuleIds                []uuid.UUID
 	ScoreRates                  []float32
 	ScoreSource                 sql.NullString
+	MediaID                     uuid.NullUUID
+	ScoreRuleValues             []float32
+}
+
+type LogActivity struct {
+	ID                     int16
+	Name                   string
+	Default                bool
+	InputType              string
+	DurationScorePerMinute float32
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	Key                    string
 }
 
 type LogTag struct {
@@ -104,12 +214,27 @@ type LogTag struct {
 }
 
 type LogUnit struct {
-	ID            uuid.UUID
-	LogActivityID int16
-	Name          string
-	Modifier      float32
-	LanguageCode  sql.NullString
-	UnitKey       string
+	ID              uuid.UUID
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	UnitKey         string
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
+}
+
+type Medium struct {
+	ID              uuid.UUID
+	Title           string
+	MediaType       string
+	LanguageCode    sql.NullString
+	TotalLength     sql.NullFloat64
+	LengthUnit      sql.NullString
+	ExternalIds     json.RawMessage
+	CreatedByUserID uuid.NullUUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type ModerationAuditLog struct {
@@ -126,17 +251,54 @@ type PlatformScoringConfig struct {
 	ActiveRuleSetID uuid.UUID
 }
 
+type ScoringRescoreJob struct {
+	ID                uuid.UUID
+	Scope             string
+	ContestID         uuid.NullUUID
+	RuleSetID         uuid.UUID
+	DryRun            bool
+	Status            string
+	CursorLogID       uuid.NullUUID
+	TotalLogs         int32
+	ProcessedLogs     int32
+	ChangedLogs       int32
+	SkippedLogs       int32
+	LastError         sql.NullString
+	RequestedByUserID uuid.NullUUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	CompletedAt       sql.NullTime
+	CursorLoggedAt    sql.NullTime
+}
+
+type ScoringRescoreJobUserDiff struct {
+	JobID       uuid.UUID
+	UserID      uuid.UUID
+	ChangedLogs int32
+	ScoreBefore float32
+	ScoreAfter  float32
+}
+
 type ScoringRule struct {
-	ID           uuid.UUID
-	RuleSetID    uuid.UUID
-	Priority     int32
-	Stackable    bool
-	ActivityID   int16
-	UnitKey      sql.NullString
-	LanguageCode sql.NullString
-	Tag          sql.NullString
-	ScoreSource  string
-	Rate         float32
+	ID             uuid.UUID
+	RuleSetID      uuid.UUID
+	Priority       int32
+	Stackable      bool
+	ActivityID     int16
+	UnitKey        sql.NullString
+	LanguageCode   sql.NullString
+	Tag            sql.NullString
+	ScoreSource    string
+	Rate           float32
+	Tags           []string
+	TagMatch       sql.NullString
+	MinValue       sql.NullFloat64
+	MaxValue       sql.NullFloat64
+	EffectiveFrom  sql.NullTime
+	EffectiveUntil sql.NullTime
+	CapPeriod      sql.NullString
+	CapValue       sql.NullFloat64
+	CapScore       sql.NullFloat64
 }
 
 type ScoringRuleSet struct {
@@ -149,6 +311,7 @@ type ScoringRuleSet struct {
 	FallbackRuleSetID uuid.NullUUID
 	CreatedAt         time.Time
 	PublishedAt       sql.NullTime
+	ArchivedAt        sql.NullTime
 }
 
 type User struct {
@@ -158,8 +321,41 @@ type User struct {
 	UpdatedAt   time.Time
 }
 
+type UserErasure struct {
+	ID                    uuid.UUID
+	UserID                uuid.UUID
+	RequestedByUserID     uuid.UUID
+	Status                string
+	Attempts              int32
+	LastError             sql.NullString
+	DatabaseErasedAt      sql.NullTime
+	LeaderboardsErasedAt  sql.NullTime
+	RelationshipsErasedAt sql.NullTime
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	CompletedAt           sql.NullTime
+}
+
+type UserErasureAuditLog struct {
+	ID           int64
+	ErasureID    uuid.UUID
+	Event        string
+	Metadata     json.RawMessage
+	CreatedAt    time.Time
+	PreviousHash string
+	Hash         string
+}
+
 type UserRole struct {
 	UserID    uuid.UUID
 	Role      string
 	UpdatedAt time.Time
 }
+
+type UserSetting struct {
+	UserID          uuid.UUID
+	Timezone        string
+	StreakGraceDays int16
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
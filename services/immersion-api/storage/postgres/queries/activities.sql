@@ -0,0 +1,53 @@
+-- name: ListActivities :many
+select
+  id,
+  key,
+  name,
+  "default",
+  input_type,
+  duration_score_per_minute
+from log_activities
+order by id asc;
+
+-- name: CreateActivity :one
+-- IDs come from the identity column, the key is never updated.
+insert into log_activities (
+  key,
+  name,
+  "default",
+  input_type,
+  duration_score_per_minute
+) values (
+  sqlc.arg('key'),
+  sqlc.arg('name'),
+  sqlc.arg('default'),
+  sqlc.arg('input_type'),
+  sqlc.arg('duration_score_per_minute')
+)
+returning id, key, name, "default", input_type, duration_score_per_minute;
+
+-- name: UpdateActivity :one
+update log_activities
+set
+  name = sqlc.arg('name'),
+  "default" = sqlc.arg('default'),
+  input_type = sqlc.arg('input_type'),
+  duration_score_per_minute = sqlc.arg('duration_score_per_minute'),
+  updated_at = now()
+where id = sqlc.arg('id')
+returning id, key, name, "default", input_type, duration_score_per_minute;
+
+-- name: ActivityNameExists :one
+select exists (
+  select 1
+  from log_activities
+  where lower(name) = lower(sqlc.arg('name'))
+    and id <> sqlc.arg('exclude_id')
+);
+
+-- name: ActivityKeyExists :one
+select exists (
+  select 1
+  from log_activities
+  where key = sqlc.arg('key')
+);
@@ -0,0 +1,108 @@
+-- name: CreateContestInviteCode :one
+insert into contest_invite_codes (
+  contest_id,
+  code,
+  created_by_user_id,
+  expires_at,
+  max_uses
+) values (
+  sqlc.arg('contest_id'),
+  sqlc.arg('code'),
+  sqlc.arg('created_by_user_id'),
+  sqlc.narg('expires_at'),
+  sqlc.narg('max_uses')
+) returning *;
+
+-- name: ListContestInviteCodes :many
+select *
+from contest_invite_codes
+where contest_id = sqlc.arg('contest_id')
+order by created_at desc;
+
+-- name: RevokeContestInviteCode :one
+update contest_invite_codes
+set revoked_at = now()
+where
+  id = sqlc.arg('id')
+  and contest_id = sqlc.arg('contest_id')
+  and revoked_at is null
+returning id;
+
+-- name: LockContestInviteCode :one
+select *
+from contest_invite_codes
+where
+  contest_id = sqlc.arg('contest_id')
+  and code = sqlc.arg('code')
+for update;
+
+-- name: IncrementContestInviteCodeUses :exec
+update contest_invite_codes
+set use_count = use_count + 1
+where id = sqlc.arg('id');
+
+-- name: ContestInvitationExists :one
+select exists(
+  select 1
+  from contest_invitations
+  where
+    contest_id = sqlc.arg('contest_id')
+    and user_id = sqlc.arg('user_id')
+);
+
+-- name: CreateContestInvitation :exec
+insert into contest_invitations (
+  contest_id,
+  user_id,
+  invite_code_id,
+  invited_by_user_id
+) values (
+  sqlc.arg('contest_id'),
+  sqlc.arg('user_id'),
+  sqlc.narg('invite_code_id'),
+  sqlc.narg('invited_by_user_id')
+) on conflict (contest_id, user_id) do nothing;
+
+-- name: DeleteContestInvitation :exec
+delete from contest_invitations
+where
+  contest_id = sqlc.arg('contest_id')
+  and user_id = sqlc.arg('user_id');
+
+-- name: ListContestInvitations :many
+select
+  contest_invitations.contest_id,
+  contest_invitations.user_id,
+  coalesce(users.display_name, '')::varchar as user_display_name,
+  contest_invitations.invite_code_id,
+  contest_invitations.invited_by_user_id,
+  contest_invitations.created_at
+from contest_invitations
+left join users on (users.id = contest_invitations.user_id)
+where contest_invitations.contest_id = sqlc.arg('contest_id')
+order by contest_invitations.created_at desc;
+
+-- name: ListInvitedContestIDsForUser :many
+select contest_id
+from contest_invitations
+where user_id = sqlc.arg('user_id');
+
+-- name: DeleteContestInvitationsForUser :exec
+delete from contest_invitations
+where user_id = sqlc.arg('user_id');
+
+-- name: DeleteContestRegistration :exec
+delete from contest_registrations
+where
+  contest_id = sqlc.arg('contest_id')
+  and user_id = sqlc.arg('user_id');
+
+-- name: DetachContestLogsForUser :exec
+delete from contest_logs
+where
+  contest_id = sqlc.arg('contest_id')
+  and log_id in (
+    select logs.id
+    from logs
+    where logs.user_id = sqlc.arg('user_id')
+  );
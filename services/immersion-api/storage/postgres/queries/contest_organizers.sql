@@ -0,0 +1,37 @@
+-- name: CreateContestOrganizer :exec
+insert into contest_organizers (
+  contest_id,
+  user_id,
+  added_by_user_id
+) values (
+  sqlc.arg('contest_id'),
+  sqlc.arg('user_id'),
+  sqlc.arg('added_by_user_id')
+) on conflict (contest_id, user_id) do nothing;
+
+-- name: DeleteContestOrganizer :execrows
+delete from contest_organizers
+where
+  contest_id = sqlc.arg('contest_id')
+  and user_id = sqlc.arg('user_id');
+
+-- name: ListContestOrganizers :many
+select
+  contest_organizers.contest_id,
+  contest_organizers.user_id,
+  coalesce(users.display_name, '')::varchar as user_display_name,
+  contest_organizers.added_by_user_id,
+  contest_organizers.created_at
+from contest_organizers
+left join users on (users.id = contest_organizers.user_id)
+where contest_organizers.contest_id = sqlc.arg('contest_id')
+order by contest_organizers.created_at asc;
+
+-- name: ListOrganizedContestIDsForUser :many
+select contest_id
+from contest_organizers
+where user_id = sqlc.arg('user_id');
+
+-- name: DeleteContestOrganizersForUser :exec
+delete from contest_organizers
+where user_id = sqlc.arg('user_id');
@@ -0,0 +1,139 @@
+-- name: CreateContestTeam :one
+insert into contest_teams (
+  contest_id,
+  "name"
+) values (
+  sqlc.arg('contest_id'),
+  sqlc.arg('name')
+) returning id;
+
+-- name: ContestTeamNameExists :one
+select exists(
+  select 1
+  from contest_teams
+  where
+    contest_id = sqlc.arg('contest_id')
+    and lower("name") = lower(sqlc.arg('name'))
+);
+
+-- name: LockContestTeam :one
+-- Locks the team so concurrent joins cannot exceed the team size limit.
+select id
+from contest_teams
+where
+  id = sqlc.arg('id')
+  and contest_id = sqlc.arg('contest_id')
+for update;
+
+-- name: CountContestTeamMembers :one
+select count(*)::integer
+from contest_team_members
+where
+  team_id = sqlc.arg('team_id')
+  and user_id != sqlc.arg('user_id');
+
+-- name: FindContestTeamIDForUser :one
+select team_id
+from contest_team_members
+where
+  contest_id = sqlc.arg('contest_id')
+  and user_id = sqlc.arg('user_id');
+
+-- name: UpsertContestTeamMember :exec
+insert into contest_team_members (
+  contest_id,
+  user_id,
+  team_id
+) values (
+  sqlc.arg('contest_id'),
+  sqlc.arg('user_id'),
+  sqlc.arg('team_id')
+) on conflict (contest_id, user_id) do
+update set
+  team_id = sqlc.arg('team_id'),
+  joined_at = now();
+
+-- name: DeleteContestTeamMember :one
+delete from contest_team_members
+where
+  contest_id = sqlc.arg('contest_id')
+  and user_id = sqlc.arg('user_id')
+returning team_id;
+
+-- name: DeleteContestTeamIfEmpty :exec
+delete from contest_teams
+where
+  id = sqlc.arg('id')
+  and not exists (
+    select 1
+    from contest_team_members
+    where team_id = contest_teams.id
+  );
+
+-- name: ListContestTeams :many
+select
+  contest_teams.id,
+  contest_teams.contest_id,
+  contest_teams."name",
+  contest_teams.created_at
+from contest_teams
+where contest_teams.contest_id = sqlc.arg('contest_id')
+order by lower(contest_teams."name") asc;
+
+-- name: ListContestTeamMembers :many
+select
+  contest_team_members.team_id,
+  contest_team_members.user_id,
+  users.display_name as user_display_name,
+  contest_team_members.joined_at
+from contest_team_members
+inner join users on users.id = contest_team_members.user_id
+where contest_team_members.contest_id = sqlc.arg('contest_id')
+order by contest_team_members.joined_at asc;
+
+-- name: FindContestTeamSettings :one
+select
+  team_mode,
+  team_size_limit,
+  team_score_aggregation
+from contests
+where
+  id = sqlc.arg('id')
+  and deleted_at is null;
+
+-- name: ContestTeamScores :many
+-- Returns the summed member scores of every team in a contest. Members are
+-- only counted while they are registered for the contest.
+select
+  contest_teams.id as team_id,
+  count(members.user_id)::integer as member_count,
+  coalesce(sum(scores.score), 0)::real as total_score
+from contest_teams
+left join contest_team_members members
+  on members.team_id = contest_teams.id
+  and exists (
+    select 1
+    from contest_registrations cr
+    where
+      cr.contest_id = members.contest_id
+      and cr.user_id = members.user_id
+      and cr.deleted_at is null
+  )
+left join (
+  select
+    logs.user_id,
+    sum(coalesce(contest_logs.computed_score, contest_logs.score)) as score
+  from contest_logs
+  inner join logs on logs.id = contest_logs.log_id
+  where
+    contest_logs.contest_id = sqlc.arg('contest_id')
+    and logs.deleted_at is null
+  group by logs.user_id
+) scores on scores.user_id = members.user_id
+where contest_teams.contest_id = sqlc.arg('contest_id')
+group by contest_teams.id;
+
+-- name: EraseUserContestTeamMemberships :many
+delete from contest_team_members
+where user_id = sqlc.arg('user_id')
+returning contest_id, team_id;
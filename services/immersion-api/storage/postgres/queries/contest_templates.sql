@@ -0,0 +1,205 @@
+-- name: CreateContestTemplate :one
+insert into contest_templates (
+  owner_user_id,
+  owner_user_display_name,
+  title_pattern,
+  "description",
+  official,
+  "private",
+  duration_days,
+  registration_closes_before_end_days,
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  scoring_rule_set_id,
+  frequency,
+  interval_count,
+  first_contest_start,
+  lead_days
+) values (
+  sqlc.arg('owner_user_id'),
+  sqlc.arg('owner_user_display_name'),
+  sqlc.arg('title_pattern'),
+  sqlc.narg('description'),
+  sqlc.arg('official'),
+  sqlc.arg('private'),
+  sqlc.arg('duration_days'),
+  sqlc.arg('registration_closes_before_end_days'),
+  sqlc.arg('language_code_allow_list'),
+  sqlc.arg('activity_type_id_allow_list'),
+  sqlc.arg('team_mode'),
+  sqlc.narg('team_size_limit'),
+  sqlc.arg('team_score_aggregation'),
+  sqlc.narg('scoring_rule_set_id'),
+  sqlc.arg('frequency'),
+  sqlc.arg('interval_count'),
+  sqlc.arg('first_contest_start'),
+  sqlc.arg('lead_days')
+) returning *;
+
+-- name: UpdateContestTemplate :one
+update contest_templates
+set
+  title_pattern = sqlc.arg('title_pattern'),
+  "description" = sqlc.narg('description'),
+  "private" = sqlc.arg('private'),
+  duration_days = sqlc.arg('duration_days'),
+  registration_closes_before_end_days = sqlc.arg('registration_closes_before_end_days'),
+  language_code_allow_list = sqlc.arg('language_code_allow_list'),
+  activity_type_id_allow_list = sqlc.arg('activity_type_id_allow_list'),
+  team_mode = sqlc.arg('team_mode'),
+  team_size_limit = sqlc.narg('team_size_limit'),
+  team_score_aggregation = sqlc.arg('team_score_aggregation'),
+  scoring_rule_set_id = sqlc.narg('scoring_rule_set_id'),
+  lead_days = sqlc.arg('lead_days'),
+  active = sqlc.arg('active'),
+  updated_at = sqlc.arg('now')
+where id = sqlc.arg('id')
+returning *;
+
+-- name: FindContestTemplateByID :one
+select *
+from contest_templates
+where id = sqlc.arg('id');
+
+-- name: ListContestTemplates :many
+select *
+from contest_templates
+where (owner_user_id = sqlc.narg('user_id') or sqlc.narg('user_id') is null)
+order by created_at desc;
+
+-- name: ListActiveContestTemplates :many
+select *
+from contest_templates
+where active
+order by created_at asc;
+
+-- name: ListContestsForTemplate :many
+select
+  contests.id,
+  contests.template_occurrence,
+  contests.title,
+  contests.contest_start,
+  contests.contest_end,
+  contests.registration_end,
+  contests."private",
+  contests.deleted_at
+from contests
+where
+  contests.template_id = sqlc.arg('template_id')::uuid
+  and (sqlc.arg('include_private')::boolean or contests."private" = false)
+order by contests.template_occurrence desc;
+
+-- name: CreateContestFromTemplate :one
+insert into contests (
+  owner_user_id,
+  owner_user_display_name,
+  official,
+  "private",
+  contest_start,
+  contest_end,
+  registration_end,
+  title,
+  "description",
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template_id,
+  template_occurrence
+) select
+  owner_user_id,
+  owner_user_display_name,
+  official,
+  "private",
+  sqlc.arg('contest_start'),
+  sqlc.arg('contest_end'),
+  sqlc.arg('registration_end'),
+  sqlc.arg('title'),
+  "description",
+  language_code_allow_list,
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template.id,
+  sqlc.arg('occurrence')::integer
+from contest_templates as template
+where template.id = sqlc.arg('template_id')
+on conflict do nothing
+returning id;
+
+-- name: AdvanceContestTemplate :execrows
+update contest_templates
+set
+  next_occurrence = sqlc.arg('next_occurrence'),
+  updated_at = sqlc.arg('now')
+where
+  id = sqlc.arg('id')
+  and next_occurrence = sqlc.arg('current_occurrence');
+
+-- name: CopyScoringRuleSetToContest :one
+insert into scoring_rule_sets (
+  scope,
+  contest_id,
+  version,
+  status,
+  mode,
+  fallback_rule_set_id,
+  published_at
+) select
+  'contest',
+  sqlc.arg('contest_id')::uuid,
+  1,
+  'published',
+  mode,
+  fallback_rule_set_id,
+  sqlc.arg('published_at')::timestamp
+from scoring_rule_sets as source
+where source.id = sqlc.arg('source_rule_set_id')
+returning id;
+
+-- name: CopyScoringRules :exec
+insert into scoring_rules (
+  rule_set_id,
+  priority,
+  stackable,
+  activity_id,
+  unit_key,
+  language_code,
+  tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
+  score_source,
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
+) select
+  sqlc.arg('rule_set_id'),
+  priority,
+  stackable,
+  activity_id,
+  unit_key,
+  language_code,
+  tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
+  score_source,
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
+from scoring_rules as source
+where source.rule_set_id = sqlc.arg('source_rule_set_id');
@@ -10,7 +10,10 @@ insert into contests (
   title,
   "description",
   language_code_allow_list,
-  activity_type_id_allow_list
+  activity_type_id_allow_list,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation
 ) values (
   sqlc.arg('owner_user_id'),
   sqlc.arg('owner_user_display_name'),
@@ -22,7 +25,10 @@ insert into contests (
   sqlc.arg('title'),
   sqlc.arg('description'),
   sqlc.arg('language_code_allow_list'),
-  sqlc.arg('activity_type_id_allow_list')
+  sqlc.arg('activity_type_id_allow_list'),
+  sqlc.arg('team_mode'),
+  sqlc.narg('team_size_limit'),
+  sqlc.arg('team_score_aggregation')
 ) returning id;
 
 -- name: GetContestsByUserCountForYear :one
@@ -41,6 +47,8 @@ set
   registration_end = sqlc.arg('registration_end'),
   title = sqlc.arg('title'),
   "description" = sqlc.arg('description'),
+  language_code_allow_list = sqlc.arg('language_code_allow_list'),
+  activity_type_id_allow_list = sqlc.arg('activity_type_id_allow_list'),
   updated_at = now()
 where
   id = sqlc.arg('id')
@@ -55,6 +63,36 @@ where
   and deleted_at is null
 returning id;
 
+-- name: RestrictContestRegistrationLanguages :exec
+update contest_registrations
+set
+  language_codes = array(
+    select code
+    from unnest(contest_registrations.language_codes) as code
+    where code = any(sqlc.arg('language_codes')::varchar[])
+  ),
+  updated_at = now()
+where
+  contest_id = sqlc.arg('contest_id')
+  and deleted_at is null
+  and not (language_codes <@ sqlc.arg('language_codes')::varchar[]);
+
+-- name: DetachContestLogsOutsideLanguages :many
+with detached as (
+  delete from contest_logs
+  where
+    contest_logs.contest_id = sqlc.arg('contest_id')
+    and contest_logs.log_id in (
+      select logs.id
+      from logs
+      where logs.language_code <> all(sqlc.arg('language_codes')::varchar[])
+    )
+  returning contest_logs.log_id
+)
+select distinct logs.user_id
+from detached
+inner join logs on (logs.id = detached.log_id);
+
 -- name: ListContests :many
 select
   contests.id,
@@ -69,6 +107,9 @@ select
   language_code_allow_list,
   activity_type_id_allow_list,
   official,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
   contests.created_at,
   contests.updated_at,
   contests.deleted_at
@@ -97,6 +138,10 @@ select
   language_code_allow_list,
   activity_type_id_allow_list,
   official,
+  team_mode,
+  team_size_limit,
+  team_score_aggregation,
+  template_id,
   contests.created_at,
   contests.updated_at,
   contests.deleted_at
@@ -0,0 +1,81 @@
+-- name: ListLogsForExport :many
+select
+  logs.id,
+  logs.language_code,
+  languages.name as language_name,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(logs.unit_key, '') as unit_key,
+  coalesce(log_units.name, '') as unit_name,
+  logs.description,
+  logs.media_id,
+  logs.amount,
+  logs.modifier,
+  logs.duration_seconds,
+  coalesce(logs.computed_score, logs.score) as score,
+  logs.score_rule_set_id,
+  logs.score_rule_ids,
+  logs.score_rates,
+  logs.score_rule_values,
+  logs.score_source,
+  logs.eligible_official_leaderboard,
+  logs.created_at,
+  logs.updated_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  ) as tags,
+  coalesce(
+    (select array_agg(contest_id::text order by contest_id) from contest_logs where log_id = logs.id),
+    array[]::text[]
+  ) as contest_ids
+from logs
+inner join languages on (languages.code = logs.language_code)
+left join log_units on (log_units.id = logs.unit_id)
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+  and (logs.created_at, logs.id) > (sqlc.arg('after_created_at')::timestamp, sqlc.arg('after_id')::uuid)
+order by logs.created_at asc, logs.id asc
+limit sqlc.arg('page_size');
+
+-- name: ListContestRegistrationsForExport :many
+select
+  contest_registrations.id,
+  contest_registrations.contest_id,
+  contest_registrations.language_codes,
+  contest_registrations.created_at,
+  contests.title,
+  contests.contest_start,
+  contests.contest_end,
+  contests.official,
+  contests.private,
+  coalesce((
+    select sum(coalesce(contest_logs.computed_score, contest_logs.score))
+    from contest_logs
+    inner join logs on (logs.id = contest_logs.log_id)
+    where
+      contest_logs.contest_id = contest_registrations.contest_id
+      and logs.user_id = contest_registrations.user_id
+      and logs.deleted_at is null
+  ), 0)::real as score
+from contest_registrations
+inner join contests on (contests.id = contest_registrations.contest_id)
+where
+  contest_registrations.user_id = sqlc.arg('user_id')
+  and contest_registrations.deleted_at is null
+order by contests.contest_start asc, contest_registrations.id asc;
+
+-- name: YearlyScoresForExport :many
+select
+  logs.year,
+  logs.language_code,
+  languages.name as language_name,
+  sum(coalesce(logs.computed_score, logs.score))::real as score
+from logs
+inner join languages on (languages.code = logs.language_code)
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+group by logs.year, logs.language_code, languages.name
+order by logs.year asc, score desc;
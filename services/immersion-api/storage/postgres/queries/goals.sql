@@ -0,0 +1,143 @@
+-- name: CreateGoal :exec
+insert into goals (
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  created_at,
+  updated_at
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('user_id'),
+  sqlc.arg('title'),
+  sqlc.narg('language_code'),
+  sqlc.narg('log_activity_id'),
+  sqlc.narg('unit_key'),
+  sqlc.narg('tag'),
+  sqlc.arg('metric'),
+  sqlc.arg('target'),
+  sqlc.arg('period'),
+  sqlc.narg('starts_on'),
+  sqlc.narg('ends_on'),
+  sqlc.arg('now'),
+  sqlc.arg('now')
+);
+
+-- name: UpdateGoal :exec
+update goals
+set
+  title = sqlc.arg('title'),
+  language_code = sqlc.narg('language_code'),
+  log_activity_id = sqlc.narg('log_activity_id'),
+  unit_key = sqlc.narg('unit_key'),
+  tag = sqlc.narg('tag'),
+  metric = sqlc.arg('metric'),
+  target = sqlc.arg('target'),
+  period = sqlc.arg('period'),
+  starts_on = sqlc.narg('starts_on'),
+  ends_on = sqlc.narg('ends_on'),
+  progress_period_start = null,
+  progress_period_end = null,
+  progress_current = null,
+  progress_recent_per_day = null,
+  progress_refreshed_at = null,
+  updated_at = sqlc.arg('now')
+where id = sqlc.arg('id') and deleted_at is null;
+
+-- name: DeleteGoal :exec
+update goals
+set deleted_at = sqlc.arg('now')
+where id = sqlc.arg('id') and deleted_at is null;
+
+-- name: FindGoalByID :one
+select
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  progress_period_start,
+  progress_period_end,
+  progress_current,
+  progress_recent_per_day,
+  progress_refreshed_at,
+  created_at,
+  updated_at
+from goals
+where id = sqlc.arg('id') and deleted_at is null;
+
+-- name: ListGoalsForUser :many
+select
+  id,
+  user_id,
+  title,
+  language_code,
+  log_activity_id,
+  unit_key,
+  tag,
+  metric,
+  target,
+  period,
+  starts_on,
+  ends_on,
+  progress_period_start,
+  progress_period_end,
+  progress_current,
+  progress_recent_per_day,
+  progress_refreshed_at,
+  created_at,
+  updated_at
+from goals
+where user_id = sqlc.arg('user_id') and deleted_at is null
+order by created_at asc;
+
+-- name: UpdateGoalProgress :exec
+update goals
+set
+  progress_period_start = sqlc.arg('period_start'),
+  progress_period_end = sqlc.arg('period_end'),
+  progress_current = sqlc.arg('current'),
+  progress_recent_per_day = sqlc.arg('recent_per_day'),
+  progress_refreshed_at = sqlc.arg('refreshed_at')
+where id = sqlc.arg('id') and deleted_at is null;
+
+-- name: FetchGoalMetricTotals :one
+select
+  coalesce(sum(coalesce(logs.computed_score, logs.score)), 0)::real as score,
+  coalesce(sum(logs.amount), 0)::real as amount,
+  coalesce(sum(logs.duration_seconds), 0)::real as duration_seconds
+from logs
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+  and logs.created_at >= sqlc.arg('from')
+  and logs.created_at < sqlc.arg('to')
+  and (logs.language_code = sqlc.narg('language_code') or sqlc.narg('language_code') is null)
+  and (logs.log_activity_id = sqlc.narg('activity_id')::integer or sqlc.narg('activity_id') is null)
+  and (logs.unit_key = sqlc.narg('unit_key') or sqlc.narg('unit_key') is null)
+  and (
+    sqlc.narg('tag')::varchar is null
+    or exists (
+      select 1 from log_tags
+      where log_tags.log_id = logs.id and log_tags.tag = sqlc.narg('tag')::varchar
+    )
+  );
+
+-- name: DeleteGoalsForUser :exec
+delete from goals
+where user_id = sqlc.arg('user_id');
@@ -97,11 +97,13 @@ offset sqlc.arg('start_from');
 
 -- name: ContestLeaderboardAllScores :many
 -- Returns all user scores for a contest without pagination/ranking.
--- Used for rebuilding the Redis leaderboard sorted set.
+-- Used for rebuilding the Redis leaderboard sorted set. Cancelled contests
+-- have no scores, so rebuilding their board clears it.
 select
   cr.user_id,
   coalesce(scores.score, 0)::real as score
 from contest_registrations cr
+inner join contests on contests.id = cr.contest_id
 left join (
   select
     logs.user_id,
@@ -115,7 +117,8 @@ left join (
 ) scores on scores.user_id = cr.user_id
 where
   cr.contest_id = sqlc.arg('contest_id')
-  and cr.deleted_at is null;
+  and cr.deleted_at is null
+  and contests.deleted_at is null;
 
 -- name: YearlyLeaderboardAllScores :many
 -- Returns all user scores for a year without pagination/ranking.
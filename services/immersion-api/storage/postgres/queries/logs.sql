@@ -13,9 +13,11 @@ insert into logs (
   score_rule_set_id,
   score_rule_ids,
   score_rates,
+  score_rule_values,
   score_source,
   eligible_official_leaderboard,
-  "description"
+  "description",
+  media_id
 ) values (
   sqlc.arg('id'),
   sqlc.arg('user_id'),
@@ -30,9 +32,54 @@ insert into logs (
   sqlc.arg('score_rule_set_id'),
   sqlc.arg('score_rule_ids'),
   sqlc.arg('score_rates'),
+  sqlc.arg('score_rule_values'),
   sqlc.arg('score_source'),
   sqlc.arg('eligible_official_leaderboard'),
-  sqlc.arg('description')
+  sqlc.arg('description'),
+  sqlc.narg('media_id')
+) returning id;
+
+-- name: CreateImportedLog :one
+insert into logs (
+  id,
+  user_id,
+  language_code,
+  log_activity_id,
+  unit_id,
+  unit_key,
+  amount,
+  modifier,
+  duration_seconds,
+  computed_score,
+  score_rule_set_id,
+  score_rule_ids,
+  score_rates,
+  score_rule_values,
+  score_source,
+  eligible_official_leaderboard,
+  "description",
+  created_at,
+  updated_at
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('user_id'),
+  sqlc.arg('language_code'),
+  sqlc.arg('log_activity_id'),
+  sqlc.arg('unit_id'),
+  sqlc.arg('unit_key'),
+  sqlc.arg('amount'),
+  sqlc.arg('modifier'),
+  sqlc.arg('duration_seconds'),
+  sqlc.arg('computed_score'),
+  sqlc.arg('score_rule_set_id'),
+  sqlc.arg('score_rule_ids'),
+  sqlc.arg('score_rates'),
+  sqlc.arg('score_rule_values'),
+  sqlc.arg('score_source'),
+  sqlc.arg('eligible_official_leaderboard'),
+  sqlc.arg('description'),
+  sqlc.arg('created_at'),
+  sqlc.arg('created_at')
 ) returning id;
 
 -- name: CreateContestLogRelation :exec
@@ -47,6 +94,7 @@ insert into contest_logs (
   score_rule_set_id,
   score_rule_ids,
   score_rates,
+  score_rule_values,
   score_source
 ) values (
   (select contest_id from contest_registrations where id = sqlc.arg('registration_id')),
@@ -59,6 +107,7 @@ insert into contest_logs (
   sqlc.arg('score_rule_set_id'),
   sqlc.arg('score_rule_ids'),
   sqlc.arg('score_rates'),
+  sqlc.arg('score_rule_values'),
   sqlc.arg('score_source')
 );
 
@@ -81,6 +130,7 @@ with eligible_logs as (
     contest_logs.score_rule_set_id,
     contest_logs.score_rule_ids,
     contest_logs.score_rates,
+    contest_logs.score_rule_values,
     contest_logs.score_source,
     logs.created_at,
     logs.updated_at,
@@ -120,6 +170,7 @@ with eligible_logs as (
     coalesce(logs.unit_key, '') as unit_key,
     coalesce(log_units.name, '') as unit_name,
     logs.description,
+    logs.media_id,
     logs.amount,
     logs.modifier,
     logs.duration_seconds,
@@ -127,6 +178,7 @@ with eligible_logs as (
     logs.score_rule_set_id,
     logs.score_rule_ids,
     logs.score_rates,
+    logs.score_rule_values,
     logs.score_source,
     logs.created_at,
     logs.updated_at,
@@ -162,6 +214,7 @@ select
   coalesce(logs.unit_key, '') as unit_key,
   coalesce(log_units.name, '') as unit_name,
   logs.description,
+  logs.media_id,
   logs.amount,
   logs.modifier,
   logs.duration_seconds,
@@ -169,6 +222,7 @@ select
   logs.score_rule_set_id,
   logs.score_rule_ids,
   logs.score_rates,
+  logs.score_rule_values,
   logs.score_source,
   logs.eligible_official_leaderboard,
   logs.created_at,
@@ -205,19 +259,58 @@ inner join contest_registrations on (
 inner join users as owner_users on (owner_users.id = contests.owner_user_id)
 where log_id = sqlc.arg('id');
 
+-- name: ListContestLogScoresForLog :many
+select
+  contest_registrations.id as registration_id,
+  contest_logs.contest_id,
+  logs.unit_id,
+  coalesce(contest_logs.unit_key, '') as unit_key,
+  contest_logs.amount,
+  contest_logs.modifier,
+  contest_logs.duration_seconds,
+  coalesce(contest_logs.computed_score, contest_logs.score) as score,
+  contest_logs.score_rule_set_id,
+  contest_logs.score_rule_ids,
+  contest_logs.score_rates,
+  contest_logs.score_rule_values,
+  contest_logs.score_source
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+inner join contest_registrations on (
+  contest_registrations.contest_id = contest_logs.contest_id
+  and contest_registrations.user_id = logs.user_id
+)
+where contest_logs.log_id = sqlc.arg('log_id')
+order by contest_logs.contest_id;
+
 -- name: YearlyActivityForUser :many
+-- Days are bucketed in the user's time zone, the range bounds the local year.
 select
   sum(coalesce(computed_score, score))::real as score,
   count(id) as update_count,
-  created_at::date as "date"
+  (created_at at time zone 'UTC' at time zone sqlc.arg('timezone')::text)::date as "date"
 from logs
 where
   user_id = sqlc.arg('user_id')
-  and year = sqlc.arg('year')
+  and created_at >= sqlc.arg('from')
+  and created_at < sqlc.arg('to')
   and deleted_at is null
 group by "date"
 order by date asc;
 
+-- name: ActivityDaysForUser :many
+-- Distinct days with logs per language, bucketed in the user's time zone.
+select distinct
+  logs.language_code,
+  languages.name as language_name,
+  (logs.created_at at time zone 'UTC' at time zone sqlc.arg('timezone')::text)::date as "date"
+from logs
+inner join languages on (languages.code = logs.language_code)
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+order by "date" asc, logs.language_code asc;
+
 -- name: FetchScoresForProfile :many
 select
   language_code,
@@ -304,8 +397,10 @@ set
   score_rule_set_id = sqlc.arg('score_rule_set_id'),
   score_rule_ids = sqlc.arg('score_rule_ids'),
   score_rates = sqlc.arg('score_rates'),
+  score_rule_values = sqlc.arg('score_rule_values'),
   score_source = sqlc.arg('score_source'),
   "description" = sqlc.arg('description'),
+  media_id = sqlc.narg('media_id'),
   updated_at = sqlc.arg('now')
 where
   id = sqlc.arg('log_id')
@@ -322,6 +417,7 @@ set
   score_rule_set_id = sqlc.arg('score_rule_set_id'),
   score_rule_ids = sqlc.arg('score_rule_ids'),
   score_rates = sqlc.arg('score_rates'),
+  score_rule_values = sqlc.arg('score_rule_values'),
   score_source = sqlc.arg('score_source')
 from contests
 where
@@ -340,6 +436,7 @@ set
   score_rule_set_id = sqlc.arg('score_rule_set_id'),
   score_rule_ids = sqlc.arg('score_rule_ids'),
   score_rates = sqlc.arg('score_rates'),
+  score_rule_values = sqlc.arg('score_rule_values'),
   score_source = sqlc.arg('score_source')
 from contests
 where
@@ -0,0 +1,107 @@
+-- name: CreateMedia :one
+insert into media (
+  id,
+  title,
+  media_type,
+  language_code,
+  total_length,
+  length_unit,
+  external_ids,
+  created_by_user_id
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('title'),
+  sqlc.arg('media_type'),
+  sqlc.narg('language_code'),
+  sqlc.narg('total_length'),
+  sqlc.narg('length_unit'),
+  sqlc.arg('external_ids'),
+  sqlc.arg('created_by_user_id')
+)
+returning id, title, media_type, language_code, total_length, length_unit, external_ids, created_by_user_id, created_at, updated_at;
+
+-- name: UpdateMedia :one
+update media
+set
+  title = sqlc.arg('title'),
+  media_type = sqlc.arg('media_type'),
+  language_code = sqlc.narg('language_code'),
+  total_length = sqlc.narg('total_length'),
+  length_unit = sqlc.narg('length_unit'),
+  external_ids = sqlc.arg('external_ids'),
+  updated_at = now()
+where id = sqlc.arg('id')
+returning id, title, media_type, language_code, total_length, length_unit, external_ids, created_by_user_id, created_at, updated_at;
+
+-- name: FindMediaByID :one
+select id, title, media_type, language_code, total_length, length_unit, external_ids, created_by_user_id, created_at, updated_at
+from media
+where id = sqlc.arg('id');
+
+-- name: MediaExists :one
+select exists (select 1 from media where id = sqlc.arg('id'));
+
+-- name: FindMediaByExternalID :one
+select id
+from media
+where external_ids->>sqlc.arg('key')::text = sqlc.arg('value')::text
+limit 1;
+
+-- name: SearchMedia :many
+-- Titles match on trigram similarity so typos and partial titles still find
+-- the media, identifiers like ISBNs match exactly.
+select
+  id,
+  title,
+  media_type,
+  language_code,
+  total_length,
+  length_unit,
+  external_ids,
+  created_by_user_id,
+  created_at,
+  updated_at
+from media
+where
+  (sqlc.narg('language_code')::varchar is null or language_code is null or language_code = sqlc.narg('language_code'))
+  and (
+    lower(title) % lower(sqlc.arg('query'))
+    -- the query is matched literally, not as a like pattern
+    or lower(title) like '%' || replace(replace(replace(lower(sqlc.arg('query')), '\', '\\'), '%', '\%'), '_', '\_') || '%' escape '\'
+    or external_ids->>'isbn' = sqlc.arg('query')
+  )
+order by similarity(lower(title), lower(sqlc.arg('query'))) desc, title asc
+limit sqlc.arg('limit');
+
+-- name: SumMediaLogsForUser :many
+select
+  logs.media_id::uuid as media_id,
+  log_units.media_length_unit,
+  coalesce(sum(logs.amount), 0)::real as amount,
+  coalesce(sum(logs.duration_seconds), 0)::bigint as duration_seconds,
+  count(*) as log_count,
+  max(logs.created_at)::timestamp as last_logged_at
+from logs
+left join log_units on log_units.id = logs.unit_id
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+  and logs.media_id is not null
+  and (sqlc.narg('media_id')::uuid is null or logs.media_id = sqlc.narg('media_id'))
+group by logs.media_id, log_units.media_length_unit;
+
+-- name: ListMediaByIDs :many
+select id, title, media_type, language_code, total_length, length_unit, external_ids, created_by_user_id, created_at, updated_at
+from media
+where id = any(sqlc.arg('ids')::uuid[]);
+
+-- name: LinkLogsToMediaByDescription :execrows
+update logs
+set
+  media_id = sqlc.arg('media_id'),
+  updated_at = now()
+where
+  user_id = sqlc.arg('user_id')
+  and deleted_at is null
+  and media_id is null
+  and lower(trim("description")) = any(sqlc.arg('descriptions')::text[]);
@@ -22,7 +22,8 @@ inner join users
 where
   user_id = sqlc.arg('user_id')
   and contest_id = sqlc.arg('contest_id')
-  and contest_registrations.deleted_at is null;
+  and contest_registrations.deleted_at is null
+  and contests.deleted_at is null;
 
 -- name: UpsertContestRegistration :one
 insert into contest_registrations (
@@ -69,7 +70,8 @@ where
   user_id = sqlc.arg('user_id')
   and contests.contest_start <= sqlc.arg('now')::timestamp
   and (contests.contest_end + '1 day'::interval) > sqlc.arg('now')::timestamp
-  and contest_registrations.deleted_at is null;
+  and contest_registrations.deleted_at is null
+  and contests.deleted_at is null;
 
 
 -- name: FindYearlyContestRegistrationForUser :many
@@ -96,4 +98,5 @@ where
   user_id = sqlc.arg('user_id')
   and (contests.private != true or sqlc.arg('include_private')::boolean)
   and extract(year from contests.contest_start) = sqlc.arg('year')::integer
-  and contest_registrations.deleted_at is null;
\ No newline at end of file
+  and contest_registrations.deleted_at is null
+  and contests.deleted_at is null;
\ No newline at end of file
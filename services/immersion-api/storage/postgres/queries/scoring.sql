@@ -26,6 +26,7 @@ order by priority asc;
 select *
 from scoring_rule_sets
 where scope = 'platform'
+  and status <> 'archived'
 order by version desc;
 
 -- name: ListContestScoringRuleSets :many
@@ -33,6 +34,7 @@ select *
 from scoring_rule_sets
 where scope = 'contest'
   and contest_id = sqlc.arg('contest_id')
+  and status <> 'archived'
 order by version desc;
 
 -- name: NextPlatformScoringRuleSetVersion :one
@@ -76,8 +78,17 @@ insert into scoring_rules (
   unit_key,
   language_code,
   tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
   score_source,
-  rate
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
 ) values (
   sqlc.arg('id'),
   sqlc.arg('rule_set_id'),
@@ -87,8 +98,17 @@ insert into scoring_rules (
   sqlc.arg('unit_key'),
   sqlc.arg('language_code'),
   sqlc.arg('tag'),
+  sqlc.arg('tags'),
+  sqlc.narg('tag_match'),
+  sqlc.narg('min_value'),
+  sqlc.narg('max_value'),
+  sqlc.narg('effective_from'),
+  sqlc.narg('effective_until'),
   sqlc.arg('score_source'),
-  sqlc.arg('rate')
+  sqlc.arg('rate'),
+  sqlc.narg('cap_period'),
+  sqlc.narg('cap_value'),
+  sqlc.narg('cap_score')
 );
 
 -- name: PublishScoringRuleSet :one
@@ -100,6 +120,45 @@ where id = sqlc.arg('id')
   and status = 'draft'
 returning *;
 
+-- name: UpdateScoringRuleSetDraft :one
+update scoring_rule_sets
+set
+  mode = sqlc.arg('mode'),
+  fallback_rule_set_id = sqlc.arg('fallback_rule_set_id')
+where id = sqlc.arg('id')
+  and status = 'draft'
+returning *;
+
+-- name: DeleteScoringRulesForRuleSet :exec
+delete from scoring_rules
+where rule_set_id = sqlc.arg('rule_set_id');
+
+-- name: DeleteScoringRuleSetDraft :execrows
+delete from scoring_rule_sets
+where id = sqlc.arg('id')
+  and status = 'draft';
+
+-- name: ArchiveScoringRuleSet :one
+-- Active rule sets keep scoring new logs and cannot be archived.
+update scoring_rule_sets
+set
+  status = 'archived',
+  archived_at = sqlc.arg('archived_at')
+where scoring_rule_sets.id = sqlc.arg('id')
+  and scoring_rule_sets.status = 'published'
+  and not exists (
+    select 1
+    from platform_scoring_config
+    where platform_scoring_config.active_rule_set_id = scoring_rule_sets.id
+  )
+  and not exists (
+    select 1
+    from contests
+    where contests.scoring_rule_set_id = scoring_rule_sets.id
+      and contests.deleted_at is null
+  )
+returning *;
+
 -- name: ActivatePlatformScoringRuleSet :exec
 insert into platform_scoring_config (
   singleton,
@@ -118,3 +177,86 @@ set
   updated_at = sqlc.arg('updated_at')
 where id = sqlc.arg('contest_id')
   and deleted_at is null;
+
+-- name: ListLogsForScoringImpact :many
+select
+  logs.id,
+  logs.user_id,
+  users.display_name as user_display_name,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(logs.unit_key, '') as unit_key,
+  logs.amount,
+  logs.modifier,
+  logs.duration_seconds,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone
+from logs
+inner join users on (users.id = logs.user_id)
+where
+  logs.deleted_at is null
+  and (
+    sqlc.narg('contest_id')::uuid is null
+    or exists (
+      select 1
+      from contest_logs
+      where
+        contest_logs.log_id = logs.id
+        and contest_logs.contest_id = sqlc.narg('contest_id')::uuid
+    )
+  )
+  and (
+    sqlc.narg('registered_contest_id')::uuid is null
+    or exists (
+      select 1
+      from contest_registrations
+      where
+        contest_registrations.user_id = logs.user_id
+        and contest_registrations.contest_id = sqlc.narg('registered_contest_id')::uuid
+        and contest_registrations.deleted_at is null
+    )
+  )
+  and (cardinality(sqlc.arg('language_codes')::varchar[]) = 0 or logs.language_code = any(sqlc.arg('language_codes')::varchar[]))
+  and (cardinality(sqlc.arg('activity_ids')::integer[]) = 0 or logs.log_activity_id = any(sqlc.arg('activity_ids')::integer[]))
+order by logs.created_at desc
+limit sqlc.arg('sample_size');
+
+-- name: SumPlatformScoringRuleUsage :one
+select
+  coalesce(sum(
+    logs.score_rule_values[array_position(logs.score_rule_ids, sqlc.arg('rule_id')::uuid)]
+  ), 0)::real as value,
+  coalesce(sum(logs.computed_score), 0)::real as score
+from logs
+where
+  logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+  and logs.created_at >= sqlc.arg('from')
+  and logs.created_at < sqlc.arg('until')
+  and sqlc.arg('rule_id')::uuid = any(logs.score_rule_ids)
+  and (sqlc.narg('exclude_log_id')::uuid is null or logs.id <> sqlc.narg('exclude_log_id')::uuid);
+
+-- name: SumContestScoringRuleUsage :one
+select
+  coalesce(sum(
+    contest_logs.score_rule_values[array_position(contest_logs.score_rule_ids, sqlc.arg('rule_id')::uuid)]
+  ), 0)::real as value,
+  coalesce(sum(contest_logs.computed_score), 0)::real as score
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = sqlc.arg('contest_id')
+  and logs.user_id = sqlc.arg('user_id')
+  and logs.deleted_at is null
+  and logs.created_at >= sqlc.arg('from')
+  and logs.created_at < sqlc.arg('until')
+  and sqlc.arg('rule_id')::uuid = any(contest_logs.score_rule_ids)
+  and (sqlc.narg('exclude_log_id')::uuid is null or logs.id <> sqlc.narg('exclude_log_id')::uuid);
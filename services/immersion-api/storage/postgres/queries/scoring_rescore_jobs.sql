@@ -0,0 +1,247 @@
+-- name: CountLogsForPlatformRescore :one
+select count(*)::integer
+from logs
+where deleted_at is null;
+
+-- name: CountLogsForContestRescore :one
+select count(*)::integer
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = sqlc.arg('contest_id')
+  and logs.deleted_at is null;
+
+-- name: SupersedeScoringRescoreJobs :exec
+update scoring_rescore_jobs
+set
+  "status" = 'superseded',
+  updated_at = sqlc.arg('now')
+where
+  dry_run = false
+  and "status" in ('pending', 'running')
+  and scope = sqlc.arg('scope')
+  and contest_id is not distinct from sqlc.narg('contest_id')::uuid;
+
+-- name: CreateScoringRescoreJob :one
+insert into scoring_rescore_jobs (
+  scope,
+  contest_id,
+  rule_set_id,
+  dry_run,
+  total_logs,
+  requested_by_user_id,
+  created_at,
+  updated_at
+) values (
+  sqlc.arg('scope'),
+  sqlc.narg('contest_id'),
+  sqlc.arg('rule_set_id'),
+  sqlc.arg('dry_run'),
+  sqlc.arg('total_logs'),
+  sqlc.narg('requested_by_user_id'),
+  sqlc.arg('now'),
+  sqlc.arg('now')
+)
+returning *;
+
+-- name: FindScoringRescoreJobByID :one
+select *
+from scoring_rescore_jobs
+where id = sqlc.arg('id');
+
+-- name: ListScoringRescoreJobs :many
+select *
+from scoring_rescore_jobs
+where
+  (sqlc.narg('contest_id')::uuid is null and scope = 'platform')
+  or contest_id = sqlc.narg('contest_id')::uuid
+order by created_at desc
+limit sqlc.arg('max_results');
+
+-- name: FindNextScoringRescoreJob :one
+select *
+from scoring_rescore_jobs
+where "status" in ('pending', 'running')
+order by created_at asc
+limit 1;
+
+-- name: LockScoringRescoreJob :one
+select *
+from scoring_rescore_jobs
+where id = sqlc.arg('id')
+for update;
+
+-- name: AdvanceScoringRescoreJob :exec
+update scoring_rescore_jobs
+set
+  "status" = sqlc.arg('status'),
+  cursor_log_id = sqlc.narg('cursor_log_id'),
+  cursor_logged_at = sqlc.narg('cursor_logged_at'),
+  processed_logs = processed_logs + sqlc.arg('processed_logs'),
+  changed_logs = changed_logs + sqlc.arg('changed_logs'),
+  skipped_logs = skipped_logs + sqlc.arg('skipped_logs'),
+  updated_at = sqlc.arg('now'),
+  completed_at = sqlc.narg('completed_at')
+where id = sqlc.arg('id');
+
+-- name: FailScoringRescoreJob :exec
+update scoring_rescore_jobs
+set
+  "status" = 'failed',
+  last_error = sqlc.arg('last_error'),
+  updated_at = sqlc.arg('now')
+where
+  id = sqlc.arg('id')
+  and "status" in ('pending', 'running');
+
+-- name: UpsertScoringRescoreJobUserDiff :exec
+insert into scoring_rescore_job_user_diffs (
+  job_id,
+  user_id,
+  changed_logs,
+  score_before,
+  score_after
+) values (
+  sqlc.arg('job_id'),
+  sqlc.arg('user_id'),
+  sqlc.arg('changed_logs'),
+  sqlc.arg('score_before'),
+  sqlc.arg('score_after')
+)
+on conflict (job_id, user_id) do update
+set
+  changed_logs = scoring_rescore_job_user_diffs.changed_logs + excluded.changed_logs,
+  score_before = scoring_rescore_job_user_diffs.score_before + excluded.score_before,
+  score_after = scoring_rescore_job_user_diffs.score_after + excluded.score_after;
+
+-- name: ListScoringRescoreJobUserDiffs :many
+select
+  diffs.user_id,
+  coalesce(users.display_name, '') as user_display_name,
+  diffs.changed_logs,
+  diffs.score_before,
+  diffs.score_after,
+  count(*) over() as total_size
+from scoring_rescore_job_user_diffs as diffs
+left join users on (users.id = diffs.user_id)
+where diffs.job_id = sqlc.arg('job_id')
+order by abs(diffs.score_after - diffs.score_before) desc, diffs.user_id asc
+limit sqlc.arg('page_size')
+offset sqlc.arg('start_from');
+
+-- name: ListLogsForPlatformRescore :many
+select
+  logs.id,
+  logs.user_id,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(logs.unit_key, '') as unit_key,
+  logs.amount,
+  logs.modifier,
+  logs.duration_seconds,
+  coalesce(logs.computed_score, logs.score) as score,
+  logs.score_rule_set_id,
+  logs.score_rule_ids,
+  logs.score_rates,
+  logs.score_rule_values,
+  logs.score_source,
+  logs.eligible_official_leaderboard,
+  logs.year,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone,
+  -- ongoing contests without their own rule set copy the platform score
+  coalesce(
+    (
+      select array_agg(contest_logs.contest_id)
+      from contest_logs
+      inner join contests on (contests.id = contest_logs.contest_id)
+      where
+        contest_logs.log_id = logs.id
+        and contests.contest_end >= sqlc.arg('now')
+        and contests.scoring_rule_set_id is null
+    ),
+    array[]::uuid[]
+  )::uuid[] as contest_ids
+from logs
+where
+  logs.deleted_at is null
+  and (
+    sqlc.narg('after_log_id')::uuid is null
+    or (logs.created_at, logs.id) > (sqlc.narg('after_logged_at')::timestamp, sqlc.narg('after_log_id')::uuid)
+  )
+order by logs.created_at asc, logs.id asc
+limit sqlc.arg('batch_size');
+
+-- name: ListLogsForContestRescore :many
+select
+  logs.id,
+  logs.user_id,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(contest_logs.unit_key, '') as unit_key,
+  contest_logs.amount,
+  contest_logs.modifier,
+  contest_logs.duration_seconds,
+  coalesce(contest_logs.computed_score, contest_logs.score) as score,
+  contest_logs.score_rule_set_id,
+  contest_logs.score_rule_ids,
+  contest_logs.score_rates,
+  contest_logs.score_rule_values,
+  contest_logs.score_source,
+  logs.eligible_official_leaderboard,
+  logs.year,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = sqlc.arg('contest_id')
+  and logs.deleted_at is null
+  and (
+    sqlc.narg('after_log_id')::uuid is null
+    or (logs.created_at, logs.id) > (sqlc.narg('after_logged_at')::timestamp, sqlc.narg('after_log_id')::uuid)
+  )
+order by logs.created_at asc, logs.id asc
+limit sqlc.arg('batch_size');
+
+-- name: UpdateLogScore :exec
+update logs
+set
+  computed_score = sqlc.arg('computed_score'),
+  score_rule_set_id = sqlc.arg('score_rule_set_id'),
+  score_rule_ids = sqlc.arg('score_rule_ids'),
+  score_rates = sqlc.arg('score_rates'),
+  score_rule_values = sqlc.arg('score_rule_values'),
+  score_source = sqlc.arg('score_source')
+where
+  id = sqlc.arg('log_id')
+  and deleted_at is null;
+
+-- name: UpdateContestLogScore :exec
+update contest_logs
+set
+  computed_score = sqlc.arg('computed_score'),
+  score_rule_set_id = sqlc.arg('score_rule_set_id'),
+  score_rule_ids = sqlc.arg('score_rule_ids'),
+  score_rates = sqlc.arg('score_rates'),
+  score_rule_values = sqlc.arg('score_rule_values'),
+  score_source = sqlc.arg('score_source')
+where
+  contest_id = sqlc.arg('contest_id')
+  and log_id = sqlc.arg('log_id');
@@ -0,0 +1,47 @@
+-- name: ListTrendingTags :many
+select
+  log_tags.tag,
+  count(distinct logs.id) as log_count,
+  count(distinct logs.user_id) as user_count,
+  coalesce(sum(coalesce(logs.computed_score, logs.score)), 0)::real as total_score
+from log_tags
+inner join logs on logs.id = log_tags.log_id
+where
+  logs.deleted_at is null
+  and logs.created_at >= sqlc.arg('since')
+  and (sqlc.narg('language_code')::varchar is null or logs.language_code = sqlc.narg('language_code'))
+group by log_tags.tag
+order by user_count desc, log_count desc, log_tags.tag
+limit sqlc.arg('limit');
+
+-- name: ListTrendingMedia :many
+select
+  media.id,
+  media.title,
+  media.media_type,
+  count(logs.id) as log_count,
+  count(distinct logs.user_id) as user_count,
+  coalesce(sum(coalesce(logs.computed_score, logs.score)), 0)::real as total_score
+from logs
+inner join media on media.id = logs.media_id
+where
+  logs.deleted_at is null
+  and logs.created_at >= sqlc.arg('since')
+  and (sqlc.narg('language_code')::varchar is null or logs.language_code = sqlc.narg('language_code'))
+group by media.id, media.title, media.media_type
+order by user_count desc, log_count desc, media.title
+limit sqlc.arg('limit');
+
+-- name: ListContestTopTags :many
+select
+  log_tags.tag,
+  count(distinct logs.id) as log_count,
+  count(distinct logs.user_id) as user_count,
+  coalesce(sum(coalesce(contest_logs.computed_score, contest_logs.score)), 0)::real as total_score
+from contest_logs
+inner join logs on logs.id = contest_logs.log_id and logs.deleted_at is null
+inner join log_tags on log_tags.log_id = logs.id
+where contest_logs.contest_id = sqlc.arg('contest_id')
+group by log_tags.tag
+order by log_count desc, total_score desc, log_tags.tag
+limit sqlc.arg('limit');
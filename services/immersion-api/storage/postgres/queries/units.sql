@@ -5,11 +5,81 @@ select
   log_activity_id,
   name,
   modifier,
-  language_code
+  language_code,
+  deprecated_at,
+  media_length_unit
+from log_units
+where sqlc.arg('include_deprecated')::boolean or deprecated_at is null
+order by log_activity_id asc, unit_key asc, language_code asc nulls first;
+
+-- name: FindUnitByID :one
+select
+  id,
+  unit_key,
+  log_activity_id,
+  name,
+  modifier,
+  language_code,
+  deprecated_at,
+  media_length_unit
 from log_units
-order by log_activity_id asc;
+where id = sqlc.arg('id');
+
+-- name: UnitKeyExists :one
+select exists (
+  select 1
+  from log_units
+  where unit_key = sqlc.arg('unit_key')
+    and deprecated_at is null
+);
+
+-- name: CreateUnit :one
+insert into log_units (
+  id,
+  unit_key,
+  log_activity_id,
+  name,
+  modifier,
+  language_code,
+  media_length_unit
+) values (
+  sqlc.arg('id'),
+  sqlc.arg('unit_key'),
+  sqlc.arg('log_activity_id'),
+  sqlc.arg('name'),
+  sqlc.arg('modifier'),
+  sqlc.narg('language_code'),
+  sqlc.narg('media_length_unit')
+)
+returning id, unit_key, log_activity_id, name, modifier, language_code, deprecated_at, media_length_unit;
+
+-- name: UpdateUnit :one
+-- The key, activity and language identify the unit and never change.
+update log_units
+set
+  name = sqlc.arg('name'),
+  modifier = sqlc.arg('modifier'),
+  deprecated_at = sqlc.narg('deprecated_at'),
+  media_length_unit = sqlc.narg('media_length_unit')
+where id = sqlc.arg('id')
+returning id, unit_key, log_activity_id, name, modifier, language_code, deprecated_at, media_length_unit;
+
+-- name: DeleteUnusedUnit :execrows
+delete from log_units
+where log_units.id = sqlc.arg('id')
+  and not exists (
+    select 1
+    from logs
+    where logs.unit_id = log_units.id
+  );
+
+-- name: DeprecateUnit :execrows
+update log_units
+set deprecated_at = coalesce(deprecated_at, sqlc.arg('deprecated_at'))
+where id = sqlc.arg('id');
 
 -- name: FindUnitForTracking :one
+-- Deprecated units can no longer be logged, logs keep their current unit.
 select
   id,
   unit_key,
@@ -21,9 +91,11 @@ from log_units
 where
   id = sqlc.arg('id')
   and log_activity_id = sqlc.arg('log_activity_id')
-  and (language_code is null or language_code = sqlc.arg('language_code'));
+  and (language_code is null or language_code = sqlc.arg('language_code'))
+  and (deprecated_at is null or id = sqlc.narg('current_unit_id')::uuid);
 
 -- name: FindUnitForTrackingByKey :one
+-- Active units take precedence over a deprecated current unit with the same key.
 select
   id,
   unit_key,
@@ -36,5 +108,6 @@ where
   unit_key = sqlc.arg('unit_key')
   and log_activity_id = sqlc.arg('log_activity_id')
   and (language_code is null or language_code = sqlc.arg('language_code'))
-order by language_code is null asc
+  and (deprecated_at is null or id = sqlc.narg('current_unit_id')::uuid)
+order by deprecated_at is not null asc, language_code is null asc
 limit 1;
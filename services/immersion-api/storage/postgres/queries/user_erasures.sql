@@ -0,0 +1,167 @@
+-- name: CreateUserErasure :one
+insert into user_erasures (user_id, requested_by_user_id, created_at, updated_at)
+values (sqlc.arg('user_id'), sqlc.arg('requested_by_user_id'), sqlc.arg('now'), sqlc.arg('now'))
+on conflict (user_id) where status = 'pending' do nothing
+returning id;
+
+-- name: FindUserErasureByUserID :one
+select
+  id,
+  user_id,
+  requested_by_user_id,
+  status,
+  attempts,
+  last_error,
+  database_erased_at,
+  leaderboards_erased_at,
+  relationships_erased_at,
+  created_at,
+  updated_at,
+  completed_at
+from user_erasures
+where user_id = sqlc.arg('user_id')
+order by status = 'pending' desc, created_at desc
+limit 1;
+
+-- name: ListPendingUserErasures :many
+select
+  id,
+  user_id,
+  requested_by_user_id,
+  status,
+  attempts,
+  last_error,
+  database_erased_at,
+  leaderboards_erased_at,
+  relationships_erased_at,
+  created_at,
+  updated_at,
+  completed_at
+from user_erasures
+where status = 'pending'
+order by created_at asc
+limit sqlc.arg('batch_size');
+
+-- name: MarkUserErasureDatabaseErased :exec
+update user_erasures
+set database_erased_at = coalesce(database_erased_at, sqlc.arg('now')), updated_at = sqlc.arg('now')
+where id = sqlc.arg('id');
+
+-- name: MarkUserErasureLeaderboardsErased :exec
+update user_erasures
+set leaderboards_erased_at = coalesce(leaderboards_erased_at, sqlc.arg('now')), updated_at = sqlc.arg('now')
+where id = sqlc.arg('id');
+
+-- name: MarkUserErasureRelationshipsErased :exec
+update user_erasures
+set relationships_erased_at = coalesce(relationships_erased_at, sqlc.arg('now')), updated_at = sqlc.arg('now')
+where id = sqlc.arg('id');
+
+-- name: CompleteUserErasure :exec
+update user_erasures
+set status = 'completed', last_error = null, completed_at = sqlc.arg('now')::timestamp, updated_at = sqlc.arg('now')
+where id = sqlc.arg('id') and status = 'pending';
+
+-- name: RecordUserErasureFailure :exec
+update user_erasures
+set attempts = attempts + 1, last_error = sqlc.arg('last_error'), updated_at = sqlc.arg('now')
+where id = sqlc.arg('id');
+
+-- name: LockUserErasureAuditLog :exec
+select pg_advisory_xact_lock(hashtext('user_erasure_audit_log'));
+
+-- name: FindLatestUserErasureAuditHash :one
+select hash
+from user_erasure_audit_log
+order by id desc
+limit 1;
+
+-- name: InsertUserErasureAuditLog :exec
+insert into user_erasure_audit_log (erasure_id, event, metadata, created_at, previous_hash, hash)
+values (
+  sqlc.arg('erasure_id'),
+  sqlc.arg('event'),
+  sqlc.arg('metadata'),
+  sqlc.arg('created_at'),
+  sqlc.arg('previous_hash'),
+  sqlc.arg('hash')
+);
+
+-- name: EraseUserContestLogs :exec
+delete from contest_logs
+where log_id in (select id from logs where user_id = sqlc.arg('user_id'));
+
+-- name: EraseUserLogTags :exec
+delete from log_tags
+where user_id = sqlc.arg('user_id');
+
+-- name: EraseUserLogs :exec
+delete from logs
+where user_id = sqlc.arg('user_id');
+
+-- name: EraseUserContestRegistrations :exec
+delete from contest_registrations
+where user_id = sqlc.arg('user_id');
+
+-- name: EraseUserLeaderboardOutboxEvents :exec
+delete from leaderboard_outbox
+where user_id = sqlc.arg('user_id');
+
+-- name: AnonymizeUserContests :exec
+update contests
+set
+  owner_user_id = '00000000-0000-0000-0000-000000000000'::uuid,
+  owner_user_display_name = sqlc.arg('display_name'),
+  updated_at = now()
+where owner_user_id = sqlc.arg('user_id');
+
+-- name: AnonymizeUserModerationAuditLogs :exec
+update moderation_audit_log
+set user_id = '00000000-0000-0000-0000-000000000000'::uuid
+where user_id = sqlc.arg('user_id');
+
+-- name: EraseUserRoles :exec
+delete from user_roles
+where user_id = sqlc.arg('user_id');
+
+-- name: EraseUser :exec
+delete from users
+where id = sqlc.arg('user_id');
+
+-- name: AnonymizeUserContestInviteCodes :exec
+update contest_invite_codes
+set created_by_user_id = null
+where created_by_user_id = sqlc.arg('user_id')::uuid;
+
+-- name: AnonymizeUserContestInvitations :exec
+update contest_invitations
+set invited_by_user_id = null
+where invited_by_user_id = sqlc.arg('user_id')::uuid;
+
+-- name: AnonymizeUserContestOrganizers :exec
+update contest_organizers
+set added_by_user_id = null
+where added_by_user_id = sqlc.arg('user_id')::uuid;
+
+-- name: AnonymizeUserContestTemplates :exec
+update contest_templates
+set
+  owner_user_id = '00000000-0000-0000-0000-000000000000'::uuid,
+  owner_user_display_name = sqlc.arg('display_name'),
+  active = false,
+  updated_at = now()
+where owner_user_id = sqlc.arg('user_id');
+
+-- name: AnonymizeUserScoringRescoreJobs :exec
+update scoring_rescore_jobs
+set requested_by_user_id = null
+where requested_by_user_id = sqlc.arg('user_id')::uuid;
+
+-- name: EraseUserScoringRescoreJobUserDiffs :exec
+delete from scoring_rescore_job_user_diffs
+where user_id = sqlc.arg('user_id');
+
+-- name: AnonymizeUserMedia :exec
+update media
+set created_by_user_id = null
+where created_by_user_id = sqlc.arg('user_id')::uuid;
@@ -0,0 +1,32 @@
+-- name: FindUserSettings :one
+select
+  user_id,
+  timezone,
+  streak_grace_days,
+  created_at,
+  updated_at
+from user_settings
+where user_id = sqlc.arg('user_id');
+
+-- name: UpsertUserSettings :exec
+insert into user_settings (
+  user_id,
+  timezone,
+  streak_grace_days,
+  created_at,
+  updated_at
+) values (
+  sqlc.arg('user_id'),
+  sqlc.arg('timezone'),
+  sqlc.arg('streak_grace_days'),
+  sqlc.arg('now'),
+  sqlc.arg('now')
+) on conflict (user_id) do
+update set
+  timezone = sqlc.arg('timezone'),
+  streak_grace_days = sqlc.arg('streak_grace_days'),
+  updated_at = sqlc.arg('now');
+
+-- name: DeleteUserSettings :exec
+delete from user_settings
+where user_id = sqlc.arg('user_id');
@@ -38,6 +38,7 @@ where
   user_id = $1
   and contest_id = $2
   and contest_registrations.deleted_at is null
+  and contests.deleted_at is null
 `
 
 type FindContestRegistrationForUserParams struct {
@@ -111,6 +112,7 @@ where
   and contests.contest_start <= $2::timestamp
   and (contests.contest_end + '1 day'::interval) > $2::timestamp
   and contest_registrations.deleted_at is null
+  and contests.deleted_at is null
 `
 
 type FindOngoingContestRegistrationForUserParams struct {
@@ -200,6 +202,7 @@ where
   and (contests.private != true or $2::boolean)
   and extract(year from contests.contest_start) = $3::integer
   and contest_registrations.deleted_at is null
+  and contests.deleted_at is null
 `
 
 type FindYearlyContestRegistrationForUserParams struct {
@@ -64,6 +64,13 @@ func scoreRates(provenance *domain.ScoreProvenance) []float32 {
 	return provenance.Rates
 }
 
+func scoreRuleValues(provenance *domain.ScoreProvenance) []float32 {
+	if provenance == nil {
+		return nil
+	}
+	return provenance.Values
+}
+
 func scoreSource(provenance *domain.ScoreProvenance) sql.NullString {
 	if provenance == nil || provenance.Source == "" {
 		return sql.NullString{}
@@ -81,6 +88,7 @@ func readLogTracking(
 	scoreRuleSetID uuid.NullUUID,
 	scoreRuleIDs []uuid.UUID,
 	scoreRates []float32,
+	scoreRuleValues []float32,
 	scoreSource sql.NullString,
 ) domain.LogTracking {
 	tracking := domain.LogTracking{
@@ -90,6 +98,7 @@ func readLogTracking(
 		tracking.ScoreProvenance = &domain.ScoreProvenance{
 			RuleIDs: append([]uuid.UUID(nil), scoreRuleIDs...),
 			Rates:   append([]float32(nil), scoreRates...),
+			Values:  append([]float32(nil), scoreRuleValues...),
 			Source:  domain.ScoreSource(scoreSource.String),
 		}
 		if scoreRuleSetID.Valid {
@@ -149,6 +149,7 @@ func TestReadLogTracking(t *testing.T) {
 				uuid.NullUUID{},
 				nil,
 				nil,
+				nil,
 				sql.NullString{},
 			)
 
@@ -167,21 +168,25 @@ func TestScoreProvenanceConversions(t *testing.T) {
 	ruleSetID := uuid.New()
 	ruleIDs := []uuid.UUID{uuid.New(), uuid.New()}
 	rates := []float32{1, 1.5}
+	values := []float32{30, 30}
 	provenance := &domain.ScoreProvenance{
 		RuleSetID: &ruleSetID,
 		RuleIDs:   ruleIDs,
 		Rates:     rates,
+		Values:    values,
 		Source:    domain.ScoreSourceAmount,
 	}
 
 	assert.Equal(t, ruleSetID, scoreRuleSetID(provenance).UUID)
 	assert.Equal(t, ruleIDs, scoreRuleIDs(provenance))
 	assert.Equal(t, rates, scoreRates(provenance))
+	assert.Equal(t, values, scoreRuleValues(provenance))
 	assert.Equal(t, string(domain.ScoreSourceAmount), scoreSource(provenance).String)
 
 	assert.False(t, scoreRuleSetID(nil).Valid)
 	assert.Nil(t, scoreRuleIDs(nil))
 	assert.Nil(t, scoreRates(nil))
+	assert.Nil(t, scoreRuleValues(nil))
 	assert.False(t, scoreSource(nil).Valid)
 }
 
@@ -189,6 +194,7 @@ func TestReadLogTrackingWithScoreProvenance(t *testing.T) {
 	ruleSetID := uuid.New()
 	ruleIDs := []uuid.UUID{uuid.New(), uuid.New()}
 	rates := []float32{1, 1.5}
+	values := []float32{10, 10}
 
 	tracking := readLogTracking(
 		uuid.NullUUID{},
@@ -200,6 +206,7 @@ func TestReadLogTrackingWithScoreProvenance(t *testing.T) {
 		uuid.NullUUID{UUID: ruleSetID, Valid: true},
 		ruleIDs,
 		rates,
+		values,
 		sql.NullString{String: string(domain.ScoreSourceDurationMinutes), Valid: true},
 	)
 
@@ -208,5 +215,6 @@ func TestReadLogTrackingWithScoreProvenance(t *testing.T) {
 	assert.Equal(t, ruleSetID, *tracking.ScoreProvenance.RuleSetID)
 	assert.Equal(t, ruleIDs, tracking.ScoreProvenance.RuleIDs)
 	assert.Equal(t, rates, tracking.ScoreProvenance.Rates)
+	assert.Equal(t, values, tracking.ScoreProvenance.Values)
 	assert.Equal(t, domain.ScoreSourceDurationMinutes, tracking.ScoreProvenance.Source)
 }
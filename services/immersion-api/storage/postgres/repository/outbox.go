@@ -15,10 +15,14 @@ type LeaderboardOutboxParams struct {
 	ContestIDs      []uuid.UUID
 	OfficialContest bool
 	Year            int16
+	// GoalProgress requests a refresh of the user's goal progress, set by
+	// write paths that change the user's logs.
+	GoalProgress bool
 }
 
 // insertLeaderboardOutboxEvents inserts outbox events for contest score refreshes
-// and optionally official score refreshes within an existing transaction.
+// and optionally official score and goal progress refreshes within an existing
+// transaction.
 func insertLeaderboardOutboxEvents(ctx context.Context, qtx *postgres.Queries, p LeaderboardOutboxParams) error {
 	for _, contestID := range p.ContestIDs {
 		if err := qtx.InsertLeaderboardOutboxEvent(ctx, postgres.InsertLeaderboardOutboxEventParams{
@@ -40,5 +44,40 @@ func insertLeaderboardOutboxEvents(ctx context.Context, qtx *postgres.Queries, p
 		}
 	}
 
+	if p.GoalProgress {
+		if err := qtx.InsertLeaderboardOutboxEvent(ctx, postgres.InsertLeaderboardOutboxEventParams{
+			EventType: "refresh_goal_progress",
+			UserID:    p.UserID,
+		}); err != nil {
+			return fmt.Errorf("could not insert goal progress outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// insertContestTeamOutboxEvent requests a rebuild of the team leaderboard of a
+// contest after its team memberships changed.
+func insertContestTeamOutboxEvent(ctx context.Context, qtx *postgres.Queries, contestID uuid.UUID, userID uuid.UUID) error {
+	if err := qtx.InsertLeaderboardOutboxEvent(ctx, postgres.InsertLeaderboardOutboxEventParams{
+		EventType: "refresh_contest_team_scores",
+		UserID:    userID,
+		ContestID: uuid.NullUUID{UUID: contestID, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not insert contest team outbox event: %w", err)
+	}
+	return nil
+}
+
+// insertContestRebuildOutboxEvent requests a full rebuild of the leaderboard
+// of a contest, e.g. after a participant was removed from it.
+func insertContestRebuildOutboxEvent(ctx context.Context, qtx *postgres.Queries, contestID uuid.UUID, userID uuid.UUID) error {
+	if err := qtx.InsertLeaderboardOutboxEvent(ctx, postgres.InsertLeaderboardOutboxEventParams{
+		EventType: "rebuild_contest_leaderboard",
+		UserID:    userID,
+		ContestID: uuid.NullUUID{UUID: contestID, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not insert contest rebuild outbox event: %w", err)
+	}
 	return nil
 }
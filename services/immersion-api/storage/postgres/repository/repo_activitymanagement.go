@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) ListActivities(ctx context.Context) ([]domain.Activity, error) {
+	rows, err := r.q.ListActivities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list activities: %w", err)
+	}
+
+	activities := make([]domain.Activity, len(rows))
+	for i, row := range rows {
+		activities[i] = *activityFromRow(row)
+	}
+
+	return activities, nil
+}
+
+func (r *Repository) CreateActivity(ctx context.Context, activity *domain.Activity) (*domain.Activity, error) {
+	row, err := r.q.CreateActivity(ctx, postgres.CreateActivityParams{
+		Key:                    activity.Key,
+		Name:                   activity.Name,
+		Default:                activity.Default,
+		InputType:              string(activity.InputType),
+		DurationScorePerMinute: activity.DurationScorePerMinute,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create activity: %w", err)
+	}
+
+	return activityFromRow(postgres.ListActivitiesRow(row)), nil
+}
+
+func (r *Repository) UpdateActivity(ctx context.Context, activity *domain.Activity) (*domain.Activity, error) {
+	row, err := r.q.UpdateActivity(ctx, postgres.UpdateActivityParams{
+		ID:                     int16(activity.ID),
+		Name:                   activity.Name,
+		Default:                activity.Default,
+		InputType:              string(activity.InputType),
+		DurationScorePerMinute: activity.DurationScorePerMinute,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not update activity: %w", err)
+	}
+
+	return activityFromRow(postgres.ListActivitiesRow(row)), nil
+}
+
+func (r *Repository) ActivityNameExists(ctx context.Context, name string, excludeID int32) (bool, error) {
+	exists, err := r.q.ActivityNameExists(ctx, postgres.ActivityNameExistsParams{
+		Name:      name,
+		ExcludeID: int16(excludeID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not check if activity exists: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *Repository) ActivityKeyExists(ctx context.Context, key string) (bool, error) {
+	exists, err := r.q.ActivityKeyExists(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("could not check if activity key exists: %w", err)
+	}
+	return exists, nil
+}
+
+func activityFromRow(row postgres.ListActivitiesRow) *domain.Activity {
+	return &domain.Activity{
+		ID:                     int32(row.ID),
+		Key:                    row.Key,
+		Name:                   row.Name,
+		Default:                row.Default,
+		InputType:              domain.ActivityInputType(row.InputType),
+		DurationScorePerMinute: row.DurationScorePerMinute,
+	}
+}
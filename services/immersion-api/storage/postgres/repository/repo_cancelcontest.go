@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func (r *Repository) CancelContest(ctx context.Context, req *domain.ContestCancelRequest) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not start transaction: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	if _, err := qtx.CancelContest(ctx, req.ID); err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not cancel contest: %w", err)
+	}
+
+	// Cancelled contests have no scores, the rebuild clears their leaderboard
+	if err := insertContestRebuildOutboxEvent(ctx, qtx, req.ID, uuid.Nil); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if moderatorUserID := req.ModeratorUserID(); moderatorUserID != nil {
+		if err := insertModerationAuditLog(ctx, qtx, &domain.ModerationAuditLogCreateRequest{
+			ModeratorUserID: *moderatorUserID,
+			Action:          "cancel_contest",
+			Metadata: map[string]any{
+				"contest_id": req.ID.String(),
+			},
+			Description: req.Reason,
+		}); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not cancel contest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) CreateContestInviteCode(ctx context.Context, req *domain.ContestInviteCodeCreateRequest) (*domain.ContestInviteCode, error) {
+	row, err := r.q.CreateContestInviteCode(ctx, postgres.CreateContestInviteCodeParams{
+		ContestID:       req.ContestID,
+		Code:            req.Code(),
+		CreatedByUserID: postgres.NewNullUUID(req.CreatedByUserID()),
+		ExpiresAt:       postgres.NewNullTime(req.ExpiresAt),
+		MaxUses:         postgres.NewNullInt32(req.MaxUses),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create invite code: %w", err)
+	}
+	return contestInviteCodeFromRow(row), nil
+}
+
+func (r *Repository) ListContestInviteCodes(ctx context.Context, contestID uuid.UUID) ([]domain.ContestInviteCode, error) {
+	rows, err := r.q.ListContestInviteCodes(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invite codes: %w", err)
+	}
+
+	codes := make([]domain.ContestInviteCode, len(rows))
+	for i, row := range rows {
+		codes[i] = *contestInviteCodeFromRow(row)
+	}
+	return codes, nil
+}
+
+func (r *Repository) RevokeContestInviteCode(ctx context.Context, req *domain.ContestInviteCodeRevokeRequest) error {
+	_, err := r.q.RevokeContestInviteCode(ctx, postgres.RevokeContestInviteCodeParams{
+		ID:        req.InviteCodeID,
+		ContestID: req.ContestID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not revoke invite code: %w", err)
+	}
+	return nil
+}
+
+// redeemContestInviteCode invites the user with an invite code within an
+// existing transaction, so the code is only used up when the registration it
+// was redeemed for is written as well.
+func redeemContestInviteCode(ctx context.Context, qtx *postgres.Queries, req *domain.ContestInviteRedeemRequest) error {
+	// Lock the code so concurrent redemptions cannot exceed its usage limit
+	row, err := qtx.LockContestInviteCode(ctx, postgres.LockContestInviteCodeParams{
+		ContestID: req.ContestID,
+		Code:      req.Code,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrInvalidContestInvite
+		}
+		return fmt.Errorf("could not find invite code: %w", err)
+	}
+
+	code := contestInviteCodeFromRow(row)
+	if !code.Usable(req.Now) {
+		return domain.ErrInvalidContestInvite
+	}
+
+	invited, err := qtx.ContestInvitationExists(ctx, postgres.ContestInvitationExistsParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not check invitation: %w", err)
+	}
+	if invited {
+		return nil
+	}
+
+	if err := qtx.IncrementContestInviteCodeUses(ctx, code.ID); err != nil {
+		return fmt.Errorf("could not count invite code use: %w", err)
+	}
+
+	if err := qtx.CreateContestInvitation(ctx, postgres.CreateContestInvitationParams{
+		ContestID:    req.ContestID,
+		UserID:       req.UserID,
+		InviteCodeID: postgres.NewNullUUID(code.ID),
+	}); err != nil {
+		return fmt.Errorf("could not create invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateContestInvitation(ctx context.Context, req *domain.ContestInvitationCreateRequest) error {
+	err := r.q.CreateContestInvitation(ctx, postgres.CreateContestInvitationParams{
+		ContestID:       req.ContestID,
+		UserID:          req.UserID,
+		InvitedByUserID: postgres.NewNullUUID(req.InvitedByUserID()),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create invitation: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ListContestInvitations(ctx context.Context, contestID uuid.UUID) ([]domain.ContestInvitation, error) {
+	rows, err := r.q.ListContestInvitations(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invitations: %w", err)
+	}
+
+	invitations := make([]domain.ContestInvitation, len(rows))
+	for i, row := range rows {
+		invitations[i] = domain.ContestInvitation{
+			ContestID:       row.ContestID,
+			UserID:          row.UserID,
+			UserDisplayName: row.UserDisplayName,
+			InviteCodeID:    postgres.NewUUIDPtrFromNullUUID(row.InviteCodeID),
+			InvitedByUserID: postgres.NewUUIDPtrFromNullUUID(row.InvitedByUserID),
+			CreatedAt:       row.CreatedAt,
+		}
+	}
+	return invitations, nil
+}
+
+func (r *Repository) RemoveContestParticipant(ctx context.Context, req *domain.ContestParticipantRemoveRequest) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not start transaction: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	if err := qtx.DeleteContestInvitation(ctx, postgres.DeleteContestInvitationParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	}); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not delete invitation: %w", err)
+	}
+
+	teamID, err := qtx.DeleteContestTeamMember(ctx, postgres.DeleteContestTeamMemberParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not leave team: %w", err)
+	}
+	if err == nil {
+		if err := qtx.DeleteContestTeamIfEmpty(ctx, teamID); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not clean up team: %w", err)
+		}
+	}
+
+	if err := qtx.DetachContestLogsForUser(ctx, postgres.DetachContestLogsForUserParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	}); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not detach contest logs: %w", err)
+	}
+
+	if err := qtx.DeleteContestRegistration(ctx, postgres.DeleteContestRegistrationParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	}); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not delete registration: %w", err)
+	}
+
+	// The rebuild also refreshes the team leaderboard
+	if err := insertContestRebuildOutboxEvent(ctx, qtx, req.ContestID, req.UserID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if moderatorUserID := req.ModeratorUserID(); moderatorUserID != nil {
+		if err := insertModerationAuditLog(ctx, qtx, &domain.ModerationAuditLogCreateRequest{
+			ModeratorUserID: *moderatorUserID,
+			Action:          "remove_contest_participant",
+			Metadata: map[string]any{
+				"contest_id": req.ContestID.String(),
+				"user_id":    req.UserID.String(),
+			},
+			Description: req.Reason,
+		}); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not remove participant: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ListInvitedContestIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.q.ListInvitedContestIDsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invited contests: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *Repository) DeleteContestInvitationsForUser(ctx context.Context, userID uuid.UUID) error {
+	if err := r.q.DeleteContestInvitationsForUser(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete invitations: %w", err)
+	}
+	return nil
+}
+
+func contestInviteCodeFromRow(row postgres.ContestInviteCode) *domain.ContestInviteCode {
+	return &domain.ContestInviteCode{
+		ID:        row.ID,
+		ContestID: row.ContestID,
+		Code:      row.Code,
+		ExpiresAt: postgres.NewTimeFromNullTime(row.ExpiresAt),
+		MaxUses:   postgres.NewInt32PtrFromNullInt32(row.MaxUses),
+		UseCount:  row.UseCount,
+		RevokedAt: postgres.NewTimeFromNullTime(row.RevokedAt),
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) CreateContestOrganizer(ctx context.Context, req *domain.ContestOrganizerAddRequest) error {
+	err := r.q.CreateContestOrganizer(ctx, postgres.CreateContestOrganizerParams{
+		ContestID:     req.ContestID,
+		UserID:        req.UserID,
+		AddedByUserID: postgres.NewNullUUID(req.AddedByUserID()),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create contest organizer: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteContestOrganizer(ctx context.Context, req *domain.ContestOrganizerRemoveRequest) error {
+	rows, err := r.q.DeleteContestOrganizer(ctx, postgres.DeleteContestOrganizerParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete contest organizer: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) ListContestOrganizers(ctx context.Context, contestID uuid.UUID) ([]domain.ContestOrganizer, error) {
+	rows, err := r.q.ListContestOrganizers(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest organizers: %w", err)
+	}
+
+	organizers := make([]domain.ContestOrganizer, len(rows))
+	for i, row := range rows {
+		organizers[i] = domain.ContestOrganizer{
+			ContestID:       row.ContestID,
+			UserID:          row.UserID,
+			UserDisplayName: row.UserDisplayName,
+			AddedByUserID:   postgres.NewUUIDPtrFromNullUUID(row.AddedByUserID),
+			CreatedAt:       row.CreatedAt,
+		}
+	}
+	return organizers, nil
+}
+
+func (r *Repository) ListOrganizedContestIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.q.ListOrganizedContestIDsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list organized contests: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *Repository) DeleteContestOrganizersForUser(ctx context.Context, userID uuid.UUID) error {
+	if err := r.q.DeleteContestOrganizersForUser(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete contest organizers: %w", err)
+	}
+	return nil
+}
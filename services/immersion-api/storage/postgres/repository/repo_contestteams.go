@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) ContestTeamNameExists(ctx context.Context, contestID uuid.UUID, name string) (bool, error) {
+	exists, err := r.q.ContestTeamNameExists(ctx, postgres.ContestTeamNameExistsParams{
+		ContestID: contestID,
+		Name:      name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not check team name: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *Repository) CreateContestTeam(ctx context.Context, req *domain.ContestTeamCreateRequest) (*domain.ContestTeam, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create team: %w", err)
+	}
+
+	qtx := r.q.WithTx(tx)
+
+	teamID, err := qtx.CreateContestTeam(ctx, postgres.CreateContestTeamParams{
+		ContestID: req.ContestID,
+		Name:      req.Name,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not create team: %w", err)
+	}
+
+	if err := moveContestTeamMember(ctx, qtx, req.ContestID, req.UserID(), teamID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not create team: %w", err)
+	}
+
+	teams, err := r.ListContestTeams(ctx, req.ContestID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range teams {
+		if teams[i].ID == teamID {
+			return &teams[i], nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *Repository) JoinContestTeam(ctx context.Context, req *domain.ContestTeamJoinRequest, sizeLimit int32) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not join team: %w", err)
+	}
+
+	qtx := r.q.WithTx(tx)
+
+	if _, err := qtx.LockContestTeam(ctx, postgres.LockContestTeamParams{
+		ID:        req.TeamID,
+		ContestID: req.ContestID,
+	}); err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not lock team: %w", err)
+	}
+
+	members, err := qtx.CountContestTeamMembers(ctx, postgres.CountContestTeamMembersParams{
+		TeamID: req.TeamID,
+		UserID: req.UserID(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not count team members: %w", err)
+	}
+	if members >= sizeLimit {
+		_ = tx.Rollback()
+		return domain.ErrContestTeamFull
+	}
+
+	if err := moveContestTeamMember(ctx, qtx, req.ContestID, req.UserID(), req.TeamID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not join team: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) LeaveContestTeam(ctx context.Context, req *domain.ContestTeamLeaveRequest) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not leave team: %w", err)
+	}
+
+	qtx := r.q.WithTx(tx)
+
+	teamID, err := qtx.DeleteContestTeamMember(ctx, postgres.DeleteContestTeamMemberParams{
+		ContestID: req.ContestID,
+		UserID:    req.UserID(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not leave team: %w", err)
+	}
+
+	if err := qtx.DeleteContestTeamIfEmpty(ctx, teamID); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not clean up team: %w", err)
+	}
+
+	if err := insertContestTeamOutboxEvent(ctx, qtx, req.ContestID, req.UserID()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not leave team: %w", err)
+	}
+	return nil
+}
+
+// moveContestTeamMember puts the user into the team, removes their previous
+// team if it became empty and schedules a team leaderboard refresh.
+func moveContestTeamMember(ctx context.Context, qtx *postgres.Queries, contestID, userID, teamID uuid.UUID) error {
+	previousTeamID, err := qtx.FindContestTeamIDForUser(ctx, postgres.FindContestTeamIDForUserParams{
+		ContestID: contestID,
+		UserID:    userID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("could not find current team: %w", err)
+	}
+	hadTeam := err == nil
+	if hadTeam && previousTeamID == teamID {
+		return nil
+	}
+
+	if err := qtx.UpsertContestTeamMember(ctx, postgres.UpsertContestTeamMemberParams{
+		ContestID: contestID,
+		UserID:    userID,
+		TeamID:    teamID,
+	}); err != nil {
+		return fmt.Errorf("could not add team member: %w", err)
+	}
+
+	if hadTeam {
+		if err := qtx.DeleteContestTeamIfEmpty(ctx, previousTeamID); err != nil {
+			return fmt.Errorf("could not clean up previous team: %w", err)
+		}
+	}
+
+	return insertContestTeamOutboxEvent(ctx, qtx, contestID, userID)
+}
+
+func (r *Repository) ListContestTeams(ctx context.Context, contestID uuid.UUID) ([]domain.ContestTeam, error) {
+	rows, err := r.q.ListContestTeams(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list teams: %w", err)
+	}
+
+	memberRows, err := r.q.ListContestTeamMembers(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list team members: %w", err)
+	}
+
+	members := make(map[uuid.UUID][]domain.ContestTeamMember, len(rows))
+	for _, m := range memberRows {
+		members[m.TeamID] = append(members[m.TeamID], domain.ContestTeamMember{
+			UserID:          m.UserID,
+			UserDisplayName: m.UserDisplayName,
+			JoinedAt:        m.JoinedAt,
+		})
+	}
+
+	teams := make([]domain.ContestTeam, len(rows))
+	for i, row := range rows {
+		teamMembers := members[row.ID]
+		if teamMembers == nil {
+			teamMembers = []domain.ContestTeamMember{}
+		}
+		teams[i] = domain.ContestTeam{
+			ID:        row.ID,
+			ContestID: row.ContestID,
+			Name:      row.Name,
+			Members:   teamMembers,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return teams, nil
+}
+
+func (r *Repository) FindContestTeamSettings(ctx context.Context, contestID uuid.UUID) (*domain.ContestTeamSettings, error) {
+	row, err := r.q.FindContestTeamSettings(ctx, contestID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch contest team settings: %w", err)
+	}
+
+	return &domain.ContestTeamSettings{
+		TeamMode:         row.TeamMode,
+		TeamSizeLimit:    postgres.NewInt32PtrFromNullInt16(row.TeamSizeLimit),
+		ScoreAggregation: domain.ContestTeamScoreAggregation(row.TeamScoreAggregation),
+	}, nil
+}
+
+func (r *Repository) FetchContestTeamScores(ctx context.Context, contestID uuid.UUID) ([]domain.ContestTeamScore, error) {
+	rows, err := r.q.ContestTeamScores(ctx, contestID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch contest team scores: %w", err)
+	}
+
+	scores := make([]domain.ContestTeamScore, len(rows))
+	for i, row := range rows {
+		scores[i] = domain.ContestTeamScore{
+			TeamID:      row.TeamID,
+			MemberCount: int(row.MemberCount),
+			TotalScore:  float64(row.TotalScore),
+		}
+	}
+	return scores, nil
+}
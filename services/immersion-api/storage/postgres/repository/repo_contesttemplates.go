@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) CreateContestTemplate(ctx context.Context, template *domain.ContestTemplate) (*domain.ContestTemplate, error) {
+	row, err := r.q.CreateContestTemplate(ctx, postgres.CreateContestTemplateParams{
+		OwnerUserID:                     template.OwnerUserID,
+		OwnerUserDisplayName:            template.OwnerUserDisplayName,
+		TitlePattern:                    template.TitlePattern,
+		Description:                     postgres.NewNullString(template.Description),
+		Official:                        template.Official,
+		Private:                         template.Private,
+		DurationDays:                    postgres.NewNullInt32(template.DurationDays),
+		RegistrationClosesBeforeEndDays: template.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           template.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         template.ActivityTypeIDAllowList,
+		TeamMode:                        template.TeamMode,
+		TeamSizeLimit:                   postgres.NewNullInt16FromInt32(template.TeamSizeLimit),
+		TeamScoreAggregation:            string(template.TeamScoreAggregation),
+		ScoringRuleSetID:                postgres.NewNullUUIDFromPtr(template.ScoringRuleSetID),
+		Frequency:                       string(template.Frequency),
+		IntervalCount:                   template.IntervalCount,
+		FirstContestStart:               template.FirstContestStart,
+		LeadDays:                        template.LeadDays,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create contest template: %w", err)
+	}
+	return contestTemplateFromRow(row), nil
+}
+
+func (r *Repository) UpdateContestTemplate(ctx context.Context, template *domain.ContestTemplate) (*domain.ContestTemplate, error) {
+	row, err := r.q.UpdateContestTemplate(ctx, postgres.UpdateContestTemplateParams{
+		ID:                              template.ID,
+		TitlePattern:                    template.TitlePattern,
+		Description:                     postgres.NewNullString(template.Description),
+		Private:                         template.Private,
+		DurationDays:                    postgres.NewNullInt32(template.DurationDays),
+		RegistrationClosesBeforeEndDays: template.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           template.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         template.ActivityTypeIDAllowList,
+		TeamMode:                        template.TeamMode,
+		TeamSizeLimit:                   postgres.NewNullInt16FromInt32(template.TeamSizeLimit),
+		TeamScoreAggregation:            string(template.TeamScoreAggregation),
+		ScoringRuleSetID:                postgres.NewNullUUIDFromPtr(template.ScoringRuleSetID),
+		LeadDays:                        template.LeadDays,
+		Active:                          template.Active,
+		Now:                             template.UpdatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not update contest template: %w", err)
+	}
+	return contestTemplateFromRow(row), nil
+}
+
+func (r *Repository) FindContestTemplateByID(ctx context.Context, id uuid.UUID) (*domain.ContestTemplate, error) {
+	row, err := r.q.FindContestTemplateByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch contest template: %w", err)
+	}
+	return contestTemplateFromRow(row), nil
+}
+
+func (r *Repository) ListContestTemplates(ctx context.Context, userID *uuid.UUID) ([]domain.ContestTemplate, error) {
+	rows, err := r.q.ListContestTemplates(ctx, postgres.NewNullUUIDFromPtr(userID))
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest templates: %w", err)
+	}
+	return contestTemplatesFromRows(rows), nil
+}
+
+func (r *Repository) ListActiveContestTemplates(ctx context.Context) ([]domain.ContestTemplate, error) {
+	rows, err := r.q.ListActiveContestTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list active contest templates: %w", err)
+	}
+	return contestTemplatesFromRows(rows), nil
+}
+
+func (r *Repository) ListContestsForTemplate(ctx context.Context, templateID uuid.UUID, includePrivate bool) ([]domain.ContestTemplateContest, error) {
+	rows, err := r.q.ListContestsForTemplate(ctx, postgres.ListContestsForTemplateParams{
+		TemplateID:     templateID,
+		IncludePrivate: includePrivate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list contests of template: %w", err)
+	}
+
+	contests := make([]domain.ContestTemplateContest, len(rows))
+	for i, row := range rows {
+		contests[i] = domain.ContestTemplateContest{
+			ID:              row.ID,
+			Occurrence:      row.TemplateOccurrence.Int32,
+			Title:           row.Title,
+			ContestStart:    row.ContestStart,
+			ContestEnd:      row.ContestEnd,
+			RegistrationEnd: row.RegistrationEnd,
+			Private:         row.Private,
+			Deleted:         row.DeletedAt.Valid,
+		}
+	}
+	return contests, nil
+}
+
+func (r *Repository) SkipContestTemplateOccurrences(ctx context.Context, templateID uuid.UUID, from, to int32, now time.Time) error {
+	rows, err := r.q.AdvanceContestTemplate(ctx, postgres.AdvanceContestTemplateParams{
+		ID:                templateID,
+		CurrentOccurrence: from,
+		NextOccurrence:    to,
+		Now:               now,
+	})
+	if err != nil {
+		return fmt.Errorf("could not skip contest template occurrences: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrConflict
+	}
+	return nil
+}
+
+// CreateContestFromTemplate creates the contest of an occurrence. The contest
+// gets its own published copy of the template's scoring rule set, as contest
+// rule sets belong to a single contest.
+func (r *Repository) CreateContestFromTemplate(ctx context.Context, template *domain.ContestTemplate, occurrence domain.ContestTemplateOccurrence, now time.Time) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not create contest from template: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	rows, err := qtx.AdvanceContestTemplate(ctx, postgres.AdvanceContestTemplateParams{
+		ID:                template.ID,
+		CurrentOccurrence: occurrence.Index,
+		NextOccurrence:    occurrence.Index + 1,
+		Now:               now,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not advance contest template: %w", err)
+	}
+	if rows == 0 {
+		_ = tx.Rollback()
+		return domain.ErrConflict
+	}
+
+	contestID, err := qtx.CreateContestFromTemplate(ctx, postgres.CreateContestFromTemplateParams{
+		TemplateID:      template.ID,
+		Occurrence:      occurrence.Index,
+		Title:           occurrence.Title,
+		ContestStart:    occurrence.ContestStart,
+		ContestEnd:      occurrence.ContestEnd,
+		RegistrationEnd: occurrence.RegistrationEnd,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// The occurrence already exists, only the template was behind.
+		if err = tx.Commit(); err != nil {
+			return fmt.Errorf("could not create contest from template: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not create contest from template: %w", err)
+	}
+
+	if template.ScoringRuleSetID != nil {
+		ruleSetID, err := qtx.CopyScoringRuleSetToContest(ctx, postgres.CopyScoringRuleSetToContestParams{
+			ContestID:       contestID,
+			SourceRuleSetID: *template.ScoringRuleSetID,
+			PublishedAt:     now,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not copy scoring rule set: %w", err)
+		}
+
+		err = qtx.CopyScoringRules(ctx, postgres.CopyScoringRulesParams{
+			RuleSetID:       ruleSetID,
+			SourceRuleSetID: *template.ScoringRuleSetID,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not copy scoring rules: %w", err)
+		}
+
+		err = qtx.ActivateContestScoringRuleSet(ctx, postgres.ActivateContestScoringRuleSetParams{
+			ContestID: contestID,
+			RuleSetID: postgres.NewNullUUID(ruleSetID),
+			UpdatedAt: now,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not activate scoring rule set: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not create contest from template: %w", err)
+	}
+	return nil
+}
+
+func contestTemplatesFromRows(rows []postgres.ContestTemplate) []domain.ContestTemplate {
+	templates := make([]domain.ContestTemplate, len(rows))
+	for i, row := range rows {
+		templates[i] = *contestTemplateFromRow(row)
+	}
+	return templates
+}
+
+func contestTemplateFromRow(row postgres.ContestTemplate) *domain.ContestTemplate {
+	return &domain.ContestTemplate{
+		ID:                              row.ID,
+		OwnerUserID:                     row.OwnerUserID,
+		OwnerUserDisplayName:            row.OwnerUserDisplayName,
+		TitlePattern:                    row.TitlePattern,
+		Description:                     postgres.NewStringFromNullString(row.Description),
+		Official:                        row.Official,
+		Private:                         row.Private,
+		DurationDays:                    postgres.NewInt32PtrFromNullInt32(row.DurationDays),
+		RegistrationClosesBeforeEndDays: row.RegistrationClosesBeforeEndDays,
+		LanguageCodeAllowList:           row.LanguageCodeAllowList,
+		ActivityTypeIDAllowList:         row.ActivityTypeIDAllowList,
+		TeamMode:                        row.TeamMode,
+		TeamSizeLimit:                   postgres.NewInt32PtrFromNullInt16(row.TeamSizeLimit),
+		TeamScoreAggregation:            domain.ContestTeamScoreAggregation(row.TeamScoreAggregation),
+		ScoringRuleSetID:                postgres.NewUUIDPtrFromNullUUID(row.ScoringRuleSetID),
+		Frequency:                       domain.ContestRecurrenceFrequency(row.Frequency),
+		IntervalCount:                   row.IntervalCount,
+		FirstContestStart:               row.FirstContestStart,
+		LeadDays:                        row.LeadDays,
+		NextOccurrence:                  row.NextOccurrence,
+		Active:                          row.Active,
+		CreatedAt:                       row.CreatedAt,
+		UpdatedAt:                       row.UpdatedAt,
+	}
+}
@@ -28,6 +28,9 @@ func (r *Repository) CreateContest(ctx context.Context, req *domain.ContestCreat
 		Description:             postgres.NewNullString(req.Description),
 		LanguageCodeAllowList:   req.LanguageCodeAllowList,
 		ActivityTypeIDAllowList: req.ActivityTypeIDAllowList,
+		TeamMode:                req.TeamMode,
+		TeamSizeLimit:           postgres.NewNullInt16FromInt32(req.TeamSizeLimit),
+		TeamScoreAggregation:    string(req.TeamScoreAggregation),
 	})
 
 	if err != nil {
@@ -61,6 +64,9 @@ func (r *Repository) CreateContest(ctx context.Context, req *domain.ContestCreat
 		Private:                 contest.Private,
 		LanguageCodeAllowList:   contest.LanguageCodeAllowList,
 		ActivityTypeIDAllowList: contest.ActivityTypeIDAllowList,
+		TeamMode:                contest.TeamMode,
+		TeamSizeLimit:           postgres.NewInt32PtrFromNullInt16(contest.TeamSizeLimit),
+		TeamScoreAggregation:    domain.ContestTeamScoreAggregation(contest.TeamScoreAggregation),
 		CreatedAt:               contest.CreatedAt,
 		UpdatedAt:               contest.UpdatedAt,
 	}, nil
@@ -32,18 +32,57 @@ func (r *Repository) CreateLog(ctx context.Context, req *domain.LogCreateRequest
 		ScoreRuleSetID:              scoreRuleSetID(tracking.ScoreProvenance),
 		ScoreRuleIds:                scoreRuleIDs(tracking.ScoreProvenance),
 		ScoreRates:                  scoreRates(tracking.ScoreProvenance),
+		ScoreRuleValues:             scoreRuleValues(tracking.ScoreProvenance),
 		ScoreSource:                 scoreSource(tracking.ScoreProvenance),
 		EligibleOfficialLeaderboard: req.EligibleOfficialLeaderboard(),
 		Description:                 postgres.NewNullString(req.Description),
+		MediaID:                     postgres.NewNullUUIDFromPtr(req.MediaID),
 	})
 	if err != nil {
 		_ = tx.Rollback()
 		return nil, fmt.Errorf("could not create log: %w", err)
 	}
 
-	// Track unique contest IDs for outbox events
 	contestIDSet := map[uuid.UUID]struct{}{}
+	if err = insertLogRelations(ctx, qtx, id, req, contestIDSet); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Write outbox events for leaderboard sync
+	contestIDs := make([]uuid.UUID, 0, len(contestIDSet))
+	for id := range contestIDSet {
+		contestIDs = append(contestIDs, id)
+	}
+	if err = insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+		UserID:          req.UserID(),
+		ContestIDs:      contestIDs,
+		OfficialContest: req.EligibleOfficialLeaderboard(),
+		Year:            req.Year(),
+		GoalProgress:    true,
+	}); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not create log: %w", err)
+	}
+
+	return &logId, nil
+}
 
+// insertLogRelations attaches a newly inserted log to its contest registrations
+// and tags, recording every affected contest in contestIDSet so callers can
+// enqueue leaderboard outbox events once per contest.
+func insertLogRelations(
+	ctx context.Context,
+	qtx *postgres.Queries,
+	logID uuid.UUID,
+	req *domain.LogCreateRequest,
+	contestIDSet map[uuid.UUID]struct{},
+) error {
+	tracking := req.Tracking()
 	contestTrackings := req.ContestTrackings()
 	if len(contestTrackings) == 0 {
 		contestTrackings = make([]domain.ContestLogTracking, len(req.RegistrationIDs))
@@ -57,9 +96,9 @@ func (r *Repository) CreateLog(ctx context.Context, req *domain.LogCreateRequest
 
 	for _, contestTracking := range contestTrackings {
 		contestTracking := contestTracking
-		if err = qtx.CreateContestLogRelation(ctx, postgres.CreateContestLogRelationParams{
+		if err := qtx.CreateContestLogRelation(ctx, postgres.CreateContestLogRelationParams{
 			RegistrationID:  contestTracking.RegistrationID,
-			LogID:           id,
+			LogID:           logID,
 			UnitKey:         trackingUnitKey(contestTracking.Tracking),
 			Amount:          trackingAmount(contestTracking.Tracking),
 			Modifier:        trackingModifier(contestTracking.Tracking),
@@ -68,50 +107,29 @@ func (r *Repository) CreateLog(ctx context.Context, req *domain.LogCreateRequest
 			ScoreRuleSetID:  scoreRuleSetID(contestTracking.Tracking.ScoreProvenance),
 			ScoreRuleIds:    scoreRuleIDs(contestTracking.Tracking.ScoreProvenance),
 			ScoreRates:      scoreRates(contestTracking.Tracking.ScoreProvenance),
+			ScoreRuleValues: scoreRuleValues(contestTracking.Tracking.ScoreProvenance),
 			ScoreSource:     scoreSource(contestTracking.Tracking.ScoreProvenance),
 		}); err != nil {
-			_ = tx.Rollback()
-			return nil, fmt.Errorf("could not create log: %w", err)
+			return fmt.Errorf("could not create log: %w", err)
 		}
 
 		contestID, err := qtx.FetchContestIDForRegistration(ctx, contestTracking.RegistrationID)
 		if err != nil {
-			_ = tx.Rollback()
-			return nil, fmt.Errorf("could not resolve contest for registration: %w", err)
+			return fmt.Errorf("could not resolve contest for registration: %w", err)
 		}
 		contestIDSet[contestID] = struct{}{}
 	}
 
 	// Insert tags into log_tags table
 	for _, tag := range req.Tags {
-		if err = qtx.InsertLogTag(ctx, postgres.InsertLogTagParams{
-			LogID:  id,
+		if err := qtx.InsertLogTag(ctx, postgres.InsertLogTagParams{
+			LogID:  logID,
 			UserID: req.UserID(),
 			Tag:    tag,
 		}); err != nil {
-			_ = tx.Rollback()
-			return nil, fmt.Errorf("could not insert log tag: %w", err)
+			return fmt.Errorf("could not insert log tag: %w", err)
 		}
 	}
 
-	// Write outbox events for leaderboard sync
-	contestIDs := make([]uuid.UUID, 0, len(contestIDSet))
-	for id := range contestIDSet {
-		contestIDs = append(contestIDs, id)
-	}
-	if err = insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
-		UserID:          req.UserID(),
-		ContestIDs:      contestIDs,
-		OfficialContest: req.EligibleOfficialLeaderboard(),
-		Year:            req.Year(),
-	}); err != nil {
-		_ = tx.Rollback()
-		return nil, err
-	}
-
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("could not create log: %w", err)
-	}
-
-	return &logId, nil
+	return nil
 }
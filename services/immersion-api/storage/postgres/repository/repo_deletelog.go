@@ -53,6 +53,7 @@ func (r *Repository) DeleteLog(ctx context.Context, req *domain.LogDeleteRequest
 		ContestIDs:      contestIDs,
 		OfficialContest: logCtx.EligibleOfficialLeaderboard,
 		Year:            logCtx.Year,
+		GoalProgress:    true,
 	}); err != nil {
 		_ = tx.Rollback()
 		return err
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+const exportLogsPageSize = 500
+
+// ReadExportSnapshot runs fn in a read-only repeatable read transaction, so
+// every read of the export sees the same snapshot of the user's data.
+func (r *Repository) ReadExportSnapshot(ctx context.Context, fn func(domain.DataExportRepository) error) error {
+	tx, err := r.psql.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("could not start export snapshot: %w", err)
+	}
+
+	if err := fn(&Repository{psql: r.psql, q: r.q.WithTx(tx)}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// StreamLogsForExport walks all non-deleted logs of a user in creation order,
+// fetching them in keyset-paginated pages so large histories are never loaded
+// at once.
+func (r *Repository) StreamLogsForExport(ctx context.Context, userID uuid.UUID, fn func(*domain.Log) error) error {
+	afterCreatedAt := time.Time{}
+	afterID := uuid.Nil
+
+	for {
+		rows, err := r.q.ListLogsForExport(ctx, postgres.ListLogsForExportParams{
+			UserID:         userID,
+			AfterCreatedAt: afterCreatedAt,
+			AfterID:        afterID,
+			PageSize:       exportLogsPageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("could not list logs for export: %w", err)
+		}
+
+		for _, row := range rows {
+			log, err := exportLogFromRow(userID, row)
+			if err != nil {
+				return err
+			}
+			if err := fn(log); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < exportLogsPageSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		afterCreatedAt = last.CreatedAt
+		afterID = last.ID
+	}
+}
+
+func exportLogFromRow(userID uuid.UUID, row postgres.ListLogsForExportRow) (*domain.Log, error) {
+	contestIDs := postgres.StringArrayFromInterface(row.ContestIds)
+	refs := make([]domain.ContestRegistrationReference, len(contestIDs))
+	for i, raw := range contestIDs {
+		contestID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse contest id for log %s: %w", row.ID, err)
+		}
+		refs[i] = domain.ContestRegistrationReference{ContestID: contestID}
+	}
+
+	return &domain.Log{
+		ID:              row.ID,
+		UserID:          userID,
+		Description:     postgres.NewStringFromNullString(row.Description),
+		MediaID:         postgres.NewUUIDPtrFromNullUUID(row.MediaID),
+		LanguageCode:    row.LanguageCode,
+		LanguageName:    row.LanguageName,
+		ActivityID:      int(row.ActivityID),
+		UnitID:          postgres.NewUUIDFromNullUUID(row.UnitID),
+		UnitKey:         row.UnitKey,
+		UnitName:        row.UnitName,
+		Tags:            postgres.StringArrayFromInterface(row.Tags),
+		Amount:          postgres.NewFloat32FromNullFloat64(row.Amount),
+		Modifier:        postgres.NewFloat32FromNullFloat64(row.Modifier),
+		Score:           postgres.NewFloat32FromNullFloat64(row.Score),
+		DurationSeconds: postgres.NewInt32PtrFromNullInt32(row.DurationSeconds),
+		Tracking: readLogTracking(
+			row.UnitID,
+			row.UnitKey,
+			row.Amount,
+			row.Modifier,
+			row.DurationSeconds,
+			row.Score,
+			row.ScoreRuleSetID,
+			row.ScoreRuleIds,
+			row.ScoreRates,
+			row.ScoreRuleValues,
+			row.ScoreSource,
+		),
+		EligibleOfficialLeaderboard: row.EligibleOfficialLeaderboard,
+		CreatedAt:                   row.CreatedAt,
+		UpdatedAt:                   row.UpdatedAt,
+		Registrations:               refs,
+	}, nil
+}
+
+func (r *Repository) ListContestRegistrationsForExport(ctx context.Context, userID uuid.UUID) ([]domain.ExportedContestRegistration, error) {
+	rows, err := r.q.ListContestRegistrationsForExport(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest registrations for export: %w", err)
+	}
+
+	registrations := make([]domain.ExportedContestRegistration, len(rows))
+	for i, row := range rows {
+		registrations[i] = domain.ExportedContestRegistration{
+			ID:            row.ID,
+			ContestID:     row.ContestID,
+			Title:         row.Title,
+			ContestStart:  row.ContestStart,
+			ContestEnd:    row.ContestEnd,
+			Official:      row.Official,
+			Private:       row.Private,
+			LanguageCodes: row.LanguageCodes,
+			Score:         row.Score,
+			CreatedAt:     row.CreatedAt,
+		}
+	}
+
+	return registrations, nil
+}
+
+func (r *Repository) YearlyScoresForExport(ctx context.Context, userID uuid.UUID) ([]domain.YearlyScore, error) {
+	rows, err := r.q.YearlyScoresForExport(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list yearly scores for export: %w", err)
+	}
+
+	scores := make([]domain.YearlyScore, len(rows))
+	for i, row := range rows {
+		scores[i] = domain.YearlyScore{
+			Year:         int(row.Year),
+			LanguageCode: row.LanguageCode,
+			LanguageName: row.LanguageName,
+			Score:        row.Score,
+		}
+	}
+
+	return scores, nil
+}
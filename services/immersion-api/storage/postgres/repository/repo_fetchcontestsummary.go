@@ -7,8 +7,11 @@ import (
 	"fmt"
 
 	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
 )
 
+const contestSummaryTopTagLimit = 10
+
 func (r *Repository) FetchContestSummary(ctx context.Context, req *domain.ContestSummaryFetchRequest) (*domain.ContestSummaryFetchResponse, error) {
 	summary, err := r.q.ContestSummary(ctx, req.ContestID)
 	if err != nil {
@@ -18,9 +21,23 @@ func (r *Repository) FetchContestSummary(ctx context.Context, req *domain.Contes
 		return nil, fmt.Errorf("could not fetch contest summary: %w", err)
 	}
 
+	tags, err := r.q.ListContestTopTags(ctx, postgres.ListContestTopTagsParams{
+		ContestID: req.ContestID,
+		Limit:     contestSummaryTopTagLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch contest top tags: %w", err)
+	}
+
+	topTags := make([]domain.TagStatistic, len(tags))
+	for i, it := range tags {
+		topTags[i] = tagStatisticFromRow(postgres.ListTrendingTagsRow(it))
+	}
+
 	return &domain.ContestSummaryFetchResponse{
 		ParticipantCount: int(summary.ParticipantCount),
 		LanguageCount:    int(summary.LanguageCount),
 		TotalScore:       summary.TotalScore,
+		TopTags:          topTags,
 	}, nil
 }
@@ -15,7 +15,7 @@ func (r *Repository) FetchLogConfigurationOptions(ctx context.Context, userID uu
 		return nil, fmt.Errorf("could not fetch log configuration options: %w", err)
 	}
 
-	units, err := r.q.ListUnits(ctx)
+	units, err := r.q.ListUnits(ctx, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch log configuration options: %w", err)
 	}
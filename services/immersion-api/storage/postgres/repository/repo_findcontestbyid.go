@@ -58,6 +58,10 @@ func (r *Repository) FindContestByID(ctx context.Context, req *domain.ContestFin
 		Private:              contest.Private,
 		AllowedLanguages:     langs,
 		AllowedActivities:    acts,
+		TeamMode:             contest.TeamMode,
+		TeamSizeLimit:        postgres.NewInt32PtrFromNullInt16(contest.TeamSizeLimit),
+		TeamScoreAggregation: domain.ContestTeamScoreAggregation(contest.TeamScoreAggregation),
+		TemplateID:           postgres.NewUUIDPtrFromNullUUID(contest.TemplateID),
 		CreatedAt:            contest.CreatedAt,
 		UpdatedAt:            contest.UpdatedAt,
 		Deleted:              contest.DeletedAt.Valid,
@@ -51,6 +51,7 @@ func (r *Repository) FindLogByID(ctx context.Context, req *domain.LogFindRequest
 		log.ScoreRuleSetID,
 		log.ScoreRuleIds,
 		log.ScoreRates,
+		log.ScoreRuleValues,
 		log.ScoreSource,
 	)
 
@@ -59,6 +60,7 @@ func (r *Repository) FindLogByID(ctx context.Context, req *domain.LogFindRequest
 		UserID:                      log.UserID,
 		UserDisplayName:             &log.UserDisplayName,
 		Description:                 postgres.NewStringFromNullString(log.Description),
+		MediaID:                     postgres.NewUUIDPtrFromNullUUID(log.MediaID),
 		LanguageCode:                log.LanguageCode,
 		LanguageName:                log.LanguageName,
 		ActivityID:                  int(log.ActivityID),
@@ -139,17 +139,30 @@ func scoringRuleSetToDomain(
 		publishedAt := ruleSet.PublishedAt.Time
 		result.PublishedAt = &publishedAt
 	}
+	if ruleSet.ArchivedAt.Valid {
+		archivedAt := ruleSet.ArchivedAt.Time
+		result.ArchivedAt = &archivedAt
+	}
 	for i, rule := range rules {
 		result.Rules[i] = domain.ScoringRule{
-			ID:           rule.ID,
-			Priority:     rule.Priority,
-			Stackable:    rule.Stackable,
-			ActivityID:   int32(rule.ActivityID),
-			UnitKey:      rule.UnitKey.String,
-			LanguageCode: rule.LanguageCode.String,
-			Tag:          rule.Tag.String,
-			ScoreSource:  domain.ScoreSource(rule.ScoreSource),
-			Rate:         rule.Rate,
+			ID:             rule.ID,
+			Priority:       rule.Priority,
+			Stackable:      rule.Stackable,
+			ActivityID:     int32(rule.ActivityID),
+			UnitKey:        rule.UnitKey.String,
+			LanguageCode:   rule.LanguageCode.String,
+			Tag:            rule.Tag.String,
+			Tags:           rule.Tags,
+			TagMatch:       domain.ScoringTagMatch(rule.TagMatch.String),
+			MinValue:       optionalFloat32(rule.MinValue),
+			MaxValue:       optionalFloat32(rule.MaxValue),
+			EffectiveFrom:  postgres.NewTimeFromNullTime(rule.EffectiveFrom),
+			EffectiveUntil: postgres.NewTimeFromNullTime(rule.EffectiveUntil),
+			ScoreSource:    domain.ScoreSource(rule.ScoreSource),
+			Rate:           rule.Rate,
+			CapPeriod:      domain.ScoringCapPeriod(rule.CapPeriod.String),
+			CapValue:       optionalFloat32(rule.CapValue),
+			CapScore:       optionalFloat32(rule.CapScore),
 		}
 	}
 	return result
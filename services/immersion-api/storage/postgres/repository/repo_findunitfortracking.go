@@ -15,6 +15,7 @@ func (r *Repository) FindUnitForTracking(ctx context.Context, req *domain.UnitFi
 		ID:            req.ID,
 		LogActivityID: int16(req.ActivityID),
 		LanguageCode:  postgres.NewNullString(&req.LanguageCode),
+		CurrentUnitID: postgres.NewNullUUIDFromPtr(req.CurrentUnitID),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -38,10 +39,11 @@ func (r *Repository) FindUnitForTrackingByKey(ctx context.Context, req *domain.U
 		UnitKey:       req.Key,
 		LogActivityID: int16(req.ActivityID),
 		LanguageCode:  postgres.NewNullString(&req.LanguageCode),
+		CurrentUnitID: postgres.NewNullUUIDFromPtr(req.CurrentUnitID),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("invalid unit supplied: %w", domain.ErrInvalidLog)
+			return nil, fmt.Errorf("unit key %q is not valid for activity %d: %w", req.Key, req.ActivityID, domain.ErrInvalidLog)
 		}
 		return nil, fmt.Errorf("could not fetch unit for tracking: %w", err)
 	}
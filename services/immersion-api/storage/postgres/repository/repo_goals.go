@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) CreateGoal(ctx context.Context, goal *domain.Goal, now time.Time) error {
+	err := r.q.CreateGoal(ctx, postgres.CreateGoalParams{
+		ID:            goal.ID,
+		UserID:        goal.UserID,
+		Title:         goal.Title,
+		LanguageCode:  postgres.NewNullString(goal.LanguageCode),
+		LogActivityID: newNullInt16FromIntPtr(goal.ActivityID),
+		UnitKey:       postgres.NewNullString(goal.UnitKey),
+		Tag:           postgres.NewNullString(goal.Tag),
+		Metric:        string(goal.Metric),
+		Target:        goal.Target,
+		Period:        string(goal.Period),
+		StartsOn:      postgres.NewNullTime(goal.StartsOn),
+		EndsOn:        postgres.NewNullTime(goal.EndsOn),
+		Now:           now,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create goal: %w", err)
+	}
+
+	goal.CreatedAt = now
+	goal.UpdatedAt = now
+	return nil
+}
+
+func (r *Repository) UpdateGoal(ctx context.Context, goal *domain.Goal, now time.Time) error {
+	err := r.q.UpdateGoal(ctx, postgres.UpdateGoalParams{
+		ID:            goal.ID,
+		Title:         goal.Title,
+		LanguageCode:  postgres.NewNullString(goal.LanguageCode),
+		LogActivityID: newNullInt16FromIntPtr(goal.ActivityID),
+		UnitKey:       postgres.NewNullString(goal.UnitKey),
+		Tag:           postgres.NewNullString(goal.Tag),
+		Metric:        string(goal.Metric),
+		Target:        goal.Target,
+		Period:        string(goal.Period),
+		StartsOn:      postgres.NewNullTime(goal.StartsOn),
+		EndsOn:        postgres.NewNullTime(goal.EndsOn),
+		Now:           now,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update goal: %w", err)
+	}
+
+	goal.Progress = nil
+	goal.UpdatedAt = now
+	return nil
+}
+
+func (r *Repository) DeleteGoal(ctx context.Context, id uuid.UUID, now time.Time) error {
+	err := r.q.DeleteGoal(ctx, postgres.DeleteGoalParams{
+		ID:  id,
+		Now: sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete goal: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) FindGoalByID(ctx context.Context, id uuid.UUID) (*domain.Goal, error) {
+	row, err := r.q.FindGoalByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch goal: %w", err)
+	}
+
+	return goalFromRow(row), nil
+}
+
+func (r *Repository) ListGoalsForUser(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
+	rows, err := r.q.ListGoalsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list goals: %w", err)
+	}
+
+	goals := make([]domain.Goal, len(rows))
+	for i, row := range rows {
+		// Both queries select the same columns.
+		goals[i] = *goalFromRow(postgres.FindGoalByIDRow(row))
+	}
+	return goals, nil
+}
+
+func (r *Repository) FetchGoalMetricTotals(ctx context.Context, filter domain.GoalMetricFilter) (*domain.GoalMetricTotals, error) {
+	var activityID sql.NullInt32
+	if filter.ActivityID != nil {
+		activityID = sql.NullInt32{Int32: int32(*filter.ActivityID), Valid: true}
+	}
+
+	row, err := r.q.FetchGoalMetricTotals(ctx, postgres.FetchGoalMetricTotalsParams{
+		UserID:       filter.UserID,
+		From:         filter.From,
+		To:           filter.To,
+		LanguageCode: postgres.NewNullString(filter.LanguageCode),
+		ActivityID:   activityID,
+		UnitKey:      postgres.NewNullString(filter.UnitKey),
+		Tag:          postgres.NewNullString(filter.Tag),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch goal totals: %w", err)
+	}
+
+	return &domain.GoalMetricTotals{
+		Score:           row.Score,
+		Amount:          row.Amount,
+		DurationSeconds: row.DurationSeconds,
+	}, nil
+}
+
+func (r *Repository) UpdateGoalProgress(ctx context.Context, goalID uuid.UUID, progress *domain.GoalProgress) error {
+	err := r.q.UpdateGoalProgress(ctx, postgres.UpdateGoalProgressParams{
+		ID:           goalID,
+		PeriodStart:  postgres.NewNullTime(&progress.PeriodStart),
+		PeriodEnd:    postgres.NewNullTime(&progress.PeriodEnd),
+		Current:      postgres.NewNullFloat64FromFloat32(progress.Current),
+		RecentPerDay: postgres.NewNullFloat64FromFloat32(progress.RecentPerDay),
+		RefreshedAt:  postgres.NewNullTime(&progress.RefreshedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("could not update goal progress: %w", err)
+	}
+	return nil
+}
+
+func goalFromRow(row postgres.FindGoalByIDRow) *domain.Goal {
+	goal := &domain.Goal{
+		ID:           row.ID,
+		UserID:       row.UserID,
+		Title:        row.Title,
+		LanguageCode: postgres.NewStringFromNullString(row.LanguageCode),
+		UnitKey:      postgres.NewStringFromNullString(row.UnitKey),
+		Tag:          postgres.NewStringFromNullString(row.Tag),
+		Metric:       domain.GoalMetric(row.Metric),
+		Target:       row.Target,
+		Period:       domain.GoalPeriod(row.Period),
+		StartsOn:     postgres.NewTimeFromNullTime(row.StartsOn),
+		EndsOn:       postgres.NewTimeFromNullTime(row.EndsOn),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+	if row.LogActivityID.Valid {
+		activityID := int(row.LogActivityID.Int16)
+		goal.ActivityID = &activityID
+	}
+	if row.ProgressRefreshedAt.Valid {
+		goal.Progress = &domain.GoalProgress{
+			PeriodStart:  row.ProgressPeriodStart.Time,
+			PeriodEnd:    row.ProgressPeriodEnd.Time,
+			Current:      postgres.NewFloat32FromNullFloat64(row.ProgressCurrent),
+			RecentPerDay: postgres.NewFloat32FromNullFloat64(row.ProgressRecentPerDay),
+			RefreshedAt:  row.ProgressRefreshedAt.Time,
+		}
+	}
+	return goal
+}
+
+func newNullInt16FromIntPtr(val *int) sql.NullInt16 {
+	if val == nil {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: int16(*val), Valid: true}
+}
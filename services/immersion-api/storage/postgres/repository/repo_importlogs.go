@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+// ImportLogs inserts every entry of the batch in a single transaction and
+// returns the new log IDs in entry order. Leaderboard outbox events are
+// written once per affected contest and official year.
+func (r *Repository) ImportLogs(ctx context.Context, batch *domain.LogImportBatch) ([]uuid.UUID, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not import logs: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	ids := make([]uuid.UUID, 0, len(batch.Entries))
+	contestIDSet := map[uuid.UUID]struct{}{}
+	officialYears := map[int16]struct{}{}
+
+	for _, entry := range batch.Entries {
+		req := entry.Log
+		tracking := req.Tracking()
+		logID, err := qtx.CreateImportedLog(ctx, postgres.CreateImportedLogParams{
+			ID:                          uuid.New(),
+			UserID:                      batch.UserID,
+			LanguageCode:                req.LanguageCode,
+			LogActivityID:               int16(req.ActivityID),
+			UnitID:                      trackingUnitID(tracking),
+			UnitKey:                     trackingUnitKey(tracking),
+			Amount:                      trackingAmount(tracking),
+			Modifier:                    trackingModifier(tracking),
+			DurationSeconds:             trackingDurationSeconds(tracking),
+			ComputedScore:               postgres.NewNullFloat64FromFloat32(tracking.ComputedScore),
+			ScoreRuleSetID:              scoreRuleSetID(tracking.ScoreProvenance),
+			ScoreRuleIds:                scoreRuleIDs(tracking.ScoreProvenance),
+			ScoreRates:                  scoreRates(tracking.ScoreProvenance),
+			ScoreRuleValues:             scoreRuleValues(tracking.ScoreProvenance),
+			ScoreSource:                 scoreSource(tracking.ScoreProvenance),
+			EligibleOfficialLeaderboard: req.EligibleOfficialLeaderboard(),
+			Description:                 postgres.NewNullString(req.Description),
+			CreatedAt:                   entry.LoggedAt,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("could not import log: %w", err)
+		}
+
+		if err = insertLogRelations(ctx, qtx, logID, req, contestIDSet); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if req.EligibleOfficialLeaderboard() {
+			officialYears[req.Year()] = struct{}{}
+		}
+		ids = append(ids, logID)
+	}
+
+	contestIDs := make([]uuid.UUID, 0, len(contestIDSet))
+	for id := range contestIDSet {
+		contestIDs = append(contestIDs, id)
+	}
+	if err = insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+		UserID:       batch.UserID,
+		ContestIDs:   contestIDs,
+		GoalProgress: true,
+	}); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	for year := range officialYears {
+		if err = insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+			UserID:          batch.UserID,
+			OfficialContest: true,
+			Year:            year,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not import logs: %w", err)
+	}
+
+	return ids, nil
+}
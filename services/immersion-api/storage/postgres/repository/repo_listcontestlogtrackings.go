@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+)
+
+func (r *Repository) ListContestLogTrackingsForLog(ctx context.Context, logID uuid.UUID) ([]domain.ContestLogTracking, error) {
+	rows, err := r.q.ListContestLogScoresForLog(ctx, logID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list contest scores for log: %w", err)
+	}
+
+	result := make([]domain.ContestLogTracking, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ContestLogTracking{
+			RegistrationID: row.RegistrationID,
+			ContestID:      row.ContestID,
+			Tracking: readLogTracking(
+				row.UnitID,
+				row.UnitKey,
+				row.Amount,
+				row.Modifier,
+				row.DurationSeconds,
+				row.Score,
+				row.ScoreRuleSetID,
+				row.ScoreRuleIds,
+				row.ScoreRates,
+				row.ScoreRuleValues,
+				row.ScoreSource,
+			),
+		}
+	}
+	return result, nil
+}
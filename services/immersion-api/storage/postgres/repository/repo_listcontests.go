@@ -60,6 +60,7 @@ func (r *Repository) ListContests(ctx context.Context, req *domain.ContestListRe
 			Private:                 c.Private,
 			LanguageCodeAllowList:   c.LanguageCodeAllowList,
 			ActivityTypeIDAllowList: c.ActivityTypeIDAllowList,
+			TeamMode:                c.TeamMode,
 			CreatedAt:               c.CreatedAt,
 			UpdatedAt:               c.UpdatedAt,
 			Deleted:                 c.DeletedAt.Valid,
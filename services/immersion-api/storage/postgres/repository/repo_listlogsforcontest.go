@@ -50,6 +50,7 @@ func (r *Repository) ListLogsForContest(ctx context.Context, req *domain.LogList
 			it.ScoreRuleSetID,
 			it.ScoreRuleIds,
 			it.ScoreRates,
+			it.ScoreRuleValues,
 			it.ScoreSource,
 		)
 		res[i] = domain.Log{
@@ -40,12 +40,14 @@ func (r *Repository) ListLogsForUser(ctx context.Context, req *domain.LogListFor
 			it.ScoreRuleSetID,
 			it.ScoreRuleIds,
 			it.ScoreRates,
+			it.ScoreRuleValues,
 			it.ScoreSource,
 		)
 		res[i] = domain.Log{
 			ID:              it.ID,
 			UserID:          it.UserID,
 			Description:     postgres.NewStringFromNullString(it.Description),
+			MediaID:         postgres.NewUUIDPtrFromNullUUID(it.MediaID),
 			LanguageCode:    it.LanguageCode,
 			LanguageName:    it.LanguageName,
 			ActivityID:      int(it.ActivityID),
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) SearchMedia(ctx context.Context, req *domain.MediaSearchRequest) ([]domain.Media, error) {
+	rows, err := r.q.SearchMedia(ctx, postgres.SearchMediaParams{
+		LanguageCode: postgres.NewNullString(req.LanguageCode),
+		Query:        req.Query,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not search media: %w", err)
+	}
+
+	return mediaFromRows(rows)
+}
+
+func (r *Repository) FindMediaByID(ctx context.Context, id uuid.UUID) (*domain.Media, error) {
+	row, err := r.q.FindMediaByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not find media: %w", err)
+	}
+
+	return mediaFromRow(row)
+}
+
+func (r *Repository) FindMediaIDByExternalID(ctx context.Context, key string, value string) (*uuid.UUID, error) {
+	id, err := r.q.FindMediaByExternalID(ctx, postgres.FindMediaByExternalIDParams{
+		Key:   key,
+		Value: value,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not find media by external id: %w", err)
+	}
+
+	return &id, nil
+}
+
+func (r *Repository) ListMediaByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Media, error) {
+	rows, err := r.q.ListMediaByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not list media: %w", err)
+	}
+
+	return mediaFromRows(rows)
+}
+
+func (r *Repository) CreateMedia(ctx context.Context, media *domain.Media) (*domain.Media, error) {
+	externalIDs, err := json.Marshal(media.ExternalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode external ids: %w", err)
+	}
+
+	row, err := r.q.CreateMedia(ctx, postgres.CreateMediaParams{
+		ID:              media.ID,
+		Title:           media.Title,
+		MediaType:       string(media.Type),
+		LanguageCode:    postgres.NewNullString(media.LanguageCode),
+		TotalLength:     postgres.NewNullFloat64FromFloat32Ptr(media.TotalLength),
+		LengthUnit:      postgres.NewNullString((*string)(media.LengthUnit)),
+		ExternalIds:     externalIDs,
+		CreatedByUserID: postgres.NewNullUUIDFromPtr(media.CreatedByUserID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create media: %w", err)
+	}
+
+	return mediaFromRow(row)
+}
+
+func (r *Repository) UpdateMedia(ctx context.Context, media *domain.Media) (*domain.Media, error) {
+	externalIDs, err := json.Marshal(media.ExternalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode external ids: %w", err)
+	}
+
+	row, err := r.q.UpdateMedia(ctx, postgres.UpdateMediaParams{
+		ID:           media.ID,
+		Title:        media.Title,
+		MediaType:    string(media.Type),
+		LanguageCode: postgres.NewNullString(media.LanguageCode),
+		TotalLength:  postgres.NewNullFloat64FromFloat32Ptr(media.TotalLength),
+		LengthUnit:   postgres.NewNullString((*string)(media.LengthUnit)),
+		ExternalIds:  externalIDs,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not update media: %w", err)
+	}
+
+	return mediaFromRow(row)
+}
+
+func (r *Repository) MediaExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	exists, err := r.q.MediaExists(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("could not check if media exists: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *Repository) SumMediaLogsForUser(ctx context.Context, userID uuid.UUID, mediaID *uuid.UUID) ([]domain.MediaLogTotal, error) {
+	rows, err := r.q.SumMediaLogsForUser(ctx, postgres.SumMediaLogsForUserParams{
+		UserID:  userID,
+		MediaID: postgres.NewNullUUIDFromPtr(mediaID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not sum media logs: %w", err)
+	}
+
+	totals := make([]domain.MediaLogTotal, len(rows))
+	for i, row := range rows {
+		totals[i] = domain.MediaLogTotal{
+			MediaID:         row.MediaID,
+			LengthUnit:      mediaLengthUnitFromNullString(row.MediaLengthUnit),
+			Amount:          row.Amount,
+			DurationSeconds: row.DurationSeconds,
+			LogCount:        int(row.LogCount),
+			LastLoggedAt:    row.LastLoggedAt,
+		}
+	}
+
+	return totals, nil
+}
+
+func (r *Repository) LinkLogsToMediaByDescription(ctx context.Context, userID uuid.UUID, mediaID uuid.UUID, descriptions []string) (int64, error) {
+	linked, err := r.q.LinkLogsToMediaByDescription(ctx, postgres.LinkLogsToMediaByDescriptionParams{
+		MediaID:      postgres.NewNullUUID(mediaID),
+		UserID:       userID,
+		Descriptions: descriptions,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not link logs to media: %w", err)
+	}
+	return linked, nil
+}
+
+func mediaFromRows(rows []postgres.Medium) ([]domain.Media, error) {
+	media := make([]domain.Media, len(rows))
+	for i, row := range rows {
+		it, err := mediaFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		media[i] = *it
+	}
+	return media, nil
+}
+
+func mediaFromRow(row postgres.Medium) (*domain.Media, error) {
+	externalIDs := map[string]string{}
+	if len(row.ExternalIds) > 0 {
+		if err := json.Unmarshal(row.ExternalIds, &externalIDs); err != nil {
+			return nil, fmt.Errorf("could not decode external ids of media %s: %w", row.ID, err)
+		}
+	}
+
+	media := &domain.Media{
+		ID:              row.ID,
+		Title:           row.Title,
+		Type:            domain.MediaType(row.MediaType),
+		LanguageCode:    postgres.NewStringFromNullString(row.LanguageCode),
+		ExternalIDs:     externalIDs,
+		CreatedByUserID: postgres.NewUUIDPtrFromNullUUID(row.CreatedByUserID),
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+	if row.TotalLength.Valid && row.LengthUnit.Valid {
+		length := float32(row.TotalLength.Float64)
+		unit := domain.MediaLengthUnit(row.LengthUnit.String)
+		media.TotalLength = &length
+		media.LengthUnit = &unit
+	}
+
+	return media, nil
+}
+
+func mediaLengthUnitFromNullString(value sql.NullString) *domain.MediaLengthUnit {
+	if !value.Valid {
+		return nil
+	}
+	unit := domain.MediaLengthUnit(value.String)
+	return &unit
+}
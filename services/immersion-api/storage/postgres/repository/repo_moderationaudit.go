@@ -10,6 +10,12 @@ import (
 )
 
 func (r *Repository) CreateModerationAuditLog(ctx context.Context, req *domain.ModerationAuditLogCreateRequest) error {
+	return insertModerationAuditLog(ctx, r.q, req)
+}
+
+// insertModerationAuditLog writes an audit log entry, within a transaction
+// when called with a transactional qtx.
+func insertModerationAuditLog(ctx context.Context, qtx *postgres.Queries, req *domain.ModerationAuditLogCreateRequest) error {
 	metadata := req.Metadata
 	if metadata == nil {
 		metadata = map[string]any{}
@@ -20,7 +26,7 @@ func (r *Repository) CreateModerationAuditLog(ctx context.Context, req *domain.M
 		return fmt.Errorf("could not marshal metadata: %w", err)
 	}
 
-	err = r.q.CreateModerationAuditLog(ctx, postgres.CreateModerationAuditLogParams{
+	err = qtx.CreateModerationAuditLog(ctx, postgres.CreateModerationAuditLogParams{
 		UserID:      req.ModeratorUserID,
 		Action:      req.Action,
 		Metadata:    metadataJSON,
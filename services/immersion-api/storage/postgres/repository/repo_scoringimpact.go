@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) ListScoringImpactSample(ctx context.Context, req *domain.ScoringImpactSampleRequest) ([]domain.ScoringImpactSampleLog, error) {
+	// Empty filters have to be sent as empty arrays, null would match nothing
+	rows, err := r.q.ListLogsForScoringImpact(ctx, postgres.ListLogsForScoringImpactParams{
+		ContestID:           postgres.NewNullUUIDFromPtr(req.ContestID),
+		RegisteredContestID: postgres.NewNullUUIDFromPtr(req.RegisteredContestID),
+		LanguageCodes:       append([]string{}, req.LanguageCodes...),
+		ActivityIds:         append([]int32{}, req.ActivityIDs...),
+		SampleSize:          req.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list logs for scoring impact: %w", err)
+	}
+
+	sample := make([]domain.ScoringImpactSampleLog, len(rows))
+	for i, row := range rows {
+		sample[i] = domain.ScoringImpactSampleLog{
+			LogID:           row.ID,
+			UserID:          row.UserID,
+			UserDisplayName: row.UserDisplayName,
+			ActivityID:      int32(row.ActivityID),
+			LanguageCode:    row.LanguageCode,
+			Tags:            row.Tags,
+			LoggedAt:        row.CreatedAt,
+			Timezone:        row.Timezone,
+			Tracking: readLogTracking(
+				row.UnitID,
+				row.UnitKey,
+				row.Amount,
+				row.Modifier,
+				row.DurationSeconds,
+				sql.NullFloat64{},
+				scoreRuleSetID(nil),
+				nil,
+				nil,
+				nil,
+				sql.NullString{},
+			),
+		}
+	}
+	return sample, nil
+}
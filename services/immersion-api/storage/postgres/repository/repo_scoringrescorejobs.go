@@ -0,0 +1,407 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+// CreateScoringRescoreJob stores the job along with the number of logs it
+// will process. Jobs writing scores supersede the unfinished ones of the same
+// scope in the same transaction, so only the latest rule set is applied.
+func (r *Repository) CreateScoringRescoreJob(ctx context.Context, job *domain.ScoringRescoreJob) (*domain.ScoringRescoreJob, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create scoring rescore job: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	var total int32
+	if job.ContestID == nil {
+		total, err = qtx.CountLogsForPlatformRescore(ctx)
+	} else {
+		total, err = qtx.CountLogsForContestRescore(ctx, *job.ContestID)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not count logs to rescore: %w", err)
+	}
+
+	if !job.DryRun {
+		if err := qtx.SupersedeScoringRescoreJobs(ctx, postgres.SupersedeScoringRescoreJobsParams{
+			Scope:     string(job.Scope),
+			ContestID: postgres.NewNullUUIDFromPtr(job.ContestID),
+			Now:       job.CreatedAt,
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("could not supersede scoring rescore jobs: %w", err)
+		}
+	}
+
+	row, err := qtx.CreateScoringRescoreJob(ctx, postgres.CreateScoringRescoreJobParams{
+		Scope:             string(job.Scope),
+		ContestID:         postgres.NewNullUUIDFromPtr(job.ContestID),
+		RuleSetID:         job.RuleSetID,
+		DryRun:            job.DryRun,
+		TotalLogs:         total,
+		RequestedByUserID: postgres.NewNullUUIDFromPtr(job.RequestedByUserID),
+		Now:               job.CreatedAt,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not create scoring rescore job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not create scoring rescore job: %w", err)
+	}
+	return scoringRescoreJobFromRow(row), nil
+}
+
+func (r *Repository) FindScoringRescoreJobByID(ctx context.Context, id uuid.UUID) (*domain.ScoringRescoreJob, error) {
+	row, err := r.q.FindScoringRescoreJobByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch scoring rescore job: %w", err)
+	}
+	return scoringRescoreJobFromRow(row), nil
+}
+
+func (r *Repository) ListScoringRescoreJobs(ctx context.Context, contestID *uuid.UUID, limit int32) ([]domain.ScoringRescoreJob, error) {
+	rows, err := r.q.ListScoringRescoreJobs(ctx, postgres.ListScoringRescoreJobsParams{
+		ContestID:  postgres.NewNullUUIDFromPtr(contestID),
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list scoring rescore jobs: %w", err)
+	}
+
+	jobs := make([]domain.ScoringRescoreJob, len(rows))
+	for i, row := range rows {
+		jobs[i] = *scoringRescoreJobFromRow(row)
+	}
+	return jobs, nil
+}
+
+func (r *Repository) ListScoringRescoreJobUserDiffs(ctx context.Context, req *domain.ScoringRescoreJobDiffRequest) (*domain.ScoringRescoreJobDiffResponse, error) {
+	rows, err := r.q.ListScoringRescoreJobUserDiffs(ctx, postgres.ListScoringRescoreJobUserDiffsParams{
+		JobID:     req.JobID,
+		StartFrom: int32(req.Page * req.PageSize),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list scoring rescore job diff: %w", err)
+	}
+
+	users := make([]domain.ScoringRescoreUserDiff, len(rows))
+	for i, row := range rows {
+		users[i] = domain.ScoringRescoreUserDiff{
+			UserID:          row.UserID,
+			UserDisplayName: row.UserDisplayName,
+			ChangedLogs:     row.ChangedLogs,
+			ScoreBefore:     row.ScoreBefore,
+			ScoreAfter:      row.ScoreAfter,
+		}
+	}
+
+	var totalSize int64
+	if len(rows) > 0 {
+		totalSize = rows[0].TotalSize
+	}
+	nextPageToken := ""
+	if (req.Page*req.PageSize)+req.PageSize < int(totalSize) {
+		nextPageToken = fmt.Sprint(req.Page + 1)
+	}
+
+	return &domain.ScoringRescoreJobDiffResponse{
+		Users:         users,
+		TotalSize:     int(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (r *Repository) FindNextScoringRescoreJob(ctx context.Context) (*domain.ScoringRescoreJob, error) {
+	row, err := r.q.FindNextScoringRescoreJob(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch next scoring rescore job: %w", err)
+	}
+	return scoringRescoreJobFromRow(row), nil
+}
+
+func (r *Repository) ListScoringRescoreCandidates(ctx context.Context, job *domain.ScoringRescoreJob, limit int32, now time.Time) ([]domain.ScoringRescoreCandidate, error) {
+	if job.ContestID != nil {
+		rows, err := r.q.ListLogsForContestRescore(ctx, postgres.ListLogsForContestRescoreParams{
+			ContestID:     *job.ContestID,
+			AfterLogID:    postgres.NewNullUUIDFromPtr(job.CursorLogID),
+			AfterLoggedAt: postgres.NewNullTime(job.CursorLoggedAt),
+			BatchSize:     limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not list contest logs to rescore: %w", err)
+		}
+
+		candidates := make([]domain.ScoringRescoreCandidate, len(rows))
+		for i, row := range rows {
+			candidates[i] = domain.ScoringRescoreCandidate{
+				LogID:                       row.ID,
+				UserID:                      row.UserID,
+				ActivityID:                  int32(row.ActivityID),
+				LanguageCode:                row.LanguageCode,
+				Tags:                        row.Tags,
+				Year:                        row.Year,
+				LoggedAt:                    row.CreatedAt,
+				Timezone:                    row.Timezone,
+				EligibleOfficialLeaderboard: row.EligibleOfficialLeaderboard,
+				Tracking: readLogTracking(
+					row.UnitID,
+					row.UnitKey,
+					row.Amount,
+					row.Modifier,
+					row.DurationSeconds,
+					row.Score,
+					row.ScoreRuleSetID,
+					row.ScoreRuleIds,
+					row.ScoreRates,
+					row.ScoreRuleValues,
+					row.ScoreSource,
+				),
+			}
+		}
+		return candidates, nil
+	}
+
+	rows, err := r.q.ListLogsForPlatformRescore(ctx, postgres.ListLogsForPlatformRescoreParams{
+		AfterLogID:    postgres.NewNullUUIDFromPtr(job.CursorLogID),
+		AfterLoggedAt: postgres.NewNullTime(job.CursorLoggedAt),
+		BatchSize:     limit,
+		Now:           now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list logs to rescore: %w", err)
+	}
+
+	candidates := make([]domain.ScoringRescoreCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = domain.ScoringRescoreCandidate{
+			LogID:                       row.ID,
+			UserID:                      row.UserID,
+			ActivityID:                  int32(row.ActivityID),
+			LanguageCode:                row.LanguageCode,
+			Tags:                        row.Tags,
+			Year:                        row.Year,
+			LoggedAt:                    row.CreatedAt,
+			Timezone:                    row.Timezone,
+			EligibleOfficialLeaderboard: row.EligibleOfficialLeaderboard,
+			ContestIDs:                  row.ContestIds,
+			Tracking: readLogTracking(
+				row.UnitID,
+				row.UnitKey,
+				row.Amount,
+				row.Modifier,
+				row.DurationSeconds,
+				row.Score,
+				row.ScoreRuleSetID,
+				row.ScoreRuleIds,
+				row.ScoreRates,
+				row.ScoreRuleValues,
+				row.ScoreSource,
+			),
+		}
+	}
+	return candidates, nil
+}
+
+func (r *Repository) SaveScoringRescoreBatch(ctx context.Context, batch *domain.ScoringRescoreBatch) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not save scoring rescore batch: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	// Another worker may have processed the batch, or the job was superseded
+	// while it was being processed.
+	current, err := qtx.LockScoringRescoreJob(ctx, batch.Job.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not lock scoring rescore job: %w", err)
+	}
+	if !scoringRescoreJobFromRow(current).Runnable() || current.CursorLogID != postgres.NewNullUUIDFromPtr(batch.Job.CursorLogID) {
+		_ = tx.Rollback()
+		return domain.ErrConflict
+	}
+
+	if !batch.Job.DryRun {
+		if err := writeScoringRescoreChanges(ctx, qtx, batch); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	for _, diff := range batch.UserDiffs {
+		if err := qtx.UpsertScoringRescoreJobUserDiff(ctx, postgres.UpsertScoringRescoreJobUserDiffParams{
+			JobID:       batch.Job.ID,
+			UserID:      diff.UserID,
+			ChangedLogs: diff.ChangedLogs,
+			ScoreBefore: diff.ScoreBefore,
+			ScoreAfter:  diff.ScoreAfter,
+		}); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not record scoring rescore diff: %w", err)
+		}
+	}
+
+	status := domain.ScoringRescoreJobStatusRunning
+	var completedAt *time.Time
+	if batch.Done {
+		status = domain.ScoringRescoreJobStatusCompleted
+		completedAt = &batch.Now
+	}
+	if err := qtx.AdvanceScoringRescoreJob(ctx, postgres.AdvanceScoringRescoreJobParams{
+		ID:             batch.Job.ID,
+		Status:         string(status),
+		CursorLogID:    postgres.NewNullUUIDFromPtr(batch.CursorLogID),
+		CursorLoggedAt: postgres.NewNullTime(batch.CursorLoggedAt),
+		ProcessedLogs:  batch.Processed,
+		ChangedLogs:    int32(len(batch.Changes)),
+		SkippedLogs:    batch.Skipped,
+		Now:            batch.Now,
+		CompletedAt:    postgres.NewNullTime(completedAt),
+	}); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not advance scoring rescore job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not save scoring rescore batch: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) FailScoringRescoreJob(ctx context.Context, id uuid.UUID, reason string, now time.Time) error {
+	if err := r.q.FailScoringRescoreJob(ctx, postgres.FailScoringRescoreJobParams{
+		ID:        id,
+		LastError: postgres.NewNullString(&reason),
+		Now:       now,
+	}); err != nil {
+		return fmt.Errorf("could not mark scoring rescore job as failed: %w", err)
+	}
+	return nil
+}
+
+// writeScoringRescoreChanges stores the new scores and requests one
+// leaderboard refresh per user instead of one per log.
+func writeScoringRescoreChanges(ctx context.Context, qtx *postgres.Queries, batch *domain.ScoringRescoreBatch) error {
+	type userRefresh struct {
+		contestIDs    []uuid.UUID
+		officialYears []int16
+	}
+	refreshes := map[uuid.UUID]*userRefresh{}
+	userIDs := []uuid.UUID{}
+
+	for _, change := range batch.Changes {
+		candidate := change.Candidate
+		tracking := change.Tracking
+		refresh, ok := refreshes[candidate.UserID]
+		if !ok {
+			refresh = &userRefresh{}
+			refreshes[candidate.UserID] = refresh
+			userIDs = append(userIDs, candidate.UserID)
+		}
+
+		contestIDs := candidate.ContestIDs
+		if batch.Job.ContestID != nil {
+			contestIDs = []uuid.UUID{*batch.Job.ContestID}
+		} else {
+			if err := qtx.UpdateLogScore(ctx, postgres.UpdateLogScoreParams{
+				LogID:           candidate.LogID,
+				ComputedScore:   postgres.NewNullFloat64FromFloat32(tracking.ComputedScore),
+				ScoreRuleSetID:  scoreRuleSetID(tracking.ScoreProvenance),
+				ScoreRuleIds:    scoreRuleIDs(tracking.ScoreProvenance),
+				ScoreRates:      scoreRates(tracking.ScoreProvenance),
+				ScoreRuleValues: scoreRuleValues(tracking.ScoreProvenance),
+				ScoreSource:     scoreSource(tracking.ScoreProvenance),
+			}); err != nil {
+				return fmt.Errorf("could not update score of log %s: %w", candidate.LogID, err)
+			}
+			if candidate.EligibleOfficialLeaderboard && !slices.Contains(refresh.officialYears, candidate.Year) {
+				refresh.officialYears = append(refresh.officialYears, candidate.Year)
+			}
+		}
+
+		for _, contestID := range contestIDs {
+			if err := qtx.UpdateContestLogScore(ctx, postgres.UpdateContestLogScoreParams{
+				ContestID:       contestID,
+				LogID:           candidate.LogID,
+				ComputedScore:   postgres.NewNullFloat64FromFloat32(tracking.ComputedScore),
+				ScoreRuleSetID:  scoreRuleSetID(tracking.ScoreProvenance),
+				ScoreRuleIds:    scoreRuleIDs(tracking.ScoreProvenance),
+				ScoreRates:      scoreRates(tracking.ScoreProvenance),
+				ScoreRuleValues: scoreRuleValues(tracking.ScoreProvenance),
+				ScoreSource:     scoreSource(tracking.ScoreProvenance),
+			}); err != nil {
+				return fmt.Errorf("could not update score of contest log %s: %w", contestID, err)
+			}
+			if !slices.Contains(refresh.contestIDs, contestID) {
+				refresh.contestIDs = append(refresh.contestIDs, contestID)
+			}
+		}
+	}
+
+	for _, userID := range userIDs {
+		refresh := refreshes[userID]
+		if err := insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+			UserID:       userID,
+			ContestIDs:   refresh.contestIDs,
+			GoalProgress: batch.Job.ContestID == nil,
+		}); err != nil {
+			return err
+		}
+		for _, year := range refresh.officialYears {
+			if err := insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+				UserID:          userID,
+				OfficialContest: true,
+				Year:            year,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func scoringRescoreJobFromRow(row postgres.ScoringRescoreJob) *domain.ScoringRescoreJob {
+	return &domain.ScoringRescoreJob{
+		ID:                row.ID,
+		Scope:             domain.ScoringRuleSetScope(row.Scope),
+		ContestID:         postgres.NewUUIDPtrFromNullUUID(row.ContestID),
+		RuleSetID:         row.RuleSetID,
+		DryRun:            row.DryRun,
+		Status:            domain.ScoringRescoreJobStatus(row.Status),
+		CursorLogID:       postgres.NewUUIDPtrFromNullUUID(row.CursorLogID),
+		CursorLoggedAt:    postgres.NewTimeFromNullTime(row.CursorLoggedAt),
+		TotalLogs:         row.TotalLogs,
+		ProcessedLogs:     row.ProcessedLogs,
+		ChangedLogs:       row.ChangedLogs,
+		SkippedLogs:       row.SkippedLogs,
+		LastError:         postgres.NewStringFromNullString(row.LastError),
+		RequestedByUserID: postgres.NewUUIDPtrFromNullUUID(row.RequestedByUserID),
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+		CompletedAt:       postgres.NewTimeFromNullTime(row.CompletedAt),
+	}
+}
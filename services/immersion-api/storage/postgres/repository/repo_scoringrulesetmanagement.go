@@ -107,36 +107,48 @@ func (r *Repository) CreateScoringRuleSetDraft(
 		_ = tx.Rollback()
 		return nil, fmt.Errorf("could not create scoring rule set: %w", err)
 	}
-	rules := make([]postgres.ScoringRule, len(req.Rules))
-	for i, rule := range req.Rules {
-		ruleID := uuid.New()
-		if err := qtx.CreateScoringRule(ctx, postgres.CreateScoringRuleParams{
-			ID:           ruleID,
-			RuleSetID:    id,
-			Priority:     rule.Priority,
-			Stackable:    rule.Stackable,
-			ActivityID:   int16(rule.ActivityID),
-			UnitKey:      nullableText(rule.UnitKey),
-			LanguageCode: nullableText(rule.LanguageCode),
-			Tag:          nullableText(rule.Tag),
-			ScoreSource:  string(rule.ScoreSource),
-			Rate:         rule.Rate,
-		}); err != nil {
-			_ = tx.Rollback()
-			return nil, fmt.Errorf("could not create scoring rule: %w", err)
-		}
-		rules[i] = postgres.ScoringRule{
-			ID:           ruleID,
-			RuleSetID:    id,
-			Priority:     rule.Priority,
-			Stackable:    rule.Stackable,
-			ActivityID:   int16(rule.ActivityID),
-			UnitKey:      nullableText(rule.UnitKey),
-			LanguageCode: nullableText(rule.LanguageCode),
-			Tag:          nullableText(rule.Tag),
-			ScoreSource:  string(rule.ScoreSource),
-			Rate:         rule.Rate,
+	rules, err := createScoringRules(ctx, qtx, id, req.Rules)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit scoring rule set: %w", err)
+	}
+	return scoringRuleSetToDomain(row, rules), nil
+}
+
+func (r *Repository) UpdateScoringRuleSetDraft(
+	ctx context.Context,
+	id uuid.UUID,
+	req *domain.ScoringRuleSetDraftCreateRequest,
+) (*domain.ScoringRuleSet, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not start scoring rule set transaction: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	row, err := qtx.UpdateScoringRuleSetDraft(ctx, postgres.UpdateScoringRuleSetDraftParams{
+		ID:                id,
+		Mode:              nullableText(string(req.Mode)),
+		FallbackRuleSetID: nullableUUID(req.FallbackRuleSetID),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrConflict
 		}
+		return nil, fmt.Errorf("could not update scoring rule set: %w", err)
+	}
+	if err := qtx.DeleteScoringRulesForRuleSet(ctx, id); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not delete scoring rules: %w", err)
+	}
+	rules, err := createScoringRules(ctx, qtx, id, req.Rules)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
 	}
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("could not commit scoring rule set: %w", err)
@@ -144,6 +156,17 @@ func (r *Repository) CreateScoringRuleSetDraft(
 	return scoringRuleSetToDomain(row, rules), nil
 }
 
+func (r *Repository) DeleteScoringRuleSetDraft(ctx context.Context, id uuid.UUID) error {
+	deleted, err := r.q.DeleteScoringRuleSetDraft(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not delete scoring rule set: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrConflict
+	}
+	return nil
+}
+
 func (r *Repository) PublishScoringRuleSet(
 	ctx context.Context,
 	id uuid.UUID,
@@ -162,6 +185,24 @@ func (r *Repository) PublishScoringRuleSet(
 	return loadScoringRuleSet(ctx, r.q, row)
 }
 
+func (r *Repository) ArchiveScoringRuleSet(
+	ctx context.Context,
+	id uuid.UUID,
+	archivedAt time.Time,
+) (*domain.ScoringRuleSet, error) {
+	row, err := r.q.ArchiveScoringRuleSet(ctx, postgres.ArchiveScoringRuleSetParams{
+		ID:         id,
+		ArchivedAt: postgres.NewNullTime(&archivedAt),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("only inactive published rule sets can be archived: %w", domain.ErrConflict)
+		}
+		return nil, fmt.Errorf("could not archive scoring rule set: %w", err)
+	}
+	return loadScoringRuleSet(ctx, r.q, row)
+}
+
 func (r *Repository) ActivatePlatformScoringRuleSet(ctx context.Context, id uuid.UUID) error {
 	if err := r.q.ActivatePlatformScoringRuleSet(ctx, id); err != nil {
 		return fmt.Errorf("could not activate platform scoring rule set: %w", err)
@@ -185,6 +226,63 @@ func (r *Repository) ActivateContestScoringRuleSet(
 	return nil
 }
 
+func createScoringRules(
+	ctx context.Context,
+	qtx *postgres.Queries,
+	ruleSetID uuid.UUID,
+	rules []domain.ScoringRule,
+) ([]postgres.ScoringRule, error) {
+	result := make([]postgres.ScoringRule, len(rules))
+	for i, rule := range rules {
+		params := postgres.CreateScoringRuleParams{
+			ID:             uuid.New(),
+			RuleSetID:      ruleSetID,
+			Priority:       rule.Priority,
+			Stackable:      rule.Stackable,
+			ActivityID:     int16(rule.ActivityID),
+			UnitKey:        nullableText(rule.UnitKey),
+			LanguageCode:   nullableText(rule.LanguageCode),
+			Tag:            nullableText(rule.Tag),
+			Tags:           append([]string{}, rule.Tags...),
+			TagMatch:       nullableText(string(rule.TagMatch)),
+			MinValue:       postgres.NewNullFloat64FromFloat32Ptr(rule.MinValue),
+			MaxValue:       postgres.NewNullFloat64FromFloat32Ptr(rule.MaxValue),
+			EffectiveFrom:  postgres.NewNullTime(rule.EffectiveFrom),
+			EffectiveUntil: postgres.NewNullTime(rule.EffectiveUntil),
+			ScoreSource:    string(rule.ScoreSource),
+			Rate:           rule.Rate,
+			CapPeriod:      nullableText(string(rule.CapPeriod)),
+			CapValue:       postgres.NewNullFloat64FromFloat32Ptr(rule.CapValue),
+			CapScore:       postgres.NewNullFloat64FromFloat32Ptr(rule.CapScore),
+		}
+		if err := qtx.CreateScoringRule(ctx, params); err != nil {
+			return nil, fmt.Errorf("could not create scoring rule: %w", err)
+		}
+		result[i] = postgres.ScoringRule{
+			ID:             params.ID,
+			RuleSetID:      params.RuleSetID,
+			Priority:       params.Priority,
+			Stackable:      params.Stackable,
+			ActivityID:     params.ActivityID,
+			UnitKey:        params.UnitKey,
+			LanguageCode:   params.LanguageCode,
+			Tag:            params.Tag,
+			Tags:           params.Tags,
+			TagMatch:       params.TagMatch,
+			MinValue:       params.MinValue,
+			MaxValue:       params.MaxValue,
+			EffectiveFrom:  params.EffectiveFrom,
+			EffectiveUntil: params.EffectiveUntil,
+			ScoreSource:    params.ScoreSource,
+			Rate:           params.Rate,
+			CapPeriod:      params.CapPeriod,
+			CapValue:       params.CapValue,
+			CapScore:       params.CapScore,
+		}
+	}
+	return result, nil
+}
+
 func nullableUUID(value *uuid.UUID) uuid.NullUUID {
 	if value == nil {
 		return uuid.NullUUID{}
@@ -195,3 +293,11 @@ func nullableUUID(value *uuid.UUID) uuid.NullUUID {
 func nullableText(value string) sql.NullString {
 	return sql.NullString{String: value, Valid: value != ""}
 }
+
+func optionalFloat32(value sql.NullFloat64) *float32 {
+	if !value.Valid {
+		return nil
+	}
+	result := float32(value.Float64)
+	return &result
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) FindScoringRuleUsage(ctx context.Context, req *domain.ScoringRuleUsageRequest) (*domain.ScoringRuleUsage, error) {
+	if req.ContestID != nil {
+		row, err := r.q.SumContestScoringRuleUsage(ctx, postgres.SumContestScoringRuleUsageParams{
+			ContestID:    *req.ContestID,
+			UserID:       req.UserID,
+			From:         req.From,
+			Until:        req.Until,
+			RuleID:       req.RuleID,
+			ExcludeLogID: postgres.NewNullUUIDFromPtr(req.ExcludeLogID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not sum contest scoring rule usage: %w", err)
+		}
+		return &domain.ScoringRuleUsage{Value: row.Value, Score: row.Score}, nil
+	}
+
+	row, err := r.q.SumPlatformScoringRuleUsage(ctx, postgres.SumPlatformScoringRuleUsageParams{
+		UserID:       req.UserID,
+		From:         req.From,
+		Until:        req.Until,
+		RuleID:       req.RuleID,
+		ExcludeLogID: postgres.NewNullUUIDFromPtr(req.ExcludeLogID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not sum scoring rule usage: %w", err)
+	}
+	return &domain.ScoringRuleUsage{Value: row.Value, Score: row.Score}, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) ListTrendingTags(ctx context.Context, req *domain.TrendingStatisticsQuery) ([]domain.TagStatistic, error) {
+	rows, err := r.q.ListTrendingTags(ctx, postgres.ListTrendingTagsParams{
+		Since:        req.Since,
+		LanguageCode: postgres.NewNullString(req.LanguageCode),
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trending tags: %w", err)
+	}
+
+	tags := make([]domain.TagStatistic, len(rows))
+	for i, row := range rows {
+		tags[i] = tagStatisticFromRow(row)
+	}
+
+	return tags, nil
+}
+
+func (r *Repository) ListTrendingMedia(ctx context.Context, req *domain.TrendingStatisticsQuery) ([]domain.MediaStatistic, error) {
+	rows, err := r.q.ListTrendingMedia(ctx, postgres.ListTrendingMediaParams{
+		Since:        req.Since,
+		LanguageCode: postgres.NewNullString(req.LanguageCode),
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trending media: %w", err)
+	}
+
+	media := make([]domain.MediaStatistic, len(rows))
+	for i, row := range rows {
+		media[i] = domain.MediaStatistic{
+			MediaID:    row.ID,
+			Title:      row.Title,
+			Type:       domain.MediaType(row.MediaType),
+			LogCount:   int(row.LogCount),
+			UserCount:  int(row.UserCount),
+			TotalScore: row.TotalScore,
+		}
+	}
+
+	return media, nil
+}
+
+func tagStatisticFromRow(row postgres.ListTrendingTagsRow) domain.TagStatistic {
+	return domain.TagStatistic{
+		Tag:        row.Tag,
+		LogCount:   int(row.LogCount),
+		UserCount:  int(row.UserCount),
+		TotalScore: row.TotalScore,
+	}
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) ListUnits(ctx context.Context, includeDeprecated bool) ([]domain.Unit, error) {
+	rows, err := r.q.ListUnits(ctx, includeDeprecated)
+	if err != nil {
+		return nil, fmt.Errorf("could not list units: %w", err)
+	}
+
+	units := make([]domain.Unit, len(rows))
+	for i, row := range rows {
+		units[i] = *unitFromRow(row)
+	}
+
+	return units, nil
+}
+
+func (r *Repository) FindUnitByID(ctx context.Context, id uuid.UUID) (*domain.Unit, error) {
+	row, err := r.q.FindUnitByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not find unit: %w", err)
+	}
+
+	return unitFromRow(postgres.ListUnitsRow(row)), nil
+}
+
+func (r *Repository) UnitKeyExists(ctx context.Context, key string) (bool, error) {
+	exists, err := r.q.UnitKeyExists(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("could not check if unit exists: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *Repository) CreateUnit(ctx context.Context, unit *domain.Unit) (*domain.Unit, error) {
+	row, err := r.q.CreateUnit(ctx, postgres.CreateUnitParams{
+		ID:              unit.ID,
+		UnitKey:         unit.Key,
+		LogActivityID:   int16(unit.LogActivityID),
+		Name:            unit.Name,
+		Modifier:        unit.Modifier,
+		LanguageCode:    postgres.NewNullString(unit.LanguageCode),
+		MediaLengthUnit: postgres.NewNullString((*string)(unit.MediaLengthUnit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create unit: %w", err)
+	}
+
+	return unitFromRow(postgres.ListUnitsRow(row)), nil
+}
+
+func (r *Repository) UpdateUnit(ctx context.Context, unit *domain.Unit) (*domain.Unit, error) {
+	row, err := r.q.UpdateUnit(ctx, postgres.UpdateUnitParams{
+		ID:              unit.ID,
+		Name:            unit.Name,
+		Modifier:        unit.Modifier,
+		DeprecatedAt:    postgres.NewNullTime(unit.DeprecatedAt),
+		MediaLengthUnit: postgres.NewNullString((*string)(unit.MediaLengthUnit)),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not update unit: %w", err)
+	}
+
+	return unitFromRow(postgres.ListUnitsRow(row)), nil
+}
+
+func (r *Repository) DeleteOrDeprecateUnit(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("could not start transaction: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	deleted, err := qtx.DeleteUnusedUnit(ctx, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("could not delete unit: %w", err)
+	}
+
+	deprecated := false
+	if deleted == 0 {
+		updated, err := qtx.DeprecateUnit(ctx, postgres.DeprecateUnitParams{
+			ID:           id,
+			DeprecatedAt: postgres.NewNullTime(&now),
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return false, fmt.Errorf("could not deprecate unit: %w", err)
+		}
+		if updated == 0 {
+			_ = tx.Rollback()
+			return false, domain.ErrNotFound
+		}
+		deprecated = true
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("could not delete unit: %w", err)
+	}
+
+	return deprecated, nil
+}
+
+func unitFromRow(row postgres.ListUnitsRow) *domain.Unit {
+	return &domain.Unit{
+		ID:              row.ID,
+		Key:             row.UnitKey,
+		LogActivityID:   int(row.LogActivityID),
+		Name:            row.Name,
+		Modifier:        row.Modifier,
+		LanguageCode:    postgres.NewStringFromNullString(row.LanguageCode),
+		DeprecatedAt:    postgres.NewTimeFromNullTime(row.DeprecatedAt),
+		MediaLengthUnit: mediaLengthUnitFromNullString(row.MediaLengthUnit),
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) UpdateContest(ctx context.Context, req *domain.ContestUpdateRequest) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not start transaction: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	_, err = qtx.UpdateContest(ctx, postgres.UpdateContestParams{
+		ID:                      req.ID,
+		Private:                 req.Private,
+		ContestStart:            req.ContestStart,
+		ContestEnd:              req.ContestEnd,
+		RegistrationEnd:         req.RegistrationEnd,
+		Title:                   req.Title,
+		Description:             postgres.NewNullString(req.Description),
+		LanguageCodeAllowList:   req.LanguageCodeAllowList,
+		ActivityTypeIDAllowList: req.ActivityTypeIDAllowList,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("could not update contest: %w", err)
+	}
+
+	if req.RestrictsLanguages() {
+		if err := restrictContestLanguages(ctx, qtx, req.ID, req.LanguageCodeAllowList); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if moderatorUserID := req.ModeratorUserID(); moderatorUserID != nil {
+		if err := insertModerationAuditLog(ctx, qtx, &domain.ModerationAuditLogCreateRequest{
+			ModeratorUserID: *moderatorUserID,
+			Action:          "update_contest",
+			Metadata: map[string]any{
+				"contest_id":     req.ID.String(),
+				"changed_fields": req.ChangedFields(),
+			},
+			Description: req.Reason,
+		}); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not update contest: %w", err)
+	}
+
+	return nil
+}
+
+// restrictContestLanguages drops languages that are no longer allowed from
+// registrations and detaches the logs in those languages from the contest.
+func restrictContestLanguages(ctx context.Context, qtx *postgres.Queries, contestID uuid.UUID, languageCodes []string) error {
+	if err := qtx.RestrictContestRegistrationLanguages(ctx, postgres.RestrictContestRegistrationLanguagesParams{
+		ContestID:     contestID,
+		LanguageCodes: languageCodes,
+	}); err != nil {
+		return fmt.Errorf("could not restrict registration languages: %w", err)
+	}
+
+	userIDs, err := qtx.DetachContestLogsOutsideLanguages(ctx, postgres.DetachContestLogsOutsideLanguagesParams{
+		ContestID:     contestID,
+		LanguageCodes: languageCodes,
+	})
+	if err != nil {
+		return fmt.Errorf("could not detach contest logs for languages: %w", err)
+	}
+
+	// Official rounds cannot limit languages, so only the contest scores change
+	for _, userID := range userIDs {
+		if err := insertLeaderboardOutboxEvents(ctx, qtx, LeaderboardOutboxParams{
+			UserID:     userID,
+			ContestIDs: []uuid.UUID{contestID},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
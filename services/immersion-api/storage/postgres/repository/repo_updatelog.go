@@ -36,8 +36,10 @@ func (r *Repository) UpdateLog(ctx context.Context, req *domain.LogUpdateRequest
 		ScoreRuleSetID:  scoreRuleSetID(tracking.ScoreProvenance),
 		ScoreRuleIds:    scoreRuleIDs(tracking.ScoreProvenance),
 		ScoreRates:      scoreRates(tracking.ScoreProvenance),
+		ScoreRuleValues: scoreRuleValues(tracking.ScoreProvenance),
 		ScoreSource:     scoreSource(tracking.ScoreProvenance),
 		Description:     postgres.NewNullString(req.Description),
+		MediaID:         postgres.NewNullUUIDFromPtr(req.MediaID),
 		Now:             req.Now(),
 	}); err != nil {
 		_ = tx.Rollback()
@@ -56,6 +58,7 @@ func (r *Repository) UpdateLog(ctx context.Context, req *domain.LogUpdateRequest
 			ScoreRuleSetID:  scoreRuleSetID(nil),
 			ScoreRuleIds:    scoreRuleIDs(nil),
 			ScoreRates:      scoreRates(nil),
+			ScoreRuleValues: scoreRuleValues(nil),
 			ScoreSource:     scoreSource(nil),
 			Now:             req.Now(),
 		}); err != nil {
@@ -76,6 +79,7 @@ func (r *Repository) UpdateLog(ctx context.Context, req *domain.LogUpdateRequest
 				ScoreRuleSetID:  scoreRuleSetID(contestTracking.Tracking.ScoreProvenance),
 				ScoreRuleIds:    scoreRuleIDs(contestTracking.Tracking.ScoreProvenance),
 				ScoreRates:      scoreRates(contestTracking.Tracking.ScoreProvenance),
+				ScoreRuleValues: scoreRuleValues(contestTracking.Tracking.ScoreProvenance),
 				ScoreSource:     scoreSource(contestTracking.Tracking.ScoreProvenance),
 				Now:             req.Now(),
 			}); err != nil {
@@ -116,6 +120,7 @@ func (r *Repository) UpdateLog(ctx context.Context, req *domain.LogUpdateRequest
 		ContestIDs:      ongoingContestIDs,
 		OfficialContest: logCtx.EligibleOfficialLeaderboard,
 		Year:            logCtx.Year,
+		GoalProgress:    true,
 	}); err != nil {
 		_ = tx.Rollback()
 		return err
@@ -46,6 +46,7 @@ func (r *Repository) UpdateLogContests(ctx context.Context, req *domain.LogConte
 			ScoreRuleSetID:  scoreRuleSetID(tracking.ScoreProvenance),
 			ScoreRuleIds:    scoreRuleIDs(tracking.ScoreProvenance),
 			ScoreRates:      scoreRates(tracking.ScoreProvenance),
+			ScoreRuleValues: scoreRuleValues(tracking.ScoreProvenance),
 			ScoreSource:     scoreSource(tracking.ScoreProvenance),
 		}); err != nil {
 			_ = tx.Rollback()
@@ -16,6 +16,13 @@ func (r *Repository) UpsertContestRegistration(ctx context.Context, req *domain.
 	}
 	qtx := r.q.WithTx(tx)
 
+	if redeem := req.InviteRedeem(); redeem != nil {
+		if err := redeemContestInviteCode(ctx, qtx, redeem); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
 	_, err = qtx.UpsertContestRegistration(ctx, postgres.UpsertContestRegistrationParams{
 		ID:            req.ID(),
 		ContestID:     req.ContestID,
@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) CreateUserErasure(ctx context.Context, userID uuid.UUID, requestedBy uuid.UUID, now time.Time) (*domain.UserErasure, error) {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create user erasure: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	erasureID, err := qtx.CreateUserErasure(ctx, postgres.CreateUserErasureParams{
+		UserID:            userID,
+		RequestedByUserID: requestedBy,
+		Now:               now,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// Already in flight, the pending erasure is returned below.
+	case err != nil:
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not create user erasure: %w", err)
+	default:
+		err = appendUserErasureAuditEntry(ctx, qtx, erasureID, domain.UserErasureAuditEventRequested, map[string]any{
+			"self_service": userID == requestedBy,
+		}, now)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	row, err := qtx.FindUserErasureByUserID(ctx, userID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("could not fetch user erasure: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not create user erasure: %w", err)
+	}
+
+	return userErasureFromRow(row), nil
+}
+
+func (r *Repository) ListPendingUserErasures(ctx context.Context, limit int32) ([]domain.UserErasure, error) {
+	rows, err := r.q.ListPendingUserErasures(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not list pending user erasures: %w", err)
+	}
+
+	erasures := make([]domain.UserErasure, len(rows))
+	for i, row := range rows {
+		erasures[i] = *userErasureFromRow(row)
+	}
+	return erasures, nil
+}
+
+// EraseUserData removes everything immersion-api stores about the user in a
+// single transaction. Contests owned by the user are kept for the other
+// participants but no longer reference the user, their contest templates are
+// kept for the series history but stop creating contests. Invitations and
+// co-organizer roles of the user are removed later by the relationships step
+// together with their Keto tuples.
+func (r *Repository) EraseUserData(ctx context.Context, erasure *domain.UserErasure, now time.Time) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not erase user data: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	userID := erasure.UserID
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"contest logs", func() error { return qtx.EraseUserContestLogs(ctx, userID) }},
+		{"log tags", func() error { return qtx.EraseUserLogTags(ctx, userID) }},
+		{"logs", func() error { return qtx.EraseUserLogs(ctx, userID) }},
+		{"contest registrations", func() error { return qtx.EraseUserContestRegistrations(ctx, userID) }},
+		{"leaderboard outbox events", func() error { return qtx.EraseUserLeaderboardOutboxEvents(ctx, userID) }},
+		{"contest team memberships", func() error { return eraseUserContestTeamMemberships(ctx, qtx, userID) }},
+		{"contests", func() error {
+			return qtx.AnonymizeUserContests(ctx, postgres.AnonymizeUserContestsParams{
+				UserID:      userID,
+				DisplayName: domain.ErasedUserDisplayName,
+			})
+		}},
+		{"contest templates", func() error {
+			return qtx.AnonymizeUserContestTemplates(ctx, postgres.AnonymizeUserContestTemplatesParams{
+				UserID:      userID,
+				DisplayName: domain.ErasedUserDisplayName,
+			})
+		}},
+		{"moderation audit logs", func() error { return qtx.AnonymizeUserModerationAuditLogs(ctx, userID) }},
+		{"contest invite codes", func() error { return qtx.AnonymizeUserContestInviteCodes(ctx, userID) }},
+		{"contest invitations", func() error { return qtx.AnonymizeUserContestInvitations(ctx, userID) }},
+		{"contest organizers", func() error { return qtx.AnonymizeUserContestOrganizers(ctx, userID) }},
+		{"scoring rescore jobs", func() error { return qtx.AnonymizeUserScoringRescoreJobs(ctx, userID) }},
+		{"scoring rescore diffs", func() error { return qtx.EraseUserScoringRescoreJobUserDiffs(ctx, userID) }},
+		{"media", func() error { return qtx.AnonymizeUserMedia(ctx, userID) }},
+		{"goals", func() error { return qtx.DeleteGoalsForUser(ctx, userID) }},
+		{"user settings", func() error { return qtx.DeleteUserSettings(ctx, userID) }},
+		{"user roles", func() error { return qtx.EraseUserRoles(ctx, userID) }},
+		{"user", func() error { return qtx.EraseUser(ctx, userID) }},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not erase %s: %w", step.name, err)
+		}
+	}
+
+	if err = markUserErasureStepCompleted(ctx, qtx, erasure.ID, domain.UserErasureStepDatabase, now); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not erase user data: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) MarkUserErasureStepCompleted(ctx context.Context, erasureID uuid.UUID, step domain.UserErasureStep, now time.Time) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not mark user erasure step: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	if err = markUserErasureStepCompleted(ctx, qtx, erasureID, step, now); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not mark user erasure step: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) RecordUserErasureFailure(ctx context.Context, erasureID uuid.UUID, step domain.UserErasureStep, reason string, now time.Time) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not record user erasure failure: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	err = qtx.RecordUserErasureFailure(ctx, postgres.RecordUserErasureFailureParams{
+		ID:        erasureID,
+		LastError: postgres.NewNullString(&reason),
+		Now:       now,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not record user erasure failure: %w", err)
+	}
+
+	// The reason is kept out of the audit trail as error messages from other
+	// services may contain the user's ID.
+	err = appendUserErasureAuditEntry(ctx, qtx, erasureID, domain.UserErasureAuditEventStepFailed, map[string]any{
+		"step": string(step),
+	}, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not record user erasure failure: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) CompleteUserErasure(ctx context.Context, erasureID uuid.UUID, now time.Time) error {
+	tx, err := r.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not complete user erasure: %w", err)
+	}
+	qtx := r.q.WithTx(tx)
+
+	if err = qtx.CompleteUserErasure(ctx, postgres.CompleteUserErasureParams{ID: erasureID, Now: now}); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("could not complete user erasure: %w", err)
+	}
+	if err = appendUserErasureAuditEntry(ctx, qtx, erasureID, domain.UserErasureAuditEventCompleted, nil, now); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not complete user erasure: %w", err)
+	}
+	return nil
+}
+
+func markUserErasureStepCompleted(ctx context.Context, qtx *postgres.Queries, erasureID uuid.UUID, step domain.UserErasureStep, now time.Time) error {
+	var err error
+	switch step {
+	case domain.UserErasureStepDatabase:
+		err = qtx.MarkUserErasureDatabaseErased(ctx, postgres.MarkUserErasureDatabaseErasedParams{ID: erasureID, Now: now})
+	case domain.UserErasureStepLeaderboards:
+		err = qtx.MarkUserErasureLeaderboardsErased(ctx, postgres.MarkUserErasureLeaderboardsErasedParams{ID: erasureID, Now: now})
+	case domain.UserErasureStepRelationships:
+		err = qtx.MarkUserErasureRelationshipsErased(ctx, postgres.MarkUserErasureRelationshipsErasedParams{ID: erasureID, Now: now})
+	default:
+		return fmt.Errorf("unknown user erasure step %q", step)
+	}
+	if err != nil {
+		return fmt.Errorf("could not mark user erasure step %s: %w", step, err)
+	}
+
+	return appendUserErasureAuditEntry(ctx, qtx, erasureID, domain.UserErasureAuditEventStepCompleted, map[string]any{
+		"step": string(step),
+	}, now)
+}
+
+// appendUserErasureAuditEntry chains a new entry onto the audit trail. An
+// advisory lock serializes writers so two entries can never share a parent.
+func appendUserErasureAuditEntry(
+	ctx context.Context,
+	qtx *postgres.Queries,
+	erasureID uuid.UUID,
+	event string,
+	metadata map[string]any,
+	now time.Time,
+) error {
+	if err := qtx.LockUserErasureAuditLog(ctx); err != nil {
+		return fmt.Errorf("could not lock user erasure audit log: %w", err)
+	}
+
+	previousHash, err := qtx.FindLatestUserErasureAuditHash(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("could not fetch latest user erasure audit hash: %w", err)
+	}
+
+	entry, err := domain.NewUserErasureAuditEntry(previousHash, erasureID, event, metadata, now)
+	if err != nil {
+		return err
+	}
+	metadataJSON, err := json.Marshal(entry.Metadata)
+	if err != nil {
+		return fmt.Errorf("could not marshal metadata: %w", err)
+	}
+
+	err = qtx.InsertUserErasureAuditLog(ctx, postgres.InsertUserErasureAuditLogParams{
+		ErasureID:    entry.ErasureID,
+		Event:        entry.Event,
+		Metadata:     metadataJSON,
+		CreatedAt:    entry.CreatedAt,
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	})
+	if err != nil {
+		return fmt.Errorf("could not insert user erasure audit log: %w", err)
+	}
+	return nil
+}
+
+func userErasureFromRow(row postgres.UserErasure) *domain.UserErasure {
+	return &domain.UserErasure{
+		ID:                    row.ID,
+		UserID:                row.UserID,
+		RequestedByUserID:     row.RequestedByUserID,
+		Status:                domain.UserErasureStatus(row.Status),
+		Attempts:              int(row.Attempts),
+		LastError:             postgres.NewStringFromNullString(row.LastError),
+		DatabaseErasedAt:      postgres.NewTimeFromNullTime(row.DatabaseErasedAt),
+		LeaderboardsErasedAt:  postgres.NewTimeFromNullTime(row.LeaderboardsErasedAt),
+		RelationshipsErasedAt: postgres.NewTimeFromNullTime(row.RelationshipsErasedAt),
+		CreatedAt:             row.CreatedAt,
+		UpdatedAt:             row.UpdatedAt,
+		CompletedAt:           postgres.NewTimeFromNullTime(row.CompletedAt),
+	}
+}
+
+// eraseUserContestTeamMemberships removes the user from all teams. The team
+// leaderboards are refreshed without referencing the erased user, so the
+// outbox does not hold on to their ID.
+func eraseUserContestTeamMemberships(ctx context.Context, qtx *postgres.Queries, userID uuid.UUID) error {
+	memberships, err := qtx.EraseUserContestTeamMemberships(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range memberships {
+		if err := qtx.DeleteContestTeamIfEmpty(ctx, m.TeamID); err != nil {
+			return err
+		}
+		if err := insertContestTeamOutboxEvent(ctx, qtx, m.ContestID, uuid.Nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	"github.com/tadoku/tadoku/services/immersion-api/storage/postgres"
+)
+
+func (r *Repository) FindUserSettings(ctx context.Context, userID uuid.UUID) (*domain.UserSettings, error) {
+	row, err := r.q.FindUserSettings(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not fetch user settings: %w", err)
+	}
+
+	return &domain.UserSettings{
+		UserID:          row.UserID,
+		Timezone:        row.Timezone,
+		StreakGraceDays: int(row.StreakGraceDays),
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}, nil
+}
+
+func (r *Repository) UpsertUserSettings(ctx context.Context, settings *domain.UserSettings, now time.Time) error {
+	err := r.q.UpsertUserSettings(ctx, postgres.UpsertUserSettingsParams{
+		UserID:          settings.UserID,
+		Timezone:        settings.Timezone,
+		StreakGraceDays: int16(settings.StreakGraceDays),
+		Now:             now,
+	})
+	if err != nil {
+		return fmt.Errorf("could not upsert user settings: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ActivityDaysForUser(ctx context.Context, userID uuid.UUID, timezone string) ([]domain.ActivityDay, error) {
+	rows, err := r.q.ActivityDaysForUser(ctx, postgres.ActivityDaysForUserParams{
+		UserID:   userID,
+		Timezone: timezone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch activity days: %w", err)
+	}
+
+	days := make([]domain.ActivityDay, len(rows))
+	for i, row := range rows {
+		days[i] = domain.ActivityDay{
+			LanguageCode: row.LanguageCode,
+			LanguageName: row.LanguageName,
+			Date:         time.Date(row.Date.Year(), row.Date.Month(), row.Date.Day(), 0, 0, 0, 0, time.UTC),
+		}
+	}
+	return days, nil
+}
@@ -12,8 +12,10 @@ import (
 
 func (r *Repository) YearlyActivityForUser(ctx context.Context, req *domain.ProfileYearlyActivityRequest) ([]domain.UserActivityScore, error) {
 	rows, err := r.q.YearlyActivityForUser(ctx, postgres.YearlyActivityForUserParams{
-		UserID: req.UserID,
-		Year:   int16(req.Year),
+		UserID:   req.UserID,
+		Timezone: req.Timezone(),
+		From:     req.From(),
+		To:       req.To(),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
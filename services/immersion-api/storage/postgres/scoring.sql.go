@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const activateContestScoringRuleSet = `-- name: ActivateContestScoringRuleSet :exec
@@ -50,6 +51,51 @@ func (q *Queries) ActivatePlatformScoringRuleSet(ctx context.Context, ruleSetID
 	return err
 }
 
+const archiveScoringRuleSet = `-- name: ArchiveScoringRuleSet :one
+update scoring_rule_sets
+set
+  status = 'archived',
+  archived_at = $1
+where scoring_rule_sets.id = $2
+  and scoring_rule_sets.status = 'published'
+  and not exists (
+    select 1
+    from platform_scoring_config
+    where platform_scoring_config.active_rule_set_id = scoring_rule_sets.id
+  )
+  and not exists (
+    select 1
+    from contests
+    where contests.scoring_rule_set_id = scoring_rule_sets.id
+      and contests.deleted_at is null
+  )
+returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
+`
+
+type ArchiveScoringRuleSetParams struct {
+	ArchivedAt sql.NullTime
+	ID         uuid.UUID
+}
+
+// Active rule sets keep scoring new logs and cannot be archived.
+func (q *Queries) ArchiveScoringRuleSet(ctx context.Context, arg ArchiveScoringRuleSetParams) (ScoringRuleSet, error) {
+	row := q.db.QueryRowContext(ctx, archiveScoringRuleSet, arg.ArchivedAt, arg.ID)
+	var i ScoringRuleSet
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.Version,
+		&i.Status,
+		&i.Mode,
+		&i.FallbackRuleSetID,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const createScoringRule = `-- name: CreateScoringRule :exec
 insert into scoring_rules (
   id,
@@ -60,8 +106,17 @@ insert into scoring_rules (
   unit_key,
   language_code,
   tag,
+  tags,
+  tag_match,
+  min_value,
+  max_value,
+  effective_from,
+  effective_until,
   score_source,
-  rate
+  rate,
+  cap_period,
+  cap_value,
+  cap_score
 ) values (
   $1,
   $2,
@@ -72,21 +127,39 @@ insert into scoring_rules (
   $7,
   $8,
   $9,
-  $10
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17,
+  $18,
+  $19
 )
 `
 
 type CreateScoringRuleParams struct {
-	ID           uuid.UUID
-	RuleSetID    uuid.UUID
-	Priority     int32
-	Stackable    bool
-	ActivityID   int16
-	UnitKey      sql.NullString
-	LanguageCode sql.NullString
-	Tag          sql.NullString
-	ScoreSource  string
-	Rate         float32
+	ID             uuid.UUID
+	RuleSetID      uuid.UUID
+	Priority       int32
+	Stackable      bool
+	ActivityID     int16
+	UnitKey        sql.NullString
+	LanguageCode   sql.NullString
+	Tag            sql.NullString
+	Tags           []string
+	TagMatch       sql.NullString
+	MinValue       sql.NullFloat64
+	MaxValue       sql.NullFloat64
+	EffectiveFrom  sql.NullTime
+	EffectiveUntil sql.NullTime
+	ScoreSource    string
+	Rate           float32
+	CapPeriod      sql.NullString
+	CapValue       sql.NullFloat64
+	CapScore       sql.NullFloat64
 }
 
 func (q *Queries) CreateScoringRule(ctx context.Context, arg CreateScoringRuleParams) error {
@@ -99,8 +172,17 @@ func (q *Queries) CreateScoringRule(ctx context.Context, arg CreateScoringRulePa
 		arg.UnitKey,
 		arg.LanguageCode,
 		arg.Tag,
+		pq.Array(arg.Tags),
+		arg.TagMatch,
+		arg.MinValue,
+		arg.MaxValue,
+		arg.EffectiveFrom,
+		arg.EffectiveUntil,
 		arg.ScoreSource,
 		arg.Rate,
+		arg.CapPeriod,
+		arg.CapValue,
+		arg.CapScore,
 	)
 	return err
 }
@@ -123,7 +205,7 @@ insert into scoring_rule_sets (
   $5,
   $6
 )
-returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at
+returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
 `
 
 type CreateScoringRuleSetParams struct {
@@ -155,12 +237,37 @@ func (q *Queries) CreateScoringRuleSet(ctx context.Context, arg CreateScoringRul
 		&i.FallbackRuleSetID,
 		&i.CreatedAt,
 		&i.PublishedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
+const deleteScoringRuleSetDraft = `-- name: DeleteScoringRuleSetDraft :execrows
+delete from scoring_rule_sets
+where id = $1
+  and status = 'draft'
+`
+
+func (q *Queries) DeleteScoringRuleSetDraft(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteScoringRuleSetDraft, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteScoringRulesForRuleSet = `-- name: DeleteScoringRulesForRuleSet :exec
+delete from scoring_rules
+where rule_set_id = $1
+`
+
+func (q *Queries) DeleteScoringRulesForRuleSet(ctx context.Context, ruleSetID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteScoringRulesForRuleSet, ruleSetID)
+	return err
+}
+
 const findActivePlatformScoringRuleSet = `-- name: FindActivePlatformScoringRuleSet :one
-select scoring_rule_sets.id, scoring_rule_sets.scope, scoring_rule_sets.contest_id, scoring_rule_sets.version, scoring_rule_sets.status, scoring_rule_sets.mode, scoring_rule_sets.fallback_rule_set_id, scoring_rule_sets.created_at, scoring_rule_sets.published_at
+select scoring_rule_sets.id, scoring_rule_sets.scope, scoring_rule_sets.contest_id, scoring_rule_sets.version, scoring_rule_sets.status, scoring_rule_sets.mode, scoring_rule_sets.fallback_rule_set_id, scoring_rule_sets.created_at, scoring_rule_sets.published_at, scoring_rule_sets.archived_at
 from platform_scoring_config
 inner join scoring_rule_sets
   on scoring_rule_sets.id = platform_scoring_config.active_rule_set_id
@@ -180,6 +287,7 @@ func (q *Queries) FindActivePlatformScoringRuleSet(ctx context.Context) (Scoring
 		&i.FallbackRuleSetID,
 		&i.CreatedAt,
 		&i.PublishedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -199,7 +307,7 @@ func (q *Queries) FindContestScoringRuleSetID(ctx context.Context, contestID uui
 }
 
 const findScoringRuleSetByID = `-- name: FindScoringRuleSetByID :one
-select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at
+select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
 from scoring_rule_sets
 where id = $1
 `
@@ -217,15 +325,17 @@ func (q *Queries) FindScoringRuleSetByID(ctx context.Context, id uuid.UUID) (Sco
 		&i.FallbackRuleSetID,
 		&i.CreatedAt,
 		&i.PublishedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const listContestScoringRuleSets = `-- name: ListContestScoringRuleSets :many
-select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at
+select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
 from scoring_rule_sets
 where scope = 'contest'
   and contest_id = $1
+  and status <> 'archived'
 order by version desc
 `
 
@@ -248,6 +358,126 @@ func (q *Queries) ListContestScoringRuleSets(ctx context.Context, contestID uuid
 			&i.FallbackRuleSetID,
 			&i.CreatedAt,
 			&i.PublishedAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLogsForScoringImpact = `-- name: ListLogsForScoringImpact :many
+select
+  logs.id,
+  logs.user_id,
+  users.display_name as user_display_name,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(logs.unit_key, '') as unit_key,
+  logs.amount,
+  logs.modifier,
+  logs.duration_seconds,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone
+from logs
+inner join users on (users.id = logs.user_id)
+where
+  logs.deleted_at is null
+  and (
+    $1::uuid is null
+    or exists (
+      select 1
+      from contest_logs
+      where
+        contest_logs.log_id = logs.id
+        and contest_logs.contest_id = $1::uuid
+    )
+  )
+  and (
+    $2::uuid is null
+    or exists (
+      select 1
+      from contest_registrations
+      where
+        contest_registrations.user_id = logs.user_id
+        and contest_registrations.contest_id = $2::uuid
+        and contest_registrations.deleted_at is null
+    )
+  )
+  and (cardinality($3::varchar[]) = 0 or logs.language_code = any($3::varchar[]))
+  and (cardinality($4::integer[]) = 0 or logs.log_activity_id = any($4::integer[]))
+order by logs.created_at desc
+limit $5
+`
+
+type ListLogsForScoringImpactParams struct {
+	ContestID           uuid.NullUUID
+	RegisteredContestID uuid.NullUUID
+	LanguageCodes       []string
+	ActivityIds         []int32
+	SampleSize          int32
+}
+
+type ListLogsForScoringImpactRow struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	UserDisplayName string
+	LanguageCode    string
+	ActivityID      int16
+	UnitID          uuid.NullUUID
+	UnitKey         string
+	Amount          sql.NullFloat64
+	Modifier        sql.NullFloat64
+	DurationSeconds sql.NullInt32
+	CreatedAt       time.Time
+	Tags            []string
+	Timezone        string
+}
+
+func (q *Queries) ListLogsForScoringImpact(ctx context.Context, arg ListLogsForScoringImpactParams) ([]ListLogsForScoringImpactRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLogsForScoringImpact,
+		arg.ContestID,
+		arg.RegisteredContestID,
+		pq.Array(arg.LanguageCodes),
+		pq.Array(arg.ActivityIds),
+		arg.SampleSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLogsForScoringImpactRow
+	for rows.Next() {
+		var i ListLogsForScoringImpactRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserDisplayName,
+			&i.LanguageCode,
+			&i.ActivityID,
+			&i.UnitID,
+			&i.UnitKey,
+			&i.Amount,
+			&i.Modifier,
+			&i.DurationSeconds,
+			&i.CreatedAt,
+			pq.Array(&i.Tags),
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -263,9 +493,10 @@ func (q *Queries) ListContestScoringRuleSets(ctx context.Context, contestID uuid
 }
 
 const listPlatformScoringRuleSets = `-- name: ListPlatformScoringRuleSets :many
-select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at
+select id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
 from scoring_rule_sets
 where scope = 'platform'
+  and status <> 'archived'
 order by version desc
 `
 
@@ -288,6 +519,7 @@ func (q *Queries) ListPlatformScoringRuleSets(ctx context.Context) ([]ScoringRul
 			&i.FallbackRuleSetID,
 			&i.CreatedAt,
 			&i.PublishedAt,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -303,7 +535,7 @@ func (q *Queries) ListPlatformScoringRuleSets(ctx context.Context) ([]ScoringRul
 }
 
 const listScoringRulesForRuleSet = `-- name: ListScoringRulesForRuleSet :many
-select id, rule_set_id, priority, stackable, activity_id, unit_key, language_code, tag, score_source, rate
+select id, rule_set_id, priority, stackable, activity_id, unit_key, language_code, tag, score_source, rate, tags, tag_match, min_value, max_value, effective_from, effective_until, cap_period, cap_value, cap_score
 from scoring_rules
 where rule_set_id = $1
 order by priority asc
@@ -329,6 +561,15 @@ func (q *Queries) ListScoringRulesForRuleSet(ctx context.Context, ruleSetID uuid
 			&i.Tag,
 			&i.ScoreSource,
 			&i.Rate,
+			pq.Array(&i.Tags),
+			&i.TagMatch,
+			&i.MinValue,
+			&i.MaxValue,
+			&i.EffectiveFrom,
+			&i.EffectiveUntil,
+			&i.CapPeriod,
+			&i.CapValue,
+			&i.CapScore,
 		); err != nil {
 			return nil, err
 		}
@@ -377,7 +618,7 @@ set
   published_at = $1
 where id = $2
   and status = 'draft'
-returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at
+returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
 `
 
 type PublishScoringRuleSetParams struct {
@@ -398,6 +639,129 @@ func (q *Queries) PublishScoringRuleSet(ctx context.Context, arg PublishScoringR
 		&i.FallbackRuleSetID,
 		&i.CreatedAt,
 		&i.PublishedAt,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const sumContestScoringRuleUsage = `-- name: SumContestScoringRuleUsage :one
+select
+  coalesce(sum(
+    contest_logs.score_rule_values[array_position(contest_logs.score_rule_ids, $1::uuid)]
+  ), 0)::real as value,
+  coalesce(sum(contest_logs.computed_score), 0)::real as score
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = $2
+  and logs.user_id = $3
+  and logs.deleted_at is null
+  and logs.created_at >= $4
+  and logs.created_at < $5
+  and $1::uuid = any(contest_logs.score_rule_ids)
+  and ($6::uuid is null or logs.id <> $6::uuid)
+`
+
+type SumContestScoringRuleUsageParams struct {
+	RuleID       uuid.UUID
+	ContestID    uuid.UUID
+	UserID       uuid.UUID
+	From         time.Time
+	Until        time.Time
+	ExcludeLogID uuid.NullUUID
+}
+
+type SumContestScoringRuleUsageRow struct {
+	Value float32
+	Score float32
+}
+
+func (q *Queries) SumContestScoringRuleUsage(ctx context.Context, arg SumContestScoringRuleUsageParams) (SumContestScoringRuleUsageRow, error) {
+	row := q.db.QueryRowContext(ctx, sumContestScoringRuleUsage,
+		arg.RuleID,
+		arg.ContestID,
+		arg.UserID,
+		arg.From,
+		arg.Until,
+		arg.ExcludeLogID,
+	)
+	var i SumContestScoringRuleUsageRow
+	err := row.Scan(&i.Value, &i.Score)
+	return i, err
+}
+
+const sumPlatformScoringRuleUsage = `-- name: SumPlatformScoringRuleUsage :one
+select
+  coalesce(sum(
+    logs.score_rule_values[array_position(logs.score_rule_ids, $1::uuid)]
+  ), 0)::real as value,
+  coalesce(sum(logs.computed_score), 0)::real as score
+from logs
+where
+  logs.user_id = $2
+  and logs.deleted_at is null
+  and logs.created_at >= $3
+  and logs.created_at < $4
+  and $1::uuid = any(logs.score_rule_ids)
+  and ($5::uuid is null or logs.id <> $5::uuid)
+`
+
+type SumPlatformScoringRuleUsageParams struct {
+	RuleID       uuid.UUID
+	UserID       uuid.UUID
+	From         time.Time
+	Until        time.Time
+	ExcludeLogID uuid.NullUUID
+}
+
+type SumPlatformScoringRuleUsageRow struct {
+	Value float32
+	Score float32
+}
+
+func (q *Queries) SumPlatformScoringRuleUsage(ctx context.Context, arg SumPlatformScoringRuleUsageParams) (SumPlatformScoringRuleUsageRow, error) {
+	row := q.db.QueryRowContext(ctx, sumPlatformScoringRuleUsage,
+		arg.RuleID,
+		arg.UserID,
+		arg.From,
+		arg.Until,
+		arg.ExcludeLogID,
+	)
+	var i SumPlatformScoringRuleUsageRow
+	err := row.Scan(&i.Value, &i.Score)
+	return i, err
+}
+
+const updateScoringRuleSetDraft = `-- name: UpdateScoringRuleSetDraft :one
+update scoring_rule_sets
+set
+  mode = $1,
+  fallback_rule_set_id = $2
+where id = $3
+  and status = 'draft'
+returning id, scope, contest_id, version, status, mode, fallback_rule_set_id, created_at, published_at, archived_at
+`
+
+type UpdateScoringRuleSetDraftParams struct {
+	Mode              sql.NullString
+	FallbackRuleSetID uuid.NullUUID
+	ID                uuid.UUID
+}
+
+func (q *Queries) UpdateScoringRuleSetDraft(ctx context.Context, arg UpdateScoringRuleSetDraftParams) (ScoringRuleSet, error) {
+	row := q.db.QueryRowContext(ctx, updateScoringRuleSetDraft, arg.Mode, arg.FallbackRuleSetID, arg.ID)
+	var i ScoringRuleSet
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.Version,
+		&i.Status,
+		&i.Mode,
+		&i.FallbackRuleSetID,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
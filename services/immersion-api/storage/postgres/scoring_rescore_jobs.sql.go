@@ -0,0 +1,778 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: scoring_rescore_jobs.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const advanceScoringRescoreJob = `-- name: AdvanceScoringRescoreJob :exec
+update scoring_rescore_jobs
+set
+  "status" = $1,
+  cursor_log_id = $2,
+  cursor_logged_at = $3,
+  processed_logs = processed_logs + $4,
+  changed_logs = changed_logs + $5,
+  skipped_logs = skipped_logs + $6,
+  updated_at = $7,
+  completed_at = $8
+where id = $9
+`
+
+type AdvanceScoringRescoreJobParams struct {
+	Status         string
+	CursorLogID    uuid.NullUUID
+	CursorLoggedAt sql.NullTime
+	ProcessedLogs  int32
+	ChangedLogs    int32
+	SkippedLogs    int32
+	Now            time.Time
+	CompletedAt    sql.NullTime
+	ID             uuid.UUID
+}
+
+func (q *Queries) AdvanceScoringRescoreJob(ctx context.Context, arg AdvanceScoringRescoreJobParams) error {
+	_, err := q.db.ExecContext(ctx, advanceScoringRescoreJob,
+		arg.Status,
+		arg.CursorLogID,
+		arg.CursorLoggedAt,
+		arg.ProcessedLogs,
+		arg.ChangedLogs,
+		arg.SkippedLogs,
+		arg.Now,
+		arg.CompletedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const countLogsForContestRescore = `-- name: CountLogsForContestRescore :one
+select count(*)::integer
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = $1
+  and logs.deleted_at is null
+`
+
+func (q *Queries) CountLogsForContestRescore(ctx context.Context, contestID uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countLogsForContestRescore, contestID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countLogsForPlatformRescore = `-- name: CountLogsForPlatformRescore :one
+select count(*)::integer
+from logs
+where deleted_at is null
+`
+
+func (q *Queries) CountLogsForPlatformRescore(ctx context.Context) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countLogsForPlatformRescore)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createScoringRescoreJob = `-- name: CreateScoringRescoreJob :one
+insert into scoring_rescore_jobs (
+  scope,
+  contest_id,
+  rule_set_id,
+  dry_run,
+  total_logs,
+  requested_by_user_id,
+  created_at,
+  updated_at
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $7
+)
+returning id, scope, contest_id, rule_set_id, dry_run, status, cursor_log_id, total_logs, processed_logs, changed_logs, skipped_logs, last_error, requested_by_user_id, created_at, updated_at, completed_at, cursor_logged_at
+`
+
+type CreateScoringRescoreJobParams struct {
+	Scope             string
+	ContestID         uuid.NullUUID
+	RuleSetID         uuid.UUID
+	DryRun            bool
+	TotalLogs         int32
+	RequestedByUserID uuid.NullUUID
+	Now               time.Time
+}
+
+func (q *Queries) CreateScoringRescoreJob(ctx context.Context, arg CreateScoringRescoreJobParams) (ScoringRescoreJob, error) {
+	row := q.db.QueryRowContext(ctx, createScoringRescoreJob,
+		arg.Scope,
+		arg.ContestID,
+		arg.RuleSetID,
+		arg.DryRun,
+		arg.TotalLogs,
+		arg.RequestedByUserID,
+		arg.Now,
+	)
+	var i ScoringRescoreJob
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.RuleSetID,
+		&i.DryRun,
+		&i.Status,
+		&i.CursorLogID,
+		&i.TotalLogs,
+		&i.ProcessedLogs,
+		&i.ChangedLogs,
+		&i.SkippedLogs,
+		&i.LastError,
+		&i.RequestedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.CursorLoggedAt,
+	)
+	return i, err
+}
+
+const failScoringRescoreJob = `-- name: FailScoringRescoreJob :exec
+update scoring_rescore_jobs
+set
+  "status" = 'failed',
+  last_error = $1,
+  updated_at = $2
+where
+  id = $3
+  and "status" in ('pending', 'running')
+`
+
+type FailScoringRescoreJobParams struct {
+	LastError sql.NullString
+	Now       time.Time
+	ID        uuid.UUID
+}
+
+func (q *Queries) FailScoringRescoreJob(ctx context.Context, arg FailScoringRescoreJobParams) error {
+	_, err := q.db.ExecContext(ctx, failScoringRescoreJob, arg.LastError, arg.Now, arg.ID)
+	return err
+}
+
+const findNextScoringRescoreJob = `-- name: FindNextScoringRescoreJob :one
+select id, scope, contest_id, rule_set_id, dry_run, status, cursor_log_id, total_logs, processed_logs, changed_logs, skipped_logs, last_error, requested_by_user_id, created_at, updated_at, completed_at, cursor_logged_at
+from scoring_rescore_jobs
+where "status" in ('pending', 'running')
+order by created_at asc
+limit 1
+`
+
+func (q *Queries) FindNextScoringRescoreJob(ctx context.Context) (ScoringRescoreJob, error) {
+	row := q.db.QueryRowContext(ctx, findNextScoringRescoreJob)
+	var i ScoringRescoreJob
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.RuleSetID,
+		&i.DryRun,
+		&i.Status,
+		&i.CursorLogID,
+		&i.TotalLogs,
+		&i.ProcessedLogs,
+		&i.ChangedLogs,
+		&i.SkippedLogs,
+		&i.LastError,
+		&i.RequestedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.CursorLoggedAt,
+	)
+	return i, err
+}
+
+const findScoringRescoreJobByID = `-- name: FindScoringRescoreJobByID :one
+select id, scope, contest_id, rule_set_id, dry_run, status, cursor_log_id, total_logs, processed_logs, changed_logs, skipped_logs, last_error, requested_by_user_id, created_at, updated_at, completed_at, cursor_logged_at
+from scoring_rescore_jobs
+where id = $1
+`
+
+func (q *Queries) FindScoringRescoreJobByID(ctx context.Context, id uuid.UUID) (ScoringRescoreJob, error) {
+	row := q.db.QueryRowContext(ctx, findScoringRescoreJobByID, id)
+	var i ScoringRescoreJob
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.RuleSetID,
+		&i.DryRun,
+		&i.Status,
+		&i.CursorLogID,
+		&i.TotalLogs,
+		&i.ProcessedLogs,
+		&i.ChangedLogs,
+		&i.SkippedLogs,
+		&i.LastError,
+		&i.RequestedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.CursorLoggedAt,
+	)
+	return i, err
+}
+
+const listLogsForContestRescore = `-- name: ListLogsForContestRescore :many
+select
+  logs.id,
+  logs.user_id,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(contest_logs.unit_key, '') as unit_key,
+  contest_logs.amount,
+  contest_logs.modifier,
+  contest_logs.duration_seconds,
+  coalesce(contest_logs.computed_score, contest_logs.score) as score,
+  contest_logs.score_rule_set_id,
+  contest_logs.score_rule_ids,
+  contest_logs.score_rates,
+  contest_logs.score_rule_values,
+  contest_logs.score_source,
+  logs.eligible_official_leaderboard,
+  logs.year,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone
+from contest_logs
+inner join logs on (logs.id = contest_logs.log_id)
+where
+  contest_logs.contest_id = $1
+  and logs.deleted_at is null
+  and (
+    $2::uuid is null
+    or (logs.created_at, logs.id) > ($3::timestamp, $2::uuid)
+  )
+order by logs.created_at asc, logs.id asc
+limit $4
+`
+
+type ListLogsForContestRescoreParams struct {
+	ContestID     uuid.UUID
+	AfterLogID    uuid.NullUUID
+	AfterLoggedAt sql.NullTime
+	BatchSize     int32
+}
+
+type ListLogsForContestRescoreRow struct {
+	ID                          uuid.UUID
+	UserID                      uuid.UUID
+	LanguageCode                string
+	ActivityID                  int16
+	UnitID                      uuid.NullUUID
+	UnitKey                     string
+	Amount                      sql.NullFloat64
+	Modifier                    sql.NullFloat64
+	DurationSeconds             sql.NullInt32
+	Score                       sql.NullFloat64
+	ScoreRuleSetID              uuid.NullUUID
+	ScoreRuleIds                []uuid.UUID
+	ScoreRates                  []float32
+	ScoreRuleValues             []float32
+	ScoreSource                 sql.NullString
+	EligibleOfficialLeaderboard bool
+	Year                        int16
+	CreatedAt                   time.Time
+	Tags                        []string
+	Timezone                    string
+}
+
+func (q *Queries) ListLogsForContestRescore(ctx context.Context, arg ListLogsForContestRescoreParams) ([]ListLogsForContestRescoreRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLogsForContestRescore,
+		arg.ContestID,
+		arg.AfterLogID,
+		arg.AfterLoggedAt,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLogsForContestRescoreRow
+	for rows.Next() {
+		var i ListLogsForContestRescoreRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LanguageCode,
+			&i.ActivityID,
+			&i.UnitID,
+			&i.UnitKey,
+			&i.Amount,
+			&i.Modifier,
+			&i.DurationSeconds,
+			&i.Score,
+			&i.ScoreRuleSetID,
+			pq.Array(&i.ScoreRuleIds),
+			pq.Array(&i.ScoreRates),
+			pq.Array(&i.ScoreRuleValues),
+			&i.ScoreSource,
+			&i.EligibleOfficialLeaderboard,
+			&i.Year,
+			&i.CreatedAt,
+			pq.Array(&i.Tags),
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLogsForPlatformRescore = `-- name: ListLogsForPlatformRescore :many
+select
+  logs.id,
+  logs.user_id,
+  logs.language_code,
+  logs.log_activity_id as activity_id,
+  logs.unit_id,
+  coalesce(logs.unit_key, '') as unit_key,
+  logs.amount,
+  logs.modifier,
+  logs.duration_seconds,
+  coalesce(logs.computed_score, logs.score) as score,
+  logs.score_rule_set_id,
+  logs.score_rule_ids,
+  logs.score_rates,
+  logs.score_rule_values,
+  logs.score_source,
+  logs.eligible_official_leaderboard,
+  logs.year,
+  logs.created_at,
+  coalesce(
+    (select array_agg(tag order by tag) from log_tags where log_id = logs.id),
+    array[]::text[]
+  )::text[] as tags,
+  coalesce(
+    (select timezone from user_settings where user_id = logs.user_id),
+    'UTC'
+  )::text as timezone,
+  -- ongoing contests without their own rule set copy the platform score
+  coalesce(
+    (
+      select array_agg(contest_logs.contest_id)
+      from contest_logs
+      inner join contests on (contests.id = contest_logs.contest_id)
+      where
+        contest_logs.log_id = logs.id
+        and contests.contest_end >= $1
+        and contests.scoring_rule_set_id is null
+    ),
+    array[]::uuid[]
+  )::uuid[] as contest_ids
+from logs
+where
+  logs.deleted_at is null
+  and (
+    $2::uuid is null
+    or (logs.created_at, logs.id) > ($3::timestamp, $2::uuid)
+  )
+order by logs.created_at asc, logs.id asc
+limit $4
+`
+
+type ListLogsForPlatformRescoreParams struct {
+	Now           time.Time
+	AfterLogID    uuid.NullUUID
+	AfterLoggedAt sql.NullTime
+	BatchSize     int32
+}
+
+type ListLogsForPlatformRescoreRow struct {
+	ID                          uuid.UUID
+	UserID                      uuid.UUID
+	LanguageCode                string
+	ActivityID                  int16
+	UnitID                      uuid.NullUUID
+	UnitKey                     string
+	Amount                      sql.NullFloat64
+	Modifier                    sql.NullFloat64
+	DurationSeconds             sql.NullInt32
+	Score                       sql.NullFloat64
+	ScoreRuleSetID              uuid.NullUUID
+	ScoreRuleIds                []uuid.UUID
+	ScoreRates                  []float32
+	ScoreRuleValues             []float32
+	ScoreSource                 sql.NullString
+	EligibleOfficialLeaderboard bool
+	Year                        int16
+	CreatedAt                   time.Time
+	Tags                        []string
+	Timezone                    string
+	ContestIds                  []uuid.UUID
+}
+
+func (q *Queries) ListLogsForPlatformRescore(ctx context.Context, arg ListLogsForPlatformRescoreParams) ([]ListLogsForPlatformRescoreRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLogsForPlatformRescore,
+		arg.Now,
+		arg.AfterLogID,
+		arg.AfterLoggedAt,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLogsForPlatformRescoreRow
+	for rows.Next() {
+		var i ListLogsForPlatformRescoreRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LanguageCode,
+			&i.ActivityID,
+			&i.UnitID,
+			&i.UnitKey,
+			&i.Amount,
+			&i.Modifier,
+			&i.DurationSeconds,
+			&i.Score,
+			&i.ScoreRuleSetID,
+			pq.Array(&i.ScoreRuleIds),
+			pq.Array(&i.ScoreRates),
+			pq.Array(&i.ScoreRuleValues),
+			&i.ScoreSource,
+			&i.EligibleOfficialLeaderboard,
+			&i.Year,
+			&i.CreatedAt,
+			pq.Array(&i.Tags),
+			&i.Timezone,
+			pq.Array(&i.ContestIds),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScoringRescoreJobUserDiffs = `-- name: ListScoringRescoreJobUserDiffs :many
+select
+  diffs.user_id,
+  coalesce(users.display_name, '') as user_display_name,
+  diffs.changed_logs,
+  diffs.score_before,
+  diffs.score_after,
+  count(*) over() as total_size
+from scoring_rescore_job_user_diffs as diffs
+left join users on (users.id = diffs.user_id)
+where diffs.job_id = $1
+order by abs(diffs.score_after - diffs.score_before) desc, diffs.user_id asc
+limit $3
+offset $2
+`
+
+type ListScoringRescoreJobUserDiffsParams struct {
+	JobID     uuid.UUID
+	StartFrom int32
+	PageSize  int32
+}
+
+type ListScoringRescoreJobUserDiffsRow struct {
+	UserID          uuid.UUID
+	UserDisplayName string
+	ChangedLogs     int32
+	ScoreBefore     float32
+	ScoreAfter      float32
+	TotalSize       int64
+}
+
+func (q *Queries) ListScoringRescoreJobUserDiffs(ctx context.Context, arg ListScoringRescoreJobUserDiffsParams) ([]ListScoringRescoreJobUserDiffsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listScoringRescoreJobUserDiffs, arg.JobID, arg.StartFrom, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScoringRescoreJobUserDiffsRow
+	for rows.Next() {
+		var i ListScoringRescoreJobUserDiffsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserDisplayName,
+			&i.ChangedLogs,
+			&i.ScoreBefore,
+			&i.ScoreAfter,
+			&i.TotalSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScoringRescoreJobs = `-- name: ListScoringRescoreJobs :many
+select id, scope, contest_id, rule_set_id, dry_run, status, cursor_log_id, total_logs, processed_logs, changed_logs, skipped_logs, last_error, requested_by_user_id, created_at, updated_at, completed_at, cursor_logged_at
+from scoring_rescore_jobs
+where
+  ($1::uuid is null and scope = 'platform')
+  or contest_id = $1::uuid
+order by created_at desc
+limit $2
+`
+
+type ListScoringRescoreJobsParams struct {
+	ContestID  uuid.NullUUID
+	MaxResults int32
+}
+
+func (q *Queries) ListScoringRescoreJobs(ctx context.Context, arg ListScoringRescoreJobsParams) ([]ScoringRescoreJob, error) {
+	rows, err := q.db.QueryContext(ctx, listScoringRescoreJobs, arg.ContestID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScoringRescoreJob
+	for rows.Next() {
+		var i ScoringRescoreJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scope,
+			&i.ContestID,
+			&i.RuleSetID,
+			&i.DryRun,
+			&i.Status,
+			&i.CursorLogID,
+			&i.TotalLogs,
+			&i.ProcessedLogs,
+			&i.ChangedLogs,
+			&i.SkippedLogs,
+			&i.LastError,
+			&i.RequestedByUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+			&i.CursorLoggedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockScoringRescoreJob = `-- name: LockScoringRescoreJob :one
+select id, scope, contest_id, rule_set_id, dry_run, status, cursor_log_id, total_logs, processed_logs, changed_logs, skipped_logs, last_error, requested_by_user_id, created_at, updated_at, completed_at, cursor_logged_at
+from scoring_rescore_jobs
+where id = $1
+for update
+`
+
+func (q *Queries) LockScoringRescoreJob(ctx context.Context, id uuid.UUID) (ScoringRescoreJob, error) {
+	row := q.db.QueryRowContext(ctx, lockScoringRescoreJob, id)
+	var i ScoringRescoreJob
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.ContestID,
+		&i.RuleSetID,
+		&i.DryRun,
+		&i.Status,
+		&i.CursorLogID,
+		&i.TotalLogs,
+		&i.ProcessedLogs,
+		&i.ChangedLogs,
+		&i.SkippedLogs,
+		&i.LastError,
+		&i.RequestedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.CursorLoggedAt,
+	)
+	return i, err
+}
+
+const supersedeScoringRescoreJobs = `-- name: SupersedeScoringRescoreJobs :exec
+update scoring_rescore_jobs
+set
+  "status" = 'superseded',
+  updated_at = $1
+where
+  dry_run = false
+  and "status" in ('pending', 'running')
+  and scope = $2
+  and contest_id is not distinct from $3::uuid
+`
+
+type SupersedeScoringRescoreJobsParams struct {
+	Now       time.Time
+	Scope     string
+	ContestID uuid.NullUUID
+}
+
+func (q *Queries) SupersedeScoringRescoreJobs(ctx context.Context, arg SupersedeScoringRescoreJobsParams) error {
+	_, err := q.db.ExecContext(ctx, supersedeScoringRescoreJobs, arg.Now, arg.Scope, arg.ContestID)
+	return err
+}
+
+const updateContestLogScore = `-- name: UpdateContestLogScore :exec
+update contest_logs
+set
+  computed_score = $1,
+  score_rule_set_id = $2,
+  score_rule_ids = $3,
+  score_rates = $4,
+  score_rule_values = $5,
+  score_source = $6
+where
+  contest_id = $7
+  and log_id = $8
+`
+
+type UpdateContestLogScoreParams struct {
+	ComputedScore   sql.NullFloat64
+	ScoreRuleSetID  uuid.NullUUID
+	ScoreRuleIds    []uuid.UUID
+	ScoreRates      []float32
+	ScoreRuleValues []float32
+	ScoreSource     sql.NullString
+	ContestID       uuid.UUID
+	LogID           uuid.UUID
+}
+
+func (q *Queries) UpdateContestLogScore(ctx context.Context, arg UpdateContestLogScoreParams) error {
+	_, err := q.db.ExecContext(ctx, updateContestLogScore,
+		arg.ComputedScore,
+		arg.ScoreRuleSetID,
+		pq.Array(arg.ScoreRuleIds),
+		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
+		arg.ScoreSource,
+		arg.ContestID,
+		arg.LogID,
+	)
+	return err
+}
+
+const updateLogScore = `-- name: UpdateLogScore :exec
+update logs
+set
+  computed_score = $1,
+  score_rule_set_id = $2,
+  score_rule_ids = $3,
+  score_rates = $4,
+  score_rule_values = $5,
+  score_source = $6
+where
+  id = $7
+  and deleted_at is null
+`
+
+type UpdateLogScoreParams struct {
+	ComputedScore   sql.NullFloat64
+	ScoreRuleSetID  uuid.NullUUID
+	ScoreRuleIds    []uuid.UUID
+	ScoreRates      []float32
+	ScoreRuleValues []float32
+	ScoreSource     sql.NullString
+	LogID           uuid.UUID
+}
+
+func (q *Queries) UpdateLogScore(ctx context.Context, arg UpdateLogScoreParams) error {
+	_, err := q.db.ExecContext(ctx, updateLogScore,
+		arg.ComputedScore,
+		arg.ScoreRuleSetID,
+		pq.Array(arg.ScoreRuleIds),
+		pq.Array(arg.ScoreRates),
+		pq.Array(arg.ScoreRuleValues),
+		arg.ScoreSource,
+		arg.LogID,
+	)
+	return err
+}
+
+const upsertScoringRescoreJobUserDiff = `-- name: UpsertScoringRescoreJobUserDiff :exec
+insert into scoring_rescore_job_user_diffs (
+  job_id,
+  user_id,
+  changed_logs,
+  score_before,
+  score_after
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+on conflict (job_id, user_id) do update
+set
+  changed_logs = scoring_rescore_job_user_diffs.changed_logs + excluded.changed_logs,
+  score_before = scoring_rescore_job_user_diffs.score_before + excluded.score_before,
+  score_after = scoring_rescore_job_user_diffs.score_after + excluded.score_after
+`
+
+type UpsertScoringRescoreJobUserDiffParams struct {
+	JobID       uuid.UUID
+	UserID      uuid.UUID
+	ChangedLogs int32
+	ScoreBefore float32
+	ScoreAfter  float32
+}
+
+func (q *Queries) UpsertScoringRescoreJobUserDiff(ctx context.Context, arg UpsertScoringRescoreJobUserDiffParams) error {
+	_, err := q.db.ExecContext(ctx, upsertScoringRescoreJobUserDiff,
+		arg.JobID,
+		arg.UserID,
+		arg.ChangedLogs,
+		arg.ScoreBefore,
+		arg.ScoreAfter,
+	)
+	return err
+}
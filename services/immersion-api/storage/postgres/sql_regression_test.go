@@ -20,6 +20,7 @@ func TestGeneratedLogReadQueriesUseEffectiveScoreAndTrackingFields(t *testing.T)
 		{"FetchScoresForProfile", fetchScoresForProfile, baseEffectiveScore},
 		{"YearlyActivitySplitForUser", yearlyActivitySplitForUser, baseEffectiveScore},
 		{"YearlyActivityForUser", yearlyActivityForUser, "coalesce(computed_score, score)"},
+		{"FetchGoalMetricTotals", fetchGoalMetricTotals, baseEffectiveScore},
 	}
 	for _, tt := range baseLogQueries {
 		t.Run(tt.name, func(t *testing.T) {
@@ -12,6 +12,150 @@ import (
 	"github.com/google/uuid"
 )
 
+const createUnit = `-- name: CreateUnit :one
+insert into log_units (
+  id,
+  unit_key,
+  log_activity_id,
+  name,
+  modifier,
+  language_code,
+  media_length_unit
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+returning id, unit_key, log_activity_id, name, modifier, language_code, deprecated_at, media_length_unit
+`
+
+type CreateUnitParams struct {
+	ID              uuid.UUID
+	UnitKey         string
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	MediaLengthUnit sql.NullString
+}
+
+type CreateUnitRow struct {
+	ID              uuid.UUID
+	UnitKey         string
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
+}
+
+func (q *Queries) CreateUnit(ctx context.Context, arg CreateUnitParams) (CreateUnitRow, error) {
+	row := q.db.QueryRowContext(ctx, createUnit,
+		arg.ID,
+		arg.UnitKey,
+		arg.LogActivityID,
+		arg.Name,
+		arg.Modifier,
+		arg.LanguageCode,
+		arg.MediaLengthUnit,
+	)
+	var i CreateUnitRow
+	err := row.Scan(
+		&i.ID,
+		&i.UnitKey,
+		&i.LogActivityID,
+		&i.Name,
+		&i.Modifier,
+		&i.LanguageCode,
+		&i.DeprecatedAt,
+		&i.MediaLengthUnit,
+	)
+	return i, err
+}
+
+const deleteUnusedUnit = `-- name: DeleteUnusedUnit :execrows
+delete from log_units
+where log_units.id = $1
+  and not exists (
+    select 1
+    from logs
+    where logs.unit_id = log_units.id
+  )
+`
+
+func (q *Queries) DeleteUnusedUnit(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUnusedUnit, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deprecateUnit = `-- name: DeprecateUnit :execrows
+update log_units
+set deprecated_at = coalesce(deprecated_at, $1)
+where id = $2
+`
+
+type DeprecateUnitParams struct {
+	DeprecatedAt sql.NullTime
+	ID           uuid.UUID
+}
+
+func (q *Queries) DeprecateUnit(ctx context.Context, arg DeprecateUnitParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deprecateUnit, arg.DeprecatedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findUnitByID = `-- name: FindUnitByID :one
+select
+  id,
+  unit_key,
+  log_activity_id,
+  name,
+  modifier,
+  language_code,
+  deprecated_at,
+  media_length_unit
+from log_units
+where id = $1
+`
+
+type FindUnitByIDRow struct {
+	ID              uuid.UUID
+	UnitKey         string
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
+}
+
+func (q *Queries) FindUnitByID(ctx context.Context, id uuid.UUID) (FindUnitByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, findUnitByID, id)
+	var i FindUnitByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UnitKey,
+		&i.LogActivityID,
+		&i.Name,
+		&i.Modifier,
+		&i.LanguageCode,
+		&i.DeprecatedAt,
+		&i.MediaLengthUnit,
+	)
+	return i, err
+}
+
 const findUnitForTracking = `-- name: FindUnitForTracking :one
 select
   id,
@@ -25,12 +169,14 @@ where
   id = $1
   and log_activity_id = $2
   and (language_code is null or language_code = $3)
+  and (deprecated_at is null or id = $4::uuid)
 `
 
 type FindUnitForTrackingParams struct {
 	ID            uuid.UUID
 	LogActivityID int16
 	LanguageCode  sql.NullString
+	CurrentUnitID uuid.NullUUID
 }
 
 type FindUnitForTrackingRow struct {
@@ -42,8 +188,14 @@ type FindUnitForTrackingRow struct {
 	LanguageCode  sql.NullString
 }
 
+// Deprecated units can no longer be logged, logs keep their current unit.
 func (q *Queries) FindUnitForTracking(ctx context.Context, arg FindUnitForTrackingParams) (FindUnitForTrackingRow, error) {
-	row := q.db.QueryRowContext(ctx, findUnitForTracking, arg.ID, arg.LogActivityID, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, findUnitForTracking,
+		arg.ID,
+		arg.LogActivityID,
+		arg.LanguageCode,
+		arg.CurrentUnitID,
+	)
 	var i FindUnitForTrackingRow
 	err := row.Scan(
 		&i.ID,
@@ -69,7 +221,8 @@ where
   unit_key = $1
   and log_activity_id = $2
   and (language_code is null or language_code = $3)
-order by language_code is null asc
+  and (deprecated_at is null or id = $4::uuid)
+order by deprecated_at is not null asc, language_code is null asc
 limit 1
 `
 
@@ -77,6 +230,7 @@ type FindUnitForTrackingByKeyParams struct {
 	UnitKey       string
 	LogActivityID int16
 	LanguageCode  sql.NullString
+	CurrentUnitID uuid.NullUUID
 }
 
 type FindUnitForTrackingByKeyRow struct {
@@ -88,8 +242,14 @@ type FindUnitForTrackingByKeyRow struct {
 	LanguageCode  sql.NullString
 }
 
+// Active units take precedence over a deprecated current unit with the same key.
 func (q *Queries) FindUnitForTrackingByKey(ctx context.Context, arg FindUnitForTrackingByKeyParams) (FindUnitForTrackingByKeyRow, error) {
-	row := q.db.QueryRowContext(ctx, findUnitForTrackingByKey, arg.UnitKey, arg.LogActivityID, arg.LanguageCode)
+	row := q.db.QueryRowContext(ctx, findUnitForTrackingByKey,
+		arg.UnitKey,
+		arg.LogActivityID,
+		arg.LanguageCode,
+		arg.CurrentUnitID,
+	)
 	var i FindUnitForTrackingByKeyRow
 	err := row.Scan(
 		&i.ID,
@@ -109,22 +269,27 @@ select
   log_activity_id,
   name,
   modifier,
-  language_code
+  language_code,
+  deprecated_at,
+  media_length_unit
 from log_units
-order by log_activity_id asc
+where $1::boolean or deprecated_at is null
+order by log_activity_id asc, unit_key asc, language_code asc nulls first
 `
 
 type ListUnitsRow struct {
-	ID            uuid.UUID
-	UnitKey       string
-	LogActivityID int16
-	Name          string
-	Modifier      float32
-	LanguageCode  sql.NullString
+	ID              uuid.UUID
+	UnitKey         string
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
 }
 
-func (q *Queries) ListUnits(ctx context.Context) ([]ListUnitsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listUnits)
+func (q *Queries) ListUnits(ctx context.Context, includeDeprecated bool) ([]ListUnitsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnits, includeDeprecated)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +304,8 @@ func (q *Queries) ListUnits(ctx context.Context) ([]ListUnitsRow, error) {
 			&i.Name,
 			&i.Modifier,
 			&i.LanguageCode,
+			&i.DeprecatedAt,
+			&i.MediaLengthUnit,
 		); err != nil {
 			return nil, err
 		}
@@ -152,3 +319,72 @@ func (q *Queries) ListUnits(ctx context.Context) ([]ListUnitsRow, error) {
 	}
 	return items, nil
 }
+
+const unitKeyExists = `-- name: UnitKeyExists :one
+select exists (
+  select 1
+  from log_units
+  where unit_key = $1
+    and deprecated_at is null
+)
+`
+
+func (q *Queries) UnitKeyExists(ctx context.Context, unitKey string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, unitKeyExists, unitKey)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const updateUnit = `-- name: UpdateUnit :one
+update log_units
+set
+  name = $1,
+  modifier = $2,
+  deprecated_at = $3,
+  media_length_unit = $4
+where id = $5
+returning id, unit_key, log_activity_id, name, modifier, language_code, deprecated_at, media_length_unit
+`
+
+type UpdateUnitParams struct {
+	Name            string
+	Modifier        float32
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
+	ID              uuid.UUID
+}
+
+type UpdateUnitRow struct {
+	ID              uuid.UUID
+	UnitKey         string
+	LogActivityID   int16
+	Name            string
+	Modifier        float32
+	LanguageCode    sql.NullString
+	DeprecatedAt    sql.NullTime
+	MediaLengthUnit sql.NullString
+}
+
+// The key, activity and language identify the unit and never change.
+func (q *Queries) UpdateUnit(ctx context.Context, arg UpdateUnitParams) (UpdateUnitRow, error) {
+	row := q.db.QueryRowContext(ctx, updateUnit,
+		arg.Name,
+		arg.Modifier,
+		arg.DeprecatedAt,
+		arg.MediaLengthUnit,
+		arg.ID,
+	)
+	var i UpdateUnitRow
+	err := row.Scan(
+		&i.ID,
+		&i.UnitKey,
+		&i.LogActivityID,
+		&i.Name,
+		&i.Modifier,
+		&i.LanguageCode,
+		&i.DeprecatedAt,
+		&i.MediaLengthUnit,
+	)
+	return i, err
+}
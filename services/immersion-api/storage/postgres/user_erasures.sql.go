@@ -0,0 +1,453 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: user_erasures.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const anonymizeUserContestInvitations = `-- name: AnonymizeUserContestInvitations :exec
+update contest_invitations
+set invited_by_user_id = null
+where invited_by_user_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserContestInvitations(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserContestInvitations, userID)
+	return err
+}
+
+const anonymizeUserContestInviteCodes = `-- name: AnonymizeUserContestInviteCodes :exec
+update contest_invite_codes
+set created_by_user_id = null
+where created_by_user_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserContestInviteCodes(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserContestInviteCodes, userID)
+	return err
+}
+
+const anonymizeUserContestOrganizers = `-- name: AnonymizeUserContestOrganizers :exec
+update contest_organizers
+set added_by_user_id = null
+where added_by_user_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserContestOrganizers(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserContestOrganizers, userID)
+	return err
+}
+
+const anonymizeUserContestTemplates = `-- name: AnonymizeUserContestTemplates :exec
+update contest_templates
+set
+  owner_user_id = '00000000-0000-0000-0000-000000000000'::uuid,
+  owner_user_display_name = $1,
+  active = false,
+  updated_at = now()
+where owner_user_id = $2
+`
+
+type AnonymizeUserContestTemplatesParams struct {
+	DisplayName string
+	UserID      uuid.UUID
+}
+
+func (q *Queries) AnonymizeUserContestTemplates(ctx context.Context, arg AnonymizeUserContestTemplatesParams) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserContestTemplates, arg.DisplayName, arg.UserID)
+	return err
+}
+
+const anonymizeUserContests = `-- name: AnonymizeUserContests :exec
+update contests
+set
+  owner_user_id = '00000000-0000-0000-0000-000000000000'::uuid,
+  owner_user_display_name = $1,
+  updated_at = now()
+where owner_user_id = $2
+`
+
+type AnonymizeUserContestsParams struct {
+	DisplayName string
+	UserID      uuid.UUID
+}
+
+func (q *Queries) AnonymizeUserContests(ctx context.Context, arg AnonymizeUserContestsParams) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserContests, arg.DisplayName, arg.UserID)
+	return err
+}
+
+const anonymizeUserMedia = `-- name: AnonymizeUserMedia :exec
+update media
+set created_by_user_id = null
+where created_by_user_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserMedia(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserMedia, userID)
+	return err
+}
+
+const anonymizeUserModerationAuditLogs = `-- name: AnonymizeUserModerationAuditLogs :exec
+update moderation_audit_log
+set user_id = '00000000-0000-0000-0000-000000000000'::uuid
+where user_id = $1
+`
+
+func (q *Queries) AnonymizeUserModerationAuditLogs(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserModerationAuditLogs, userID)
+	return err
+}
+
+const anonymizeUserScoringRescoreJobs = `-- name: AnonymizeUserScoringRescoreJobs :exec
+update scoring_rescore_jobs
+set requested_by_user_id = null
+where requested_by_user_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserScoringRescoreJobs(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, anonymizeUserScoringRescoreJobs, userID)
+	return err
+}
+
+const completeUserErasure = `-- name: CompleteUserErasure :exec
+update user_erasures
+set status = 'completed', last_error = null, completed_at = $1::timestamp, updated_at = $1
+where id = $2 and status = 'pending'
+`
+
+type CompleteUserErasureParams struct {
+	Now time.Time
+	ID  uuid.UUID
+}
+
+func (q *Queries) CompleteUserErasure(ctx context.Context, arg CompleteUserErasureParams) error {
+	_, err := q.db.ExecContext(ctx, completeUserErasure, arg.Now, arg.ID)
+	return err
+}
+
+const createUserErasure = `-- name: CreateUserErasure :one
+insert into user_erasures (user_id, requested_by_user_id, created_at, updated_at)
+values ($1, $2, $3, $3)
+on conflict (user_id) where status = 'pending' do nothing
+returning id
+`
+
+type CreateUserErasureParams struct {
+	UserID            uuid.UUID
+	RequestedByUserID uuid.UUID
+	Now               time.Time
+}
+
+func (q *Queries) CreateUserErasure(ctx context.Context, arg CreateUserErasureParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createUserErasure, arg.UserID, arg.RequestedByUserID, arg.Now)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const eraseUser = `-- name: EraseUser :exec
+delete from users
+where id = $1
+`
+
+func (q *Queries) EraseUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUser, userID)
+	return err
+}
+
+const eraseUserContestLogs = `-- name: EraseUserContestLogs :exec
+delete from contest_logs
+where log_id in (select id from logs where user_id = $1)
+`
+
+func (q *Queries) EraseUserContestLogs(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserContestLogs, userID)
+	return err
+}
+
+const eraseUserContestRegistrations = `-- name: EraseUserContestRegistrations :exec
+delete from contest_registrations
+where user_id = $1
+`
+
+func (q *Queries) EraseUserContestRegistrations(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserContestRegistrations, userID)
+	return err
+}
+
+const eraseUserLeaderboardOutboxEvents = `-- name: EraseUserLeaderboardOutboxEvents :exec
+delete from leaderboard_outbox
+where user_id = $1
+`
+
+func (q *Queries) EraseUserLeaderboardOutboxEvents(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserLeaderboardOutboxEvents, userID)
+	return err
+}
+
+const eraseUserLogTags = `-- name: EraseUserLogTags :exec
+delete from log_tags
+where user_id = $1
+`
+
+func (q *Queries) EraseUserLogTags(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserLogTags, userID)
+	return err
+}
+
+const eraseUserLogs = `-- name: EraseUserLogs :exec
+delete from logs
+where user_id = $1
+`
+
+func (q *Queries) EraseUserLogs(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserLogs, userID)
+	return err
+}
+
+const eraseUserRoles = `-- name: EraseUserRoles :exec
+delete from user_roles
+where user_id = $1
+`
+
+func (q *Queries) EraseUserRoles(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserRoles, userID)
+	return err
+}
+
+const eraseUserScoringRescoreJobUserDiffs = `-- name: EraseUserScoringRescoreJobUserDiffs :exec
+delete from scoring_rescore_job_user_diffs
+where user_id = $1
+`
+
+func (q *Queries) EraseUserScoringRescoreJobUserDiffs(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, eraseUserScoringRescoreJobUserDiffs, userID)
+	return err
+}
+
+const findLatestUserErasureAuditHash = `-- name: FindLatestUserErasureAuditHash :one
+select hash
+from user_erasure_audit_log
+order by id desc
+limit 1
+`
+
+func (q *Queries) FindLatestUserErasureAuditHash(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, findLatestUserErasureAuditHash)
+	var hash string
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const findUserErasureByUserID = `-- name: FindUserErasureByUserID :one
+select
+  id,
+  user_id,
+  requested_by_user_id,
+  status,
+  attempts,
+  last_error,
+  database_erased_at,
+  leaderboards_erased_at,
+  relationships_erased_at,
+  created_at,
+  updated_at,
+  completed_at
+from user_erasures
+where user_id = $1
+order by status = 'pending' desc, created_at desc
+limit 1
+`
+
+func (q *Queries) FindUserErasureByUserID(ctx context.Context, userID uuid.UUID) (UserErasure, error) {
+	row := q.db.QueryRowContext(ctx, findUserErasureByUserID, userID)
+	var i UserErasure
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RequestedByUserID,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.DatabaseErasedAt,
+		&i.LeaderboardsErasedAt,
+		&i.RelationshipsErasedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const insertUserErasureAuditLog = `-- name: InsertUserErasureAuditLog :exec
+insert into user_erasure_audit_log (erasure_id, event, metadata, created_at, previous_hash, hash)
+values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+`
+
+type InsertUserErasureAuditLogParams struct {
+	ErasureID    uuid.UUID
+	Event        string
+	Metadata     json.RawMessage
+	CreatedAt    time.Time
+	PreviousHash string
+	Hash         string
+}
+
+func (q *Queries) InsertUserErasureAuditLog(ctx context.Context, arg InsertUserErasureAuditLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertUserErasureAuditLog,
+		arg.ErasureID,
+		arg.Event,
+		arg.Metadata,
+		arg.CreatedAt,
+		arg.PreviousHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const listPendingUserErasures = `-- name: ListPendingUserErasures :many
+select
+  id,
+  user_id,
+  requested_by_user_id,
+  status,
+  attempts,
+  last_error,
+  database_erased_at,
+  leaderboards_erased_at,
+  relationships_erased_at,
+  created_at,
+  updated_at,
+  completed_at
+from user_erasures
+where status = 'pending'
+order by created_at asc
+limit $1
+`
+
+func (q *Queries) ListPendingUserErasures(ctx context.Context, batchSize int32) ([]UserErasure, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingUserErasures, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserErasure
+	for rows.Next() {
+		var i UserErasure
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RequestedByUserID,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.DatabaseErasedAt,
+			&i.LeaderboardsErasedAt,
+			&i.RelationshipsErasedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUserErasureAuditLog = `-- name: LockUserErasureAuditLog :exec
+select pg_advisory_xact_lock(hashtext('user_erasure_audit_log'))
+`
+
+func (q *Queries) LockUserErasureAuditLog(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockUserErasureAuditLog)
+	return err
+}
+
+const markUserErasureDatabaseErased = `-- name: MarkUserErasureDatabaseErased :exec
+update user_erasures
+set database_erased_at = coalesce(database_erased_at, $1), updated_at = $1
+where id = $2
+`
+
+type MarkUserErasureDatabaseErasedParams struct {
+	Now time.Time
+	ID  uuid.UUID
+}
+
+func (q *Queries) MarkUserErasureDatabaseErased(ctx context.Context, arg MarkUserErasureDatabaseErasedParams) error {
+	_, err := q.db.ExecContext(ctx, markUserErasureDatabaseErased, arg.Now, arg.ID)
+	return err
+}
+
+const markUserErasureLeaderboardsErased = `-- name: MarkUserErasureLeaderboardsErased :exec
+update user_erasures
+set leaderboards_erased_at = coalesce(leaderboards_erased_at, $1), updated_at = $1
+where id = $2
+`
+
+type MarkUserErasureLeaderboardsErasedParams struct {
+	Now time.Time
+	ID  uuid.UUID
+}
+
+func (q *Queries) MarkUserErasureLeaderboardsErased(ctx context.Context, arg MarkUserErasureLeaderboardsErasedParams) error {
+	_, err := q.db.ExecContext(ctx, markUserErasureLeaderboardsErased, arg.Now, arg.ID)
+	return err
+}
+
+const markUserErasureRelationshipsErased = `-- name: MarkUserErasureRelationshipsErased :exec
+update user_erasures
+set relationships_erased_at = coalesce(relationships_erased_at, $1), updated_at = $1
+where id = $2
+`
+
+type MarkUserErasureRelationshipsErasedParams struct {
+	Now time.Time
+	ID  uuid.UUID
+}
+
+func (q *Queries) MarkUserErasureRelationshipsErased(ctx context.Context, arg MarkUserErasureRelationshipsErasedParams) error {
+	_, err := q.db.ExecContext(ctx, markUserErasureRelationshipsErased, arg.Now, arg.ID)
+	return err
+}
+
+const recordUserErasureFailure = `-- name: RecordUserErasureFailure :exec
+update user_erasures
+set attempts = attempts + 1, last_error = $1, updated_at = $2
+where id = $3
+`
+
+type RecordUserErasureFailureParams struct {
+	LastError sql.NullString
+	Now       time.Time
+	ID        uuid.UUID
+}
+
+func (q *Queries) RecordUserErasureFailure(ctx context.Context, arg RecordUserErasureFailureParams) error {
+	_, err := q.db.ExecContext(ctx, recordUserErasureFailure, arg.LastError, arg.Now, arg.ID)
+	return err
+}
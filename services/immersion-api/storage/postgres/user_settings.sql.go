@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.16.0
+// source: user_settings.sql
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteUserSettings = `-- name: DeleteUserSettings :exec
+delete from user_settings
+where user_id = $1
+`
+
+func (q *Queries) DeleteUserSettings(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteUserSettings, userID)
+	return err
+}
+
+const findUserSettings = `-- name: FindUserSettings :one
+select
+  user_id,
+  timezone,
+  streak_grace_days,
+  created_at,
+  updated_at
+from user_settings
+where user_id = $1
+`
+
+func (q *Queries) FindUserSettings(ctx context.Context, userID uuid.UUID) (UserSetting, error) {
+	row := q.db.QueryRowContext(ctx, findUserSettings, userID)
+	var i UserSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.Timezone,
+		&i.StreakGraceDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUserSettings = `-- name: UpsertUserSettings :exec
+insert into user_settings (
+  user_id,
+  timezone,
+  streak_grace_days,
+  created_at,
+  updated_at
+) values (
+  $1,
+  $2,
+  $3,
+  $4,
+  $4
+) on conflict (user_id) do
+update set
+  timezone = $2,
+  streak_grace_days = $3,
+  updated_at = $4
+`
+
+type UpsertUserSettingsParams struct {
+	UserID          uuid.UUID
+	Timezone        string
+	StreakGraceDays int16
+	Now             time.Time
+}
+
+func (q *Queries) UpsertUserSettings(ctx context.Context, arg UpsertUserSettingsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUserSettings,
+		arg.UserID,
+		arg.Timezone,
+		arg.StreakGraceDays,
+		arg.Now,
+	)
+	return err
+}
@@ -13,9 +13,10 @@ import (
 
 // Redis key prefixes for leaderboard sorted sets.
 const (
-	contestLeaderboardPrefix = "leaderboard:contest:"
-	yearlyLeaderboardPrefix  = "leaderboard:yearly:"
-	globalLeaderboardKey     = "leaderboard:global"
+	contestLeaderboardPrefix     = "leaderboard:contest:"
+	contestTeamLeaderboardPrefix = "leaderboard:contest-teams:"
+	yearlyLeaderboardPrefix      = "leaderboard:yearly:"
+	globalLeaderboardKey         = "leaderboard:global"
 )
 
 // updateScoreScript atomically checks if a sorted set exists and sets
@@ -112,6 +113,11 @@ func contestLeaderboardKey(contestID uuid.UUID) string {
 	return contestLeaderboardPrefix + contestID.String()
 }
 
+// contestTeamLeaderboardKey holds team IDs instead of user IDs as members.
+func contestTeamLeaderboardKey(contestID uuid.UUID) string {
+	return contestTeamLeaderboardPrefix + contestID.String()
+}
+
 func yearlyLeaderboardKey(year int) string {
 	return yearlyLeaderboardPrefix + strconv.Itoa(year)
 }
@@ -198,6 +204,34 @@ func (s *LeaderboardStore) FetchContestLeaderboardPage(ctx context.Context, cont
 	return s.fetchLeaderboardPage(ctx, contestLeaderboardKey(contestID), page, pageSize)
 }
 
+func (s *LeaderboardStore) RebuildContestTeamLeaderboard(ctx context.Context, contestID uuid.UUID, scores []domain.TeamLeaderboardScore) error {
+	members := make([]domain.LeaderboardScore, len(scores))
+	for i, entry := range scores {
+		members[i] = domain.LeaderboardScore{UserID: entry.TeamID, Score: entry.Score}
+	}
+	return s.rebuildLeaderboard(ctx, contestTeamLeaderboardKey(contestID), members)
+}
+
+func (s *LeaderboardStore) FetchContestTeamLeaderboardPage(ctx context.Context, contestID uuid.UUID, page, pageSize int) (*domain.TeamLeaderboardPage, bool, error) {
+	lbPage, exists, err := s.fetchLeaderboardPage(ctx, contestTeamLeaderboardKey(contestID), page, pageSize)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+
+	scores := make([]domain.TeamLeaderboardScore, len(lbPage.Scores))
+	for i, entry := range lbPage.Scores {
+		scores[i] = domain.TeamLeaderboardScore{TeamID: entry.UserID, Score: entry.Score}
+	}
+
+	return &domain.TeamLeaderboardPage{
+		Scores:     scores,
+		TotalCount: lbPage.TotalCount,
+		StartRank:  lbPage.StartRank,
+		HasPrevTie: lbPage.HasPrevTie,
+		HasNextTie: lbPage.HasNextTie,
+	}, true, nil
+}
+
 func (s *LeaderboardStore) RebuildGlobalLeaderboard(ctx context.Context, scores []domain.LeaderboardScore) error {
 	return s.rebuildLeaderboard(ctx, globalLeaderboardKey, scores)
 }
@@ -330,3 +364,31 @@ func (s *LeaderboardStore) rebuildLeaderboard(ctx context.Context, key string, s
 
 	return nil
 }
+
+// RemoveUserFromLeaderboards removes the user from every leaderboard sorted
+// set. Keys are discovered with SCAN so leaderboards of finished contests and
+// past years are covered as well.
+func (s *LeaderboardStore) RemoveUserFromLeaderboards(ctx context.Context, userID uuid.UUID) error {
+	member := userID.String()
+	cursor := uint64(0)
+
+	for {
+		scanCmd := s.client.B().Scan().Cursor(cursor).Match("leaderboard:*").Count(500).Type("zset").Build()
+		entry, err := s.client.Do(ctx, scanCmd).AsScanEntry()
+		if err != nil {
+			return fmt.Errorf("failed to scan leaderboard keys: %w", err)
+		}
+
+		for _, key := range entry.Elements {
+			zremCmd := s.client.B().Zrem().Key(key).Member(member).Build()
+			if err := s.client.Do(ctx, zremCmd).Error(); err != nil {
+				return fmt.Errorf("failed to remove member from leaderboard %s: %w", key, err)
+			}
+		}
+
+		if entry.Cursor == 0 {
+			return nil
+		}
+		cursor = entry.Cursor
+	}
+}
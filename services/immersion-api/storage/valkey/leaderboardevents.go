@@ -0,0 +1,200 @@
+package valkey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tadoku/tadoku/services/immersion-api/domain"
+	valkeylib "github.com/valkey-io/valkey-go"
+)
+
+// Leaderboard events are kept in a capped stream per board for replaying, and
+// broadcast on a channel per board for live delivery.
+const (
+	leaderboardEventsPrefix = "leaderboard-events:"
+	// leaderboardEventsMaxLen is approximate, Valkey trims whole stream nodes.
+	leaderboardEventsMaxLen = 1000
+	leaderboardEventsTTL    = 7 * 24 * time.Hour
+)
+
+// publishEventScript atomically appends an event to the board stream, computes
+// the rank of the user, and broadcasts the event with its assigned ID.
+//
+// KEYS[1] = event stream key
+// KEYS[2] = leaderboard sorted set key, used for the rank
+// ARGV[1] = channel
+// ARGV[2] = stream max length
+// ARGV[3] = stream TTL in seconds
+// ARGV[4] = board key
+// ARGV[5] = event type
+// ARGV[6] = user ID, empty for board wide events
+// ARGV[7] = score
+var publishEventScript = valkeylib.NewLuaScript(`
+local rank = 0
+if ARGV[6] ~= '' then
+  rank = redis.call('ZCOUNT', KEYS[2], '(' .. ARGV[7], '+inf') + 1
+end
+local id = redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[2], '*',
+  'type', ARGV[5], 'user_id', ARGV[6], 'score', ARGV[7], 'rank', rank)
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+redis.call('PUBLISH', ARGV[1], cjson.encode({
+  id = id, board = ARGV[4], type = ARGV[5], user_id = ARGV[6], score = ARGV[7], rank = rank
+}))
+return id
+`)
+
+// leaderboardEventMessage is the payload broadcast by publishEventScript.
+type leaderboardEventMessage struct {
+	ID     string `json:"id"`
+	Board  string `json:"board"`
+	Type   string `json:"type"`
+	UserID string `json:"user_id"`
+	Score  string `json:"score"`
+	Rank   int    `json:"rank"`
+}
+
+// LeaderboardEventStore implements domain.LeaderboardEventStore using Valkey
+// streams and pub/sub.
+type LeaderboardEventStore struct {
+	client valkeylib.Client
+}
+
+// NewLeaderboardEventStore creates a new LeaderboardEventStore backed by the given Valkey client.
+func NewLeaderboardEventStore(client valkeylib.Client) *LeaderboardEventStore {
+	return &LeaderboardEventStore{client: client}
+}
+
+func leaderboardEventsKey(board domain.LeaderboardBoard) string {
+	return leaderboardEventsPrefix + board.Key()
+}
+
+func leaderboardKeyForBoard(board domain.LeaderboardBoard) string {
+	switch board.Kind {
+	case domain.LeaderboardBoardContest:
+		return contestLeaderboardKey(board.ContestID)
+	case domain.LeaderboardBoardYearly:
+		return yearlyLeaderboardKey(board.Year)
+	}
+	return globalLeaderboardKey
+}
+
+func (s *LeaderboardEventStore) PublishLeaderboardEvent(ctx context.Context, board domain.LeaderboardBoard, event *domain.LeaderboardEvent) error {
+	key := leaderboardEventsKey(board)
+	userID := ""
+	if event.UserID != nil {
+		userID = event.UserID.String()
+	}
+
+	id, err := publishEventScript.Exec(ctx, s.client,
+		[]string{key, leaderboardKeyForBoard(board)},
+		[]string{
+			key,
+			strconv.Itoa(leaderboardEventsMaxLen),
+			strconv.FormatInt(int64(leaderboardEventsTTL.Seconds()), 10),
+			board.Key(),
+			string(event.Type),
+			userID,
+			strconv.FormatFloat(event.Score, 'f', -1, 64),
+		},
+	).ToString()
+	if err != nil {
+		return fmt.Errorf("failed to publish leaderboard event for key %s: %w", key, err)
+	}
+
+	event.ID = id
+	event.Board = board.Key()
+	return nil
+}
+
+func (s *LeaderboardEventStore) ListLeaderboardEventsSince(ctx context.Context, board domain.LeaderboardBoard, lastEventID string) ([]domain.LeaderboardEvent, bool, error) {
+	key := leaderboardEventsKey(board)
+
+	rangeCmd := s.client.B().Xrange().Key(key).Start("(" + lastEventID).End("+").Build()
+	entries, err := s.client.Do(ctx, rangeCmd).AsXRange()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list leaderboard events for key %s: %w", key, err)
+	}
+
+	// The history is complete if lastEventID hasn't been trimmed yet. This is
+	// checked after listing, so a trim in between errs on the side of a rebuild.
+	oldestCmd := s.client.B().Xrange().Key(key).Start("-").End("+").Count(1).Build()
+	oldest, err := s.client.Do(ctx, oldestCmd).AsXRange()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch oldest leaderboard event for key %s: %w", key, err)
+	}
+	complete := len(oldest) > 0 && domain.CompareLeaderboardEventIDs(oldest[0].ID, lastEventID) <= 0
+
+	events := make([]domain.LeaderboardEvent, 0, len(entries))
+	for _, entry := range entries {
+		event, err := leaderboardEventFromMessage(leaderboardEventMessage{
+			ID:     entry.ID,
+			Board:  board.Key(),
+			Type:   entry.FieldValues["type"],
+			UserID: entry.FieldValues["user_id"],
+			Score:  entry.FieldValues["score"],
+		}, entry.FieldValues["rank"])
+		if err != nil {
+			return nil, false, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, complete, nil
+}
+
+func (s *LeaderboardEventStore) ReceiveLeaderboardEvents(ctx context.Context, fn func(domain.LeaderboardEvent)) error {
+	subscribeCmd := s.client.B().Psubscribe().Pattern(leaderboardEventsPrefix + "*").Build()
+	err := s.client.Receive(ctx, subscribeCmd, func(msg valkeylib.PubSubMessage) {
+		var payload leaderboardEventMessage
+		if err := json.Unmarshal([]byte(msg.Message), &payload); err != nil {
+			return
+		}
+		event, err := leaderboardEventFromMessage(payload, strconv.Itoa(payload.Rank))
+		if err != nil {
+			return
+		}
+		fn(*event)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to receive leaderboard events: %w", err)
+	}
+	return nil
+}
+
+func leaderboardEventFromMessage(msg leaderboardEventMessage, rank string) (*domain.LeaderboardEvent, error) {
+	event := &domain.LeaderboardEvent{
+		ID:    msg.ID,
+		Board: msg.Board,
+		Type:  domain.LeaderboardEventType(msg.Type),
+	}
+
+	if msg.UserID != "" {
+		userID, err := uuid.Parse(msg.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse leaderboard event user %q: %w", msg.UserID, err)
+		}
+		event.UserID = &userID
+	}
+
+	if msg.Score != "" {
+		score, err := strconv.ParseFloat(msg.Score, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse leaderboard event score %q: %w", msg.Score, err)
+		}
+		event.Score = score
+	}
+
+	if rank != "" {
+		r, err := strconv.Atoi(rank)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse leaderboard event rank %q: %w", rank, err)
+		}
+		event.Rank = r
+	}
+
+	return event, nil
+}